@@ -12,9 +12,12 @@ import (
 )
 
 type Options struct {
-	DatabaseDriver  string
-	DatabaseDSN     string
-	ProvisionerSpec string
+	DatabaseDriver            string
+	DatabaseDSN               string
+	DatabaseEncryptionKeyring string
+	ProvisionerSpec           string
+	// ArchiveBucketURL is a gocloud.dev bucket URL for storing uploaded project archives (such as gs://bucket or file:///path/to/dir).
+	ArchiveBucketURL string
 }
 
 type Service struct {
@@ -33,7 +36,15 @@ type Service struct {
 
 func New(ctx context.Context, opts *Options, logger *zap.Logger, issuer *auth.Issuer, emailClient *email.Client, github Github) (*Service, error) {
 	// Init db
-	db, err := database.Open(opts.DatabaseDriver, opts.DatabaseDSN)
+	keyring, err := database.ParseEncryptionKeyring(opts.DatabaseEncryptionKeyring)
+	if err != nil {
+		logger.Fatal("error parsing database encryption keyring", zap.Error(err))
+	}
+	if len(keyring) == 0 {
+		logger.Warn("no database encryption keyring configured, secrets will be stored in plain text")
+	}
+
+	db, err := database.Open(opts.DatabaseDriver, opts.DatabaseDSN, keyring)
 	if err != nil {
 		logger.Fatal("error connecting to database", zap.Error(err))
 	}
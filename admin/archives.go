@@ -0,0 +1,86 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/runtime/drivers/archive"
+	"go.uber.org/multierr"
+	"gocloud.dev/blob"
+)
+
+// ErrArchivesNotConfigured is returned when uploading an archive without an ArchiveBucketURL configured.
+var ErrArchivesNotConfigured = errors.New("archive uploads are not configured")
+
+// UploadArchive stores an archive of a project's files in the archive bucket and records it as an asset of the org.
+// Archives are immutable: every upload creates a new asset, which becomes a new version of the projects that deploy it.
+func (s *Service) UploadArchive(ctx context.Context, orgID string, ownerID *string, r io.Reader) (*database.Asset, error) {
+	if s.opts.ArchiveBucketURL == "" {
+		return nil, ErrArchivesNotConfigured
+	}
+
+	bucket, err := blob.OpenBucket(ctx, s.opts.ArchiveBucketURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open archive bucket: %w", err)
+	}
+	defer bucket.Close()
+
+	// Canceling the writer's context before closing it aborts the write, so partial uploads are discarded
+	wctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	path := fmt.Sprintf("archives/%s/%s.tar.gz", orgID, uuid.New().String())
+	w, err := bucket.NewWriter(wctx, path, &blob.WriterOptions{ContentType: "application/gzip"})
+	if err != nil {
+		return nil, err
+	}
+	_, err = io.Copy(w, r)
+	if err != nil {
+		cancel()
+		_ = w.Close()
+		return nil, fmt.Errorf("failed to upload archive: %w", err)
+	}
+	err = w.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload archive: %w", err)
+	}
+
+	asset, err := s.DB.InsertAsset(ctx, &database.InsertAssetOptions{
+		OrganizationID: orgID,
+		Path:           path,
+		OwnerID:        ownerID,
+	})
+	if err != nil {
+		return nil, multierr.Combine(err, bucket.Delete(ctx, path))
+	}
+
+	return asset, nil
+}
+
+// archiveRepoInfoForRuntime returns the repo driver and DSN for deploying an uploaded archive.
+func (s *Service) archiveRepoInfoForRuntime(ctx context.Context, assetID string) (string, string, error) {
+	if s.opts.ArchiveBucketURL == "" {
+		return "", "", ErrArchivesNotConfigured
+	}
+
+	asset, err := s.DB.FindAsset(ctx, assetID)
+	if err != nil {
+		return "", "", err
+	}
+
+	dsn, err := json.Marshal(archive.DSN{
+		BucketURL: s.opts.ArchiveBucketURL,
+		Path:      asset.Path,
+		ID:        asset.ID,
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	return "archive", string(dsn), nil
+}
@@ -20,13 +20,14 @@ func Register(name string, driver Driver) {
 }
 
 // Open opens a new database connection.
-func Open(driver, dsn string) (DB, error) {
+// Secrets stored in the database are encrypted with the first key in the keyring (if any).
+func Open(driver, dsn string, keyring []*EncryptionKey) (DB, error) {
 	d, ok := Drivers[driver]
 	if !ok {
 		return nil, fmt.Errorf("unknown database driver: %s", driver)
 	}
 
-	db, err := d.Open(dsn)
+	db, err := d.Open(dsn, keyring)
 	if err != nil {
 		return nil, err
 	}
@@ -36,7 +37,7 @@ func Open(driver, dsn string) (DB, error) {
 
 // Driver is the interface for DB drivers.
 type Driver interface {
-	Open(dsn string) (DB, error)
+	Open(dsn string, keyring []*EncryptionKey) (DB, error)
 }
 
 // DB is the interface for a database connection.
@@ -44,6 +45,7 @@ type DB interface {
 	Close() error
 	NewTx(ctx context.Context) (context.Context, Tx, error)
 
+	// Migrate runs schema migrations and re-encrypts secrets that are not encrypted with the current encryption key.
 	Migrate(ctx context.Context) error
 	FindMigrationVersion(ctx context.Context) (int, error)
 
@@ -73,22 +75,27 @@ type DB interface {
 	FindPublicProjectsInOrganization(ctx context.Context, orgID, afterProjectName string, limit int) ([]*Project, error)
 	FindProjectsByGithubURL(ctx context.Context, githubURL string) ([]*Project, error)
 	FindProjectsByGithubInstallationID(ctx context.Context, id int64) ([]*Project, error)
+	FindProjectsWithGitRemote(ctx context.Context) ([]*Project, error)
 	FindProject(ctx context.Context, id string) (*Project, error)
 	FindProjectByName(ctx context.Context, orgName string, name string) (*Project, error)
 	InsertProject(ctx context.Context, opts *InsertProjectOptions) (*Project, error)
 	DeleteProject(ctx context.Context, id string) error
 	UpdateProject(ctx context.Context, id string, opts *UpdateProjectOptions) (*Project, error)
 	UpdateProjectVariables(ctx context.Context, id string, variables map[string]string) (*Project, error)
+	UpdateProjectPreviewSettings(ctx context.Context, id string, enabled bool, ttlSeconds int64) (*Project, error)
 	CountProjectsForOrganization(ctx context.Context, orgID string) (int, error)
 
 	FindExpiredDeployments(ctx context.Context) ([]*Deployment, error)
 	FindDeploymentsForProject(ctx context.Context, projectID string) ([]*Deployment, error)
 	FindDeployment(ctx context.Context, id string) (*Deployment, error)
 	FindDeploymentByInstanceID(ctx context.Context, instanceID string) (*Deployment, error)
+	FindPreviewDeploymentsForProject(ctx context.Context, projectID string) ([]*Deployment, error)
+	FindPreviewDeploymentForBranch(ctx context.Context, projectID, branch string) (*Deployment, error)
 	InsertDeployment(ctx context.Context, opts *InsertDeploymentOptions) (*Deployment, error)
 	DeleteDeployment(ctx context.Context, id string) error
 	UpdateDeploymentStatus(ctx context.Context, id string, status DeploymentStatus, logs string) (*Deployment, error)
 	UpdateDeploymentBranch(ctx context.Context, id, branch string) (*Deployment, error)
+	UpdateDeploymentCommitHash(ctx context.Context, id, commitHash string) (*Deployment, error)
 	UpdateDeploymentUsedOn(ctx context.Context, ids []string) error
 	CountDeploymentsForOrganization(ctx context.Context, orgID string) (*DeploymentsCount, error)
 
@@ -107,10 +114,16 @@ type DB interface {
 	UpdateSuperuser(ctx context.Context, userID string, superuser bool) error
 	CheckUserIsAnOrganizationMember(ctx context.Context, userID, orgID string) (bool, error)
 
+	FindUsergroupsForOrganization(ctx context.Context, orgID, afterName string, limit int) ([]*Usergroup, error)
+	FindUsergroupByName(ctx context.Context, orgName, name string) (*Usergroup, error)
 	InsertUsergroup(ctx context.Context, opts *InsertUsergroupOptions) (*Usergroup, error)
+	UpdateUsergroup(ctx context.Context, id string, opts *UpdateUsergroupOptions) (*Usergroup, error)
+	DeleteUsergroup(ctx context.Context, id string) error
 	FindUsergroupsForUser(ctx context.Context, userID, orgID string) ([]*Usergroup, error)
+	FindUsergroupMemberUsers(ctx context.Context, groupID, afterEmail string, limit int) ([]*UsergroupMemberUser, error)
 	InsertUsergroupMember(ctx context.Context, groupID, userID string) error
 	DeleteUsergroupMember(ctx context.Context, groupID, userID string) error
+	DeleteUsergroupsMemberUser(ctx context.Context, orgID, userID string) error
 
 	FindUserAuthTokens(ctx context.Context, userID string) ([]*UserAuthToken, error)
 	FindUserAuthToken(ctx context.Context, id string) (*UserAuthToken, error)
@@ -153,9 +166,17 @@ type DB interface {
 	UpdateOrganizationMemberUserRole(ctx context.Context, orgID, userID, roleID string) error
 	CountSingleuserOrganizationsForMemberUser(ctx context.Context, userID string) (int, error)
 
+	FindOrganizationMemberUsergroups(ctx context.Context, orgID, afterName string, limit int) ([]*MemberUsergroup, error)
+	InsertOrganizationMemberUsergroup(ctx context.Context, groupID, orgID, roleID string) error
+	UpdateOrganizationMemberUsergroup(ctx context.Context, groupID, orgID, roleID string) error
+	DeleteOrganizationMemberUsergroup(ctx context.Context, groupID, orgID string) error
+
 	FindProjectMemberUsers(ctx context.Context, projectID, afterEmail string, limit int) ([]*Member, error)
 	InsertProjectMemberUser(ctx context.Context, projectID, userID, roleID string) error
+	FindProjectMemberUsergroups(ctx context.Context, projectID, afterName string, limit int) ([]*MemberUsergroup, error)
 	InsertProjectMemberUsergroup(ctx context.Context, groupID, projectID, roleID string) error
+	UpdateProjectMemberUsergroup(ctx context.Context, groupID, projectID, roleID string) error
+	DeleteProjectMemberUsergroup(ctx context.Context, groupID, projectID string) error
 	DeleteProjectMemberUser(ctx context.Context, projectID, userID string) error
 	DeleteAllProjectMemberUserForOrganization(ctx context.Context, orgID, userID string) error
 	UpdateProjectMemberUserRole(ctx context.Context, projectID, userID, roleID string) error
@@ -180,7 +201,13 @@ type DB interface {
 	InsertBookmark(ctx context.Context, opts *InsertBookmarkOptions) (*Bookmark, error)
 	DeleteBookmark(ctx context.Context, bookmarkID string) error
 
+	FindAsset(ctx context.Context, id string) (*Asset, error)
+	InsertAsset(ctx context.Context, opts *InsertAssetOptions) (*Asset, error)
+
 	SearchProjectUsers(ctx context.Context, projectID, emailQuery string, afterEmail string, limit int) ([]*User, error)
+
+	FindAuditEvents(ctx context.Context, opts *FindAuditEventsOptions) ([]*AuditEvent, error)
+	InsertAuditEvent(ctx context.Context, opts *InsertAuditEventOptions) (*AuditEvent, error)
 }
 
 // Tx represents a database transaction. It can only be used to commit and rollback transactions.
@@ -239,6 +266,9 @@ type UpdateOrganizationOptions struct {
 
 // Project represents one Git connection.
 // Projects belong to an organization.
+// A project is deployed either from Github (GithubURL), from any other Git remote (GitRemote), such as a self-hosted GitLab,
+// or from an uploaded archive of the project files (ArchiveAssetID).
+// GitCredentials and GitWebhookSecret are encrypted at rest, so they're not scanned directly.
 type Project struct {
 	ID                   string
 	OrganizationID       string `db:"org_id"`
@@ -246,18 +276,24 @@ type Project struct {
 	Description          string
 	Public               bool
 	Region               string
-	GithubURL            *string   `db:"github_url"`
-	GithubInstallationID *int64    `db:"github_installation_id"`
-	Subpath              string    `db:"subpath"`
-	ProdBranch           string    `db:"prod_branch"`
-	ProdVariables        Variables `db:"prod_variables"`
-	ProdOLAPDriver       string    `db:"prod_olap_driver"`
-	ProdOLAPDSN          string    `db:"prod_olap_dsn"`
-	ProdSlots            int       `db:"prod_slots"`
-	ProdTTLSeconds       *int64    `db:"prod_ttl_seconds"`
-	ProdDeploymentID     *string   `db:"prod_deployment_id"`
-	CreatedOn            time.Time `db:"created_on"`
-	UpdatedOn            time.Time `db:"updated_on"`
+	GithubURL            *string         `db:"github_url"`
+	GithubInstallationID *int64          `db:"github_installation_id"`
+	GitRemote            *string         `db:"git_remote"`
+	GitCredentials       *GitCredentials `db:"-"`
+	GitWebhookSecret     string          `db:"-"`
+	ArchiveAssetID       *string         `db:"archive_asset_id"`
+	Subpath              string          `db:"subpath"`
+	ProdBranch           string          `db:"prod_branch"`
+	ProdVariables        Variables       `db:"prod_variables"`
+	ProdOLAPDriver       string          `db:"prod_olap_driver"`
+	ProdOLAPDSN          string          `db:"prod_olap_dsn"`
+	ProdSlots            int             `db:"prod_slots"`
+	ProdTTLSeconds       *int64          `db:"prod_ttl_seconds"`
+	ProdDeploymentID     *string         `db:"prod_deployment_id"`
+	PreviewEnabled       bool            `db:"preview_enabled"`
+	PreviewTTLSeconds    int64           `db:"preview_ttl_seconds"`
+	CreatedOn            time.Time       `db:"created_on"`
+	UpdatedOn            time.Time       `db:"updated_on"`
 }
 
 // Variables implements JSON SQL encoding of variables in Project.
@@ -271,6 +307,17 @@ func (e *Variables) Scan(value interface{}) error {
 	return json.Unmarshal(b, &e)
 }
 
+// GitCredentials are the credentials used to clone a project's GitRemote.
+// Username and Password are used for HTTPS remotes (Password can be an access token).
+// PrivateKey is a PEM-encoded SSH key (such as a deploy key) used for SSH remotes.
+type GitCredentials struct {
+	Username           string `json:"username,omitempty"`
+	Password           string `json:"password,omitempty"`
+	PrivateKey         string `json:"private_key,omitempty"`
+	PrivateKeyPassword string `json:"private_key_password,omitempty"`
+	KnownHosts         string `json:"known_hosts,omitempty"`
+}
+
 // InsertProjectOptions defines options for inserting a new Project.
 type InsertProjectOptions struct {
 	OrganizationID       string `validate:"required"`
@@ -280,6 +327,10 @@ type InsertProjectOptions struct {
 	Region               string
 	GithubURL            *string `validate:"omitempty,http_url"`
 	GithubInstallationID *int64  `validate:"omitempty,ne=0"`
+	GitRemote            *string `validate:"omitempty,min=1"`
+	GitCredentials       *GitCredentials
+	GitWebhookSecret     string
+	ArchiveAssetID       *string
 	Subpath              string
 	ProdBranch           string
 	ProdVariables        map[string]string
@@ -296,6 +347,10 @@ type UpdateProjectOptions struct {
 	Public               bool
 	GithubURL            *string `validate:"omitempty,http_url"`
 	GithubInstallationID *int64  `validate:"omitempty,ne=0"`
+	GitRemote            *string `validate:"omitempty,min=1"`
+	GitCredentials       *GitCredentials
+	GitWebhookSecret     string
+	ArchiveAssetID       *string
 	ProdBranch           string
 	ProdVariables        map[string]string
 	ProdDeploymentID     *string
@@ -317,6 +372,8 @@ const (
 
 // Deployment is a single deployment of a git branch.
 // Deployments belong to a project.
+// A project has at most one prod deployment (referenced by Project.ProdDeploymentID) and any number of
+// short-lived preview deployments (at most one per branch).
 type Deployment struct {
 	ID                string           `db:"id"`
 	ProjectID         string           `db:"project_id"`
@@ -327,9 +384,17 @@ type Deployment struct {
 	RuntimeAudience   string           `db:"runtime_audience"`
 	Status            DeploymentStatus `db:"status"`
 	Logs              string           `db:"logs"`
-	CreatedOn         time.Time        `db:"created_on"`
-	UpdatedOn         time.Time        `db:"updated_on"`
-	UsedOn            time.Time        `db:"used_on"`
+	Preview           bool             `db:"preview"`
+	// PreviewVariables override the project's prod variables for a preview deployment
+	PreviewVariables Variables `db:"preview_variables"`
+	// PreviewTTLSeconds is the time after which an unused preview deployment is torn down
+	PreviewTTLSeconds *int64 `db:"preview_ttl_seconds"`
+	// CommitHash is the last commit of Branch that a reconcile was triggered for.
+	// It's only tracked for projects with a GitRemote, which are polled for new commits.
+	CommitHash string    `db:"commit_hash"`
+	CreatedOn  time.Time `db:"created_on"`
+	UpdatedOn  time.Time `db:"updated_on"`
+	UsedOn     time.Time `db:"used_on"`
 }
 
 // InsertDeploymentOptions defines options for inserting a new Deployment.
@@ -342,6 +407,9 @@ type InsertDeploymentOptions struct {
 	RuntimeAudience   string
 	Status            DeploymentStatus
 	Logs              string
+	Preview           bool
+	PreviewVariables  map[string]string
+	PreviewTTLSeconds *int64
 }
 
 // RuntimeSlotsUsed is the result of a ResolveRuntimeSlotsUsed query.
@@ -408,15 +476,34 @@ type UpdateServiceOptions struct {
 
 // Usergroup represents a group of org members
 type Usergroup struct {
-	ID    string `db:"id"`
-	OrgID string `db:"org_id"`
-	Name  string `db:"name" validate:"slug"`
+	ID          string    `db:"id"`
+	OrgID       string    `db:"org_id"`
+	Name        string    `db:"name" validate:"slug"`
+	Description string    `db:"description"`
+	CreatedOn   time.Time `db:"created_on"`
+	UpdatedOn   time.Time `db:"updated_on"`
 }
 
 // InsertUsergroupOptions defines options for inserting a new usergroup
 type InsertUsergroupOptions struct {
-	OrgID string
-	Name  string `validate:"slug"`
+	OrgID       string
+	Name        string `validate:"slug"`
+	Description string
+}
+
+// UpdateUsergroupOptions defines options for updating a usergroup
+type UpdateUsergroupOptions struct {
+	Name        string `validate:"slug"`
+	Description string
+}
+
+// UsergroupMemberUser is a user that is a member of a usergroup.
+type UsergroupMemberUser struct {
+	ID          string
+	Email       string
+	DisplayName string    `db:"display_name"`
+	CreatedOn   time.Time `db:"created_on"`
+	UpdatedOn   time.Time `db:"updated_on"`
 }
 
 // UserAuthToken is a persistent API token for a user.
@@ -536,6 +623,7 @@ type ProjectRole struct {
 	ManageDev            bool `db:"manage_dev"`
 	ReadProjectMembers   bool `db:"read_project_members"`
 	ManageProjectMembers bool `db:"manage_project_members"`
+	ReadProjectVariables bool `db:"read_project_variables"`
 }
 
 // Member is a convenience type used for display-friendly representation of an org or project member.
@@ -548,6 +636,15 @@ type Member struct {
 	UpdatedOn   time.Time `db:"updated_on"`
 }
 
+// MemberUsergroup is a usergroup that has a role on an org or project.
+type MemberUsergroup struct {
+	ID        string
+	Name      string    `db:"name"`
+	RoleName  string    `db:"role_name"`
+	CreatedOn time.Time `db:"created_on"`
+	UpdatedOn time.Time `db:"updated_on"`
+}
+
 // OrganizationInvite represents an outstanding invitation to join an org.
 type OrganizationInvite struct {
 	ID              string
@@ -643,3 +740,64 @@ type InsertBookmarkOptions struct {
 	ProjectID     string `json:"project_id"`
 	UserID        string `json:"user_id"`
 }
+
+// Asset is a file uploaded to the admin service's object store, such as an archive of a project's files.
+// Path is the object's key in the bucket.
+type Asset struct {
+	ID             string
+	OrganizationID string    `db:"org_id"`
+	Path           string    `db:"path"`
+	OwnerID        *string   `db:"owner_id"`
+	CreatedOn      time.Time `db:"created_on"`
+}
+
+// InsertAssetOptions defines options for inserting a new Asset.
+type InsertAssetOptions struct {
+	OrganizationID string `validate:"required"`
+	Path           string `validate:"required"`
+	OwnerID        *string
+}
+
+// AuditEvent is an entry in the append-only log of administrative actions.
+// It doesn't reference other tables so it's retained after the org, project or user it mentions is deleted.
+type AuditEvent struct {
+	ID          string
+	CreatedOn   time.Time `db:"created_on"`
+	OrgID       *string   `db:"org_id"`
+	OrgName     string    `db:"org_name"`
+	ProjectID   *string   `db:"project_id"`
+	ProjectName string    `db:"project_name"`
+	ActorType   string    `db:"actor_type"`
+	ActorID     string    `db:"actor_id"`
+	ActorEmail  string    `db:"actor_email"`
+	Action      string    `db:"action"`
+	Target      string    `db:"target"`
+	// Diff is a JSON object with the state before and after the action. Secrets are redacted before it's stored.
+	Diff []byte `db:"diff"`
+}
+
+// InsertAuditEventOptions defines options for inserting a new AuditEvent.
+type InsertAuditEventOptions struct {
+	OrgID       *string
+	OrgName     string
+	ProjectID   *string
+	ProjectName string
+	ActorType   string `validate:"required"`
+	ActorID     string
+	ActorEmail  string
+	Action      string `validate:"required"`
+	Target      string
+	Diff        []byte
+}
+
+// FindAuditEventsOptions filters and paginates the audit events of an organization.
+// Events are returned newest first. Pass the CreatedOn and ID of the last event of a page to get the next page.
+type FindAuditEventsOptions struct {
+	OrgID          string `validate:"required"`
+	ProjectName    string
+	Action         string
+	ActorEmail     string
+	AfterCreatedOn *time.Time
+	AfterID        string
+	Limit          int
+}
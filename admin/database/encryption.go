@@ -0,0 +1,48 @@
+package database
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// EncryptionKey is a symmetric key used to encrypt secrets (such as project variables) before they're stored in the database.
+type EncryptionKey struct {
+	ID     string `json:"key_id"`
+	Secret []byte `json:"key"`
+}
+
+// ParseEncryptionKeyring parses a JSON list of encryption keys of the form [{"key_id": "...", "key": "<base64>"}].
+// The first key in the list is used to encrypt new values. All keys are used to decrypt existing values,
+// so keys can be rotated by prepending a new key to the list.
+// An empty string returns an empty keyring, which means secrets are stored in plain text.
+func ParseEncryptionKeyring(keyring string) ([]*EncryptionKey, error) {
+	if keyring == "" {
+		return nil, nil
+	}
+
+	var res []*EncryptionKey
+	err := json.Unmarshal([]byte(keyring), &res)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse encryption keyring: %w", err)
+	}
+
+	seen := make(map[string]bool, len(res))
+	for _, key := range res {
+		if key.ID == "" {
+			return nil, errors.New("encryption keyring contains a key without an ID")
+		}
+		if seen[key.ID] {
+			return nil, fmt.Errorf("encryption keyring contains duplicate key ID %q", key.ID)
+		}
+		seen[key.ID] = true
+
+		switch len(key.Secret) {
+		case 16, 24, 32:
+		default:
+			return nil, fmt.Errorf("encryption key %q must be 16, 24 or 32 bytes, got %d", key.ID, len(key.Secret))
+		}
+	}
+
+	return res, nil
+}
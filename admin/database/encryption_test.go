@@ -0,0 +1,29 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEncryptionKeyring(t *testing.T) {
+	keyring, err := ParseEncryptionKeyring("")
+	require.NoError(t, err)
+	require.Empty(t, keyring)
+
+	keyring, err = ParseEncryptionKeyring(`[{"key_id":"new","key":"MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="},{"key_id":"old","key":"MDEyMzQ1Njc4OWFiY2RlZg=="}]`)
+	require.NoError(t, err)
+	require.Len(t, keyring, 2)
+	require.Equal(t, "new", keyring[0].ID)
+	require.Equal(t, []byte("0123456789abcdef0123456789abcdef"), keyring[0].Secret)
+	require.Equal(t, []byte("0123456789abcdef"), keyring[1].Secret)
+
+	_, err = ParseEncryptionKeyring(`[{"key_id":"short","key":"YWJj"}]`)
+	require.Error(t, err)
+	_, err = ParseEncryptionKeyring(`[{"key":"MDEyMzQ1Njc4OWFiY2RlZg=="}]`)
+	require.Error(t, err)
+	_, err = ParseEncryptionKeyring(`[{"key_id":"a","key":"MDEyMzQ1Njc4OWFiY2RlZg=="},{"key_id":"a","key":"MDEyMzQ1Njc4OWFiY2RlZg=="}]`)
+	require.Error(t, err)
+	_, err = ParseEncryptionKeyring(`not json`)
+	require.Error(t, err)
+}
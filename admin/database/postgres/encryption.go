@@ -0,0 +1,290 @@
+package postgres
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/runtime/pkg/symmetriccrypto"
+)
+
+// projectDTO is used to scan a project row along with the IDs of the keys its secrets are encrypted with.
+// An empty key ID means the value is stored in plain text.
+type projectDTO struct {
+	database.Project
+	ProdVariablesEncryptionKeyID string `db:"prod_variables_encryption_key_id"`
+	ProdOLAPDSNEncryptionKeyID   string `db:"prod_olap_dsn_encryption_key_id"`
+	// GitCredentials holds the JSON-encoded database.GitCredentials
+	GitCredentials                  string `db:"git_credentials"`
+	GitCredentialsEncryptionKeyID   string `db:"git_credentials_encryption_key_id"`
+	GitWebhookSecret                string `db:"git_webhook_secret"`
+	GitWebhookSecretEncryptionKeyID string `db:"git_webhook_secret_encryption_key_id"`
+}
+
+// deploymentDTO is used to scan a deployment row along with the ID of the key its preview variables are encrypted with.
+type deploymentDTO struct {
+	database.Deployment
+	PreviewVariablesEncryptionKeyID string `db:"preview_variables_encryption_key_id"`
+}
+
+// projectFromDTO decrypts the secrets in a scanned project.
+func (c *connection) projectFromDTO(dto *projectDTO) (*database.Project, error) {
+	vars, err := c.decryptVariables(dto.ProdVariables, dto.ProdVariablesEncryptionKeyID)
+	if err != nil {
+		return nil, err
+	}
+	dto.ProdVariables = vars
+
+	dsn, err := c.decrypt(dto.ProdOLAPDSN, dto.ProdOLAPDSNEncryptionKeyID)
+	if err != nil {
+		return nil, err
+	}
+	dto.ProdOLAPDSN = dsn
+
+	creds, err := c.decrypt(dto.GitCredentials, dto.GitCredentialsEncryptionKeyID)
+	if err != nil {
+		return nil, err
+	}
+	if creds != "" {
+		dto.Project.GitCredentials = &database.GitCredentials{}
+		err = json.Unmarshal([]byte(creds), dto.Project.GitCredentials)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse git credentials: %w", err)
+		}
+	}
+
+	secret, err := c.decrypt(dto.GitWebhookSecret, dto.GitWebhookSecretEncryptionKeyID)
+	if err != nil {
+		return nil, err
+	}
+	dto.Project.GitWebhookSecret = secret
+
+	return &dto.Project, nil
+}
+
+func (c *connection) projectsFromDTOs(dtos []*projectDTO) ([]*database.Project, error) {
+	res := make([]*database.Project, len(dtos))
+	for i, dto := range dtos {
+		var err error
+		res[i], err = c.projectFromDTO(dto)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// deploymentFromDTO decrypts the secrets in a scanned deployment.
+func (c *connection) deploymentFromDTO(dto *deploymentDTO) (*database.Deployment, error) {
+	vars, err := c.decryptVariables(dto.PreviewVariables, dto.PreviewVariablesEncryptionKeyID)
+	if err != nil {
+		return nil, err
+	}
+	dto.PreviewVariables = vars
+	return &dto.Deployment, nil
+}
+
+func (c *connection) deploymentsFromDTOs(dtos []*deploymentDTO) ([]*database.Deployment, error) {
+	res := make([]*database.Deployment, len(dtos))
+	for i, dto := range dtos {
+		var err error
+		res[i], err = c.deploymentFromDTO(dto)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// encrypt encrypts val with the current key in the keyring.
+// It returns the base64-encoded ciphertext and the ID of the key used.
+// If the keyring is empty, val is returned unchanged with an empty key ID.
+func (c *connection) encrypt(val string) (string, string, error) {
+	if len(c.keyring) == 0 {
+		return val, "", nil
+	}
+
+	key := c.keyring[0]
+	if val == "" {
+		return "", key.ID, nil
+	}
+
+	enc, err := symmetriccrypto.NewEncoder(key.Secret)
+	if err != nil {
+		return "", "", err
+	}
+
+	res, err := enc.Encrypt([]byte(val))
+	if err != nil {
+		return "", "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(res), key.ID, nil
+}
+
+// encryptGitCredentials JSON-encodes and encrypts creds with the current key in the keyring.
+// Nil credentials are stored as an empty string.
+func (c *connection) encryptGitCredentials(creds *database.GitCredentials) (string, string, error) {
+	if creds == nil {
+		return c.encrypt("")
+	}
+
+	data, err := json.Marshal(creds)
+	if err != nil {
+		return "", "", err
+	}
+	return c.encrypt(string(data))
+}
+
+// decrypt decrypts a value encrypted with encrypt.
+func (c *connection) decrypt(val, keyID string) (string, error) {
+	if keyID == "" || val == "" {
+		return val, nil
+	}
+
+	enc, err := c.encoder(keyID)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := base64.StdEncoding.DecodeString(val)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode encrypted value: %w", err)
+	}
+
+	res, err := enc.Decrypt(data)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt value with key %q: %w", keyID, err)
+	}
+
+	return string(res), nil
+}
+
+// encryptVariables encrypts each value in vars with the current key in the keyring.
+// Variable names are not encrypted.
+func (c *connection) encryptVariables(vars map[string]string) (database.Variables, string, error) {
+	if len(c.keyring) == 0 {
+		return vars, "", nil
+	}
+
+	res := make(database.Variables, len(vars))
+	for k, v := range vars {
+		var err error
+		res[k], _, err = c.encrypt(v)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	return res, c.keyring[0].ID, nil
+}
+
+// decryptVariables decrypts variables encrypted with encryptVariables.
+func (c *connection) decryptVariables(vars database.Variables, keyID string) (database.Variables, error) {
+	if keyID == "" {
+		return vars, nil
+	}
+
+	res := make(database.Variables, len(vars))
+	for k, v := range vars {
+		var err error
+		res[k], err = c.decrypt(v, keyID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (c *connection) encoder(keyID string) (symmetriccrypto.Encoder, error) {
+	for _, key := range c.keyring {
+		if key.ID == keyID {
+			return symmetriccrypto.NewEncoder(key.Secret)
+		}
+	}
+	return symmetriccrypto.Encoder{}, fmt.Errorf("encryption key %q not found in keyring", keyID)
+}
+
+// reencrypt re-encrypts all secrets that are not encrypted with the current key in the keyring.
+// This encrypts values stored in plain text when a keyring is first configured, and rotates values to a new key
+// after it has been prepended to the keyring. It does nothing if the keyring is empty.
+func (c *connection) reencrypt(ctx context.Context) error {
+	if len(c.keyring) == 0 {
+		return nil
+	}
+	currentKeyID := c.keyring[0].ID
+
+	ctx, tx, err := c.NewTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var projects []*projectDTO
+	err = c.getDB(ctx).SelectContext(ctx, &projects, `
+		SELECT * FROM projects
+		WHERE prod_variables_encryption_key_id <> $1 OR prod_olap_dsn_encryption_key_id <> $1
+		OR git_credentials_encryption_key_id <> $1 OR git_webhook_secret_encryption_key_id <> $1
+		FOR UPDATE`, currentKeyID)
+	if err != nil {
+		return parseErr("projects", err)
+	}
+
+	for _, dto := range projects {
+		p, err := c.projectFromDTO(dto)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt project %q: %w", dto.ID, err)
+		}
+
+		vars, varsKeyID, err := c.encryptVariables(p.ProdVariables)
+		if err != nil {
+			return err
+		}
+		dsn, dsnKeyID, err := c.encrypt(p.ProdOLAPDSN)
+		if err != nil {
+			return err
+		}
+		creds, credsKeyID, err := c.encryptGitCredentials(p.GitCredentials)
+		if err != nil {
+			return err
+		}
+		secret, secretKeyID, err := c.encrypt(p.GitWebhookSecret)
+		if err != nil {
+			return err
+		}
+
+		_, err = c.getDB(ctx).ExecContext(ctx, `
+			UPDATE projects SET prod_variables=$1, prod_variables_encryption_key_id=$2, prod_olap_dsn=$3, prod_olap_dsn_encryption_key_id=$4,
+			git_credentials=$5, git_credentials_encryption_key_id=$6, git_webhook_secret=$7, git_webhook_secret_encryption_key_id=$8
+			WHERE id=$9`, vars, varsKeyID, dsn, dsnKeyID, creds, credsKeyID, secret, secretKeyID, p.ID)
+		if err != nil {
+			return parseErr("project", err)
+		}
+	}
+
+	var deployments []*deploymentDTO
+	err = c.getDB(ctx).SelectContext(ctx, &deployments, "SELECT * FROM deployments WHERE preview_variables_encryption_key_id <> $1 FOR UPDATE", currentKeyID)
+	if err != nil {
+		return parseErr("deployments", err)
+	}
+
+	for _, dto := range deployments {
+		d, err := c.deploymentFromDTO(dto)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt deployment %q: %w", dto.ID, err)
+		}
+
+		vars, keyID, err := c.encryptVariables(d.PreviewVariables)
+		if err != nil {
+			return err
+		}
+
+		_, err = c.getDB(ctx).ExecContext(ctx, "UPDATE deployments SET preview_variables=$1, preview_variables_encryption_key_id=$2 WHERE id=$3", vars, keyID, d.ID)
+		if err != nil {
+			return parseErr("deployment", err)
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptionKeyRotation(t *testing.T) {
+	oldKey := &database.EncryptionKey{ID: "old", Secret: []byte("0123456789abcdef0123456789abcdef")}
+	newKey := &database.EncryptionKey{ID: "new", Secret: []byte("fedcba9876543210fedcba9876543210")}
+	vars := map[string]string{"API_KEY": "secret", "EMPTY": ""}
+
+	// Without a keyring, values are stored in plain text
+	plain := &connection{}
+	res, keyID, err := plain.encryptVariables(vars)
+	require.NoError(t, err)
+	require.Empty(t, keyID)
+	require.Equal(t, database.Variables(vars), res)
+
+	// Values are encrypted with the current key
+	c := &connection{keyring: []*database.EncryptionKey{oldKey}}
+	encrypted, keyID, err := c.encryptVariables(vars)
+	require.NoError(t, err)
+	require.Equal(t, "old", keyID)
+	require.NotEqual(t, "secret", encrypted["API_KEY"])
+	dsn, dsnKeyID, err := c.encrypt("user:password@host")
+	require.NoError(t, err)
+	require.Equal(t, "old", dsnKeyID)
+
+	// After rotation, values encrypted with the old key can still be decrypted
+	c = &connection{keyring: []*database.EncryptionKey{newKey, oldKey}}
+	p, err := c.projectFromDTO(&projectDTO{
+		Project:                      database.Project{ProdVariables: encrypted, ProdOLAPDSN: dsn},
+		ProdVariablesEncryptionKeyID: keyID,
+		ProdOLAPDSNEncryptionKeyID:   dsnKeyID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, database.Variables(vars), p.ProdVariables)
+	require.Equal(t, "user:password@host", p.ProdOLAPDSN)
+
+	// Plain text values written before the keyring was configured are still readable
+	p, err = c.projectFromDTO(&projectDTO{Project: database.Project{ProdVariables: vars, ProdOLAPDSN: "plain"}})
+	require.NoError(t, err)
+	require.Equal(t, "plain", p.ProdOLAPDSN)
+
+	// Git credentials are encrypted as JSON
+	creds := &database.GitCredentials{Username: "deploy", Password: "token"}
+	encCreds, credsKeyID, err := c.encryptGitCredentials(creds)
+	require.NoError(t, err)
+	require.Equal(t, "new", credsKeyID)
+	require.NotContains(t, encCreds, "token")
+	p, err = c.projectFromDTO(&projectDTO{GitCredentials: encCreds, GitCredentialsEncryptionKeyID: credsKeyID})
+	require.NoError(t, err)
+	require.Equal(t, creds, p.GitCredentials)
+	p, err = c.projectFromDTO(&projectDTO{})
+	require.NoError(t, err)
+	require.Nil(t, p.GitCredentials)
+
+	// Values can't be decrypted once their key is removed from the keyring
+	c = &connection{keyring: []*database.EncryptionKey{newKey}}
+	_, err = c.decryptVariables(encrypted, keyID)
+	require.Error(t, err)
+}
@@ -107,6 +107,12 @@ func (c *connection) Migrate(ctx context.Context) (err error) {
 		}
 	}
 
+	// Encrypt secrets stored in plain text or with a rotated key
+	err = c.reencrypt(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to re-encrypt secrets: %w", err)
+	}
+
 	return nil
 }
 
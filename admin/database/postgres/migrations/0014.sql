@@ -0,0 +1,8 @@
+ALTER TABLE projects ADD COLUMN preview_enabled BOOLEAN DEFAULT false NOT NULL;
+ALTER TABLE projects ADD COLUMN preview_ttl_seconds BIGINT DEFAULT 259200 NOT NULL;
+
+ALTER TABLE deployments ADD COLUMN preview BOOLEAN DEFAULT false NOT NULL;
+ALTER TABLE deployments ADD COLUMN preview_variables JSONB DEFAULT '{}'::jsonb NOT NULL;
+ALTER TABLE deployments ADD COLUMN preview_ttl_seconds BIGINT;
+
+CREATE UNIQUE INDEX deployments_preview_branch_idx ON deployments (project_id, branch) WHERE preview;
@@ -0,0 +1,27 @@
+CREATE TABLE audit_events (
+	id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+	created_on TIMESTAMPTZ DEFAULT now() NOT NULL,
+	org_id UUID,
+	org_name TEXT NOT NULL DEFAULT '',
+	project_id UUID,
+	project_name TEXT NOT NULL DEFAULT '',
+	actor_type TEXT NOT NULL,
+	actor_id TEXT NOT NULL DEFAULT '',
+	actor_email TEXT NOT NULL DEFAULT '',
+	action TEXT NOT NULL,
+	target TEXT NOT NULL DEFAULT '',
+	diff JSONB DEFAULT '{}'::JSONB NOT NULL
+);
+
+-- Events are not referenced by foreign keys so they outlive the orgs, projects and users they mention
+CREATE INDEX audit_events_org_created_on_idx ON audit_events (org_id, created_on DESC, id DESC);
+
+-- The audit log is append-only
+CREATE FUNCTION audit_events_append_only() RETURNS TRIGGER AS $$
+BEGIN
+	RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_events_append_only_trigger BEFORE UPDATE OR DELETE ON audit_events
+	FOR EACH ROW EXECUTE FUNCTION audit_events_append_only();
@@ -0,0 +1,6 @@
+ALTER TABLE projects ADD COLUMN prod_variables_encryption_key_id TEXT DEFAULT '' NOT NULL;
+ALTER TABLE projects ADD COLUMN prod_olap_dsn_encryption_key_id TEXT DEFAULT '' NOT NULL;
+ALTER TABLE deployments ADD COLUMN preview_variables_encryption_key_id TEXT DEFAULT '' NOT NULL;
+
+ALTER TABLE project_roles ADD COLUMN read_project_variables BOOLEAN DEFAULT false NOT NULL;
+UPDATE project_roles SET read_project_variables = true WHERE name = 'admin';
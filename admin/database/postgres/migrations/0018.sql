@@ -0,0 +1,8 @@
+ALTER TABLE projects ADD COLUMN git_remote TEXT;
+ALTER TABLE projects ADD COLUMN git_credentials TEXT DEFAULT '' NOT NULL;
+ALTER TABLE projects ADD COLUMN git_credentials_encryption_key_id TEXT DEFAULT '' NOT NULL;
+ALTER TABLE projects ADD COLUMN git_webhook_secret TEXT DEFAULT '' NOT NULL;
+ALTER TABLE projects ADD COLUMN git_webhook_secret_encryption_key_id TEXT DEFAULT '' NOT NULL;
+CREATE INDEX projects_git_remote_idx ON projects (git_remote) WHERE git_remote IS NOT NULL;
+
+ALTER TABLE deployments ADD COLUMN commit_hash TEXT DEFAULT '' NOT NULL;
@@ -0,0 +1,11 @@
+CREATE TABLE assets (
+	id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+	org_id UUID NOT NULL REFERENCES orgs (id) ON DELETE CASCADE,
+	path TEXT NOT NULL,
+	owner_id UUID REFERENCES users (id) ON DELETE SET NULL,
+	created_on TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+CREATE INDEX assets_org_idx ON assets (org_id);
+
+ALTER TABLE projects ADD COLUMN archive_asset_id UUID REFERENCES assets (id) ON DELETE SET NULL;
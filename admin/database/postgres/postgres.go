@@ -23,7 +23,7 @@ func init() {
 
 type driver struct{}
 
-func (d driver) Open(dsn string) (database.DB, error) {
+func (d driver) Open(dsn string, keyring []*database.EncryptionKey) (database.DB, error) {
 	db, err := otelsql.Open("pgx", dsn)
 	if err != nil {
 		return nil, err
@@ -35,11 +35,13 @@ func (d driver) Open(dsn string) (database.DB, error) {
 	}
 
 	dbx := sqlx.NewDb(db, "pgx")
-	return &connection{db: dbx}, nil
+	return &connection{db: dbx, keyring: keyring}, nil
 }
 
 type connection struct {
 	db *sqlx.DB
+	// keyring is used to encrypt secrets at rest. The first key is used for encryption. See database.ParseEncryptionKeyring.
+	keyring []*database.EncryptionKey
 }
 
 func (c *connection) Close() error {
@@ -67,7 +69,12 @@ func (c *connection) FindOrganizationsForUser(ctx context.Context, userID, after
 		UNION
 		SELECT o.* FROM orgs o JOIN projects p ON o.id = p.org_id
 		JOIN users_projects_roles upr ON p.id = upr.project_id
-		WHERE upr.user_id = $1) u 
+		WHERE upr.user_id = $1
+		UNION
+		SELECT o.* FROM orgs o JOIN projects p ON o.id = p.org_id
+		JOIN usergroups_projects_roles ugpr ON p.id = ugpr.project_id
+		JOIN usergroups_users uug ON ugpr.usergroup_id = uug.usergroup_id
+		WHERE uug.user_id = $1) u 
 		WHERE lower(u.name) > lower($2) ORDER BY lower(u.name) LIMIT $3
 	`, userID, afterName, limit)
 	if err != nil {
@@ -202,12 +209,12 @@ func (c *connection) DeleteOrganizationWhitelistedDomain(ctx context.Context, id
 }
 
 func (c *connection) FindProjects(ctx context.Context, afterName string, limit int) ([]*database.Project, error) {
-	var res []*database.Project
+	var res []*projectDTO
 	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT p.* FROM projects p WHERE lower(name) > lower($1) ORDER BY lower(p.name) LIMIT $2", afterName, limit)
 	if err != nil {
 		return nil, parseErr("projects", err)
 	}
-	return res, nil
+	return c.projectsFromDTOs(res)
 }
 
 func (c *connection) FindProjectPathsByPattern(ctx context.Context, namePattern, afterName string, limit int) ([]string, error) {
@@ -223,7 +230,7 @@ func (c *connection) FindProjectPathsByPattern(ctx context.Context, namePattern,
 }
 
 func (c *connection) FindProjectsForUser(ctx context.Context, userID string) ([]*database.Project, error) {
-	var res []*database.Project
+	var res []*projectDTO
 	err := c.getDB(ctx).SelectContext(ctx, &res, `
 		SELECT p.* FROM projects p JOIN users_projects_roles upr ON p.id = upr.project_id
 		WHERE upr.user_id = $1
@@ -235,11 +242,11 @@ func (c *connection) FindProjectsForUser(ctx context.Context, userID string) ([]
 	if err != nil {
 		return nil, parseErr("projects", err)
 	}
-	return res, nil
+	return c.projectsFromDTOs(res)
 }
 
 func (c *connection) FindProjectsForOrganization(ctx context.Context, orgID, afterProjectName string, limit int) ([]*database.Project, error) {
-	var res []*database.Project
+	var res []*projectDTO
 	err := c.getDB(ctx).SelectContext(ctx, &res, `
 		SELECT p.* FROM projects p
 		WHERE p.org_id=$1 AND lower(p.name) > lower($2)
@@ -248,11 +255,11 @@ func (c *connection) FindProjectsForOrganization(ctx context.Context, orgID, aft
 	if err != nil {
 		return nil, parseErr("projects", err)
 	}
-	return res, nil
+	return c.projectsFromDTOs(res)
 }
 
 func (c *connection) FindProjectsForOrgAndUser(ctx context.Context, orgID, userID, afterProjectName string, limit int) ([]*database.Project, error) {
-	var res []*database.Project
+	var res []*projectDTO
 	err := c.getDB(ctx).SelectContext(ctx, &res, `
 		SELECT p.* FROM projects p
 		WHERE p.org_id = $1 AND lower(p.name) > lower($2) AND (p.public = true OR p.id IN (
@@ -264,11 +271,11 @@ func (c *connection) FindProjectsForOrgAndUser(ctx context.Context, orgID, userI
 	if err != nil {
 		return nil, parseErr("projects", err)
 	}
-	return res, nil
+	return c.projectsFromDTOs(res)
 }
 
 func (c *connection) FindPublicProjectsInOrganization(ctx context.Context, orgID, afterProjectName string, limit int) ([]*database.Project, error) {
-	var res []*database.Project
+	var res []*projectDTO
 	err := c.getDB(ctx).SelectContext(ctx, &res, `
 		SELECT p.* FROM projects p 
 		WHERE p.org_id = $1 AND p.public = true AND lower(p.name) > lower($2)
@@ -277,43 +284,52 @@ func (c *connection) FindPublicProjectsInOrganization(ctx context.Context, orgID
 	if err != nil {
 		return nil, parseErr("projects", err)
 	}
-	return res, nil
+	return c.projectsFromDTOs(res)
 }
 
 func (c *connection) FindProjectsByGithubURL(ctx context.Context, githubURL string) ([]*database.Project, error) {
-	var res []*database.Project
+	var res []*projectDTO
 	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT p.* FROM projects p WHERE lower(p.github_url)=lower($1) ", githubURL)
 	if err != nil {
 		return nil, parseErr("projects", err)
 	}
-	return res, nil
+	return c.projectsFromDTOs(res)
 }
 
 func (c *connection) FindProjectsByGithubInstallationID(ctx context.Context, id int64) ([]*database.Project, error) {
-	var res []*database.Project
+	var res []*projectDTO
 	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT p.* FROM projects p WHERE p.github_installation_id=$1", id)
 	if err != nil {
 		return nil, parseErr("projects", err)
 	}
-	return res, nil
+	return c.projectsFromDTOs(res)
+}
+
+func (c *connection) FindProjectsWithGitRemote(ctx context.Context) ([]*database.Project, error) {
+	var res []*projectDTO
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT p.* FROM projects p WHERE p.git_remote IS NOT NULL")
+	if err != nil {
+		return nil, parseErr("projects", err)
+	}
+	return c.projectsFromDTOs(res)
 }
 
 func (c *connection) FindProject(ctx context.Context, id string) (*database.Project, error) {
-	res := &database.Project{}
+	res := &projectDTO{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM projects WHERE id=$1", id).StructScan(res)
 	if err != nil {
 		return nil, parseErr("project", err)
 	}
-	return res, nil
+	return c.projectFromDTO(res)
 }
 
 func (c *connection) FindProjectByName(ctx context.Context, orgName, name string) (*database.Project, error) {
-	res := &database.Project{}
+	res := &projectDTO{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT p.* FROM projects p JOIN orgs o ON p.org_id = o.id WHERE lower(p.name)=lower($1) AND lower(o.name)=lower($2)", name, orgName).StructScan(res)
 	if err != nil {
 		return nil, parseErr("project", err)
 	}
-	return res, nil
+	return c.projectFromDTO(res)
 }
 
 func (c *connection) InsertProject(ctx context.Context, opts *database.InsertProjectOptions) (*database.Project, error) {
@@ -321,16 +337,33 @@ func (c *connection) InsertProject(ctx context.Context, opts *database.InsertPro
 		return nil, err
 	}
 
-	res := &database.Project{}
-	err := c.getDB(ctx).QueryRowxContext(ctx, `
-		INSERT INTO projects (org_id, name, description, public, region, prod_olap_driver, prod_olap_dsn, prod_slots, subpath, prod_branch, prod_variables, github_url, github_installation_id, prod_ttl_seconds)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) RETURNING *`,
-		opts.OrganizationID, opts.Name, opts.Description, opts.Public, opts.Region, opts.ProdOLAPDriver, opts.ProdOLAPDSN, opts.ProdSlots, opts.Subpath, opts.ProdBranch, database.Variables(opts.ProdVariables), opts.GithubURL, opts.GithubInstallationID, opts.ProdTTLSeconds,
+	prodVariables, prodVariablesKeyID, err := c.encryptVariables(opts.ProdVariables)
+	if err != nil {
+		return nil, err
+	}
+	prodOLAPDSN, prodOLAPDSNKeyID, err := c.encrypt(opts.ProdOLAPDSN)
+	if err != nil {
+		return nil, err
+	}
+	gitCredentials, gitCredentialsKeyID, err := c.encryptGitCredentials(opts.GitCredentials)
+	if err != nil {
+		return nil, err
+	}
+	gitWebhookSecret, gitWebhookSecretKeyID, err := c.encrypt(opts.GitWebhookSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &projectDTO{}
+	err = c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO projects (org_id, name, description, public, region, prod_olap_driver, prod_olap_dsn, prod_olap_dsn_encryption_key_id, prod_slots, subpath, prod_branch, prod_variables, prod_variables_encryption_key_id, github_url, github_installation_id, prod_ttl_seconds, git_remote, git_credentials, git_credentials_encryption_key_id, git_webhook_secret, git_webhook_secret_encryption_key_id, archive_asset_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22) RETURNING *`,
+		opts.OrganizationID, opts.Name, opts.Description, opts.Public, opts.Region, opts.ProdOLAPDriver, prodOLAPDSN, prodOLAPDSNKeyID, opts.ProdSlots, opts.Subpath, opts.ProdBranch, prodVariables, prodVariablesKeyID, opts.GithubURL, opts.GithubInstallationID, opts.ProdTTLSeconds, opts.GitRemote, gitCredentials, gitCredentialsKeyID, gitWebhookSecret, gitWebhookSecretKeyID, opts.ArchiveAssetID,
 	).StructScan(res)
 	if err != nil {
 		return nil, parseErr("project", err)
 	}
-	return res, nil
+	return c.projectFromDTO(res)
 }
 
 func (c *connection) DeleteProject(ctx context.Context, id string) error {
@@ -343,27 +376,58 @@ func (c *connection) UpdateProject(ctx context.Context, id string, opts *databas
 		return nil, err
 	}
 
-	res := &database.Project{}
-	err := c.getDB(ctx).QueryRowxContext(ctx, `
-		UPDATE projects SET name=$1, description=$2, public=$3, prod_branch=$4, prod_variables=$5, github_url=$6, github_installation_id=$7, prod_deployment_id=$8, region=$9, prod_slots=$10, prod_ttl_seconds=$11, updated_on=now()
-		WHERE id=$12 RETURNING *`,
-		opts.Name, opts.Description, opts.Public, opts.ProdBranch, database.Variables(opts.ProdVariables), opts.GithubURL, opts.GithubInstallationID, opts.ProdDeploymentID, opts.Region, opts.ProdSlots, opts.ProdTTLSeconds, id,
+	prodVariables, prodVariablesKeyID, err := c.encryptVariables(opts.ProdVariables)
+	if err != nil {
+		return nil, err
+	}
+	gitCredentials, gitCredentialsKeyID, err := c.encryptGitCredentials(opts.GitCredentials)
+	if err != nil {
+		return nil, err
+	}
+	gitWebhookSecret, gitWebhookSecretKeyID, err := c.encrypt(opts.GitWebhookSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &projectDTO{}
+	err = c.getDB(ctx).QueryRowxContext(ctx, `
+		UPDATE projects SET name=$1, description=$2, public=$3, prod_branch=$4, prod_variables=$5, prod_variables_encryption_key_id=$6, github_url=$7, github_installation_id=$8, prod_deployment_id=$9, region=$10, prod_slots=$11, prod_ttl_seconds=$12,
+		git_remote=$13, git_credentials=$14, git_credentials_encryption_key_id=$15, git_webhook_secret=$16, git_webhook_secret_encryption_key_id=$17, archive_asset_id=$18, updated_on=now()
+		WHERE id=$19 RETURNING *`,
+		opts.Name, opts.Description, opts.Public, opts.ProdBranch, prodVariables, prodVariablesKeyID, opts.GithubURL, opts.GithubInstallationID, opts.ProdDeploymentID, opts.Region, opts.ProdSlots, opts.ProdTTLSeconds,
+		opts.GitRemote, gitCredentials, gitCredentialsKeyID, gitWebhookSecret, gitWebhookSecretKeyID, opts.ArchiveAssetID, id,
 	).StructScan(res)
 	if err != nil {
 		return nil, parseErr("project", err)
 	}
-	return res, nil
+	return c.projectFromDTO(res)
 }
 
 func (c *connection) UpdateProjectVariables(ctx context.Context, id string, prodVariables map[string]string) (*database.Project, error) {
-	res := &database.Project{}
-	err := c.getDB(ctx).QueryRowxContext(ctx, "UPDATE projects SET prod_variables=$1, updated_on=now() WHERE id=$2 RETURNING *",
-		prodVariables, id,
+	vars, keyID, err := c.encryptVariables(prodVariables)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &projectDTO{}
+	err = c.getDB(ctx).QueryRowxContext(ctx, "UPDATE projects SET prod_variables=$1, prod_variables_encryption_key_id=$2, updated_on=now() WHERE id=$3 RETURNING *",
+		vars, keyID, id,
 	).StructScan(res)
 	if err != nil {
 		return nil, parseErr("project", err)
 	}
-	return res, nil
+	return c.projectFromDTO(res)
+}
+
+func (c *connection) UpdateProjectPreviewSettings(ctx context.Context, id string, enabled bool, ttlSeconds int64) (*database.Project, error) {
+	res := &projectDTO{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "UPDATE projects SET preview_enabled=$1, preview_ttl_seconds=$2, updated_on=now() WHERE id=$3 RETURNING *",
+		enabled, ttlSeconds, id,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("project", err)
+	}
+	return c.projectFromDTO(res)
 }
 
 func (c *connection) CountProjectsForOrganization(ctx context.Context, orgID string) (int, error) {
@@ -375,45 +439,65 @@ func (c *connection) CountProjectsForOrganization(ctx context.Context, orgID str
 	return count, nil
 }
 
-// FindExpiredDeployments returns all the deployments which are expired as per prod ttl
+// FindExpiredDeployments returns all the deployments which are expired as per prod ttl (or the preview ttl for preview deployments).
+// Preview deployments count as used when they are accessed or updated (e.g. on a push to their branch).
 func (c *connection) FindExpiredDeployments(ctx context.Context) ([]*database.Deployment, error) {
-	var res []*database.Deployment
+	var res []*deploymentDTO
 	err := c.getDB(ctx).SelectContext(ctx, &res, `
 		SELECT d.* FROM deployments d 
 		JOIN projects p ON d.project_id = p.id
-		WHERE p.prod_ttl_seconds IS NOT NULL AND d.used_on + p.prod_ttl_seconds * interval '1 second' < now()
+		WHERE (NOT d.preview AND p.prod_ttl_seconds IS NOT NULL AND d.used_on + p.prod_ttl_seconds * interval '1 second' < now())
+		OR (d.preview AND d.preview_ttl_seconds IS NOT NULL AND GREATEST(d.used_on, d.updated_on) + d.preview_ttl_seconds * interval '1 second' < now())
 	`)
 	if err != nil {
 		return nil, parseErr("deployments", err)
 	}
-	return res, nil
+	return c.deploymentsFromDTOs(res)
 }
 
 func (c *connection) FindDeploymentsForProject(ctx context.Context, projectID string) ([]*database.Deployment, error) {
-	var res []*database.Deployment
+	var res []*deploymentDTO
 	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM deployments d WHERE d.project_id=$1", projectID)
 	if err != nil {
 		return nil, parseErr("deployments", err)
 	}
-	return res, nil
+	return c.deploymentsFromDTOs(res)
 }
 
 func (c *connection) FindDeployment(ctx context.Context, id string) (*database.Deployment, error) {
-	res := &database.Deployment{}
+	res := &deploymentDTO{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT d.* FROM deployments d WHERE d.id=$1", id).StructScan(res)
 	if err != nil {
 		return nil, parseErr("deployment", err)
 	}
-	return res, nil
+	return c.deploymentFromDTO(res)
 }
 
 func (c *connection) FindDeploymentByInstanceID(ctx context.Context, instanceID string) (*database.Deployment, error) {
-	res := &database.Deployment{}
+	res := &deploymentDTO{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM deployments d WHERE d.runtime_instance_id=$1", instanceID).StructScan(res)
 	if err != nil {
 		return nil, parseErr("deployment", err)
 	}
-	return res, nil
+	return c.deploymentFromDTO(res)
+}
+
+func (c *connection) FindPreviewDeploymentsForProject(ctx context.Context, projectID string) ([]*database.Deployment, error) {
+	var res []*deploymentDTO
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM deployments d WHERE d.project_id=$1 AND d.preview ORDER BY d.branch", projectID)
+	if err != nil {
+		return nil, parseErr("deployments", err)
+	}
+	return c.deploymentsFromDTOs(res)
+}
+
+func (c *connection) FindPreviewDeploymentForBranch(ctx context.Context, projectID, branch string) (*database.Deployment, error) {
+	res := &deploymentDTO{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM deployments d WHERE d.project_id=$1 AND d.preview AND d.branch=$2", projectID, branch).StructScan(res)
+	if err != nil {
+		return nil, parseErr("deployment", err)
+	}
+	return c.deploymentFromDTO(res)
 }
 
 func (c *connection) InsertDeployment(ctx context.Context, opts *database.InsertDeploymentOptions) (*database.Deployment, error) {
@@ -421,16 +505,21 @@ func (c *connection) InsertDeployment(ctx context.Context, opts *database.Insert
 		return nil, err
 	}
 
-	res := &database.Deployment{}
-	err := c.getDB(ctx).QueryRowxContext(ctx, `
-		INSERT INTO deployments (project_id, slots, branch, runtime_host, runtime_instance_id, runtime_audience, status, logs)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING *`,
-		opts.ProjectID, opts.Slots, opts.Branch, opts.RuntimeHost, opts.RuntimeInstanceID, opts.RuntimeAudience, opts.Status, opts.Logs,
+	previewVariables, previewVariablesKeyID, err := c.encryptVariables(opts.PreviewVariables)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &deploymentDTO{}
+	err = c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO deployments (project_id, slots, branch, runtime_host, runtime_instance_id, runtime_audience, status, logs, preview, preview_variables, preview_variables_encryption_key_id, preview_ttl_seconds)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) RETURNING *`,
+		opts.ProjectID, opts.Slots, opts.Branch, opts.RuntimeHost, opts.RuntimeInstanceID, opts.RuntimeAudience, opts.Status, opts.Logs, opts.Preview, previewVariables, previewVariablesKeyID, opts.PreviewTTLSeconds,
 	).StructScan(res)
 	if err != nil {
 		return nil, parseErr("deployment", err)
 	}
-	return res, nil
+	return c.deploymentFromDTO(res)
 }
 
 func (c *connection) DeleteDeployment(ctx context.Context, id string) error {
@@ -439,12 +528,12 @@ func (c *connection) DeleteDeployment(ctx context.Context, id string) error {
 }
 
 func (c *connection) UpdateDeploymentStatus(ctx context.Context, id string, status database.DeploymentStatus, logs string) (*database.Deployment, error) {
-	res := &database.Deployment{}
+	res := &deploymentDTO{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, "UPDATE deployments SET status=$1, logs=$2, updated_on=now() WHERE id=$3 RETURNING *", status, logs, id).StructScan(res)
 	if err != nil {
 		return nil, parseErr("deployment", err)
 	}
-	return res, nil
+	return c.deploymentFromDTO(res)
 }
 
 func (c *connection) UpdateDeploymentUsedOn(ctx context.Context, ids []string) error {
@@ -456,12 +545,21 @@ func (c *connection) UpdateDeploymentUsedOn(ctx context.Context, ids []string) e
 }
 
 func (c *connection) UpdateDeploymentBranch(ctx context.Context, id, branch string) (*database.Deployment, error) {
-	res := &database.Deployment{}
+	res := &deploymentDTO{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, "UPDATE deployments SET branch=$1, updated_on=now() WHERE id=$2 RETURNING *", branch, id).StructScan(res)
 	if err != nil {
 		return nil, parseErr("deployment", err)
 	}
-	return res, nil
+	return c.deploymentFromDTO(res)
+}
+
+func (c *connection) UpdateDeploymentCommitHash(ctx context.Context, id, commitHash string) (*database.Deployment, error) {
+	res := &deploymentDTO{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "UPDATE deployments SET commit_hash=$1, updated_on=now() WHERE id=$2 RETURNING *", commitHash, id).StructScan(res)
+	if err != nil {
+		return nil, parseErr("deployment", err)
+	}
+	return c.deploymentFromDTO(res)
 }
 
 func (c *connection) CountDeploymentsForOrganization(ctx context.Context, orgID string) (*database.DeploymentsCount, error) {
@@ -603,6 +701,31 @@ func (c *connection) CheckUserIsAnOrganizationMember(ctx context.Context, userID
 	return res, nil
 }
 
+func (c *connection) FindUsergroupsForOrganization(ctx context.Context, orgID, afterName string, limit int) ([]*database.Usergroup, error) {
+	var res []*database.Usergroup
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT ug.* FROM usergroups ug
+		WHERE ug.org_id=$1 AND lower(ug.name) > lower($2)
+		ORDER BY lower(ug.name) LIMIT $3
+	`, orgID, afterName, limit)
+	if err != nil {
+		return nil, parseErr("usergroups", err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindUsergroupByName(ctx context.Context, orgName, name string) (*database.Usergroup, error) {
+	res := &database.Usergroup{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		SELECT ug.* FROM usergroups ug JOIN orgs o ON ug.org_id = o.id
+		WHERE lower(o.name)=lower($1) AND lower(ug.name)=lower($2)
+	`, orgName, name).StructScan(res)
+	if err != nil {
+		return nil, parseErr("usergroup", err)
+	}
+	return res, nil
+}
+
 func (c *connection) InsertUsergroup(ctx context.Context, opts *database.InsertUsergroupOptions) (*database.Usergroup, error) {
 	if err := database.Validate(opts); err != nil {
 		return nil, err
@@ -610,14 +733,34 @@ func (c *connection) InsertUsergroup(ctx context.Context, opts *database.InsertU
 
 	res := &database.Usergroup{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, `
-		INSERT INTO usergroups (org_id, name) VALUES ($1, $2) RETURNING *
-	`, opts.OrgID, opts.Name).StructScan(res)
+		INSERT INTO usergroups (org_id, name, description) VALUES ($1, $2, $3) RETURNING *
+	`, opts.OrgID, opts.Name, opts.Description).StructScan(res)
+	if err != nil {
+		return nil, parseErr("usergroup", err)
+	}
+	return res, nil
+}
+
+func (c *connection) UpdateUsergroup(ctx context.Context, id string, opts *database.UpdateUsergroupOptions) (*database.Usergroup, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.Usergroup{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		UPDATE usergroups SET name=$1, description=$2, updated_on=now() WHERE id=$3 RETURNING *
+	`, opts.Name, opts.Description, id).StructScan(res)
 	if err != nil {
 		return nil, parseErr("usergroup", err)
 	}
 	return res, nil
 }
 
+func (c *connection) DeleteUsergroup(ctx context.Context, id string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM usergroups WHERE id=$1", id)
+	return checkDeleteRow("usergroup", res, err)
+}
+
 func (c *connection) FindUsergroupsForUser(ctx context.Context, userID, orgID string) ([]*database.Usergroup, error) {
 	var res []*database.Usergroup
 	err := c.getDB(ctx).SelectContext(ctx, &res, `
@@ -630,6 +773,20 @@ func (c *connection) FindUsergroupsForUser(ctx context.Context, userID, orgID st
 	return res, nil
 }
 
+func (c *connection) FindUsergroupMemberUsers(ctx context.Context, groupID, afterEmail string, limit int) ([]*database.UsergroupMemberUser, error) {
+	var res []*database.UsergroupMemberUser
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT u.id, u.email, u.display_name, u.created_on, u.updated_on FROM users u
+		JOIN usergroups_users uug ON u.id = uug.user_id
+		WHERE uug.usergroup_id=$1 AND lower(u.email) > lower($2)
+		ORDER BY lower(u.email) LIMIT $3
+	`, groupID, afterEmail, limit)
+	if err != nil {
+		return nil, parseErr("usergroup members", err)
+	}
+	return res, nil
+}
+
 func (c *connection) InsertUsergroupMember(ctx context.Context, groupID, userID string) error {
 	_, err := c.getDB(ctx).ExecContext(ctx, "INSERT INTO usergroups_users (user_id, usergroup_id) VALUES ($1, $2)", userID, groupID)
 	if err != nil {
@@ -643,6 +800,17 @@ func (c *connection) DeleteUsergroupMember(ctx context.Context, groupID, userID
 	return checkDeleteRow("usergroup member", res, err)
 }
 
+func (c *connection) DeleteUsergroupsMemberUser(ctx context.Context, orgID, userID string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, `
+		DELETE FROM usergroups_users uug
+		WHERE uug.user_id = $1 AND uug.usergroup_id IN (SELECT ug.id FROM usergroups ug WHERE ug.org_id = $2)
+	`, userID, orgID)
+	if err != nil {
+		return parseErr("usergroup member", err)
+	}
+	return nil
+}
+
 func (c *connection) FindUserAuthTokens(ctx context.Context, userID string) ([]*database.UserAuthToken, error) {
 	var res []*database.UserAuthToken
 	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT t.* FROM user_auth_tokens t WHERE t.user_id=$1", userID)
@@ -999,6 +1167,39 @@ func (c *connection) CountSingleuserOrganizationsForMemberUser(ctx context.Conte
 	return count, nil
 }
 
+func (c *connection) FindOrganizationMemberUsergroups(ctx context.Context, orgID, afterName string, limit int) ([]*database.MemberUsergroup, error) {
+	var res []*database.MemberUsergroup
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT ug.id, ug.name, ug.created_on, ug.updated_on, r.name as role_name FROM usergroups ug
+		JOIN usergroups_orgs_roles uor ON ug.id = uor.usergroup_id
+		JOIN org_roles r ON r.id = uor.org_role_id
+		WHERE uor.org_id=$1 AND lower(ug.name) > lower($2)
+		ORDER BY lower(ug.name) LIMIT $3
+	`, orgID, afterName, limit)
+	if err != nil {
+		return nil, parseErr("org usergroups", err)
+	}
+	return res, nil
+}
+
+func (c *connection) InsertOrganizationMemberUsergroup(ctx context.Context, groupID, orgID, roleID string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "INSERT INTO usergroups_orgs_roles (usergroup_id, org_id, org_role_id) VALUES ($1, $2, $3)", groupID, orgID, roleID)
+	if err != nil {
+		return parseErr("org group member", err)
+	}
+	return nil
+}
+
+func (c *connection) UpdateOrganizationMemberUsergroup(ctx context.Context, groupID, orgID, roleID string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, `UPDATE usergroups_orgs_roles SET org_role_id = $1 WHERE usergroup_id = $2 AND org_id = $3`, roleID, groupID, orgID)
+	return checkUpdateRow("org group member", res, err)
+}
+
+func (c *connection) DeleteOrganizationMemberUsergroup(ctx context.Context, groupID, orgID string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM usergroups_orgs_roles WHERE usergroup_id = $1 AND org_id = $2", groupID, orgID)
+	return checkDeleteRow("org group member", res, err)
+}
+
 func (c *connection) FindProjectMemberUsers(ctx context.Context, projectID, afterEmail string, limit int) ([]*database.Member, error) {
 	var res []*database.Member
 	err := c.getDB(ctx).SelectContext(ctx, &res, `
@@ -1051,6 +1252,31 @@ func (c *connection) InsertProjectMemberUsergroup(ctx context.Context, groupID,
 	return nil
 }
 
+func (c *connection) FindProjectMemberUsergroups(ctx context.Context, projectID, afterName string, limit int) ([]*database.MemberUsergroup, error) {
+	var res []*database.MemberUsergroup
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT ug.id, ug.name, ug.created_on, ug.updated_on, r.name as role_name FROM usergroups ug
+		JOIN usergroups_projects_roles upr ON ug.id = upr.usergroup_id
+		JOIN project_roles r ON r.id = upr.project_role_id
+		WHERE upr.project_id=$1 AND lower(ug.name) > lower($2)
+		ORDER BY lower(ug.name) LIMIT $3
+	`, projectID, afterName, limit)
+	if err != nil {
+		return nil, parseErr("project usergroups", err)
+	}
+	return res, nil
+}
+
+func (c *connection) UpdateProjectMemberUsergroup(ctx context.Context, groupID, projectID, roleID string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, `UPDATE usergroups_projects_roles SET project_role_id = $1 WHERE usergroup_id = $2 AND project_id = $3`, roleID, groupID, projectID)
+	return checkUpdateRow("project group member", res, err)
+}
+
+func (c *connection) DeleteProjectMemberUsergroup(ctx context.Context, groupID, projectID string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM usergroups_projects_roles WHERE usergroup_id = $1 AND project_id = $2", groupID, projectID)
+	return checkDeleteRow("project group member", res, err)
+}
+
 func (c *connection) DeleteProjectMemberUser(ctx context.Context, projectID, userID string) error {
 	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM users_projects_roles WHERE user_id = $1 AND project_id = $2", userID, projectID)
 	return checkDeleteRow("project member", res, err)
@@ -1235,6 +1461,90 @@ func (c *connection) DeleteBookmark(ctx context.Context, bookmarkID string) erro
 	return checkDeleteRow("bookmarks", res, err)
 }
 
+// FindAsset returns an asset by ID.
+func (c *connection) FindAsset(ctx context.Context, id string) (*database.Asset, error) {
+	res := &database.Asset{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM assets WHERE id = $1", id).StructScan(res)
+	if err != nil {
+		return nil, parseErr("asset", err)
+	}
+	return res, nil
+}
+
+// InsertAsset inserts an asset.
+func (c *connection) InsertAsset(ctx context.Context, opts *database.InsertAssetOptions) (*database.Asset, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.Asset{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "INSERT INTO assets (org_id, path, owner_id) VALUES ($1, $2, $3) RETURNING *",
+		opts.OrganizationID, opts.Path, opts.OwnerID,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("asset", err)
+	}
+	return res, nil
+}
+
+// FindAuditEvents returns the audit events of an org, newest first.
+func (c *connection) FindAuditEvents(ctx context.Context, opts *database.FindAuditEventsOptions) ([]*database.AuditEvent, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	qry := "SELECT * FROM audit_events WHERE org_id = $1"
+	args := []any{opts.OrgID}
+	if opts.ProjectName != "" {
+		args = append(args, opts.ProjectName)
+		qry += fmt.Sprintf(" AND lower(project_name) = lower($%d)", len(args))
+	}
+	if opts.Action != "" {
+		args = append(args, opts.Action)
+		qry += fmt.Sprintf(" AND lower(action) = lower($%d)", len(args))
+	}
+	if opts.ActorEmail != "" {
+		args = append(args, opts.ActorEmail)
+		qry += fmt.Sprintf(" AND lower(actor_email) = lower($%d)", len(args))
+	}
+	if opts.AfterCreatedOn != nil {
+		args = append(args, *opts.AfterCreatedOn, opts.AfterID)
+		qry += fmt.Sprintf(" AND (created_on, id) < ($%d, $%d)", len(args)-1, len(args))
+	}
+	args = append(args, opts.Limit)
+	qry += fmt.Sprintf(" ORDER BY created_on DESC, id DESC LIMIT $%d", len(args))
+
+	var res []*database.AuditEvent
+	err := c.getDB(ctx).SelectContext(ctx, &res, qry, args...)
+	if err != nil {
+		return nil, parseErr("audit events", err)
+	}
+	return res, nil
+}
+
+// InsertAuditEvent appends an event to the audit log.
+func (c *connection) InsertAuditEvent(ctx context.Context, opts *database.InsertAuditEventOptions) (*database.AuditEvent, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	diff := opts.Diff
+	if len(diff) == 0 {
+		diff = []byte("{}")
+	}
+
+	res := &database.AuditEvent{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO audit_events (org_id, org_name, project_id, project_name, actor_type, actor_id, actor_email, action, target, diff)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING *`,
+		opts.OrgID, opts.OrgName, opts.ProjectID, opts.ProjectName, opts.ActorType, opts.ActorID, opts.ActorEmail, opts.Action, opts.Target, diff,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("audit event", err)
+	}
+	return res, nil
+}
+
 func checkUpdateRow(target string, res sql.Result, err error) error {
 	if err != nil {
 		return parseErr(target, err)
@@ -1317,6 +1627,8 @@ func parseErr(target string, err error) error {
 			return newAlreadyExistsErr("domain has already been added for the org")
 		case "service_name_idx":
 			return newAlreadyExistsErr("a service with that name already exists in the org")
+		case "deployments_preview_branch_idx":
+			return newAlreadyExistsErr("a preview deployment already exists for the branch")
 		default:
 			if target == "" {
 				return database.ErrNotUnique
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -18,7 +19,7 @@ func TestPostgres(t *testing.T) {
 	pg := pgtestcontainer.New(t)
 	defer pg.Terminate(t)
 
-	db, err := database.Open("postgres", pg.DatabaseURL)
+	db, err := database.Open("postgres", pg.DatabaseURL, nil)
 	require.NoError(t, err)
 	require.NotNil(t, db)
 
@@ -28,6 +29,10 @@ func TestPostgres(t *testing.T) {
 	t.Run("TestProjects", func(t *testing.T) { testProjects(t, db) })
 	// Add new tests here
 	t.Run("TestProjectsWithVariables", func(t *testing.T) { testProjectsWithVariables(t, db) })
+	t.Run("TestPreviewDeployments", func(t *testing.T) { testPreviewDeployments(t, db) })
+	t.Run("TestUsergroups", func(t *testing.T) { testUsergroups(t, db) })
+	t.Run("TestAuditEvents", func(t *testing.T) { testAuditEvents(t, db) })
+	t.Run("TestAssets", func(t *testing.T) { testAssets(t, db) })
 
 	t.Run("TestOrgsWithPagination", func(t *testing.T) { testOrgsWithPagination(t, db) })
 	t.Run("TestProjectsWithPagination", func(t *testing.T) { testProjectsWithPagination(t, db) })
@@ -166,6 +171,212 @@ func testProjectsWithVariables(t *testing.T, db database.DB) {
 	require.Equal(t, database.Variables(opts.ProdVariables), proj.ProdVariables)
 }
 
+func testPreviewDeployments(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "preview-org"})
+	require.NoError(t, err)
+
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{
+		OrganizationID: org.ID,
+		Name:           "bar",
+		ProdBranch:     "main",
+	})
+	require.NoError(t, err)
+	require.False(t, proj.PreviewEnabled)
+	require.Equal(t, int64(259200), proj.PreviewTTLSeconds)
+
+	proj, err = db.UpdateProjectPreviewSettings(ctx, proj.ID, true, 3600)
+	require.NoError(t, err)
+	require.True(t, proj.PreviewEnabled)
+	require.Equal(t, int64(3600), proj.PreviewTTLSeconds)
+
+	_, err = db.InsertDeployment(ctx, &database.InsertDeploymentOptions{
+		ProjectID:         proj.ID,
+		Branch:            "main",
+		RuntimeHost:       "localhost",
+		RuntimeInstanceID: "prod",
+	})
+	require.NoError(t, err)
+
+	depl, err := db.InsertDeployment(ctx, &database.InsertDeploymentOptions{
+		ProjectID:         proj.ID,
+		Branch:            "feature",
+		RuntimeHost:       "localhost",
+		RuntimeInstanceID: "preview",
+		Preview:           true,
+		PreviewVariables:  map[string]string{"foo": "bar"},
+		PreviewTTLSeconds: &proj.PreviewTTLSeconds,
+	})
+	require.NoError(t, err)
+	require.True(t, depl.Preview)
+	require.Equal(t, database.Variables{"foo": "bar"}, depl.PreviewVariables)
+
+	// Only one preview deployment per branch
+	_, err = db.InsertDeployment(ctx, &database.InsertDeploymentOptions{
+		ProjectID:         proj.ID,
+		Branch:            "feature",
+		RuntimeHost:       "localhost",
+		RuntimeInstanceID: "preview2",
+		Preview:           true,
+	})
+	require.ErrorIs(t, err, database.ErrNotUnique)
+
+	depls, err := db.FindPreviewDeploymentsForProject(ctx, proj.ID)
+	require.NoError(t, err)
+	require.Len(t, depls, 1)
+	require.Equal(t, depl.ID, depls[0].ID)
+
+	found, err := db.FindPreviewDeploymentForBranch(ctx, proj.ID, "feature")
+	require.NoError(t, err)
+	require.Equal(t, depl.ID, found.ID)
+
+	_, err = db.FindPreviewDeploymentForBranch(ctx, proj.ID, "main")
+	require.ErrorIs(t, err, database.ErrNotFound)
+}
+
+func testUsergroups(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "usergroups-org"})
+	require.NoError(t, err)
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "bar"})
+	require.NoError(t, err)
+	user, err := db.InsertUser(ctx, &database.InsertUserOptions{Email: "usergroups@example.com", QuotaSingleuserOrgs: 1})
+	require.NoError(t, err)
+
+	group, err := db.InsertUsergroup(ctx, &database.InsertUsergroupOptions{OrgID: org.ID, Name: "analysts", Description: "hello"})
+	require.NoError(t, err)
+	require.Equal(t, "hello", group.Description)
+
+	_, err = db.InsertUsergroup(ctx, &database.InsertUsergroupOptions{OrgID: org.ID, Name: "Analysts"})
+	require.ErrorIs(t, err, database.ErrNotUnique)
+
+	group, err = db.UpdateUsergroup(ctx, group.ID, &database.UpdateUsergroupOptions{Name: "engineers"})
+	require.NoError(t, err)
+	require.Equal(t, "engineers", group.Name)
+
+	found, err := db.FindUsergroupByName(ctx, org.Name, "Engineers")
+	require.NoError(t, err)
+	require.Equal(t, group.ID, found.ID)
+
+	groups, err := db.FindUsergroupsForOrganization(ctx, org.ID, "", 10)
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+
+	require.NoError(t, db.InsertUsergroupMember(ctx, group.ID, user.ID))
+	members, err := db.FindUsergroupMemberUsers(ctx, group.ID, "", 10)
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	require.Equal(t, user.Email, members[0].Email)
+
+	// Project roles granted to the group apply to its members
+	viewer, err := db.FindProjectRole(ctx, database.ProjectRoleNameViewer)
+	require.NoError(t, err)
+	admin, err := db.FindProjectRole(ctx, database.ProjectRoleNameAdmin)
+	require.NoError(t, err)
+	require.NoError(t, db.InsertProjectMemberUsergroup(ctx, group.ID, proj.ID, viewer.ID))
+	roles, err := db.ResolveProjectRolesForUser(ctx, user.ID, proj.ID)
+	require.NoError(t, err)
+	require.Len(t, roles, 1)
+	require.Equal(t, viewer.ID, roles[0].ID)
+
+	require.NoError(t, db.UpdateProjectMemberUsergroup(ctx, group.ID, proj.ID, admin.ID))
+	projMembers, err := db.FindProjectMemberUsergroups(ctx, proj.ID, "", 10)
+	require.NoError(t, err)
+	require.Len(t, projMembers, 1)
+	require.Equal(t, database.ProjectRoleNameAdmin, projMembers[0].RoleName)
+
+	// Removing the user from the org's groups revokes the roles
+	require.NoError(t, db.DeleteUsergroupsMemberUser(ctx, org.ID, user.ID))
+	roles, err = db.ResolveProjectRolesForUser(ctx, user.ID, proj.ID)
+	require.NoError(t, err)
+	require.Len(t, roles, 0)
+
+	require.NoError(t, db.DeleteUsergroup(ctx, group.ID))
+	_, err = db.FindUsergroupByName(ctx, org.Name, "engineers")
+	require.ErrorIs(t, err, database.ErrNotFound)
+	projMembers, err = db.FindProjectMemberUsergroups(ctx, proj.ID, "", 10)
+	require.NoError(t, err)
+	require.Len(t, projMembers, 0)
+}
+
+func testAuditEvents(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "audit-org"})
+	require.NoError(t, err)
+
+	for i, action := range []string{"CreateProject", "UpdateProjectVariables", "UpdateProjectVariables"} {
+		e, err := db.InsertAuditEvent(ctx, &database.InsertAuditEventOptions{
+			OrgID:       &org.ID,
+			OrgName:     org.Name,
+			ProjectName: "proj",
+			ActorType:   "user",
+			ActorEmail:  "audit@example.com",
+			Action:      action,
+			Target:      fmt.Sprintf("target-%d", i),
+			Diff:        []byte(`{"after":{"variables":{"foo":"[redacted]"}}}`),
+		})
+		require.NoError(t, err)
+		require.Equal(t, action, e.Action)
+	}
+
+	// Filter and paginate newest first
+	events, err := db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: org.ID, Action: "updateprojectvariables", Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, "target-2", events[0].Target)
+	require.JSONEq(t, `{"after":{"variables":{"foo":"[redacted]"}}}`, string(events[0].Diff))
+
+	events, err = db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{
+		OrgID:          org.ID,
+		Action:         "UpdateProjectVariables",
+		AfterCreatedOn: &events[0].CreatedOn,
+		AfterID:        events[0].ID,
+		Limit:          10,
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, "target-1", events[0].Target)
+
+	events, err = db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: org.ID, ActorEmail: "other@example.com", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 0)
+
+	// Events are append-only and outlive the org
+	_, err = db.(*connection).db.ExecContext(ctx, "UPDATE audit_events SET target = 'x' WHERE org_id = $1", org.ID)
+	require.Error(t, err)
+	require.NoError(t, db.DeleteOrganization(ctx, org.Name))
+	events, err = db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: org.ID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+}
+
+func testAssets(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "assets-org"})
+	require.NoError(t, err)
+
+	asset, err := db.InsertAsset(ctx, &database.InsertAssetOptions{OrganizationID: org.ID, Path: "archives/foo.tar.gz"})
+	require.NoError(t, err)
+	require.Equal(t, org.ID, asset.OrganizationID)
+	require.Nil(t, asset.OwnerID)
+
+	found, err := db.FindAsset(ctx, asset.ID)
+	require.NoError(t, err)
+	require.Equal(t, "archives/foo.tar.gz", found.Path)
+
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{
+		OrganizationID: org.ID,
+		Name:           "archived",
+		ArchiveAssetID: &asset.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, asset.ID, *proj.ArchiveAssetID)
+}
+
 func testOrgsWithPagination(t *testing.T, db database.DB) {
 	ctx := context.Background()
 
@@ -14,6 +14,7 @@ import (
 	"github.com/rilldata/rill/admin/provisioner"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/client"
+	"github.com/rilldata/rill/runtime/drivers/git"
 	"github.com/rilldata/rill/runtime/drivers/github"
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	"github.com/rilldata/rill/runtime/server/auth"
@@ -26,6 +27,9 @@ type createDeploymentOptions struct {
 	Region               string
 	GithubURL            *string
 	GithubInstallationID *int64
+	GitRemote            *string
+	GitCredentials       *database.GitCredentials
+	ArchiveAssetID       *string
 	Subpath              string
 	ProdBranch           string
 	ProdVariables        database.Variables
@@ -33,14 +37,15 @@ type createDeploymentOptions struct {
 	ProdOLAPDSN          string
 	ProdSlots            int
 	Annotations          deploymentAnnotations
+	// Preview deploys ProdBranch as a preview deployment. PreviewVariables are the overrides applied on top of ProdVariables.
+	Preview           bool
+	PreviewVariables  map[string]string
+	PreviewTTLSeconds *int64
 }
 
 func (s *Service) createDeployment(ctx context.Context, opts *createDeploymentOptions) (*database.Deployment, error) {
-	// We require Github info on project to create a deployment
-	if opts.GithubURL == nil || opts.GithubInstallationID == nil || opts.ProdBranch == "" {
-		return nil, fmt.Errorf("cannot create project without github info")
-	}
-	repoDriver, repoDSN, err := githubRepoInfoForRuntime(*opts.GithubURL, *opts.GithubInstallationID, opts.Subpath, opts.ProdBranch)
+	// We require Github info, a Git remote or an archive on project to create a deployment
+	repoDriver, repoDSN, err := s.repoInfoForRuntime(ctx, opts.GithubURL, opts.GithubInstallationID, opts.GitRemote, opts.GitCredentials, opts.ArchiveAssetID, opts.Subpath, opts.ProdBranch)
 	if err != nil {
 		return nil, err
 	}
@@ -70,8 +75,8 @@ func (s *Service) createDeployment(ctx context.Context, opts *createDeploymentOp
 			return nil, fmt.Errorf("slot count can't be 0 for driver 'duckdb'")
 		}
 
-		olapConfig["dsn"] = fmt.Sprintf("%s.db?max_memory=%dGB", path.Join(alloc.DataDir, instanceID), alloc.MemoryGB)
-		olapConfig["pool_size"] = strconv.Itoa(alloc.CPU)
+		olapConfig["dsn"] = fmt.Sprintf("%s.db", path.Join(alloc.DataDir, instanceID))
+		duckDBResourceLimits(olapConfig, alloc)
 		embedCatalog = true
 		ingestionLimit = alloc.StorageBytes
 	case "duckdb-ext-storage": // duckdb driver having capability to store table as view
@@ -83,8 +88,8 @@ func (s *Service) createDeployment(ctx context.Context, opts *createDeploymentOp
 		}
 
 		olapDriver = "duckdb"
-		olapConfig["dsn"] = fmt.Sprintf("%s.db?max_memory=%dGB", path.Join(alloc.DataDir, instanceID, "main"), alloc.MemoryGB)
-		olapConfig["pool_size"] = strconv.Itoa(alloc.CPU)
+		olapConfig["dsn"] = fmt.Sprintf("%s.db", path.Join(alloc.DataDir, instanceID, "main"))
+		duckDBResourceLimits(olapConfig, alloc)
 		olapConfig["external_table_storage"] = strconv.FormatBool(true)
 		embedCatalog = true
 		ingestionLimit = alloc.StorageBytes
@@ -151,6 +156,9 @@ func (s *Service) createDeployment(ctx context.Context, opts *createDeploymentOp
 		RuntimeAudience:   alloc.Audience,
 		Status:            database.DeploymentStatusPending,
 		Logs:              "",
+		Preview:           opts.Preview,
+		PreviewVariables:  opts.PreviewVariables,
+		PreviewTTLSeconds: opts.PreviewTTLSeconds,
 	})
 	if err != nil {
 		_, err2 := rt.DeleteInstance(ctx, &runtimev1.DeleteInstanceRequest{
@@ -163,9 +171,21 @@ func (s *Service) createDeployment(ctx context.Context, opts *createDeploymentOp
 	return depl, nil
 }
 
+// duckDBResourceLimits sets the DuckDB connector's memory, threads and temp directory limits based on the deployment's slot allocation.
+func duckDBResourceLimits(olapConfig map[string]string, alloc *provisioner.Allocation) {
+	olapConfig["pool_size"] = strconv.Itoa(alloc.CPU)
+	olapConfig["memory_limit_gb"] = strconv.Itoa(alloc.MemoryGB)
+	olapConfig["cpu"] = strconv.Itoa(alloc.CPU)
+	// Spilling to disk counts against the storage allocation, so we allow it to use up to a quarter of it
+	olapConfig["max_temp_directory_size_bytes"] = strconv.FormatInt(alloc.StorageBytes/4, 10)
+}
+
 type updateDeploymentOptions struct {
 	GithubURL            *string
 	GithubInstallationID *int64
+	GitRemote            *string
+	GitCredentials       *database.GitCredentials
+	ArchiveAssetID       *string
 	Subpath              string
 	Branch               string
 	Variables            map[string]string
@@ -173,11 +193,7 @@ type updateDeploymentOptions struct {
 }
 
 func (s *Service) updateDeployment(ctx context.Context, depl *database.Deployment, opts *updateDeploymentOptions) error {
-	if opts.GithubURL == nil || opts.GithubInstallationID == nil || opts.Branch == "" {
-		return fmt.Errorf("cannot update deployment without github info")
-	}
-
-	repoDriver, repoDSN, err := githubRepoInfoForRuntime(*opts.GithubURL, *opts.GithubInstallationID, opts.Subpath, opts.Branch)
+	repoDriver, repoDSN, err := s.repoInfoForRuntime(ctx, opts.GithubURL, opts.GithubInstallationID, opts.GitRemote, opts.GitCredentials, opts.ArchiveAssetID, opts.Subpath, opts.Branch)
 	if err != nil {
 		return err
 	}
@@ -255,11 +271,11 @@ func (s *Service) HibernateDeployments(ctx context.Context) error {
 
 		proj, err := s.DB.FindProject(ctx, depl.ProjectID)
 		if err != nil {
-			s.Logger.Error("hibernate: find project error", zap.String("project_id", proj.ID), zap.String("deployment_id", depl.ID), zap.Error(err), observability.ZapCtx(ctx))
+			s.Logger.Error("hibernate: find project error", zap.String("project_id", depl.ProjectID), zap.String("deployment_id", depl.ID), zap.Error(err), observability.ZapCtx(ctx))
 			continue
 		}
 
-		s.Logger.Info("hibernate: deleting deployment", zap.String("project_id", proj.ID), zap.String("deployment_id", depl.ID))
+		s.Logger.Info("hibernate: deleting deployment", zap.String("project_id", proj.ID), zap.String("deployment_id", depl.ID), zap.Bool("preview", depl.Preview))
 
 		err = s.teardownDeployment(ctx, proj, depl)
 		if err != nil {
@@ -267,6 +283,11 @@ func (s *Service) HibernateDeployments(ctx context.Context) error {
 			continue
 		}
 
+		// Expired preview deployments are not tracked on the project
+		if depl.Preview {
+			continue
+		}
+
 		// Update prod deployment on project
 		_, err = s.DB.UpdateProject(ctx, proj.ID, &database.UpdateProjectOptions{
 			Name:                 proj.Name,
@@ -274,6 +295,10 @@ func (s *Service) HibernateDeployments(ctx context.Context) error {
 			Public:               proj.Public,
 			GithubURL:            proj.GithubURL,
 			GithubInstallationID: proj.GithubInstallationID,
+			GitRemote:            proj.GitRemote,
+			GitCredentials:       proj.GitCredentials,
+			GitWebhookSecret:     proj.GitWebhookSecret,
+			ArchiveAssetID:       proj.ArchiveAssetID,
 			ProdBranch:           proj.ProdBranch,
 			ProdVariables:        proj.ProdVariables,
 			ProdSlots:            proj.ProdSlots,
@@ -367,6 +392,51 @@ func (s *Service) openRuntimeClient(host, audience string) (*client.Client, erro
 	return rt, nil
 }
 
+// repoInfoForRuntime returns the repo driver and DSN that a runtime should use to deploy branch.
+// Projects deployed from an uploaded archive are served by the "archive" driver (and have no branch).
+// Projects with a GitRemote are cloned with the generic "git" driver. Other projects are cloned from Github.
+func (s *Service) repoInfoForRuntime(ctx context.Context, githubURL *string, installationID *int64, gitRemote *string, gitCredentials *database.GitCredentials, archiveAssetID *string, subPath, branch string) (string, string, error) {
+	if archiveAssetID != nil {
+		return s.archiveRepoInfoForRuntime(ctx, *archiveAssetID)
+	}
+	if branch == "" {
+		return "", "", fmt.Errorf("cannot deploy project without a branch")
+	}
+	if gitRemote != nil {
+		return gitRepoInfoForRuntime(*gitRemote, gitCredentials, subPath, branch)
+	}
+	if githubURL == nil || installationID == nil {
+		return "", "", fmt.Errorf("cannot deploy project without github info or a git remote")
+	}
+	return githubRepoInfoForRuntime(*githubURL, *installationID, subPath, branch)
+}
+
+func gitRepoInfoForRuntime(remote string, creds *database.GitCredentials, subPath, branch string) (string, string, error) {
+	dsn, err := json.Marshal(gitDSN(remote, creds, subPath, branch))
+	if err != nil {
+		return "", "", err
+	}
+
+	return "git", string(dsn), nil
+}
+
+// gitDSN builds the config for the "git" repo driver.
+func gitDSN(remote string, creds *database.GitCredentials, subPath, branch string) *git.DSN {
+	dsn := &git.DSN{
+		RemoteURL: remote,
+		Subpath:   subPath,
+		Branch:    branch,
+	}
+	if creds != nil {
+		dsn.Username = creds.Username
+		dsn.Password = creds.Password
+		dsn.PrivateKey = creds.PrivateKey
+		dsn.PrivateKeyPassword = creds.PrivateKeyPassword
+		dsn.KnownHosts = creds.KnownHosts
+	}
+	return dsn
+}
+
 func githubRepoInfoForRuntime(githubURL string, installationID int64, subPath, branch string) (string, string, error) {
 	dsn, err := json.Marshal(github.DSN{
 		GithubURL:      githubURL,
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"html/template"
 	"net/url"
+	"strings"
+	"time"
 )
 
 //go:embed templates/gen/*
@@ -160,6 +162,77 @@ func (c *Client) SendProjectAddition(opts *ProjectAddition) error {
 	})
 }
 
+type ScheduledReport struct {
+	ToEmail    string
+	ToName     string
+	Title      string
+	ReportTime time.Time
+	OpenLink   string
+	Attachment *Attachment
+}
+
+func (c *Client) SendScheduledReport(opts *ScheduledReport) error {
+	openLink := opts.OpenLink
+	if openLink == "" {
+		openLink = c.frontendURL
+	}
+
+	subject := fmt.Sprintf("%s (%s)", opts.Title, opts.ReportTime.UTC().Format("2006-01-02 15:04 MST"))
+
+	buf := new(bytes.Buffer)
+	err := c.templates.Lookup("call_to_action.html").Execute(buf, &CallToAction{
+		Title:      subject,
+		Body:       template.HTML(fmt.Sprintf("The scheduled report <b>%s</b> ran at %s. The exported data is attached as <b>%s</b>.", template.HTMLEscapeString(opts.Title), opts.ReportTime.UTC().Format(time.RFC1123), template.HTMLEscapeString(opts.Attachment.Filename))),
+		ButtonText: "Open in Rill",
+		ButtonLink: openLink,
+	})
+	if err != nil {
+		return fmt.Errorf("email template error: %w", err)
+	}
+	return c.sender.Send(opts.ToEmail, opts.ToName, subject, buf.String(), opts.Attachment)
+}
+
+type Alert struct {
+	ToEmail  string
+	ToName   string
+	Title    string
+	Firing   bool
+	Details  []string
+	OpenLink string
+}
+
+func (c *Client) SendAlert(opts *Alert) error {
+	openLink := opts.OpenLink
+	if openLink == "" {
+		openLink = c.frontendURL
+	}
+
+	status := "resolved"
+	if opts.Firing {
+		status = "firing"
+	}
+	subject := fmt.Sprintf("[%s] %s", strings.ToUpper(status), opts.Title)
+
+	body := fmt.Sprintf("The alert <b>%s</b> is %s.", template.HTMLEscapeString(opts.Title), status)
+	if len(opts.Details) > 0 {
+		body += "<ul>"
+		for _, d := range opts.Details {
+			body += "<li>" + template.HTMLEscapeString(d) + "</li>"
+		}
+		body += "</ul>"
+	}
+
+	return c.SendCallToAction(&CallToAction{
+		ToEmail:    opts.ToEmail,
+		ToName:     opts.ToName,
+		Subject:    subject,
+		Title:      subject,
+		Body:       template.HTML(body),
+		ButtonText: "Open in Rill",
+		ButtonLink: openLink,
+	})
+}
+
 func mustJoinURLPath(base string, elem ...string) string {
 	res, err := url.JoinPath(base, elem...)
 	if err != nil {
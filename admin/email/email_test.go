@@ -1,26 +1,36 @@
 package email
 
 import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 )
 
 type mockSender struct {
-	fromEmail string
-	fromName  string
-	toEmail   string
-	toName    string
-	subject   string
-	body      string
+	fromEmail   string
+	fromName    string
+	toEmail     string
+	toName      string
+	subject     string
+	body        string
+	attachments []*Attachment
 }
 
-func (m *mockSender) Send(toEmail, toName, subject, body string) error {
+func (m *mockSender) Send(toEmail, toName, subject, body string, attachments ...*Attachment) error {
 	m.toEmail = toEmail
 	m.toName = toName
 	m.subject = subject
 	m.body = body
+	m.attachments = attachments
 	return nil
 }
 
@@ -45,3 +55,80 @@ func TestOrganizationInvite(t *testing.T) {
 	require.Contains(t, mock.body, opts.RoleName)
 	require.Contains(t, mock.body, opts.InvitedByName)
 }
+
+func TestScheduledReport(t *testing.T) {
+	mock := &mockSender{}
+	client := New(mock, "https://example.com", "")
+
+	opts := &ScheduledReport{
+		ToEmail:    uuid.New().String(),
+		Title:      uuid.New().String(),
+		ReportTime: time.Date(2023, 10, 2, 9, 0, 0, 0, time.UTC),
+		Attachment: &Attachment{Filename: "report.csv", ContentType: "text/csv", Data: []byte("a,b\n1,2\n")},
+	}
+	err := client.SendScheduledReport(opts)
+	require.NoError(t, err)
+
+	require.Equal(t, opts.ToEmail, mock.toEmail)
+	require.Contains(t, mock.subject, opts.Title)
+	require.Contains(t, mock.body, opts.Title)
+	require.Contains(t, mock.body, "https://example.com")
+	require.Equal(t, []*Attachment{opts.Attachment}, mock.attachments)
+}
+
+func TestComposeMessageWithAttachments(t *testing.T) {
+	from := mail.Address{Name: "Rill", Address: "noreply@example.com"}
+	to := mail.Address{Address: "jane@example.com"}
+	data := bytes.Repeat([]byte("a,b\n1,2\n"), 20)
+
+	msg, err := composeMessage(from, to, "Report", "<b>Hello</b>", []*Attachment{{Filename: "report.csv", ContentType: "text/csv", Data: data}})
+	require.NoError(t, err)
+
+	m, err := mail.ReadMessage(bytes.NewReader(msg))
+	require.NoError(t, err)
+	mediaType, params, err := mime.ParseMediaType(m.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, "multipart/mixed", mediaType)
+
+	r := multipart.NewReader(m.Body, params["boundary"])
+	part, err := r.NextPart()
+	require.NoError(t, err)
+	body, err := io.ReadAll(part)
+	require.NoError(t, err)
+	require.Equal(t, "<b>Hello</b>", string(body))
+
+	part, err = r.NextPart()
+	require.NoError(t, err)
+	require.Equal(t, "report.csv", part.FileName())
+	encoded, err := io.ReadAll(part)
+	require.NoError(t, err)
+	decoded, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(string(encoded), "\r\n", ""))
+	require.NoError(t, err)
+	require.Equal(t, data, decoded)
+
+	_, err = r.NextPart()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestAlert(t *testing.T) {
+	mock := &mockSender{}
+	client := New(mock, "https://example.com", "")
+
+	opts := &Alert{
+		ToEmail: uuid.New().String(),
+		Title:   uuid.New().String(),
+		Firing:  true,
+		Details: []string{"region=<eu>: error_rate=0.05"},
+	}
+	err := client.SendAlert(opts)
+	require.NoError(t, err)
+
+	require.Equal(t, opts.ToEmail, mock.toEmail)
+	require.Equal(t, "[FIRING] "+opts.Title, mock.subject)
+	require.Contains(t, mock.body, "region=&lt;eu&gt;: error_rate=0.05")
+
+	opts.Firing = false
+	err = client.SendAlert(opts)
+	require.NoError(t, err)
+	require.Equal(t, "[RESOLVED] "+opts.Title, mock.subject)
+}
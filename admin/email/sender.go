@@ -1,16 +1,28 @@
 package email
 
 import (
+	"bytes"
+	"encoding/base64"
 	"fmt"
+	"mime"
+	"mime/multipart"
 	"net/mail"
 	"net/smtp"
+	"net/textproto"
 	"strconv"
 
 	"go.uber.org/zap"
 )
 
 type Sender interface {
-	Send(toEmail, toName, subject, body string) error
+	Send(toEmail, toName, subject, body string, attachments ...*Attachment) error
+}
+
+// Attachment is a file attached to an email
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
 }
 
 type SMTPOptions struct {
@@ -47,17 +59,14 @@ func NewSMTPSender(opts *SMTPOptions) (Sender, error) {
 	return &smtpSender{opts: opts}, nil
 }
 
-func (s *smtpSender) Send(toEmail, toName, subject, body string) error {
+func (s *smtpSender) Send(toEmail, toName, subject, body string, attachments ...*Attachment) error {
 	// Compose the email message
 	from := mail.Address{Name: s.opts.FromName, Address: s.opts.FromEmail}
 	to := mail.Address{Name: toName, Address: toEmail}
-	message := []byte("From: " + from.String() + "\r\n" +
-		"To: " + to.String() + "\r\n" +
-		"Subject: " + subject + "\r\n" +
-		"Content-Type: text/html; charset=utf-8\r\n" +
-		"\r\n" +
-		body + "\r\n",
-	)
+	message, err := composeMessage(from, to, subject, body, attachments)
+	if err != nil {
+		return err
+	}
 
 	// Build recipients list
 	recipients := []string{toEmail}
@@ -67,7 +76,7 @@ func (s *smtpSender) Send(toEmail, toName, subject, body string) error {
 
 	// Connect to the SMTP server
 	auth := smtp.PlainAuth("", s.opts.SMTPUsername, s.opts.SMTPPassword, s.opts.SMTPHost)
-	err := smtp.SendMail(s.opts.SMTPHost+":"+strconv.Itoa(s.opts.SMTPPort), auth, from.Address, recipients, message)
+	err = smtp.SendMail(s.opts.SMTPHost+":"+strconv.Itoa(s.opts.SMTPPort), auth, from.Address, recipients, message)
 	if err != nil {
 		return err
 	}
@@ -90,7 +99,12 @@ func NewConsoleSender(logger *zap.Logger, fromEmail, fromName string) (Sender, e
 	return &consoleSender{logger: logger, fromEmail: fromEmail, fromName: fromName}, nil
 }
 
-func (s *consoleSender) Send(toEmail, toName, subject, body string) error {
+func (s *consoleSender) Send(toEmail, toName, subject, body string, attachments ...*Attachment) error {
+	filenames := make([]string, len(attachments))
+	for i, a := range attachments {
+		filenames[i] = a.Filename
+	}
+
 	s.logger.Info("email sent",
 		zap.String("from_email", s.fromEmail),
 		zap.String("from_name", s.fromName),
@@ -98,6 +112,73 @@ func (s *consoleSender) Send(toEmail, toName, subject, body string) error {
 		zap.String("to_name", toName),
 		zap.String("subject", subject),
 		zap.String("body", body),
+		zap.Strings("attachments", filenames),
 	)
 	return nil
 }
+
+// composeMessage builds an HTML email message. If there are attachments, it's encoded as a multipart/mixed message.
+func composeMessage(from, to mail.Address, subject, body string, attachments []*Attachment) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	buf.WriteString("From: " + from.String() + "\r\n")
+	buf.WriteString("To: " + to.String() + "\r\n")
+	buf.WriteString("Subject: " + subject + "\r\n")
+
+	if len(attachments) == 0 {
+		buf.WriteString("Content-Type: text/html; charset=utf-8\r\n")
+		buf.WriteString("\r\n")
+		buf.WriteString(body + "\r\n")
+		return buf.Bytes(), nil
+	}
+
+	w := multipart.NewWriter(buf)
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: multipart/mixed; boundary=" + w.Boundary() + "\r\n")
+	buf.WriteString("\r\n")
+
+	part, err := w.CreatePart(textproto.MIMEHeader{"Content-Type": {"text/html; charset=utf-8"}})
+	if err != nil {
+		return nil, err
+	}
+	_, err = part.Write([]byte(body))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, a := range attachments {
+		contentType := a.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+
+		part, err := w.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {contentType},
+			"Content-Transfer-Encoding": {"base64"},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": a.Filename})},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// RFC 2045 limits base64 lines to 76 characters
+		encoded := base64.StdEncoding.EncodeToString(a.Data)
+		for len(encoded) > 76 {
+			_, err = part.Write([]byte(encoded[:76] + "\r\n"))
+			if err != nil {
+				return nil, err
+			}
+			encoded = encoded[76:]
+		}
+		_, err = part.Write([]byte(encoded + "\r\n"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = w.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,123 @@
+package admin
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/runtime/drivers/git"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+)
+
+// SyncGitRemotes checks the remotes of all projects deployed from a generic Git remote for new commits.
+// It triggers a reconcile of the deployments whose branch has changed. It's used to poll remotes that don't call the webhook.
+func (s *Service) SyncGitRemotes(ctx context.Context) error {
+	projs, err := s.DB.FindProjectsWithGitRemote(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, proj := range projs {
+		err := s.SyncGitRemote(ctx, proj)
+		if err != nil {
+			// Don't let one unreachable remote block the other projects
+			s.Logger.Warn("sync git remote: failed", zap.String("project_id", proj.ID), zap.Error(err), observability.ZapCtx(ctx))
+		}
+	}
+
+	return nil
+}
+
+// SyncGitRemote checks a project's Git remote for new commits and triggers a reconcile of the deployments whose branch has changed.
+// It only lists the remote's branches, so it's cheap to call on every push webhook and poll.
+func (s *Service) SyncGitRemote(ctx context.Context, proj *database.Project) error {
+	if proj.GitRemote == nil {
+		return fmt.Errorf("project %q is not deployed from a git remote", proj.Name)
+	}
+
+	ds, err := s.DB.FindDeploymentsForProject(ctx, proj.ID)
+	if err != nil {
+		return err
+	}
+	if len(ds) == 0 {
+		return nil
+	}
+
+	branches, err := git.RemoteBranches(ctx, gitDSN(*proj.GitRemote, proj.GitCredentials, proj.Subpath, ""))
+	if err != nil {
+		return fmt.Errorf("failed to list branches of git remote: %w", err)
+	}
+
+	for _, d := range ds {
+		hash, ok := branches[d.Branch]
+		if !ok || hash == d.CommitHash {
+			// Keep serving the last deployed state if the branch was deleted
+			continue
+		}
+
+		d, err = s.DB.UpdateDeploymentCommitHash(ctx, d.ID, hash)
+		if err != nil {
+			return err
+		}
+
+		s.Logger.Info("sync git remote: new commit", zap.String("project_id", proj.ID), zap.String("deployment_id", d.ID), zap.String("branch", d.Branch), zap.String("commit", hash), observability.ZapCtx(ctx))
+
+		err = s.TriggerReconcile(ctx, d)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateGitWebhook checks that a webhook request for a project deployed from a Git remote was sent with the project's webhook secret.
+// The secret can be passed as a token (GitLab's X-Gitlab-Token header or an Authorization bearer token),
+// or used to sign the payload with HMAC-SHA256 (Gitea's X-Gitea-Signature or the X-Hub-Signature-256 header).
+func ValidateGitWebhook(proj *database.Project, header func(string) string, payload []byte) bool {
+	secret := proj.GitWebhookSecret
+	if proj.GitRemote == nil || secret == "" {
+		return false
+	}
+
+	token := header("X-Gitlab-Token")
+	if token == "" {
+		token, _ = strings.CutPrefix(header("Authorization"), "Bearer ")
+	}
+	if token != "" {
+		return subtle.ConstantTimeCompare([]byte(token), []byte(secret)) == 1
+	}
+
+	sig := header("X-Gitea-Signature")
+	if sig == "" {
+		sig, _ = strings.CutPrefix(header("X-Hub-Signature-256"), "sha256=")
+	}
+	if sig != "" {
+		got, err := hex.DecodeString(sig)
+		if err != nil {
+			return false
+		}
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(payload)
+		return hmac.Equal(got, mac.Sum(nil))
+	}
+
+	return false
+}
+
+// newGitWebhookSecret generates a random secret for authenticating a project's Git webhook.
+func newGitWebhookSecret() (string, error) {
+	b := make([]byte, 24)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,45 @@
+package admin
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"testing"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGitWebhook(t *testing.T) {
+	remote := "https://gitlab.example.com/org/repo.git"
+	proj := &database.Project{GitRemote: &remote, GitWebhookSecret: "secret"}
+	payload := []byte(`{"ref":"refs/heads/main"}`)
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(payload)
+	sig := hex.EncodeToString(mac.Sum(nil))
+
+	tests := []struct {
+		name   string
+		header http.Header
+		valid  bool
+	}{
+		{"gitlab token", http.Header{"X-Gitlab-Token": {"secret"}}, true},
+		{"bearer token", http.Header{"Authorization": {"Bearer secret"}}, true},
+		{"gitea signature", http.Header{"X-Gitea-Signature": {sig}}, true},
+		{"hub signature", http.Header{"X-Hub-Signature-256": {"sha256=" + sig}}, true},
+		{"wrong token", http.Header{"X-Gitlab-Token": {"wrong"}}, false},
+		{"wrong signature", http.Header{"X-Gitea-Signature": {hex.EncodeToString([]byte("wrong"))}}, false},
+		{"invalid signature", http.Header{"X-Gitea-Signature": {"not hex"}}, false},
+		{"no secret", http.Header{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.valid, ValidateGitWebhook(proj, tt.header.Get, payload))
+		})
+	}
+
+	// Projects deployed from Github don't accept the webhook
+	require.False(t, ValidateGitWebhook(&database.Project{GitWebhookSecret: "secret"}, http.Header{"X-Gitlab-Token": {"secret"}}.Get, payload))
+}
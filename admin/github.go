@@ -176,6 +176,9 @@ func (s *Service) ProcessGithubEvent(ctx context.Context, rawEvent any) error {
 	// Triggered on push to repository
 	case *github.PushEvent:
 		return s.processGithubPush(ctx, event)
+	// Triggered when a pull request is opened, updated or closed
+	case *github.PullRequestEvent:
+		return s.processGithubPullRequest(ctx, event)
 	// Triggered during first installation of app to an account (org or user) or one or more repos
 	case *github.InstallationEvent:
 		return s.processGithubInstallationEvent(ctx, event)
@@ -212,7 +215,14 @@ func (s *Service) processGithubPush(ctx context.Context, event *github.PushEvent
 	// Iterate over all projects and trigger reconcile
 	for _, project := range projects {
 		if branch != project.ProdBranch {
-			// Ignore if push was not to production branch
+			// Pushes to other branches only impact preview deployments
+			if project.PreviewEnabled {
+				s.syncPreviewDeployment(ctx, project, branch, event.GetDeleted())
+			}
+			continue
+		}
+		if event.GetDeleted() {
+			// Keep serving the last deployed state if the production branch was deleted
 			continue
 		}
 
@@ -234,6 +244,44 @@ func (s *Service) processGithubPush(ctx context.Context, event *github.PushEvent
 	return nil
 }
 
+func (s *Service) processGithubPullRequest(ctx context.Context, event *github.PullRequestEvent) error {
+	pr := event.GetPullRequest()
+	repo := event.GetRepo()
+
+	// Pull requests from forks can't be deployed since the branch doesn't exist in the repo
+	if pr.GetHead().GetRepo().GetID() != repo.GetID() {
+		return nil
+	}
+
+	var deleted bool
+	switch event.GetAction() {
+	case "opened", "reopened", "synchronize":
+		deleted = false
+	case "closed":
+		deleted = true
+	default:
+		return nil
+	}
+
+	projects, err := s.DB.FindProjectsByGithubURL(ctx, repo.GetHTMLURL())
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	branch := pr.GetHead().GetRef()
+	for _, project := range projects {
+		if !project.PreviewEnabled || branch == project.ProdBranch {
+			continue
+		}
+		s.syncPreviewDeployment(ctx, project, branch, deleted)
+	}
+
+	return nil
+}
+
 func (s *Service) processGithubInstallationEvent(ctx context.Context, event *github.InstallationEvent) error {
 	switch event.GetAction() {
 	case "created", "unsuspend", "new_permissions_accepted":
@@ -61,6 +61,7 @@ func (s *Service) ProjectPermissionsForUser(ctx context.Context, projectID, user
 			ManageDev:            true,
 			ReadProjectMembers:   true,
 			ManageProjectMembers: true,
+			ReadProjectVariables: true,
 		}, nil
 	}
 
@@ -92,6 +93,7 @@ func (s *Service) ProjectPermissionsForService(ctx context.Context, projectID, s
 			ManageDev:            true,
 			ReadProjectMembers:   true,
 			ManageProjectMembers: true,
+			ReadProjectVariables: true,
 		}, nil
 	}
 
@@ -122,5 +124,6 @@ func unionProjectRoles(a *adminv1.ProjectPermissions, b *database.ProjectRole) *
 		ManageDev:            a.ManageDev || b.ManageDev,
 		ReadProjectMembers:   a.ReadProjectMembers || b.ReadProjectMembers,
 		ManageProjectMembers: a.ManageProjectMembers || b.ManageProjectMembers,
+		ReadProjectVariables: a.ReadProjectVariables || b.ReadProjectVariables,
 	}
 }
@@ -0,0 +1,180 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+)
+
+// ErrQuotaExceeded is returned when creating a deployment would exceed one of the organization's quotas.
+var ErrQuotaExceeded = errors.New("quota exceeded")
+
+// CreatePreviewDeploymentOptions configures a preview deployment.
+type CreatePreviewDeploymentOptions struct {
+	Branch string
+	// Variables override the project's prod variables for the preview deployment.
+	Variables map[string]string
+	// TTLSeconds overrides the project's default preview TTL.
+	TTLSeconds *int64
+}
+
+// CreatePreviewDeployment provisions and reconciles a preview deployment of a non-prod branch of a project.
+// Each project can have at most one preview deployment per branch.
+func (s *Service) CreatePreviewDeployment(ctx context.Context, proj *database.Project, opts *CreatePreviewDeploymentOptions) (*database.Deployment, error) {
+	if opts.Branch == "" {
+		return nil, errors.New("branch is required for preview deployments")
+	}
+	if opts.Branch == proj.ProdBranch {
+		return nil, fmt.Errorf("cannot create a preview deployment of the prod branch %q", proj.ProdBranch)
+	}
+
+	_, err := s.DB.FindPreviewDeploymentForBranch(ctx, proj.ID, opts.Branch)
+	if err == nil {
+		return nil, fmt.Errorf("a preview deployment already exists for branch %q: %w", opts.Branch, database.ErrNotUnique)
+	}
+	if !errors.Is(err, database.ErrNotFound) {
+		return nil, err
+	}
+
+	org, err := s.DB.FindOrganization(ctx, proj.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Preview deployments count towards the org's quotas like any other deployment
+	if org.QuotaSlotsPerDeployment >= 0 && proj.ProdSlots > org.QuotaSlotsPerDeployment {
+		return nil, fmt.Errorf("%w: org can't provision more than %d slots per deployment", ErrQuotaExceeded, org.QuotaSlotsPerDeployment)
+	}
+	stats, err := s.DB.CountDeploymentsForOrganization(ctx, org.ID)
+	if err != nil {
+		return nil, err
+	}
+	if org.QuotaDeployments >= 0 && stats.Deployments >= org.QuotaDeployments {
+		return nil, fmt.Errorf("%w: org %q is limited to %d deployments", ErrQuotaExceeded, org.Name, org.QuotaDeployments)
+	}
+	if org.QuotaSlotsTotal >= 0 && stats.Slots+proj.ProdSlots > org.QuotaSlotsTotal {
+		return nil, fmt.Errorf("%w: org %q is limited to %d total slots", ErrQuotaExceeded, org.Name, org.QuotaSlotsTotal)
+	}
+
+	ttl := opts.TTLSeconds
+	if ttl == nil {
+		ttl = &proj.PreviewTTLSeconds
+	}
+
+	depl, err := s.createDeployment(ctx, &createDeploymentOptions{
+		ProjectID:            proj.ID,
+		Region:               proj.Region,
+		GithubURL:            proj.GithubURL,
+		GithubInstallationID: proj.GithubInstallationID,
+		GitRemote:            proj.GitRemote,
+		GitCredentials:       proj.GitCredentials,
+		ArchiveAssetID:       proj.ArchiveAssetID,
+		Subpath:              proj.Subpath,
+		ProdBranch:           opts.Branch,
+		ProdVariables:        mergeVariables(proj.ProdVariables, opts.Variables),
+		ProdOLAPDriver:       proj.ProdOLAPDriver,
+		ProdOLAPDSN:          proj.ProdOLAPDSN,
+		ProdSlots:            proj.ProdSlots,
+		Annotations:          newDeploymentAnnotations(org, proj),
+		Preview:              true,
+		PreviewVariables:     opts.Variables,
+		PreviewTTLSeconds:    ttl,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("created preview deployment", zap.String("project_id", proj.ID), zap.String("deployment_id", depl.ID), zap.String("branch", opts.Branch), observability.ZapCtx(ctx))
+
+	err = s.TriggerReconcile(ctx, depl)
+	if err != nil {
+		return nil, err
+	}
+
+	return depl, nil
+}
+
+// DeletePreviewDeployment tears down a preview deployment.
+func (s *Service) DeletePreviewDeployment(ctx context.Context, proj *database.Project, depl *database.Deployment) error {
+	if !depl.Preview {
+		return fmt.Errorf("deployment %q is not a preview deployment", depl.ID)
+	}
+
+	err := s.teardownDeployment(ctx, proj, depl)
+	if err != nil {
+		return err
+	}
+
+	s.Logger.Info("deleted preview deployment", zap.String("project_id", proj.ID), zap.String("deployment_id", depl.ID), zap.String("branch", depl.Branch), observability.ZapCtx(ctx))
+	return nil
+}
+
+// redeployPreviewDeployment re-provisions a preview deployment with the same branch, variables and TTL.
+// Unlike prod deployments, the old deployment is torn down first since there can only be one preview deployment per branch.
+func (s *Service) redeployPreviewDeployment(ctx context.Context, proj *database.Project, depl *database.Deployment) error {
+	err := s.teardownDeployment(ctx, proj, depl)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.CreatePreviewDeployment(ctx, proj, &CreatePreviewDeploymentOptions{
+		Branch:     depl.Branch,
+		Variables:  depl.PreviewVariables,
+		TTLSeconds: depl.PreviewTTLSeconds,
+	})
+	return err
+}
+
+// syncPreviewDeployment creates or reconciles the preview deployment for a branch, or tears it down if deleted is true.
+// It's used for Github webhooks, so it runs in the background and only logs errors.
+func (s *Service) syncPreviewDeployment(ctx context.Context, proj *database.Project, branch string, deleted bool) {
+	s.reconcileWg.Add(1)
+	go func() {
+		defer s.reconcileWg.Done()
+		err := s.syncPreviewDeploymentInner(s.closeCtx, proj, branch, deleted) // Use s.closeCtx to cancel if the service is stopped
+		if err != nil {
+			s.Logger.Error("preview deployment: sync failed", zap.String("project_id", proj.ID), zap.String("branch", branch), zap.Bool("deleted", deleted), zap.Error(err), observability.ZapCtx(ctx))
+		}
+	}()
+}
+
+func (s *Service) syncPreviewDeploymentInner(ctx context.Context, proj *database.Project, branch string, deleted bool) error {
+	depl, err := s.DB.FindPreviewDeploymentForBranch(ctx, proj.ID, branch)
+	if err != nil && !errors.Is(err, database.ErrNotFound) {
+		return err
+	}
+
+	if deleted {
+		if depl == nil {
+			return nil
+		}
+		return s.DeletePreviewDeployment(ctx, proj, depl)
+	}
+
+	if depl != nil {
+		return s.triggerReconcile(ctx, depl)
+	}
+
+	_, err = s.CreatePreviewDeployment(ctx, proj, &CreatePreviewDeploymentOptions{Branch: branch})
+	if errors.Is(err, database.ErrNotUnique) {
+		// Created concurrently by another webhook for the same branch (e.g. a push and a pull request event)
+		return nil
+	}
+	return err
+}
+
+// mergeVariables returns a new map with the overrides applied on top of the base variables.
+func mergeVariables(base, overrides map[string]string) map[string]string {
+	res := make(map[string]string, len(base)+len(overrides))
+	for k, v := range base {
+		res[k] = v
+	}
+	for k, v := range overrides {
+		res[k] = v
+	}
+	return res
+}
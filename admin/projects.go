@@ -20,9 +20,18 @@ import (
 
 // CreateProject creates a new project and provisions and reconciles a prod deployment for it.
 func (s *Service) CreateProject(ctx context.Context, org *database.Organization, userID string, opts *database.InsertProjectOptions) (*database.Project, error) {
-	// Check Github info is set (presently required to make a deployment)
-	if opts.GithubURL == nil || opts.GithubInstallationID == nil || opts.ProdBranch == "" {
-		return nil, fmt.Errorf("cannot create project without github info")
+	// Check Github info, a Git remote or an archive is set (presently required to make a deployment)
+	if opts.ArchiveAssetID == nil && ((opts.GitRemote == nil && (opts.GithubURL == nil || opts.GithubInstallationID == nil)) || opts.ProdBranch == "") {
+		return nil, fmt.Errorf("cannot create project without github info, a git remote or an archive")
+	}
+
+	// Projects deployed from a Git remote are redeployed when a webhook authenticated with this secret is called
+	if opts.GitRemote != nil && opts.GitWebhookSecret == "" {
+		secret, err := newGitWebhookSecret()
+		if err != nil {
+			return nil, err
+		}
+		opts.GitWebhookSecret = secret
 	}
 
 	// Get roles for initial setup
@@ -72,6 +81,9 @@ func (s *Service) CreateProject(ctx context.Context, org *database.Organization,
 		Region:               proj.Region,
 		GithubURL:            proj.GithubURL,
 		GithubInstallationID: proj.GithubInstallationID,
+		GitRemote:            proj.GitRemote,
+		GitCredentials:       proj.GitCredentials,
+		ArchiveAssetID:       proj.ArchiveAssetID,
 		Subpath:              proj.Subpath,
 		ProdBranch:           proj.ProdBranch,
 		ProdVariables:        proj.ProdVariables,
@@ -92,6 +104,10 @@ func (s *Service) CreateProject(ctx context.Context, org *database.Organization,
 		Public:               proj.Public,
 		GithubURL:            proj.GithubURL,
 		GithubInstallationID: proj.GithubInstallationID,
+		GitRemote:            proj.GitRemote,
+		GitCredentials:       proj.GitCredentials,
+		GitWebhookSecret:     proj.GitWebhookSecret,
+		ArchiveAssetID:       proj.ArchiveAssetID,
 		ProdBranch:           proj.ProdBranch,
 		ProdVariables:        proj.ProdVariables,
 		ProdSlots:            proj.ProdSlots,
@@ -141,8 +157,18 @@ func (s *Service) TeardownProject(ctx context.Context, p *database.Project) erro
 }
 
 // UpdateProject updates a project and any impacted deployments.
-// It runs a reconcile if deployment parameters (like branch or variables) have been changed and reconcileDeployment is set.
-func (s *Service) UpdateProject(ctx context.Context, proj *database.Project, opts *database.UpdateProjectOptions) (*database.Project, error) {
+// The project is updated in a transaction. If txFn is not nil, it's called with the updated project in the same transaction (e.g. to record an audit event).
+// Impacted deployments are only updated and reconciled after the transaction has committed.
+func (s *Service) UpdateProject(ctx context.Context, proj *database.Project, opts *database.UpdateProjectOptions, txFn func(ctx context.Context, proj *database.Project) error) (*database.Project, error) {
+	// Generate a webhook secret if the project is moved to a Git remote
+	if opts.GitRemote != nil && opts.GitWebhookSecret == "" {
+		secret, err := newGitWebhookSecret()
+		if err != nil {
+			return nil, err
+		}
+		opts.GitWebhookSecret = secret
+	}
+
 	if proj.Region != opts.Region || proj.ProdSlots != opts.ProdSlots { // require new deployments
 		s.Logger.Info("recreating deployment", observability.ZapCtx(ctx))
 		var oldDepl *database.Deployment
@@ -159,6 +185,7 @@ func (s *Service) UpdateProject(ctx context.Context, proj *database.Project, opt
 			return nil, err
 		}
 
+		// The new deployment is provisioned outside the transaction, since it involves external services.
 		depl, err := s.createDeployment(ctx, &createDeploymentOptions{
 			ProjectID:            proj.ID,
 			Subpath:              proj.Subpath,
@@ -167,6 +194,9 @@ func (s *Service) UpdateProject(ctx context.Context, proj *database.Project, opt
 			Region:               opts.Region,
 			GithubURL:            opts.GithubURL,
 			GithubInstallationID: opts.GithubInstallationID,
+			GitRemote:            opts.GitRemote,
+			GitCredentials:       opts.GitCredentials,
+			ArchiveAssetID:       opts.ArchiveAssetID,
 			ProdBranch:           opts.ProdBranch,
 			ProdVariables:        opts.ProdVariables,
 			ProdSlots:            opts.ProdSlots,
@@ -177,7 +207,7 @@ func (s *Service) UpdateProject(ctx context.Context, proj *database.Project, opt
 		}
 
 		opts.ProdDeploymentID = &depl.ID
-		res, err := s.DB.UpdateProject(ctx, proj.ID, opts)
+		res, err := s.updateProjectTx(ctx, proj.ID, opts, txFn)
 		if err != nil {
 			return nil, multierr.Combine(err, s.teardownDeployment(ctx, proj, depl))
 		}
@@ -195,10 +225,20 @@ func (s *Service) UpdateProject(ctx context.Context, proj *database.Project, opt
 		return res, nil
 	}
 
-	projNameChanged := proj.Name != opts.Name
-	var deplAnnotations *deploymentAnnotations
+	res, err := s.updateProjectTx(ctx, proj.ID, opts, txFn)
+	if err != nil {
+		return nil, err
+	}
 
-	if projNameChanged {
+	impactsDeployments := (proj.ProdBranch != opts.ProdBranch ||
+		!reflect.DeepEqual(proj.GithubURL, opts.GithubURL) ||
+		!reflect.DeepEqual(proj.GithubInstallationID, opts.GithubInstallationID) ||
+		!reflect.DeepEqual(proj.GitRemote, opts.GitRemote) ||
+		!reflect.DeepEqual(proj.GitCredentials, opts.GitCredentials) ||
+		!reflect.DeepEqual(proj.ArchiveAssetID, opts.ArchiveAssetID))
+
+	var deplAnnotations *deploymentAnnotations
+	if proj.Name != opts.Name {
 		// Regenerate deployment annotations
 		org, err := s.DB.FindOrganization(ctx, proj.OrganizationID)
 		if err != nil {
@@ -213,10 +253,6 @@ func (s *Service) UpdateProject(ctx context.Context, proj *database.Project, opt
 		}
 	}
 
-	impactsDeployments := (proj.ProdBranch != opts.ProdBranch ||
-		!reflect.DeepEqual(proj.GithubURL, opts.GithubURL) ||
-		!reflect.DeepEqual(proj.GithubInstallationID, opts.GithubInstallationID))
-
 	if impactsDeployments {
 		s.Logger.Info("updating deployments", observability.ZapCtx(ctx))
 		ds, err := s.DB.FindDeploymentsForProject(ctx, proj.ID)
@@ -224,15 +260,24 @@ func (s *Service) UpdateProject(ctx context.Context, proj *database.Project, opt
 			return nil, err
 		}
 
-		// NOTE: This assumes every deployment (almost always, there's just one) deploys the prod branch.
-		// It needs to be refactored when implementing preview deploys.
 		for _, d := range ds {
+			// Preview deployments keep deploying their own branch
+			branch := opts.ProdBranch
+			variables := opts.ProdVariables
+			if d.Preview {
+				branch = d.Branch
+				variables = mergeVariables(opts.ProdVariables, d.PreviewVariables)
+			}
+
 			err := s.updateDeployment(ctx, d, &updateDeploymentOptions{
 				GithubURL:            opts.GithubURL,
 				GithubInstallationID: opts.GithubInstallationID,
+				GitRemote:            opts.GitRemote,
+				GitCredentials:       opts.GitCredentials,
+				ArchiveAssetID:       opts.ArchiveAssetID,
 				Subpath:              proj.Subpath,
-				Branch:               opts.ProdBranch,
-				Variables:            opts.ProdVariables,
+				Branch:               branch,
+				Variables:            variables,
 				Annotations:          deplAnnotations,
 			})
 			if err != nil {
@@ -240,40 +285,92 @@ func (s *Service) UpdateProject(ctx context.Context, proj *database.Project, opt
 				return nil, err
 			}
 		}
-	} else if projNameChanged {
+	} else if deplAnnotations != nil {
 		err := s.UpdateDeploymentAnnotations(ctx, proj.ID, *deplAnnotations)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	proj, err := s.DB.UpdateProject(ctx, proj.ID, opts)
+	return res, nil
+}
+
+// UpdateProjectVariables updates project's variables and pushes the updated variables to deployments.
+// The variables are updated in a transaction. If txFn is not nil, it's called with the updated project in the same transaction.
+// The variables are only pushed to deployments after the transaction has committed.
+// NOTE : this does not trigger reconcile.
+func (s *Service) UpdateProjectVariables(ctx context.Context, proj *database.Project, variables map[string]string, txFn func(ctx context.Context, proj *database.Project) error) (*database.Project, error) {
+	txCtx, tx, err := s.DB.NewTx(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer func() { _ = tx.Rollback() }()
 
-	return proj, nil
-}
+	res, err := s.DB.UpdateProjectVariables(txCtx, proj.ID, variables)
+	if err != nil {
+		return nil, err
+	}
 
-// UpdateProjectVariables updates project's variables and pushes the updated variables to deployments.
-// NOTE : this does not trigger reconcile.
-func (s *Service) UpdateProjectVariables(ctx context.Context, proj *database.Project, variables map[string]string) (*database.Project, error) {
+	if txFn != nil {
+		err = txFn(txCtx, res)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	// Push the variables to the deployments.
+	// Start using original context again since transaction in txCtx is done.
 	ds, err := s.DB.FindDeploymentsForProject(ctx, proj.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	// NOTE: This assumes every deployment (almost always, there's just one) deploys the prod branch.
-	// It needs to be refactored when implementing preview deploys.
 	for _, d := range ds {
-		err := s.updateDeplVariables(ctx, d, variables)
+		deplVariables := variables
+		if d.Preview {
+			deplVariables = mergeVariables(variables, d.PreviewVariables)
+		}
+
+		err := s.updateDeplVariables(ctx, d, deplVariables)
 		if err != nil {
 			// TODO: This may leave things in an inconsistent state. (Although presently, there's almost never multiple deployments.)
 			return nil, err
 		}
 	}
 
-	return s.DB.UpdateProjectVariables(ctx, proj.ID, variables)
+	return res, nil
+}
+
+// updateProjectTx updates the project and calls txFn (if not nil) in the same transaction.
+func (s *Service) updateProjectTx(ctx context.Context, id string, opts *database.UpdateProjectOptions, txFn func(ctx context.Context, proj *database.Project) error) (*database.Project, error) {
+	txCtx, tx, err := s.DB.NewTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	proj, err := s.DB.UpdateProject(txCtx, id, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if txFn != nil {
+		err = txFn(txCtx, proj)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return proj, nil
 }
 
 // UpdateOrgDeploymentAnnotations iterates over projects of the given org and
@@ -330,6 +427,10 @@ func (s *Service) UpdateDeploymentAnnotations(ctx context.Context, projID string
 
 // TriggerRedeploy de-provisions and re-provisions a project's prod deployment.
 func (s *Service) TriggerRedeploy(ctx context.Context, proj *database.Project, prevDepl *database.Deployment) error {
+	if prevDepl != nil && prevDepl.Preview {
+		return s.redeployPreviewDeployment(ctx, proj, prevDepl)
+	}
+
 	org, err := s.DB.FindOrganization(ctx, proj.OrganizationID)
 	if err != nil {
 		return err
@@ -341,6 +442,9 @@ func (s *Service) TriggerRedeploy(ctx context.Context, proj *database.Project, p
 		Region:               proj.Region,
 		GithubURL:            proj.GithubURL,
 		GithubInstallationID: proj.GithubInstallationID,
+		GitRemote:            proj.GitRemote,
+		GitCredentials:       proj.GitCredentials,
+		ArchiveAssetID:       proj.ArchiveAssetID,
 		Subpath:              proj.Subpath,
 		ProdBranch:           proj.ProdBranch,
 		ProdVariables:        proj.ProdVariables,
@@ -360,6 +464,10 @@ func (s *Service) TriggerRedeploy(ctx context.Context, proj *database.Project, p
 		Public:               proj.Public,
 		GithubURL:            proj.GithubURL,
 		GithubInstallationID: proj.GithubInstallationID,
+		GitRemote:            proj.GitRemote,
+		GitCredentials:       proj.GitCredentials,
+		GitWebhookSecret:     proj.GitWebhookSecret,
+		ArchiveAssetID:       proj.ArchiveAssetID,
 		ProdBranch:           proj.ProdBranch,
 		ProdVariables:        proj.ProdVariables,
 		ProdDeploymentID:     &newDepl.ID,
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// archiveMaxUploadBytes limits the size of uploaded project archives.
+const archiveMaxUploadBytes = 100 << 20
+
+// registerArchiveEndpoints registers the non-gRPC endpoints for deploying projects from uploaded archives.
+func (s *Server) registerArchiveEndpoints(mux *http.ServeMux) {
+	mux.Handle("/archives/upload", otelhttp.WithRouteTag("/archives/upload", s.authenticator.HTTPMiddleware(http.HandlerFunc(s.uploadArchive))))
+}
+
+// uploadArchive stores a gzipped tarball of a project's files, which can then be deployed by passing the returned asset ID to CreateProject or UpdateProject.
+// It's implemented as a non-gRPC endpoint mounted directly on /archives/upload?organization=...&project=...
+// The caller must be allowed to create projects in the org or, if the optional project parameter is set, to manage the project.
+func (s *Server) uploadArchive(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "expected a POST request", http.StatusBadRequest)
+		return
+	}
+
+	claims := auth.GetClaims(r.Context())
+	if claims.OwnerType() != auth.OwnerTypeUser {
+		http.Error(w, "not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	orgName := r.URL.Query().Get("organization")
+	if orgName == "" {
+		http.Error(w, "organization not specified", http.StatusBadRequest)
+		return
+	}
+
+	org, err := s.admin.DB.FindOrganizationByName(r.Context(), orgName)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			http.Error(w, "org not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	allowed := claims.OrganizationPermissions(r.Context(), org.ID).CreateProjects
+	if projectName := r.URL.Query().Get("project"); !allowed && projectName != "" {
+		proj, err := s.admin.DB.FindProjectByName(r.Context(), org.Name, projectName)
+		if err == nil {
+			allowed = claims.ProjectPermissions(r.Context(), org.ID, proj.ID).ManageProject
+		}
+	}
+	if !allowed {
+		http.Error(w, "does not have permission to upload archives", http.StatusForbidden)
+		return
+	}
+
+	ownerID := claims.OwnerID()
+	body := http.MaxBytesReader(w, r.Body, archiveMaxUploadBytes)
+	asset, err := s.admin.UploadArchive(r.Context(), org.ID, &ownerID, body)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			http.Error(w, fmt.Sprintf("archive exceeds the limit of %d bytes", archiveMaxUploadBytes), http.StatusRequestEntityTooLarge)
+			return
+		}
+		if errors.Is(err, admin.ErrArchivesNotConfigured) {
+			http.Error(w, err.Error(), http.StatusNotImplemented)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(map[string]string{"asset_id": asset.ID})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// checkArchiveAsset checks that an uploaded archive exists and belongs to the org.
+func (s *Server) checkArchiveAsset(ctx context.Context, orgID, assetID string) error {
+	asset, err := s.admin.DB.FindAsset(ctx, assetID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return status.Error(codes.InvalidArgument, "archive not found")
+		}
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if asset.OrganizationID != orgID {
+		return status.Error(codes.InvalidArgument, "archive not found")
+	}
+	return nil
+}
@@ -0,0 +1,319 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// redactedValue replaces secrets in the before/after state of audit events.
+const redactedValue = "[redacted]"
+
+// sensitiveAuditKeys are substrings of keys whose values are never written to the audit log.
+var sensitiveAuditKeys = []string{"dsn", "password", "secret", "token", "credentials", "variables"}
+
+// auditEvent describes a mutating action to record in the audit log.
+// The action name is derived from the gRPC method and the actor from the request's claims.
+type auditEvent struct {
+	org     *database.Organization
+	project *database.Project
+	// target identifies the entity the action was applied to, e.g. a user email or usergroup name.
+	target string
+	// before and after are the state of the target before and after the action.
+	// They can be proto messages, structs or maps, and are redacted before they're stored.
+	before any
+	after  any
+}
+
+func (s *Server) ListAuditEvents(ctx context.Context, req *adminv1.ListAuditEventsRequest) (*adminv1.ListAuditEventsResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.action", req.Action),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "org not found")
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.OrganizationPermissions(ctx, org.ID).ManageOrg && !claims.Superuser(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to read audit events")
+	}
+
+	token, err := unmarshalPageToken(req.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	pageSize := validPageSize(req.PageSize)
+
+	opts := &database.FindAuditEventsOptions{
+		OrgID:       org.ID,
+		ProjectName: req.Project,
+		Action:      req.Action,
+		ActorEmail:  req.ActorEmail,
+		Limit:       pageSize,
+	}
+	if token.Val != "" {
+		createdOn, id, err := parseAuditPageToken(token.Val)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		opts.AfterCreatedOn = &createdOn
+		opts.AfterID = id
+	}
+
+	events, err := s.admin.DB.FindAuditEvents(ctx, opts)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	nextToken := ""
+	if len(events) >= pageSize {
+		last := events[len(events)-1]
+		nextToken = marshalPageToken(fmt.Sprintf("%s %s", last.CreatedOn.Format(time.RFC3339Nano), last.ID))
+	}
+
+	dtos := make([]*adminv1.AuditEvent, len(events))
+	for i, e := range events {
+		dtos[i], err = auditEventToPB(e)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &adminv1.ListAuditEventsResponse{
+		Events:        dtos,
+		NextPageToken: nextToken,
+	}, nil
+}
+
+// auditTx runs fn and records ev in the same transaction, so the event is persisted if and only if fn's changes are.
+// fn should use the ctx it's passed for all database calls. It may update ev before returning.
+// Errors returned by fn are passed through unchanged.
+func (s *Server) auditTx(ctx context.Context, ev *auditEvent, fn func(ctx context.Context) error) error {
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	err = fn(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.recordAuditEvent(ctx, ev)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// recordAuditEvent writes ev to the audit log.
+// If ctx carries a transaction (see database.DB.NewTx), the event is only persisted if the transaction commits.
+func (s *Server) recordAuditEvent(ctx context.Context, ev *auditEvent) error {
+	method, ok := grpc.Method(ctx)
+	if !ok {
+		return status.Error(codes.Internal, "audit: no gRPC method in context")
+	}
+	action := method[strings.LastIndex(method, "/")+1:]
+
+	diff, err := auditDiff(ev.before, ev.after)
+	if err != nil {
+		return status.Errorf(codes.Internal, "audit: %s", err.Error())
+	}
+
+	opts := &database.InsertAuditEventOptions{
+		Action: action,
+		Target: ev.target,
+		Diff:   diff,
+	}
+	if ev.org != nil {
+		opts.OrgID = &ev.org.ID
+		opts.OrgName = ev.org.Name
+	}
+	if ev.project != nil {
+		opts.ProjectID = &ev.project.ID
+		opts.ProjectName = ev.project.Name
+		if opts.OrgID == nil {
+			opts.OrgID = &ev.project.OrganizationID
+		}
+	}
+
+	claims := auth.GetClaims(ctx)
+	opts.ActorType = string(claims.OwnerType())
+	opts.ActorID = claims.OwnerID()
+	if claims.OwnerType() == auth.OwnerTypeUser {
+		user, err := s.admin.DB.FindUser(ctx, claims.OwnerID())
+		if err != nil && !errors.Is(err, database.ErrNotFound) {
+			return status.Error(codes.Internal, err.Error())
+		}
+		if user != nil {
+			opts.ActorEmail = user.Email
+		}
+	}
+
+	_, err = s.admin.DB.InsertAuditEvent(ctx, opts)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// auditDiff serializes the before and after state of an audit event to JSON with secrets redacted.
+func auditDiff(before, after any) ([]byte, error) {
+	res := make(map[string]any)
+	if before != nil {
+		v, err := auditValue(before)
+		if err != nil {
+			return nil, err
+		}
+		res["before"] = v
+	}
+	if after != nil {
+		v, err := auditValue(after)
+		if err != nil {
+			return nil, err
+		}
+		res["after"] = v
+	}
+	return json.Marshal(res)
+}
+
+// auditValue converts v to a generic JSON value and redacts its secrets.
+func auditValue(v any) (any, error) {
+	var data []byte
+	var err error
+	if msg, ok := v.(proto.Message); ok {
+		data, err = protojson.Marshal(msg)
+	} else {
+		data, err = json.Marshal(v)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var res any
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return nil, err
+	}
+	return redactAuditValue(res), nil
+}
+
+// redactAuditValue recursively replaces the values of sensitive keys in v.
+// Variables are redacted per key so that the diff still shows which variables changed.
+func redactAuditValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, val := range v {
+			if !isSensitiveAuditKey(k) {
+				v[k] = redactAuditValue(val)
+				continue
+			}
+			if m, ok := val.(map[string]any); ok && strings.Contains(strings.ToLower(k), "variables") {
+				for vk := range m {
+					m[vk] = redactedValue
+				}
+				continue
+			}
+			v[k] = redactedValue
+		}
+		return v
+	case []any:
+		for i, val := range v {
+			v[i] = redactAuditValue(val)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func isSensitiveAuditKey(k string) bool {
+	k = strings.ToLower(k)
+	for _, s := range sensitiveAuditKeys {
+		if strings.Contains(k, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// changedVariables returns the sorted names of variables that were added, removed or changed between before and after.
+func changedVariables(before, after map[string]string) []string {
+	var res []string
+	for k, v := range after {
+		if prev, ok := before[k]; !ok || prev != v {
+			res = append(res, k)
+		}
+	}
+	for k := range before {
+		if _, ok := after[k]; !ok {
+			res = append(res, k)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+func parseAuditPageToken(val string) (time.Time, string, error) {
+	createdOn, id, ok := strings.Cut(val, " ")
+	if !ok {
+		return time.Time{}, "", fmt.Errorf("invalid page token")
+	}
+	t, err := time.Parse(time.RFC3339Nano, createdOn)
+	if err != nil {
+		return time.Time{}, "", fmt.Errorf("invalid page token: %w", err)
+	}
+	return t, id, nil
+}
+
+func auditEventToPB(e *database.AuditEvent) (*adminv1.AuditEvent, error) {
+	diff := &structpb.Struct{}
+	if len(e.Diff) > 0 {
+		err := diff.UnmarshalJSON(e.Diff)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &adminv1.AuditEvent{
+		Id:         e.ID,
+		CreatedOn:  timestamppb.New(e.CreatedOn),
+		Project:    e.ProjectName,
+		ActorType:  e.ActorType,
+		ActorId:    e.ActorID,
+		ActorEmail: e.ActorEmail,
+		Action:     e.Action,
+		Target:     e.Target,
+		Diff:       diff,
+	}, nil
+}
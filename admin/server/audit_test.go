@@ -0,0 +1,32 @@
+package server
+
+import (
+	"testing"
+
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditDiff(t *testing.T) {
+	before := &adminv1.Project{Name: "foo", ProdOlapDsn: "s3cret"}
+	after := map[string]any{
+		"variables": map[string]string{"API_KEY": "abc", "REGION": "us"},
+		"changed":   changedVariables(map[string]string{"API_KEY": "xyz", "OLD": "1"}, map[string]string{"API_KEY": "abc", "REGION": "us"}),
+		"nested":    []any{map[string]any{"password": "hunter2", "name": "bar"}},
+	}
+
+	diff, err := auditDiff(before, after)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"before": {"name": "foo", "prodOlapDsn": "[redacted]"},
+		"after": {
+			"variables": {"API_KEY": "[redacted]", "REGION": "[redacted]"},
+			"changed": ["API_KEY", "OLD", "REGION"],
+			"nested": [{"password": "[redacted]", "name": "bar"}]
+		}
+	}`, string(diff))
+
+	diff, err = auditDiff(nil, nil)
+	require.NoError(t, err)
+	require.JSONEq(t, `{}`, string(diff))
+}
@@ -100,15 +100,22 @@ func (s *Server) CreateBookmark(ctx context.Context, req *adminv1.CreateBookmark
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to read the project")
 	}
 
-	bookmark, err := s.admin.DB.InsertBookmark(ctx, &database.InsertBookmarkOptions{
-		DisplayName:   req.DisplayName,
-		Data:          req.Data,
-		DashboardName: req.DashboardName,
-		ProjectID:     req.ProjectId,
-		UserID:        claims.OwnerID(),
+	var bookmark *database.Bookmark
+	err = s.auditTx(ctx, &auditEvent{project: proj, target: req.DisplayName}, func(ctx context.Context) error {
+		bookmark, err = s.admin.DB.InsertBookmark(ctx, &database.InsertBookmarkOptions{
+			DisplayName:   req.DisplayName,
+			Data:          req.Data,
+			DashboardName: req.DashboardName,
+			ProjectID:     req.ProjectId,
+			UserID:        claims.OwnerID(),
+		})
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		return nil
 	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &adminv1.CreateBookmarkResponse{
@@ -134,11 +141,22 @@ func (s *Server) RemoveBookmark(ctx context.Context, req *adminv1.RemoveBookmark
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to delete the bookmark")
 	}
 
-	err = s.admin.DB.DeleteBookmark(ctx, req.BookmarkId)
+	proj, err := s.admin.DB.FindProject(ctx, bookmark.ProjectID)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	err = s.auditTx(ctx, &auditEvent{project: proj, target: bookmark.DisplayName}, func(ctx context.Context) error {
+		err := s.admin.DB.DeleteBookmark(ctx, req.BookmarkId)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &adminv1.RemoveBookmarkResponse{}, nil
 }
 
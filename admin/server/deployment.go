@@ -40,6 +40,11 @@ func (s *Server) TriggerReconcile(ctx context.Context, req *adminv1.TriggerRecon
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	err = s.recordAuditEvent(ctx, &auditEvent{project: proj, target: depl.ID})
+	if err != nil {
+		return nil, err
+	}
+
 	return &adminv1.TriggerReconcileResponse{}, nil
 }
 
@@ -69,6 +74,11 @@ func (s *Server) TriggerRefreshSources(ctx context.Context, req *adminv1.Trigger
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	err = s.recordAuditEvent(ctx, &auditEvent{project: proj, target: depl.ID, after: map[string]any{"sources": req.Sources}})
+	if err != nil {
+		return nil, err
+	}
+
 	return &adminv1.TriggerRefreshSourcesResponse{}, nil
 }
 
@@ -141,7 +151,12 @@ func (s *Server) TriggerRedeploy(ctx context.Context, req *adminv1.TriggerRedepl
 	}
 
 	claims := auth.GetClaims(ctx)
-	if !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ManageProd {
+	permissions := claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID)
+	if depl != nil && depl.Preview {
+		if !permissions.ManageDev {
+			return nil, status.Error(codes.PermissionDenied, "does not have permission to manage preview deployment")
+		}
+	} else if !permissions.ManageProd {
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to manage deployment")
 	}
 
@@ -150,6 +165,16 @@ func (s *Server) TriggerRedeploy(ctx context.Context, req *adminv1.TriggerRedepl
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	// Redeploys provision new runtime instances, so the event is recorded after it succeeds
+	ev := &auditEvent{project: proj, target: proj.Name}
+	if depl != nil {
+		ev.target = depl.ID
+	}
+	err = s.recordAuditEvent(ctx, ev)
+	if err != nil {
+		return nil, err
+	}
+
 	return &adminv1.TriggerRedeployResponse{}, nil
 }
 
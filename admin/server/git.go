@@ -0,0 +1,64 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+)
+
+// gitWebhookMaxPayloadBytes limits the size of webhook payloads. The payload is only read to verify its signature.
+const gitWebhookMaxPayloadBytes = 25 << 20
+
+// gitWebhook is called by a generic Git host (such as GitLab or Gitea) on pushes to the repository of a project deployed from a Git remote.
+// It's implemented as a non-gRPC endpoint mounted directly on /git/webhook?organization=...&project=...
+// The request must be authenticated with the project's webhook secret (see admin.ValidateGitWebhook).
+// It checks the remote for new commits and triggers a reconcile of the deployments whose branch has changed.
+func (s *Server) gitWebhook(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "expected a POST request", http.StatusBadRequest)
+		return
+	}
+
+	orgName := r.URL.Query().Get("organization")
+	projectName := r.URL.Query().Get("project")
+	if orgName == "" || projectName == "" {
+		http.Error(w, "organization or project not specified", http.StatusBadRequest)
+		return
+	}
+
+	payload, err := io.ReadAll(io.LimitReader(r.Body, gitWebhookMaxPayloadBytes))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to read payload: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	proj, err := s.admin.DB.FindProjectByName(r.Context(), orgName, projectName)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			// Don't reveal whether the project exists
+			http.Error(w, "invalid webhook secret", http.StatusUnauthorized)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if !admin.ValidateGitWebhook(proj, r.Header.Get, payload) {
+		http.Error(w, "invalid webhook secret", http.StatusUnauthorized)
+		return
+	}
+
+	// Reconciles run in the background, so this doesn't block on them
+	err = s.admin.SyncGitRemote(context.Background(), proj)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to process event: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
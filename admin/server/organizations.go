@@ -128,6 +128,12 @@ func (s *Server) CreateOrganization(ctx context.Context, req *adminv1.CreateOrga
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	// The org is created in its own transaction, so the event is recorded after it commits
+	err = s.recordAuditEvent(ctx, &auditEvent{org: org, target: org.Name, after: organizationToDTO(org)})
+	if err != nil {
+		return nil, err
+	}
+
 	return &adminv1.CreateOrganizationResponse{
 		Organization: organizationToDTO(org),
 	}, nil
@@ -146,9 +152,16 @@ func (s *Server) DeleteOrganization(ctx context.Context, req *adminv1.DeleteOrga
 		return nil, status.Error(codes.PermissionDenied, "not allowed to delete org")
 	}
 
-	err = s.admin.DB.DeleteOrganization(ctx, req.Name)
+	ev := &auditEvent{org: org, target: org.Name, before: organizationToDTO(org)}
+	err = s.auditTx(ctx, ev, func(ctx context.Context) error {
+		err := s.admin.DB.DeleteOrganization(ctx, req.Name)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
 
 	return &adminv1.DeleteOrganizationResponse{}, nil
@@ -182,17 +195,25 @@ func (s *Server) UpdateOrganization(ctx context.Context, req *adminv1.UpdateOrga
 		}
 	}
 
-	org, err = s.admin.DB.UpdateOrganization(ctx, org.ID, &database.UpdateOrganizationOptions{
-		Name:                    orgNewName,
-		Description:             valOrDefault(req.Description, org.Description),
-		QuotaProjects:           org.QuotaProjects,
-		QuotaDeployments:        org.QuotaDeployments,
-		QuotaSlotsTotal:         org.QuotaSlotsTotal,
-		QuotaSlotsPerDeployment: org.QuotaSlotsPerDeployment,
-		QuotaOutstandingInvites: org.QuotaOutstandingInvites,
+	ev := &auditEvent{org: org, target: org.Name, before: organizationToDTO(org)}
+	err = s.auditTx(ctx, ev, func(ctx context.Context) error {
+		org, err = s.admin.DB.UpdateOrganization(ctx, org.ID, &database.UpdateOrganizationOptions{
+			Name:                    orgNewName,
+			Description:             valOrDefault(req.Description, org.Description),
+			QuotaProjects:           org.QuotaProjects,
+			QuotaDeployments:        org.QuotaDeployments,
+			QuotaSlotsTotal:         org.QuotaSlotsTotal,
+			QuotaSlotsPerDeployment: org.QuotaSlotsPerDeployment,
+			QuotaOutstandingInvites: org.QuotaOutstandingInvites,
+		})
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		ev.after = organizationToDTO(org)
+		return nil
 	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &adminv1.UpdateOrganizationResponse{
@@ -331,14 +352,21 @@ func (s *Server) AddOrganizationMember(ctx context.Context, req *adminv1.AddOrga
 		}
 
 		// Invite user to join org
-		err := s.admin.DB.InsertOrganizationInvite(ctx, &database.InsertOrganizationInviteOptions{
-			Email:     req.Email,
-			InviterID: invitedByUserID,
-			OrgID:     org.ID,
-			RoleID:    role.ID,
+		ev := &auditEvent{org: org, target: req.Email, after: map[string]any{"role": role.Name, "pending_signup": true}}
+		err := s.auditTx(ctx, ev, func(ctx context.Context) error {
+			err := s.admin.DB.InsertOrganizationInvite(ctx, &database.InsertOrganizationInviteOptions{
+				Email:     req.Email,
+				InviterID: invitedByUserID,
+				OrgID:     org.ID,
+				RoleID:    role.ID,
+			})
+			if err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+			return nil
 		})
 		if err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
+			return nil, err
 		}
 
 		// Send invitation email
@@ -377,6 +405,11 @@ func (s *Server) AddOrganizationMember(ctx context.Context, req *adminv1.AddOrga
 		// If the user is already in the all user group, we can ignore the error
 	}
 
+	err = s.recordAuditEvent(ctx, &auditEvent{org: org, target: req.Email, after: map[string]any{"role": role.Name}})
+	if err != nil {
+		return nil, err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -427,9 +460,15 @@ func (s *Server) RemoveOrganizationMember(ctx context.Context, req *adminv1.Remo
 			}
 			return nil, status.Error(codes.Internal, err.Error())
 		}
-		err = s.admin.DB.DeleteOrganizationInvite(ctx, invite.ID)
+		err = s.auditTx(ctx, &auditEvent{org: org, target: req.Email, before: map[string]any{"pending_signup": true}}, func(ctx context.Context) error {
+			err := s.admin.DB.DeleteOrganizationInvite(ctx, invite.ID)
+			if err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+			return nil
+		})
 		if err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
+			return nil, err
 		}
 		return &adminv1.RemoveOrganizationMemberResponse{}, nil
 	}
@@ -460,8 +499,8 @@ func (s *Server) RemoveOrganizationMember(ctx context.Context, req *adminv1.Remo
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	// delete from all user group
-	err = s.admin.DB.DeleteUsergroupMember(ctx, *org.AllUsergroupID, user.ID)
+	// delete from all of the org's user groups (including the all user group)
+	err = s.admin.DB.DeleteUsergroupsMemberUser(ctx, org.ID, user.ID)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -474,6 +513,11 @@ func (s *Server) RemoveOrganizationMember(ctx context.Context, req *adminv1.Remo
 		}
 	}
 
+	err = s.recordAuditEvent(ctx, &auditEvent{org: org, target: req.Email, after: map[string]any{"keep_project_roles": req.KeepProjectRoles}})
+	if err != nil {
+		return nil, err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -516,9 +560,15 @@ func (s *Server) SetOrganizationMemberRole(ctx context.Context, req *adminv1.Set
 			}
 			return nil, status.Error(codes.Internal, err.Error())
 		}
-		err = s.admin.DB.UpdateOrganizationInviteRole(ctx, invite.ID, role.ID)
+		err = s.auditTx(ctx, &auditEvent{org: org, target: req.Email, after: map[string]any{"role": role.Name, "pending_signup": true}}, func(ctx context.Context) error {
+			err := s.admin.DB.UpdateOrganizationInviteRole(ctx, invite.ID, role.ID)
+			if err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+			return nil
+		})
 		if err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
+			return nil, err
 		}
 		return &adminv1.SetOrganizationMemberRoleResponse{}, nil
 	}
@@ -540,9 +590,15 @@ func (s *Server) SetOrganizationMemberRole(ctx context.Context, req *adminv1.Set
 		}
 	}
 
-	err = s.admin.DB.UpdateOrganizationMemberUserRole(ctx, org.ID, user.ID, role.ID)
+	err = s.auditTx(ctx, &auditEvent{org: org, target: req.Email, after: map[string]any{"role": role.Name}}, func(ctx context.Context) error {
+		err := s.admin.DB.UpdateOrganizationMemberUserRole(ctx, org.ID, user.ID, role.ID)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
 
 	return &adminv1.SetOrganizationMemberRoleResponse{}, nil
@@ -595,11 +651,17 @@ func (s *Server) LeaveOrganization(ctx context.Context, req *adminv1.LeaveOrgani
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	// delete from all user group
-	err = s.admin.DB.DeleteUsergroupMember(ctx, *org.AllUsergroupID, claims.OwnerID())
+	// delete from all of the org's user groups (including the all user group)
+	err = s.admin.DB.DeleteUsergroupsMemberUser(ctx, org.ID, claims.OwnerID())
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+
+	err = s.recordAuditEvent(ctx, &auditEvent{org: org})
+	if err != nil {
+		return nil, err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -702,6 +764,15 @@ func (s *Server) CreateWhitelistedDomain(ctx context.Context, req *adminv1.Creat
 		}
 	}
 
+	addedEmails := make([]string, len(newUsers))
+	for i, user := range newUsers {
+		addedEmails[i] = user.Email
+	}
+	err = s.recordAuditEvent(ctx, &auditEvent{org: org, target: req.Domain, after: map[string]any{"role": role.Name, "added_members": addedEmails}})
+	if err != nil {
+		return nil, err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, err
@@ -738,9 +809,15 @@ func (s *Server) RemoveWhitelistedDomain(ctx context.Context, req *adminv1.Remov
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	err = s.admin.DB.DeleteOrganizationWhitelistedDomain(ctx, invite.ID)
+	err = s.auditTx(ctx, &auditEvent{org: org, target: req.Domain}, func(ctx context.Context) error {
+		err := s.admin.DB.DeleteOrganizationWhitelistedDomain(ctx, invite.ID)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &adminv1.RemoveWhitelistedDomainResponse{}, nil
@@ -818,7 +895,16 @@ func (s *Server) SudoUpdateOrganizationQuotas(ctx context.Context, req *adminv1.
 		QuotaOutstandingInvites: int(valOrDefault(req.OutstandingInvites, uint32(org.QuotaOutstandingInvites))),
 	}
 
-	updatedOrg, err := s.admin.DB.UpdateOrganization(ctx, org.ID, opts)
+	var updatedOrg *database.Organization
+	ev := &auditEvent{org: org, target: org.Name, before: organizationToDTO(org).Quotas}
+	err = s.auditTx(ctx, ev, func(ctx context.Context) error {
+		updatedOrg, err = s.admin.DB.UpdateOrganization(ctx, org.ID, opts)
+		if err != nil {
+			return err
+		}
+		ev.after = organizationToDTO(updatedOrg).Quotas
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,201 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	runtimeauth "github.com/rilldata/rill/runtime/server/auth"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) ListPreviewDeployments(ctx context.Context, req *adminv1.ListPreviewDeploymentsRequest) (*adminv1.ListPreviewDeploymentsResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.OrganizationName),
+		attribute.String("args.project", req.Project),
+	)
+
+	proj, err := s.admin.DB.FindProjectByName(ctx, req.OrganizationName, req.Project)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	permissions := claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID)
+	if !permissions.ReadDev {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to read preview deployments")
+	}
+
+	depls, err := s.admin.DB.FindPreviewDeploymentsForProject(ctx, proj.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	dtos := make([]*adminv1.Deployment, len(depls))
+	for i, d := range depls {
+		if !permissions.ReadDevStatus {
+			d.Logs = ""
+		}
+		dtos[i] = deploymentToDTO(d)
+	}
+
+	return &adminv1.ListPreviewDeploymentsResponse{Deployments: dtos}, nil
+}
+
+func (s *Server) GetPreviewDeployment(ctx context.Context, req *adminv1.GetPreviewDeploymentRequest) (*adminv1.GetPreviewDeploymentResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.OrganizationName),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.branch", req.Branch),
+	)
+
+	proj, err := s.admin.DB.FindProjectByName(ctx, req.OrganizationName, req.Project)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	permissions := claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID)
+	if !permissions.ReadDev {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to read preview deployments")
+	}
+
+	depl, err := s.admin.DB.FindPreviewDeploymentForBranch(ctx, proj.ID, req.Branch)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "preview deployment not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if !permissions.ReadDevStatus {
+		depl.Logs = ""
+	}
+
+	var attr map[string]any
+	if claims.OwnerType() == auth.OwnerTypeUser {
+		attr, err = s.jwtAttributesForUser(ctx, claims.OwnerID(), proj.OrganizationID, permissions)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	jwt, err := s.issuer.NewToken(runtimeauth.TokenOptions{
+		AudienceURL: depl.RuntimeAudience,
+		Subject:     claims.OwnerID(),
+		TTL:         time.Hour,
+		InstancePermissions: map[string][]runtimeauth.Permission{
+			depl.RuntimeInstanceID: {
+				runtimeauth.ReadObjects,
+				runtimeauth.ReadMetrics,
+				runtimeauth.ReadProfiling,
+				runtimeauth.ReadRepo,
+			},
+		},
+		Attributes: attr,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not issue jwt: %s", err.Error())
+	}
+
+	s.admin.Used.Deployment(depl.ID)
+
+	return &adminv1.GetPreviewDeploymentResponse{
+		Deployment: deploymentToDTO(depl),
+		Jwt:        jwt,
+	}, nil
+}
+
+func (s *Server) CreatePreviewDeployment(ctx context.Context, req *adminv1.CreatePreviewDeploymentRequest) (*adminv1.CreatePreviewDeploymentResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.OrganizationName),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.branch", req.Branch),
+	)
+	if req.TtlSeconds != nil {
+		observability.AddRequestAttributes(ctx, attribute.Int64("args.ttl_seconds", *req.TtlSeconds))
+	}
+
+	proj, err := s.admin.DB.FindProjectByName(ctx, req.OrganizationName, req.Project)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ManageDev {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to create preview deployments")
+	}
+
+	if req.TtlSeconds != nil && *req.TtlSeconds <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "preview TTL must be positive")
+	}
+
+	depl, err := s.admin.CreatePreviewDeployment(ctx, proj, &admin.CreatePreviewDeploymentOptions{
+		Branch:     req.Branch,
+		Variables:  req.Variables,
+		TTLSeconds: req.TtlSeconds,
+	})
+	if err != nil {
+		if errors.Is(err, database.ErrNotUnique) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		if errors.Is(err, admin.ErrQuotaExceeded) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.recordAuditEvent(ctx, &auditEvent{project: proj, target: req.Branch, after: deploymentToDTO(depl)})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1.CreatePreviewDeploymentResponse{
+		Deployment: deploymentToDTO(depl),
+	}, nil
+}
+
+func (s *Server) DeletePreviewDeployment(ctx context.Context, req *adminv1.DeletePreviewDeploymentRequest) (*adminv1.DeletePreviewDeploymentResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.OrganizationName),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.branch", req.Branch),
+	)
+
+	proj, err := s.admin.DB.FindProjectByName(ctx, req.OrganizationName, req.Project)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ManageDev {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to delete preview deployments")
+	}
+
+	depl, err := s.admin.DB.FindPreviewDeploymentForBranch(ctx, proj.ID, req.Branch)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "preview deployment not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.admin.DeletePreviewDeployment(ctx, proj, depl)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.recordAuditEvent(ctx, &auditEvent{project: proj, target: req.Branch, before: deploymentToDTO(depl)})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1.DeletePreviewDeploymentResponse{}, nil
+}
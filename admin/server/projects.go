@@ -5,6 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"path"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/rilldata/rill/admin"
@@ -106,9 +109,15 @@ func (s *Server) GetProject(ctx context.Context, req *adminv1.GetProjectRequest)
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to read project")
 	}
 
+	projDTO := s.projToDTO(proj, org.Name)
+	if permissions.ReadProjectVariables || claims.Superuser(ctx) {
+		projDTO.ProdOlapDsn = proj.ProdOLAPDSN
+		projDTO.GitWebhookSecret = proj.GitWebhookSecret
+	}
+
 	if proj.ProdDeploymentID == nil || !permissions.ReadProd {
 		return &adminv1.GetProjectResponse{
-			Project:            s.projToDTO(proj, org.Name),
+			Project:            projDTO,
 			ProjectPermissions: permissions,
 		}, nil
 	}
@@ -152,7 +161,7 @@ func (s *Server) GetProject(ctx context.Context, req *adminv1.GetProjectRequest)
 	s.admin.Used.Deployment(depl.ID)
 
 	return &adminv1.GetProjectResponse{
-		Project:            s.projToDTO(proj, org.Name),
+		Project:            projDTO,
 		ProdDeployment:     deploymentToDTO(depl),
 		Jwt:                jwt,
 		ProjectPermissions: permissions,
@@ -203,6 +212,8 @@ func (s *Server) CreateProject(ctx context.Context, req *adminv1.CreateProjectRe
 		attribute.String("args.sub_path", req.Subpath),
 		attribute.String("args.prod_branch", req.ProdBranch),
 		attribute.String("args.github_url", req.GithubUrl),
+		attribute.String("args.git_remote", req.GitRemote),
+		attribute.String("args.archive_asset_id", req.ArchiveAssetId),
 	)
 
 	// Check the request is made by a user
@@ -248,10 +259,37 @@ func (s *Server) CreateProject(ctx context.Context, req *adminv1.CreateProjectRe
 		return nil, status.Errorf(codes.FailedPrecondition, "quota exceeded: org %q is limited to %d total slots", org.Name, org.QuotaSlotsTotal)
 	}
 
-	// Check Github app is installed and caller has access on the repo
-	installationID, err := s.getAndCheckGithubInstallationID(ctx, req.GithubUrl, claims.OwnerID())
-	if err != nil {
-		return nil, err
+	if err := validateSubpath(req.Subpath); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Projects are deployed from an uploaded archive, from a generic Git remote or from Github
+	var githubURL, gitRemote, archiveAssetID *string
+	var installationID *int64
+	if req.ArchiveAssetId != "" {
+		if req.GithubUrl != "" || req.GitRemote != "" {
+			return nil, status.Error(codes.InvalidArgument, "cannot set both an archive and a git repository")
+		}
+		if err := s.checkArchiveAsset(ctx, org.ID, req.ArchiveAssetId); err != nil {
+			return nil, err
+		}
+		archiveAssetID = &req.ArchiveAssetId
+	} else if req.GitRemote != "" {
+		if req.GithubUrl != "" {
+			return nil, status.Error(codes.InvalidArgument, "cannot set both a github url and a git remote")
+		}
+		if err := validateGitRemote(req.GitRemote); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		gitRemote = &req.GitRemote
+	} else {
+		// Check Github app is installed and caller has access on the repo
+		id, err := s.getAndCheckGithubInstallationID(ctx, req.GithubUrl, claims.OwnerID())
+		if err != nil {
+			return nil, err
+		}
+		githubURL = &req.GithubUrl
+		installationID = &id
 	}
 
 	// Add prod TTL as 7 days if not a public project else infinite
@@ -273,8 +311,11 @@ func (s *Server) CreateProject(ctx context.Context, req *adminv1.CreateProjectRe
 		ProdSlots:            int(req.ProdSlots),
 		Subpath:              req.Subpath,
 		ProdBranch:           req.ProdBranch,
-		GithubURL:            &req.GithubUrl,
-		GithubInstallationID: &installationID,
+		GithubURL:            githubURL,
+		GithubInstallationID: installationID,
+		GitRemote:            gitRemote,
+		GitCredentials:       gitCredentialsFromDTO(req.GitCredentials),
+		ArchiveAssetID:       archiveAssetID,
 		ProdVariables:        req.Variables,
 		ProdTTLSeconds:       prodTTL,
 	})
@@ -282,6 +323,12 @@ func (s *Server) CreateProject(ctx context.Context, req *adminv1.CreateProjectRe
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	// The project is provisioned in several steps, so the event is recorded after it succeeds
+	err = s.recordAuditEvent(ctx, &auditEvent{org: org, project: proj, target: proj.Name, after: s.projToDTO(proj, org.Name)})
+	if err != nil {
+		return nil, err
+	}
+
 	return &adminv1.CreateProjectResponse{
 		Project: s.projToDTO(proj, org.Name),
 	}, nil
@@ -308,6 +355,11 @@ func (s *Server) DeleteProject(ctx context.Context, req *adminv1.DeleteProjectRe
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	err = s.recordAuditEvent(ctx, &auditEvent{project: proj, target: proj.Name, before: s.projToDTO(proj, req.OrganizationName)})
+	if err != nil {
+		return nil, err
+	}
+
 	return &adminv1.DeleteProjectResponse{}, nil
 }
 
@@ -328,6 +380,12 @@ func (s *Server) UpdateProject(ctx context.Context, req *adminv1.UpdateProjectRe
 	if req.GithubUrl != nil {
 		observability.AddRequestAttributes(ctx, attribute.String("args.github_url", *req.GithubUrl))
 	}
+	if req.GitRemote != nil {
+		observability.AddRequestAttributes(ctx, attribute.String("args.git_remote", *req.GitRemote))
+	}
+	if req.ArchiveAssetId != nil {
+		observability.AddRequestAttributes(ctx, attribute.String("args.archive_asset_id", *req.ArchiveAssetId))
+	}
 	if req.Public != nil {
 		observability.AddRequestAttributes(ctx, attribute.Bool("args.public", *req.Public))
 	}
@@ -340,6 +398,12 @@ func (s *Server) UpdateProject(ctx context.Context, req *adminv1.UpdateProjectRe
 	if req.NewName != nil {
 		observability.AddRequestAttributes(ctx, attribute.String("args.new_name", *req.NewName))
 	}
+	if req.PreviewEnabled != nil {
+		observability.AddRequestAttributes(ctx, attribute.Bool("args.preview_enabled", *req.PreviewEnabled))
+	}
+	if req.PreviewTtlSeconds != nil {
+		observability.AddRequestAttributes(ctx, attribute.Int64("args.preview_ttl_seconds", *req.PreviewTtlSeconds))
+	}
 
 	// Check the request is made by a user
 	claims := auth.GetClaims(ctx)
@@ -357,16 +421,62 @@ func (s *Server) UpdateProject(ctx context.Context, req *adminv1.UpdateProjectRe
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to delete project")
 	}
 
+	if req.GithubUrl != nil && req.GitRemote != nil {
+		return nil, status.Error(codes.InvalidArgument, "cannot set both a github url and a git remote")
+	}
+	if req.ArchiveAssetId != nil && (req.GithubUrl != nil || req.GitRemote != nil) {
+		return nil, status.Error(codes.InvalidArgument, "cannot set both an archive and a git repository")
+	}
+
 	githubURL := proj.GithubURL
+	installationID := proj.GithubInstallationID
+	gitRemote := proj.GitRemote
+	gitCredentials := proj.GitCredentials
+	archiveAssetID := proj.ArchiveAssetID
+	if req.ArchiveAssetId != nil {
+		if err := s.checkArchiveAsset(ctx, proj.OrganizationID, *req.ArchiveAssetId); err != nil {
+			return nil, err
+		}
+		// Deploying an archive removes the project's Git repository
+		archiveAssetID = req.ArchiveAssetId
+		githubURL = nil
+		installationID = nil
+		gitRemote = nil
+		gitCredentials = nil
+	}
 	if req.GithubUrl != nil {
 		// If changing the Github URL, check github app is installed and caller has access on the repo
 		if safeStr(proj.GithubURL) != *req.GithubUrl {
-			_, err = s.getAndCheckGithubInstallationID(ctx, *req.GithubUrl, claims.OwnerID())
+			id, err := s.getAndCheckGithubInstallationID(ctx, *req.GithubUrl, claims.OwnerID())
 			if err != nil {
 				return nil, err
 			}
 			githubURL = req.GithubUrl
+			installationID = &id
+		}
+		// Moving the project to Github removes its Git remote or archive
+		gitRemote = nil
+		gitCredentials = nil
+		archiveAssetID = nil
+	}
+	if req.GitRemote != nil {
+		if err := validateGitRemote(*req.GitRemote); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		gitRemote = req.GitRemote
+		githubURL = nil
+		installationID = nil
+		archiveAssetID = nil
+	}
+	if req.GitCredentials != nil {
+		if gitRemote == nil {
+			return nil, status.Error(codes.InvalidArgument, "cannot set git credentials for a project without a git remote")
 		}
+		gitCredentials = gitCredentialsFromDTO(req.GitCredentials)
+	}
+
+	if req.PreviewTtlSeconds != nil && *req.PreviewTtlSeconds <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "preview TTL must be positive")
 	}
 
 	prodTTLSeconds := proj.ProdTTLSeconds
@@ -383,7 +493,11 @@ func (s *Server) UpdateProject(ctx context.Context, req *adminv1.UpdateProjectRe
 		Description:          valOrDefault(req.Description, proj.Description),
 		Public:               valOrDefault(req.Public, proj.Public),
 		GithubURL:            githubURL,
-		GithubInstallationID: proj.GithubInstallationID,
+		GithubInstallationID: installationID,
+		GitRemote:            gitRemote,
+		GitCredentials:       gitCredentials,
+		GitWebhookSecret:     proj.GitWebhookSecret,
+		ArchiveAssetID:       archiveAssetID,
 		ProdBranch:           valOrDefault(req.ProdBranch, proj.ProdBranch),
 		ProdVariables:        proj.ProdVariables,
 		ProdDeploymentID:     proj.ProdDeploymentID,
@@ -391,8 +505,25 @@ func (s *Server) UpdateProject(ctx context.Context, req *adminv1.UpdateProjectRe
 		ProdTTLSeconds:       prodTTLSeconds,
 		Region:               valOrDefault(req.Region, proj.Region),
 	}
-	proj, err = s.admin.UpdateProject(ctx, proj, opts)
+	// The project, its preview settings and the audit event are written in one transaction.
+	// The project's deployments are only updated after it has committed.
+	ev := &auditEvent{project: proj, target: proj.Name, before: s.projToDTO(proj, req.OrganizationName)}
+	_, err = s.admin.UpdateProject(ctx, proj, opts, func(ctx context.Context, updated *database.Project) error {
+		proj = updated
+		if req.PreviewEnabled != nil || req.PreviewTtlSeconds != nil {
+			proj, err = s.admin.DB.UpdateProjectPreviewSettings(ctx, proj.ID, valOrDefault(req.PreviewEnabled, proj.PreviewEnabled), valOrDefault(req.PreviewTtlSeconds, proj.PreviewTTLSeconds))
+			if err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+		}
+		ev.project = proj
+		ev.after = s.projToDTO(proj, req.OrganizationName)
+		return s.recordAuditEvent(ctx, ev)
+	})
 	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -413,7 +544,7 @@ func (s *Server) GetProjectVariables(ctx context.Context, req *adminv1.GetProjec
 	}
 
 	claims := auth.GetClaims(ctx)
-	if !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ManageProject {
+	if !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ReadProjectVariables {
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to read project variables")
 	}
 
@@ -436,8 +567,20 @@ func (s *Server) UpdateProjectVariables(ctx context.Context, req *adminv1.Update
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to update project variables")
 	}
 
-	proj, err = s.admin.UpdateProjectVariables(ctx, proj, req.Variables)
+	ev := &auditEvent{
+		project: proj,
+		target:  proj.Name,
+		before:  map[string]any{"variables": proj.ProdVariables},
+		after:   map[string]any{"variables": req.Variables, "changed": changedVariables(proj.ProdVariables, req.Variables)},
+	}
+	// The variables and the audit event are written in one transaction; the variables are only pushed to the deployments after it has committed.
+	proj, err = s.admin.UpdateProjectVariables(ctx, proj, req.Variables, func(ctx context.Context, _ *database.Project) error {
+		return s.recordAuditEvent(ctx, ev)
+	})
 	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
 		return nil, status.Errorf(codes.Internal, "variables updated failed with error %s", err.Error())
 	}
 
@@ -583,14 +726,21 @@ func (s *Server) AddProjectMember(ctx context.Context, req *adminv1.AddProjectMe
 		}
 
 		// Invite user to join the project
-		err := s.admin.DB.InsertProjectInvite(ctx, &database.InsertProjectInviteOptions{
-			Email:     req.Email,
-			InviterID: invitedByUserID,
-			ProjectID: proj.ID,
-			RoleID:    role.ID,
+		ev := &auditEvent{org: org, project: proj, target: req.Email, after: map[string]any{"role": role.Name, "pending_signup": true}}
+		err := s.auditTx(ctx, ev, func(ctx context.Context) error {
+			err := s.admin.DB.InsertProjectInvite(ctx, &database.InsertProjectInviteOptions{
+				Email:     req.Email,
+				InviterID: invitedByUserID,
+				ProjectID: proj.ID,
+				RoleID:    role.ID,
+			})
+			if err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+			return nil
 		})
 		if err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
+			return nil, err
 		}
 
 		// Send invitation email
@@ -611,9 +761,15 @@ func (s *Server) AddProjectMember(ctx context.Context, req *adminv1.AddProjectMe
 		}, nil
 	}
 
-	err = s.admin.DB.InsertProjectMemberUser(ctx, proj.ID, user.ID, role.ID)
+	err = s.auditTx(ctx, &auditEvent{org: org, project: proj, target: req.Email, after: map[string]any{"role": role.Name}}, func(ctx context.Context) error {
+		err := s.admin.DB.InsertProjectMemberUser(ctx, proj.ID, user.ID, role.ID)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
 
 	err = s.admin.Email.SendProjectAddition(&email.ProjectAddition{
@@ -662,16 +818,28 @@ func (s *Server) RemoveProjectMember(ctx context.Context, req *adminv1.RemovePro
 			}
 			return nil, status.Error(codes.Internal, err.Error())
 		}
-		err = s.admin.DB.DeleteProjectInvite(ctx, invite.ID)
+		err = s.auditTx(ctx, &auditEvent{project: proj, target: req.Email, before: map[string]any{"pending_signup": true}}, func(ctx context.Context) error {
+			err := s.admin.DB.DeleteProjectInvite(ctx, invite.ID)
+			if err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+			return nil
+		})
 		if err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
+			return nil, err
 		}
 		return &adminv1.RemoveProjectMemberResponse{}, nil
 	}
 
-	err = s.admin.DB.DeleteProjectMemberUser(ctx, proj.ID, user.ID)
+	err = s.auditTx(ctx, &auditEvent{project: proj, target: req.Email}, func(ctx context.Context) error {
+		err := s.admin.DB.DeleteProjectMemberUser(ctx, proj.ID, user.ID)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &adminv1.RemoveProjectMemberResponse{}, nil
@@ -712,16 +880,28 @@ func (s *Server) SetProjectMemberRole(ctx context.Context, req *adminv1.SetProje
 			}
 			return nil, status.Error(codes.Internal, err.Error())
 		}
-		err = s.admin.DB.UpdateProjectInviteRole(ctx, invite.ID, role.ID)
+		err = s.auditTx(ctx, &auditEvent{project: proj, target: req.Email, after: map[string]any{"role": role.Name, "pending_signup": true}}, func(ctx context.Context) error {
+			err := s.admin.DB.UpdateProjectInviteRole(ctx, invite.ID, role.ID)
+			if err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+			return nil
+		})
 		if err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
+			return nil, err
 		}
 		return &adminv1.SetProjectMemberRoleResponse{}, nil
 	}
 
-	err = s.admin.DB.UpdateProjectMemberUserRole(ctx, proj.ID, user.ID, role.ID)
+	err = s.auditTx(ctx, &auditEvent{project: proj, target: req.Email, after: map[string]any{"role": role.Name}}, func(ctx context.Context) error {
+		err := s.admin.DB.UpdateProjectMemberUserRole(ctx, proj.ID, user.ID, role.ID)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &adminv1.SetProjectMemberRoleResponse{}, nil
@@ -764,29 +944,80 @@ func (s *Server) getAndCheckGithubInstallationID(ctx context.Context, githubURL,
 	return installationID, nil
 }
 
+// projToDTO converts a project to its API representation.
+// It omits the project's OLAP DSN since it may contain credentials; callers must set it explicitly for users allowed to read it.
 func (s *Server) projToDTO(p *database.Project, orgName string) *adminv1.Project {
 	frontendURL, _ := url.JoinPath(s.opts.FrontendURL, orgName, p.Name)
 
 	return &adminv1.Project{
-		Id:               p.ID,
-		Name:             p.Name,
-		Description:      p.Description,
-		Public:           p.Public,
-		OrgId:            p.OrganizationID,
-		OrgName:          orgName,
-		Region:           p.Region,
-		ProdOlapDriver:   p.ProdOLAPDriver,
-		ProdOlapDsn:      p.ProdOLAPDSN,
-		ProdSlots:        int64(p.ProdSlots),
-		ProdBranch:       p.ProdBranch,
-		Subpath:          p.Subpath,
-		GithubUrl:        safeStr(p.GithubURL),
-		ProdDeploymentId: safeStr(p.ProdDeploymentID),
-		ProdTtlSeconds:   safeInt64(p.ProdTTLSeconds),
-		FrontendUrl:      frontendURL,
-		CreatedOn:        timestamppb.New(p.CreatedOn),
-		UpdatedOn:        timestamppb.New(p.UpdatedOn),
+		Id:                p.ID,
+		Name:              p.Name,
+		Description:       p.Description,
+		Public:            p.Public,
+		OrgId:             p.OrganizationID,
+		OrgName:           orgName,
+		Region:            p.Region,
+		ProdOlapDriver:    p.ProdOLAPDriver,
+		ProdSlots:         int64(p.ProdSlots),
+		ProdBranch:        p.ProdBranch,
+		Subpath:           p.Subpath,
+		GithubUrl:         safeStr(p.GithubURL),
+		GitRemote:         safeStr(p.GitRemote),
+		ArchiveAssetId:    safeStr(p.ArchiveAssetID),
+		ProdDeploymentId:  safeStr(p.ProdDeploymentID),
+		ProdTtlSeconds:    safeInt64(p.ProdTTLSeconds),
+		PreviewEnabled:    p.PreviewEnabled,
+		PreviewTtlSeconds: p.PreviewTTLSeconds,
+		FrontendUrl:       frontendURL,
+		CreatedOn:         timestamppb.New(p.CreatedOn),
+		UpdatedOn:         timestamppb.New(p.UpdatedOn),
+	}
+}
+
+func gitCredentialsFromDTO(c *adminv1.GitCredentials) *database.GitCredentials {
+	if c == nil {
+		return nil
+	}
+	return &database.GitCredentials{
+		Username:           c.Username,
+		Password:           c.Password,
+		PrivateKey:         c.PrivateKey,
+		PrivateKeyPassword: c.PrivateKeyPassword,
+		KnownHosts:         c.KnownHosts,
+	}
+}
+
+// scpRemoteRegexp matches scp-style Git remotes like "git@example.com:org/repo.git".
+var scpRemoteRegexp = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.-]*@[A-Za-z0-9][A-Za-z0-9.-]*:[^:]`)
+
+// validateGitRemote checks that remote is a network Git remote.
+// Other transports, like local paths and file:// URLs, would let the caller read files on the runtime's host.
+func validateGitRemote(remote string) error {
+	if remote == "" {
+		return errors.New("git remote can't be empty")
+	}
+	if scpRemoteRegexp.MatchString(remote) {
+		return nil
+	}
+	u, err := url.Parse(remote)
+	if err == nil && (u.Scheme == "https" || u.Scheme == "ssh") && u.Host != "" {
+		return nil
+	}
+	return fmt.Errorf("invalid git remote %q: must be an https:// or ssh:// URL or of the form user@host:path", remote)
+}
+
+// validateSubpath checks that subpath is a relative path inside the repository.
+func validateSubpath(subpath string) error {
+	p := strings.ReplaceAll(subpath, "\\", "/")
+	if path.IsAbs(p) {
+		return fmt.Errorf("invalid subpath %q: must be relative", subpath)
+	}
+	for _, elem := range strings.Split(p, "/") {
+		if elem == ".." {
+			return fmt.Errorf("invalid subpath %q: must not contain \"..\"", subpath)
+		}
 	}
+	return nil
 }
 
 func deploymentToDTO(d *database.Deployment) *adminv1.Deployment {
@@ -815,6 +1046,8 @@ func deploymentToDTO(d *database.Deployment) *adminv1.Deployment {
 		RuntimeInstanceId: d.RuntimeInstanceID,
 		Status:            s,
 		Logs:              d.Logs,
+		Preview:           d.Preview,
+		PreviewTtlSeconds: safeInt64(d.PreviewTTLSeconds),
 		CreatedOn:         timestamppb.New(d.CreatedOn),
 		UpdatedOn:         timestamppb.New(d.UpdatedOn),
 	}
@@ -0,0 +1,51 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGitRemote(t *testing.T) {
+	valid := []string{
+		"https://github.com/rilldata/rill.git",
+		"https://user@gitlab.example.com:8443/org/repo",
+		"ssh://git@github.com/rilldata/rill.git",
+		"ssh://git@example.com:2222/srv/repo.git",
+		"git@github.com:rilldata/rill.git",
+		"git@example.com:/srv/repo.git",
+	}
+	for _, remote := range valid {
+		require.NoError(t, validateGitRemote(remote), remote)
+	}
+
+	invalid := []string{
+		"",
+		"file:///etc",
+		"/var/lib/repo.git",
+		"./repo",
+		"../repo",
+		"http://github.com/rilldata/rill.git",
+		"git://github.com/rilldata/rill.git",
+		"ext::sh -c touch% /tmp/pwned",
+		"https:///rill.git",
+		"github.com:rilldata/rill.git",
+		"-oProxyCommand=touch@host:repo",
+		"git@host::repo",
+	}
+	for _, remote := range invalid {
+		require.Error(t, validateGitRemote(remote), remote)
+	}
+}
+
+func TestValidateSubpath(t *testing.T) {
+	valid := []string{"", "project", "nested/project", "./project", "project..v2"}
+	for _, p := range valid {
+		require.NoError(t, validateSubpath(p), p)
+	}
+
+	invalid := []string{"/etc", "..", "../other", "project/../../other", "project/..", `..\other`, `\etc`}
+	for _, p := range invalid {
+		require.Error(t, validateSubpath(p), p)
+	}
+}
@@ -186,6 +186,12 @@ func (s *Server) HTTPHandler(ctx context.Context) (http.Handler, error) {
 	// Add Github-related endpoints (not gRPC handlers, just regular endpoints on /github/*)
 	s.registerGithubEndpoints(mux)
 
+	// Add webhook for projects deployed from a generic Git remote
+	mux.Handle("/git/webhook", otelhttp.WithRouteTag("/git/webhook", http.HandlerFunc(s.gitWebhook)))
+
+	// Add endpoint for uploading archives of projects that are not deployed from a Git repository
+	s.registerArchiveEndpoints(mux)
+
 	// Add temporary internal endpoint for refreshing sources
 	mux.Handle("/internal/projects/trigger-refresh", otelhttp.WithRouteTag("/internal/projects/trigger-refresh", http.HandlerFunc(s.triggerRefreshSourcesInternal)))
 
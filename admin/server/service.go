@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/rilldata/rill/admin"
 	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/admin/server/auth"
 	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
@@ -31,9 +32,18 @@ func (s *Server) CreateService(ctx context.Context, req *adminv1.CreateServiceRe
 		return nil, status.Error(codes.PermissionDenied, "not allowed to create a service")
 	}
 
-	service, err := s.admin.DB.InsertService(ctx, &database.InsertServiceOptions{
-		OrgID: org.ID,
-		Name:  req.Name,
+	var service *database.Service
+	ev := &auditEvent{org: org, target: req.Name}
+	err = s.auditTx(ctx, ev, func(ctx context.Context) error {
+		service, err = s.admin.DB.InsertService(ctx, &database.InsertServiceOptions{
+			OrgID: org.ID,
+			Name:  req.Name,
+		})
+		if err != nil {
+			return err
+		}
+		ev.after = serviceToPB(service, req.OrganizationName)
+		return nil
 	})
 	if err != nil {
 		return nil, err
@@ -98,11 +108,20 @@ func (s *Server) UpdateService(ctx context.Context, req *adminv1.UpdateServiceRe
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	updatedService, err := s.admin.DB.UpdateService(ctx, service.ID, &database.UpdateServiceOptions{
-		Name: valOrDefault(req.NewName, service.Name),
+	var updatedService *database.Service
+	ev := &auditEvent{org: org, target: service.Name, before: serviceToPB(service, req.OrganizationName)}
+	err = s.auditTx(ctx, ev, func(ctx context.Context) error {
+		updatedService, err = s.admin.DB.UpdateService(ctx, service.ID, &database.UpdateServiceOptions{
+			Name: valOrDefault(req.NewName, service.Name),
+		})
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		ev.after = serviceToPB(updatedService, req.OrganizationName)
+		return nil
 	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &adminv1.UpdateServiceResponse{
@@ -132,9 +151,15 @@ func (s *Server) DeleteService(ctx context.Context, req *adminv1.DeleteServiceRe
 		return nil, status.Error(codes.PermissionDenied, "not allowed to delete service")
 	}
 
-	err = s.admin.DB.DeleteService(ctx, service.ID)
+	err = s.auditTx(ctx, &auditEvent{org: org, target: service.Name, before: serviceToPB(service, req.OrganizationName)}, func(ctx context.Context) error {
+		err := s.admin.DB.DeleteService(ctx, service.ID)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &adminv1.DeleteServiceResponse{}, nil
@@ -203,9 +228,19 @@ func (s *Server) IssueServiceAuthToken(ctx context.Context, req *adminv1.IssueSe
 		return nil, status.Error(codes.PermissionDenied, "not allowed to update org")
 	}
 
-	token, err := s.admin.IssueServiceAuthToken(ctx, service.ID, nil)
+	var token admin.AuthToken
+	ev := &auditEvent{org: org, target: service.Name}
+	err = s.auditTx(ctx, ev, func(ctx context.Context) error {
+		token, err = s.admin.IssueServiceAuthToken(ctx, service.ID, nil)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		// Only the token's ID is recorded, never its secret
+		ev.after = map[string]any{"id": token.Token().ID.String()}
+		return nil
+	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &adminv1.IssueServiceAuthTokenResponse{
@@ -239,9 +274,16 @@ func (s *Server) RevokeServiceAuthToken(ctx context.Context, req *adminv1.Revoke
 		return nil, status.Error(codes.PermissionDenied, "not allowed to revoke auth token")
 	}
 
-	err = s.admin.DB.DeleteServiceAuthToken(ctx, token.ID)
+	ev := &auditEvent{org: org, target: service.Name, before: map[string]any{"id": token.ID}}
+	err = s.auditTx(ctx, ev, func(ctx context.Context) error {
+		err := s.admin.DB.DeleteServiceAuthToken(ctx, token.ID)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &adminv1.RevokeServiceAuthTokenResponse{}, nil
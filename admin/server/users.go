@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/rilldata/rill/admin"
 	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/admin/server/auth"
 	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
@@ -56,9 +57,16 @@ func (s *Server) SetSuperuser(ctx context.Context, req *adminv1.SetSuperuserRequ
 		return nil, err
 	}
 
-	err = s.admin.DB.UpdateSuperuser(ctx, user.ID, req.Superuser)
+	ev := &auditEvent{target: user.Email, before: map[string]any{"superuser": user.Superuser}, after: map[string]any{"superuser": req.Superuser}}
+	err = s.auditTx(ctx, ev, func(ctx context.Context) error {
+		err := s.admin.DB.UpdateSuperuser(ctx, user.ID, req.Superuser)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &adminv1.SetSuperuserResponse{}, nil
@@ -147,12 +155,21 @@ func (s *Server) UpdateUserPreferences(ctx context.Context, req *adminv1.UpdateU
 	}
 
 	// Update user quota here
-	updatedUser, err := s.admin.DB.UpdateUser(ctx, user.ID, &database.UpdateUserOptions{
-		DisplayName:         user.DisplayName,
-		PhotoURL:            user.PhotoURL,
-		GithubUsername:      user.GithubUsername,
-		QuotaSingleuserOrgs: user.QuotaSingleuserOrgs,
-		PreferenceTimeZone:  valOrDefault(req.Preferences.TimeZone, user.PreferenceTimeZone),
+	var updatedUser *database.User
+	ev := &auditEvent{target: user.Email, before: map[string]any{"time_zone": user.PreferenceTimeZone}}
+	err = s.auditTx(ctx, ev, func(ctx context.Context) error {
+		updatedUser, err = s.admin.DB.UpdateUser(ctx, user.ID, &database.UpdateUserOptions{
+			DisplayName:         user.DisplayName,
+			PhotoURL:            user.PhotoURL,
+			GithubUsername:      user.GithubUsername,
+			QuotaSingleuserOrgs: user.QuotaSingleuserOrgs,
+			PreferenceTimeZone:  valOrDefault(req.Preferences.TimeZone, user.PreferenceTimeZone),
+		})
+		if err != nil {
+			return err
+		}
+		ev.after = map[string]any{"time_zone": updatedUser.PreferenceTimeZone}
+		return nil
 	})
 	if err != nil {
 		return nil, err
@@ -190,7 +207,12 @@ func (s *Server) IssueRepresentativeAuthToken(ctx context.Context, req *adminv1.
 	ttl := time.Duration(req.TtlMinutes) * time.Minute
 	displayName := fmt.Sprintf("Support for %s", u.Email)
 
-	token, err := s.admin.IssueUserAuthToken(ctx, claims.OwnerID(), database.AuthClientIDRillSupport, displayName, &u.ID, &ttl)
+	var token admin.AuthToken
+	ev := &auditEvent{target: u.Email, after: map[string]any{"ttl_minutes": req.TtlMinutes}}
+	err = s.auditTx(ctx, ev, func(ctx context.Context) error {
+		token, err = s.admin.IssueUserAuthToken(ctx, claims.OwnerID(), database.AuthClientIDRillSupport, displayName, &u.ID, &ttl)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -213,7 +235,9 @@ func (s *Server) RevokeCurrentAuthToken(ctx context.Context, req *adminv1.Revoke
 	}
 	tokenID := claims.AuthTokenID()
 
-	err := s.admin.DB.DeleteUserAuthToken(ctx, tokenID)
+	err := s.auditTx(ctx, &auditEvent{target: tokenID}, func(ctx context.Context) error {
+		return s.admin.DB.DeleteUserAuthToken(ctx, tokenID)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -252,7 +276,9 @@ func (s *Server) SudoGetResource(ctx context.Context, req *adminv1.SudoGetResour
 		if err != nil {
 			return nil, err
 		}
-		res.Resource = &adminv1.SudoGetResourceResponse_Project{Project: s.projToDTO(proj, org.Name)}
+		projDTO := s.projToDTO(proj, org.Name)
+		projDTO.ProdOlapDsn = proj.ProdOLAPDSN
+		res.Resource = &adminv1.SudoGetResourceResponse_Project{Project: projDTO}
 	case *adminv1.SudoGetResourceRequest_DeploymentId:
 		depl, err := s.admin.DB.FindDeployment(ctx, id.DeploymentId)
 		if err != nil {
@@ -303,12 +329,21 @@ func (s *Server) SudoUpdateUserQuotas(ctx context.Context, req *adminv1.SudoUpda
 	}
 
 	// Update user quota here
-	updatedUser, err := s.admin.DB.UpdateUser(ctx, user.ID, &database.UpdateUserOptions{
-		DisplayName:         user.DisplayName,
-		PhotoURL:            user.PhotoURL,
-		GithubUsername:      user.GithubUsername,
-		QuotaSingleuserOrgs: int(valOrDefault(req.SingleuserOrgs, uint32(user.QuotaSingleuserOrgs))),
-		PreferenceTimeZone:  user.PreferenceTimeZone,
+	var updatedUser *database.User
+	ev := &auditEvent{target: user.Email, before: map[string]any{"singleuser_orgs": user.QuotaSingleuserOrgs}}
+	err = s.auditTx(ctx, ev, func(ctx context.Context) error {
+		updatedUser, err = s.admin.DB.UpdateUser(ctx, user.ID, &database.UpdateUserOptions{
+			DisplayName:         user.DisplayName,
+			PhotoURL:            user.PhotoURL,
+			GithubUsername:      user.GithubUsername,
+			QuotaSingleuserOrgs: int(valOrDefault(req.SingleuserOrgs, uint32(user.QuotaSingleuserOrgs))),
+			PreferenceTimeZone:  user.PreferenceTimeZone,
+		})
+		if err != nil {
+			return err
+		}
+		ev.after = map[string]any{"singleuser_orgs": updatedUser.QuotaSingleuserOrgs}
+		return nil
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,7 @@
+package worker
+
+import "context"
+
+func (w *Worker) syncGitRemotes(ctx context.Context) error {
+	return w.admin.SyncGitRemotes(ctx)
+}
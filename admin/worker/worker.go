@@ -47,6 +47,10 @@ func (w *Worker) Run(ctx context.Context) error {
 		return w.schedule(ctx, "hibernate_expired_deployments", w.hibernateExpiredDeployments, 15*time.Minute)
 	})
 
+	group.Go(func() error {
+		return w.schedule(ctx, "sync_git_remotes", w.syncGitRemotes, 2*time.Minute)
+	})
+
 	// NOTE: Add new scheduled jobs here
 
 	w.logger.Info("worker started")
@@ -60,6 +64,8 @@ func (w *Worker) RunJob(ctx context.Context, name string) error {
 		return w.runJob(ctx, name, w.checkSlots)
 	case "reset_all_deployments":
 		return w.runJob(ctx, name, w.resetAllDeployments)
+	case "sync_git_remotes":
+		return w.runJob(ctx, name, w.syncGitRemotes)
 	// NOTE: Add new ad-hoc jobs here
 	default:
 		return fmt.Errorf("unknown job: %s", name)
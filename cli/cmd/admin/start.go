@@ -32,38 +32,40 @@ import (
 // Env var keys must be prefixed with RILL_ADMIN_ and are converted from snake_case to CamelCase.
 // For example RILL_ADMIN_HTTP_PORT is mapped to Config.HTTPPort.
 type Config struct {
-	DatabaseDriver         string                 `default:"postgres" split_words:"true"`
-	DatabaseURL            string                 `split_words:"true"`
-	Jobs                   []string               `split_words:"true"`
-	HTTPPort               int                    `default:"8080" split_words:"true"`
-	GRPCPort               int                    `default:"9090" split_words:"true"`
-	LogLevel               zapcore.Level          `default:"info" split_words:"true"`
-	MetricsExporter        observability.Exporter `default:"prometheus" split_words:"true"`
-	TracesExporter         observability.Exporter `default:"" split_words:"true"`
-	ExternalURL            string                 `default:"http://localhost:8080" split_words:"true"`
-	FrontendURL            string                 `default:"http://localhost:3000" split_words:"true"`
-	SessionKeyPairs        []string               `split_words:"true"`
-	AllowedOrigins         []string               `default:"*" split_words:"true"`
-	AuthDomain             string                 `split_words:"true"`
-	AuthClientID           string                 `split_words:"true"`
-	AuthClientSecret       string                 `split_words:"true"`
-	GithubAppID            int64                  `split_words:"true"`
-	GithubAppName          string                 `split_words:"true"`
-	GithubAppPrivateKey    string                 `split_words:"true"`
-	GithubAppWebhookSecret string                 `split_words:"true"`
-	GithubClientID         string                 `split_words:"true"`
-	GithubClientSecret     string                 `split_words:"true"`
-	ProvisionerSpec        string                 `split_words:"true"`
-	SigningJWKS            string                 `split_words:"true"`
-	SigningKeyID           string                 `split_words:"true"`
-	EmailSMTPHost          string                 `split_words:"true"`
-	EmailSMTPPort          int                    `split_words:"true"`
-	EmailSMTPUsername      string                 `split_words:"true"`
-	EmailSMTPPassword      string                 `split_words:"true"`
-	EmailSenderEmail       string                 `split_words:"true"`
-	EmailSenderName        string                 `split_words:"true"`
-	EmailBCC               string                 `split_words:"true"`
-	RedisURL               string                 `default:"" split_words:"true"`
+	DatabaseDriver            string                 `default:"postgres" split_words:"true"`
+	DatabaseURL               string                 `split_words:"true"`
+	DatabaseEncryptionKeyring string                 `split_words:"true"`
+	Jobs                      []string               `split_words:"true"`
+	HTTPPort                  int                    `default:"8080" split_words:"true"`
+	GRPCPort                  int                    `default:"9090" split_words:"true"`
+	LogLevel                  zapcore.Level          `default:"info" split_words:"true"`
+	MetricsExporter           observability.Exporter `default:"prometheus" split_words:"true"`
+	TracesExporter            observability.Exporter `default:"" split_words:"true"`
+	ExternalURL               string                 `default:"http://localhost:8080" split_words:"true"`
+	FrontendURL               string                 `default:"http://localhost:3000" split_words:"true"`
+	SessionKeyPairs           []string               `split_words:"true"`
+	AllowedOrigins            []string               `default:"*" split_words:"true"`
+	AuthDomain                string                 `split_words:"true"`
+	AuthClientID              string                 `split_words:"true"`
+	AuthClientSecret          string                 `split_words:"true"`
+	GithubAppID               int64                  `split_words:"true"`
+	GithubAppName             string                 `split_words:"true"`
+	GithubAppPrivateKey       string                 `split_words:"true"`
+	GithubAppWebhookSecret    string                 `split_words:"true"`
+	GithubClientID            string                 `split_words:"true"`
+	GithubClientSecret        string                 `split_words:"true"`
+	ProvisionerSpec           string                 `split_words:"true"`
+	ArchiveBucketURL          string                 `split_words:"true"`
+	SigningJWKS               string                 `split_words:"true"`
+	SigningKeyID              string                 `split_words:"true"`
+	EmailSMTPHost             string                 `split_words:"true"`
+	EmailSMTPPort             int                    `split_words:"true"`
+	EmailSMTPUsername         string                 `split_words:"true"`
+	EmailSMTPPassword         string                 `split_words:"true"`
+	EmailSenderEmail          string                 `split_words:"true"`
+	EmailSenderName           string                 `split_words:"true"`
+	EmailBCC                  string                 `split_words:"true"`
+	RedisURL                  string                 `default:"" split_words:"true"`
 }
 
 // StartCmd starts an admin server. It only allows configuration using environment variables.
@@ -147,9 +149,11 @@ func StartCmd(cliCfg *config.Config) *cobra.Command {
 
 			// Init admin service
 			admOpts := &admin.Options{
-				DatabaseDriver:  conf.DatabaseDriver,
-				DatabaseDSN:     conf.DatabaseURL,
-				ProvisionerSpec: conf.ProvisionerSpec,
+				DatabaseDriver:            conf.DatabaseDriver,
+				DatabaseDSN:               conf.DatabaseURL,
+				DatabaseEncryptionKeyring: conf.DatabaseEncryptionKeyring,
+				ProvisionerSpec:           conf.ProvisionerSpec,
+				ArchiveBucketURL:          conf.ArchiveBucketURL,
 			}
 			adm, err := admin.New(cmd.Context(), admOpts, logger, issuer, emailClient, gh)
 			if err != nil {
@@ -39,8 +39,9 @@ const (
 // DeployCmd is the guided tour for deploying rill projects to rill cloud.
 func DeployCmd(cfg *config.Config) *cobra.Command {
 	var description, projectPath, subPath, region, dbDriver, dbDSN, prodBranch, name, remote, orgName string
+	var gitRemote, gitUsername, gitPassword, gitPrivateKeyFile, gitKnownHostsFile string
 	var slots int
-	var public bool
+	var public, upload bool
 
 	deployCmd := &cobra.Command{
 		Use:   "deploy",
@@ -101,6 +102,48 @@ func DeployCmd(cfg *config.Config) *cobra.Command {
 				return nil
 			}
 
+			// Deploy an archive of the project files instead of a Git repository
+			if upload {
+				if orgName != "" {
+					cfg.Org = orgName
+				}
+				return uploadFlow(ctx, cfg, tel, fullProjectPath, &adminv1.CreateProjectRequest{
+					OrganizationName: cfg.Org,
+					Name:             name,
+					Description:      description,
+					Region:           region,
+					ProdOlapDriver:   dbDriver,
+					ProdOlapDsn:      dbDSN,
+					ProdSlots:        int64(slots),
+					Public:           public,
+				})
+			}
+
+			// Deploy from a Git remote not hosted on Github
+			if gitRemote != "" {
+				if orgName != "" {
+					cfg.Org = orgName
+				}
+				creds, err := gitCredentialsFromFlags(gitUsername, gitPassword, gitPrivateKeyFile, gitKnownHostsFile)
+				if err != nil {
+					return err
+				}
+				return gitRemoteFlow(ctx, cfg, tel, fullProjectPath, &adminv1.CreateProjectRequest{
+					OrganizationName: cfg.Org,
+					Name:             name,
+					Description:      description,
+					Region:           region,
+					ProdOlapDriver:   dbDriver,
+					ProdOlapDsn:      dbDSN,
+					ProdSlots:        int64(slots),
+					Subpath:          subPath,
+					ProdBranch:       prodBranch,
+					Public:           public,
+					GitRemote:        gitRemote,
+					GitCredentials:   creds,
+				})
+			}
+
 			// Verify projectPath is a Git repo with remote on Github
 			remote, githubURL, err := gitutil.ExtractGitRemote(projectPath, remote)
 			if err != nil {
@@ -170,42 +213,9 @@ func DeployCmd(cfg *config.Config) *cobra.Command {
 				cfg.Org = orgName
 			}
 
-			// Set a default org for the user if necessary
-			// (If user is not in an org, we'll create one based on their Github account later in the flow.)
-			if cfg.Org == "" {
-				res, err := client.ListOrganizations(context.Background(), &adminv1.ListOrganizationsRequest{})
-				if err != nil {
-					return fmt.Errorf("listing orgs failed: %w", err)
-				}
-
-				if len(res.Organizations) == 1 {
-					cfg.Org = res.Organizations[0].Name
-					if err := dotrill.SetDefaultOrg(cfg.Org); err != nil {
-						return err
-					}
-				} else if len(res.Organizations) > 1 {
-					orgName, err := org.SwitchSelectFlow(res.Organizations)
-					if err != nil {
-						return fmt.Errorf("org selection failed %w", err)
-					}
-
-					cfg.Org = orgName
-					if err := dotrill.SetDefaultOrg(cfg.Org); err != nil {
-						return err
-					}
-				}
-			}
-
-			// If no default org is set by now, it means the user is not in an org yet.
-			// We create a default org based on their Github account name.
-			if cfg.Org == "" {
-				err := createOrgFlow(ctx, cfg, client, ghAccount)
-				if err != nil {
-					return fmt.Errorf("org creation failed with error: %w", err)
-				}
-				success.Printf("Created org %q. Run `rill org edit` to change name if required.\n", cfg.Org)
-			} else {
-				info.Printf("Using org %q.\n", cfg.Org)
+			// Select an org for the user or create one based on their Github account
+			if err := selectOrgFlow(ctx, cfg, client, ghAccount); err != nil {
+				return err
 			}
 
 			nameExist := false
@@ -290,6 +300,12 @@ func DeployCmd(cfg *config.Config) *cobra.Command {
 	deployCmd.Flags().StringVar(&prodBranch, "prod-branch", "", "Git branch to deploy from (default: the default Git branch)")
 	deployCmd.Flags().StringVar(&name, "project", "", "Project name (default: Git repo name)")
 	deployCmd.Flags().StringVar(&remote, "remote", "", "Remote name (defaults: first github remote)")
+	deployCmd.Flags().BoolVar(&upload, "upload", false, "Upload the project files instead of deploying from a Git repository")
+	deployCmd.Flags().StringVar(&gitRemote, "git-remote", "", "URL of a Git remote not hosted on Github to deploy from (HTTPS or SSH)")
+	deployCmd.Flags().StringVar(&gitUsername, "git-username", "", "Username for the Git remote")
+	deployCmd.Flags().StringVar(&gitPassword, "git-password", "", "Password or access token for the Git remote")
+	deployCmd.Flags().StringVar(&gitPrivateKeyFile, "git-private-key-file", "", "Path to an SSH deploy key for the Git remote")
+	deployCmd.Flags().StringVar(&gitKnownHostsFile, "git-known-hosts-file", "", "Path to a known_hosts file with the SSH host keys of the Git remote")
 	if !cfg.IsDev() {
 		if err := deployCmd.Flags().MarkHidden("prod-slots"); err != nil {
 			panic(err)
@@ -389,6 +405,50 @@ func githubFlow(ctx context.Context, c *adminclient.Client, githubURL string, si
 	return res, nil
 }
 
+// selectOrgFlow sets a default org for the user if necessary.
+// If the user is not in an org yet, it creates one named after defaultName.
+func selectOrgFlow(ctx context.Context, cfg *config.Config, client *adminclient.Client, defaultName string) error {
+	info := color.New(color.Bold).Add(color.FgWhite)
+	success := color.New(color.Bold).Add(color.FgGreen)
+
+	if cfg.Org == "" {
+		res, err := client.ListOrganizations(ctx, &adminv1.ListOrganizationsRequest{})
+		if err != nil {
+			return fmt.Errorf("listing orgs failed: %w", err)
+		}
+
+		if len(res.Organizations) == 1 {
+			cfg.Org = res.Organizations[0].Name
+			if err := dotrill.SetDefaultOrg(cfg.Org); err != nil {
+				return err
+			}
+		} else if len(res.Organizations) > 1 {
+			orgName, err := org.SwitchSelectFlow(res.Organizations)
+			if err != nil {
+				return fmt.Errorf("org selection failed %w", err)
+			}
+
+			cfg.Org = orgName
+			if err := dotrill.SetDefaultOrg(cfg.Org); err != nil {
+				return err
+			}
+		}
+	}
+
+	// If no default org is set by now, it means the user is not in an org yet.
+	if cfg.Org == "" {
+		err := createOrgFlow(ctx, cfg, client, defaultName)
+		if err != nil {
+			return fmt.Errorf("org creation failed with error: %w", err)
+		}
+		success.Printf("Created org %q. Run `rill org edit` to change name if required.\n", cfg.Org)
+	} else {
+		info.Printf("Using org %q.\n", cfg.Org)
+	}
+
+	return nil
+}
+
 func createOrgFlow(ctx context.Context, cfg *config.Config, client *adminclient.Client, defaultName string) error {
 	warn := color.New(color.Bold).Add(color.FgYellow)
 	res, err := client.CreateOrganization(ctx, &adminv1.CreateOrganizationRequest{
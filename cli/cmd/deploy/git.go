@@ -0,0 +1,156 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/rilldata/rill/admin/pkg/urlutil"
+	"github.com/rilldata/rill/cli/pkg/browser"
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	"github.com/rilldata/rill/cli/pkg/telemetry"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/fileutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// gitRemoteFlow deploys a project from a Git remote that is not hosted on Github (such as GitLab or Gitea).
+// Unlike the Github flow, it doesn't require an app installation; the admin service polls the remote for changes
+// and also redeploys when the remote calls the project's webhook.
+func gitRemoteFlow(ctx context.Context, cfg *config.Config, tel *telemetry.Telemetry, projectPath string, req *adminv1.CreateProjectRequest) error {
+	info := color.New(color.Bold).Add(color.FgWhite)
+	success := color.New(color.Bold).Add(color.FgGreen)
+	errorWriter := color.New(color.Bold).Add(color.FgRed)
+
+	account, repo, err := splitGitRemote(req.GitRemote)
+	if err != nil {
+		return err
+	}
+
+	if req.ProdBranch == "" {
+		return fmt.Errorf("--prod-branch must be set when deploying from --git-remote")
+	}
+
+	if !cfg.IsAuthenticated() {
+		if err := loginWithTelemetry(ctx, cfg, "", tel); err != nil {
+			return err
+		}
+	}
+
+	client, err := cmdutil.Client(cfg)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	// Select an org for the user or create one based on the remote's account name
+	if err := selectOrgFlow(ctx, cfg, client, account); err != nil {
+		return err
+	}
+	req.OrganizationName = cfg.Org
+
+	// If no project name was provided, default to Git repo name
+	if req.Name == "" {
+		req.Name = repo
+	}
+
+	// Create the project (automatically deploys prod branch)
+	res, err := createProjectFlow(ctx, client, req)
+	if err != nil {
+		if s, ok := status.FromError(err); ok && s.Code() == codes.PermissionDenied {
+			errorWriter.Printf("You do not have the permissions needed to create a project in org %q. Please reach out to your Rill admin.\n", cfg.Org)
+			return nil
+		}
+		return fmt.Errorf("create project failed with error %w", err)
+	}
+
+	webhookURL, err := urlutil.WithQuery(urlutil.MustJoinURL(adminHTTPURL(cfg), "/git/webhook"), map[string]string{
+		"organization": cfg.Org,
+		"project":      res.Project.Name,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Success!
+	success.Printf("Created project \"%s/%s\". Use `rill project rename` to change name if required.\n\n", cfg.Org, res.Project.Name)
+	success.Printf("Rill checks %q for new commits every few minutes.\n", req.GitRemote)
+	info.Printf("To deploy immediately when you push changes, add a push webhook to your repository:\n\n")
+	info.Printf("\tURL:    %s\n", webhookURL)
+	info.Printf("\tSecret: %s\n\n", res.Project.GitWebhookSecret)
+
+	// Run flow to check connector credentials
+	variablesFlow(ctx, projectPath, res.Project.Name)
+	if res.Project.FrontendUrl != "" {
+		success.Printf("Your project can be accessed at: %s\n", res.Project.FrontendUrl)
+		success.Printf("Opening project in browser...\n")
+		time.Sleep(3 * time.Second)
+		_ = browser.Open(res.Project.FrontendUrl)
+	}
+
+	tel.Emit(telemetry.ActionDeploySuccess)
+	return nil
+}
+
+// gitCredentialsFromFlags builds the credentials for a Git remote from the deploy command's flags.
+// It returns nil if no credentials were provided.
+func gitCredentialsFromFlags(username, password, privateKeyFile, knownHostsFile string) (*adminv1.GitCredentials, error) {
+	creds := &adminv1.GitCredentials{
+		Username: username,
+		Password: password,
+	}
+
+	if privateKeyFile != "" {
+		privateKeyFile, err := fileutil.ExpandHome(privateKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		data, err := os.ReadFile(privateKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read private key: %w", err)
+		}
+		creds.PrivateKey = string(data)
+	}
+
+	if knownHostsFile != "" {
+		knownHostsFile, err := fileutil.ExpandHome(knownHostsFile)
+		if err != nil {
+			return nil, err
+		}
+		data, err := os.ReadFile(knownHostsFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read known hosts: %w", err)
+		}
+		creds.KnownHosts = string(data)
+	}
+
+	if creds.Username == "" && creds.Password == "" && creds.PrivateKey == "" && creds.KnownHosts == "" {
+		return nil, nil
+	}
+	return creds, nil
+}
+
+// splitGitRemote extracts the account and repository names from a Git remote URL.
+// For nested groups (such as GitLab subgroups), the account is the top-level group.
+func splitGitRemote(remote string) (account, repo string, err error) {
+	ep, err := transport.NewEndpoint(remote)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid git remote %q: %w", remote, err)
+	}
+
+	p := strings.Trim(ep.Path, "/")
+	repo = fileutil.Stem(path.Base(p))
+	account, _, _ = strings.Cut(p, "/")
+	if repo == "" || account == "" || account == p {
+		return "", "", fmt.Errorf("invalid git remote %q: must have the form <host>/<account>/<repo>", remote)
+	}
+
+	return account, repo, nil
+}
@@ -0,0 +1,183 @@
+package deploy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	adminclient "github.com/rilldata/rill/admin/client"
+	"github.com/rilldata/rill/admin/pkg/urlutil"
+	"github.com/rilldata/rill/cli/pkg/browser"
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	"github.com/rilldata/rill/cli/pkg/telemetry"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/archiveutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// uploadFlow deploys a project that is not in a Git repository by uploading an archive of the project directory.
+// Files ignored by .gitignore are not uploaded. If the project already exists, the new archive is deployed to it.
+func uploadFlow(ctx context.Context, cfg *config.Config, tel *telemetry.Telemetry, projectPath string, req *adminv1.CreateProjectRequest) error {
+	info := color.New(color.Bold).Add(color.FgWhite)
+	success := color.New(color.Bold).Add(color.FgGreen)
+	errorWriter := color.New(color.Bold).Add(color.FgRed)
+
+	absPath, err := filepath.Abs(projectPath)
+	if err != nil {
+		return err
+	}
+
+	if !cfg.IsAuthenticated() {
+		if err := loginWithTelemetry(ctx, cfg, "", tel); err != nil {
+			return err
+		}
+	}
+
+	client, err := cmdutil.Client(cfg)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	// Select an org for the user or create one named after the project directory
+	if err := selectOrgFlow(ctx, cfg, client, filepath.Base(absPath)); err != nil {
+		return err
+	}
+	req.OrganizationName = cfg.Org
+
+	// If no project name was provided, default to the project directory's name
+	if req.Name == "" {
+		req.Name = filepath.Base(absPath)
+	}
+
+	info.Printf("Uploading project files in %q...\n", absPath)
+	assetID, err := uploadArchive(ctx, cfg, absPath, req.Name)
+	if err != nil {
+		return fmt.Errorf("upload failed: %w", err)
+	}
+
+	// Deploy the archive to the existing project if there is one
+	exists, err := projectExists(ctx, client, cfg.Org, req.Name)
+	if err != nil {
+		return err
+	}
+	if exists {
+		_, err := client.UpdateProject(ctx, &adminv1.UpdateProjectRequest{
+			OrganizationName: cfg.Org,
+			Name:             req.Name,
+			ArchiveAssetId:   &assetID,
+		})
+		if err != nil {
+			return fmt.Errorf("update project failed with error %w", err)
+		}
+		success.Printf("Deployed the uploaded files to project \"%s/%s\".\n", cfg.Org, req.Name)
+		tel.Emit(telemetry.ActionDeploySuccess)
+		return nil
+	}
+
+	// Create the project (automatically deploys the archive)
+	req.ArchiveAssetId = assetID
+	res, err := createProjectFlow(ctx, client, req)
+	if err != nil {
+		if s, ok := status.FromError(err); ok && s.Code() == codes.PermissionDenied {
+			errorWriter.Printf("You do not have the permissions needed to create a project in org %q. Please reach out to your Rill admin.\n", cfg.Org)
+			return nil
+		}
+		return fmt.Errorf("create project failed with error %w", err)
+	}
+
+	// Success!
+	success.Printf("Created project \"%s/%s\". Use `rill project rename` to change name if required.\n\n", cfg.Org, res.Project.Name)
+	success.Printf("Run `rill deploy --upload` again to deploy changes to the project files.\n")
+	// Run flow to check connector credentials
+	variablesFlow(ctx, projectPath, res.Project.Name)
+	if res.Project.FrontendUrl != "" {
+		success.Printf("Your project can be accessed at: %s\n", res.Project.FrontendUrl)
+		success.Printf("Opening project in browser...\n")
+		time.Sleep(3 * time.Second)
+		_ = browser.Open(res.Project.FrontendUrl)
+	}
+
+	tel.Emit(telemetry.ActionDeploySuccess)
+	return nil
+}
+
+// uploadArchive uploads a gzipped tarball of the project directory to the admin service and returns the ID of the uploaded asset.
+func uploadArchive(ctx context.Context, cfg *config.Config, projectPath, projectName string) (string, error) {
+	f, err := os.CreateTemp("", "rill_project_*.tar.gz")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	if err := archiveutil.Create(f, projectPath); err != nil {
+		return "", fmt.Errorf("failed to archive project: %w", err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	uploadURL, err := urlutil.WithQuery(urlutil.MustJoinURL(adminHTTPURL(cfg), "/archives/upload"), map[string]string{
+		"organization": cfg.Org,
+		"project":      projectName,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, uploadURL, f)
+	if err != nil {
+		return "", err
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+cfg.AdminToken())
+	httpReq.Header.Set("Content-Type", "application/gzip")
+
+	res, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return "", fmt.Errorf("%s: %s", res.Status, strings.TrimSpace(string(msg)))
+	}
+
+	var body struct {
+		AssetID string `json:"asset_id"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return "", err
+	}
+	return body.AssetID, nil
+}
+
+func projectExists(ctx context.Context, client *adminclient.Client, orgName, projectName string) (bool, error) {
+	_, err := client.GetProject(ctx, &adminv1.GetProjectRequest{OrganizationName: orgName, Name: projectName})
+	if err != nil {
+		if s, ok := status.FromError(err); ok && s.Code() == codes.NotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// adminHTTPURL returns the URL of the admin service's HTTP endpoints.
+// In development, the admin service serves gRPC and HTTP on different ports.
+func adminHTTPURL(cfg *config.Config) string {
+	if strings.Contains(cfg.AdminURL, "http://localhost:9090") {
+		return "http://localhost:8080"
+	}
+	return cfg.AdminURL
+}
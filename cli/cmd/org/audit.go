@@ -0,0 +1,115 @@
+package org
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func AuditCmd(cfg *config.Config) *cobra.Command {
+	var name string
+	var project string
+	var action string
+	var actorEmail string
+	var showDiff bool
+	var pageSize uint32
+	var pageToken string
+
+	auditCmd := &cobra.Command{
+		Use:   "audit [<org-name>]",
+		Short: "List the audit log of administrative actions in an organization",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				name = args[0]
+			}
+			if name == "" {
+				name = cfg.Org
+			}
+			if name == "" {
+				return fmt.Errorf("please specify an organization with --org")
+			}
+
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			res, err := client.ListAuditEvents(cmd.Context(), &adminv1.ListAuditEventsRequest{
+				Organization: name,
+				Project:      project,
+				Action:       action,
+				ActorEmail:   actorEmail,
+				PageSize:     pageSize,
+				PageToken:    pageToken,
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(res.Events) == 0 {
+				cmdutil.PrintlnWarn("No audit events found")
+				return nil
+			}
+
+			cmdutil.PrintlnSuccess("Audit events")
+			cmdutil.TablePrinter(toAuditTable(res.Events, showDiff))
+			if res.NextPageToken != "" {
+				cmd.Println()
+				cmd.Printf("Next page token: %s\n", res.NextPageToken)
+			}
+			return nil
+		},
+	}
+
+	auditCmd.Flags().StringVar(&name, "org", cfg.Org, "Organization Name")
+	auditCmd.Flags().StringVar(&project, "project", "", "Only show events for this project")
+	auditCmd.Flags().StringVar(&action, "action", "", "Only show events for this action (e.g. UpdateProjectVariables)")
+	auditCmd.Flags().StringVar(&actorEmail, "actor", "", "Only show events by the user with this email")
+	auditCmd.Flags().BoolVar(&showDiff, "diff", false, "Show the state before and after each action")
+	auditCmd.Flags().Uint32Var(&pageSize, "page-size", 50, "Number of events to return per page")
+	auditCmd.Flags().StringVar(&pageToken, "page-token", "", "Pagination token")
+
+	return auditCmd
+}
+
+func toAuditTable(events []*adminv1.AuditEvent, showDiff bool) []*auditEvent {
+	res := make([]*auditEvent, 0, len(events))
+
+	for _, e := range events {
+		actor := e.ActorEmail
+		if actor == "" {
+			actor = fmt.Sprintf("%s:%s", e.ActorType, e.ActorId)
+		}
+
+		row := &auditEvent{
+			CreatedOn: e.CreatedOn.AsTime().Format(cmdutil.TSFormatLayout),
+			Actor:     actor,
+			Action:    e.Action,
+			Project:   e.Project,
+			Target:    e.Target,
+		}
+		if showDiff && e.Diff != nil {
+			diff, err := e.Diff.MarshalJSON()
+			if err == nil {
+				row.Diff = string(diff)
+			}
+		}
+		res = append(res, row)
+	}
+
+	return res
+}
+
+type auditEvent struct {
+	CreatedOn string `header:"time,timestamp(ms|utc|human)" json:"time"`
+	Actor     string `header:"actor" json:"actor"`
+	Action    string `header:"action" json:"action"`
+	Project   string `header:"project" json:"project"`
+	Target    string `header:"target" json:"target"`
+	Diff      string `header:"diff" json:"diff,omitempty"`
+}
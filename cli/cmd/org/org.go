@@ -21,6 +21,7 @@ func OrgCmd(cfg *config.Config) *cobra.Command {
 	orgCmd.AddCommand(ListCmd(cfg))
 	orgCmd.AddCommand(DeleteCmd(cfg))
 	orgCmd.AddCommand(RenameCmd(cfg))
+	orgCmd.AddCommand(AuditCmd(cfg))
 
 	return orgCmd
 }
@@ -11,9 +11,9 @@ import (
 
 func EditCmd(cfg *config.Config) *cobra.Command {
 	var name, description, prodBranch, path, region string
-	var public bool
+	var public, previewEnabled bool
 	var slots int
-	var prodTTL int64
+	var prodTTL, previewTTL int64
 
 	editCmd := &cobra.Command{
 		Use:   "edit [<project-name>]",
@@ -77,6 +77,16 @@ func EditCmd(cfg *config.Config) *cobra.Command {
 				req.ProdTtlSeconds = &prodTTL
 			}
 
+			if cmd.Flags().Changed("preview") {
+				promptFlagValues = false
+				req.PreviewEnabled = &previewEnabled
+			}
+
+			if cmd.Flags().Changed("preview-ttl-seconds") {
+				promptFlagValues = false
+				req.PreviewTtlSeconds = &previewTTL
+			}
+
 			if promptFlagValues {
 				resp, err := client.GetProject(ctx, &adminv1.GetProjectRequest{OrganizationName: cfg.Org, Name: name})
 				if err != nil {
@@ -121,6 +131,8 @@ func EditCmd(cfg *config.Config) *cobra.Command {
 	editCmd.Flags().StringVar(&path, "path", ".", "Project directory")
 	editCmd.Flags().IntVar(&slots, "prod-slots", 0, "Slots to allocate for production deployments (default: current slots)")
 	editCmd.Flags().Int64Var(&prodTTL, "prod-ttl-seconds", 0, "Prod deployment TTL in seconds")
+	editCmd.Flags().BoolVar(&previewEnabled, "preview", false, "Automatically deploy previews of pushed branches and pull requests")
+	editCmd.Flags().Int64Var(&previewTTL, "preview-ttl-seconds", 0, "Preview deployment TTL in seconds")
 	editCmd.Flags().StringVar(&region, "region", "", "Deployment region (default: current region)")
 	if !cfg.IsDev() {
 		if err := editCmd.Flags().MarkHidden("prod-slots"); err != nil {
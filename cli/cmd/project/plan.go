@@ -0,0 +1,149 @@
+package project
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	runtimeclient "github.com/rilldata/rill/runtime/client"
+	"github.com/spf13/cobra"
+)
+
+func PlanCmd(cfg *config.Config) *cobra.Command {
+	var name, path string
+
+	planCmd := &cobra.Command{
+		Use:   "plan [<project-name>]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Show what deploying the local project files would change",
+		Long:  "Compares the project files in --path with the project's production deployment and shows which resources would be created, deleted, renamed, re-ingested or rebuilt. Nothing is changed.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			if len(args) > 0 {
+				name = args[0]
+			}
+
+			if !cmd.Flags().Changed("project") && len(args) == 0 && cfg.Interactive {
+				name, err = inferProjectName(cmd.Context(), client, cfg.Org, path)
+				if err != nil {
+					return err
+				}
+			}
+
+			proj, err := client.GetProject(cmd.Context(), &adminv1.GetProjectRequest{
+				OrganizationName: cfg.Org,
+				Name:             name,
+			})
+			if err != nil {
+				return err
+			}
+
+			depl := proj.ProdDeployment
+			if depl == nil {
+				return fmt.Errorf("project %q does not have a production deployment", name)
+			}
+
+			files, err := readProjectFiles(path)
+			if err != nil {
+				return err
+			}
+			if len(files) == 0 {
+				return fmt.Errorf("no project files found in %q", path)
+			}
+
+			rt, err := runtimeclient.New(depl.RuntimeHost, proj.Jwt)
+			if err != nil {
+				return err
+			}
+			defer rt.Close()
+
+			res, err := rt.PlanReconcile(cmd.Context(), &runtimev1.PlanReconcileRequest{
+				InstanceId: depl.RuntimeInstanceId,
+				Files:      files,
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(res.ParseErrors) > 0 {
+				fmt.Println("Parse errors:")
+				for _, e := range res.ParseErrors {
+					fmt.Printf("  %s: %s\n", e.FilePath, e.Message)
+				}
+				fmt.Println()
+			}
+
+			if len(res.Changes) == 0 {
+				cmdutil.PrintlnSuccess("No changes")
+				return nil
+			}
+
+			rows := make([]*plannedChange, 0, len(res.Changes))
+			for _, c := range res.Changes {
+				rows = append(rows, toPlannedChangeRow(c))
+			}
+			cmdutil.TablePrinter(rows)
+
+			return nil
+		},
+	}
+
+	planCmd.Flags().SortFlags = false
+	planCmd.Flags().StringVar(&name, "project", "", "Project Name")
+	planCmd.Flags().StringVar(&path, "path", ".", "Project directory")
+
+	return planCmd
+}
+
+// readProjectFiles reads the files in a local project directory that are parsed by the runtime, keyed by their path relative to the directory.
+func readProjectFiles(path string) (map[string]string, error) {
+	files := make(map[string]string)
+	err := doublestar.GlobWalk(os.DirFS(path), "**/*.{sql,yaml,yml}", func(p string, d fs.DirEntry) error {
+		if d.IsDir() {
+			return nil
+		}
+		data, err := os.ReadFile(filepath.Join(path, p))
+		if err != nil {
+			return err
+		}
+		files["/"+p] = string(data)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+func toPlannedChangeRow(c *runtimev1.PlannedChange) *plannedChange {
+	change := strings.ToLower(strings.TrimPrefix(c.Type.String(), "PLANNED_CHANGE_TYPE_"))
+	if c.RenamedFrom != nil {
+		change = fmt.Sprintf("%s (from %s)", change, c.RenamedFrom.Name)
+	}
+
+	return &plannedChange{
+		Kind:   strings.TrimPrefix(c.Name.Kind, "rill.runtime.v1."),
+		Name:   c.Name.Name,
+		Change: change,
+		Reason: c.Reason,
+	}
+}
+
+type plannedChange struct {
+	Kind   string `header:"kind" json:"kind"`
+	Name   string `header:"name" json:"name"`
+	Change string `header:"change" json:"change"`
+	Reason string `header:"reason" json:"reason"`
+}
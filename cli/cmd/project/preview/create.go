@@ -0,0 +1,76 @@
+package preview
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func CreateCmd(cfg *config.Config) *cobra.Command {
+	var project, branch string
+	var variables []string
+	var ttl int64
+
+	createCmd := &cobra.Command{
+		Use:   "create [<branch>]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Create a preview deployment of a branch",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			if len(args) > 0 {
+				branch = args[0]
+			}
+			if project == "" {
+				return fmt.Errorf("please provide a project name with the --project flag")
+			}
+			if branch == "" {
+				return fmt.Errorf("pass branch name as argument or with --branch flag")
+			}
+
+			vars := make(map[string]string, len(variables))
+			for _, v := range variables {
+				key, val, ok := strings.Cut(v, "=")
+				if !ok || key == "" {
+					return fmt.Errorf("invalid variable %q: expected format key=value", v)
+				}
+				vars[key] = val
+			}
+
+			req := &adminv1.CreatePreviewDeploymentRequest{
+				OrganizationName: cfg.Org,
+				Project:          project,
+				Branch:           branch,
+				Variables:        vars,
+			}
+			if cmd.Flags().Changed("ttl-seconds") {
+				req.TtlSeconds = &ttl
+			}
+
+			res, err := client.CreatePreviewDeployment(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			cmdutil.PrintlnSuccess(fmt.Sprintf("Created preview deployment of branch %q", branch))
+			cmdutil.TablePrinter(toRow(res.Deployment))
+			return nil
+		},
+	}
+
+	createCmd.Flags().SortFlags = false
+	createCmd.Flags().StringVar(&project, "project", "", "Project Name")
+	createCmd.Flags().StringVar(&branch, "branch", "", "Branch to deploy")
+	createCmd.Flags().StringSliceVar(&variables, "var", []string{}, "Variable overrides for the preview in the format key=value")
+	createCmd.Flags().Int64Var(&ttl, "ttl-seconds", 0, "Time to live for the preview deployment in seconds (default: project's preview TTL)")
+
+	return createCmd
+}
@@ -0,0 +1,55 @@
+package preview
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func DeleteCmd(cfg *config.Config) *cobra.Command {
+	var project, branch string
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete [<branch>]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Delete the preview deployment of a branch",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			if len(args) > 0 {
+				branch = args[0]
+			}
+			if project == "" {
+				return fmt.Errorf("please provide a project name with the --project flag")
+			}
+			if branch == "" {
+				return fmt.Errorf("pass branch name as argument or with --branch flag")
+			}
+
+			_, err = client.DeletePreviewDeployment(cmd.Context(), &adminv1.DeletePreviewDeploymentRequest{
+				OrganizationName: cfg.Org,
+				Project:          project,
+				Branch:           branch,
+			})
+			if err != nil {
+				return err
+			}
+
+			cmdutil.PrintlnSuccess(fmt.Sprintf("Deleted preview deployment of branch %q", branch))
+			return nil
+		},
+	}
+
+	deleteCmd.Flags().SortFlags = false
+	deleteCmd.Flags().StringVar(&project, "project", "", "Project Name")
+	deleteCmd.Flags().StringVar(&branch, "branch", "", "Branch of the preview deployment")
+
+	return deleteCmd
+}
@@ -0,0 +1,55 @@
+package preview
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func ListCmd(cfg *config.Config) *cobra.Command {
+	var project string
+
+	listCmd := &cobra.Command{
+		Use:   "list [<project-name>]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "List preview deployments",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			if len(args) > 0 {
+				project = args[0]
+			}
+			if project == "" {
+				return fmt.Errorf("pass project name as argument or with --project flag")
+			}
+
+			res, err := client.ListPreviewDeployments(cmd.Context(), &adminv1.ListPreviewDeploymentsRequest{
+				OrganizationName: cfg.Org,
+				Project:          project,
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(res.Deployments) == 0 {
+				cmdutil.PrintlnWarn("No preview deployments found")
+				return nil
+			}
+
+			cmdutil.TablePrinter(toTable(res.Deployments))
+			return nil
+		},
+	}
+
+	listCmd.Flags().SortFlags = false
+	listCmd.Flags().StringVar(&project, "project", "", "Project Name")
+
+	return listCmd
+}
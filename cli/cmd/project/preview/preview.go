@@ -0,0 +1,50 @@
+package preview
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func PreviewCmd(cfg *config.Config) *cobra.Command {
+	previewCmd := &cobra.Command{
+		Use:               "preview",
+		Short:             "Manage preview deployments of non-production branches",
+		PersistentPreRunE: cmdutil.CheckChain(cmdutil.CheckAuth(cfg), cmdutil.CheckOrganization(cfg)),
+	}
+
+	previewCmd.AddCommand(ListCmd(cfg))
+	previewCmd.AddCommand(CreateCmd(cfg))
+	previewCmd.AddCommand(DeleteCmd(cfg))
+
+	return previewCmd
+}
+
+func toRow(d *adminv1.Deployment) *preview {
+	return &preview{
+		Branch:    d.Branch,
+		Status:    d.Status.String(),
+		TTL:       d.PreviewTtlSeconds,
+		CreatedOn: d.CreatedOn.AsTime().Format(cmdutil.TSFormatLayout),
+		UpdatedOn: d.UpdatedOn.AsTime().Format(cmdutil.TSFormatLayout),
+	}
+}
+
+func toTable(depls []*adminv1.Deployment) []*preview {
+	previews := make([]*preview, 0, len(depls))
+
+	for _, d := range depls {
+		previews = append(previews, toRow(d))
+	}
+
+	return previews
+}
+
+type preview struct {
+	Branch    string `header:"branch" json:"branch"`
+	Status    string `header:"status" json:"status"`
+	TTL       int64  `header:"ttl_seconds" json:"ttl_seconds"`
+	CreatedOn string `header:"created_on,timestamp(ms|utc|human)" json:"created_on"`
+	UpdatedOn string `header:"updated_on,timestamp(ms|utc|human)" json:"updated_on"`
+}
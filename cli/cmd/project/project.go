@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 
 	"github.com/rilldata/rill/admin/client"
+	"github.com/rilldata/rill/cli/cmd/project/preview"
 	"github.com/rilldata/rill/cli/pkg/cmdutil"
 	"github.com/rilldata/rill/cli/pkg/config"
 	"github.com/rilldata/rill/cli/pkg/gitutil"
@@ -26,8 +27,10 @@ func ProjectCmd(cfg *config.Config) *cobra.Command {
 	projectCmd.AddCommand(DeleteCmd(cfg))
 	projectCmd.AddCommand(ListCmd(cfg))
 	projectCmd.AddCommand(ReconcileCmd(cfg))
+	projectCmd.AddCommand(PlanCmd(cfg))
 	projectCmd.AddCommand(JwtCmd(cfg))
 	projectCmd.AddCommand(RenameCmd(cfg))
+	projectCmd.AddCommand(preview.PreviewCmd(cfg))
 	return projectCmd
 }
 
@@ -2,6 +2,7 @@ package project
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/rilldata/rill/cli/pkg/cmdutil"
 	"github.com/rilldata/rill/cli/pkg/config"
@@ -43,6 +44,15 @@ func ShowCmd(cfg *config.Config) *cobra.Command {
 			}
 
 			cmdutil.TablePrinter(toRow(proj.Project))
+			if proj.Project.GitRemote != "" {
+				fmt.Printf("Git remote: %s\n", proj.Project.GitRemote)
+				if proj.Project.GitWebhookSecret != "" {
+					fmt.Printf("Git webhook secret: %s\n", proj.Project.GitWebhookSecret)
+				}
+			}
+			if proj.Project.ArchiveAssetId != "" {
+				fmt.Printf("Deployed from uploaded archive: %s\n", proj.Project.ArchiveAssetId)
+			}
 			return nil
 		},
 	}
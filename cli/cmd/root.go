@@ -19,6 +19,7 @@ import (
 	"github.com/rilldata/rill/cli/cmd/sudo"
 	"github.com/rilldata/rill/cli/cmd/upgrade"
 	"github.com/rilldata/rill/cli/cmd/user"
+	"github.com/rilldata/rill/cli/cmd/usergroup"
 	versioncmd "github.com/rilldata/rill/cli/cmd/version"
 	"github.com/rilldata/rill/cli/cmd/whoami"
 	"github.com/rilldata/rill/cli/pkg/cmdutil"
@@ -148,6 +149,7 @@ func runCmd(ctx context.Context, ver config.Version) error {
 		auth.LogoutCmd(cfg),
 		sudo.SudoCmd(cfg),
 		service.ServiceCmd(cfg),
+		usergroup.UsergroupCmd(cfg),
 	}
 	for _, cmd := range adminCmds {
 		cmd.PersistentFlags().StringVar(&cfg.AdminURL, "api-url", cfg.AdminURL, "Base URL for the admin API")
@@ -9,6 +9,7 @@ import (
 	"github.com/joho/godotenv"
 	"github.com/kelseyhightower/envconfig"
 	"github.com/redis/go-redis/v9"
+	"github.com/rilldata/rill/admin/email"
 	"github.com/rilldata/rill/cli/pkg/config"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
@@ -23,15 +24,19 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	// Load connectors and reconcilers for runtime
+	_ "github.com/rilldata/rill/runtime/drivers/archive"
 	_ "github.com/rilldata/rill/runtime/drivers/athena"
 	_ "github.com/rilldata/rill/runtime/drivers/azure"
 	_ "github.com/rilldata/rill/runtime/drivers/bigquery"
+	_ "github.com/rilldata/rill/runtime/drivers/clickhouse"
 	_ "github.com/rilldata/rill/runtime/drivers/druid"
 	_ "github.com/rilldata/rill/runtime/drivers/duckdb"
 	_ "github.com/rilldata/rill/runtime/drivers/file"
 	_ "github.com/rilldata/rill/runtime/drivers/gcs"
+	_ "github.com/rilldata/rill/runtime/drivers/git"
 	_ "github.com/rilldata/rill/runtime/drivers/github"
 	_ "github.com/rilldata/rill/runtime/drivers/https"
+	_ "github.com/rilldata/rill/runtime/drivers/mysql"
 	_ "github.com/rilldata/rill/runtime/drivers/postgres"
 	_ "github.com/rilldata/rill/runtime/drivers/s3"
 	_ "github.com/rilldata/rill/runtime/drivers/sqlite"
@@ -73,6 +78,26 @@ type Config struct {
 	ActivitySinkKafkaBrokers string `default:"" split_words:"true"`
 	// Kafka topic of an activity client's sink
 	ActivitySinkKafkaTopic string `default:"" split_words:"true"`
+	// SMTP config for sending scheduled reports (emails are logged to the console if EmailSMTPHost is empty)
+	EmailSMTPHost     string `split_words:"true"`
+	EmailSMTPPort     int    `split_words:"true"`
+	EmailSMTPUsername string `split_words:"true"`
+	EmailSMTPPassword string `split_words:"true"`
+	EmailSenderEmail  string `default:"noreply@rilldata.com" split_words:"true"`
+	EmailSenderName   string `default:"Rill" split_words:"true"`
+	EmailBCC          string `split_words:"true"`
+	// Frontend URL linked to from report emails
+	EmailFrontendURL string `default:"" split_words:"true"`
+	// ControllersEnabled runs instance controllers on this node. Multiple nodes sharing the same metastore
+	// spread instances between them using leases and forward requests to the node that owns an instance.
+	ControllersEnabled bool `default:"false" split_words:"true"`
+	// NodeID identifies this node among nodes sharing the same metastore (defaults to a random ID)
+	NodeID string `default:"" split_words:"true"`
+	// NodeAddress is the gRPC address other nodes use to reach this node (defaults to localhost:<GRPCPort>)
+	NodeAddress string `default:"" split_words:"true"`
+	// NodeHTTPAddress is the HTTP address other nodes use to forward downloads to this node (defaults to localhost:<HTTPPort>)
+	NodeHTTPAddress    string        `default:"" split_words:"true"`
+	ControllerLeaseTTL time.Duration `default:"30s" split_words:"true"`
 }
 
 // StartCmd starts a stand-alone runtime server. It only allows configuration using environment variables.
@@ -143,7 +168,36 @@ func StartCmd(cliCfg *config.Config) *cobra.Command {
 			}
 			activityClient := activity.NewBufferedClient(activityOpts)
 
+			// Init email client
+			var sender email.Sender
+			if conf.EmailSMTPHost != "" {
+				sender, err = email.NewSMTPSender(&email.SMTPOptions{
+					SMTPHost:     conf.EmailSMTPHost,
+					SMTPPort:     conf.EmailSMTPPort,
+					SMTPUsername: conf.EmailSMTPUsername,
+					SMTPPassword: conf.EmailSMTPPassword,
+					FromEmail:    conf.EmailSenderEmail,
+					FromName:     conf.EmailSenderName,
+					BCC:          conf.EmailBCC,
+				})
+			} else {
+				sender, err = email.NewConsoleSender(logger, conf.EmailSenderEmail, conf.EmailSenderName)
+			}
+			if err != nil {
+				logger.Fatal("error creating email sender", zap.Error(err))
+			}
+			emailClient := email.New(sender, conf.EmailFrontendURL, "")
+
 			// Init runtime
+			nodeAddress := conf.NodeAddress
+			if nodeAddress == "" {
+				nodeAddress = fmt.Sprintf("localhost:%d", conf.GRPCPort)
+			}
+			nodeHTTPAddress := conf.NodeHTTPAddress
+			if nodeHTTPAddress == "" {
+				nodeHTTPAddress = fmt.Sprintf("localhost:%d", conf.HTTPPort)
+			}
+
 			opts := &runtime.Options{
 				ConnectionCacheSize:     conf.ConnectionCacheSize,
 				MetastoreConnector:      "metastore",
@@ -151,6 +205,11 @@ func StartCmd(cliCfg *config.Config) *cobra.Command {
 				SecurityEngineCacheSize: conf.SecurityEngineCacheSize,
 				AllowHostAccess:         conf.AllowHostAccess,
 				SafeSourceRefresh:       conf.SafeSourceRefresh,
+				Email:                   emailClient,
+				NodeID:                  conf.NodeID,
+				NodeAddress:             nodeAddress,
+				NodeHTTPAddress:         nodeHTTPAddress,
+				ControllerLeaseTTL:      conf.ControllerLeaseTTL,
 				SystemConnectors: []*runtimev1.Connector{
 					{
 						Type:   conf.MetastoreDriver,
@@ -199,6 +258,9 @@ func StartCmd(cliCfg *config.Config) *cobra.Command {
 			group, cctx := errgroup.WithContext(ctx)
 			group.Go(func() error { return s.ServeGRPC(cctx) })
 			group.Go(func() error { return s.ServeHTTP(cctx, nil) })
+			if conf.ControllersEnabled {
+				group.Go(func() error { return rt.RunControllers(cctx) })
+			}
 			err = group.Wait()
 			if err != nil {
 				logger.Error("server crashed", zap.Error(err))
@@ -0,0 +1,42 @@
+package usergroup
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func CreateCmd(cfg *config.Config) *cobra.Command {
+	var description string
+
+	createCmd := &cobra.Command{
+		Use:   "create <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Create user group",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			res, err := client.CreateUsergroup(cmd.Context(), &adminv1.CreateUsergroupRequest{
+				Organization: cfg.Org,
+				Name:         args[0],
+				Description:  description,
+			})
+			if err != nil {
+				return err
+			}
+
+			cmdutil.PrintlnSuccess("Created user group")
+			cmdutil.TablePrinter(toRow(res.Usergroup))
+			return nil
+		},
+	}
+
+	createCmd.Flags().StringVar(&description, "description", "", "Description of the user group")
+
+	return createCmd
+}
@@ -0,0 +1,50 @@
+package usergroup
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func DeleteCmd(cfg *config.Config) *cobra.Command {
+	var force bool
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Delete user group",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			name := args[0]
+			if !force {
+				fmt.Printf("Warn: Deleting the user group %q will remove the roles it grants its members\n", name)
+				if !cmdutil.ConfirmPrompt("Do you want to continue?", "", false) {
+					return nil
+				}
+			}
+
+			_, err = client.DeleteUsergroup(cmd.Context(), &adminv1.DeleteUsergroupRequest{
+				Organization: cfg.Org,
+				Usergroup:    name,
+			})
+			if err != nil {
+				return err
+			}
+
+			cmdutil.PrintlnSuccess(fmt.Sprintf("Deleted user group: %s", name))
+			return nil
+		},
+	}
+
+	deleteCmd.Flags().BoolVar(&force, "force", false, "Delete forcefully, skips the confirmation")
+
+	return deleteCmd
+}
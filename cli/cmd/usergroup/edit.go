@@ -0,0 +1,56 @@
+package usergroup
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func EditCmd(cfg *config.Config) *cobra.Command {
+	var newName, description string
+
+	editCmd := &cobra.Command{
+		Use:   "edit <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Rename a user group or change its description",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			req := &adminv1.EditUsergroupRequest{
+				Organization: cfg.Org,
+				Usergroup:    args[0],
+			}
+			if cmd.Flags().Changed("new-name") {
+				req.NewName = &newName
+			}
+			if cmd.Flags().Changed("description") {
+				req.Description = &description
+			}
+			if req.NewName == nil && req.Description == nil {
+				return fmt.Errorf("pass --new-name or --description to edit the user group")
+			}
+
+			res, err := client.EditUsergroup(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			cmdutil.PrintlnSuccess("Updated user group")
+			cmdutil.TablePrinter(toRow(res.Usergroup))
+			return nil
+		},
+	}
+
+	editCmd.Flags().SortFlags = false
+	editCmd.Flags().StringVar(&newName, "new-name", "", "New name of the user group")
+	editCmd.Flags().StringVar(&description, "description", "", "Description of the user group")
+
+	return editCmd
+}
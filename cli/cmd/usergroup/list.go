@@ -0,0 +1,98 @@
+package usergroup
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func ListCmd(cfg *config.Config) *cobra.Command {
+	var projectName string
+	var pageSize uint32
+	var pageToken string
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List user groups",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			// With --project, list the groups that have a role on the project
+			if projectName != "" {
+				res, err := client.ListProjectMemberUsergroups(cmd.Context(), &adminv1.ListProjectMemberUsergroupsRequest{
+					Organization: cfg.Org,
+					Project:      projectName,
+					PageSize:     pageSize,
+					PageToken:    pageToken,
+				})
+				if err != nil {
+					return err
+				}
+
+				if len(res.Members) == 0 {
+					cmdutil.PrintlnWarn("No user groups have a role on the project")
+					return nil
+				}
+
+				cmdutil.PrintlnSuccess("Project user groups")
+				cmdutil.TablePrinter(toMemberTable(res.Members))
+				if res.NextPageToken != "" {
+					cmd.Println()
+					cmd.Printf("Next page token: %s\n", res.NextPageToken)
+				}
+				return nil
+			}
+
+			res, err := client.ListUsergroups(cmd.Context(), &adminv1.ListUsergroupsRequest{
+				Organization: cfg.Org,
+				PageSize:     pageSize,
+				PageToken:    pageToken,
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(res.Usergroups) == 0 {
+				cmdutil.PrintlnWarn("No user groups found")
+				return nil
+			}
+
+			cmdutil.PrintlnSuccess("User groups")
+			cmdutil.TablePrinter(toTable(res.Usergroups))
+			if res.NextPageToken != "" {
+				cmd.Println()
+				cmd.Printf("Next page token: %s\n", res.NextPageToken)
+			}
+			return nil
+		},
+	}
+
+	listCmd.Flags().StringVar(&projectName, "project", "", "List the user groups that have a role on this project")
+	listCmd.Flags().Uint32Var(&pageSize, "page-size", 50, "Number of user groups to return per page")
+	listCmd.Flags().StringVar(&pageToken, "page-token", "", "Pagination token")
+
+	return listCmd
+}
+
+func toMemberTable(members []*adminv1.MemberUsergroup) []*memberUsergroup {
+	res := make([]*memberUsergroup, 0, len(members))
+
+	for _, m := range members {
+		res = append(res, &memberUsergroup{
+			Name:     m.GroupName,
+			RoleName: m.RoleName,
+		})
+	}
+
+	return res
+}
+
+type memberUsergroup struct {
+	Name     string `header:"name" json:"name"`
+	RoleName string `header:"role" json:"role"`
+}
@@ -0,0 +1,144 @@
+package usergroup
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func ListMembersCmd(cfg *config.Config) *cobra.Command {
+	var pageSize uint32
+	var pageToken string
+
+	listMembersCmd := &cobra.Command{
+		Use:   "list-members <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "List the members of a user group",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			res, err := client.ListUsergroupMembers(cmd.Context(), &adminv1.ListUsergroupMembersRequest{
+				Organization: cfg.Org,
+				Usergroup:    args[0],
+				PageSize:     pageSize,
+				PageToken:    pageToken,
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(res.Members) == 0 {
+				cmdutil.PrintlnWarn("No members found")
+				return nil
+			}
+
+			cmdutil.PrintlnSuccess("User group members")
+			cmdutil.TablePrinter(toMemberUserTable(res.Members))
+			if res.NextPageToken != "" {
+				cmd.Println()
+				cmd.Printf("Next page token: %s\n", res.NextPageToken)
+			}
+			return nil
+		},
+	}
+
+	listMembersCmd.Flags().Uint32Var(&pageSize, "page-size", 50, "Number of members to return per page")
+	listMembersCmd.Flags().StringVar(&pageToken, "page-token", "", "Pagination token")
+
+	return listMembersCmd
+}
+
+func AddMemberCmd(cfg *config.Config) *cobra.Command {
+	var email string
+
+	addMemberCmd := &cobra.Command{
+		Use:   "add-member <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Add an organization member to a user group",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdutil.StringPromptIfEmpty(&email, "Enter email")
+
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			_, err = client.AddUsergroupMember(cmd.Context(), &adminv1.AddUsergroupMemberRequest{
+				Organization: cfg.Org,
+				Usergroup:    args[0],
+				Email:        email,
+			})
+			if err != nil {
+				return err
+			}
+
+			cmdutil.PrintlnSuccess(fmt.Sprintf("Added user %q to the user group %q", email, args[0]))
+			return nil
+		},
+	}
+
+	addMemberCmd.Flags().StringVar(&email, "email", "", "Email of the user")
+
+	return addMemberCmd
+}
+
+func RemoveMemberCmd(cfg *config.Config) *cobra.Command {
+	var email string
+
+	removeMemberCmd := &cobra.Command{
+		Use:   "remove-member <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Remove a user from a user group",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdutil.StringPromptIfEmpty(&email, "Enter email")
+
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			_, err = client.RemoveUsergroupMember(cmd.Context(), &adminv1.RemoveUsergroupMemberRequest{
+				Organization: cfg.Org,
+				Usergroup:    args[0],
+				Email:        email,
+			})
+			if err != nil {
+				return err
+			}
+
+			cmdutil.PrintlnSuccess(fmt.Sprintf("Removed user %q from the user group %q", email, args[0]))
+			return nil
+		},
+	}
+
+	removeMemberCmd.Flags().StringVar(&email, "email", "", "Email of the user")
+
+	return removeMemberCmd
+}
+
+func toMemberUserTable(members []*adminv1.Member) []*memberUser {
+	res := make([]*memberUser, 0, len(members))
+
+	for _, m := range members {
+		res = append(res, &memberUser{
+			Email: m.UserEmail,
+			Name:  m.UserName,
+		})
+	}
+
+	return res
+}
+
+type memberUser struct {
+	Email string `header:"email" json:"email"`
+	Name  string `header:"name" json:"display_name"`
+}
@@ -0,0 +1,107 @@
+package usergroup
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func SetRoleCmd(cfg *config.Config) *cobra.Command {
+	var projectName string
+	var role string
+
+	setRoleCmd := &cobra.Command{
+		Use:   "set-role <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Grant a user group a role on the organization or a project",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdutil.SelectPromptIfEmpty(&role, "Select role", orgRoles, "")
+
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			group := args[0]
+			if projectName != "" {
+				_, err = client.SetProjectMemberUsergroupRole(cmd.Context(), &adminv1.SetProjectMemberUsergroupRoleRequest{
+					Organization: cfg.Org,
+					Project:      projectName,
+					Usergroup:    group,
+					Role:         role,
+				})
+				if err != nil {
+					return err
+				}
+				cmdutil.PrintlnSuccess(fmt.Sprintf("Set role of user group %q to %q in the project \"%s/%s\"", group, role, cfg.Org, projectName))
+			} else {
+				_, err = client.SetOrganizationMemberUsergroupRole(cmd.Context(), &adminv1.SetOrganizationMemberUsergroupRoleRequest{
+					Organization: cfg.Org,
+					Usergroup:    group,
+					Role:         role,
+				})
+				if err != nil {
+					return err
+				}
+				cmdutil.PrintlnSuccess(fmt.Sprintf("Set role of user group %q to %q in the organization %q", group, role, cfg.Org))
+			}
+
+			return nil
+		},
+	}
+
+	setRoleCmd.Flags().StringVar(&projectName, "project", "", "Project")
+	setRoleCmd.Flags().StringVar(&role, "role", "", fmt.Sprintf("Role of the user group [%v]", strings.Join(orgRoles, ", ")))
+
+	return setRoleCmd
+}
+
+func RemoveRoleCmd(cfg *config.Config) *cobra.Command {
+	var projectName string
+
+	removeRoleCmd := &cobra.Command{
+		Use:   "remove-role <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Remove a user group's role on the organization or a project",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			group := args[0]
+			if projectName != "" {
+				_, err = client.RemoveProjectMemberUsergroup(cmd.Context(), &adminv1.RemoveProjectMemberUsergroupRequest{
+					Organization: cfg.Org,
+					Project:      projectName,
+					Usergroup:    group,
+				})
+				if err != nil {
+					return err
+				}
+				cmdutil.PrintlnSuccess(fmt.Sprintf("Removed role of user group %q in the project \"%s/%s\"", group, cfg.Org, projectName))
+			} else {
+				_, err = client.RemoveOrganizationMemberUsergroup(cmd.Context(), &adminv1.RemoveOrganizationMemberUsergroupRequest{
+					Organization: cfg.Org,
+					Usergroup:    group,
+				})
+				if err != nil {
+					return err
+				}
+				cmdutil.PrintlnSuccess(fmt.Sprintf("Removed role of user group %q in the organization %q", group, cfg.Org))
+			}
+
+			return nil
+		},
+	}
+
+	removeRoleCmd.Flags().StringVar(&projectName, "project", "", "Project")
+
+	return removeRoleCmd
+}
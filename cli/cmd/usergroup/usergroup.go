@@ -0,0 +1,57 @@
+package usergroup
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func UsergroupCmd(cfg *config.Config) *cobra.Command {
+	usergroupCmd := &cobra.Command{
+		Use:               "usergroup",
+		Short:             "Manage user groups",
+		PersistentPreRunE: cmdutil.CheckChain(cmdutil.CheckAuth(cfg), cmdutil.CheckOrganization(cfg)),
+	}
+
+	usergroupCmd.PersistentFlags().StringVar(&cfg.Org, "org", cfg.Org, "Organization Name")
+	usergroupCmd.AddCommand(ListCmd(cfg))
+	usergroupCmd.AddCommand(CreateCmd(cfg))
+	usergroupCmd.AddCommand(EditCmd(cfg))
+	usergroupCmd.AddCommand(DeleteCmd(cfg))
+	usergroupCmd.AddCommand(ListMembersCmd(cfg))
+	usergroupCmd.AddCommand(AddMemberCmd(cfg))
+	usergroupCmd.AddCommand(RemoveMemberCmd(cfg))
+	usergroupCmd.AddCommand(SetRoleCmd(cfg))
+	usergroupCmd.AddCommand(RemoveRoleCmd(cfg))
+
+	return usergroupCmd
+}
+
+var orgRoles = []string{"admin", "viewer"}
+
+func toRow(g *adminv1.Usergroup) *usergroup {
+	return &usergroup{
+		Name:        g.Name,
+		Description: g.Description,
+		Managed:     g.Managed,
+		CreatedOn:   g.CreatedOn.AsTime().Format(cmdutil.TSFormatLayout),
+	}
+}
+
+func toTable(groups []*adminv1.Usergroup) []*usergroup {
+	res := make([]*usergroup, 0, len(groups))
+
+	for _, g := range groups {
+		res = append(res, toRow(g))
+	}
+
+	return res
+}
+
+type usergroup struct {
+	Name        string `header:"name" json:"name"`
+	Description string `header:"description" json:"description"`
+	Managed     bool   `header:"managed" json:"managed"`
+	CreatedOn   string `header:"created_on,timestamp(ms|utc|human)" json:"created_on"`
+}
@@ -19,12 +19,14 @@ import (
 	"github.com/rilldata/rill/cli/pkg/web"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/compilers/rillv1"
 	"github.com/rilldata/rill/runtime/compilers/rillv1beta"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/activity"
 	"github.com/rilldata/rill/runtime/pkg/graceful"
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	"github.com/rilldata/rill/runtime/pkg/ratelimit"
+	"github.com/rilldata/rill/runtime/reconcilers"
 	runtimeserver "github.com/rilldata/rill/runtime/server"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -227,9 +229,73 @@ func (a *App) Reconcile(strict bool) error {
 	} else {
 		a.Logger.Named("console").Infof("Hydration failed")
 	}
+
+	if strict {
+		ok, err := a.runTests()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			a.Logger.Fatalf("Tests failed")
+		}
+	}
 	return nil
 }
 
+// runTests runs the assertions of the tests defined in the project against the instance's OLAP store.
+// It returns false if any of the tests failed.
+func (a *App) runTests() (bool, error) {
+	repo, release, err := a.Runtime.Repo(a.Context, a.Instance.ID)
+	if err != nil {
+		return false, err
+	}
+	defer release()
+
+	var duckdbConnectors []string
+	for _, connector := range a.Instance.Connectors {
+		if connector.Type == "duckdb" {
+			duckdbConnectors = append(duckdbConnectors, connector.Name)
+		}
+	}
+
+	p, err := rillv1.Parse(a.Context, repo, a.Instance.ID, a.Instance.OLAPConnector, duckdbConnectors)
+	if err != nil {
+		return false, err
+	}
+
+	olap, release, err := a.Runtime.OLAP(a.Context, a.Instance.ID)
+	if err != nil {
+		return false, err
+	}
+	defer release()
+
+	ok := true
+	for _, r := range p.Resources {
+		if r.TestSpec == nil {
+			continue
+		}
+		if r.TestSpec.Connector != a.Instance.OLAPConnector {
+			a.Logger.Named("console").Warnf("Skipping test %q: connector %q is not supported locally", r.Name.Name, r.TestSpec.Connector)
+			continue
+		}
+
+		failed, err := reconcilers.RunTestAssertions(a.Context, olap, r.TestSpec)
+		if err != nil {
+			a.Logger.Errorf("Test %q: %s", r.Name.Name, err)
+			ok = false
+			continue
+		}
+		for _, f := range failed {
+			a.Logger.Errorf("Test %q: %s", r.Name.Name, f)
+			ok = false
+		}
+	}
+	if ok {
+		a.Logger.Named("console").Infof("Tests passed!")
+	}
+	return ok, nil
+}
+
 func (a *App) ReconcileSource(sourcePath string) error {
 	a.Logger.Named("console").Infof("Reconciling source and impacted models in project '%s'", a.ProjectPath)
 	paths := []string{sourcePath}
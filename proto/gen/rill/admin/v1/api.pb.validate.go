@@ -4119,6 +4119,39 @@ func (m *CreateProjectRequest) validate(all bool) error {
 
 	// no validation rules for Variables
 
+	// no validation rules for GitRemote
+
+	if all {
+		switch v := interface{}(m.GetGitCredentials()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateProjectRequestValidationError{
+					field:  "GitCredentials",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateProjectRequestValidationError{
+					field:  "GitCredentials",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGitCredentials()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateProjectRequestValidationError{
+				field:  "GitCredentials",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ArchiveAssetId
+
 	if len(errors) > 0 {
 		return CreateProjectRequestMultiError(errors)
 	}
@@ -4582,6 +4615,35 @@ func (m *UpdateProjectRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetGitCredentials()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateProjectRequestValidationError{
+					field:  "GitCredentials",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateProjectRequestValidationError{
+					field:  "GitCredentials",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGitCredentials()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateProjectRequestValidationError{
+				field:  "GitCredentials",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.Description != nil {
 		// no validation rules for Description
 	}
@@ -4614,6 +4676,22 @@ func (m *UpdateProjectRequest) validate(all bool) error {
 		// no validation rules for ProdTtlSeconds
 	}
 
+	if m.PreviewEnabled != nil {
+		// no validation rules for PreviewEnabled
+	}
+
+	if m.PreviewTtlSeconds != nil {
+		// no validation rules for PreviewTtlSeconds
+	}
+
+	if m.GitRemote != nil {
+		// no validation rules for GitRemote
+	}
+
+	if m.ArchiveAssetId != nil {
+		// no validation rules for ArchiveAssetId
+	}
+
 	if len(errors) > 0 {
 		return UpdateProjectRequestMultiError(errors)
 	}
@@ -5681,61 +5759,64 @@ var _ interface {
 	ErrorName() string
 } = TriggerRedeployResponseValidationError{}
 
-// Validate checks the field values on ListOrganizationMembersRequest with the
+// Validate checks the field values on ListPreviewDeploymentsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListOrganizationMembersRequest) Validate() error {
+func (m *ListPreviewDeploymentsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListOrganizationMembersRequest with
+// ValidateAll checks the field values on ListPreviewDeploymentsRequest with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// ListOrganizationMembersRequestMultiError, or nil if none found.
-func (m *ListOrganizationMembersRequest) ValidateAll() error {
+// ListPreviewDeploymentsRequestMultiError, or nil if none found.
+func (m *ListPreviewDeploymentsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListOrganizationMembersRequest) validate(all bool) error {
+func (m *ListPreviewDeploymentsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
-
-	if m.GetPageSize() != 0 {
-
-		if m.GetPageSize() > 1000 {
-			err := ListOrganizationMembersRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 1000",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
+		err := ListPreviewDeploymentsRequestValidationError{
+			field:  "OrganizationName",
+			reason: "value length must be at least 1 runes",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for PageToken
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := ListPreviewDeploymentsRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return ListOrganizationMembersRequestMultiError(errors)
+		return ListPreviewDeploymentsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListOrganizationMembersRequestMultiError is an error wrapping multiple
-// validation errors returned by ListOrganizationMembersRequest.ValidateAll()
+// ListPreviewDeploymentsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListPreviewDeploymentsRequest.ValidateAll()
 // if the designated constraints aren't met.
-type ListOrganizationMembersRequestMultiError []error
+type ListPreviewDeploymentsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListOrganizationMembersRequestMultiError) Error() string {
+func (m ListPreviewDeploymentsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5744,12 +5825,12 @@ func (m ListOrganizationMembersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListOrganizationMembersRequestMultiError) AllErrors() []error { return m }
+func (m ListPreviewDeploymentsRequestMultiError) AllErrors() []error { return m }
 
-// ListOrganizationMembersRequestValidationError is the validation error
-// returned by ListOrganizationMembersRequest.Validate if the designated
+// ListPreviewDeploymentsRequestValidationError is the validation error
+// returned by ListPreviewDeploymentsRequest.Validate if the designated
 // constraints aren't met.
-type ListOrganizationMembersRequestValidationError struct {
+type ListPreviewDeploymentsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5757,24 +5838,24 @@ type ListOrganizationMembersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListOrganizationMembersRequestValidationError) Field() string { return e.field }
+func (e ListPreviewDeploymentsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListOrganizationMembersRequestValidationError) Reason() string { return e.reason }
+func (e ListPreviewDeploymentsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListOrganizationMembersRequestValidationError) Cause() error { return e.cause }
+func (e ListPreviewDeploymentsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListOrganizationMembersRequestValidationError) Key() bool { return e.key }
+func (e ListPreviewDeploymentsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListOrganizationMembersRequestValidationError) ErrorName() string {
-	return "ListOrganizationMembersRequestValidationError"
+func (e ListPreviewDeploymentsRequestValidationError) ErrorName() string {
+	return "ListPreviewDeploymentsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListOrganizationMembersRequestValidationError) Error() string {
+func (e ListPreviewDeploymentsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5786,14 +5867,14 @@ func (e ListOrganizationMembersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListOrganizationMembersRequest.%s: %s%s",
+		"invalid %sListPreviewDeploymentsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListOrganizationMembersRequestValidationError{}
+var _ error = ListPreviewDeploymentsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5801,47 +5882,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListOrganizationMembersRequestValidationError{}
+} = ListPreviewDeploymentsRequestValidationError{}
 
-// Validate checks the field values on ListOrganizationMembersResponse with the
+// Validate checks the field values on ListPreviewDeploymentsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListOrganizationMembersResponse) Validate() error {
+func (m *ListPreviewDeploymentsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListOrganizationMembersResponse with
+// ValidateAll checks the field values on ListPreviewDeploymentsResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// ListOrganizationMembersResponseMultiError, or nil if none found.
-func (m *ListOrganizationMembersResponse) ValidateAll() error {
+// ListPreviewDeploymentsResponseMultiError, or nil if none found.
+func (m *ListPreviewDeploymentsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListOrganizationMembersResponse) validate(all bool) error {
+func (m *ListPreviewDeploymentsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetMembers() {
+	for idx, item := range m.GetDeployments() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListOrganizationMembersResponseValidationError{
-						field:  fmt.Sprintf("Members[%v]", idx),
+					errors = append(errors, ListPreviewDeploymentsResponseValidationError{
+						field:  fmt.Sprintf("Deployments[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListOrganizationMembersResponseValidationError{
-						field:  fmt.Sprintf("Members[%v]", idx),
+					errors = append(errors, ListPreviewDeploymentsResponseValidationError{
+						field:  fmt.Sprintf("Deployments[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -5849,8 +5930,8 @@ func (m *ListOrganizationMembersResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListOrganizationMembersResponseValidationError{
-					field:  fmt.Sprintf("Members[%v]", idx),
+				return ListPreviewDeploymentsResponseValidationError{
+					field:  fmt.Sprintf("Deployments[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -5859,22 +5940,20 @@ func (m *ListOrganizationMembersResponse) validate(all bool) error {
 
 	}
 
-	// no validation rules for NextPageToken
-
 	if len(errors) > 0 {
-		return ListOrganizationMembersResponseMultiError(errors)
+		return ListPreviewDeploymentsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListOrganizationMembersResponseMultiError is an error wrapping multiple
-// validation errors returned by ListOrganizationMembersResponse.ValidateAll()
+// ListPreviewDeploymentsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListPreviewDeploymentsResponse.ValidateAll()
 // if the designated constraints aren't met.
-type ListOrganizationMembersResponseMultiError []error
+type ListPreviewDeploymentsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListOrganizationMembersResponseMultiError) Error() string {
+func (m ListPreviewDeploymentsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5883,12 +5962,12 @@ func (m ListOrganizationMembersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListOrganizationMembersResponseMultiError) AllErrors() []error { return m }
+func (m ListPreviewDeploymentsResponseMultiError) AllErrors() []error { return m }
 
-// ListOrganizationMembersResponseValidationError is the validation error
-// returned by ListOrganizationMembersResponse.Validate if the designated
+// ListPreviewDeploymentsResponseValidationError is the validation error
+// returned by ListPreviewDeploymentsResponse.Validate if the designated
 // constraints aren't met.
-type ListOrganizationMembersResponseValidationError struct {
+type ListPreviewDeploymentsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5896,24 +5975,24 @@ type ListOrganizationMembersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListOrganizationMembersResponseValidationError) Field() string { return e.field }
+func (e ListPreviewDeploymentsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListOrganizationMembersResponseValidationError) Reason() string { return e.reason }
+func (e ListPreviewDeploymentsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListOrganizationMembersResponseValidationError) Cause() error { return e.cause }
+func (e ListPreviewDeploymentsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListOrganizationMembersResponseValidationError) Key() bool { return e.key }
+func (e ListPreviewDeploymentsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListOrganizationMembersResponseValidationError) ErrorName() string {
-	return "ListOrganizationMembersResponseValidationError"
+func (e ListPreviewDeploymentsResponseValidationError) ErrorName() string {
+	return "ListPreviewDeploymentsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListOrganizationMembersResponseValidationError) Error() string {
+func (e ListPreviewDeploymentsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5925,14 +6004,14 @@ func (e ListOrganizationMembersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListOrganizationMembersResponse.%s: %s%s",
+		"invalid %sListPreviewDeploymentsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListOrganizationMembersResponseValidationError{}
+var _ error = ListPreviewDeploymentsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5940,63 +6019,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListOrganizationMembersResponseValidationError{}
+} = ListPreviewDeploymentsResponseValidationError{}
 
-// Validate checks the field values on ListOrganizationInvitesRequest with the
+// Validate checks the field values on GetPreviewDeploymentRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListOrganizationInvitesRequest) Validate() error {
+func (m *GetPreviewDeploymentRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListOrganizationInvitesRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ListOrganizationInvitesRequestMultiError, or nil if none found.
-func (m *ListOrganizationInvitesRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetPreviewDeploymentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPreviewDeploymentRequestMultiError, or nil if none found.
+func (m *GetPreviewDeploymentRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListOrganizationInvitesRequest) validate(all bool) error {
+func (m *GetPreviewDeploymentRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
-
-	if m.GetPageSize() != 0 {
-
-		if m.GetPageSize() > 1000 {
-			err := ListOrganizationInvitesRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 1000",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
+		err := GetPreviewDeploymentRequestValidationError{
+			field:  "OrganizationName",
+			reason: "value length must be at least 1 runes",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := GetPreviewDeploymentRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for PageToken
+	if utf8.RuneCountInString(m.GetBranch()) < 1 {
+		err := GetPreviewDeploymentRequestValidationError{
+			field:  "Branch",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return ListOrganizationInvitesRequestMultiError(errors)
+		return GetPreviewDeploymentRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListOrganizationInvitesRequestMultiError is an error wrapping multiple
-// validation errors returned by ListOrganizationInvitesRequest.ValidateAll()
-// if the designated constraints aren't met.
-type ListOrganizationInvitesRequestMultiError []error
+// GetPreviewDeploymentRequestMultiError is an error wrapping multiple
+// validation errors returned by GetPreviewDeploymentRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetPreviewDeploymentRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListOrganizationInvitesRequestMultiError) Error() string {
+func (m GetPreviewDeploymentRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6005,12 +6098,12 @@ func (m ListOrganizationInvitesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListOrganizationInvitesRequestMultiError) AllErrors() []error { return m }
+func (m GetPreviewDeploymentRequestMultiError) AllErrors() []error { return m }
 
-// ListOrganizationInvitesRequestValidationError is the validation error
-// returned by ListOrganizationInvitesRequest.Validate if the designated
-// constraints aren't met.
-type ListOrganizationInvitesRequestValidationError struct {
+// GetPreviewDeploymentRequestValidationError is the validation error returned
+// by GetPreviewDeploymentRequest.Validate if the designated constraints
+// aren't met.
+type GetPreviewDeploymentRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6018,24 +6111,24 @@ type ListOrganizationInvitesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListOrganizationInvitesRequestValidationError) Field() string { return e.field }
+func (e GetPreviewDeploymentRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListOrganizationInvitesRequestValidationError) Reason() string { return e.reason }
+func (e GetPreviewDeploymentRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListOrganizationInvitesRequestValidationError) Cause() error { return e.cause }
+func (e GetPreviewDeploymentRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListOrganizationInvitesRequestValidationError) Key() bool { return e.key }
+func (e GetPreviewDeploymentRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListOrganizationInvitesRequestValidationError) ErrorName() string {
-	return "ListOrganizationInvitesRequestValidationError"
+func (e GetPreviewDeploymentRequestValidationError) ErrorName() string {
+	return "GetPreviewDeploymentRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListOrganizationInvitesRequestValidationError) Error() string {
+func (e GetPreviewDeploymentRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6047,14 +6140,14 @@ func (e ListOrganizationInvitesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListOrganizationInvitesRequest.%s: %s%s",
+		"invalid %sGetPreviewDeploymentRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListOrganizationInvitesRequestValidationError{}
+var _ error = GetPreviewDeploymentRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6062,80 +6155,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListOrganizationInvitesRequestValidationError{}
+} = GetPreviewDeploymentRequestValidationError{}
 
-// Validate checks the field values on ListOrganizationInvitesResponse with the
+// Validate checks the field values on GetPreviewDeploymentResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListOrganizationInvitesResponse) Validate() error {
+func (m *GetPreviewDeploymentResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListOrganizationInvitesResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ListOrganizationInvitesResponseMultiError, or nil if none found.
-func (m *ListOrganizationInvitesResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetPreviewDeploymentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPreviewDeploymentResponseMultiError, or nil if none found.
+func (m *GetPreviewDeploymentResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListOrganizationInvitesResponse) validate(all bool) error {
+func (m *GetPreviewDeploymentResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetInvites() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListOrganizationInvitesResponseValidationError{
-						field:  fmt.Sprintf("Invites[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListOrganizationInvitesResponseValidationError{
-						field:  fmt.Sprintf("Invites[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetDeployment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPreviewDeploymentResponseValidationError{
+					field:  "Deployment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListOrganizationInvitesResponseValidationError{
-					field:  fmt.Sprintf("Invites[%v]", idx),
+				errors = append(errors, GetPreviewDeploymentResponseValidationError{
+					field:  "Deployment",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeployment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPreviewDeploymentResponseValidationError{
+				field:  "Deployment",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for NextPageToken
+	// no validation rules for Jwt
 
 	if len(errors) > 0 {
-		return ListOrganizationInvitesResponseMultiError(errors)
+		return GetPreviewDeploymentResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListOrganizationInvitesResponseMultiError is an error wrapping multiple
-// validation errors returned by ListOrganizationInvitesResponse.ValidateAll()
-// if the designated constraints aren't met.
-type ListOrganizationInvitesResponseMultiError []error
+// GetPreviewDeploymentResponseMultiError is an error wrapping multiple
+// validation errors returned by GetPreviewDeploymentResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetPreviewDeploymentResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListOrganizationInvitesResponseMultiError) Error() string {
+func (m GetPreviewDeploymentResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6144,12 +6232,12 @@ func (m ListOrganizationInvitesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListOrganizationInvitesResponseMultiError) AllErrors() []error { return m }
+func (m GetPreviewDeploymentResponseMultiError) AllErrors() []error { return m }
 
-// ListOrganizationInvitesResponseValidationError is the validation error
-// returned by ListOrganizationInvitesResponse.Validate if the designated
-// constraints aren't met.
-type ListOrganizationInvitesResponseValidationError struct {
+// GetPreviewDeploymentResponseValidationError is the validation error returned
+// by GetPreviewDeploymentResponse.Validate if the designated constraints
+// aren't met.
+type GetPreviewDeploymentResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6157,24 +6245,24 @@ type ListOrganizationInvitesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListOrganizationInvitesResponseValidationError) Field() string { return e.field }
+func (e GetPreviewDeploymentResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListOrganizationInvitesResponseValidationError) Reason() string { return e.reason }
+func (e GetPreviewDeploymentResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListOrganizationInvitesResponseValidationError) Cause() error { return e.cause }
+func (e GetPreviewDeploymentResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListOrganizationInvitesResponseValidationError) Key() bool { return e.key }
+func (e GetPreviewDeploymentResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListOrganizationInvitesResponseValidationError) ErrorName() string {
-	return "ListOrganizationInvitesResponseValidationError"
+func (e GetPreviewDeploymentResponseValidationError) ErrorName() string {
+	return "GetPreviewDeploymentResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListOrganizationInvitesResponseValidationError) Error() string {
+func (e GetPreviewDeploymentResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6186,14 +6274,14 @@ func (e ListOrganizationInvitesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListOrganizationInvitesResponse.%s: %s%s",
+		"invalid %sGetPreviewDeploymentResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListOrganizationInvitesResponseValidationError{}
+var _ error = GetPreviewDeploymentResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6201,33 +6289,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListOrganizationInvitesResponseValidationError{}
+} = GetPreviewDeploymentResponseValidationError{}
 
-// Validate checks the field values on AddOrganizationMemberRequest with the
+// Validate checks the field values on CreatePreviewDeploymentRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddOrganizationMemberRequest) Validate() error {
+func (m *CreatePreviewDeploymentRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddOrganizationMemberRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AddOrganizationMemberRequestMultiError, or nil if none found.
-func (m *AddOrganizationMemberRequest) ValidateAll() error {
+// ValidateAll checks the field values on CreatePreviewDeploymentRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreatePreviewDeploymentRequestMultiError, or nil if none found.
+func (m *CreatePreviewDeploymentRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddOrganizationMemberRequest) validate(all bool) error {
+func (m *CreatePreviewDeploymentRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := AddOrganizationMemberRequestValidationError{
-			field:  "Organization",
+	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
+		err := CreatePreviewDeploymentRequestValidationError{
+			field:  "OrganizationName",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -6236,11 +6324,10 @@ func (m *AddOrganizationMemberRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = AddOrganizationMemberRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := CreatePreviewDeploymentRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -6248,72 +6335,37 @@ func (m *AddOrganizationMemberRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Role
-
-	if len(errors) > 0 {
-		return AddOrganizationMemberRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *AddOrganizationMemberRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
-
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
+	if utf8.RuneCountInString(m.GetBranch()) < 1 {
+		err := CreatePreviewDeploymentRequestValidationError{
+			field:  "Branch",
+			reason: "value length must be at least 1 runes",
 		}
-
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	return nil
-}
-
-func (m *AddOrganizationMemberRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
+	// no validation rules for Variables
 
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
+	if m.TtlSeconds != nil {
+		// no validation rules for TtlSeconds
 	}
 
-	parts := strings.SplitN(addr, "@", 2)
-
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
+	if len(errors) > 0 {
+		return CreatePreviewDeploymentRequestMultiError(errors)
 	}
 
-	return m._validateHostname(parts[1])
+	return nil
 }
 
-// AddOrganizationMemberRequestMultiError is an error wrapping multiple
-// validation errors returned by AddOrganizationMemberRequest.ValidateAll() if
-// the designated constraints aren't met.
-type AddOrganizationMemberRequestMultiError []error
+// CreatePreviewDeploymentRequestMultiError is an error wrapping multiple
+// validation errors returned by CreatePreviewDeploymentRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CreatePreviewDeploymentRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddOrganizationMemberRequestMultiError) Error() string {
+func (m CreatePreviewDeploymentRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6322,12 +6374,12 @@ func (m AddOrganizationMemberRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddOrganizationMemberRequestMultiError) AllErrors() []error { return m }
+func (m CreatePreviewDeploymentRequestMultiError) AllErrors() []error { return m }
 
-// AddOrganizationMemberRequestValidationError is the validation error returned
-// by AddOrganizationMemberRequest.Validate if the designated constraints
-// aren't met.
-type AddOrganizationMemberRequestValidationError struct {
+// CreatePreviewDeploymentRequestValidationError is the validation error
+// returned by CreatePreviewDeploymentRequest.Validate if the designated
+// constraints aren't met.
+type CreatePreviewDeploymentRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6335,24 +6387,24 @@ type AddOrganizationMemberRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddOrganizationMemberRequestValidationError) Field() string { return e.field }
+func (e CreatePreviewDeploymentRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddOrganizationMemberRequestValidationError) Reason() string { return e.reason }
+func (e CreatePreviewDeploymentRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddOrganizationMemberRequestValidationError) Cause() error { return e.cause }
+func (e CreatePreviewDeploymentRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddOrganizationMemberRequestValidationError) Key() bool { return e.key }
+func (e CreatePreviewDeploymentRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddOrganizationMemberRequestValidationError) ErrorName() string {
-	return "AddOrganizationMemberRequestValidationError"
+func (e CreatePreviewDeploymentRequestValidationError) ErrorName() string {
+	return "CreatePreviewDeploymentRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddOrganizationMemberRequestValidationError) Error() string {
+func (e CreatePreviewDeploymentRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6364,14 +6416,14 @@ func (e AddOrganizationMemberRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddOrganizationMemberRequest.%s: %s%s",
+		"invalid %sCreatePreviewDeploymentRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddOrganizationMemberRequestValidationError{}
+var _ error = CreatePreviewDeploymentRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6379,46 +6431,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddOrganizationMemberRequestValidationError{}
+} = CreatePreviewDeploymentRequestValidationError{}
 
-// Validate checks the field values on AddOrganizationMemberResponse with the
+// Validate checks the field values on CreatePreviewDeploymentResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddOrganizationMemberResponse) Validate() error {
+func (m *CreatePreviewDeploymentResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddOrganizationMemberResponse with
+// ValidateAll checks the field values on CreatePreviewDeploymentResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// AddOrganizationMemberResponseMultiError, or nil if none found.
-func (m *AddOrganizationMemberResponse) ValidateAll() error {
+// CreatePreviewDeploymentResponseMultiError, or nil if none found.
+func (m *CreatePreviewDeploymentResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddOrganizationMemberResponse) validate(all bool) error {
+func (m *CreatePreviewDeploymentResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PendingSignup
+	if all {
+		switch v := interface{}(m.GetDeployment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePreviewDeploymentResponseValidationError{
+					field:  "Deployment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePreviewDeploymentResponseValidationError{
+					field:  "Deployment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeployment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreatePreviewDeploymentResponseValidationError{
+				field:  "Deployment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return AddOrganizationMemberResponseMultiError(errors)
+		return CreatePreviewDeploymentResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddOrganizationMemberResponseMultiError is an error wrapping multiple
-// validation errors returned by AddOrganizationMemberResponse.ValidateAll()
+// CreatePreviewDeploymentResponseMultiError is an error wrapping multiple
+// validation errors returned by CreatePreviewDeploymentResponse.ValidateAll()
 // if the designated constraints aren't met.
-type AddOrganizationMemberResponseMultiError []error
+type CreatePreviewDeploymentResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddOrganizationMemberResponseMultiError) Error() string {
+func (m CreatePreviewDeploymentResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6427,12 +6506,12 @@ func (m AddOrganizationMemberResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddOrganizationMemberResponseMultiError) AllErrors() []error { return m }
+func (m CreatePreviewDeploymentResponseMultiError) AllErrors() []error { return m }
 
-// AddOrganizationMemberResponseValidationError is the validation error
-// returned by AddOrganizationMemberResponse.Validate if the designated
+// CreatePreviewDeploymentResponseValidationError is the validation error
+// returned by CreatePreviewDeploymentResponse.Validate if the designated
 // constraints aren't met.
-type AddOrganizationMemberResponseValidationError struct {
+type CreatePreviewDeploymentResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6440,24 +6519,24 @@ type AddOrganizationMemberResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddOrganizationMemberResponseValidationError) Field() string { return e.field }
+func (e CreatePreviewDeploymentResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddOrganizationMemberResponseValidationError) Reason() string { return e.reason }
+func (e CreatePreviewDeploymentResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddOrganizationMemberResponseValidationError) Cause() error { return e.cause }
+func (e CreatePreviewDeploymentResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddOrganizationMemberResponseValidationError) Key() bool { return e.key }
+func (e CreatePreviewDeploymentResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddOrganizationMemberResponseValidationError) ErrorName() string {
-	return "AddOrganizationMemberResponseValidationError"
+func (e CreatePreviewDeploymentResponseValidationError) ErrorName() string {
+	return "CreatePreviewDeploymentResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddOrganizationMemberResponseValidationError) Error() string {
+func (e CreatePreviewDeploymentResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6469,14 +6548,14 @@ func (e AddOrganizationMemberResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddOrganizationMemberResponse.%s: %s%s",
+		"invalid %sCreatePreviewDeploymentResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddOrganizationMemberResponseValidationError{}
+var _ error = CreatePreviewDeploymentResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6484,33 +6563,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddOrganizationMemberResponseValidationError{}
+} = CreatePreviewDeploymentResponseValidationError{}
 
-// Validate checks the field values on RemoveOrganizationMemberRequest with the
+// Validate checks the field values on DeletePreviewDeploymentRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveOrganizationMemberRequest) Validate() error {
+func (m *DeletePreviewDeploymentRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveOrganizationMemberRequest with
+// ValidateAll checks the field values on DeletePreviewDeploymentRequest with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// RemoveOrganizationMemberRequestMultiError, or nil if none found.
-func (m *RemoveOrganizationMemberRequest) ValidateAll() error {
+// DeletePreviewDeploymentRequestMultiError, or nil if none found.
+func (m *DeletePreviewDeploymentRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveOrganizationMemberRequest) validate(all bool) error {
+func (m *DeletePreviewDeploymentRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := RemoveOrganizationMemberRequestValidationError{
-			field:  "Organization",
+	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
+		err := DeletePreviewDeploymentRequestValidationError{
+			field:  "OrganizationName",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -6519,11 +6598,10 @@ func (m *RemoveOrganizationMemberRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = RemoveOrganizationMemberRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := DeletePreviewDeploymentRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -6531,111 +6609,70 @@ func (m *RemoveOrganizationMemberRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for KeepProjectRoles
+	if utf8.RuneCountInString(m.GetBranch()) < 1 {
+		err := DeletePreviewDeploymentRequestValidationError{
+			field:  "Branch",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return RemoveOrganizationMemberRequestMultiError(errors)
+		return DeletePreviewDeploymentRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *RemoveOrganizationMemberRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
+// DeletePreviewDeploymentRequestMultiError is an error wrapping multiple
+// validation errors returned by DeletePreviewDeploymentRequest.ValidateAll()
+// if the designated constraints aren't met.
+type DeletePreviewDeploymentRequestMultiError []error
 
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePreviewDeploymentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
+// AllErrors returns a list of validation violation errors.
+func (m DeletePreviewDeploymentRequestMultiError) AllErrors() []error { return m }
 
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
+// DeletePreviewDeploymentRequestValidationError is the validation error
+// returned by DeletePreviewDeploymentRequest.Validate if the designated
+// constraints aren't met.
+type DeletePreviewDeploymentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
+// Field function returns field value.
+func (e DeletePreviewDeploymentRequestValidationError) Field() string { return e.field }
 
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
-			}
-		}
-	}
+// Reason function returns reason value.
+func (e DeletePreviewDeploymentRequestValidationError) Reason() string { return e.reason }
 
-	return nil
-}
+// Cause function returns cause value.
+func (e DeletePreviewDeploymentRequestValidationError) Cause() error { return e.cause }
 
-func (m *RemoveOrganizationMemberRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
-
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
-	}
-
-	parts := strings.SplitN(addr, "@", 2)
-
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
-	}
-
-	return m._validateHostname(parts[1])
-}
-
-// RemoveOrganizationMemberRequestMultiError is an error wrapping multiple
-// validation errors returned by RemoveOrganizationMemberRequest.ValidateAll()
-// if the designated constraints aren't met.
-type RemoveOrganizationMemberRequestMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m RemoveOrganizationMemberRequestMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m RemoveOrganizationMemberRequestMultiError) AllErrors() []error { return m }
-
-// RemoveOrganizationMemberRequestValidationError is the validation error
-// returned by RemoveOrganizationMemberRequest.Validate if the designated
-// constraints aren't met.
-type RemoveOrganizationMemberRequestValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e RemoveOrganizationMemberRequestValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e RemoveOrganizationMemberRequestValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e RemoveOrganizationMemberRequestValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e RemoveOrganizationMemberRequestValidationError) Key() bool { return e.key }
+// Key function returns key value.
+func (e DeletePreviewDeploymentRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveOrganizationMemberRequestValidationError) ErrorName() string {
-	return "RemoveOrganizationMemberRequestValidationError"
+func (e DeletePreviewDeploymentRequestValidationError) ErrorName() string {
+	return "DeletePreviewDeploymentRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveOrganizationMemberRequestValidationError) Error() string {
+func (e DeletePreviewDeploymentRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6647,14 +6684,14 @@ func (e RemoveOrganizationMemberRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveOrganizationMemberRequest.%s: %s%s",
+		"invalid %sDeletePreviewDeploymentRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveOrganizationMemberRequestValidationError{}
+var _ error = DeletePreviewDeploymentRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6662,25 +6699,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveOrganizationMemberRequestValidationError{}
+} = DeletePreviewDeploymentRequestValidationError{}
 
-// Validate checks the field values on RemoveOrganizationMemberResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *RemoveOrganizationMemberResponse) Validate() error {
+// Validate checks the field values on DeletePreviewDeploymentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePreviewDeploymentResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveOrganizationMemberResponse with
+// ValidateAll checks the field values on DeletePreviewDeploymentResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// RemoveOrganizationMemberResponseMultiError, or nil if none found.
-func (m *RemoveOrganizationMemberResponse) ValidateAll() error {
+// DeletePreviewDeploymentResponseMultiError, or nil if none found.
+func (m *DeletePreviewDeploymentResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveOrganizationMemberResponse) validate(all bool) error {
+func (m *DeletePreviewDeploymentResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -6688,20 +6724,19 @@ func (m *RemoveOrganizationMemberResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return RemoveOrganizationMemberResponseMultiError(errors)
+		return DeletePreviewDeploymentResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveOrganizationMemberResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// RemoveOrganizationMemberResponse.ValidateAll() if the designated
-// constraints aren't met.
-type RemoveOrganizationMemberResponseMultiError []error
+// DeletePreviewDeploymentResponseMultiError is an error wrapping multiple
+// validation errors returned by DeletePreviewDeploymentResponse.ValidateAll()
+// if the designated constraints aren't met.
+type DeletePreviewDeploymentResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveOrganizationMemberResponseMultiError) Error() string {
+func (m DeletePreviewDeploymentResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6710,12 +6745,12 @@ func (m RemoveOrganizationMemberResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveOrganizationMemberResponseMultiError) AllErrors() []error { return m }
+func (m DeletePreviewDeploymentResponseMultiError) AllErrors() []error { return m }
 
-// RemoveOrganizationMemberResponseValidationError is the validation error
-// returned by RemoveOrganizationMemberResponse.Validate if the designated
+// DeletePreviewDeploymentResponseValidationError is the validation error
+// returned by DeletePreviewDeploymentResponse.Validate if the designated
 // constraints aren't met.
-type RemoveOrganizationMemberResponseValidationError struct {
+type DeletePreviewDeploymentResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6723,24 +6758,24 @@ type RemoveOrganizationMemberResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveOrganizationMemberResponseValidationError) Field() string { return e.field }
+func (e DeletePreviewDeploymentResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveOrganizationMemberResponseValidationError) Reason() string { return e.reason }
+func (e DeletePreviewDeploymentResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveOrganizationMemberResponseValidationError) Cause() error { return e.cause }
+func (e DeletePreviewDeploymentResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveOrganizationMemberResponseValidationError) Key() bool { return e.key }
+func (e DeletePreviewDeploymentResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveOrganizationMemberResponseValidationError) ErrorName() string {
-	return "RemoveOrganizationMemberResponseValidationError"
+func (e DeletePreviewDeploymentResponseValidationError) ErrorName() string {
+	return "DeletePreviewDeploymentResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveOrganizationMemberResponseValidationError) Error() string {
+func (e DeletePreviewDeploymentResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6752,14 +6787,14 @@ func (e RemoveOrganizationMemberResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveOrganizationMemberResponse.%s: %s%s",
+		"invalid %sDeletePreviewDeploymentResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveOrganizationMemberResponseValidationError{}
+var _ error = DeletePreviewDeploymentResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6767,55 +6802,63 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveOrganizationMemberResponseValidationError{}
+} = DeletePreviewDeploymentResponseValidationError{}
 
-// Validate checks the field values on LeaveOrganizationRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListOrganizationMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *LeaveOrganizationRequest) Validate() error {
+func (m *ListOrganizationMembersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LeaveOrganizationRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// LeaveOrganizationRequestMultiError, or nil if none found.
-func (m *LeaveOrganizationRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListOrganizationMembersRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListOrganizationMembersRequestMultiError, or nil if none found.
+func (m *ListOrganizationMembersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LeaveOrganizationRequest) validate(all bool) error {
+func (m *ListOrganizationMembersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := LeaveOrganizationRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
+	// no validation rules for Organization
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := ListOrganizationMembersRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		errors = append(errors, err)
+
 	}
 
+	// no validation rules for PageToken
+
 	if len(errors) > 0 {
-		return LeaveOrganizationRequestMultiError(errors)
+		return ListOrganizationMembersRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// LeaveOrganizationRequestMultiError is an error wrapping multiple validation
-// errors returned by LeaveOrganizationRequest.ValidateAll() if the designated
-// constraints aren't met.
-type LeaveOrganizationRequestMultiError []error
+// ListOrganizationMembersRequestMultiError is an error wrapping multiple
+// validation errors returned by ListOrganizationMembersRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListOrganizationMembersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LeaveOrganizationRequestMultiError) Error() string {
+func (m ListOrganizationMembersRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6824,11 +6867,12 @@ func (m LeaveOrganizationRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LeaveOrganizationRequestMultiError) AllErrors() []error { return m }
+func (m ListOrganizationMembersRequestMultiError) AllErrors() []error { return m }
 
-// LeaveOrganizationRequestValidationError is the validation error returned by
-// LeaveOrganizationRequest.Validate if the designated constraints aren't met.
-type LeaveOrganizationRequestValidationError struct {
+// ListOrganizationMembersRequestValidationError is the validation error
+// returned by ListOrganizationMembersRequest.Validate if the designated
+// constraints aren't met.
+type ListOrganizationMembersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6836,24 +6880,24 @@ type LeaveOrganizationRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e LeaveOrganizationRequestValidationError) Field() string { return e.field }
+func (e ListOrganizationMembersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LeaveOrganizationRequestValidationError) Reason() string { return e.reason }
+func (e ListOrganizationMembersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LeaveOrganizationRequestValidationError) Cause() error { return e.cause }
+func (e ListOrganizationMembersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LeaveOrganizationRequestValidationError) Key() bool { return e.key }
+func (e ListOrganizationMembersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LeaveOrganizationRequestValidationError) ErrorName() string {
-	return "LeaveOrganizationRequestValidationError"
+func (e ListOrganizationMembersRequestValidationError) ErrorName() string {
+	return "ListOrganizationMembersRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e LeaveOrganizationRequestValidationError) Error() string {
+func (e ListOrganizationMembersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6865,14 +6909,14 @@ func (e LeaveOrganizationRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLeaveOrganizationRequest.%s: %s%s",
+		"invalid %sListOrganizationMembersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LeaveOrganizationRequestValidationError{}
+var _ error = ListOrganizationMembersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6880,44 +6924,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LeaveOrganizationRequestValidationError{}
+} = ListOrganizationMembersRequestValidationError{}
 
-// Validate checks the field values on LeaveOrganizationResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListOrganizationMembersResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *LeaveOrganizationResponse) Validate() error {
+func (m *ListOrganizationMembersResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LeaveOrganizationResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// LeaveOrganizationResponseMultiError, or nil if none found.
-func (m *LeaveOrganizationResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListOrganizationMembersResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListOrganizationMembersResponseMultiError, or nil if none found.
+func (m *ListOrganizationMembersResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LeaveOrganizationResponse) validate(all bool) error {
+func (m *ListOrganizationMembersResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOrganizationMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOrganizationMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOrganizationMembersResponseValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return LeaveOrganizationResponseMultiError(errors)
+		return ListOrganizationMembersResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// LeaveOrganizationResponseMultiError is an error wrapping multiple validation
-// errors returned by LeaveOrganizationResponse.ValidateAll() if the
-// designated constraints aren't met.
-type LeaveOrganizationResponseMultiError []error
+// ListOrganizationMembersResponseMultiError is an error wrapping multiple
+// validation errors returned by ListOrganizationMembersResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListOrganizationMembersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LeaveOrganizationResponseMultiError) Error() string {
+func (m ListOrganizationMembersResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6926,11 +7006,12 @@ func (m LeaveOrganizationResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LeaveOrganizationResponseMultiError) AllErrors() []error { return m }
+func (m ListOrganizationMembersResponseMultiError) AllErrors() []error { return m }
 
-// LeaveOrganizationResponseValidationError is the validation error returned by
-// LeaveOrganizationResponse.Validate if the designated constraints aren't met.
-type LeaveOrganizationResponseValidationError struct {
+// ListOrganizationMembersResponseValidationError is the validation error
+// returned by ListOrganizationMembersResponse.Validate if the designated
+// constraints aren't met.
+type ListOrganizationMembersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6938,24 +7019,24 @@ type LeaveOrganizationResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e LeaveOrganizationResponseValidationError) Field() string { return e.field }
+func (e ListOrganizationMembersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LeaveOrganizationResponseValidationError) Reason() string { return e.reason }
+func (e ListOrganizationMembersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LeaveOrganizationResponseValidationError) Cause() error { return e.cause }
+func (e ListOrganizationMembersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LeaveOrganizationResponseValidationError) Key() bool { return e.key }
+func (e ListOrganizationMembersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LeaveOrganizationResponseValidationError) ErrorName() string {
-	return "LeaveOrganizationResponseValidationError"
+func (e ListOrganizationMembersResponseValidationError) ErrorName() string {
+	return "ListOrganizationMembersResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e LeaveOrganizationResponseValidationError) Error() string {
+func (e ListOrganizationMembersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6967,14 +7048,14 @@ func (e LeaveOrganizationResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLeaveOrganizationResponse.%s: %s%s",
+		"invalid %sListOrganizationMembersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LeaveOrganizationResponseValidationError{}
+var _ error = ListOrganizationMembersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6982,130 +7063,63 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LeaveOrganizationResponseValidationError{}
+} = ListOrganizationMembersResponseValidationError{}
 
-// Validate checks the field values on SetOrganizationMemberRoleRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *SetOrganizationMemberRoleRequest) Validate() error {
+// Validate checks the field values on ListOrganizationInvitesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListOrganizationInvitesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetOrganizationMemberRoleRequest with
+// ValidateAll checks the field values on ListOrganizationInvitesRequest with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// SetOrganizationMemberRoleRequestMultiError, or nil if none found.
-func (m *SetOrganizationMemberRoleRequest) ValidateAll() error {
+// ListOrganizationInvitesRequestMultiError, or nil if none found.
+func (m *ListOrganizationInvitesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetOrganizationMemberRoleRequest) validate(all bool) error {
+func (m *ListOrganizationInvitesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := SetOrganizationMemberRoleRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = SetOrganizationMemberRoleRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetRole()) < 1 {
-		err := SetOrganizationMemberRoleRequestValidationError{
-			field:  "Role",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(errors) > 0 {
-		return SetOrganizationMemberRoleRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *SetOrganizationMemberRoleRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
+	// no validation rules for Organization
 
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
+	if m.GetPageSize() != 0 {
 
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+		if m.GetPageSize() > 1000 {
+			err := ListOrganizationInvitesRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
 			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-	}
-
-	return nil
-}
 
-func (m *SetOrganizationMemberRoleRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
-
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
 	}
 
-	parts := strings.SplitN(addr, "@", 2)
+	// no validation rules for PageToken
 
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
+	if len(errors) > 0 {
+		return ListOrganizationInvitesRequestMultiError(errors)
 	}
 
-	return m._validateHostname(parts[1])
+	return nil
 }
 
-// SetOrganizationMemberRoleRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// SetOrganizationMemberRoleRequest.ValidateAll() if the designated
-// constraints aren't met.
-type SetOrganizationMemberRoleRequestMultiError []error
+// ListOrganizationInvitesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListOrganizationInvitesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListOrganizationInvitesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetOrganizationMemberRoleRequestMultiError) Error() string {
+func (m ListOrganizationInvitesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7114,12 +7128,12 @@ func (m SetOrganizationMemberRoleRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetOrganizationMemberRoleRequestMultiError) AllErrors() []error { return m }
+func (m ListOrganizationInvitesRequestMultiError) AllErrors() []error { return m }
 
-// SetOrganizationMemberRoleRequestValidationError is the validation error
-// returned by SetOrganizationMemberRoleRequest.Validate if the designated
+// ListOrganizationInvitesRequestValidationError is the validation error
+// returned by ListOrganizationInvitesRequest.Validate if the designated
 // constraints aren't met.
-type SetOrganizationMemberRoleRequestValidationError struct {
+type ListOrganizationInvitesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7127,24 +7141,24 @@ type SetOrganizationMemberRoleRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetOrganizationMemberRoleRequestValidationError) Field() string { return e.field }
+func (e ListOrganizationInvitesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetOrganizationMemberRoleRequestValidationError) Reason() string { return e.reason }
+func (e ListOrganizationInvitesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetOrganizationMemberRoleRequestValidationError) Cause() error { return e.cause }
+func (e ListOrganizationInvitesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetOrganizationMemberRoleRequestValidationError) Key() bool { return e.key }
+func (e ListOrganizationInvitesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetOrganizationMemberRoleRequestValidationError) ErrorName() string {
-	return "SetOrganizationMemberRoleRequestValidationError"
+func (e ListOrganizationInvitesRequestValidationError) ErrorName() string {
+	return "ListOrganizationInvitesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetOrganizationMemberRoleRequestValidationError) Error() string {
+func (e ListOrganizationInvitesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7156,14 +7170,14 @@ func (e SetOrganizationMemberRoleRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetOrganizationMemberRoleRequest.%s: %s%s",
+		"invalid %sListOrganizationInvitesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetOrganizationMemberRoleRequestValidationError{}
+var _ error = ListOrganizationInvitesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7171,46 +7185,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetOrganizationMemberRoleRequestValidationError{}
+} = ListOrganizationInvitesRequestValidationError{}
 
-// Validate checks the field values on SetOrganizationMemberRoleResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *SetOrganizationMemberRoleResponse) Validate() error {
+// Validate checks the field values on ListOrganizationInvitesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListOrganizationInvitesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetOrganizationMemberRoleResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// SetOrganizationMemberRoleResponseMultiError, or nil if none found.
-func (m *SetOrganizationMemberRoleResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListOrganizationInvitesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListOrganizationInvitesResponseMultiError, or nil if none found.
+func (m *ListOrganizationInvitesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetOrganizationMemberRoleResponse) validate(all bool) error {
+func (m *ListOrganizationInvitesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetInvites() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOrganizationInvitesResponseValidationError{
+						field:  fmt.Sprintf("Invites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOrganizationInvitesResponseValidationError{
+						field:  fmt.Sprintf("Invites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOrganizationInvitesResponseValidationError{
+					field:  fmt.Sprintf("Invites[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return SetOrganizationMemberRoleResponseMultiError(errors)
+		return ListOrganizationInvitesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetOrganizationMemberRoleResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// SetOrganizationMemberRoleResponse.ValidateAll() if the designated
-// constraints aren't met.
-type SetOrganizationMemberRoleResponseMultiError []error
+// ListOrganizationInvitesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListOrganizationInvitesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListOrganizationInvitesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetOrganizationMemberRoleResponseMultiError) Error() string {
+func (m ListOrganizationInvitesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7219,12 +7267,12 @@ func (m SetOrganizationMemberRoleResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetOrganizationMemberRoleResponseMultiError) AllErrors() []error { return m }
+func (m ListOrganizationInvitesResponseMultiError) AllErrors() []error { return m }
 
-// SetOrganizationMemberRoleResponseValidationError is the validation error
-// returned by SetOrganizationMemberRoleResponse.Validate if the designated
+// ListOrganizationInvitesResponseValidationError is the validation error
+// returned by ListOrganizationInvitesResponse.Validate if the designated
 // constraints aren't met.
-type SetOrganizationMemberRoleResponseValidationError struct {
+type ListOrganizationInvitesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7232,24 +7280,24 @@ type SetOrganizationMemberRoleResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetOrganizationMemberRoleResponseValidationError) Field() string { return e.field }
+func (e ListOrganizationInvitesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetOrganizationMemberRoleResponseValidationError) Reason() string { return e.reason }
+func (e ListOrganizationInvitesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetOrganizationMemberRoleResponseValidationError) Cause() error { return e.cause }
+func (e ListOrganizationInvitesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetOrganizationMemberRoleResponseValidationError) Key() bool { return e.key }
+func (e ListOrganizationInvitesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetOrganizationMemberRoleResponseValidationError) ErrorName() string {
-	return "SetOrganizationMemberRoleResponseValidationError"
+func (e ListOrganizationInvitesResponseValidationError) ErrorName() string {
+	return "ListOrganizationInvitesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetOrganizationMemberRoleResponseValidationError) Error() string {
+func (e ListOrganizationInvitesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7261,14 +7309,14 @@ func (e SetOrganizationMemberRoleResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetOrganizationMemberRoleResponse.%s: %s%s",
+		"invalid %sListOrganizationInvitesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetOrganizationMemberRoleResponseValidationError{}
+var _ error = ListOrganizationInvitesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7276,44 +7324,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetOrganizationMemberRoleResponseValidationError{}
+} = ListOrganizationInvitesResponseValidationError{}
 
-// Validate checks the field values on ListSuperusersRequest with the rules
+// Validate checks the field values on ListAuditEventsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListSuperusersRequest) Validate() error {
+func (m *ListAuditEventsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListSuperusersRequest with the rules
+// ValidateAll checks the field values on ListAuditEventsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListSuperusersRequestMultiError, or nil if none found.
-func (m *ListSuperusersRequest) ValidateAll() error {
+// ListAuditEventsRequestMultiError, or nil if none found.
+func (m *ListAuditEventsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListSuperusersRequest) validate(all bool) error {
+func (m *ListAuditEventsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListAuditEventsRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Project
+
+	// no validation rules for Action
+
+	// no validation rules for ActorEmail
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := ListAuditEventsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
 	if len(errors) > 0 {
-		return ListSuperusersRequestMultiError(errors)
+		return ListAuditEventsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListSuperusersRequestMultiError is an error wrapping multiple validation
-// errors returned by ListSuperusersRequest.ValidateAll() if the designated
+// ListAuditEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListSuperusersRequestMultiError []error
+type ListAuditEventsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListSuperusersRequestMultiError) Error() string {
+func (m ListAuditEventsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7322,11 +7404,11 @@ func (m ListSuperusersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListSuperusersRequestMultiError) AllErrors() []error { return m }
+func (m ListAuditEventsRequestMultiError) AllErrors() []error { return m }
 
-// ListSuperusersRequestValidationError is the validation error returned by
-// ListSuperusersRequest.Validate if the designated constraints aren't met.
-type ListSuperusersRequestValidationError struct {
+// ListAuditEventsRequestValidationError is the validation error returned by
+// ListAuditEventsRequest.Validate if the designated constraints aren't met.
+type ListAuditEventsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7334,24 +7416,24 @@ type ListSuperusersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListSuperusersRequestValidationError) Field() string { return e.field }
+func (e ListAuditEventsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListSuperusersRequestValidationError) Reason() string { return e.reason }
+func (e ListAuditEventsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListSuperusersRequestValidationError) Cause() error { return e.cause }
+func (e ListAuditEventsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListSuperusersRequestValidationError) Key() bool { return e.key }
+func (e ListAuditEventsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListSuperusersRequestValidationError) ErrorName() string {
-	return "ListSuperusersRequestValidationError"
+func (e ListAuditEventsRequestValidationError) ErrorName() string {
+	return "ListAuditEventsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListSuperusersRequestValidationError) Error() string {
+func (e ListAuditEventsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7363,14 +7445,14 @@ func (e ListSuperusersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListSuperusersRequest.%s: %s%s",
+		"invalid %sListAuditEventsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListSuperusersRequestValidationError{}
+var _ error = ListAuditEventsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7378,47 +7460,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListSuperusersRequestValidationError{}
+} = ListAuditEventsRequestValidationError{}
 
-// Validate checks the field values on ListSuperusersResponse with the rules
+// Validate checks the field values on ListAuditEventsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListSuperusersResponse) Validate() error {
+func (m *ListAuditEventsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListSuperusersResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListAuditEventsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListSuperusersResponseMultiError, or nil if none found.
-func (m *ListSuperusersResponse) ValidateAll() error {
+// ListAuditEventsResponseMultiError, or nil if none found.
+func (m *ListAuditEventsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListSuperusersResponse) validate(all bool) error {
+func (m *ListAuditEventsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsers() {
+	for idx, item := range m.GetEvents() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListSuperusersResponseValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
+					errors = append(errors, ListAuditEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListSuperusersResponseValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
+					errors = append(errors, ListAuditEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -7426,8 +7508,8 @@ func (m *ListSuperusersResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListSuperusersResponseValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
+				return ListAuditEventsResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -7436,20 +7518,22 @@ func (m *ListSuperusersResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return ListSuperusersResponseMultiError(errors)
+		return ListAuditEventsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListSuperusersResponseMultiError is an error wrapping multiple validation
-// errors returned by ListSuperusersResponse.ValidateAll() if the designated
+// ListAuditEventsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListSuperusersResponseMultiError []error
+type ListAuditEventsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListSuperusersResponseMultiError) Error() string {
+func (m ListAuditEventsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7458,11 +7542,11 @@ func (m ListSuperusersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListSuperusersResponseMultiError) AllErrors() []error { return m }
+func (m ListAuditEventsResponseMultiError) AllErrors() []error { return m }
 
-// ListSuperusersResponseValidationError is the validation error returned by
-// ListSuperusersResponse.Validate if the designated constraints aren't met.
-type ListSuperusersResponseValidationError struct {
+// ListAuditEventsResponseValidationError is the validation error returned by
+// ListAuditEventsResponse.Validate if the designated constraints aren't met.
+type ListAuditEventsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7470,24 +7554,24 @@ type ListSuperusersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListSuperusersResponseValidationError) Field() string { return e.field }
+func (e ListAuditEventsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListSuperusersResponseValidationError) Reason() string { return e.reason }
+func (e ListAuditEventsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListSuperusersResponseValidationError) Cause() error { return e.cause }
+func (e ListAuditEventsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListSuperusersResponseValidationError) Key() bool { return e.key }
+func (e ListAuditEventsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListSuperusersResponseValidationError) ErrorName() string {
-	return "ListSuperusersResponseValidationError"
+func (e ListAuditEventsResponseValidationError) ErrorName() string {
+	return "ListAuditEventsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListSuperusersResponseValidationError) Error() string {
+func (e ListAuditEventsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7499,14 +7583,14 @@ func (e ListSuperusersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListSuperusersResponse.%s: %s%s",
+		"invalid %sListAuditEventsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListSuperusersResponseValidationError{}
+var _ error = ListAuditEventsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7514,32 +7598,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListSuperusersResponseValidationError{}
+} = ListAuditEventsResponseValidationError{}
 
-// Validate checks the field values on SetSuperuserRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AddOrganizationMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetSuperuserRequest) Validate() error {
+func (m *AddOrganizationMemberRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetSuperuserRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AddOrganizationMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SetSuperuserRequestMultiError, or nil if none found.
-func (m *SetSuperuserRequest) ValidateAll() error {
+// AddOrganizationMemberRequestMultiError, or nil if none found.
+func (m *AddOrganizationMemberRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetSuperuserRequest) validate(all bool) error {
+func (m *AddOrganizationMemberRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := AddOrganizationMemberRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = SetSuperuserRequestValidationError{
+		err = AddOrganizationMemberRequestValidationError{
 			field:  "Email",
 			reason: "value must be a valid email address",
 			cause:  err,
@@ -7550,16 +7645,16 @@ func (m *SetSuperuserRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Superuser
+	// no validation rules for Role
 
 	if len(errors) > 0 {
-		return SetSuperuserRequestMultiError(errors)
+		return AddOrganizationMemberRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *SetSuperuserRequest) _validateHostname(host string) error {
+func (m *AddOrganizationMemberRequest) _validateHostname(host string) error {
 	s := strings.ToLower(strings.TrimSuffix(host, "."))
 
 	if len(host) > 253 {
@@ -7589,7 +7684,7 @@ func (m *SetSuperuserRequest) _validateHostname(host string) error {
 	return nil
 }
 
-func (m *SetSuperuserRequest) _validateEmail(addr string) error {
+func (m *AddOrganizationMemberRequest) _validateEmail(addr string) error {
 	a, err := mail.ParseAddress(addr)
 	if err != nil {
 		return err
@@ -7609,13 +7704,13 @@ func (m *SetSuperuserRequest) _validateEmail(addr string) error {
 	return m._validateHostname(parts[1])
 }
 
-// SetSuperuserRequestMultiError is an error wrapping multiple validation
-// errors returned by SetSuperuserRequest.ValidateAll() if the designated
-// constraints aren't met.
-type SetSuperuserRequestMultiError []error
+// AddOrganizationMemberRequestMultiError is an error wrapping multiple
+// validation errors returned by AddOrganizationMemberRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AddOrganizationMemberRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetSuperuserRequestMultiError) Error() string {
+func (m AddOrganizationMemberRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7624,11 +7719,12 @@ func (m SetSuperuserRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetSuperuserRequestMultiError) AllErrors() []error { return m }
+func (m AddOrganizationMemberRequestMultiError) AllErrors() []error { return m }
 
-// SetSuperuserRequestValidationError is the validation error returned by
-// SetSuperuserRequest.Validate if the designated constraints aren't met.
-type SetSuperuserRequestValidationError struct {
+// AddOrganizationMemberRequestValidationError is the validation error returned
+// by AddOrganizationMemberRequest.Validate if the designated constraints
+// aren't met.
+type AddOrganizationMemberRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7636,24 +7732,24 @@ type SetSuperuserRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetSuperuserRequestValidationError) Field() string { return e.field }
+func (e AddOrganizationMemberRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetSuperuserRequestValidationError) Reason() string { return e.reason }
+func (e AddOrganizationMemberRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetSuperuserRequestValidationError) Cause() error { return e.cause }
+func (e AddOrganizationMemberRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetSuperuserRequestValidationError) Key() bool { return e.key }
+func (e AddOrganizationMemberRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetSuperuserRequestValidationError) ErrorName() string {
-	return "SetSuperuserRequestValidationError"
+func (e AddOrganizationMemberRequestValidationError) ErrorName() string {
+	return "AddOrganizationMemberRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetSuperuserRequestValidationError) Error() string {
+func (e AddOrganizationMemberRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7665,14 +7761,14 @@ func (e SetSuperuserRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetSuperuserRequest.%s: %s%s",
+		"invalid %sAddOrganizationMemberRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetSuperuserRequestValidationError{}
+var _ error = AddOrganizationMemberRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7680,44 +7776,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetSuperuserRequestValidationError{}
+} = AddOrganizationMemberRequestValidationError{}
 
-// Validate checks the field values on SetSuperuserResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AddOrganizationMemberResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetSuperuserResponse) Validate() error {
+func (m *AddOrganizationMemberResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetSuperuserResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SetSuperuserResponseMultiError, or nil if none found.
-func (m *SetSuperuserResponse) ValidateAll() error {
+// ValidateAll checks the field values on AddOrganizationMemberResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AddOrganizationMemberResponseMultiError, or nil if none found.
+func (m *AddOrganizationMemberResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetSuperuserResponse) validate(all bool) error {
+func (m *AddOrganizationMemberResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for PendingSignup
+
 	if len(errors) > 0 {
-		return SetSuperuserResponseMultiError(errors)
+		return AddOrganizationMemberResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetSuperuserResponseMultiError is an error wrapping multiple validation
-// errors returned by SetSuperuserResponse.ValidateAll() if the designated
-// constraints aren't met.
-type SetSuperuserResponseMultiError []error
+// AddOrganizationMemberResponseMultiError is an error wrapping multiple
+// validation errors returned by AddOrganizationMemberResponse.ValidateAll()
+// if the designated constraints aren't met.
+type AddOrganizationMemberResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetSuperuserResponseMultiError) Error() string {
+func (m AddOrganizationMemberResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7726,11 +7824,12 @@ func (m SetSuperuserResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetSuperuserResponseMultiError) AllErrors() []error { return m }
+func (m AddOrganizationMemberResponseMultiError) AllErrors() []error { return m }
 
-// SetSuperuserResponseValidationError is the validation error returned by
-// SetSuperuserResponse.Validate if the designated constraints aren't met.
-type SetSuperuserResponseValidationError struct {
+// AddOrganizationMemberResponseValidationError is the validation error
+// returned by AddOrganizationMemberResponse.Validate if the designated
+// constraints aren't met.
+type AddOrganizationMemberResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7738,24 +7837,24 @@ type SetSuperuserResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetSuperuserResponseValidationError) Field() string { return e.field }
+func (e AddOrganizationMemberResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetSuperuserResponseValidationError) Reason() string { return e.reason }
+func (e AddOrganizationMemberResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetSuperuserResponseValidationError) Cause() error { return e.cause }
+func (e AddOrganizationMemberResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetSuperuserResponseValidationError) Key() bool { return e.key }
+func (e AddOrganizationMemberResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetSuperuserResponseValidationError) ErrorName() string {
-	return "SetSuperuserResponseValidationError"
+func (e AddOrganizationMemberResponseValidationError) ErrorName() string {
+	return "AddOrganizationMemberResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetSuperuserResponseValidationError) Error() string {
+func (e AddOrganizationMemberResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7767,14 +7866,14 @@ func (e SetSuperuserResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetSuperuserResponse.%s: %s%s",
+		"invalid %sAddOrganizationMemberResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetSuperuserResponseValidationError{}
+var _ error = AddOrganizationMemberResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7782,109 +7881,119 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetSuperuserResponseValidationError{}
+} = AddOrganizationMemberResponseValidationError{}
 
-// Validate checks the field values on SudoGetResourceRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RemoveOrganizationMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SudoGetResourceRequest) Validate() error {
+func (m *RemoveOrganizationMemberRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SudoGetResourceRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SudoGetResourceRequestMultiError, or nil if none found.
-func (m *SudoGetResourceRequest) ValidateAll() error {
+// ValidateAll checks the field values on RemoveOrganizationMemberRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveOrganizationMemberRequestMultiError, or nil if none found.
+func (m *RemoveOrganizationMemberRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SudoGetResourceRequest) validate(all bool) error {
+func (m *RemoveOrganizationMemberRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Id.(type) {
-	case *SudoGetResourceRequest_UserId:
-		if v == nil {
-			err := SudoGetResourceRequestValidationError{
-				field:  "Id",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for UserId
-	case *SudoGetResourceRequest_OrgId:
-		if v == nil {
-			err := SudoGetResourceRequestValidationError{
-				field:  "Id",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := RemoveOrganizationMemberRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
 		}
-		// no validation rules for OrgId
-	case *SudoGetResourceRequest_ProjectId:
-		if v == nil {
-			err := SudoGetResourceRequestValidationError{
-				field:  "Id",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+		if !all {
+			return err
 		}
-		// no validation rules for ProjectId
-	case *SudoGetResourceRequest_DeploymentId:
-		if v == nil {
-			err := SudoGetResourceRequestValidationError{
-				field:  "Id",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+		errors = append(errors, err)
+	}
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = RemoveOrganizationMemberRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
 		}
-		// no validation rules for DeploymentId
-	case *SudoGetResourceRequest_InstanceId:
-		if v == nil {
-			err := SudoGetResourceRequestValidationError{
-				field:  "Id",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+		if !all {
+			return err
 		}
-		// no validation rules for InstanceId
-	default:
-		_ = v // ensures v is used
+		errors = append(errors, err)
 	}
 
+	// no validation rules for KeepProjectRoles
+
 	if len(errors) > 0 {
-		return SudoGetResourceRequestMultiError(errors)
+		return RemoveOrganizationMemberRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SudoGetResourceRequestMultiError is an error wrapping multiple validation
-// errors returned by SudoGetResourceRequest.ValidateAll() if the designated
-// constraints aren't met.
-type SudoGetResourceRequestMultiError []error
+func (m *RemoveOrganizationMemberRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *RemoveOrganizationMemberRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// RemoveOrganizationMemberRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveOrganizationMemberRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RemoveOrganizationMemberRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SudoGetResourceRequestMultiError) Error() string {
+func (m RemoveOrganizationMemberRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7893,11 +8002,12 @@ func (m SudoGetResourceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SudoGetResourceRequestMultiError) AllErrors() []error { return m }
+func (m RemoveOrganizationMemberRequestMultiError) AllErrors() []error { return m }
 
-// SudoGetResourceRequestValidationError is the validation error returned by
-// SudoGetResourceRequest.Validate if the designated constraints aren't met.
-type SudoGetResourceRequestValidationError struct {
+// RemoveOrganizationMemberRequestValidationError is the validation error
+// returned by RemoveOrganizationMemberRequest.Validate if the designated
+// constraints aren't met.
+type RemoveOrganizationMemberRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7905,24 +8015,24 @@ type SudoGetResourceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SudoGetResourceRequestValidationError) Field() string { return e.field }
+func (e RemoveOrganizationMemberRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SudoGetResourceRequestValidationError) Reason() string { return e.reason }
+func (e RemoveOrganizationMemberRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SudoGetResourceRequestValidationError) Cause() error { return e.cause }
+func (e RemoveOrganizationMemberRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SudoGetResourceRequestValidationError) Key() bool { return e.key }
+func (e RemoveOrganizationMemberRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SudoGetResourceRequestValidationError) ErrorName() string {
-	return "SudoGetResourceRequestValidationError"
+func (e RemoveOrganizationMemberRequestValidationError) ErrorName() string {
+	return "RemoveOrganizationMemberRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SudoGetResourceRequestValidationError) Error() string {
+func (e RemoveOrganizationMemberRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7934,14 +8044,14 @@ func (e SudoGetResourceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSudoGetResourceRequest.%s: %s%s",
+		"invalid %sRemoveOrganizationMemberRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SudoGetResourceRequestValidationError{}
+var _ error = RemoveOrganizationMemberRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7949,254 +8059,5602 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SudoGetResourceRequestValidationError{}
+} = RemoveOrganizationMemberRequestValidationError{}
 
-// Validate checks the field values on SudoGetResourceResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SudoGetResourceResponse) Validate() error {
+// Validate checks the field values on RemoveOrganizationMemberResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RemoveOrganizationMemberResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SudoGetResourceResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SudoGetResourceResponseMultiError, or nil if none found.
-func (m *SudoGetResourceResponse) ValidateAll() error {
+// ValidateAll checks the field values on RemoveOrganizationMemberResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveOrganizationMemberResponseMultiError, or nil if none found.
+func (m *RemoveOrganizationMemberResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SudoGetResourceResponse) validate(all bool) error {
+func (m *RemoveOrganizationMemberResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Resource.(type) {
-	case *SudoGetResourceResponse_User:
-		if v == nil {
-			err := SudoGetResourceResponseValidationError{
-				field:  "Resource",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	if len(errors) > 0 {
+		return RemoveOrganizationMemberResponseMultiError(errors)
+	}
 
-		if all {
-			switch v := interface{}(m.GetUser()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
-						field:  "User",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
-						field:  "User",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SudoGetResourceResponseValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	return nil
+}
 
-	case *SudoGetResourceResponse_Org:
-		if v == nil {
-			err := SudoGetResourceResponseValidationError{
-				field:  "Resource",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+// RemoveOrganizationMemberResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// RemoveOrganizationMemberResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveOrganizationMemberResponseMultiError []error
 
-		if all {
-			switch v := interface{}(m.GetOrg()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
-						field:  "Org",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveOrganizationMemberResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveOrganizationMemberResponseMultiError) AllErrors() []error { return m }
+
+// RemoveOrganizationMemberResponseValidationError is the validation error
+// returned by RemoveOrganizationMemberResponse.Validate if the designated
+// constraints aren't met.
+type RemoveOrganizationMemberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveOrganizationMemberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveOrganizationMemberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveOrganizationMemberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveOrganizationMemberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveOrganizationMemberResponseValidationError) ErrorName() string {
+	return "RemoveOrganizationMemberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveOrganizationMemberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveOrganizationMemberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveOrganizationMemberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveOrganizationMemberResponseValidationError{}
+
+// Validate checks the field values on LeaveOrganizationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LeaveOrganizationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LeaveOrganizationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LeaveOrganizationRequestMultiError, or nil if none found.
+func (m *LeaveOrganizationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LeaveOrganizationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := LeaveOrganizationRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return LeaveOrganizationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LeaveOrganizationRequestMultiError is an error wrapping multiple validation
+// errors returned by LeaveOrganizationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type LeaveOrganizationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LeaveOrganizationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LeaveOrganizationRequestMultiError) AllErrors() []error { return m }
+
+// LeaveOrganizationRequestValidationError is the validation error returned by
+// LeaveOrganizationRequest.Validate if the designated constraints aren't met.
+type LeaveOrganizationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeaveOrganizationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeaveOrganizationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeaveOrganizationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeaveOrganizationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LeaveOrganizationRequestValidationError) ErrorName() string {
+	return "LeaveOrganizationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LeaveOrganizationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLeaveOrganizationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LeaveOrganizationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LeaveOrganizationRequestValidationError{}
+
+// Validate checks the field values on LeaveOrganizationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LeaveOrganizationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LeaveOrganizationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LeaveOrganizationResponseMultiError, or nil if none found.
+func (m *LeaveOrganizationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LeaveOrganizationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return LeaveOrganizationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// LeaveOrganizationResponseMultiError is an error wrapping multiple validation
+// errors returned by LeaveOrganizationResponse.ValidateAll() if the
+// designated constraints aren't met.
+type LeaveOrganizationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LeaveOrganizationResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LeaveOrganizationResponseMultiError) AllErrors() []error { return m }
+
+// LeaveOrganizationResponseValidationError is the validation error returned by
+// LeaveOrganizationResponse.Validate if the designated constraints aren't met.
+type LeaveOrganizationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeaveOrganizationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeaveOrganizationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeaveOrganizationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeaveOrganizationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LeaveOrganizationResponseValidationError) ErrorName() string {
+	return "LeaveOrganizationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LeaveOrganizationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLeaveOrganizationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LeaveOrganizationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LeaveOrganizationResponseValidationError{}
+
+// Validate checks the field values on SetOrganizationMemberRoleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SetOrganizationMemberRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetOrganizationMemberRoleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetOrganizationMemberRoleRequestMultiError, or nil if none found.
+func (m *SetOrganizationMemberRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetOrganizationMemberRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := SetOrganizationMemberRoleRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = SetOrganizationMemberRoleRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := SetOrganizationMemberRoleRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetOrganizationMemberRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SetOrganizationMemberRoleRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *SetOrganizationMemberRoleRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// SetOrganizationMemberRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// SetOrganizationMemberRoleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetOrganizationMemberRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetOrganizationMemberRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetOrganizationMemberRoleRequestMultiError) AllErrors() []error { return m }
+
+// SetOrganizationMemberRoleRequestValidationError is the validation error
+// returned by SetOrganizationMemberRoleRequest.Validate if the designated
+// constraints aren't met.
+type SetOrganizationMemberRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetOrganizationMemberRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetOrganizationMemberRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetOrganizationMemberRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetOrganizationMemberRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetOrganizationMemberRoleRequestValidationError) ErrorName() string {
+	return "SetOrganizationMemberRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetOrganizationMemberRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetOrganizationMemberRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetOrganizationMemberRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetOrganizationMemberRoleRequestValidationError{}
+
+// Validate checks the field values on SetOrganizationMemberRoleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SetOrganizationMemberRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetOrganizationMemberRoleResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SetOrganizationMemberRoleResponseMultiError, or nil if none found.
+func (m *SetOrganizationMemberRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetOrganizationMemberRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SetOrganizationMemberRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetOrganizationMemberRoleResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// SetOrganizationMemberRoleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetOrganizationMemberRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetOrganizationMemberRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetOrganizationMemberRoleResponseMultiError) AllErrors() []error { return m }
+
+// SetOrganizationMemberRoleResponseValidationError is the validation error
+// returned by SetOrganizationMemberRoleResponse.Validate if the designated
+// constraints aren't met.
+type SetOrganizationMemberRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetOrganizationMemberRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetOrganizationMemberRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetOrganizationMemberRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetOrganizationMemberRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetOrganizationMemberRoleResponseValidationError) ErrorName() string {
+	return "SetOrganizationMemberRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetOrganizationMemberRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetOrganizationMemberRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetOrganizationMemberRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetOrganizationMemberRoleResponseValidationError{}
+
+// Validate checks the field values on ListSuperusersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSuperusersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSuperusersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSuperusersRequestMultiError, or nil if none found.
+func (m *ListSuperusersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSuperusersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListSuperusersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSuperusersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSuperusersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListSuperusersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSuperusersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSuperusersRequestMultiError) AllErrors() []error { return m }
+
+// ListSuperusersRequestValidationError is the validation error returned by
+// ListSuperusersRequest.Validate if the designated constraints aren't met.
+type ListSuperusersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSuperusersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSuperusersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSuperusersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSuperusersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSuperusersRequestValidationError) ErrorName() string {
+	return "ListSuperusersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSuperusersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSuperusersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSuperusersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSuperusersRequestValidationError{}
+
+// Validate checks the field values on ListSuperusersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSuperusersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSuperusersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSuperusersResponseMultiError, or nil if none found.
+func (m *ListSuperusersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSuperusersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSuperusersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSuperusersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSuperusersResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSuperusersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSuperusersResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSuperusersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSuperusersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSuperusersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSuperusersResponseMultiError) AllErrors() []error { return m }
+
+// ListSuperusersResponseValidationError is the validation error returned by
+// ListSuperusersResponse.Validate if the designated constraints aren't met.
+type ListSuperusersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSuperusersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSuperusersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSuperusersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSuperusersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSuperusersResponseValidationError) ErrorName() string {
+	return "ListSuperusersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSuperusersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSuperusersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSuperusersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSuperusersResponseValidationError{}
+
+// Validate checks the field values on SetSuperuserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetSuperuserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetSuperuserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetSuperuserRequestMultiError, or nil if none found.
+func (m *SetSuperuserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetSuperuserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = SetSuperuserRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Superuser
+
+	if len(errors) > 0 {
+		return SetSuperuserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SetSuperuserRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *SetSuperuserRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// SetSuperuserRequestMultiError is an error wrapping multiple validation
+// errors returned by SetSuperuserRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetSuperuserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetSuperuserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetSuperuserRequestMultiError) AllErrors() []error { return m }
+
+// SetSuperuserRequestValidationError is the validation error returned by
+// SetSuperuserRequest.Validate if the designated constraints aren't met.
+type SetSuperuserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetSuperuserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetSuperuserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetSuperuserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetSuperuserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetSuperuserRequestValidationError) ErrorName() string {
+	return "SetSuperuserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetSuperuserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetSuperuserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetSuperuserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetSuperuserRequestValidationError{}
+
+// Validate checks the field values on SetSuperuserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetSuperuserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetSuperuserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetSuperuserResponseMultiError, or nil if none found.
+func (m *SetSuperuserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetSuperuserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SetSuperuserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetSuperuserResponseMultiError is an error wrapping multiple validation
+// errors returned by SetSuperuserResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetSuperuserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetSuperuserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetSuperuserResponseMultiError) AllErrors() []error { return m }
+
+// SetSuperuserResponseValidationError is the validation error returned by
+// SetSuperuserResponse.Validate if the designated constraints aren't met.
+type SetSuperuserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetSuperuserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetSuperuserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetSuperuserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetSuperuserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetSuperuserResponseValidationError) ErrorName() string {
+	return "SetSuperuserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetSuperuserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetSuperuserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetSuperuserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetSuperuserResponseValidationError{}
+
+// Validate checks the field values on SudoGetResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SudoGetResourceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SudoGetResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SudoGetResourceRequestMultiError, or nil if none found.
+func (m *SudoGetResourceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SudoGetResourceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Id.(type) {
+	case *SudoGetResourceRequest_UserId:
+		if v == nil {
+			err := SudoGetResourceRequestValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for UserId
+	case *SudoGetResourceRequest_OrgId:
+		if v == nil {
+			err := SudoGetResourceRequestValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for OrgId
+	case *SudoGetResourceRequest_ProjectId:
+		if v == nil {
+			err := SudoGetResourceRequestValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for ProjectId
+	case *SudoGetResourceRequest_DeploymentId:
+		if v == nil {
+			err := SudoGetResourceRequestValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for DeploymentId
+	case *SudoGetResourceRequest_InstanceId:
+		if v == nil {
+			err := SudoGetResourceRequestValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for InstanceId
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return SudoGetResourceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SudoGetResourceRequestMultiError is an error wrapping multiple validation
+// errors returned by SudoGetResourceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SudoGetResourceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SudoGetResourceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SudoGetResourceRequestMultiError) AllErrors() []error { return m }
+
+// SudoGetResourceRequestValidationError is the validation error returned by
+// SudoGetResourceRequest.Validate if the designated constraints aren't met.
+type SudoGetResourceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SudoGetResourceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SudoGetResourceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SudoGetResourceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SudoGetResourceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SudoGetResourceRequestValidationError) ErrorName() string {
+	return "SudoGetResourceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SudoGetResourceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSudoGetResourceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SudoGetResourceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SudoGetResourceRequestValidationError{}
+
+// Validate checks the field values on SudoGetResourceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SudoGetResourceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SudoGetResourceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SudoGetResourceResponseMultiError, or nil if none found.
+func (m *SudoGetResourceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SudoGetResourceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Resource.(type) {
+	case *SudoGetResourceResponse_User:
+		if v == nil {
+			err := SudoGetResourceResponseValidationError{
+				field:  "Resource",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetUser()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
+						field:  "User",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
+						field:  "User",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SudoGetResourceResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SudoGetResourceResponse_Org:
+		if v == nil {
+			err := SudoGetResourceResponseValidationError{
+				field:  "Resource",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetOrg()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
+						field:  "Org",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
 						field:  "Org",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetOrg()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetOrg()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SudoGetResourceResponseValidationError{
+					field:  "Org",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SudoGetResourceResponse_Project:
+		if v == nil {
+			err := SudoGetResourceResponseValidationError{
+				field:  "Resource",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetProject()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
+						field:  "Project",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
+						field:  "Project",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SudoGetResourceResponseValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SudoGetResourceResponse_Deployment:
+		if v == nil {
+			err := SudoGetResourceResponseValidationError{
+				field:  "Resource",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetDeployment()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
+						field:  "Deployment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
+						field:  "Deployment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDeployment()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SudoGetResourceResponseValidationError{
+					field:  "Deployment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SudoGetResourceResponse_Instance:
+		if v == nil {
+			err := SudoGetResourceResponseValidationError{
+				field:  "Resource",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetInstance()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
+						field:  "Instance",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
+						field:  "Instance",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetInstance()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SudoGetResourceResponseValidationError{
+					field:  "Instance",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return SudoGetResourceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SudoGetResourceResponseMultiError is an error wrapping multiple validation
+// errors returned by SudoGetResourceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SudoGetResourceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SudoGetResourceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SudoGetResourceResponseMultiError) AllErrors() []error { return m }
+
+// SudoGetResourceResponseValidationError is the validation error returned by
+// SudoGetResourceResponse.Validate if the designated constraints aren't met.
+type SudoGetResourceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SudoGetResourceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SudoGetResourceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SudoGetResourceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SudoGetResourceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SudoGetResourceResponseValidationError) ErrorName() string {
+	return "SudoGetResourceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SudoGetResourceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSudoGetResourceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SudoGetResourceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SudoGetResourceResponseValidationError{}
+
+// Validate checks the field values on SudoUpdateOrganizationQuotasRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SudoUpdateOrganizationQuotasRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SudoUpdateOrganizationQuotasRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SudoUpdateOrganizationQuotasRequestMultiError, or nil if none found.
+func (m *SudoUpdateOrganizationQuotasRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SudoUpdateOrganizationQuotasRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrgName
+
+	if m.Projects != nil {
+		// no validation rules for Projects
+	}
+
+	if m.Deployments != nil {
+		// no validation rules for Deployments
+	}
+
+	if m.SlotsTotal != nil {
+		// no validation rules for SlotsTotal
+	}
+
+	if m.SlotsPerDeployment != nil {
+		// no validation rules for SlotsPerDeployment
+	}
+
+	if m.OutstandingInvites != nil {
+		// no validation rules for OutstandingInvites
+	}
+
+	if len(errors) > 0 {
+		return SudoUpdateOrganizationQuotasRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SudoUpdateOrganizationQuotasRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// SudoUpdateOrganizationQuotasRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SudoUpdateOrganizationQuotasRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SudoUpdateOrganizationQuotasRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SudoUpdateOrganizationQuotasRequestMultiError) AllErrors() []error { return m }
+
+// SudoUpdateOrganizationQuotasRequestValidationError is the validation error
+// returned by SudoUpdateOrganizationQuotasRequest.Validate if the designated
+// constraints aren't met.
+type SudoUpdateOrganizationQuotasRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SudoUpdateOrganizationQuotasRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SudoUpdateOrganizationQuotasRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SudoUpdateOrganizationQuotasRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SudoUpdateOrganizationQuotasRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SudoUpdateOrganizationQuotasRequestValidationError) ErrorName() string {
+	return "SudoUpdateOrganizationQuotasRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SudoUpdateOrganizationQuotasRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSudoUpdateOrganizationQuotasRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SudoUpdateOrganizationQuotasRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SudoUpdateOrganizationQuotasRequestValidationError{}
+
+// Validate checks the field values on SudoUpdateOrganizationQuotasResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *SudoUpdateOrganizationQuotasResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SudoUpdateOrganizationQuotasResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SudoUpdateOrganizationQuotasResponseMultiError, or nil if none found.
+func (m *SudoUpdateOrganizationQuotasResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SudoUpdateOrganizationQuotasResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetOrganization()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SudoUpdateOrganizationQuotasResponseValidationError{
+					field:  "Organization",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SudoUpdateOrganizationQuotasResponseValidationError{
+					field:  "Organization",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrganization()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SudoUpdateOrganizationQuotasResponseValidationError{
+				field:  "Organization",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SudoUpdateOrganizationQuotasResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SudoUpdateOrganizationQuotasResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// SudoUpdateOrganizationQuotasResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SudoUpdateOrganizationQuotasResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SudoUpdateOrganizationQuotasResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SudoUpdateOrganizationQuotasResponseMultiError) AllErrors() []error { return m }
+
+// SudoUpdateOrganizationQuotasResponseValidationError is the validation error
+// returned by SudoUpdateOrganizationQuotasResponse.Validate if the designated
+// constraints aren't met.
+type SudoUpdateOrganizationQuotasResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SudoUpdateOrganizationQuotasResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SudoUpdateOrganizationQuotasResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SudoUpdateOrganizationQuotasResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SudoUpdateOrganizationQuotasResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SudoUpdateOrganizationQuotasResponseValidationError) ErrorName() string {
+	return "SudoUpdateOrganizationQuotasResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SudoUpdateOrganizationQuotasResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSudoUpdateOrganizationQuotasResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SudoUpdateOrganizationQuotasResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SudoUpdateOrganizationQuotasResponseValidationError{}
+
+// Validate checks the field values on SudoUpdateUserQuotasRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SudoUpdateUserQuotasRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SudoUpdateUserQuotasRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SudoUpdateUserQuotasRequestMultiError, or nil if none found.
+func (m *SudoUpdateUserQuotasRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SudoUpdateUserQuotasRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Email
+
+	if m.SingleuserOrgs != nil {
+		// no validation rules for SingleuserOrgs
+	}
+
+	if len(errors) > 0 {
+		return SudoUpdateUserQuotasRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SudoUpdateUserQuotasRequestMultiError is an error wrapping multiple
+// validation errors returned by SudoUpdateUserQuotasRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SudoUpdateUserQuotasRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SudoUpdateUserQuotasRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SudoUpdateUserQuotasRequestMultiError) AllErrors() []error { return m }
+
+// SudoUpdateUserQuotasRequestValidationError is the validation error returned
+// by SudoUpdateUserQuotasRequest.Validate if the designated constraints
+// aren't met.
+type SudoUpdateUserQuotasRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SudoUpdateUserQuotasRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SudoUpdateUserQuotasRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SudoUpdateUserQuotasRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SudoUpdateUserQuotasRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SudoUpdateUserQuotasRequestValidationError) ErrorName() string {
+	return "SudoUpdateUserQuotasRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SudoUpdateUserQuotasRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSudoUpdateUserQuotasRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SudoUpdateUserQuotasRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SudoUpdateUserQuotasRequestValidationError{}
+
+// Validate checks the field values on SudoUpdateUserQuotasResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SudoUpdateUserQuotasResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SudoUpdateUserQuotasResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SudoUpdateUserQuotasResponseMultiError, or nil if none found.
+func (m *SudoUpdateUserQuotasResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SudoUpdateUserQuotasResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SudoUpdateUserQuotasResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SudoUpdateUserQuotasResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SudoUpdateUserQuotasResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SudoUpdateUserQuotasResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SudoUpdateUserQuotasResponseMultiError is an error wrapping multiple
+// validation errors returned by SudoUpdateUserQuotasResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SudoUpdateUserQuotasResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SudoUpdateUserQuotasResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SudoUpdateUserQuotasResponseMultiError) AllErrors() []error { return m }
+
+// SudoUpdateUserQuotasResponseValidationError is the validation error returned
+// by SudoUpdateUserQuotasResponse.Validate if the designated constraints
+// aren't met.
+type SudoUpdateUserQuotasResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SudoUpdateUserQuotasResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SudoUpdateUserQuotasResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SudoUpdateUserQuotasResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SudoUpdateUserQuotasResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SudoUpdateUserQuotasResponseValidationError) ErrorName() string {
+	return "SudoUpdateUserQuotasResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SudoUpdateUserQuotasResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSudoUpdateUserQuotasResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SudoUpdateUserQuotasResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SudoUpdateUserQuotasResponseValidationError{}
+
+// Validate checks the field values on CreateUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateUsergroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateUsergroupRequestMultiError, or nil if none found.
+func (m *CreateUsergroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateUsergroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := CreateUsergroupRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CreateUsergroupRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	if len(errors) > 0 {
+		return CreateUsergroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateUsergroupRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateUsergroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateUsergroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateUsergroupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateUsergroupRequestMultiError) AllErrors() []error { return m }
+
+// CreateUsergroupRequestValidationError is the validation error returned by
+// CreateUsergroupRequest.Validate if the designated constraints aren't met.
+type CreateUsergroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateUsergroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateUsergroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateUsergroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateUsergroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateUsergroupRequestValidationError) ErrorName() string {
+	return "CreateUsergroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateUsergroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateUsergroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateUsergroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateUsergroupRequestValidationError{}
+
+// Validate checks the field values on CreateUsergroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateUsergroupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateUsergroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateUsergroupResponseMultiError, or nil if none found.
+func (m *CreateUsergroupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateUsergroupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUsergroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateUsergroupResponseValidationError{
+					field:  "Usergroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateUsergroupResponseValidationError{
+					field:  "Usergroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUsergroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateUsergroupResponseValidationError{
+				field:  "Usergroup",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateUsergroupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateUsergroupResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateUsergroupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateUsergroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateUsergroupResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateUsergroupResponseMultiError) AllErrors() []error { return m }
+
+// CreateUsergroupResponseValidationError is the validation error returned by
+// CreateUsergroupResponse.Validate if the designated constraints aren't met.
+type CreateUsergroupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateUsergroupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateUsergroupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateUsergroupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateUsergroupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateUsergroupResponseValidationError) ErrorName() string {
+	return "CreateUsergroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateUsergroupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateUsergroupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateUsergroupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateUsergroupResponseValidationError{}
+
+// Validate checks the field values on GetUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUsergroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUsergroupRequestMultiError, or nil if none found.
+func (m *GetUsergroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUsergroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := GetUsergroupRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := GetUsergroupRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUsergroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUsergroupRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUsergroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUsergroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUsergroupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUsergroupRequestMultiError) AllErrors() []error { return m }
+
+// GetUsergroupRequestValidationError is the validation error returned by
+// GetUsergroupRequest.Validate if the designated constraints aren't met.
+type GetUsergroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUsergroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUsergroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUsergroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUsergroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUsergroupRequestValidationError) ErrorName() string {
+	return "GetUsergroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUsergroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUsergroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUsergroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUsergroupRequestValidationError{}
+
+// Validate checks the field values on GetUsergroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUsergroupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUsergroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUsergroupResponseMultiError, or nil if none found.
+func (m *GetUsergroupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUsergroupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUsergroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUsergroupResponseValidationError{
+					field:  "Usergroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUsergroupResponseValidationError{
+					field:  "Usergroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUsergroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUsergroupResponseValidationError{
+				field:  "Usergroup",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetUsergroupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUsergroupResponseMultiError is an error wrapping multiple validation
+// errors returned by GetUsergroupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetUsergroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUsergroupResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUsergroupResponseMultiError) AllErrors() []error { return m }
+
+// GetUsergroupResponseValidationError is the validation error returned by
+// GetUsergroupResponse.Validate if the designated constraints aren't met.
+type GetUsergroupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUsergroupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUsergroupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUsergroupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUsergroupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUsergroupResponseValidationError) ErrorName() string {
+	return "GetUsergroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUsergroupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUsergroupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUsergroupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUsergroupResponseValidationError{}
+
+// Validate checks the field values on ListUsergroupsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUsergroupsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUsergroupsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUsergroupsRequestMultiError, or nil if none found.
+func (m *ListUsergroupsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUsergroupsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListUsergroupsRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := ListUsergroupsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListUsergroupsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUsergroupsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListUsergroupsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListUsergroupsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUsergroupsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUsergroupsRequestMultiError) AllErrors() []error { return m }
+
+// ListUsergroupsRequestValidationError is the validation error returned by
+// ListUsergroupsRequest.Validate if the designated constraints aren't met.
+type ListUsergroupsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUsergroupsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUsergroupsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUsergroupsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUsergroupsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUsergroupsRequestValidationError) ErrorName() string {
+	return "ListUsergroupsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUsergroupsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUsergroupsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUsergroupsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUsergroupsRequestValidationError{}
+
+// Validate checks the field values on ListUsergroupsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUsergroupsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUsergroupsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUsergroupsResponseMultiError, or nil if none found.
+func (m *ListUsergroupsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUsergroupsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsergroups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUsergroupsResponseValidationError{
+						field:  fmt.Sprintf("Usergroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUsergroupsResponseValidationError{
+						field:  fmt.Sprintf("Usergroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListUsergroupsResponseValidationError{
+					field:  fmt.Sprintf("Usergroups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListUsergroupsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUsergroupsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListUsergroupsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListUsergroupsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUsergroupsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUsergroupsResponseMultiError) AllErrors() []error { return m }
+
+// ListUsergroupsResponseValidationError is the validation error returned by
+// ListUsergroupsResponse.Validate if the designated constraints aren't met.
+type ListUsergroupsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUsergroupsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUsergroupsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUsergroupsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUsergroupsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUsergroupsResponseValidationError) ErrorName() string {
+	return "ListUsergroupsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUsergroupsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUsergroupsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUsergroupsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUsergroupsResponseValidationError{}
+
+// Validate checks the field values on EditUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EditUsergroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EditUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EditUsergroupRequestMultiError, or nil if none found.
+func (m *EditUsergroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EditUsergroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := EditUsergroupRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := EditUsergroupRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.NewName != nil {
+		// no validation rules for NewName
+	}
+
+	if m.Description != nil {
+		// no validation rules for Description
+	}
+
+	if len(errors) > 0 {
+		return EditUsergroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EditUsergroupRequestMultiError is an error wrapping multiple validation
+// errors returned by EditUsergroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type EditUsergroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EditUsergroupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EditUsergroupRequestMultiError) AllErrors() []error { return m }
+
+// EditUsergroupRequestValidationError is the validation error returned by
+// EditUsergroupRequest.Validate if the designated constraints aren't met.
+type EditUsergroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EditUsergroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EditUsergroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EditUsergroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EditUsergroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EditUsergroupRequestValidationError) ErrorName() string {
+	return "EditUsergroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EditUsergroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEditUsergroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EditUsergroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EditUsergroupRequestValidationError{}
+
+// Validate checks the field values on EditUsergroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EditUsergroupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EditUsergroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EditUsergroupResponseMultiError, or nil if none found.
+func (m *EditUsergroupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EditUsergroupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUsergroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EditUsergroupResponseValidationError{
+					field:  "Usergroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EditUsergroupResponseValidationError{
+					field:  "Usergroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUsergroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EditUsergroupResponseValidationError{
+				field:  "Usergroup",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EditUsergroupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EditUsergroupResponseMultiError is an error wrapping multiple validation
+// errors returned by EditUsergroupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type EditUsergroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EditUsergroupResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EditUsergroupResponseMultiError) AllErrors() []error { return m }
+
+// EditUsergroupResponseValidationError is the validation error returned by
+// EditUsergroupResponse.Validate if the designated constraints aren't met.
+type EditUsergroupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EditUsergroupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EditUsergroupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EditUsergroupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EditUsergroupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EditUsergroupResponseValidationError) ErrorName() string {
+	return "EditUsergroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EditUsergroupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEditUsergroupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EditUsergroupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EditUsergroupResponseValidationError{}
+
+// Validate checks the field values on DeleteUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteUsergroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteUsergroupRequestMultiError, or nil if none found.
+func (m *DeleteUsergroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteUsergroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := DeleteUsergroupRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := DeleteUsergroupRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteUsergroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteUsergroupRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteUsergroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteUsergroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteUsergroupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteUsergroupRequestMultiError) AllErrors() []error { return m }
+
+// DeleteUsergroupRequestValidationError is the validation error returned by
+// DeleteUsergroupRequest.Validate if the designated constraints aren't met.
+type DeleteUsergroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteUsergroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteUsergroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteUsergroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteUsergroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteUsergroupRequestValidationError) ErrorName() string {
+	return "DeleteUsergroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteUsergroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteUsergroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteUsergroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteUsergroupRequestValidationError{}
+
+// Validate checks the field values on DeleteUsergroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteUsergroupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteUsergroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteUsergroupResponseMultiError, or nil if none found.
+func (m *DeleteUsergroupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteUsergroupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteUsergroupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteUsergroupResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteUsergroupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteUsergroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteUsergroupResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteUsergroupResponseMultiError) AllErrors() []error { return m }
+
+// DeleteUsergroupResponseValidationError is the validation error returned by
+// DeleteUsergroupResponse.Validate if the designated constraints aren't met.
+type DeleteUsergroupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteUsergroupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteUsergroupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteUsergroupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteUsergroupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteUsergroupResponseValidationError) ErrorName() string {
+	return "DeleteUsergroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteUsergroupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteUsergroupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteUsergroupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteUsergroupResponseValidationError{}
+
+// Validate checks the field values on ListUsergroupMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUsergroupMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUsergroupMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUsergroupMembersRequestMultiError, or nil if none found.
+func (m *ListUsergroupMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUsergroupMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListUsergroupMembersRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := ListUsergroupMembersRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := ListUsergroupMembersRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListUsergroupMembersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUsergroupMembersRequestMultiError is an error wrapping multiple
+// validation errors returned by ListUsergroupMembersRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListUsergroupMembersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUsergroupMembersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUsergroupMembersRequestMultiError) AllErrors() []error { return m }
+
+// ListUsergroupMembersRequestValidationError is the validation error returned
+// by ListUsergroupMembersRequest.Validate if the designated constraints
+// aren't met.
+type ListUsergroupMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUsergroupMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUsergroupMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUsergroupMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUsergroupMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUsergroupMembersRequestValidationError) ErrorName() string {
+	return "ListUsergroupMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUsergroupMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUsergroupMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUsergroupMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUsergroupMembersRequestValidationError{}
+
+// Validate checks the field values on ListUsergroupMembersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUsergroupMembersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUsergroupMembersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUsergroupMembersResponseMultiError, or nil if none found.
+func (m *ListUsergroupMembersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUsergroupMembersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUsergroupMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUsergroupMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListUsergroupMembersResponseValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListUsergroupMembersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUsergroupMembersResponseMultiError is an error wrapping multiple
+// validation errors returned by ListUsergroupMembersResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListUsergroupMembersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUsergroupMembersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUsergroupMembersResponseMultiError) AllErrors() []error { return m }
+
+// ListUsergroupMembersResponseValidationError is the validation error returned
+// by ListUsergroupMembersResponse.Validate if the designated constraints
+// aren't met.
+type ListUsergroupMembersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUsergroupMembersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUsergroupMembersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUsergroupMembersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUsergroupMembersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUsergroupMembersResponseValidationError) ErrorName() string {
+	return "ListUsergroupMembersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUsergroupMembersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUsergroupMembersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUsergroupMembersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUsergroupMembersResponseValidationError{}
+
+// Validate checks the field values on AddUsergroupMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddUsergroupMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddUsergroupMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddUsergroupMemberRequestMultiError, or nil if none found.
+func (m *AddUsergroupMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddUsergroupMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := AddUsergroupMemberRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := AddUsergroupMemberRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = AddUsergroupMemberRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddUsergroupMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AddUsergroupMemberRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *AddUsergroupMemberRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// AddUsergroupMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by AddUsergroupMemberRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AddUsergroupMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddUsergroupMemberRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddUsergroupMemberRequestMultiError) AllErrors() []error { return m }
+
+// AddUsergroupMemberRequestValidationError is the validation error returned by
+// AddUsergroupMemberRequest.Validate if the designated constraints aren't met.
+type AddUsergroupMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddUsergroupMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddUsergroupMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddUsergroupMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddUsergroupMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddUsergroupMemberRequestValidationError) ErrorName() string {
+	return "AddUsergroupMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddUsergroupMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddUsergroupMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddUsergroupMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddUsergroupMemberRequestValidationError{}
+
+// Validate checks the field values on AddUsergroupMemberResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddUsergroupMemberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddUsergroupMemberResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddUsergroupMemberResponseMultiError, or nil if none found.
+func (m *AddUsergroupMemberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddUsergroupMemberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AddUsergroupMemberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddUsergroupMemberResponseMultiError is an error wrapping multiple
+// validation errors returned by AddUsergroupMemberResponse.ValidateAll() if
+// the designated constraints aren't met.
+type AddUsergroupMemberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddUsergroupMemberResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddUsergroupMemberResponseMultiError) AllErrors() []error { return m }
+
+// AddUsergroupMemberResponseValidationError is the validation error returned
+// by AddUsergroupMemberResponse.Validate if the designated constraints aren't met.
+type AddUsergroupMemberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddUsergroupMemberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddUsergroupMemberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddUsergroupMemberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddUsergroupMemberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddUsergroupMemberResponseValidationError) ErrorName() string {
+	return "AddUsergroupMemberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddUsergroupMemberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddUsergroupMemberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddUsergroupMemberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddUsergroupMemberResponseValidationError{}
+
+// Validate checks the field values on RemoveUsergroupMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveUsergroupMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveUsergroupMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveUsergroupMemberRequestMultiError, or nil if none found.
+func (m *RemoveUsergroupMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveUsergroupMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := RemoveUsergroupMemberRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := RemoveUsergroupMemberRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = RemoveUsergroupMemberRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveUsergroupMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RemoveUsergroupMemberRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *RemoveUsergroupMemberRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// RemoveUsergroupMemberRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveUsergroupMemberRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RemoveUsergroupMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveUsergroupMemberRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveUsergroupMemberRequestMultiError) AllErrors() []error { return m }
+
+// RemoveUsergroupMemberRequestValidationError is the validation error returned
+// by RemoveUsergroupMemberRequest.Validate if the designated constraints
+// aren't met.
+type RemoveUsergroupMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveUsergroupMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveUsergroupMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveUsergroupMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveUsergroupMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveUsergroupMemberRequestValidationError) ErrorName() string {
+	return "RemoveUsergroupMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveUsergroupMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveUsergroupMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveUsergroupMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveUsergroupMemberRequestValidationError{}
+
+// Validate checks the field values on RemoveUsergroupMemberResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveUsergroupMemberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveUsergroupMemberResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveUsergroupMemberResponseMultiError, or nil if none found.
+func (m *RemoveUsergroupMemberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveUsergroupMemberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RemoveUsergroupMemberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveUsergroupMemberResponseMultiError is an error wrapping multiple
+// validation errors returned by RemoveUsergroupMemberResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RemoveUsergroupMemberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveUsergroupMemberResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveUsergroupMemberResponseMultiError) AllErrors() []error { return m }
+
+// RemoveUsergroupMemberResponseValidationError is the validation error
+// returned by RemoveUsergroupMemberResponse.Validate if the designated
+// constraints aren't met.
+type RemoveUsergroupMemberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveUsergroupMemberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveUsergroupMemberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveUsergroupMemberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveUsergroupMemberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveUsergroupMemberResponseValidationError) ErrorName() string {
+	return "RemoveUsergroupMemberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveUsergroupMemberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveUsergroupMemberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveUsergroupMemberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveUsergroupMemberResponseValidationError{}
+
+// Validate checks the field values on ListOrganizationMemberUsergroupsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *ListOrganizationMemberUsergroupsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// ListOrganizationMemberUsergroupsRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// ListOrganizationMemberUsergroupsRequestMultiError, or nil if none found.
+func (m *ListOrganizationMemberUsergroupsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOrganizationMemberUsergroupsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListOrganizationMemberUsergroupsRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := ListOrganizationMemberUsergroupsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListOrganizationMemberUsergroupsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOrganizationMemberUsergroupsRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// ListOrganizationMemberUsergroupsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListOrganizationMemberUsergroupsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOrganizationMemberUsergroupsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOrganizationMemberUsergroupsRequestMultiError) AllErrors() []error { return m }
+
+// ListOrganizationMemberUsergroupsRequestValidationError is the validation
+// error returned by ListOrganizationMemberUsergroupsRequest.Validate if the
+// designated constraints aren't met.
+type ListOrganizationMemberUsergroupsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOrganizationMemberUsergroupsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOrganizationMemberUsergroupsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOrganizationMemberUsergroupsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOrganizationMemberUsergroupsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOrganizationMemberUsergroupsRequestValidationError) ErrorName() string {
+	return "ListOrganizationMemberUsergroupsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOrganizationMemberUsergroupsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOrganizationMemberUsergroupsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOrganizationMemberUsergroupsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOrganizationMemberUsergroupsRequestValidationError{}
+
+// Validate checks the field values on ListOrganizationMemberUsergroupsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *ListOrganizationMemberUsergroupsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// ListOrganizationMemberUsergroupsResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// ListOrganizationMemberUsergroupsResponseMultiError, or nil if none found.
+func (m *ListOrganizationMemberUsergroupsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOrganizationMemberUsergroupsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOrganizationMemberUsergroupsResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOrganizationMemberUsergroupsResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOrganizationMemberUsergroupsResponseValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListOrganizationMemberUsergroupsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOrganizationMemberUsergroupsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// ListOrganizationMemberUsergroupsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListOrganizationMemberUsergroupsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOrganizationMemberUsergroupsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOrganizationMemberUsergroupsResponseMultiError) AllErrors() []error { return m }
+
+// ListOrganizationMemberUsergroupsResponseValidationError is the validation
+// error returned by ListOrganizationMemberUsergroupsResponse.Validate if the
+// designated constraints aren't met.
+type ListOrganizationMemberUsergroupsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOrganizationMemberUsergroupsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOrganizationMemberUsergroupsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOrganizationMemberUsergroupsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOrganizationMemberUsergroupsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOrganizationMemberUsergroupsResponseValidationError) ErrorName() string {
+	return "ListOrganizationMemberUsergroupsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOrganizationMemberUsergroupsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOrganizationMemberUsergroupsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOrganizationMemberUsergroupsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOrganizationMemberUsergroupsResponseValidationError{}
+
+// Validate checks the field values on
+// SetOrganizationMemberUsergroupRoleRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SetOrganizationMemberUsergroupRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// SetOrganizationMemberUsergroupRoleRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// SetOrganizationMemberUsergroupRoleRequestMultiError, or nil if none found.
+func (m *SetOrganizationMemberUsergroupRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetOrganizationMemberUsergroupRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := SetOrganizationMemberUsergroupRoleRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := SetOrganizationMemberUsergroupRoleRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := SetOrganizationMemberUsergroupRoleRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetOrganizationMemberUsergroupRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetOrganizationMemberUsergroupRoleRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// SetOrganizationMemberUsergroupRoleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetOrganizationMemberUsergroupRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetOrganizationMemberUsergroupRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetOrganizationMemberUsergroupRoleRequestMultiError) AllErrors() []error { return m }
+
+// SetOrganizationMemberUsergroupRoleRequestValidationError is the validation
+// error returned by SetOrganizationMemberUsergroupRoleRequest.Validate if the
+// designated constraints aren't met.
+type SetOrganizationMemberUsergroupRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetOrganizationMemberUsergroupRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetOrganizationMemberUsergroupRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetOrganizationMemberUsergroupRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetOrganizationMemberUsergroupRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetOrganizationMemberUsergroupRoleRequestValidationError) ErrorName() string {
+	return "SetOrganizationMemberUsergroupRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetOrganizationMemberUsergroupRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetOrganizationMemberUsergroupRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetOrganizationMemberUsergroupRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetOrganizationMemberUsergroupRoleRequestValidationError{}
+
+// Validate checks the field values on
+// SetOrganizationMemberUsergroupRoleResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SetOrganizationMemberUsergroupRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// SetOrganizationMemberUsergroupRoleResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// SetOrganizationMemberUsergroupRoleResponseMultiError, or nil if none found.
+func (m *SetOrganizationMemberUsergroupRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetOrganizationMemberUsergroupRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SetOrganizationMemberUsergroupRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetOrganizationMemberUsergroupRoleResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// SetOrganizationMemberUsergroupRoleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetOrganizationMemberUsergroupRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetOrganizationMemberUsergroupRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetOrganizationMemberUsergroupRoleResponseMultiError) AllErrors() []error { return m }
+
+// SetOrganizationMemberUsergroupRoleResponseValidationError is the validation
+// error returned by SetOrganizationMemberUsergroupRoleResponse.Validate if
+// the designated constraints aren't met.
+type SetOrganizationMemberUsergroupRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetOrganizationMemberUsergroupRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetOrganizationMemberUsergroupRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetOrganizationMemberUsergroupRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetOrganizationMemberUsergroupRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetOrganizationMemberUsergroupRoleResponseValidationError) ErrorName() string {
+	return "SetOrganizationMemberUsergroupRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetOrganizationMemberUsergroupRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetOrganizationMemberUsergroupRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetOrganizationMemberUsergroupRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetOrganizationMemberUsergroupRoleResponseValidationError{}
+
+// Validate checks the field values on RemoveOrganizationMemberUsergroupRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *RemoveOrganizationMemberUsergroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// RemoveOrganizationMemberUsergroupRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// RemoveOrganizationMemberUsergroupRequestMultiError, or nil if none found.
+func (m *RemoveOrganizationMemberUsergroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveOrganizationMemberUsergroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := RemoveOrganizationMemberUsergroupRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := RemoveOrganizationMemberUsergroupRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveOrganizationMemberUsergroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveOrganizationMemberUsergroupRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// RemoveOrganizationMemberUsergroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveOrganizationMemberUsergroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveOrganizationMemberUsergroupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveOrganizationMemberUsergroupRequestMultiError) AllErrors() []error { return m }
+
+// RemoveOrganizationMemberUsergroupRequestValidationError is the validation
+// error returned by RemoveOrganizationMemberUsergroupRequest.Validate if the
+// designated constraints aren't met.
+type RemoveOrganizationMemberUsergroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveOrganizationMemberUsergroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveOrganizationMemberUsergroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveOrganizationMemberUsergroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveOrganizationMemberUsergroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveOrganizationMemberUsergroupRequestValidationError) ErrorName() string {
+	return "RemoveOrganizationMemberUsergroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveOrganizationMemberUsergroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveOrganizationMemberUsergroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveOrganizationMemberUsergroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveOrganizationMemberUsergroupRequestValidationError{}
+
+// Validate checks the field values on
+// RemoveOrganizationMemberUsergroupResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RemoveOrganizationMemberUsergroupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// RemoveOrganizationMemberUsergroupResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// RemoveOrganizationMemberUsergroupResponseMultiError, or nil if none found.
+func (m *RemoveOrganizationMemberUsergroupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveOrganizationMemberUsergroupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RemoveOrganizationMemberUsergroupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveOrganizationMemberUsergroupResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// RemoveOrganizationMemberUsergroupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveOrganizationMemberUsergroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveOrganizationMemberUsergroupResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveOrganizationMemberUsergroupResponseMultiError) AllErrors() []error { return m }
+
+// RemoveOrganizationMemberUsergroupResponseValidationError is the validation
+// error returned by RemoveOrganizationMemberUsergroupResponse.Validate if the
+// designated constraints aren't met.
+type RemoveOrganizationMemberUsergroupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveOrganizationMemberUsergroupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveOrganizationMemberUsergroupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveOrganizationMemberUsergroupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveOrganizationMemberUsergroupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveOrganizationMemberUsergroupResponseValidationError) ErrorName() string {
+	return "RemoveOrganizationMemberUsergroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveOrganizationMemberUsergroupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveOrganizationMemberUsergroupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveOrganizationMemberUsergroupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveOrganizationMemberUsergroupResponseValidationError{}
+
+// Validate checks the field values on ListProjectMemberUsergroupsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListProjectMemberUsergroupsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProjectMemberUsergroupsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListProjectMemberUsergroupsRequestMultiError, or nil if none found.
+func (m *ListProjectMemberUsergroupsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProjectMemberUsergroupsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListProjectMemberUsergroupsRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := ListProjectMemberUsergroupsRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := ListProjectMemberUsergroupsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListProjectMemberUsergroupsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProjectMemberUsergroupsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ListProjectMemberUsergroupsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListProjectMemberUsergroupsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProjectMemberUsergroupsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProjectMemberUsergroupsRequestMultiError) AllErrors() []error { return m }
+
+// ListProjectMemberUsergroupsRequestValidationError is the validation error
+// returned by ListProjectMemberUsergroupsRequest.Validate if the designated
+// constraints aren't met.
+type ListProjectMemberUsergroupsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProjectMemberUsergroupsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProjectMemberUsergroupsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProjectMemberUsergroupsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProjectMemberUsergroupsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProjectMemberUsergroupsRequestValidationError) ErrorName() string {
+	return "ListProjectMemberUsergroupsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProjectMemberUsergroupsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProjectMemberUsergroupsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProjectMemberUsergroupsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProjectMemberUsergroupsRequestValidationError{}
+
+// Validate checks the field values on ListProjectMemberUsergroupsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListProjectMemberUsergroupsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProjectMemberUsergroupsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListProjectMemberUsergroupsResponseMultiError, or nil if none found.
+func (m *ListProjectMemberUsergroupsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProjectMemberUsergroupsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProjectMemberUsergroupsResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProjectMemberUsergroupsResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return SudoGetResourceResponseValidationError{
-					field:  "Org",
+				return ListProjectMemberUsergroupsResponseValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
-
-	case *SudoGetResourceResponse_Project:
-		if v == nil {
-			err := SudoGetResourceResponseValidationError{
-				field:  "Resource",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListProjectMemberUsergroupsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProjectMemberUsergroupsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ListProjectMemberUsergroupsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListProjectMemberUsergroupsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProjectMemberUsergroupsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProjectMemberUsergroupsResponseMultiError) AllErrors() []error { return m }
+
+// ListProjectMemberUsergroupsResponseValidationError is the validation error
+// returned by ListProjectMemberUsergroupsResponse.Validate if the designated
+// constraints aren't met.
+type ListProjectMemberUsergroupsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProjectMemberUsergroupsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProjectMemberUsergroupsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProjectMemberUsergroupsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProjectMemberUsergroupsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProjectMemberUsergroupsResponseValidationError) ErrorName() string {
+	return "ListProjectMemberUsergroupsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProjectMemberUsergroupsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProjectMemberUsergroupsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProjectMemberUsergroupsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProjectMemberUsergroupsResponseValidationError{}
+
+// Validate checks the field values on SetProjectMemberUsergroupRoleRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *SetProjectMemberUsergroupRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetProjectMemberUsergroupRoleRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SetProjectMemberUsergroupRoleRequestMultiError, or nil if none found.
+func (m *SetProjectMemberUsergroupRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetProjectMemberUsergroupRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := SetProjectMemberUsergroupRoleRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
-		if all {
-			switch v := interface{}(m.GetProject()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
-						field:  "Project",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
-						field:  "Project",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SudoGetResourceResponseValidationError{
-					field:  "Project",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := SetProjectMemberUsergroupRoleRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
-	case *SudoGetResourceResponse_Deployment:
-		if v == nil {
-			err := SudoGetResourceResponseValidationError{
-				field:  "Resource",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := SetProjectMemberUsergroupRoleRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
-		if all {
-			switch v := interface{}(m.GetDeployment()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
-						field:  "Deployment",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
-						field:  "Deployment",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetDeployment()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SudoGetResourceResponseValidationError{
-					field:  "Deployment",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := SetProjectMemberUsergroupRoleRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetProjectMemberUsergroupRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetProjectMemberUsergroupRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// SetProjectMemberUsergroupRoleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetProjectMemberUsergroupRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetProjectMemberUsergroupRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetProjectMemberUsergroupRoleRequestMultiError) AllErrors() []error { return m }
+
+// SetProjectMemberUsergroupRoleRequestValidationError is the validation error
+// returned by SetProjectMemberUsergroupRoleRequest.Validate if the designated
+// constraints aren't met.
+type SetProjectMemberUsergroupRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetProjectMemberUsergroupRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetProjectMemberUsergroupRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetProjectMemberUsergroupRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetProjectMemberUsergroupRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetProjectMemberUsergroupRoleRequestValidationError) ErrorName() string {
+	return "SetProjectMemberUsergroupRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetProjectMemberUsergroupRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetProjectMemberUsergroupRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetProjectMemberUsergroupRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetProjectMemberUsergroupRoleRequestValidationError{}
+
+// Validate checks the field values on SetProjectMemberUsergroupRoleResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *SetProjectMemberUsergroupRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetProjectMemberUsergroupRoleResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SetProjectMemberUsergroupRoleResponseMultiError, or nil if none found.
+func (m *SetProjectMemberUsergroupRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetProjectMemberUsergroupRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SetProjectMemberUsergroupRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetProjectMemberUsergroupRoleResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// SetProjectMemberUsergroupRoleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetProjectMemberUsergroupRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetProjectMemberUsergroupRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetProjectMemberUsergroupRoleResponseMultiError) AllErrors() []error { return m }
+
+// SetProjectMemberUsergroupRoleResponseValidationError is the validation error
+// returned by SetProjectMemberUsergroupRoleResponse.Validate if the
+// designated constraints aren't met.
+type SetProjectMemberUsergroupRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetProjectMemberUsergroupRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetProjectMemberUsergroupRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetProjectMemberUsergroupRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetProjectMemberUsergroupRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetProjectMemberUsergroupRoleResponseValidationError) ErrorName() string {
+	return "SetProjectMemberUsergroupRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetProjectMemberUsergroupRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetProjectMemberUsergroupRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetProjectMemberUsergroupRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetProjectMemberUsergroupRoleResponseValidationError{}
+
+// Validate checks the field values on RemoveProjectMemberUsergroupRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RemoveProjectMemberUsergroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveProjectMemberUsergroupRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RemoveProjectMemberUsergroupRequestMultiError, or nil if none found.
+func (m *RemoveProjectMemberUsergroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveProjectMemberUsergroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
 
-	case *SudoGetResourceResponse_Instance:
-		if v == nil {
-			err := SudoGetResourceResponseValidationError{
-				field:  "Resource",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := RemoveProjectMemberUsergroupRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
-		if all {
-			switch v := interface{}(m.GetInstance()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
-						field:  "Instance",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
-						field:  "Instance",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetInstance()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SudoGetResourceResponseValidationError{
-					field:  "Instance",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := RemoveProjectMemberUsergroupRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	default:
-		_ = v // ensures v is used
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := RemoveProjectMemberUsergroupRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return SudoGetResourceResponseMultiError(errors)
+		return RemoveProjectMemberUsergroupRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SudoGetResourceResponseMultiError is an error wrapping multiple validation
-// errors returned by SudoGetResourceResponse.ValidateAll() if the designated
+// RemoveProjectMemberUsergroupRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// RemoveProjectMemberUsergroupRequest.ValidateAll() if the designated
 // constraints aren't met.
-type SudoGetResourceResponseMultiError []error
+type RemoveProjectMemberUsergroupRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SudoGetResourceResponseMultiError) Error() string {
+func (m RemoveProjectMemberUsergroupRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8205,11 +13663,12 @@ func (m SudoGetResourceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SudoGetResourceResponseMultiError) AllErrors() []error { return m }
+func (m RemoveProjectMemberUsergroupRequestMultiError) AllErrors() []error { return m }
 
-// SudoGetResourceResponseValidationError is the validation error returned by
-// SudoGetResourceResponse.Validate if the designated constraints aren't met.
-type SudoGetResourceResponseValidationError struct {
+// RemoveProjectMemberUsergroupRequestValidationError is the validation error
+// returned by RemoveProjectMemberUsergroupRequest.Validate if the designated
+// constraints aren't met.
+type RemoveProjectMemberUsergroupRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8217,24 +13676,24 @@ type SudoGetResourceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SudoGetResourceResponseValidationError) Field() string { return e.field }
+func (e RemoveProjectMemberUsergroupRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SudoGetResourceResponseValidationError) Reason() string { return e.reason }
+func (e RemoveProjectMemberUsergroupRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SudoGetResourceResponseValidationError) Cause() error { return e.cause }
+func (e RemoveProjectMemberUsergroupRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SudoGetResourceResponseValidationError) Key() bool { return e.key }
+func (e RemoveProjectMemberUsergroupRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SudoGetResourceResponseValidationError) ErrorName() string {
-	return "SudoGetResourceResponseValidationError"
+func (e RemoveProjectMemberUsergroupRequestValidationError) ErrorName() string {
+	return "RemoveProjectMemberUsergroupRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SudoGetResourceResponseValidationError) Error() string {
+func (e RemoveProjectMemberUsergroupRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8246,14 +13705,14 @@ func (e SudoGetResourceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSudoGetResourceResponse.%s: %s%s",
+		"invalid %sRemoveProjectMemberUsergroupRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SudoGetResourceResponseValidationError{}
+var _ error = RemoveProjectMemberUsergroupRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8261,68 +13720,188 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SudoGetResourceResponseValidationError{}
+} = RemoveProjectMemberUsergroupRequestValidationError{}
 
-// Validate checks the field values on SudoUpdateOrganizationQuotasRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *SudoUpdateOrganizationQuotasRequest) Validate() error {
+// Validate checks the field values on RemoveProjectMemberUsergroupResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *RemoveProjectMemberUsergroupResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SudoUpdateOrganizationQuotasRequest
+// ValidateAll checks the field values on RemoveProjectMemberUsergroupResponse
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the result is a list of violation errors wrapped in
-// SudoUpdateOrganizationQuotasRequestMultiError, or nil if none found.
-func (m *SudoUpdateOrganizationQuotasRequest) ValidateAll() error {
+// RemoveProjectMemberUsergroupResponseMultiError, or nil if none found.
+func (m *RemoveProjectMemberUsergroupResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SudoUpdateOrganizationQuotasRequest) validate(all bool) error {
+func (m *RemoveProjectMemberUsergroupResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OrgName
+	if len(errors) > 0 {
+		return RemoveProjectMemberUsergroupResponseMultiError(errors)
+	}
 
-	if m.Projects != nil {
-		// no validation rules for Projects
+	return nil
+}
+
+// RemoveProjectMemberUsergroupResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// RemoveProjectMemberUsergroupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveProjectMemberUsergroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveProjectMemberUsergroupResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	if m.Deployments != nil {
-		// no validation rules for Deployments
+// AllErrors returns a list of validation violation errors.
+func (m RemoveProjectMemberUsergroupResponseMultiError) AllErrors() []error { return m }
+
+// RemoveProjectMemberUsergroupResponseValidationError is the validation error
+// returned by RemoveProjectMemberUsergroupResponse.Validate if the designated
+// constraints aren't met.
+type RemoveProjectMemberUsergroupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveProjectMemberUsergroupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveProjectMemberUsergroupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveProjectMemberUsergroupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveProjectMemberUsergroupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveProjectMemberUsergroupResponseValidationError) ErrorName() string {
+	return "RemoveProjectMemberUsergroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveProjectMemberUsergroupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	if m.SlotsTotal != nil {
-		// no validation rules for SlotsTotal
+	key := ""
+	if e.key {
+		key = "key for "
 	}
 
-	if m.SlotsPerDeployment != nil {
-		// no validation rules for SlotsPerDeployment
+	return fmt.Sprintf(
+		"invalid %sRemoveProjectMemberUsergroupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveProjectMemberUsergroupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveProjectMemberUsergroupResponseValidationError{}
+
+// Validate checks the field values on ListProjectMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProjectMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProjectMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProjectMembersRequestMultiError, or nil if none found.
+func (m *ListProjectMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProjectMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	if m.OutstandingInvites != nil {
-		// no validation rules for OutstandingInvites
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListProjectMembersRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := ListProjectMembersRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := ListProjectMembersRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
 	}
 
+	// no validation rules for PageToken
+
 	if len(errors) > 0 {
-		return SudoUpdateOrganizationQuotasRequestMultiError(errors)
+		return ListProjectMembersRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SudoUpdateOrganizationQuotasRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// SudoUpdateOrganizationQuotasRequest.ValidateAll() if the designated
-// constraints aren't met.
-type SudoUpdateOrganizationQuotasRequestMultiError []error
+// ListProjectMembersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListProjectMembersRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListProjectMembersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SudoUpdateOrganizationQuotasRequestMultiError) Error() string {
+func (m ListProjectMembersRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8331,12 +13910,11 @@ func (m SudoUpdateOrganizationQuotasRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SudoUpdateOrganizationQuotasRequestMultiError) AllErrors() []error { return m }
+func (m ListProjectMembersRequestMultiError) AllErrors() []error { return m }
 
-// SudoUpdateOrganizationQuotasRequestValidationError is the validation error
-// returned by SudoUpdateOrganizationQuotasRequest.Validate if the designated
-// constraints aren't met.
-type SudoUpdateOrganizationQuotasRequestValidationError struct {
+// ListProjectMembersRequestValidationError is the validation error returned by
+// ListProjectMembersRequest.Validate if the designated constraints aren't met.
+type ListProjectMembersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8344,24 +13922,24 @@ type SudoUpdateOrganizationQuotasRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SudoUpdateOrganizationQuotasRequestValidationError) Field() string { return e.field }
+func (e ListProjectMembersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SudoUpdateOrganizationQuotasRequestValidationError) Reason() string { return e.reason }
+func (e ListProjectMembersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SudoUpdateOrganizationQuotasRequestValidationError) Cause() error { return e.cause }
+func (e ListProjectMembersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SudoUpdateOrganizationQuotasRequestValidationError) Key() bool { return e.key }
+func (e ListProjectMembersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SudoUpdateOrganizationQuotasRequestValidationError) ErrorName() string {
-	return "SudoUpdateOrganizationQuotasRequestValidationError"
+func (e ListProjectMembersRequestValidationError) ErrorName() string {
+	return "ListProjectMembersRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SudoUpdateOrganizationQuotasRequestValidationError) Error() string {
+func (e ListProjectMembersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8373,14 +13951,14 @@ func (e SudoUpdateOrganizationQuotasRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSudoUpdateOrganizationQuotasRequest.%s: %s%s",
+		"invalid %sListProjectMembersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SudoUpdateOrganizationQuotasRequestValidationError{}
+var _ error = ListProjectMembersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8388,75 +13966,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SudoUpdateOrganizationQuotasRequestValidationError{}
+} = ListProjectMembersRequestValidationError{}
 
-// Validate checks the field values on SudoUpdateOrganizationQuotasResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *SudoUpdateOrganizationQuotasResponse) Validate() error {
+// Validate checks the field values on ListProjectMembersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProjectMembersResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SudoUpdateOrganizationQuotasResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// SudoUpdateOrganizationQuotasResponseMultiError, or nil if none found.
-func (m *SudoUpdateOrganizationQuotasResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListProjectMembersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProjectMembersResponseMultiError, or nil if none found.
+func (m *ListProjectMembersResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SudoUpdateOrganizationQuotasResponse) validate(all bool) error {
+func (m *ListProjectMembersResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetOrganization()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SudoUpdateOrganizationQuotasResponseValidationError{
-					field:  "Organization",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProjectMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProjectMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SudoUpdateOrganizationQuotasResponseValidationError{
-					field:  "Organization",
+				return ListProjectMembersResponseValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOrganization()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SudoUpdateOrganizationQuotasResponseValidationError{
-				field:  "Organization",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return SudoUpdateOrganizationQuotasResponseMultiError(errors)
+		return ListProjectMembersResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SudoUpdateOrganizationQuotasResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// SudoUpdateOrganizationQuotasResponse.ValidateAll() if the designated
-// constraints aren't met.
-type SudoUpdateOrganizationQuotasResponseMultiError []error
+// ListProjectMembersResponseMultiError is an error wrapping multiple
+// validation errors returned by ListProjectMembersResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListProjectMembersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SudoUpdateOrganizationQuotasResponseMultiError) Error() string {
+func (m ListProjectMembersResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8465,12 +14048,11 @@ func (m SudoUpdateOrganizationQuotasResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SudoUpdateOrganizationQuotasResponseMultiError) AllErrors() []error { return m }
+func (m ListProjectMembersResponseMultiError) AllErrors() []error { return m }
 
-// SudoUpdateOrganizationQuotasResponseValidationError is the validation error
-// returned by SudoUpdateOrganizationQuotasResponse.Validate if the designated
-// constraints aren't met.
-type SudoUpdateOrganizationQuotasResponseValidationError struct {
+// ListProjectMembersResponseValidationError is the validation error returned
+// by ListProjectMembersResponse.Validate if the designated constraints aren't met.
+type ListProjectMembersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8478,24 +14060,24 @@ type SudoUpdateOrganizationQuotasResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SudoUpdateOrganizationQuotasResponseValidationError) Field() string { return e.field }
+func (e ListProjectMembersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SudoUpdateOrganizationQuotasResponseValidationError) Reason() string { return e.reason }
+func (e ListProjectMembersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SudoUpdateOrganizationQuotasResponseValidationError) Cause() error { return e.cause }
+func (e ListProjectMembersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SudoUpdateOrganizationQuotasResponseValidationError) Key() bool { return e.key }
+func (e ListProjectMembersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SudoUpdateOrganizationQuotasResponseValidationError) ErrorName() string {
-	return "SudoUpdateOrganizationQuotasResponseValidationError"
+func (e ListProjectMembersResponseValidationError) ErrorName() string {
+	return "ListProjectMembersResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SudoUpdateOrganizationQuotasResponseValidationError) Error() string {
+func (e ListProjectMembersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8507,14 +14089,14 @@ func (e SudoUpdateOrganizationQuotasResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSudoUpdateOrganizationQuotasResponse.%s: %s%s",
+		"invalid %sListProjectMembersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SudoUpdateOrganizationQuotasResponseValidationError{}
+var _ error = ListProjectMembersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8522,50 +14104,83 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SudoUpdateOrganizationQuotasResponseValidationError{}
+} = ListProjectMembersResponseValidationError{}
 
-// Validate checks the field values on SudoUpdateUserQuotasRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListProjectInvitesRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SudoUpdateUserQuotasRequest) Validate() error {
+func (m *ListProjectInvitesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SudoUpdateUserQuotasRequest with the
+// ValidateAll checks the field values on ListProjectInvitesRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SudoUpdateUserQuotasRequestMultiError, or nil if none found.
-func (m *SudoUpdateUserQuotasRequest) ValidateAll() error {
+// ListProjectInvitesRequestMultiError, or nil if none found.
+func (m *ListProjectInvitesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SudoUpdateUserQuotasRequest) validate(all bool) error {
+func (m *ListProjectInvitesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Email
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListProjectInvitesRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := ListProjectInvitesRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := ListProjectInvitesRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-	if m.SingleuserOrgs != nil {
-		// no validation rules for SingleuserOrgs
 	}
 
+	// no validation rules for PageToken
+
 	if len(errors) > 0 {
-		return SudoUpdateUserQuotasRequestMultiError(errors)
+		return ListProjectInvitesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SudoUpdateUserQuotasRequestMultiError is an error wrapping multiple
-// validation errors returned by SudoUpdateUserQuotasRequest.ValidateAll() if
-// the designated constraints aren't met.
-type SudoUpdateUserQuotasRequestMultiError []error
+// ListProjectInvitesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListProjectInvitesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListProjectInvitesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SudoUpdateUserQuotasRequestMultiError) Error() string {
+func (m ListProjectInvitesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8574,12 +14189,11 @@ func (m SudoUpdateUserQuotasRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SudoUpdateUserQuotasRequestMultiError) AllErrors() []error { return m }
+func (m ListProjectInvitesRequestMultiError) AllErrors() []error { return m }
 
-// SudoUpdateUserQuotasRequestValidationError is the validation error returned
-// by SudoUpdateUserQuotasRequest.Validate if the designated constraints
-// aren't met.
-type SudoUpdateUserQuotasRequestValidationError struct {
+// ListProjectInvitesRequestValidationError is the validation error returned by
+// ListProjectInvitesRequest.Validate if the designated constraints aren't met.
+type ListProjectInvitesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8587,24 +14201,24 @@ type SudoUpdateUserQuotasRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SudoUpdateUserQuotasRequestValidationError) Field() string { return e.field }
+func (e ListProjectInvitesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SudoUpdateUserQuotasRequestValidationError) Reason() string { return e.reason }
+func (e ListProjectInvitesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SudoUpdateUserQuotasRequestValidationError) Cause() error { return e.cause }
+func (e ListProjectInvitesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SudoUpdateUserQuotasRequestValidationError) Key() bool { return e.key }
+func (e ListProjectInvitesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SudoUpdateUserQuotasRequestValidationError) ErrorName() string {
-	return "SudoUpdateUserQuotasRequestValidationError"
+func (e ListProjectInvitesRequestValidationError) ErrorName() string {
+	return "ListProjectInvitesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SudoUpdateUserQuotasRequestValidationError) Error() string {
+func (e ListProjectInvitesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8616,14 +14230,14 @@ func (e SudoUpdateUserQuotasRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSudoUpdateUserQuotasRequest.%s: %s%s",
+		"invalid %sListProjectInvitesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SudoUpdateUserQuotasRequestValidationError{}
+var _ error = ListProjectInvitesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8631,73 +14245,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SudoUpdateUserQuotasRequestValidationError{}
+} = ListProjectInvitesRequestValidationError{}
 
-// Validate checks the field values on SudoUpdateUserQuotasResponse with the
+// Validate checks the field values on ListProjectInvitesResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SudoUpdateUserQuotasResponse) Validate() error {
+func (m *ListProjectInvitesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SudoUpdateUserQuotasResponse with the
+// ValidateAll checks the field values on ListProjectInvitesResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SudoUpdateUserQuotasResponseMultiError, or nil if none found.
-func (m *SudoUpdateUserQuotasResponse) ValidateAll() error {
+// ListProjectInvitesResponseMultiError, or nil if none found.
+func (m *ListProjectInvitesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SudoUpdateUserQuotasResponse) validate(all bool) error {
+func (m *ListProjectInvitesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetUser()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SudoUpdateUserQuotasResponseValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetInvites() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProjectInvitesResponseValidationError{
+						field:  fmt.Sprintf("Invites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProjectInvitesResponseValidationError{
+						field:  fmt.Sprintf("Invites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SudoUpdateUserQuotasResponseValidationError{
-					field:  "User",
+				return ListProjectInvitesResponseValidationError{
+					field:  fmt.Sprintf("Invites[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SudoUpdateUserQuotasResponseValidationError{
-				field:  "User",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return SudoUpdateUserQuotasResponseMultiError(errors)
+		return ListProjectInvitesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SudoUpdateUserQuotasResponseMultiError is an error wrapping multiple
-// validation errors returned by SudoUpdateUserQuotasResponse.ValidateAll() if
+// ListProjectInvitesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListProjectInvitesResponse.ValidateAll() if
 // the designated constraints aren't met.
-type SudoUpdateUserQuotasResponseMultiError []error
+type ListProjectInvitesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SudoUpdateUserQuotasResponseMultiError) Error() string {
+func (m ListProjectInvitesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8706,12 +14327,11 @@ func (m SudoUpdateUserQuotasResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SudoUpdateUserQuotasResponseMultiError) AllErrors() []error { return m }
+func (m ListProjectInvitesResponseMultiError) AllErrors() []error { return m }
 
-// SudoUpdateUserQuotasResponseValidationError is the validation error returned
-// by SudoUpdateUserQuotasResponse.Validate if the designated constraints
-// aren't met.
-type SudoUpdateUserQuotasResponseValidationError struct {
+// ListProjectInvitesResponseValidationError is the validation error returned
+// by ListProjectInvitesResponse.Validate if the designated constraints aren't met.
+type ListProjectInvitesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8719,24 +14339,24 @@ type SudoUpdateUserQuotasResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SudoUpdateUserQuotasResponseValidationError) Field() string { return e.field }
+func (e ListProjectInvitesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SudoUpdateUserQuotasResponseValidationError) Reason() string { return e.reason }
+func (e ListProjectInvitesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SudoUpdateUserQuotasResponseValidationError) Cause() error { return e.cause }
+func (e ListProjectInvitesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SudoUpdateUserQuotasResponseValidationError) Key() bool { return e.key }
+func (e ListProjectInvitesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SudoUpdateUserQuotasResponseValidationError) ErrorName() string {
-	return "SudoUpdateUserQuotasResponseValidationError"
+func (e ListProjectInvitesResponseValidationError) ErrorName() string {
+	return "ListProjectInvitesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SudoUpdateUserQuotasResponseValidationError) Error() string {
+func (e ListProjectInvitesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8748,14 +14368,14 @@ func (e SudoUpdateUserQuotasResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSudoUpdateUserQuotasResponse.%s: %s%s",
+		"invalid %sListProjectInvitesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SudoUpdateUserQuotasResponseValidationError{}
+var _ error = ListProjectInvitesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8763,24 +14383,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SudoUpdateUserQuotasResponseValidationError{}
+} = ListProjectInvitesResponseValidationError{}
 
-// Validate checks the field values on ListProjectMembersRequest with the rules
+// Validate checks the field values on AddProjectMemberRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListProjectMembersRequest) Validate() error {
+func (m *AddProjectMemberRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectMembersRequest with the
+// ValidateAll checks the field values on AddProjectMemberRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListProjectMembersRequestMultiError, or nil if none found.
-func (m *ListProjectMembersRequest) ValidateAll() error {
+// AddProjectMemberRequestMultiError, or nil if none found.
+func (m *AddProjectMemberRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectMembersRequest) validate(all bool) error {
+func (m *AddProjectMemberRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8788,7 +14408,7 @@ func (m *ListProjectMembersRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := ListProjectMembersRequestValidationError{
+		err := AddProjectMemberRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -8799,7 +14419,7 @@ func (m *ListProjectMembersRequest) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := ListProjectMembersRequestValidationError{
+		err := AddProjectMemberRequestValidationError{
 			field:  "Project",
 			reason: "value length must be at least 1 runes",
 		}
@@ -8809,37 +14429,93 @@ func (m *ListProjectMembersRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetPageSize() != 0 {
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = AddProjectMemberRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-		if m.GetPageSize() > 1000 {
-			err := ListProjectMembersRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 1000",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := AddProjectMemberRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
+	if len(errors) > 0 {
+		return AddProjectMemberRequestMultiError(errors)
 	}
 
-	// no validation rules for PageToken
+	return nil
+}
 
-	if len(errors) > 0 {
-		return ListProjectMembersRequestMultiError(errors)
+func (m *AddProjectMemberRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
 	}
 
 	return nil
 }
 
-// ListProjectMembersRequestMultiError is an error wrapping multiple validation
-// errors returned by ListProjectMembersRequest.ValidateAll() if the
-// designated constraints aren't met.
-type ListProjectMembersRequestMultiError []error
+func (m *AddProjectMemberRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// AddProjectMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by AddProjectMemberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddProjectMemberRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectMembersRequestMultiError) Error() string {
+func (m AddProjectMemberRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8848,11 +14524,11 @@ func (m ListProjectMembersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectMembersRequestMultiError) AllErrors() []error { return m }
+func (m AddProjectMemberRequestMultiError) AllErrors() []error { return m }
 
-// ListProjectMembersRequestValidationError is the validation error returned by
-// ListProjectMembersRequest.Validate if the designated constraints aren't met.
-type ListProjectMembersRequestValidationError struct {
+// AddProjectMemberRequestValidationError is the validation error returned by
+// AddProjectMemberRequest.Validate if the designated constraints aren't met.
+type AddProjectMemberRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8860,24 +14536,24 @@ type ListProjectMembersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectMembersRequestValidationError) Field() string { return e.field }
+func (e AddProjectMemberRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectMembersRequestValidationError) Reason() string { return e.reason }
+func (e AddProjectMemberRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectMembersRequestValidationError) Cause() error { return e.cause }
+func (e AddProjectMemberRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectMembersRequestValidationError) Key() bool { return e.key }
+func (e AddProjectMemberRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectMembersRequestValidationError) ErrorName() string {
-	return "ListProjectMembersRequestValidationError"
+func (e AddProjectMemberRequestValidationError) ErrorName() string {
+	return "AddProjectMemberRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectMembersRequestValidationError) Error() string {
+func (e AddProjectMemberRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8889,95 +14565,61 @@ func (e ListProjectMembersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectMembersRequest.%s: %s%s",
+		"invalid %sAddProjectMemberRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
-		cause)
-}
-
-var _ error = ListProjectMembersRequestValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = ListProjectMembersRequestValidationError{}
-
-// Validate checks the field values on ListProjectMembersResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListProjectMembersResponse) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on ListProjectMembersResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListProjectMembersResponseMultiError, or nil if none found.
-func (m *ListProjectMembersResponse) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *ListProjectMembersResponse) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	for idx, item := range m.GetMembers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListProjectMembersResponseValidationError{
-						field:  fmt.Sprintf("Members[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListProjectMembersResponseValidationError{
-						field:  fmt.Sprintf("Members[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListProjectMembersResponseValidationError{
-					field:  fmt.Sprintf("Members[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+		cause)
+}
+
+var _ error = AddProjectMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddProjectMemberRequestValidationError{}
+
+// Validate checks the field values on AddProjectMemberResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddProjectMemberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddProjectMemberResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddProjectMemberResponseMultiError, or nil if none found.
+func (m *AddProjectMemberResponse) ValidateAll() error {
+	return m.validate(true)
+}
 
+func (m *AddProjectMemberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	// no validation rules for NextPageToken
+	var errors []error
+
+	// no validation rules for PendingSignup
 
 	if len(errors) > 0 {
-		return ListProjectMembersResponseMultiError(errors)
+		return AddProjectMemberResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProjectMembersResponseMultiError is an error wrapping multiple
-// validation errors returned by ListProjectMembersResponse.ValidateAll() if
-// the designated constraints aren't met.
-type ListProjectMembersResponseMultiError []error
+// AddProjectMemberResponseMultiError is an error wrapping multiple validation
+// errors returned by AddProjectMemberResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AddProjectMemberResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectMembersResponseMultiError) Error() string {
+func (m AddProjectMemberResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8986,11 +14628,11 @@ func (m ListProjectMembersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectMembersResponseMultiError) AllErrors() []error { return m }
+func (m AddProjectMemberResponseMultiError) AllErrors() []error { return m }
 
-// ListProjectMembersResponseValidationError is the validation error returned
-// by ListProjectMembersResponse.Validate if the designated constraints aren't met.
-type ListProjectMembersResponseValidationError struct {
+// AddProjectMemberResponseValidationError is the validation error returned by
+// AddProjectMemberResponse.Validate if the designated constraints aren't met.
+type AddProjectMemberResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8998,24 +14640,24 @@ type ListProjectMembersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectMembersResponseValidationError) Field() string { return e.field }
+func (e AddProjectMemberResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectMembersResponseValidationError) Reason() string { return e.reason }
+func (e AddProjectMemberResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectMembersResponseValidationError) Cause() error { return e.cause }
+func (e AddProjectMemberResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectMembersResponseValidationError) Key() bool { return e.key }
+func (e AddProjectMemberResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectMembersResponseValidationError) ErrorName() string {
-	return "ListProjectMembersResponseValidationError"
+func (e AddProjectMemberResponseValidationError) ErrorName() string {
+	return "AddProjectMemberResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectMembersResponseValidationError) Error() string {
+func (e AddProjectMemberResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9027,14 +14669,14 @@ func (e ListProjectMembersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectMembersResponse.%s: %s%s",
+		"invalid %sAddProjectMemberResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProjectMembersResponseValidationError{}
+var _ error = AddProjectMemberResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9042,24 +14684,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProjectMembersResponseValidationError{}
+} = AddProjectMemberResponseValidationError{}
 
-// Validate checks the field values on ListProjectInvitesRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RemoveProjectMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListProjectInvitesRequest) Validate() error {
+func (m *RemoveProjectMemberRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectInvitesRequest with the
+// ValidateAll checks the field values on RemoveProjectMemberRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListProjectInvitesRequestMultiError, or nil if none found.
-func (m *ListProjectInvitesRequest) ValidateAll() error {
+// RemoveProjectMemberRequestMultiError, or nil if none found.
+func (m *RemoveProjectMemberRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectInvitesRequest) validate(all bool) error {
+func (m *RemoveProjectMemberRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9067,7 +14709,7 @@ func (m *ListProjectInvitesRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := ListProjectInvitesRequestValidationError{
+		err := RemoveProjectMemberRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -9078,7 +14720,7 @@ func (m *ListProjectInvitesRequest) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := ListProjectInvitesRequestValidationError{
+		err := RemoveProjectMemberRequestValidationError{
 			field:  "Project",
 			reason: "value length must be at least 1 runes",
 		}
@@ -9088,37 +14730,82 @@ func (m *ListProjectInvitesRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetPageSize() != 0 {
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = RemoveProjectMemberRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-		if m.GetPageSize() > 1000 {
-			err := ListProjectInvitesRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 1000",
-			}
-			if !all {
-				return err
+	if len(errors) > 0 {
+		return RemoveProjectMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RemoveProjectMemberRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
 			}
-			errors = append(errors, err)
 		}
+	}
+
+	return nil
+}
 
+func (m *RemoveProjectMemberRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
 	}
+	addr = a.Address
 
-	// no validation rules for PageToken
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
 
-	if len(errors) > 0 {
-		return ListProjectInvitesRequestMultiError(errors)
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
 	}
 
-	return nil
+	return m._validateHostname(parts[1])
 }
 
-// ListProjectInvitesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListProjectInvitesRequest.ValidateAll() if the
-// designated constraints aren't met.
-type ListProjectInvitesRequestMultiError []error
+// RemoveProjectMemberRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveProjectMemberRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RemoveProjectMemberRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectInvitesRequestMultiError) Error() string {
+func (m RemoveProjectMemberRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9127,11 +14814,11 @@ func (m ListProjectInvitesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectInvitesRequestMultiError) AllErrors() []error { return m }
+func (m RemoveProjectMemberRequestMultiError) AllErrors() []error { return m }
 
-// ListProjectInvitesRequestValidationError is the validation error returned by
-// ListProjectInvitesRequest.Validate if the designated constraints aren't met.
-type ListProjectInvitesRequestValidationError struct {
+// RemoveProjectMemberRequestValidationError is the validation error returned
+// by RemoveProjectMemberRequest.Validate if the designated constraints aren't met.
+type RemoveProjectMemberRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9139,24 +14826,24 @@ type ListProjectInvitesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectInvitesRequestValidationError) Field() string { return e.field }
+func (e RemoveProjectMemberRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectInvitesRequestValidationError) Reason() string { return e.reason }
+func (e RemoveProjectMemberRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectInvitesRequestValidationError) Cause() error { return e.cause }
+func (e RemoveProjectMemberRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectInvitesRequestValidationError) Key() bool { return e.key }
+func (e RemoveProjectMemberRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectInvitesRequestValidationError) ErrorName() string {
-	return "ListProjectInvitesRequestValidationError"
+func (e RemoveProjectMemberRequestValidationError) ErrorName() string {
+	return "RemoveProjectMemberRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectInvitesRequestValidationError) Error() string {
+func (e RemoveProjectMemberRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9168,14 +14855,14 @@ func (e ListProjectInvitesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectInvitesRequest.%s: %s%s",
+		"invalid %sRemoveProjectMemberRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProjectInvitesRequestValidationError{}
+var _ error = RemoveProjectMemberRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9183,80 +14870,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProjectInvitesRequestValidationError{}
+} = RemoveProjectMemberRequestValidationError{}
 
-// Validate checks the field values on ListProjectInvitesResponse with the
+// Validate checks the field values on RemoveProjectMemberResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListProjectInvitesResponse) Validate() error {
+func (m *RemoveProjectMemberResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectInvitesResponse with the
+// ValidateAll checks the field values on RemoveProjectMemberResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListProjectInvitesResponseMultiError, or nil if none found.
-func (m *ListProjectInvitesResponse) ValidateAll() error {
+// RemoveProjectMemberResponseMultiError, or nil if none found.
+func (m *RemoveProjectMemberResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectInvitesResponse) validate(all bool) error {
+func (m *RemoveProjectMemberResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetInvites() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListProjectInvitesResponseValidationError{
-						field:  fmt.Sprintf("Invites[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListProjectInvitesResponseValidationError{
-						field:  fmt.Sprintf("Invites[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListProjectInvitesResponseValidationError{
-					field:  fmt.Sprintf("Invites[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for NextPageToken
-
 	if len(errors) > 0 {
-		return ListProjectInvitesResponseMultiError(errors)
+		return RemoveProjectMemberResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProjectInvitesResponseMultiError is an error wrapping multiple
-// validation errors returned by ListProjectInvitesResponse.ValidateAll() if
+// RemoveProjectMemberResponseMultiError is an error wrapping multiple
+// validation errors returned by RemoveProjectMemberResponse.ValidateAll() if
 // the designated constraints aren't met.
-type ListProjectInvitesResponseMultiError []error
+type RemoveProjectMemberResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectInvitesResponseMultiError) Error() string {
+func (m RemoveProjectMemberResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9265,11 +14916,12 @@ func (m ListProjectInvitesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectInvitesResponseMultiError) AllErrors() []error { return m }
+func (m RemoveProjectMemberResponseMultiError) AllErrors() []error { return m }
 
-// ListProjectInvitesResponseValidationError is the validation error returned
-// by ListProjectInvitesResponse.Validate if the designated constraints aren't met.
-type ListProjectInvitesResponseValidationError struct {
+// RemoveProjectMemberResponseValidationError is the validation error returned
+// by RemoveProjectMemberResponse.Validate if the designated constraints
+// aren't met.
+type RemoveProjectMemberResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9277,24 +14929,24 @@ type ListProjectInvitesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectInvitesResponseValidationError) Field() string { return e.field }
+func (e RemoveProjectMemberResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectInvitesResponseValidationError) Reason() string { return e.reason }
+func (e RemoveProjectMemberResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectInvitesResponseValidationError) Cause() error { return e.cause }
+func (e RemoveProjectMemberResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectInvitesResponseValidationError) Key() bool { return e.key }
+func (e RemoveProjectMemberResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectInvitesResponseValidationError) ErrorName() string {
-	return "ListProjectInvitesResponseValidationError"
+func (e RemoveProjectMemberResponseValidationError) ErrorName() string {
+	return "RemoveProjectMemberResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectInvitesResponseValidationError) Error() string {
+func (e RemoveProjectMemberResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9306,14 +14958,14 @@ func (e ListProjectInvitesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectInvitesResponse.%s: %s%s",
+		"invalid %sRemoveProjectMemberResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProjectInvitesResponseValidationError{}
+var _ error = RemoveProjectMemberResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9321,24 +14973,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProjectInvitesResponseValidationError{}
+} = RemoveProjectMemberResponseValidationError{}
 
-// Validate checks the field values on AddProjectMemberRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SetProjectMemberRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddProjectMemberRequest) Validate() error {
+func (m *SetProjectMemberRoleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddProjectMemberRequest with the
+// ValidateAll checks the field values on SetProjectMemberRoleRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AddProjectMemberRequestMultiError, or nil if none found.
-func (m *AddProjectMemberRequest) ValidateAll() error {
+// SetProjectMemberRoleRequestMultiError, or nil if none found.
+func (m *SetProjectMemberRoleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddProjectMemberRequest) validate(all bool) error {
+func (m *SetProjectMemberRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9346,7 +14998,7 @@ func (m *AddProjectMemberRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := AddProjectMemberRequestValidationError{
+		err := SetProjectMemberRoleRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -9357,7 +15009,7 @@ func (m *AddProjectMemberRequest) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := AddProjectMemberRequestValidationError{
+		err := SetProjectMemberRoleRequestValidationError{
 			field:  "Project",
 			reason: "value length must be at least 1 runes",
 		}
@@ -9368,7 +15020,7 @@ func (m *AddProjectMemberRequest) validate(all bool) error {
 	}
 
 	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = AddProjectMemberRequestValidationError{
+		err = SetProjectMemberRoleRequestValidationError{
 			field:  "Email",
 			reason: "value must be a valid email address",
 			cause:  err,
@@ -9380,7 +15032,7 @@ func (m *AddProjectMemberRequest) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetRole()) < 1 {
-		err := AddProjectMemberRequestValidationError{
+		err := SetProjectMemberRoleRequestValidationError{
 			field:  "Role",
 			reason: "value length must be at least 1 runes",
 		}
@@ -9391,13 +15043,13 @@ func (m *AddProjectMemberRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AddProjectMemberRequestMultiError(errors)
+		return SetProjectMemberRoleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *AddProjectMemberRequest) _validateHostname(host string) error {
+func (m *SetProjectMemberRoleRequest) _validateHostname(host string) error {
 	s := strings.ToLower(strings.TrimSuffix(host, "."))
 
 	if len(host) > 253 {
@@ -9427,7 +15079,7 @@ func (m *AddProjectMemberRequest) _validateHostname(host string) error {
 	return nil
 }
 
-func (m *AddProjectMemberRequest) _validateEmail(addr string) error {
+func (m *SetProjectMemberRoleRequest) _validateEmail(addr string) error {
 	a, err := mail.ParseAddress(addr)
 	if err != nil {
 		return err
@@ -9447,13 +15099,13 @@ func (m *AddProjectMemberRequest) _validateEmail(addr string) error {
 	return m._validateHostname(parts[1])
 }
 
-// AddProjectMemberRequestMultiError is an error wrapping multiple validation
-// errors returned by AddProjectMemberRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AddProjectMemberRequestMultiError []error
+// SetProjectMemberRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by SetProjectMemberRoleRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetProjectMemberRoleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddProjectMemberRequestMultiError) Error() string {
+func (m SetProjectMemberRoleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9462,11 +15114,12 @@ func (m AddProjectMemberRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddProjectMemberRequestMultiError) AllErrors() []error { return m }
+func (m SetProjectMemberRoleRequestMultiError) AllErrors() []error { return m }
 
-// AddProjectMemberRequestValidationError is the validation error returned by
-// AddProjectMemberRequest.Validate if the designated constraints aren't met.
-type AddProjectMemberRequestValidationError struct {
+// SetProjectMemberRoleRequestValidationError is the validation error returned
+// by SetProjectMemberRoleRequest.Validate if the designated constraints
+// aren't met.
+type SetProjectMemberRoleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9474,24 +15127,24 @@ type AddProjectMemberRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddProjectMemberRequestValidationError) Field() string { return e.field }
+func (e SetProjectMemberRoleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddProjectMemberRequestValidationError) Reason() string { return e.reason }
+func (e SetProjectMemberRoleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddProjectMemberRequestValidationError) Cause() error { return e.cause }
+func (e SetProjectMemberRoleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddProjectMemberRequestValidationError) Key() bool { return e.key }
+func (e SetProjectMemberRoleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddProjectMemberRequestValidationError) ErrorName() string {
-	return "AddProjectMemberRequestValidationError"
+func (e SetProjectMemberRoleRequestValidationError) ErrorName() string {
+	return "SetProjectMemberRoleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddProjectMemberRequestValidationError) Error() string {
+func (e SetProjectMemberRoleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9503,14 +15156,14 @@ func (e AddProjectMemberRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddProjectMemberRequest.%s: %s%s",
+		"invalid %sSetProjectMemberRoleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddProjectMemberRequestValidationError{}
+var _ error = SetProjectMemberRoleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9518,46 +15171,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddProjectMemberRequestValidationError{}
+} = SetProjectMemberRoleRequestValidationError{}
 
-// Validate checks the field values on AddProjectMemberResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SetProjectMemberRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddProjectMemberResponse) Validate() error {
+func (m *SetProjectMemberRoleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddProjectMemberResponse with the
+// ValidateAll checks the field values on SetProjectMemberRoleResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AddProjectMemberResponseMultiError, or nil if none found.
-func (m *AddProjectMemberResponse) ValidateAll() error {
+// SetProjectMemberRoleResponseMultiError, or nil if none found.
+func (m *SetProjectMemberRoleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddProjectMemberResponse) validate(all bool) error {
+func (m *SetProjectMemberRoleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PendingSignup
-
 	if len(errors) > 0 {
-		return AddProjectMemberResponseMultiError(errors)
+		return SetProjectMemberRoleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddProjectMemberResponseMultiError is an error wrapping multiple validation
-// errors returned by AddProjectMemberResponse.ValidateAll() if the designated
-// constraints aren't met.
-type AddProjectMemberResponseMultiError []error
+// SetProjectMemberRoleResponseMultiError is an error wrapping multiple
+// validation errors returned by SetProjectMemberRoleResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SetProjectMemberRoleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddProjectMemberResponseMultiError) Error() string {
+func (m SetProjectMemberRoleResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9566,11 +15217,12 @@ func (m AddProjectMemberResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddProjectMemberResponseMultiError) AllErrors() []error { return m }
+func (m SetProjectMemberRoleResponseMultiError) AllErrors() []error { return m }
 
-// AddProjectMemberResponseValidationError is the validation error returned by
-// AddProjectMemberResponse.Validate if the designated constraints aren't met.
-type AddProjectMemberResponseValidationError struct {
+// SetProjectMemberRoleResponseValidationError is the validation error returned
+// by SetProjectMemberRoleResponse.Validate if the designated constraints
+// aren't met.
+type SetProjectMemberRoleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9578,172 +15230,88 @@ type AddProjectMemberResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddProjectMemberResponseValidationError) Field() string { return e.field }
+func (e SetProjectMemberRoleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddProjectMemberResponseValidationError) Reason() string { return e.reason }
+func (e SetProjectMemberRoleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddProjectMemberResponseValidationError) Cause() error { return e.cause }
+func (e SetProjectMemberRoleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddProjectMemberResponseValidationError) Key() bool { return e.key }
+func (e SetProjectMemberRoleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddProjectMemberResponseValidationError) ErrorName() string {
-	return "AddProjectMemberResponseValidationError"
+func (e SetProjectMemberRoleResponseValidationError) ErrorName() string {
+	return "SetProjectMemberRoleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddProjectMemberResponseValidationError) Error() string {
+func (e SetProjectMemberRoleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	key := ""
-	if e.key {
-		key = "key for "
-	}
-
-	return fmt.Sprintf(
-		"invalid %sAddProjectMemberResponse.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = AddProjectMemberResponseValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = AddProjectMemberResponseValidationError{}
-
-// Validate checks the field values on RemoveProjectMemberRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveProjectMemberRequest) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on RemoveProjectMemberRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RemoveProjectMemberRequestMultiError, or nil if none found.
-func (m *RemoveProjectMemberRequest) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *RemoveProjectMemberRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := RemoveProjectMemberRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := RemoveProjectMemberRequestValidationError{
-			field:  "Project",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = RemoveProjectMemberRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(errors) > 0 {
-		return RemoveProjectMemberRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *RemoveProjectMemberRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
-
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
-
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
-			}
-		}
+	key := ""
+	if e.key {
+		key = "key for "
 	}
 
-	return nil
+	return fmt.Sprintf(
+		"invalid %sSetProjectMemberRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
 }
 
-func (m *RemoveProjectMemberRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
+var _ error = SetProjectMemberRoleResponseValidationError{}
 
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetProjectMemberRoleResponseValidationError{}
+
+// Validate checks the field values on GetCurrentUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCurrentUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCurrentUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCurrentUserRequestMultiError, or nil if none found.
+func (m *GetCurrentUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCurrentUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	parts := strings.SplitN(addr, "@", 2)
+	var errors []error
 
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
+	if len(errors) > 0 {
+		return GetCurrentUserRequestMultiError(errors)
 	}
 
-	return m._validateHostname(parts[1])
+	return nil
 }
 
-// RemoveProjectMemberRequestMultiError is an error wrapping multiple
-// validation errors returned by RemoveProjectMemberRequest.ValidateAll() if
-// the designated constraints aren't met.
-type RemoveProjectMemberRequestMultiError []error
+// GetCurrentUserRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCurrentUserRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCurrentUserRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveProjectMemberRequestMultiError) Error() string {
+func (m GetCurrentUserRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9752,11 +15320,11 @@ func (m RemoveProjectMemberRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveProjectMemberRequestMultiError) AllErrors() []error { return m }
+func (m GetCurrentUserRequestMultiError) AllErrors() []error { return m }
 
-// RemoveProjectMemberRequestValidationError is the validation error returned
-// by RemoveProjectMemberRequest.Validate if the designated constraints aren't met.
-type RemoveProjectMemberRequestValidationError struct {
+// GetCurrentUserRequestValidationError is the validation error returned by
+// GetCurrentUserRequest.Validate if the designated constraints aren't met.
+type GetCurrentUserRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9764,24 +15332,24 @@ type RemoveProjectMemberRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveProjectMemberRequestValidationError) Field() string { return e.field }
+func (e GetCurrentUserRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveProjectMemberRequestValidationError) Reason() string { return e.reason }
+func (e GetCurrentUserRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveProjectMemberRequestValidationError) Cause() error { return e.cause }
+func (e GetCurrentUserRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveProjectMemberRequestValidationError) Key() bool { return e.key }
+func (e GetCurrentUserRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveProjectMemberRequestValidationError) ErrorName() string {
-	return "RemoveProjectMemberRequestValidationError"
+func (e GetCurrentUserRequestValidationError) ErrorName() string {
+	return "GetCurrentUserRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveProjectMemberRequestValidationError) Error() string {
+func (e GetCurrentUserRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9793,14 +15361,14 @@ func (e RemoveProjectMemberRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveProjectMemberRequest.%s: %s%s",
+		"invalid %sGetCurrentUserRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveProjectMemberRequestValidationError{}
+var _ error = GetCurrentUserRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9808,44 +15376,102 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveProjectMemberRequestValidationError{}
+} = GetCurrentUserRequestValidationError{}
 
-// Validate checks the field values on RemoveProjectMemberResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetCurrentUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveProjectMemberResponse) Validate() error {
+func (m *GetCurrentUserResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveProjectMemberResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetCurrentUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RemoveProjectMemberResponseMultiError, or nil if none found.
-func (m *RemoveProjectMemberResponse) ValidateAll() error {
+// GetCurrentUserResponseMultiError, or nil if none found.
+func (m *GetCurrentUserResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveProjectMemberResponse) validate(all bool) error {
+func (m *GetCurrentUserResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCurrentUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCurrentUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCurrentUserResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCurrentUserResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCurrentUserResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCurrentUserResponseValidationError{
+				field:  "Preferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return RemoveProjectMemberResponseMultiError(errors)
+		return GetCurrentUserResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveProjectMemberResponseMultiError is an error wrapping multiple
-// validation errors returned by RemoveProjectMemberResponse.ValidateAll() if
-// the designated constraints aren't met.
-type RemoveProjectMemberResponseMultiError []error
+// GetCurrentUserResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCurrentUserResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetCurrentUserResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveProjectMemberResponseMultiError) Error() string {
+func (m GetCurrentUserResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9854,12 +15480,11 @@ func (m RemoveProjectMemberResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveProjectMemberResponseMultiError) AllErrors() []error { return m }
+func (m GetCurrentUserResponseMultiError) AllErrors() []error { return m }
 
-// RemoveProjectMemberResponseValidationError is the validation error returned
-// by RemoveProjectMemberResponse.Validate if the designated constraints
-// aren't met.
-type RemoveProjectMemberResponseValidationError struct {
+// GetCurrentUserResponseValidationError is the validation error returned by
+// GetCurrentUserResponse.Validate if the designated constraints aren't met.
+type GetCurrentUserResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9867,24 +15492,24 @@ type RemoveProjectMemberResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveProjectMemberResponseValidationError) Field() string { return e.field }
+func (e GetCurrentUserResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveProjectMemberResponseValidationError) Reason() string { return e.reason }
+func (e GetCurrentUserResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveProjectMemberResponseValidationError) Cause() error { return e.cause }
+func (e GetCurrentUserResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveProjectMemberResponseValidationError) Key() bool { return e.key }
+func (e GetCurrentUserResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveProjectMemberResponseValidationError) ErrorName() string {
-	return "RemoveProjectMemberResponseValidationError"
+func (e GetCurrentUserResponseValidationError) ErrorName() string {
+	return "GetCurrentUserResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveProjectMemberResponseValidationError) Error() string {
+func (e GetCurrentUserResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9896,14 +15521,14 @@ func (e RemoveProjectMemberResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveProjectMemberResponse.%s: %s%s",
+		"invalid %sGetCurrentUserResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveProjectMemberResponseValidationError{}
+var _ error = GetCurrentUserResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9911,54 +15536,32 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveProjectMemberResponseValidationError{}
+} = GetCurrentUserResponseValidationError{}
 
-// Validate checks the field values on SetProjectMemberRoleRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetProjectMemberRoleRequest) Validate() error {
+// Validate checks the field values on GetUserRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetUserRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetProjectMemberRoleRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SetProjectMemberRoleRequestMultiError, or nil if none found.
-func (m *SetProjectMemberRoleRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetUserRequestMultiError,
+// or nil if none found.
+func (m *GetUserRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetProjectMemberRoleRequest) validate(all bool) error {
+func (m *GetUserRequest) validate(all bool) error {
 	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := SetProjectMemberRoleRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := SetProjectMemberRoleRequestValidationError{
-			field:  "Project",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+		return nil
 	}
 
+	var errors []error
+
 	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = SetProjectMemberRoleRequestValidationError{
+		err = GetUserRequestValidationError{
 			field:  "Email",
 			reason: "value must be a valid email address",
 			cause:  err,
@@ -9969,25 +15572,14 @@ func (m *SetProjectMemberRoleRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetRole()) < 1 {
-		err := SetProjectMemberRoleRequestValidationError{
-			field:  "Role",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
-		return SetProjectMemberRoleRequestMultiError(errors)
+		return GetUserRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *SetProjectMemberRoleRequest) _validateHostname(host string) error {
+func (m *GetUserRequest) _validateHostname(host string) error {
 	s := strings.ToLower(strings.TrimSuffix(host, "."))
 
 	if len(host) > 253 {
@@ -10017,7 +15609,7 @@ func (m *SetProjectMemberRoleRequest) _validateHostname(host string) error {
 	return nil
 }
 
-func (m *SetProjectMemberRoleRequest) _validateEmail(addr string) error {
+func (m *GetUserRequest) _validateEmail(addr string) error {
 	a, err := mail.ParseAddress(addr)
 	if err != nil {
 		return err
@@ -10037,13 +15629,13 @@ func (m *SetProjectMemberRoleRequest) _validateEmail(addr string) error {
 	return m._validateHostname(parts[1])
 }
 
-// SetProjectMemberRoleRequestMultiError is an error wrapping multiple
-// validation errors returned by SetProjectMemberRoleRequest.ValidateAll() if
-// the designated constraints aren't met.
-type SetProjectMemberRoleRequestMultiError []error
+// GetUserRequestMultiError is an error wrapping multiple validation errors
+// returned by GetUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetProjectMemberRoleRequestMultiError) Error() string {
+func (m GetUserRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10052,12 +15644,11 @@ func (m SetProjectMemberRoleRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetProjectMemberRoleRequestMultiError) AllErrors() []error { return m }
+func (m GetUserRequestMultiError) AllErrors() []error { return m }
 
-// SetProjectMemberRoleRequestValidationError is the validation error returned
-// by SetProjectMemberRoleRequest.Validate if the designated constraints
-// aren't met.
-type SetProjectMemberRoleRequestValidationError struct {
+// GetUserRequestValidationError is the validation error returned by
+// GetUserRequest.Validate if the designated constraints aren't met.
+type GetUserRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10065,24 +15656,22 @@ type SetProjectMemberRoleRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetProjectMemberRoleRequestValidationError) Field() string { return e.field }
+func (e GetUserRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetProjectMemberRoleRequestValidationError) Reason() string { return e.reason }
+func (e GetUserRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetProjectMemberRoleRequestValidationError) Cause() error { return e.cause }
+func (e GetUserRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetProjectMemberRoleRequestValidationError) Key() bool { return e.key }
+func (e GetUserRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetProjectMemberRoleRequestValidationError) ErrorName() string {
-	return "SetProjectMemberRoleRequestValidationError"
-}
+func (e GetUserRequestValidationError) ErrorName() string { return "GetUserRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SetProjectMemberRoleRequestValidationError) Error() string {
+func (e GetUserRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10094,14 +15683,14 @@ func (e SetProjectMemberRoleRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetProjectMemberRoleRequest.%s: %s%s",
+		"invalid %sGetUserRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetProjectMemberRoleRequestValidationError{}
+var _ error = GetUserRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10109,44 +15698,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetProjectMemberRoleRequestValidationError{}
+} = GetUserRequestValidationError{}
 
-// Validate checks the field values on SetProjectMemberRoleResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetProjectMemberRoleResponse) Validate() error {
+// Validate checks the field values on GetUserResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetUserResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetProjectMemberRoleResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SetProjectMemberRoleResponseMultiError, or nil if none found.
-func (m *SetProjectMemberRoleResponse) ValidateAll() error {
+// GetUserResponseMultiError, or nil if none found.
+func (m *GetUserResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetProjectMemberRoleResponse) validate(all bool) error {
+func (m *GetUserResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return SetProjectMemberRoleResponseMultiError(errors)
+		return GetUserResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetProjectMemberRoleResponseMultiError is an error wrapping multiple
-// validation errors returned by SetProjectMemberRoleResponse.ValidateAll() if
-// the designated constraints aren't met.
-type SetProjectMemberRoleResponseMultiError []error
+// GetUserResponseMultiError is an error wrapping multiple validation errors
+// returned by GetUserResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetProjectMemberRoleResponseMultiError) Error() string {
+func (m GetUserResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10155,12 +15773,11 @@ func (m SetProjectMemberRoleResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetProjectMemberRoleResponseMultiError) AllErrors() []error { return m }
+func (m GetUserResponseMultiError) AllErrors() []error { return m }
 
-// SetProjectMemberRoleResponseValidationError is the validation error returned
-// by SetProjectMemberRoleResponse.Validate if the designated constraints
-// aren't met.
-type SetProjectMemberRoleResponseValidationError struct {
+// GetUserResponseValidationError is the validation error returned by
+// GetUserResponse.Validate if the designated constraints aren't met.
+type GetUserResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10168,24 +15785,22 @@ type SetProjectMemberRoleResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetProjectMemberRoleResponseValidationError) Field() string { return e.field }
+func (e GetUserResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetProjectMemberRoleResponseValidationError) Reason() string { return e.reason }
+func (e GetUserResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetProjectMemberRoleResponseValidationError) Cause() error { return e.cause }
+func (e GetUserResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetProjectMemberRoleResponseValidationError) Key() bool { return e.key }
+func (e GetUserResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetProjectMemberRoleResponseValidationError) ErrorName() string {
-	return "SetProjectMemberRoleResponseValidationError"
-}
+func (e GetUserResponseValidationError) ErrorName() string { return "GetUserResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SetProjectMemberRoleResponseValidationError) Error() string {
+func (e GetUserResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10197,14 +15812,14 @@ func (e SetProjectMemberRoleResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetProjectMemberRoleResponse.%s: %s%s",
+		"invalid %sGetUserResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetProjectMemberRoleResponseValidationError{}
+var _ error = GetUserResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10212,44 +15827,177 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetProjectMemberRoleResponseValidationError{}
+} = GetUserResponseValidationError{}
 
-// Validate checks the field values on GetCurrentUserRequest with the rules
+// Validate checks the field values on UserPreferences with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserPreferences) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserPreferences with the rules
 // defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserPreferencesMultiError, or nil if none found.
+func (m *UserPreferences) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserPreferences) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.TimeZone != nil {
+		// no validation rules for TimeZone
+	}
+
+	if len(errors) > 0 {
+		return UserPreferencesMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserPreferencesMultiError is an error wrapping multiple validation errors
+// returned by UserPreferences.ValidateAll() if the designated constraints
+// aren't met.
+type UserPreferencesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserPreferencesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserPreferencesMultiError) AllErrors() []error { return m }
+
+// UserPreferencesValidationError is the validation error returned by
+// UserPreferences.Validate if the designated constraints aren't met.
+type UserPreferencesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserPreferencesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserPreferencesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserPreferencesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserPreferencesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserPreferencesValidationError) ErrorName() string { return "UserPreferencesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserPreferencesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserPreferences.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserPreferencesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserPreferencesValidationError{}
+
+// Validate checks the field values on UpdateUserPreferencesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCurrentUserRequest) Validate() error {
+func (m *UpdateUserPreferencesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCurrentUserRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateUserPreferencesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCurrentUserRequestMultiError, or nil if none found.
-func (m *GetCurrentUserRequest) ValidateAll() error {
+// UpdateUserPreferencesRequestMultiError, or nil if none found.
+func (m *UpdateUserPreferencesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCurrentUserRequest) validate(all bool) error {
+func (m *UpdateUserPreferencesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateUserPreferencesRequestValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateUserPreferencesRequestValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateUserPreferencesRequestValidationError{
+				field:  "Preferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return GetCurrentUserRequestMultiError(errors)
+		return UpdateUserPreferencesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCurrentUserRequestMultiError is an error wrapping multiple validation
-// errors returned by GetCurrentUserRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetCurrentUserRequestMultiError []error
+// UpdateUserPreferencesRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateUserPreferencesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateUserPreferencesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCurrentUserRequestMultiError) Error() string {
+func (m UpdateUserPreferencesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10258,11 +16006,12 @@ func (m GetCurrentUserRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCurrentUserRequestMultiError) AllErrors() []error { return m }
+func (m UpdateUserPreferencesRequestMultiError) AllErrors() []error { return m }
 
-// GetCurrentUserRequestValidationError is the validation error returned by
-// GetCurrentUserRequest.Validate if the designated constraints aren't met.
-type GetCurrentUserRequestValidationError struct {
+// UpdateUserPreferencesRequestValidationError is the validation error returned
+// by UpdateUserPreferencesRequest.Validate if the designated constraints
+// aren't met.
+type UpdateUserPreferencesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10270,24 +16019,24 @@ type GetCurrentUserRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCurrentUserRequestValidationError) Field() string { return e.field }
+func (e UpdateUserPreferencesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCurrentUserRequestValidationError) Reason() string { return e.reason }
+func (e UpdateUserPreferencesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCurrentUserRequestValidationError) Cause() error { return e.cause }
+func (e UpdateUserPreferencesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCurrentUserRequestValidationError) Key() bool { return e.key }
+func (e UpdateUserPreferencesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCurrentUserRequestValidationError) ErrorName() string {
-	return "GetCurrentUserRequestValidationError"
+func (e UpdateUserPreferencesRequestValidationError) ErrorName() string {
+	return "UpdateUserPreferencesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCurrentUserRequestValidationError) Error() string {
+func (e UpdateUserPreferencesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10299,14 +16048,14 @@ func (e GetCurrentUserRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCurrentUserRequest.%s: %s%s",
+		"invalid %sUpdateUserPreferencesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCurrentUserRequestValidationError{}
+var _ error = UpdateUserPreferencesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10314,64 +16063,35 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCurrentUserRequestValidationError{}
+} = UpdateUserPreferencesRequestValidationError{}
 
-// Validate checks the field values on GetCurrentUserResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdateUserPreferencesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCurrentUserResponse) Validate() error {
+func (m *UpdateUserPreferencesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCurrentUserResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetCurrentUserResponseMultiError, or nil if none found.
-func (m *GetCurrentUserResponse) ValidateAll() error {
+// ValidateAll checks the field values on UpdateUserPreferencesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateUserPreferencesResponseMultiError, or nil if none found.
+func (m *UpdateUserPreferencesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCurrentUserResponse) validate(all bool) error {
+func (m *UpdateUserPreferencesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetUser()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetCurrentUserResponseValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetCurrentUserResponseValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetCurrentUserResponseValidationError{
-				field:  "User",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if all {
 		switch v := interface{}(m.GetPreferences()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetCurrentUserResponseValidationError{
+				errors = append(errors, UpdateUserPreferencesResponseValidationError{
 					field:  "Preferences",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -10379,7 +16099,7 @@ func (m *GetCurrentUserResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetCurrentUserResponseValidationError{
+				errors = append(errors, UpdateUserPreferencesResponseValidationError{
 					field:  "Preferences",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -10388,7 +16108,7 @@ func (m *GetCurrentUserResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetCurrentUserResponseValidationError{
+			return UpdateUserPreferencesResponseValidationError{
 				field:  "Preferences",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -10397,19 +16117,19 @@ func (m *GetCurrentUserResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetCurrentUserResponseMultiError(errors)
+		return UpdateUserPreferencesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCurrentUserResponseMultiError is an error wrapping multiple validation
-// errors returned by GetCurrentUserResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetCurrentUserResponseMultiError []error
+// UpdateUserPreferencesResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateUserPreferencesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateUserPreferencesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCurrentUserResponseMultiError) Error() string {
+func (m UpdateUserPreferencesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10418,11 +16138,12 @@ func (m GetCurrentUserResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCurrentUserResponseMultiError) AllErrors() []error { return m }
+func (m UpdateUserPreferencesResponseMultiError) AllErrors() []error { return m }
 
-// GetCurrentUserResponseValidationError is the validation error returned by
-// GetCurrentUserResponse.Validate if the designated constraints aren't met.
-type GetCurrentUserResponseValidationError struct {
+// UpdateUserPreferencesResponseValidationError is the validation error
+// returned by UpdateUserPreferencesResponse.Validate if the designated
+// constraints aren't met.
+type UpdateUserPreferencesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10430,24 +16151,24 @@ type GetCurrentUserResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCurrentUserResponseValidationError) Field() string { return e.field }
+func (e UpdateUserPreferencesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCurrentUserResponseValidationError) Reason() string { return e.reason }
+func (e UpdateUserPreferencesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCurrentUserResponseValidationError) Cause() error { return e.cause }
+func (e UpdateUserPreferencesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCurrentUserResponseValidationError) Key() bool { return e.key }
+func (e UpdateUserPreferencesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCurrentUserResponseValidationError) ErrorName() string {
-	return "GetCurrentUserResponseValidationError"
+func (e UpdateUserPreferencesResponseValidationError) ErrorName() string {
+	return "UpdateUserPreferencesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCurrentUserResponseValidationError) Error() string {
+func (e UpdateUserPreferencesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10459,14 +16180,14 @@ func (e GetCurrentUserResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCurrentUserResponse.%s: %s%s",
+		"invalid %sUpdateUserPreferencesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCurrentUserResponseValidationError{}
+var _ error = UpdateUserPreferencesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10474,106 +16195,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCurrentUserResponseValidationError{}
+} = UpdateUserPreferencesResponseValidationError{}
 
-// Validate checks the field values on GetUserRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetUserRequest) Validate() error {
+// Validate checks the field values on ListBookmarksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBookmarksRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetUserRequestMultiError,
-// or nil if none found.
-func (m *GetUserRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListBookmarksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBookmarksRequestMultiError, or nil if none found.
+func (m *ListBookmarksRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = GetUserRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(errors) > 0 {
-		return GetUserRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *GetUserRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
-
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
-
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
-			}
-		}
-	}
-
-	return nil
-}
-
-func (m *GetUserRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
-
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
+func (m *ListBookmarksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	parts := strings.SplitN(addr, "@", 2)
+	var errors []error
 
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
+	// no validation rules for ProjectId
+
+	if len(errors) > 0 {
+		return ListBookmarksRequestMultiError(errors)
 	}
 
-	return m._validateHostname(parts[1])
+	return nil
 }
 
-// GetUserRequestMultiError is an error wrapping multiple validation errors
-// returned by GetUserRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetUserRequestMultiError []error
+// ListBookmarksRequestMultiError is an error wrapping multiple validation
+// errors returned by ListBookmarksRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListBookmarksRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserRequestMultiError) Error() string {
+func (m ListBookmarksRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10582,11 +16243,11 @@ func (m GetUserRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserRequestMultiError) AllErrors() []error { return m }
+func (m ListBookmarksRequestMultiError) AllErrors() []error { return m }
 
-// GetUserRequestValidationError is the validation error returned by
-// GetUserRequest.Validate if the designated constraints aren't met.
-type GetUserRequestValidationError struct {
+// ListBookmarksRequestValidationError is the validation error returned by
+// ListBookmarksRequest.Validate if the designated constraints aren't met.
+type ListBookmarksRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10594,22 +16255,24 @@ type GetUserRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserRequestValidationError) Field() string { return e.field }
+func (e ListBookmarksRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserRequestValidationError) Reason() string { return e.reason }
+func (e ListBookmarksRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserRequestValidationError) Cause() error { return e.cause }
+func (e ListBookmarksRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserRequestValidationError) Key() bool { return e.key }
+func (e ListBookmarksRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserRequestValidationError) ErrorName() string { return "GetUserRequestValidationError" }
+func (e ListBookmarksRequestValidationError) ErrorName() string {
+	return "ListBookmarksRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetUserRequestValidationError) Error() string {
+func (e ListBookmarksRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10621,14 +16284,14 @@ func (e GetUserRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserRequest.%s: %s%s",
+		"invalid %sListBookmarksRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserRequestValidationError{}
+var _ error = ListBookmarksRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10636,73 +16299,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserRequestValidationError{}
+} = ListBookmarksRequestValidationError{}
 
-// Validate checks the field values on GetUserResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetUserResponse) Validate() error {
+// Validate checks the field values on ListBookmarksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBookmarksResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserResponse with the rules
+// ValidateAll checks the field values on ListBookmarksResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetUserResponseMultiError, or nil if none found.
-func (m *GetUserResponse) ValidateAll() error {
+// ListBookmarksResponseMultiError, or nil if none found.
+func (m *ListBookmarksResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserResponse) validate(all bool) error {
+func (m *ListBookmarksResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetUser()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetUserResponseValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetBookmarks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBookmarksResponseValidationError{
+						field:  fmt.Sprintf("Bookmarks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBookmarksResponseValidationError{
+						field:  fmt.Sprintf("Bookmarks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetUserResponseValidationError{
-					field:  "User",
+				return ListBookmarksResponseValidationError{
+					field:  fmt.Sprintf("Bookmarks[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetUserResponseValidationError{
-				field:  "User",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return GetUserResponseMultiError(errors)
+		return ListBookmarksResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUserResponseMultiError is an error wrapping multiple validation errors
-// returned by GetUserResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetUserResponseMultiError []error
+// ListBookmarksResponseMultiError is an error wrapping multiple validation
+// errors returned by ListBookmarksResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListBookmarksResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserResponseMultiError) Error() string {
+func (m ListBookmarksResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10711,11 +16379,11 @@ func (m GetUserResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserResponseMultiError) AllErrors() []error { return m }
+func (m ListBookmarksResponseMultiError) AllErrors() []error { return m }
 
-// GetUserResponseValidationError is the validation error returned by
-// GetUserResponse.Validate if the designated constraints aren't met.
-type GetUserResponseValidationError struct {
+// ListBookmarksResponseValidationError is the validation error returned by
+// ListBookmarksResponse.Validate if the designated constraints aren't met.
+type ListBookmarksResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10723,22 +16391,24 @@ type GetUserResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserResponseValidationError) Field() string { return e.field }
+func (e ListBookmarksResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserResponseValidationError) Reason() string { return e.reason }
+func (e ListBookmarksResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserResponseValidationError) Cause() error { return e.cause }
+func (e ListBookmarksResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserResponseValidationError) Key() bool { return e.key }
+func (e ListBookmarksResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserResponseValidationError) ErrorName() string { return "GetUserResponseValidationError" }
+func (e ListBookmarksResponseValidationError) ErrorName() string {
+	return "ListBookmarksResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetUserResponseValidationError) Error() string {
+func (e ListBookmarksResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10750,14 +16420,14 @@ func (e GetUserResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserResponse.%s: %s%s",
+		"invalid %sListBookmarksResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserResponseValidationError{}
+var _ error = ListBookmarksResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10765,48 +16435,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserResponseValidationError{}
+} = ListBookmarksResponseValidationError{}
 
-// Validate checks the field values on UserPreferences with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *UserPreferences) Validate() error {
+// Validate checks the field values on GetBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBookmarkRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserPreferences with the rules
+// ValidateAll checks the field values on GetBookmarkRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UserPreferencesMultiError, or nil if none found.
-func (m *UserPreferences) ValidateAll() error {
+// GetBookmarkRequestMultiError, or nil if none found.
+func (m *GetBookmarkRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserPreferences) validate(all bool) error {
+func (m *GetBookmarkRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.TimeZone != nil {
-		// no validation rules for TimeZone
-	}
+	// no validation rules for BookmarkId
 
 	if len(errors) > 0 {
-		return UserPreferencesMultiError(errors)
+		return GetBookmarkRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserPreferencesMultiError is an error wrapping multiple validation errors
-// returned by UserPreferences.ValidateAll() if the designated constraints
+// GetBookmarkRequestMultiError is an error wrapping multiple validation errors
+// returned by GetBookmarkRequest.ValidateAll() if the designated constraints
 // aren't met.
-type UserPreferencesMultiError []error
+type GetBookmarkRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserPreferencesMultiError) Error() string {
+func (m GetBookmarkRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10815,11 +16483,11 @@ func (m UserPreferencesMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserPreferencesMultiError) AllErrors() []error { return m }
+func (m GetBookmarkRequestMultiError) AllErrors() []error { return m }
 
-// UserPreferencesValidationError is the validation error returned by
-// UserPreferences.Validate if the designated constraints aren't met.
-type UserPreferencesValidationError struct {
+// GetBookmarkRequestValidationError is the validation error returned by
+// GetBookmarkRequest.Validate if the designated constraints aren't met.
+type GetBookmarkRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10827,22 +16495,24 @@ type UserPreferencesValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserPreferencesValidationError) Field() string { return e.field }
+func (e GetBookmarkRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserPreferencesValidationError) Reason() string { return e.reason }
+func (e GetBookmarkRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserPreferencesValidationError) Cause() error { return e.cause }
+func (e GetBookmarkRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserPreferencesValidationError) Key() bool { return e.key }
+func (e GetBookmarkRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserPreferencesValidationError) ErrorName() string { return "UserPreferencesValidationError" }
+func (e GetBookmarkRequestValidationError) ErrorName() string {
+	return "GetBookmarkRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e UserPreferencesValidationError) Error() string {
+func (e GetBookmarkRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10854,14 +16524,14 @@ func (e UserPreferencesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserPreferences.%s: %s%s",
+		"invalid %sGetBookmarkRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserPreferencesValidationError{}
+var _ error = GetBookmarkRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10869,24 +16539,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserPreferencesValidationError{}
+} = GetBookmarkRequestValidationError{}
 
-// Validate checks the field values on UpdateUserPreferencesRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateUserPreferencesRequest) Validate() error {
+func (m *GetBookmarkResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateUserPreferencesRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateUserPreferencesRequestMultiError, or nil if none found.
-func (m *UpdateUserPreferencesRequest) ValidateAll() error {
+// GetBookmarkResponseMultiError, or nil if none found.
+func (m *GetBookmarkResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateUserPreferencesRequest) validate(all bool) error {
+func (m *GetBookmarkResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -10894,28 +16564,28 @@ func (m *UpdateUserPreferencesRequest) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetPreferences()).(type) {
+		switch v := interface{}(m.GetBookmark()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateUserPreferencesRequestValidationError{
-					field:  "Preferences",
+				errors = append(errors, GetBookmarkResponseValidationError{
+					field:  "Bookmark",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateUserPreferencesRequestValidationError{
-					field:  "Preferences",
+				errors = append(errors, GetBookmarkResponseValidationError{
+					field:  "Bookmark",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetBookmark()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateUserPreferencesRequestValidationError{
-				field:  "Preferences",
+			return GetBookmarkResponseValidationError{
+				field:  "Bookmark",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -10923,19 +16593,19 @@ func (m *UpdateUserPreferencesRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateUserPreferencesRequestMultiError(errors)
+		return GetBookmarkResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateUserPreferencesRequestMultiError is an error wrapping multiple
-// validation errors returned by UpdateUserPreferencesRequest.ValidateAll() if
-// the designated constraints aren't met.
-type UpdateUserPreferencesRequestMultiError []error
+// GetBookmarkResponseMultiError is an error wrapping multiple validation
+// errors returned by GetBookmarkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetBookmarkResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateUserPreferencesRequestMultiError) Error() string {
+func (m GetBookmarkResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10944,12 +16614,11 @@ func (m UpdateUserPreferencesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateUserPreferencesRequestMultiError) AllErrors() []error { return m }
+func (m GetBookmarkResponseMultiError) AllErrors() []error { return m }
 
-// UpdateUserPreferencesRequestValidationError is the validation error returned
-// by UpdateUserPreferencesRequest.Validate if the designated constraints
-// aren't met.
-type UpdateUserPreferencesRequestValidationError struct {
+// GetBookmarkResponseValidationError is the validation error returned by
+// GetBookmarkResponse.Validate if the designated constraints aren't met.
+type GetBookmarkResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10957,24 +16626,24 @@ type UpdateUserPreferencesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateUserPreferencesRequestValidationError) Field() string { return e.field }
+func (e GetBookmarkResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateUserPreferencesRequestValidationError) Reason() string { return e.reason }
+func (e GetBookmarkResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateUserPreferencesRequestValidationError) Cause() error { return e.cause }
+func (e GetBookmarkResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateUserPreferencesRequestValidationError) Key() bool { return e.key }
+func (e GetBookmarkResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateUserPreferencesRequestValidationError) ErrorName() string {
-	return "UpdateUserPreferencesRequestValidationError"
+func (e GetBookmarkResponseValidationError) ErrorName() string {
+	return "GetBookmarkResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateUserPreferencesRequestValidationError) Error() string {
+func (e GetBookmarkResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10986,14 +16655,14 @@ func (e UpdateUserPreferencesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateUserPreferencesRequest.%s: %s%s",
+		"invalid %sGetBookmarkResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateUserPreferencesRequestValidationError{}
+var _ error = GetBookmarkResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11001,73 +16670,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateUserPreferencesRequestValidationError{}
+} = GetBookmarkResponseValidationError{}
 
-// Validate checks the field values on UpdateUserPreferencesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateUserPreferencesResponse) Validate() error {
+func (m *CreateBookmarkRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateUserPreferencesResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// UpdateUserPreferencesResponseMultiError, or nil if none found.
-func (m *UpdateUserPreferencesResponse) ValidateAll() error {
+// ValidateAll checks the field values on CreateBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBookmarkRequestMultiError, or nil if none found.
+func (m *CreateBookmarkRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateUserPreferencesResponse) validate(all bool) error {
+func (m *CreateBookmarkRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetPreferences()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateUserPreferencesResponseValidationError{
-					field:  "Preferences",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateUserPreferencesResponseValidationError{
-					field:  "Preferences",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateUserPreferencesResponseValidationError{
-				field:  "Preferences",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for DisplayName
+
+	// no validation rules for Data
+
+	// no validation rules for DashboardName
+
+	// no validation rules for ProjectId
 
 	if len(errors) > 0 {
-		return UpdateUserPreferencesResponseMultiError(errors)
+		return CreateBookmarkRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateUserPreferencesResponseMultiError is an error wrapping multiple
-// validation errors returned by UpdateUserPreferencesResponse.ValidateAll()
-// if the designated constraints aren't met.
-type UpdateUserPreferencesResponseMultiError []error
+// CreateBookmarkRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateBookmarkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateBookmarkRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateUserPreferencesResponseMultiError) Error() string {
+func (m CreateBookmarkRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11076,12 +16724,11 @@ func (m UpdateUserPreferencesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateUserPreferencesResponseMultiError) AllErrors() []error { return m }
+func (m CreateBookmarkRequestMultiError) AllErrors() []error { return m }
 
-// UpdateUserPreferencesResponseValidationError is the validation error
-// returned by UpdateUserPreferencesResponse.Validate if the designated
-// constraints aren't met.
-type UpdateUserPreferencesResponseValidationError struct {
+// CreateBookmarkRequestValidationError is the validation error returned by
+// CreateBookmarkRequest.Validate if the designated constraints aren't met.
+type CreateBookmarkRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11089,24 +16736,24 @@ type UpdateUserPreferencesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateUserPreferencesResponseValidationError) Field() string { return e.field }
+func (e CreateBookmarkRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateUserPreferencesResponseValidationError) Reason() string { return e.reason }
+func (e CreateBookmarkRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateUserPreferencesResponseValidationError) Cause() error { return e.cause }
+func (e CreateBookmarkRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateUserPreferencesResponseValidationError) Key() bool { return e.key }
+func (e CreateBookmarkRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateUserPreferencesResponseValidationError) ErrorName() string {
-	return "UpdateUserPreferencesResponseValidationError"
+func (e CreateBookmarkRequestValidationError) ErrorName() string {
+	return "CreateBookmarkRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateUserPreferencesResponseValidationError) Error() string {
+func (e CreateBookmarkRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11118,14 +16765,14 @@ func (e UpdateUserPreferencesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateUserPreferencesResponse.%s: %s%s",
+		"invalid %sCreateBookmarkRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateUserPreferencesResponseValidationError{}
+var _ error = CreateBookmarkRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11133,46 +16780,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateUserPreferencesResponseValidationError{}
+} = CreateBookmarkRequestValidationError{}
 
-// Validate checks the field values on ListBookmarksRequest with the rules
+// Validate checks the field values on CreateBookmarkResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListBookmarksRequest) Validate() error {
+func (m *CreateBookmarkResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListBookmarksRequest with the rules
+// ValidateAll checks the field values on CreateBookmarkResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListBookmarksRequestMultiError, or nil if none found.
-func (m *ListBookmarksRequest) ValidateAll() error {
+// CreateBookmarkResponseMultiError, or nil if none found.
+func (m *CreateBookmarkResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListBookmarksRequest) validate(all bool) error {
+func (m *CreateBookmarkResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProjectId
+	if all {
+		switch v := interface{}(m.GetBookmark()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateBookmarkResponseValidationError{
+					field:  "Bookmark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateBookmarkResponseValidationError{
+					field:  "Bookmark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBookmark()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateBookmarkResponseValidationError{
+				field:  "Bookmark",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return ListBookmarksRequestMultiError(errors)
+		return CreateBookmarkResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListBookmarksRequestMultiError is an error wrapping multiple validation
-// errors returned by ListBookmarksRequest.ValidateAll() if the designated
+// CreateBookmarkResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateBookmarkResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListBookmarksRequestMultiError []error
+type CreateBookmarkResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListBookmarksRequestMultiError) Error() string {
+func (m CreateBookmarkResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11181,11 +16855,11 @@ func (m ListBookmarksRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListBookmarksRequestMultiError) AllErrors() []error { return m }
+func (m CreateBookmarkResponseMultiError) AllErrors() []error { return m }
 
-// ListBookmarksRequestValidationError is the validation error returned by
-// ListBookmarksRequest.Validate if the designated constraints aren't met.
-type ListBookmarksRequestValidationError struct {
+// CreateBookmarkResponseValidationError is the validation error returned by
+// CreateBookmarkResponse.Validate if the designated constraints aren't met.
+type CreateBookmarkResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11193,24 +16867,24 @@ type ListBookmarksRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListBookmarksRequestValidationError) Field() string { return e.field }
+func (e CreateBookmarkResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListBookmarksRequestValidationError) Reason() string { return e.reason }
+func (e CreateBookmarkResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListBookmarksRequestValidationError) Cause() error { return e.cause }
+func (e CreateBookmarkResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListBookmarksRequestValidationError) Key() bool { return e.key }
+func (e CreateBookmarkResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListBookmarksRequestValidationError) ErrorName() string {
-	return "ListBookmarksRequestValidationError"
+func (e CreateBookmarkResponseValidationError) ErrorName() string {
+	return "CreateBookmarkResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListBookmarksRequestValidationError) Error() string {
+func (e CreateBookmarkResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11222,14 +16896,14 @@ func (e ListBookmarksRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListBookmarksRequest.%s: %s%s",
+		"invalid %sCreateBookmarkResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListBookmarksRequestValidationError{}
+var _ error = CreateBookmarkResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11237,78 +16911,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListBookmarksRequestValidationError{}
+} = CreateBookmarkResponseValidationError{}
 
-// Validate checks the field values on ListBookmarksResponse with the rules
+// Validate checks the field values on RemoveBookmarkRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListBookmarksResponse) Validate() error {
+func (m *RemoveBookmarkRequest) Validate() error {
 	return m.validate(false)
-}
-
-// ValidateAll checks the field values on ListBookmarksResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListBookmarksResponseMultiError, or nil if none found.
-func (m *ListBookmarksResponse) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *ListBookmarksResponse) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	for idx, item := range m.GetBookmarks() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListBookmarksResponseValidationError{
-						field:  fmt.Sprintf("Bookmarks[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListBookmarksResponseValidationError{
-						field:  fmt.Sprintf("Bookmarks[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListBookmarksResponseValidationError{
-					field:  fmt.Sprintf("Bookmarks[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+}
+
+// ValidateAll checks the field values on RemoveBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveBookmarkRequestMultiError, or nil if none found.
+func (m *RemoveBookmarkRequest) ValidateAll() error {
+	return m.validate(true)
+}
 
+func (m *RemoveBookmarkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for BookmarkId
+
 	if len(errors) > 0 {
-		return ListBookmarksResponseMultiError(errors)
+		return RemoveBookmarkRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListBookmarksResponseMultiError is an error wrapping multiple validation
-// errors returned by ListBookmarksResponse.ValidateAll() if the designated
+// RemoveBookmarkRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveBookmarkRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListBookmarksResponseMultiError []error
+type RemoveBookmarkRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListBookmarksResponseMultiError) Error() string {
+func (m RemoveBookmarkRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11317,11 +16959,11 @@ func (m ListBookmarksResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListBookmarksResponseMultiError) AllErrors() []error { return m }
+func (m RemoveBookmarkRequestMultiError) AllErrors() []error { return m }
 
-// ListBookmarksResponseValidationError is the validation error returned by
-// ListBookmarksResponse.Validate if the designated constraints aren't met.
-type ListBookmarksResponseValidationError struct {
+// RemoveBookmarkRequestValidationError is the validation error returned by
+// RemoveBookmarkRequest.Validate if the designated constraints aren't met.
+type RemoveBookmarkRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11329,24 +16971,24 @@ type ListBookmarksResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListBookmarksResponseValidationError) Field() string { return e.field }
+func (e RemoveBookmarkRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListBookmarksResponseValidationError) Reason() string { return e.reason }
+func (e RemoveBookmarkRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListBookmarksResponseValidationError) Cause() error { return e.cause }
+func (e RemoveBookmarkRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListBookmarksResponseValidationError) Key() bool { return e.key }
+func (e RemoveBookmarkRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListBookmarksResponseValidationError) ErrorName() string {
-	return "ListBookmarksResponseValidationError"
+func (e RemoveBookmarkRequestValidationError) ErrorName() string {
+	return "RemoveBookmarkRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListBookmarksResponseValidationError) Error() string {
+func (e RemoveBookmarkRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11358,14 +17000,14 @@ func (e ListBookmarksResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListBookmarksResponse.%s: %s%s",
+		"invalid %sRemoveBookmarkRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListBookmarksResponseValidationError{}
+var _ error = RemoveBookmarkRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11373,46 +17015,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListBookmarksResponseValidationError{}
+} = RemoveBookmarkRequestValidationError{}
 
-// Validate checks the field values on GetBookmarkRequest with the rules
+// Validate checks the field values on RemoveBookmarkResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetBookmarkRequest) Validate() error {
+func (m *RemoveBookmarkResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetBookmarkRequest with the rules
+// ValidateAll checks the field values on RemoveBookmarkResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetBookmarkRequestMultiError, or nil if none found.
-func (m *GetBookmarkRequest) ValidateAll() error {
+// RemoveBookmarkResponseMultiError, or nil if none found.
+func (m *RemoveBookmarkResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetBookmarkRequest) validate(all bool) error {
+func (m *RemoveBookmarkResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BookmarkId
-
 	if len(errors) > 0 {
-		return GetBookmarkRequestMultiError(errors)
+		return RemoveBookmarkResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetBookmarkRequestMultiError is an error wrapping multiple validation errors
-// returned by GetBookmarkRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetBookmarkRequestMultiError []error
+// RemoveBookmarkResponseMultiError is an error wrapping multiple validation
+// errors returned by RemoveBookmarkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveBookmarkResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetBookmarkRequestMultiError) Error() string {
+func (m RemoveBookmarkResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11421,11 +17061,11 @@ func (m GetBookmarkRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetBookmarkRequestMultiError) AllErrors() []error { return m }
+func (m RemoveBookmarkResponseMultiError) AllErrors() []error { return m }
 
-// GetBookmarkRequestValidationError is the validation error returned by
-// GetBookmarkRequest.Validate if the designated constraints aren't met.
-type GetBookmarkRequestValidationError struct {
+// RemoveBookmarkResponseValidationError is the validation error returned by
+// RemoveBookmarkResponse.Validate if the designated constraints aren't met.
+type RemoveBookmarkResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11433,24 +17073,24 @@ type GetBookmarkRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetBookmarkRequestValidationError) Field() string { return e.field }
+func (e RemoveBookmarkResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetBookmarkRequestValidationError) Reason() string { return e.reason }
+func (e RemoveBookmarkResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetBookmarkRequestValidationError) Cause() error { return e.cause }
+func (e RemoveBookmarkResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetBookmarkRequestValidationError) Key() bool { return e.key }
+func (e RemoveBookmarkResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetBookmarkRequestValidationError) ErrorName() string {
-	return "GetBookmarkRequestValidationError"
+func (e RemoveBookmarkResponseValidationError) ErrorName() string {
+	return "RemoveBookmarkResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetBookmarkRequestValidationError) Error() string {
+func (e RemoveBookmarkResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11462,14 +17102,14 @@ func (e GetBookmarkRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetBookmarkRequest.%s: %s%s",
+		"invalid %sRemoveBookmarkResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetBookmarkRequestValidationError{}
+var _ error = RemoveBookmarkResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11477,73 +17117,72 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetBookmarkRequestValidationError{}
+} = RemoveBookmarkResponseValidationError{}
 
-// Validate checks the field values on GetBookmarkResponse with the rules
+// Validate checks the field values on SearchUsersRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetBookmarkResponse) Validate() error {
+func (m *SearchUsersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetBookmarkResponse with the rules
+// ValidateAll checks the field values on SearchUsersRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetBookmarkResponseMultiError, or nil if none found.
-func (m *GetBookmarkResponse) ValidateAll() error {
+// SearchUsersRequestMultiError, or nil if none found.
+func (m *SearchUsersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetBookmarkResponse) validate(all bool) error {
+func (m *SearchUsersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetBookmark()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetBookmarkResponseValidationError{
-					field:  "Bookmark",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetBookmarkResponseValidationError{
-					field:  "Bookmark",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetEmailPattern()) < 1 {
+		err := SearchUsersRequestValidationError{
+			field:  "EmailPattern",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetBookmark()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetBookmarkResponseValidationError{
-				field:  "Bookmark",
-				reason: "embedded message failed validation",
-				cause:  err,
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := SearchUsersRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
 			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
+
 	}
 
+	// no validation rules for PageToken
+
 	if len(errors) > 0 {
-		return GetBookmarkResponseMultiError(errors)
+		return SearchUsersRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetBookmarkResponseMultiError is an error wrapping multiple validation
-// errors returned by GetBookmarkResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetBookmarkResponseMultiError []error
+// SearchUsersRequestMultiError is an error wrapping multiple validation errors
+// returned by SearchUsersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SearchUsersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetBookmarkResponseMultiError) Error() string {
+func (m SearchUsersRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11552,11 +17191,11 @@ func (m GetBookmarkResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetBookmarkResponseMultiError) AllErrors() []error { return m }
+func (m SearchUsersRequestMultiError) AllErrors() []error { return m }
 
-// GetBookmarkResponseValidationError is the validation error returned by
-// GetBookmarkResponse.Validate if the designated constraints aren't met.
-type GetBookmarkResponseValidationError struct {
+// SearchUsersRequestValidationError is the validation error returned by
+// SearchUsersRequest.Validate if the designated constraints aren't met.
+type SearchUsersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11564,24 +17203,24 @@ type GetBookmarkResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetBookmarkResponseValidationError) Field() string { return e.field }
+func (e SearchUsersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetBookmarkResponseValidationError) Reason() string { return e.reason }
+func (e SearchUsersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetBookmarkResponseValidationError) Cause() error { return e.cause }
+func (e SearchUsersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetBookmarkResponseValidationError) Key() bool { return e.key }
+func (e SearchUsersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetBookmarkResponseValidationError) ErrorName() string {
-	return "GetBookmarkResponseValidationError"
+func (e SearchUsersRequestValidationError) ErrorName() string {
+	return "SearchUsersRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetBookmarkResponseValidationError) Error() string {
+func (e SearchUsersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11593,14 +17232,14 @@ func (e GetBookmarkResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetBookmarkResponse.%s: %s%s",
+		"invalid %sSearchUsersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetBookmarkResponseValidationError{}
+var _ error = SearchUsersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11608,52 +17247,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetBookmarkResponseValidationError{}
+} = SearchUsersRequestValidationError{}
 
-// Validate checks the field values on CreateBookmarkRequest with the rules
+// Validate checks the field values on SearchUsersResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateBookmarkRequest) Validate() error {
+func (m *SearchUsersResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateBookmarkRequest with the rules
+// ValidateAll checks the field values on SearchUsersResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateBookmarkRequestMultiError, or nil if none found.
-func (m *CreateBookmarkRequest) ValidateAll() error {
+// SearchUsersResponseMultiError, or nil if none found.
+func (m *SearchUsersResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateBookmarkRequest) validate(all bool) error {
+func (m *SearchUsersResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DisplayName
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
 
-	// no validation rules for Data
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchUsersResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for DashboardName
+	}
 
-	// no validation rules for ProjectId
+	// no validation rules for NextPageToken
 
 	if len(errors) > 0 {
-		return CreateBookmarkRequestMultiError(errors)
+		return SearchUsersResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateBookmarkRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateBookmarkRequest.ValidateAll() if the designated
+// SearchUsersResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchUsersResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CreateBookmarkRequestMultiError []error
+type SearchUsersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateBookmarkRequestMultiError) Error() string {
+func (m SearchUsersResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11662,11 +17329,11 @@ func (m CreateBookmarkRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateBookmarkRequestMultiError) AllErrors() []error { return m }
+func (m SearchUsersResponseMultiError) AllErrors() []error { return m }
 
-// CreateBookmarkRequestValidationError is the validation error returned by
-// CreateBookmarkRequest.Validate if the designated constraints aren't met.
-type CreateBookmarkRequestValidationError struct {
+// SearchUsersResponseValidationError is the validation error returned by
+// SearchUsersResponse.Validate if the designated constraints aren't met.
+type SearchUsersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11674,24 +17341,24 @@ type CreateBookmarkRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateBookmarkRequestValidationError) Field() string { return e.field }
+func (e SearchUsersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateBookmarkRequestValidationError) Reason() string { return e.reason }
+func (e SearchUsersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateBookmarkRequestValidationError) Cause() error { return e.cause }
+func (e SearchUsersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateBookmarkRequestValidationError) Key() bool { return e.key }
+func (e SearchUsersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateBookmarkRequestValidationError) ErrorName() string {
-	return "CreateBookmarkRequestValidationError"
+func (e SearchUsersResponseValidationError) ErrorName() string {
+	return "SearchUsersResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateBookmarkRequestValidationError) Error() string {
+func (e SearchUsersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11703,14 +17370,14 @@ func (e CreateBookmarkRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateBookmarkRequest.%s: %s%s",
+		"invalid %sSearchUsersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateBookmarkRequestValidationError{}
+var _ error = SearchUsersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11718,73 +17385,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateBookmarkRequestValidationError{}
+} = SearchUsersResponseValidationError{}
 
-// Validate checks the field values on CreateBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RevokeCurrentAuthTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateBookmarkResponse) Validate() error {
+func (m *RevokeCurrentAuthTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateBookmarkResponseMultiError, or nil if none found.
-func (m *CreateBookmarkResponse) ValidateAll() error {
+// ValidateAll checks the field values on RevokeCurrentAuthTokenRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeCurrentAuthTokenRequestMultiError, or nil if none found.
+func (m *RevokeCurrentAuthTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateBookmarkResponse) validate(all bool) error {
+func (m *RevokeCurrentAuthTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetBookmark()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateBookmarkResponseValidationError{
-					field:  "Bookmark",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateBookmarkResponseValidationError{
-					field:  "Bookmark",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetBookmark()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateBookmarkResponseValidationError{
-				field:  "Bookmark",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return CreateBookmarkResponseMultiError(errors)
+		return RevokeCurrentAuthTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateBookmarkResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateBookmarkResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateBookmarkResponseMultiError []error
+// RevokeCurrentAuthTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by RevokeCurrentAuthTokenRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeCurrentAuthTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateBookmarkResponseMultiError) Error() string {
+func (m RevokeCurrentAuthTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11793,11 +17431,12 @@ func (m CreateBookmarkResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateBookmarkResponseMultiError) AllErrors() []error { return m }
+func (m RevokeCurrentAuthTokenRequestMultiError) AllErrors() []error { return m }
 
-// CreateBookmarkResponseValidationError is the validation error returned by
-// CreateBookmarkResponse.Validate if the designated constraints aren't met.
-type CreateBookmarkResponseValidationError struct {
+// RevokeCurrentAuthTokenRequestValidationError is the validation error
+// returned by RevokeCurrentAuthTokenRequest.Validate if the designated
+// constraints aren't met.
+type RevokeCurrentAuthTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11805,24 +17444,24 @@ type CreateBookmarkResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateBookmarkResponseValidationError) Field() string { return e.field }
+func (e RevokeCurrentAuthTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateBookmarkResponseValidationError) Reason() string { return e.reason }
+func (e RevokeCurrentAuthTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateBookmarkResponseValidationError) Cause() error { return e.cause }
+func (e RevokeCurrentAuthTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateBookmarkResponseValidationError) Key() bool { return e.key }
+func (e RevokeCurrentAuthTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateBookmarkResponseValidationError) ErrorName() string {
-	return "CreateBookmarkResponseValidationError"
+func (e RevokeCurrentAuthTokenRequestValidationError) ErrorName() string {
+	return "RevokeCurrentAuthTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateBookmarkResponseValidationError) Error() string {
+func (e RevokeCurrentAuthTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11834,14 +17473,14 @@ func (e CreateBookmarkResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateBookmarkResponse.%s: %s%s",
+		"invalid %sRevokeCurrentAuthTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateBookmarkResponseValidationError{}
+var _ error = RevokeCurrentAuthTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11849,46 +17488,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateBookmarkResponseValidationError{}
+} = RevokeCurrentAuthTokenRequestValidationError{}
 
-// Validate checks the field values on RemoveBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RevokeCurrentAuthTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveBookmarkRequest) Validate() error {
+func (m *RevokeCurrentAuthTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RemoveBookmarkRequestMultiError, or nil if none found.
-func (m *RemoveBookmarkRequest) ValidateAll() error {
+// ValidateAll checks the field values on RevokeCurrentAuthTokenResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeCurrentAuthTokenResponseMultiError, or nil if none found.
+func (m *RevokeCurrentAuthTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveBookmarkRequest) validate(all bool) error {
+func (m *RevokeCurrentAuthTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BookmarkId
+	// no validation rules for TokenId
 
 	if len(errors) > 0 {
-		return RemoveBookmarkRequestMultiError(errors)
+		return RevokeCurrentAuthTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveBookmarkRequestMultiError is an error wrapping multiple validation
-// errors returned by RemoveBookmarkRequest.ValidateAll() if the designated
-// constraints aren't met.
-type RemoveBookmarkRequestMultiError []error
+// RevokeCurrentAuthTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by RevokeCurrentAuthTokenResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeCurrentAuthTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveBookmarkRequestMultiError) Error() string {
+func (m RevokeCurrentAuthTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11897,11 +17536,12 @@ func (m RemoveBookmarkRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveBookmarkRequestMultiError) AllErrors() []error { return m }
+func (m RevokeCurrentAuthTokenResponseMultiError) AllErrors() []error { return m }
 
-// RemoveBookmarkRequestValidationError is the validation error returned by
-// RemoveBookmarkRequest.Validate if the designated constraints aren't met.
-type RemoveBookmarkRequestValidationError struct {
+// RevokeCurrentAuthTokenResponseValidationError is the validation error
+// returned by RevokeCurrentAuthTokenResponse.Validate if the designated
+// constraints aren't met.
+type RevokeCurrentAuthTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11909,24 +17549,24 @@ type RemoveBookmarkRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveBookmarkRequestValidationError) Field() string { return e.field }
+func (e RevokeCurrentAuthTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveBookmarkRequestValidationError) Reason() string { return e.reason }
+func (e RevokeCurrentAuthTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveBookmarkRequestValidationError) Cause() error { return e.cause }
+func (e RevokeCurrentAuthTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveBookmarkRequestValidationError) Key() bool { return e.key }
+func (e RevokeCurrentAuthTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveBookmarkRequestValidationError) ErrorName() string {
-	return "RemoveBookmarkRequestValidationError"
+func (e RevokeCurrentAuthTokenResponseValidationError) ErrorName() string {
+	return "RevokeCurrentAuthTokenResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveBookmarkRequestValidationError) Error() string {
+func (e RevokeCurrentAuthTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11938,59 +17578,125 @@ func (e RemoveBookmarkRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveBookmarkRequest.%s: %s%s",
+		"invalid %sRevokeCurrentAuthTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveBookmarkRequestValidationError{}
+var _ error = RevokeCurrentAuthTokenResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeCurrentAuthTokenResponseValidationError{}
+
+// Validate checks the field values on IssueRepresentativeAuthTokenRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *IssueRepresentativeAuthTokenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueRepresentativeAuthTokenRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// IssueRepresentativeAuthTokenRequestMultiError, or nil if none found.
+func (m *IssueRepresentativeAuthTokenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueRepresentativeAuthTokenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = IssueRepresentativeAuthTokenRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for TtlMinutes
+
+	if len(errors) > 0 {
+		return IssueRepresentativeAuthTokenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *IssueRepresentativeAuthTokenRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
 
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = RemoveBookmarkRequestValidationError{}
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
 
-// Validate checks the field values on RemoveBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveBookmarkResponse) Validate() error {
-	return m.validate(false)
+	return nil
 }
 
-// ValidateAll checks the field values on RemoveBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RemoveBookmarkResponseMultiError, or nil if none found.
-func (m *RemoveBookmarkResponse) ValidateAll() error {
-	return m.validate(true)
-}
+func (m *IssueRepresentativeAuthTokenRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
 
-func (m *RemoveBookmarkResponse) validate(all bool) error {
-	if m == nil {
-		return nil
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
 	}
 
-	var errors []error
+	parts := strings.SplitN(addr, "@", 2)
 
-	if len(errors) > 0 {
-		return RemoveBookmarkResponseMultiError(errors)
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
 	}
 
-	return nil
+	return m._validateHostname(parts[1])
 }
 
-// RemoveBookmarkResponseMultiError is an error wrapping multiple validation
-// errors returned by RemoveBookmarkResponse.ValidateAll() if the designated
+// IssueRepresentativeAuthTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// IssueRepresentativeAuthTokenRequest.ValidateAll() if the designated
 // constraints aren't met.
-type RemoveBookmarkResponseMultiError []error
+type IssueRepresentativeAuthTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveBookmarkResponseMultiError) Error() string {
+func (m IssueRepresentativeAuthTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11999,11 +17705,12 @@ func (m RemoveBookmarkResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveBookmarkResponseMultiError) AllErrors() []error { return m }
+func (m IssueRepresentativeAuthTokenRequestMultiError) AllErrors() []error { return m }
 
-// RemoveBookmarkResponseValidationError is the validation error returned by
-// RemoveBookmarkResponse.Validate if the designated constraints aren't met.
-type RemoveBookmarkResponseValidationError struct {
+// IssueRepresentativeAuthTokenRequestValidationError is the validation error
+// returned by IssueRepresentativeAuthTokenRequest.Validate if the designated
+// constraints aren't met.
+type IssueRepresentativeAuthTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12011,24 +17718,24 @@ type RemoveBookmarkResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveBookmarkResponseValidationError) Field() string { return e.field }
+func (e IssueRepresentativeAuthTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveBookmarkResponseValidationError) Reason() string { return e.reason }
+func (e IssueRepresentativeAuthTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveBookmarkResponseValidationError) Cause() error { return e.cause }
+func (e IssueRepresentativeAuthTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveBookmarkResponseValidationError) Key() bool { return e.key }
+func (e IssueRepresentativeAuthTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveBookmarkResponseValidationError) ErrorName() string {
-	return "RemoveBookmarkResponseValidationError"
+func (e IssueRepresentativeAuthTokenRequestValidationError) ErrorName() string {
+	return "IssueRepresentativeAuthTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveBookmarkResponseValidationError) Error() string {
+func (e IssueRepresentativeAuthTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12040,14 +17747,14 @@ func (e RemoveBookmarkResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveBookmarkResponse.%s: %s%s",
+		"invalid %sIssueRepresentativeAuthTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveBookmarkResponseValidationError{}
+var _ error = IssueRepresentativeAuthTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12055,72 +17762,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveBookmarkResponseValidationError{}
+} = IssueRepresentativeAuthTokenRequestValidationError{}
 
-// Validate checks the field values on SearchUsersRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SearchUsersRequest) Validate() error {
+// Validate checks the field values on IssueRepresentativeAuthTokenResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *IssueRepresentativeAuthTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SearchUsersRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SearchUsersRequestMultiError, or nil if none found.
-func (m *SearchUsersRequest) ValidateAll() error {
+// ValidateAll checks the field values on IssueRepresentativeAuthTokenResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// IssueRepresentativeAuthTokenResponseMultiError, or nil if none found.
+func (m *IssueRepresentativeAuthTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SearchUsersRequest) validate(all bool) error {
+func (m *IssueRepresentativeAuthTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetEmailPattern()) < 1 {
-		err := SearchUsersRequestValidationError{
-			field:  "EmailPattern",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetPageSize() != 0 {
-
-		if m.GetPageSize() > 1000 {
-			err := SearchUsersRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 1000",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	// no validation rules for PageToken
+	// no validation rules for Token
 
 	if len(errors) > 0 {
-		return SearchUsersRequestMultiError(errors)
+		return IssueRepresentativeAuthTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SearchUsersRequestMultiError is an error wrapping multiple validation errors
-// returned by SearchUsersRequest.ValidateAll() if the designated constraints
-// aren't met.
-type SearchUsersRequestMultiError []error
+// IssueRepresentativeAuthTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// IssueRepresentativeAuthTokenResponse.ValidateAll() if the designated
+// constraints aren't met.
+type IssueRepresentativeAuthTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SearchUsersRequestMultiError) Error() string {
+func (m IssueRepresentativeAuthTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12129,11 +17812,12 @@ func (m SearchUsersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SearchUsersRequestMultiError) AllErrors() []error { return m }
+func (m IssueRepresentativeAuthTokenResponseMultiError) AllErrors() []error { return m }
 
-// SearchUsersRequestValidationError is the validation error returned by
-// SearchUsersRequest.Validate if the designated constraints aren't met.
-type SearchUsersRequestValidationError struct {
+// IssueRepresentativeAuthTokenResponseValidationError is the validation error
+// returned by IssueRepresentativeAuthTokenResponse.Validate if the designated
+// constraints aren't met.
+type IssueRepresentativeAuthTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12141,24 +17825,24 @@ type SearchUsersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SearchUsersRequestValidationError) Field() string { return e.field }
+func (e IssueRepresentativeAuthTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SearchUsersRequestValidationError) Reason() string { return e.reason }
+func (e IssueRepresentativeAuthTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SearchUsersRequestValidationError) Cause() error { return e.cause }
+func (e IssueRepresentativeAuthTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SearchUsersRequestValidationError) Key() bool { return e.key }
+func (e IssueRepresentativeAuthTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SearchUsersRequestValidationError) ErrorName() string {
-	return "SearchUsersRequestValidationError"
+func (e IssueRepresentativeAuthTokenResponseValidationError) ErrorName() string {
+	return "IssueRepresentativeAuthTokenResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SearchUsersRequestValidationError) Error() string {
+func (e IssueRepresentativeAuthTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12170,14 +17854,14 @@ func (e SearchUsersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSearchUsersRequest.%s: %s%s",
+		"invalid %sIssueRepresentativeAuthTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SearchUsersRequestValidationError{}
+var _ error = IssueRepresentativeAuthTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12185,80 +17869,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SearchUsersRequestValidationError{}
+} = IssueRepresentativeAuthTokenResponseValidationError{}
 
-// Validate checks the field values on SearchUsersResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RevokeServiceAuthTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SearchUsersResponse) Validate() error {
+func (m *RevokeServiceAuthTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SearchUsersResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SearchUsersResponseMultiError, or nil if none found.
-func (m *SearchUsersResponse) ValidateAll() error {
+// ValidateAll checks the field values on RevokeServiceAuthTokenRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeServiceAuthTokenRequestMultiError, or nil if none found.
+func (m *RevokeServiceAuthTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SearchUsersResponse) validate(all bool) error {
+func (m *RevokeServiceAuthTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SearchUsersResponseValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SearchUsersResponseValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SearchUsersResponseValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for NextPageToken
+	// no validation rules for TokenId
 
 	if len(errors) > 0 {
-		return SearchUsersResponseMultiError(errors)
+		return RevokeServiceAuthTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SearchUsersResponseMultiError is an error wrapping multiple validation
-// errors returned by SearchUsersResponse.ValidateAll() if the designated
-// constraints aren't met.
-type SearchUsersResponseMultiError []error
+// RevokeServiceAuthTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by RevokeServiceAuthTokenRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeServiceAuthTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SearchUsersResponseMultiError) Error() string {
+func (m RevokeServiceAuthTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12267,11 +17917,12 @@ func (m SearchUsersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SearchUsersResponseMultiError) AllErrors() []error { return m }
+func (m RevokeServiceAuthTokenRequestMultiError) AllErrors() []error { return m }
 
-// SearchUsersResponseValidationError is the validation error returned by
-// SearchUsersResponse.Validate if the designated constraints aren't met.
-type SearchUsersResponseValidationError struct {
+// RevokeServiceAuthTokenRequestValidationError is the validation error
+// returned by RevokeServiceAuthTokenRequest.Validate if the designated
+// constraints aren't met.
+type RevokeServiceAuthTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12279,24 +17930,24 @@ type SearchUsersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SearchUsersResponseValidationError) Field() string { return e.field }
+func (e RevokeServiceAuthTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SearchUsersResponseValidationError) Reason() string { return e.reason }
+func (e RevokeServiceAuthTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SearchUsersResponseValidationError) Cause() error { return e.cause }
+func (e RevokeServiceAuthTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SearchUsersResponseValidationError) Key() bool { return e.key }
+func (e RevokeServiceAuthTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SearchUsersResponseValidationError) ErrorName() string {
-	return "SearchUsersResponseValidationError"
+func (e RevokeServiceAuthTokenRequestValidationError) ErrorName() string {
+	return "RevokeServiceAuthTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SearchUsersResponseValidationError) Error() string {
+func (e RevokeServiceAuthTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12308,14 +17959,14 @@ func (e SearchUsersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSearchUsersResponse.%s: %s%s",
+		"invalid %sRevokeServiceAuthTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SearchUsersResponseValidationError{}
+var _ error = RevokeServiceAuthTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12323,24 +17974,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SearchUsersResponseValidationError{}
+} = RevokeServiceAuthTokenRequestValidationError{}
 
-// Validate checks the field values on RevokeCurrentAuthTokenRequest with the
+// Validate checks the field values on RevokeServiceAuthTokenResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeCurrentAuthTokenRequest) Validate() error {
+func (m *RevokeServiceAuthTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeCurrentAuthTokenRequest with
+// ValidateAll checks the field values on RevokeServiceAuthTokenResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// RevokeCurrentAuthTokenRequestMultiError, or nil if none found.
-func (m *RevokeCurrentAuthTokenRequest) ValidateAll() error {
+// RevokeServiceAuthTokenResponseMultiError, or nil if none found.
+func (m *RevokeServiceAuthTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeCurrentAuthTokenRequest) validate(all bool) error {
+func (m *RevokeServiceAuthTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -12348,19 +17999,19 @@ func (m *RevokeCurrentAuthTokenRequest) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return RevokeCurrentAuthTokenRequestMultiError(errors)
+		return RevokeServiceAuthTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeCurrentAuthTokenRequestMultiError is an error wrapping multiple
-// validation errors returned by RevokeCurrentAuthTokenRequest.ValidateAll()
+// RevokeServiceAuthTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by RevokeServiceAuthTokenResponse.ValidateAll()
 // if the designated constraints aren't met.
-type RevokeCurrentAuthTokenRequestMultiError []error
+type RevokeServiceAuthTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeCurrentAuthTokenRequestMultiError) Error() string {
+func (m RevokeServiceAuthTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12369,12 +18020,12 @@ func (m RevokeCurrentAuthTokenRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeCurrentAuthTokenRequestMultiError) AllErrors() []error { return m }
+func (m RevokeServiceAuthTokenResponseMultiError) AllErrors() []error { return m }
 
-// RevokeCurrentAuthTokenRequestValidationError is the validation error
-// returned by RevokeCurrentAuthTokenRequest.Validate if the designated
+// RevokeServiceAuthTokenResponseValidationError is the validation error
+// returned by RevokeServiceAuthTokenResponse.Validate if the designated
 // constraints aren't met.
-type RevokeCurrentAuthTokenRequestValidationError struct {
+type RevokeServiceAuthTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12382,24 +18033,24 @@ type RevokeCurrentAuthTokenRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeCurrentAuthTokenRequestValidationError) Field() string { return e.field }
+func (e RevokeServiceAuthTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeCurrentAuthTokenRequestValidationError) Reason() string { return e.reason }
+func (e RevokeServiceAuthTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeCurrentAuthTokenRequestValidationError) Cause() error { return e.cause }
+func (e RevokeServiceAuthTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeCurrentAuthTokenRequestValidationError) Key() bool { return e.key }
+func (e RevokeServiceAuthTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeCurrentAuthTokenRequestValidationError) ErrorName() string {
-	return "RevokeCurrentAuthTokenRequestValidationError"
+func (e RevokeServiceAuthTokenResponseValidationError) ErrorName() string {
+	return "RevokeServiceAuthTokenResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeCurrentAuthTokenRequestValidationError) Error() string {
+func (e RevokeServiceAuthTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12411,14 +18062,14 @@ func (e RevokeCurrentAuthTokenRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeCurrentAuthTokenRequest.%s: %s%s",
+		"invalid %sRevokeServiceAuthTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeCurrentAuthTokenRequestValidationError{}
+var _ error = RevokeServiceAuthTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12426,46 +18077,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeCurrentAuthTokenRequestValidationError{}
+} = RevokeServiceAuthTokenResponseValidationError{}
 
-// Validate checks the field values on RevokeCurrentAuthTokenResponse with the
+// Validate checks the field values on IssueServiceAuthTokenRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeCurrentAuthTokenResponse) Validate() error {
+func (m *IssueServiceAuthTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeCurrentAuthTokenResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RevokeCurrentAuthTokenResponseMultiError, or nil if none found.
-func (m *RevokeCurrentAuthTokenResponse) ValidateAll() error {
+// ValidateAll checks the field values on IssueServiceAuthTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IssueServiceAuthTokenRequestMultiError, or nil if none found.
+func (m *IssueServiceAuthTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeCurrentAuthTokenResponse) validate(all bool) error {
+func (m *IssueServiceAuthTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TokenId
+	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
+		err := IssueServiceAuthTokenRequestValidationError{
+			field:  "OrganizationName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ServiceName
 
 	if len(errors) > 0 {
-		return RevokeCurrentAuthTokenResponseMultiError(errors)
+		return IssueServiceAuthTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeCurrentAuthTokenResponseMultiError is an error wrapping multiple
-// validation errors returned by RevokeCurrentAuthTokenResponse.ValidateAll()
-// if the designated constraints aren't met.
-type RevokeCurrentAuthTokenResponseMultiError []error
+// IssueServiceAuthTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by IssueServiceAuthTokenRequest.ValidateAll() if
+// the designated constraints aren't met.
+type IssueServiceAuthTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeCurrentAuthTokenResponseMultiError) Error() string {
+func (m IssueServiceAuthTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12474,12 +18136,12 @@ func (m RevokeCurrentAuthTokenResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeCurrentAuthTokenResponseMultiError) AllErrors() []error { return m }
+func (m IssueServiceAuthTokenRequestMultiError) AllErrors() []error { return m }
 
-// RevokeCurrentAuthTokenResponseValidationError is the validation error
-// returned by RevokeCurrentAuthTokenResponse.Validate if the designated
-// constraints aren't met.
-type RevokeCurrentAuthTokenResponseValidationError struct {
+// IssueServiceAuthTokenRequestValidationError is the validation error returned
+// by IssueServiceAuthTokenRequest.Validate if the designated constraints
+// aren't met.
+type IssueServiceAuthTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12487,24 +18149,24 @@ type RevokeCurrentAuthTokenResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeCurrentAuthTokenResponseValidationError) Field() string { return e.field }
+func (e IssueServiceAuthTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeCurrentAuthTokenResponseValidationError) Reason() string { return e.reason }
+func (e IssueServiceAuthTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeCurrentAuthTokenResponseValidationError) Cause() error { return e.cause }
+func (e IssueServiceAuthTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeCurrentAuthTokenResponseValidationError) Key() bool { return e.key }
+func (e IssueServiceAuthTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeCurrentAuthTokenResponseValidationError) ErrorName() string {
-	return "RevokeCurrentAuthTokenResponseValidationError"
+func (e IssueServiceAuthTokenRequestValidationError) ErrorName() string {
+	return "IssueServiceAuthTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeCurrentAuthTokenResponseValidationError) Error() string {
+func (e IssueServiceAuthTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12516,14 +18178,14 @@ func (e RevokeCurrentAuthTokenResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeCurrentAuthTokenResponse.%s: %s%s",
+		"invalid %sIssueServiceAuthTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeCurrentAuthTokenResponseValidationError{}
+var _ error = IssueServiceAuthTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12531,110 +18193,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeCurrentAuthTokenResponseValidationError{}
+} = IssueServiceAuthTokenRequestValidationError{}
 
-// Validate checks the field values on IssueRepresentativeAuthTokenRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *IssueRepresentativeAuthTokenRequest) Validate() error {
+// Validate checks the field values on IssueServiceAuthTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IssueServiceAuthTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueRepresentativeAuthTokenRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// IssueRepresentativeAuthTokenRequestMultiError, or nil if none found.
-func (m *IssueRepresentativeAuthTokenRequest) ValidateAll() error {
+// ValidateAll checks the field values on IssueServiceAuthTokenResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// IssueServiceAuthTokenResponseMultiError, or nil if none found.
+func (m *IssueServiceAuthTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueRepresentativeAuthTokenRequest) validate(all bool) error {
+func (m *IssueServiceAuthTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = IssueRepresentativeAuthTokenRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for TtlMinutes
+	// no validation rules for Token
 
 	if len(errors) > 0 {
-		return IssueRepresentativeAuthTokenRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *IssueRepresentativeAuthTokenRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
-
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
-
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
-			}
-		}
+		return IssueServiceAuthTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *IssueRepresentativeAuthTokenRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
-
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
-	}
-
-	parts := strings.SplitN(addr, "@", 2)
-
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
-	}
-
-	return m._validateHostname(parts[1])
-}
-
-// IssueRepresentativeAuthTokenRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// IssueRepresentativeAuthTokenRequest.ValidateAll() if the designated
-// constraints aren't met.
-type IssueRepresentativeAuthTokenRequestMultiError []error
+// IssueServiceAuthTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by IssueServiceAuthTokenResponse.ValidateAll()
+// if the designated constraints aren't met.
+type IssueServiceAuthTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueRepresentativeAuthTokenRequestMultiError) Error() string {
+func (m IssueServiceAuthTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12643,12 +18241,12 @@ func (m IssueRepresentativeAuthTokenRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueRepresentativeAuthTokenRequestMultiError) AllErrors() []error { return m }
+func (m IssueServiceAuthTokenResponseMultiError) AllErrors() []error { return m }
 
-// IssueRepresentativeAuthTokenRequestValidationError is the validation error
-// returned by IssueRepresentativeAuthTokenRequest.Validate if the designated
+// IssueServiceAuthTokenResponseValidationError is the validation error
+// returned by IssueServiceAuthTokenResponse.Validate if the designated
 // constraints aren't met.
-type IssueRepresentativeAuthTokenRequestValidationError struct {
+type IssueServiceAuthTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12656,24 +18254,24 @@ type IssueRepresentativeAuthTokenRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueRepresentativeAuthTokenRequestValidationError) Field() string { return e.field }
+func (e IssueServiceAuthTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueRepresentativeAuthTokenRequestValidationError) Reason() string { return e.reason }
+func (e IssueServiceAuthTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueRepresentativeAuthTokenRequestValidationError) Cause() error { return e.cause }
+func (e IssueServiceAuthTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueRepresentativeAuthTokenRequestValidationError) Key() bool { return e.key }
+func (e IssueServiceAuthTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueRepresentativeAuthTokenRequestValidationError) ErrorName() string {
-	return "IssueRepresentativeAuthTokenRequestValidationError"
+func (e IssueServiceAuthTokenResponseValidationError) ErrorName() string {
+	return "IssueServiceAuthTokenResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueRepresentativeAuthTokenRequestValidationError) Error() string {
+func (e IssueServiceAuthTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12685,14 +18283,14 @@ func (e IssueRepresentativeAuthTokenRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueRepresentativeAuthTokenRequest.%s: %s%s",
+		"invalid %sIssueServiceAuthTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueRepresentativeAuthTokenRequestValidationError{}
+var _ error = IssueServiceAuthTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12700,48 +18298,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueRepresentativeAuthTokenRequestValidationError{}
+} = IssueServiceAuthTokenResponseValidationError{}
 
-// Validate checks the field values on IssueRepresentativeAuthTokenResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *IssueRepresentativeAuthTokenResponse) Validate() error {
+// Validate checks the field values on ListServiceAuthTokensRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListServiceAuthTokensRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueRepresentativeAuthTokenResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// IssueRepresentativeAuthTokenResponseMultiError, or nil if none found.
-func (m *IssueRepresentativeAuthTokenResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListServiceAuthTokensRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListServiceAuthTokensRequestMultiError, or nil if none found.
+func (m *ListServiceAuthTokensRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueRepresentativeAuthTokenResponse) validate(all bool) error {
+func (m *ListServiceAuthTokensRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Token
+	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
+		err := ListServiceAuthTokensRequestValidationError{
+			field:  "OrganizationName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ServiceName
 
 	if len(errors) > 0 {
-		return IssueRepresentativeAuthTokenResponseMultiError(errors)
+		return ListServiceAuthTokensRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// IssueRepresentativeAuthTokenResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// IssueRepresentativeAuthTokenResponse.ValidateAll() if the designated
-// constraints aren't met.
-type IssueRepresentativeAuthTokenResponseMultiError []error
+// ListServiceAuthTokensRequestMultiError is an error wrapping multiple
+// validation errors returned by ListServiceAuthTokensRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListServiceAuthTokensRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueRepresentativeAuthTokenResponseMultiError) Error() string {
+func (m ListServiceAuthTokensRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12750,12 +18357,12 @@ func (m IssueRepresentativeAuthTokenResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueRepresentativeAuthTokenResponseMultiError) AllErrors() []error { return m }
+func (m ListServiceAuthTokensRequestMultiError) AllErrors() []error { return m }
 
-// IssueRepresentativeAuthTokenResponseValidationError is the validation error
-// returned by IssueRepresentativeAuthTokenResponse.Validate if the designated
-// constraints aren't met.
-type IssueRepresentativeAuthTokenResponseValidationError struct {
+// ListServiceAuthTokensRequestValidationError is the validation error returned
+// by ListServiceAuthTokensRequest.Validate if the designated constraints
+// aren't met.
+type ListServiceAuthTokensRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12763,24 +18370,24 @@ type IssueRepresentativeAuthTokenResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueRepresentativeAuthTokenResponseValidationError) Field() string { return e.field }
+func (e ListServiceAuthTokensRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueRepresentativeAuthTokenResponseValidationError) Reason() string { return e.reason }
+func (e ListServiceAuthTokensRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueRepresentativeAuthTokenResponseValidationError) Cause() error { return e.cause }
+func (e ListServiceAuthTokensRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueRepresentativeAuthTokenResponseValidationError) Key() bool { return e.key }
+func (e ListServiceAuthTokensRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueRepresentativeAuthTokenResponseValidationError) ErrorName() string {
-	return "IssueRepresentativeAuthTokenResponseValidationError"
+func (e ListServiceAuthTokensRequestValidationError) ErrorName() string {
+	return "ListServiceAuthTokensRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueRepresentativeAuthTokenResponseValidationError) Error() string {
+func (e ListServiceAuthTokensRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12792,14 +18399,14 @@ func (e IssueRepresentativeAuthTokenResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueRepresentativeAuthTokenResponse.%s: %s%s",
+		"invalid %sListServiceAuthTokensRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueRepresentativeAuthTokenResponseValidationError{}
+var _ error = ListServiceAuthTokensRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12807,46 +18414,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueRepresentativeAuthTokenResponseValidationError{}
+} = ListServiceAuthTokensRequestValidationError{}
 
-// Validate checks the field values on RevokeServiceAuthTokenRequest with the
+// Validate checks the field values on ListServiceAuthTokensResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeServiceAuthTokenRequest) Validate() error {
+func (m *ListServiceAuthTokensResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeServiceAuthTokenRequest with
+// ValidateAll checks the field values on ListServiceAuthTokensResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// RevokeServiceAuthTokenRequestMultiError, or nil if none found.
-func (m *RevokeServiceAuthTokenRequest) ValidateAll() error {
+// ListServiceAuthTokensResponseMultiError, or nil if none found.
+func (m *ListServiceAuthTokensResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeServiceAuthTokenRequest) validate(all bool) error {
+func (m *ListServiceAuthTokensResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TokenId
+	for idx, item := range m.GetTokens() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListServiceAuthTokensResponseValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListServiceAuthTokensResponseValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListServiceAuthTokensResponseValidationError{
+					field:  fmt.Sprintf("Tokens[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return RevokeServiceAuthTokenRequestMultiError(errors)
+		return ListServiceAuthTokensResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeServiceAuthTokenRequestMultiError is an error wrapping multiple
-// validation errors returned by RevokeServiceAuthTokenRequest.ValidateAll()
+// ListServiceAuthTokensResponseMultiError is an error wrapping multiple
+// validation errors returned by ListServiceAuthTokensResponse.ValidateAll()
 // if the designated constraints aren't met.
-type RevokeServiceAuthTokenRequestMultiError []error
+type ListServiceAuthTokensResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeServiceAuthTokenRequestMultiError) Error() string {
+func (m ListServiceAuthTokensResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12855,12 +18494,12 @@ func (m RevokeServiceAuthTokenRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeServiceAuthTokenRequestMultiError) AllErrors() []error { return m }
+func (m ListServiceAuthTokensResponseMultiError) AllErrors() []error { return m }
 
-// RevokeServiceAuthTokenRequestValidationError is the validation error
-// returned by RevokeServiceAuthTokenRequest.Validate if the designated
+// ListServiceAuthTokensResponseValidationError is the validation error
+// returned by ListServiceAuthTokensResponse.Validate if the designated
 // constraints aren't met.
-type RevokeServiceAuthTokenRequestValidationError struct {
+type ListServiceAuthTokensResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12868,24 +18507,24 @@ type RevokeServiceAuthTokenRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeServiceAuthTokenRequestValidationError) Field() string { return e.field }
+func (e ListServiceAuthTokensResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeServiceAuthTokenRequestValidationError) Reason() string { return e.reason }
+func (e ListServiceAuthTokensResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeServiceAuthTokenRequestValidationError) Cause() error { return e.cause }
+func (e ListServiceAuthTokensResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeServiceAuthTokenRequestValidationError) Key() bool { return e.key }
+func (e ListServiceAuthTokensResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeServiceAuthTokenRequestValidationError) ErrorName() string {
-	return "RevokeServiceAuthTokenRequestValidationError"
+func (e ListServiceAuthTokensResponseValidationError) ErrorName() string {
+	return "ListServiceAuthTokensResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeServiceAuthTokenRequestValidationError) Error() string {
+func (e ListServiceAuthTokensResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12897,14 +18536,14 @@ func (e RevokeServiceAuthTokenRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeServiceAuthTokenRequest.%s: %s%s",
+		"invalid %sListServiceAuthTokensResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeServiceAuthTokenRequestValidationError{}
+var _ error = ListServiceAuthTokensResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12912,44 +18551,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeServiceAuthTokenRequestValidationError{}
+} = ListServiceAuthTokensResponseValidationError{}
 
-// Validate checks the field values on RevokeServiceAuthTokenResponse with the
+// Validate checks the field values on GetGithubRepoStatusRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeServiceAuthTokenResponse) Validate() error {
+func (m *GetGithubRepoStatusRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeServiceAuthTokenResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RevokeServiceAuthTokenResponseMultiError, or nil if none found.
-func (m *RevokeServiceAuthTokenResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetGithubRepoStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetGithubRepoStatusRequestMultiError, or nil if none found.
+func (m *GetGithubRepoStatusRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeServiceAuthTokenResponse) validate(all bool) error {
+func (m *GetGithubRepoStatusRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for GithubUrl
+
 	if len(errors) > 0 {
-		return RevokeServiceAuthTokenResponseMultiError(errors)
+		return GetGithubRepoStatusRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeServiceAuthTokenResponseMultiError is an error wrapping multiple
-// validation errors returned by RevokeServiceAuthTokenResponse.ValidateAll()
-// if the designated constraints aren't met.
-type RevokeServiceAuthTokenResponseMultiError []error
+// GetGithubRepoStatusRequestMultiError is an error wrapping multiple
+// validation errors returned by GetGithubRepoStatusRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetGithubRepoStatusRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeServiceAuthTokenResponseMultiError) Error() string {
+func (m GetGithubRepoStatusRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12958,12 +18599,11 @@ func (m RevokeServiceAuthTokenResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeServiceAuthTokenResponseMultiError) AllErrors() []error { return m }
+func (m GetGithubRepoStatusRequestMultiError) AllErrors() []error { return m }
 
-// RevokeServiceAuthTokenResponseValidationError is the validation error
-// returned by RevokeServiceAuthTokenResponse.Validate if the designated
-// constraints aren't met.
-type RevokeServiceAuthTokenResponseValidationError struct {
+// GetGithubRepoStatusRequestValidationError is the validation error returned
+// by GetGithubRepoStatusRequest.Validate if the designated constraints aren't met.
+type GetGithubRepoStatusRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12971,24 +18611,24 @@ type RevokeServiceAuthTokenResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeServiceAuthTokenResponseValidationError) Field() string { return e.field }
+func (e GetGithubRepoStatusRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeServiceAuthTokenResponseValidationError) Reason() string { return e.reason }
+func (e GetGithubRepoStatusRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeServiceAuthTokenResponseValidationError) Cause() error { return e.cause }
+func (e GetGithubRepoStatusRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeServiceAuthTokenResponseValidationError) Key() bool { return e.key }
+func (e GetGithubRepoStatusRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeServiceAuthTokenResponseValidationError) ErrorName() string {
-	return "RevokeServiceAuthTokenResponseValidationError"
+func (e GetGithubRepoStatusRequestValidationError) ErrorName() string {
+	return "GetGithubRepoStatusRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeServiceAuthTokenResponseValidationError) Error() string {
+func (e GetGithubRepoStatusRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13000,14 +18640,14 @@ func (e RevokeServiceAuthTokenResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeServiceAuthTokenResponse.%s: %s%s",
+		"invalid %sGetGithubRepoStatusRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeServiceAuthTokenResponseValidationError{}
+var _ error = GetGithubRepoStatusRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13015,57 +18655,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeServiceAuthTokenResponseValidationError{}
+} = GetGithubRepoStatusRequestValidationError{}
 
-// Validate checks the field values on IssueServiceAuthTokenRequest with the
+// Validate checks the field values on GetGithubRepoStatusResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IssueServiceAuthTokenRequest) Validate() error {
+func (m *GetGithubRepoStatusResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueServiceAuthTokenRequest with the
+// ValidateAll checks the field values on GetGithubRepoStatusResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// IssueServiceAuthTokenRequestMultiError, or nil if none found.
-func (m *IssueServiceAuthTokenRequest) ValidateAll() error {
+// GetGithubRepoStatusResponseMultiError, or nil if none found.
+func (m *GetGithubRepoStatusResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueServiceAuthTokenRequest) validate(all bool) error {
+func (m *GetGithubRepoStatusResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
-		err := IssueServiceAuthTokenRequestValidationError{
-			field:  "OrganizationName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for HasAccess
 
-	// no validation rules for ServiceName
+	// no validation rules for GrantAccessUrl
+
+	// no validation rules for DefaultBranch
 
 	if len(errors) > 0 {
-		return IssueServiceAuthTokenRequestMultiError(errors)
+		return GetGithubRepoStatusResponseMultiError(errors)
 	}
 
 	return nil
 }
-
-// IssueServiceAuthTokenRequestMultiError is an error wrapping multiple
-// validation errors returned by IssueServiceAuthTokenRequest.ValidateAll() if
+
+// GetGithubRepoStatusResponseMultiError is an error wrapping multiple
+// validation errors returned by GetGithubRepoStatusResponse.ValidateAll() if
 // the designated constraints aren't met.
-type IssueServiceAuthTokenRequestMultiError []error
+type GetGithubRepoStatusResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueServiceAuthTokenRequestMultiError) Error() string {
+func (m GetGithubRepoStatusResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13074,12 +18707,12 @@ func (m IssueServiceAuthTokenRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueServiceAuthTokenRequestMultiError) AllErrors() []error { return m }
+func (m GetGithubRepoStatusResponseMultiError) AllErrors() []error { return m }
 
-// IssueServiceAuthTokenRequestValidationError is the validation error returned
-// by IssueServiceAuthTokenRequest.Validate if the designated constraints
+// GetGithubRepoStatusResponseValidationError is the validation error returned
+// by GetGithubRepoStatusResponse.Validate if the designated constraints
 // aren't met.
-type IssueServiceAuthTokenRequestValidationError struct {
+type GetGithubRepoStatusResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13087,24 +18720,24 @@ type IssueServiceAuthTokenRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueServiceAuthTokenRequestValidationError) Field() string { return e.field }
+func (e GetGithubRepoStatusResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueServiceAuthTokenRequestValidationError) Reason() string { return e.reason }
+func (e GetGithubRepoStatusResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueServiceAuthTokenRequestValidationError) Cause() error { return e.cause }
+func (e GetGithubRepoStatusResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueServiceAuthTokenRequestValidationError) Key() bool { return e.key }
+func (e GetGithubRepoStatusResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueServiceAuthTokenRequestValidationError) ErrorName() string {
-	return "IssueServiceAuthTokenRequestValidationError"
+func (e GetGithubRepoStatusResponseValidationError) ErrorName() string {
+	return "GetGithubRepoStatusResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueServiceAuthTokenRequestValidationError) Error() string {
+func (e GetGithubRepoStatusResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13116,14 +18749,14 @@ func (e IssueServiceAuthTokenRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueServiceAuthTokenRequest.%s: %s%s",
+		"invalid %sGetGithubRepoStatusResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueServiceAuthTokenRequestValidationError{}
+var _ error = GetGithubRepoStatusResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13131,46 +18764,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueServiceAuthTokenRequestValidationError{}
+} = GetGithubRepoStatusResponseValidationError{}
 
-// Validate checks the field values on IssueServiceAuthTokenResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetGitCredentialsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IssueServiceAuthTokenResponse) Validate() error {
+func (m *GetGitCredentialsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueServiceAuthTokenResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// IssueServiceAuthTokenResponseMultiError, or nil if none found.
-func (m *IssueServiceAuthTokenResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetGitCredentialsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetGitCredentialsRequestMultiError, or nil if none found.
+func (m *GetGitCredentialsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueServiceAuthTokenResponse) validate(all bool) error {
+func (m *GetGitCredentialsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Token
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := GetGitCredentialsRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := GetGitCredentialsRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return IssueServiceAuthTokenResponseMultiError(errors)
+		return GetGitCredentialsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// IssueServiceAuthTokenResponseMultiError is an error wrapping multiple
-// validation errors returned by IssueServiceAuthTokenResponse.ValidateAll()
-// if the designated constraints aren't met.
-type IssueServiceAuthTokenResponseMultiError []error
+// GetGitCredentialsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetGitCredentialsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetGitCredentialsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueServiceAuthTokenResponseMultiError) Error() string {
+func (m GetGitCredentialsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13179,12 +18832,11 @@ func (m IssueServiceAuthTokenResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueServiceAuthTokenResponseMultiError) AllErrors() []error { return m }
+func (m GetGitCredentialsRequestMultiError) AllErrors() []error { return m }
 
-// IssueServiceAuthTokenResponseValidationError is the validation error
-// returned by IssueServiceAuthTokenResponse.Validate if the designated
-// constraints aren't met.
-type IssueServiceAuthTokenResponseValidationError struct {
+// GetGitCredentialsRequestValidationError is the validation error returned by
+// GetGitCredentialsRequest.Validate if the designated constraints aren't met.
+type GetGitCredentialsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13192,24 +18844,24 @@ type IssueServiceAuthTokenResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueServiceAuthTokenResponseValidationError) Field() string { return e.field }
+func (e GetGitCredentialsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueServiceAuthTokenResponseValidationError) Reason() string { return e.reason }
+func (e GetGitCredentialsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueServiceAuthTokenResponseValidationError) Cause() error { return e.cause }
+func (e GetGitCredentialsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueServiceAuthTokenResponseValidationError) Key() bool { return e.key }
+func (e GetGitCredentialsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueServiceAuthTokenResponseValidationError) ErrorName() string {
-	return "IssueServiceAuthTokenResponseValidationError"
+func (e GetGitCredentialsRequestValidationError) ErrorName() string {
+	return "GetGitCredentialsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueServiceAuthTokenResponseValidationError) Error() string {
+func (e GetGitCredentialsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13221,14 +18873,14 @@ func (e IssueServiceAuthTokenResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueServiceAuthTokenResponse.%s: %s%s",
+		"invalid %sGetGitCredentialsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueServiceAuthTokenResponseValidationError{}
+var _ error = GetGitCredentialsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13236,57 +18888,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueServiceAuthTokenResponseValidationError{}
+} = GetGitCredentialsRequestValidationError{}
 
-// Validate checks the field values on ListServiceAuthTokensRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetGitCredentialsResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListServiceAuthTokensRequest) Validate() error {
+func (m *GetGitCredentialsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListServiceAuthTokensRequest with the
+// ValidateAll checks the field values on GetGitCredentialsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListServiceAuthTokensRequestMultiError, or nil if none found.
-func (m *ListServiceAuthTokensRequest) ValidateAll() error {
+// GetGitCredentialsResponseMultiError, or nil if none found.
+func (m *GetGitCredentialsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListServiceAuthTokensRequest) validate(all bool) error {
+func (m *GetGitCredentialsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
-		err := ListServiceAuthTokensRequestValidationError{
-			field:  "OrganizationName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for RepoUrl
 
-	// no validation rules for ServiceName
+	// no validation rules for Username
+
+	// no validation rules for Password
+
+	// no validation rules for Subpath
+
+	// no validation rules for ProdBranch
 
 	if len(errors) > 0 {
-		return ListServiceAuthTokensRequestMultiError(errors)
+		return GetGitCredentialsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListServiceAuthTokensRequestMultiError is an error wrapping multiple
-// validation errors returned by ListServiceAuthTokensRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ListServiceAuthTokensRequestMultiError []error
+// GetGitCredentialsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetGitCredentialsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetGitCredentialsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListServiceAuthTokensRequestMultiError) Error() string {
+func (m GetGitCredentialsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13295,12 +18944,11 @@ func (m ListServiceAuthTokensRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListServiceAuthTokensRequestMultiError) AllErrors() []error { return m }
+func (m GetGitCredentialsResponseMultiError) AllErrors() []error { return m }
 
-// ListServiceAuthTokensRequestValidationError is the validation error returned
-// by ListServiceAuthTokensRequest.Validate if the designated constraints
-// aren't met.
-type ListServiceAuthTokensRequestValidationError struct {
+// GetGitCredentialsResponseValidationError is the validation error returned by
+// GetGitCredentialsResponse.Validate if the designated constraints aren't met.
+type GetGitCredentialsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13308,24 +18956,24 @@ type ListServiceAuthTokensRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListServiceAuthTokensRequestValidationError) Field() string { return e.field }
+func (e GetGitCredentialsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListServiceAuthTokensRequestValidationError) Reason() string { return e.reason }
+func (e GetGitCredentialsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListServiceAuthTokensRequestValidationError) Cause() error { return e.cause }
+func (e GetGitCredentialsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListServiceAuthTokensRequestValidationError) Key() bool { return e.key }
+func (e GetGitCredentialsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListServiceAuthTokensRequestValidationError) ErrorName() string {
-	return "ListServiceAuthTokensRequestValidationError"
+func (e GetGitCredentialsResponseValidationError) ErrorName() string {
+	return "GetGitCredentialsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListServiceAuthTokensRequestValidationError) Error() string {
+func (e GetGitCredentialsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13337,14 +18985,14 @@ func (e ListServiceAuthTokensRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListServiceAuthTokensRequest.%s: %s%s",
+		"invalid %sGetGitCredentialsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListServiceAuthTokensRequestValidationError{}
+var _ error = GetGitCredentialsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13352,78 +19000,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListServiceAuthTokensRequestValidationError{}
+} = GetGitCredentialsResponseValidationError{}
 
-// Validate checks the field values on ListServiceAuthTokensResponse with the
+// Validate checks the field values on CreateWhitelistedDomainRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListServiceAuthTokensResponse) Validate() error {
+func (m *CreateWhitelistedDomainRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListServiceAuthTokensResponse with
+// ValidateAll checks the field values on CreateWhitelistedDomainRequest with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// ListServiceAuthTokensResponseMultiError, or nil if none found.
-func (m *ListServiceAuthTokensResponse) ValidateAll() error {
+// CreateWhitelistedDomainRequestMultiError, or nil if none found.
+func (m *CreateWhitelistedDomainRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListServiceAuthTokensResponse) validate(all bool) error {
+func (m *CreateWhitelistedDomainRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetTokens() {
-		_, _ = idx, item
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := CreateWhitelistedDomainRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListServiceAuthTokensResponseValidationError{
-						field:  fmt.Sprintf("Tokens[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListServiceAuthTokensResponseValidationError{
-						field:  fmt.Sprintf("Tokens[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListServiceAuthTokensResponseValidationError{
-					field:  fmt.Sprintf("Tokens[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if utf8.RuneCountInString(m.GetDomain()) < 1 {
+		err := CreateWhitelistedDomainRequestValidationError{
+			field:  "Domain",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := CreateWhitelistedDomainRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return ListServiceAuthTokensResponseMultiError(errors)
+		return CreateWhitelistedDomainRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListServiceAuthTokensResponseMultiError is an error wrapping multiple
-// validation errors returned by ListServiceAuthTokensResponse.ValidateAll()
+// CreateWhitelistedDomainRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateWhitelistedDomainRequest.ValidateAll()
 // if the designated constraints aren't met.
-type ListServiceAuthTokensResponseMultiError []error
+type CreateWhitelistedDomainRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListServiceAuthTokensResponseMultiError) Error() string {
+func (m CreateWhitelistedDomainRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13432,12 +19079,12 @@ func (m ListServiceAuthTokensResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListServiceAuthTokensResponseMultiError) AllErrors() []error { return m }
+func (m CreateWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
 
-// ListServiceAuthTokensResponseValidationError is the validation error
-// returned by ListServiceAuthTokensResponse.Validate if the designated
+// CreateWhitelistedDomainRequestValidationError is the validation error
+// returned by CreateWhitelistedDomainRequest.Validate if the designated
 // constraints aren't met.
-type ListServiceAuthTokensResponseValidationError struct {
+type CreateWhitelistedDomainRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13445,24 +19092,24 @@ type ListServiceAuthTokensResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListServiceAuthTokensResponseValidationError) Field() string { return e.field }
+func (e CreateWhitelistedDomainRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListServiceAuthTokensResponseValidationError) Reason() string { return e.reason }
+func (e CreateWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListServiceAuthTokensResponseValidationError) Cause() error { return e.cause }
+func (e CreateWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListServiceAuthTokensResponseValidationError) Key() bool { return e.key }
+func (e CreateWhitelistedDomainRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListServiceAuthTokensResponseValidationError) ErrorName() string {
-	return "ListServiceAuthTokensResponseValidationError"
+func (e CreateWhitelistedDomainRequestValidationError) ErrorName() string {
+	return "CreateWhitelistedDomainRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListServiceAuthTokensResponseValidationError) Error() string {
+func (e CreateWhitelistedDomainRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13474,14 +19121,14 @@ func (e ListServiceAuthTokensResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListServiceAuthTokensResponse.%s: %s%s",
+		"invalid %sCreateWhitelistedDomainRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListServiceAuthTokensResponseValidationError{}
+var _ error = CreateWhitelistedDomainRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13489,46 +19136,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListServiceAuthTokensResponseValidationError{}
+} = CreateWhitelistedDomainRequestValidationError{}
 
-// Validate checks the field values on GetGithubRepoStatusRequest with the
+// Validate checks the field values on CreateWhitelistedDomainResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetGithubRepoStatusRequest) Validate() error {
+func (m *CreateWhitelistedDomainResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetGithubRepoStatusRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetGithubRepoStatusRequestMultiError, or nil if none found.
-func (m *GetGithubRepoStatusRequest) ValidateAll() error {
+// ValidateAll checks the field values on CreateWhitelistedDomainResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateWhitelistedDomainResponseMultiError, or nil if none found.
+func (m *CreateWhitelistedDomainResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetGithubRepoStatusRequest) validate(all bool) error {
+func (m *CreateWhitelistedDomainResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for GithubUrl
-
 	if len(errors) > 0 {
-		return GetGithubRepoStatusRequestMultiError(errors)
+		return CreateWhitelistedDomainResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetGithubRepoStatusRequestMultiError is an error wrapping multiple
-// validation errors returned by GetGithubRepoStatusRequest.ValidateAll() if
-// the designated constraints aren't met.
-type GetGithubRepoStatusRequestMultiError []error
+// CreateWhitelistedDomainResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateWhitelistedDomainResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CreateWhitelistedDomainResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetGithubRepoStatusRequestMultiError) Error() string {
+func (m CreateWhitelistedDomainResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13537,11 +19182,12 @@ func (m GetGithubRepoStatusRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetGithubRepoStatusRequestMultiError) AllErrors() []error { return m }
+func (m CreateWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
 
-// GetGithubRepoStatusRequestValidationError is the validation error returned
-// by GetGithubRepoStatusRequest.Validate if the designated constraints aren't met.
-type GetGithubRepoStatusRequestValidationError struct {
+// CreateWhitelistedDomainResponseValidationError is the validation error
+// returned by CreateWhitelistedDomainResponse.Validate if the designated
+// constraints aren't met.
+type CreateWhitelistedDomainResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13549,24 +19195,24 @@ type GetGithubRepoStatusRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetGithubRepoStatusRequestValidationError) Field() string { return e.field }
+func (e CreateWhitelistedDomainResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetGithubRepoStatusRequestValidationError) Reason() string { return e.reason }
+func (e CreateWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetGithubRepoStatusRequestValidationError) Cause() error { return e.cause }
+func (e CreateWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetGithubRepoStatusRequestValidationError) Key() bool { return e.key }
+func (e CreateWhitelistedDomainResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetGithubRepoStatusRequestValidationError) ErrorName() string {
-	return "GetGithubRepoStatusRequestValidationError"
+func (e CreateWhitelistedDomainResponseValidationError) ErrorName() string {
+	return "CreateWhitelistedDomainResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetGithubRepoStatusRequestValidationError) Error() string {
+func (e CreateWhitelistedDomainResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13578,14 +19224,14 @@ func (e GetGithubRepoStatusRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetGithubRepoStatusRequest.%s: %s%s",
+		"invalid %sCreateWhitelistedDomainResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetGithubRepoStatusRequestValidationError{}
+var _ error = CreateWhitelistedDomainResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13593,50 +19239,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetGithubRepoStatusRequestValidationError{}
+} = CreateWhitelistedDomainResponseValidationError{}
 
-// Validate checks the field values on GetGithubRepoStatusResponse with the
+// Validate checks the field values on RemoveWhitelistedDomainRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetGithubRepoStatusResponse) Validate() error {
+func (m *RemoveWhitelistedDomainRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetGithubRepoStatusResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetGithubRepoStatusResponseMultiError, or nil if none found.
-func (m *GetGithubRepoStatusResponse) ValidateAll() error {
+// ValidateAll checks the field values on RemoveWhitelistedDomainRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveWhitelistedDomainRequestMultiError, or nil if none found.
+func (m *RemoveWhitelistedDomainRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetGithubRepoStatusResponse) validate(all bool) error {
+func (m *RemoveWhitelistedDomainRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for HasAccess
-
-	// no validation rules for GrantAccessUrl
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := RemoveWhitelistedDomainRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for DefaultBranch
+	if utf8.RuneCountInString(m.GetDomain()) < 1 {
+		err := RemoveWhitelistedDomainRequestValidationError{
+			field:  "Domain",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return GetGithubRepoStatusResponseMultiError(errors)
+		return RemoveWhitelistedDomainRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetGithubRepoStatusResponseMultiError is an error wrapping multiple
-// validation errors returned by GetGithubRepoStatusResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetGithubRepoStatusResponseMultiError []error
+// RemoveWhitelistedDomainRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveWhitelistedDomainRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RemoveWhitelistedDomainRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetGithubRepoStatusResponseMultiError) Error() string {
+func (m RemoveWhitelistedDomainRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13645,12 +19307,12 @@ func (m GetGithubRepoStatusResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetGithubRepoStatusResponseMultiError) AllErrors() []error { return m }
+func (m RemoveWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
 
-// GetGithubRepoStatusResponseValidationError is the validation error returned
-// by GetGithubRepoStatusResponse.Validate if the designated constraints
-// aren't met.
-type GetGithubRepoStatusResponseValidationError struct {
+// RemoveWhitelistedDomainRequestValidationError is the validation error
+// returned by RemoveWhitelistedDomainRequest.Validate if the designated
+// constraints aren't met.
+type RemoveWhitelistedDomainRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13658,24 +19320,24 @@ type GetGithubRepoStatusResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetGithubRepoStatusResponseValidationError) Field() string { return e.field }
+func (e RemoveWhitelistedDomainRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetGithubRepoStatusResponseValidationError) Reason() string { return e.reason }
+func (e RemoveWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetGithubRepoStatusResponseValidationError) Cause() error { return e.cause }
+func (e RemoveWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetGithubRepoStatusResponseValidationError) Key() bool { return e.key }
+func (e RemoveWhitelistedDomainRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetGithubRepoStatusResponseValidationError) ErrorName() string {
-	return "GetGithubRepoStatusResponseValidationError"
+func (e RemoveWhitelistedDomainRequestValidationError) ErrorName() string {
+	return "RemoveWhitelistedDomainRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetGithubRepoStatusResponseValidationError) Error() string {
+func (e RemoveWhitelistedDomainRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13687,14 +19349,14 @@ func (e GetGithubRepoStatusResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetGithubRepoStatusResponse.%s: %s%s",
+		"invalid %sRemoveWhitelistedDomainRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetGithubRepoStatusResponseValidationError{}
+var _ error = RemoveWhitelistedDomainRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13702,66 +19364,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetGithubRepoStatusResponseValidationError{}
+} = RemoveWhitelistedDomainRequestValidationError{}
 
-// Validate checks the field values on GetGitCredentialsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RemoveWhitelistedDomainResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetGitCredentialsRequest) Validate() error {
+func (m *RemoveWhitelistedDomainResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetGitCredentialsRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetGitCredentialsRequestMultiError, or nil if none found.
-func (m *GetGitCredentialsRequest) ValidateAll() error {
+// ValidateAll checks the field values on RemoveWhitelistedDomainResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveWhitelistedDomainResponseMultiError, or nil if none found.
+func (m *RemoveWhitelistedDomainResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetGitCredentialsRequest) validate(all bool) error {
+func (m *RemoveWhitelistedDomainResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := GetGitCredentialsRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := GetGitCredentialsRequestValidationError{
-			field:  "Project",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
-		return GetGitCredentialsRequestMultiError(errors)
+		return RemoveWhitelistedDomainResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetGitCredentialsRequestMultiError is an error wrapping multiple validation
-// errors returned by GetGitCredentialsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetGitCredentialsRequestMultiError []error
+// RemoveWhitelistedDomainResponseMultiError is an error wrapping multiple
+// validation errors returned by RemoveWhitelistedDomainResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RemoveWhitelistedDomainResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetGitCredentialsRequestMultiError) Error() string {
+func (m RemoveWhitelistedDomainResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13770,11 +19410,12 @@ func (m GetGitCredentialsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetGitCredentialsRequestMultiError) AllErrors() []error { return m }
+func (m RemoveWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
 
-// GetGitCredentialsRequestValidationError is the validation error returned by
-// GetGitCredentialsRequest.Validate if the designated constraints aren't met.
-type GetGitCredentialsRequestValidationError struct {
+// RemoveWhitelistedDomainResponseValidationError is the validation error
+// returned by RemoveWhitelistedDomainResponse.Validate if the designated
+// constraints aren't met.
+type RemoveWhitelistedDomainResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13782,24 +19423,24 @@ type GetGitCredentialsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetGitCredentialsRequestValidationError) Field() string { return e.field }
+func (e RemoveWhitelistedDomainResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetGitCredentialsRequestValidationError) Reason() string { return e.reason }
+func (e RemoveWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetGitCredentialsRequestValidationError) Cause() error { return e.cause }
+func (e RemoveWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetGitCredentialsRequestValidationError) Key() bool { return e.key }
+func (e RemoveWhitelistedDomainResponseValidationError) Key() bool { return e.key }
 
-// ErrorName returns error name.
-func (e GetGitCredentialsRequestValidationError) ErrorName() string {
-	return "GetGitCredentialsRequestValidationError"
+// ErrorName returns error name.
+func (e RemoveWhitelistedDomainResponseValidationError) ErrorName() string {
+	return "RemoveWhitelistedDomainResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetGitCredentialsRequestValidationError) Error() string {
+func (e RemoveWhitelistedDomainResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13811,14 +19452,14 @@ func (e GetGitCredentialsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetGitCredentialsRequest.%s: %s%s",
+		"invalid %sRemoveWhitelistedDomainResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetGitCredentialsRequestValidationError{}
+var _ error = RemoveWhitelistedDomainResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13826,54 +19467,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetGitCredentialsRequestValidationError{}
+} = RemoveWhitelistedDomainResponseValidationError{}
 
-// Validate checks the field values on GetGitCredentialsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListWhitelistedDomainsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetGitCredentialsResponse) Validate() error {
+func (m *ListWhitelistedDomainsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetGitCredentialsResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetGitCredentialsResponseMultiError, or nil if none found.
-func (m *GetGitCredentialsResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListWhitelistedDomainsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListWhitelistedDomainsRequestMultiError, or nil if none found.
+func (m *ListWhitelistedDomainsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetGitCredentialsResponse) validate(all bool) error {
+func (m *ListWhitelistedDomainsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RepoUrl
-
-	// no validation rules for Username
-
-	// no validation rules for Password
-
-	// no validation rules for Subpath
-
-	// no validation rules for ProdBranch
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListWhitelistedDomainsRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return GetGitCredentialsResponseMultiError(errors)
+		return ListWhitelistedDomainsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetGitCredentialsResponseMultiError is an error wrapping multiple validation
-// errors returned by GetGitCredentialsResponse.ValidateAll() if the
-// designated constraints aren't met.
-type GetGitCredentialsResponseMultiError []error
+// ListWhitelistedDomainsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListWhitelistedDomainsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListWhitelistedDomainsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetGitCredentialsResponseMultiError) Error() string {
+func (m ListWhitelistedDomainsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13882,11 +19524,12 @@ func (m GetGitCredentialsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetGitCredentialsResponseMultiError) AllErrors() []error { return m }
+func (m ListWhitelistedDomainsRequestMultiError) AllErrors() []error { return m }
 
-// GetGitCredentialsResponseValidationError is the validation error returned by
-// GetGitCredentialsResponse.Validate if the designated constraints aren't met.
-type GetGitCredentialsResponseValidationError struct {
+// ListWhitelistedDomainsRequestValidationError is the validation error
+// returned by ListWhitelistedDomainsRequest.Validate if the designated
+// constraints aren't met.
+type ListWhitelistedDomainsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13894,24 +19537,24 @@ type GetGitCredentialsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetGitCredentialsResponseValidationError) Field() string { return e.field }
+func (e ListWhitelistedDomainsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetGitCredentialsResponseValidationError) Reason() string { return e.reason }
+func (e ListWhitelistedDomainsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetGitCredentialsResponseValidationError) Cause() error { return e.cause }
+func (e ListWhitelistedDomainsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetGitCredentialsResponseValidationError) Key() bool { return e.key }
+func (e ListWhitelistedDomainsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetGitCredentialsResponseValidationError) ErrorName() string {
-	return "GetGitCredentialsResponseValidationError"
+func (e ListWhitelistedDomainsRequestValidationError) ErrorName() string {
+	return "ListWhitelistedDomainsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetGitCredentialsResponseValidationError) Error() string {
+func (e ListWhitelistedDomainsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13923,14 +19566,14 @@ func (e GetGitCredentialsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetGitCredentialsResponse.%s: %s%s",
+		"invalid %sListWhitelistedDomainsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetGitCredentialsResponseValidationError{}
+var _ error = ListWhitelistedDomainsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13938,77 +19581,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetGitCredentialsResponseValidationError{}
+} = ListWhitelistedDomainsRequestValidationError{}
 
-// Validate checks the field values on CreateWhitelistedDomainRequest with the
+// Validate checks the field values on ListWhitelistedDomainsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateWhitelistedDomainRequest) Validate() error {
+func (m *ListWhitelistedDomainsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateWhitelistedDomainRequest with
+// ValidateAll checks the field values on ListWhitelistedDomainsResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// CreateWhitelistedDomainRequestMultiError, or nil if none found.
-func (m *CreateWhitelistedDomainRequest) ValidateAll() error {
+// ListWhitelistedDomainsResponseMultiError, or nil if none found.
+func (m *ListWhitelistedDomainsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateWhitelistedDomainRequest) validate(all bool) error {
+func (m *ListWhitelistedDomainsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := CreateWhitelistedDomainRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	for idx, item := range m.GetDomains() {
+		_, _ = idx, item
 
-	if utf8.RuneCountInString(m.GetDomain()) < 1 {
-		err := CreateWhitelistedDomainRequestValidationError{
-			field:  "Domain",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWhitelistedDomainsResponseValidationError{
+						field:  fmt.Sprintf("Domains[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWhitelistedDomainsResponseValidationError{
+						field:  fmt.Sprintf("Domains[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWhitelistedDomainsResponseValidationError{
+					field:  fmt.Sprintf("Domains[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
-	}
 
-	if utf8.RuneCountInString(m.GetRole()) < 1 {
-		err := CreateWhitelistedDomainRequestValidationError{
-			field:  "Role",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return CreateWhitelistedDomainRequestMultiError(errors)
+		return ListWhitelistedDomainsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateWhitelistedDomainRequestMultiError is an error wrapping multiple
-// validation errors returned by CreateWhitelistedDomainRequest.ValidateAll()
+// ListWhitelistedDomainsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListWhitelistedDomainsResponse.ValidateAll()
 // if the designated constraints aren't met.
-type CreateWhitelistedDomainRequestMultiError []error
+type ListWhitelistedDomainsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateWhitelistedDomainRequestMultiError) Error() string {
+func (m ListWhitelistedDomainsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14017,12 +19661,12 @@ func (m CreateWhitelistedDomainRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
+func (m ListWhitelistedDomainsResponseMultiError) AllErrors() []error { return m }
 
-// CreateWhitelistedDomainRequestValidationError is the validation error
-// returned by CreateWhitelistedDomainRequest.Validate if the designated
+// ListWhitelistedDomainsResponseValidationError is the validation error
+// returned by ListWhitelistedDomainsResponse.Validate if the designated
 // constraints aren't met.
-type CreateWhitelistedDomainRequestValidationError struct {
+type ListWhitelistedDomainsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14030,24 +19674,24 @@ type CreateWhitelistedDomainRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateWhitelistedDomainRequestValidationError) Field() string { return e.field }
+func (e ListWhitelistedDomainsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
+func (e ListWhitelistedDomainsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
+func (e ListWhitelistedDomainsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateWhitelistedDomainRequestValidationError) Key() bool { return e.key }
+func (e ListWhitelistedDomainsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateWhitelistedDomainRequestValidationError) ErrorName() string {
-	return "CreateWhitelistedDomainRequestValidationError"
+func (e ListWhitelistedDomainsResponseValidationError) ErrorName() string {
+	return "ListWhitelistedDomainsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateWhitelistedDomainRequestValidationError) Error() string {
+func (e ListWhitelistedDomainsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14059,14 +19703,14 @@ func (e CreateWhitelistedDomainRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateWhitelistedDomainRequest.%s: %s%s",
+		"invalid %sListWhitelistedDomainsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateWhitelistedDomainRequestValidationError{}
+var _ error = ListWhitelistedDomainsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -14074,44 +19718,137 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateWhitelistedDomainRequestValidationError{}
+} = ListWhitelistedDomainsResponseValidationError{}
 
-// Validate checks the field values on CreateWhitelistedDomainResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateWhitelistedDomainResponse) Validate() error {
+// Validate checks the field values on User with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *User) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateWhitelistedDomainResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// CreateWhitelistedDomainResponseMultiError, or nil if none found.
-func (m *CreateWhitelistedDomainResponse) ValidateAll() error {
+// ValidateAll checks the field values on User with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in UserMultiError, or nil if none found.
+func (m *User) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateWhitelistedDomainResponse) validate(all bool) error {
+func (m *User) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Email
+
+	// no validation rules for DisplayName
+
+	// no validation rules for PhotoUrl
+
+	if all {
+		switch v := interface{}(m.GetQuotas()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "Quotas",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "Quotas",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuotas()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "Quotas",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return CreateWhitelistedDomainResponseMultiError(errors)
+		return UserMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateWhitelistedDomainResponseMultiError is an error wrapping multiple
-// validation errors returned by CreateWhitelistedDomainResponse.ValidateAll()
-// if the designated constraints aren't met.
-type CreateWhitelistedDomainResponseMultiError []error
+// UserMultiError is an error wrapping multiple validation errors returned by
+// User.ValidateAll() if the designated constraints aren't met.
+type UserMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateWhitelistedDomainResponseMultiError) Error() string {
+func (m UserMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14120,12 +19857,11 @@ func (m CreateWhitelistedDomainResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
+func (m UserMultiError) AllErrors() []error { return m }
 
-// CreateWhitelistedDomainResponseValidationError is the validation error
-// returned by CreateWhitelistedDomainResponse.Validate if the designated
-// constraints aren't met.
-type CreateWhitelistedDomainResponseValidationError struct {
+// UserValidationError is the validation error returned by User.Validate if the
+// designated constraints aren't met.
+type UserValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14133,24 +19869,22 @@ type CreateWhitelistedDomainResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateWhitelistedDomainResponseValidationError) Field() string { return e.field }
+func (e UserValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
+func (e UserValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
+func (e UserValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateWhitelistedDomainResponseValidationError) Key() bool { return e.key }
+func (e UserValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateWhitelistedDomainResponseValidationError) ErrorName() string {
-	return "CreateWhitelistedDomainResponseValidationError"
-}
+func (e UserValidationError) ErrorName() string { return "UserValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateWhitelistedDomainResponseValidationError) Error() string {
+func (e UserValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14162,14 +19896,14 @@ func (e CreateWhitelistedDomainResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateWhitelistedDomainResponse.%s: %s%s",
+		"invalid %sUser.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateWhitelistedDomainResponseValidationError{}
+var _ error = UserValidationError{}
 
 var _ interface {
 	Field() string
@@ -14177,66 +19911,108 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateWhitelistedDomainResponseValidationError{}
+} = UserValidationError{}
 
-// Validate checks the field values on RemoveWhitelistedDomainRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveWhitelistedDomainRequest) Validate() error {
+// Validate checks the field values on Service with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Service) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveWhitelistedDomainRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RemoveWhitelistedDomainRequestMultiError, or nil if none found.
-func (m *RemoveWhitelistedDomainRequest) ValidateAll() error {
+// ValidateAll checks the field values on Service with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ServiceMultiError, or nil if none found.
+func (m *Service) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveWhitelistedDomainRequest) validate(all bool) error {
+func (m *Service) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := RemoveWhitelistedDomainRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for OrgId
+
+	// no validation rules for OrgName
+
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetDomain()) < 1 {
-		err := RemoveWhitelistedDomainRequestValidationError{
-			field:  "Domain",
-			reason: "value length must be at least 1 runes",
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return RemoveWhitelistedDomainRequestMultiError(errors)
+		return ServiceMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveWhitelistedDomainRequestMultiError is an error wrapping multiple
-// validation errors returned by RemoveWhitelistedDomainRequest.ValidateAll()
-// if the designated constraints aren't met.
-type RemoveWhitelistedDomainRequestMultiError []error
+// ServiceMultiError is an error wrapping multiple validation errors returned
+// by Service.ValidateAll() if the designated constraints aren't met.
+type ServiceMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveWhitelistedDomainRequestMultiError) Error() string {
+func (m ServiceMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14245,12 +20021,11 @@ func (m RemoveWhitelistedDomainRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
+func (m ServiceMultiError) AllErrors() []error { return m }
 
-// RemoveWhitelistedDomainRequestValidationError is the validation error
-// returned by RemoveWhitelistedDomainRequest.Validate if the designated
-// constraints aren't met.
-type RemoveWhitelistedDomainRequestValidationError struct {
+// ServiceValidationError is the validation error returned by Service.Validate
+// if the designated constraints aren't met.
+type ServiceValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14258,24 +20033,22 @@ type RemoveWhitelistedDomainRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveWhitelistedDomainRequestValidationError) Field() string { return e.field }
+func (e ServiceValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
+func (e ServiceValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
+func (e ServiceValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveWhitelistedDomainRequestValidationError) Key() bool { return e.key }
+func (e ServiceValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveWhitelistedDomainRequestValidationError) ErrorName() string {
-	return "RemoveWhitelistedDomainRequestValidationError"
-}
+func (e ServiceValidationError) ErrorName() string { return "ServiceValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RemoveWhitelistedDomainRequestValidationError) Error() string {
+func (e ServiceValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14287,14 +20060,14 @@ func (e RemoveWhitelistedDomainRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveWhitelistedDomainRequest.%s: %s%s",
+		"invalid %sService.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveWhitelistedDomainRequestValidationError{}
+var _ error = ServiceValidationError{}
 
 var _ interface {
 	Field() string
@@ -14302,44 +20075,136 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveWhitelistedDomainRequestValidationError{}
+} = ServiceValidationError{}
 
-// Validate checks the field values on RemoveWhitelistedDomainResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveWhitelistedDomainResponse) Validate() error {
+// Validate checks the field values on Organization with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Organization) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveWhitelistedDomainResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RemoveWhitelistedDomainResponseMultiError, or nil if none found.
-func (m *RemoveWhitelistedDomainResponse) ValidateAll() error {
+// ValidateAll checks the field values on Organization with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrganizationMultiError, or
+// nil if none found.
+func (m *Organization) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveWhitelistedDomainResponse) validate(all bool) error {
-	if m == nil {
-		return nil
+func (m *Organization) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	if all {
+		switch v := interface{}(m.GetQuotas()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "Quotas",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "Quotas",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuotas()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrganizationValidationError{
+				field:  "Quotas",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	var errors []error
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrganizationValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrganizationValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return RemoveWhitelistedDomainResponseMultiError(errors)
+		return OrganizationMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveWhitelistedDomainResponseMultiError is an error wrapping multiple
-// validation errors returned by RemoveWhitelistedDomainResponse.ValidateAll()
-// if the designated constraints aren't met.
-type RemoveWhitelistedDomainResponseMultiError []error
+// OrganizationMultiError is an error wrapping multiple validation errors
+// returned by Organization.ValidateAll() if the designated constraints aren't met.
+type OrganizationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveWhitelistedDomainResponseMultiError) Error() string {
+func (m OrganizationMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14348,12 +20213,11 @@ func (m RemoveWhitelistedDomainResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
+func (m OrganizationMultiError) AllErrors() []error { return m }
 
-// RemoveWhitelistedDomainResponseValidationError is the validation error
-// returned by RemoveWhitelistedDomainResponse.Validate if the designated
-// constraints aren't met.
-type RemoveWhitelistedDomainResponseValidationError struct {
+// OrganizationValidationError is the validation error returned by
+// Organization.Validate if the designated constraints aren't met.
+type OrganizationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14361,24 +20225,22 @@ type RemoveWhitelistedDomainResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveWhitelistedDomainResponseValidationError) Field() string { return e.field }
+func (e OrganizationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
+func (e OrganizationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
+func (e OrganizationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveWhitelistedDomainResponseValidationError) Key() bool { return e.key }
+func (e OrganizationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveWhitelistedDomainResponseValidationError) ErrorName() string {
-	return "RemoveWhitelistedDomainResponseValidationError"
-}
+func (e OrganizationValidationError) ErrorName() string { return "OrganizationValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RemoveWhitelistedDomainResponseValidationError) Error() string {
+func (e OrganizationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14390,14 +20252,14 @@ func (e RemoveWhitelistedDomainResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveWhitelistedDomainResponse.%s: %s%s",
+		"invalid %sOrganization.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveWhitelistedDomainResponseValidationError{}
+var _ error = OrganizationValidationError{}
 
 var _ interface {
 	Field() string
@@ -14405,55 +20267,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveWhitelistedDomainResponseValidationError{}
+} = OrganizationValidationError{}
 
-// Validate checks the field values on ListWhitelistedDomainsRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListWhitelistedDomainsRequest) Validate() error {
+// Validate checks the field values on UserQuotas with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserQuotas) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListWhitelistedDomainsRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ListWhitelistedDomainsRequestMultiError, or nil if none found.
-func (m *ListWhitelistedDomainsRequest) ValidateAll() error {
+// ValidateAll checks the field values on UserQuotas with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserQuotasMultiError, or
+// nil if none found.
+func (m *UserQuotas) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListWhitelistedDomainsRequest) validate(all bool) error {
+func (m *UserQuotas) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := ListWhitelistedDomainsRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for SingleuserOrgs
 
 	if len(errors) > 0 {
-		return ListWhitelistedDomainsRequestMultiError(errors)
+		return UserQuotasMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListWhitelistedDomainsRequestMultiError is an error wrapping multiple
-// validation errors returned by ListWhitelistedDomainsRequest.ValidateAll()
-// if the designated constraints aren't met.
-type ListWhitelistedDomainsRequestMultiError []error
+// UserQuotasMultiError is an error wrapping multiple validation errors
+// returned by UserQuotas.ValidateAll() if the designated constraints aren't met.
+type UserQuotasMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListWhitelistedDomainsRequestMultiError) Error() string {
+func (m UserQuotasMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14462,12 +20314,11 @@ func (m ListWhitelistedDomainsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListWhitelistedDomainsRequestMultiError) AllErrors() []error { return m }
+func (m UserQuotasMultiError) AllErrors() []error { return m }
 
-// ListWhitelistedDomainsRequestValidationError is the validation error
-// returned by ListWhitelistedDomainsRequest.Validate if the designated
-// constraints aren't met.
-type ListWhitelistedDomainsRequestValidationError struct {
+// UserQuotasValidationError is the validation error returned by
+// UserQuotas.Validate if the designated constraints aren't met.
+type UserQuotasValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14475,24 +20326,22 @@ type ListWhitelistedDomainsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListWhitelistedDomainsRequestValidationError) Field() string { return e.field }
+func (e UserQuotasValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListWhitelistedDomainsRequestValidationError) Reason() string { return e.reason }
+func (e UserQuotasValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListWhitelistedDomainsRequestValidationError) Cause() error { return e.cause }
+func (e UserQuotasValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListWhitelistedDomainsRequestValidationError) Key() bool { return e.key }
+func (e UserQuotasValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListWhitelistedDomainsRequestValidationError) ErrorName() string {
-	return "ListWhitelistedDomainsRequestValidationError"
-}
+func (e UserQuotasValidationError) ErrorName() string { return "UserQuotasValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListWhitelistedDomainsRequestValidationError) Error() string {
+func (e UserQuotasValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14504,14 +20353,14 @@ func (e ListWhitelistedDomainsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListWhitelistedDomainsRequest.%s: %s%s",
+		"invalid %sUserQuotas.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListWhitelistedDomainsRequestValidationError{}
+var _ error = UserQuotasValidationError{}
 
 var _ interface {
 	Field() string
@@ -14519,78 +20368,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListWhitelistedDomainsRequestValidationError{}
+} = UserQuotasValidationError{}
 
-// Validate checks the field values on ListWhitelistedDomainsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on OrganizationQuotas with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListWhitelistedDomainsResponse) Validate() error {
+func (m *OrganizationQuotas) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListWhitelistedDomainsResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ListWhitelistedDomainsResponseMultiError, or nil if none found.
-func (m *ListWhitelistedDomainsResponse) ValidateAll() error {
+// ValidateAll checks the field values on OrganizationQuotas with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrganizationQuotasMultiError, or nil if none found.
+func (m *OrganizationQuotas) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListWhitelistedDomainsResponse) validate(all bool) error {
+func (m *OrganizationQuotas) validate(all bool) error {
 	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	for idx, item := range m.GetDomains() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListWhitelistedDomainsResponseValidationError{
-						field:  fmt.Sprintf("Domains[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListWhitelistedDomainsResponseValidationError{
-						field:  fmt.Sprintf("Domains[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListWhitelistedDomainsResponseValidationError{
-					field:  fmt.Sprintf("Domains[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for Projects
+
+	// no validation rules for Deployments
+
+	// no validation rules for SlotsTotal
+
+	// no validation rules for SlotsPerDeployment
+
+	// no validation rules for OutstandingInvites
+
 	if len(errors) > 0 {
-		return ListWhitelistedDomainsResponseMultiError(errors)
+		return OrganizationQuotasMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListWhitelistedDomainsResponseMultiError is an error wrapping multiple
-// validation errors returned by ListWhitelistedDomainsResponse.ValidateAll()
-// if the designated constraints aren't met.
-type ListWhitelistedDomainsResponseMultiError []error
+// OrganizationQuotasMultiError is an error wrapping multiple validation errors
+// returned by OrganizationQuotas.ValidateAll() if the designated constraints
+// aren't met.
+type OrganizationQuotasMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListWhitelistedDomainsResponseMultiError) Error() string {
+func (m OrganizationQuotasMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14599,12 +20424,11 @@ func (m ListWhitelistedDomainsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListWhitelistedDomainsResponseMultiError) AllErrors() []error { return m }
+func (m OrganizationQuotasMultiError) AllErrors() []error { return m }
 
-// ListWhitelistedDomainsResponseValidationError is the validation error
-// returned by ListWhitelistedDomainsResponse.Validate if the designated
-// constraints aren't met.
-type ListWhitelistedDomainsResponseValidationError struct {
+// OrganizationQuotasValidationError is the validation error returned by
+// OrganizationQuotas.Validate if the designated constraints aren't met.
+type OrganizationQuotasValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14612,24 +20436,24 @@ type ListWhitelistedDomainsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListWhitelistedDomainsResponseValidationError) Field() string { return e.field }
+func (e OrganizationQuotasValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListWhitelistedDomainsResponseValidationError) Reason() string { return e.reason }
+func (e OrganizationQuotasValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListWhitelistedDomainsResponseValidationError) Cause() error { return e.cause }
+func (e OrganizationQuotasValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListWhitelistedDomainsResponseValidationError) Key() bool { return e.key }
+func (e OrganizationQuotasValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListWhitelistedDomainsResponseValidationError) ErrorName() string {
-	return "ListWhitelistedDomainsResponseValidationError"
+func (e OrganizationQuotasValidationError) ErrorName() string {
+	return "OrganizationQuotasValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListWhitelistedDomainsResponseValidationError) Error() string {
+func (e OrganizationQuotasValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14641,14 +20465,14 @@ func (e ListWhitelistedDomainsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListWhitelistedDomainsResponse.%s: %s%s",
+		"invalid %sOrganizationQuotas.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListWhitelistedDomainsResponseValidationError{}
+var _ error = OrganizationQuotasValidationError{}
 
 var _ interface {
 	Field() string
@@ -14656,23 +20480,23 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListWhitelistedDomainsResponseValidationError{}
+} = OrganizationQuotasValidationError{}
 
-// Validate checks the field values on User with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *User) Validate() error {
+// Validate checks the field values on Project with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Project) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on User with the rules defined in the
+// ValidateAll checks the field values on Project with the rules defined in the
 // proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in UserMultiError, or nil if none found.
-func (m *User) ValidateAll() error {
+// a list of violation errors wrapped in ProjectMultiError, or nil if none found.
+func (m *Project) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *User) validate(all bool) error {
+func (m *Project) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -14681,46 +20505,51 @@ func (m *User) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Email
+	// no validation rules for Name
 
-	// no validation rules for DisplayName
+	// no validation rules for OrgId
 
-	// no validation rules for PhotoUrl
+	// no validation rules for OrgName
 
-	if all {
-		switch v := interface{}(m.GetQuotas()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UserValidationError{
-					field:  "Quotas",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UserValidationError{
-					field:  "Quotas",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetQuotas()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UserValidationError{
-				field:  "Quotas",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Description
+
+	// no validation rules for Public
+
+	// no validation rules for Region
+
+	// no validation rules for GithubUrl
+
+	// no validation rules for Subpath
+
+	// no validation rules for ProdBranch
+
+	// no validation rules for ProdOlapDriver
+
+	// no validation rules for ProdOlapDsn
+
+	// no validation rules for ProdSlots
+
+	// no validation rules for ProdDeploymentId
+
+	// no validation rules for FrontendUrl
+
+	// no validation rules for ProdTtlSeconds
+
+	// no validation rules for PreviewEnabled
+
+	// no validation rules for PreviewTtlSeconds
+
+	// no validation rules for GitRemote
+
+	// no validation rules for GitWebhookSecret
+
+	// no validation rules for ArchiveAssetId
 
 	if all {
 		switch v := interface{}(m.GetCreatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UserValidationError{
+				errors = append(errors, ProjectValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -14728,7 +20557,7 @@ func (m *User) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UserValidationError{
+				errors = append(errors, ProjectValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -14737,7 +20566,7 @@ func (m *User) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UserValidationError{
+			return ProjectValidationError{
 				field:  "CreatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -14749,7 +20578,7 @@ func (m *User) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UserValidationError{
+				errors = append(errors, ProjectValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -14757,7 +20586,7 @@ func (m *User) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UserValidationError{
+				errors = append(errors, ProjectValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -14766,7 +20595,7 @@ func (m *User) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UserValidationError{
+			return ProjectValidationError{
 				field:  "UpdatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -14775,18 +20604,18 @@ func (m *User) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UserMultiError(errors)
+		return ProjectMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserMultiError is an error wrapping multiple validation errors returned by
-// User.ValidateAll() if the designated constraints aren't met.
-type UserMultiError []error
+// ProjectMultiError is an error wrapping multiple validation errors returned
+// by Project.ValidateAll() if the designated constraints aren't met.
+type ProjectMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserMultiError) Error() string {
+func (m ProjectMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14795,11 +20624,11 @@ func (m UserMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserMultiError) AllErrors() []error { return m }
+func (m ProjectMultiError) AllErrors() []error { return m }
 
-// UserValidationError is the validation error returned by User.Validate if the
-// designated constraints aren't met.
-type UserValidationError struct {
+// ProjectValidationError is the validation error returned by Project.Validate
+// if the designated constraints aren't met.
+type ProjectValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14807,22 +20636,22 @@ type UserValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserValidationError) Field() string { return e.field }
+func (e ProjectValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserValidationError) Reason() string { return e.reason }
+func (e ProjectValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserValidationError) Cause() error { return e.cause }
+func (e ProjectValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserValidationError) Key() bool { return e.key }
+func (e ProjectValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserValidationError) ErrorName() string { return "UserValidationError" }
+func (e ProjectValidationError) ErrorName() string { return "ProjectValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UserValidationError) Error() string {
+func (e ProjectValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14834,14 +20663,14 @@ func (e UserValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUser.%s: %s%s",
+		"invalid %sProject.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserValidationError{}
+var _ error = ProjectValidationError{}
 
 var _ interface {
 	Field() string
@@ -14849,108 +20678,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserValidationError{}
+} = ProjectValidationError{}
 
-// Validate checks the field values on Service with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on GitCredentials with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Service) Validate() error {
+func (m *GitCredentials) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Service with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in ServiceMultiError, or nil if none found.
-func (m *Service) ValidateAll() error {
+// ValidateAll checks the field values on GitCredentials with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GitCredentialsMultiError, or
+// nil if none found.
+func (m *GitCredentials) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Service) validate(all bool) error {
+func (m *GitCredentials) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Name
-
-	// no validation rules for OrgId
-
-	// no validation rules for OrgName
+	// no validation rules for Username
 
-	if all {
-		switch v := interface{}(m.GetCreatedOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ServiceValidationError{
-					field:  "CreatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ServiceValidationError{
-					field:  "CreatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ServiceValidationError{
-				field:  "CreatedOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Password
 
-	if all {
-		switch v := interface{}(m.GetUpdatedOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ServiceValidationError{
-					field:  "UpdatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ServiceValidationError{
-					field:  "UpdatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ServiceValidationError{
-				field:  "UpdatedOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for PrivateKey
+
+	// no validation rules for PrivateKeyPassword
+
+	// no validation rules for KnownHosts
 
 	if len(errors) > 0 {
-		return ServiceMultiError(errors)
+		return GitCredentialsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ServiceMultiError is an error wrapping multiple validation errors returned
-// by Service.ValidateAll() if the designated constraints aren't met.
-type ServiceMultiError []error
+// GitCredentialsMultiError is an error wrapping multiple validation errors
+// returned by GitCredentials.ValidateAll() if the designated constraints
+// aren't met.
+type GitCredentialsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ServiceMultiError) Error() string {
+func (m GitCredentialsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14959,11 +20734,11 @@ func (m ServiceMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ServiceMultiError) AllErrors() []error { return m }
+func (m GitCredentialsMultiError) AllErrors() []error { return m }
 
-// ServiceValidationError is the validation error returned by Service.Validate
-// if the designated constraints aren't met.
-type ServiceValidationError struct {
+// GitCredentialsValidationError is the validation error returned by
+// GitCredentials.Validate if the designated constraints aren't met.
+type GitCredentialsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14971,22 +20746,22 @@ type ServiceValidationError struct {
 }
 
 // Field function returns field value.
-func (e ServiceValidationError) Field() string { return e.field }
+func (e GitCredentialsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ServiceValidationError) Reason() string { return e.reason }
+func (e GitCredentialsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ServiceValidationError) Cause() error { return e.cause }
+func (e GitCredentialsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ServiceValidationError) Key() bool { return e.key }
+func (e GitCredentialsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ServiceValidationError) ErrorName() string { return "ServiceValidationError" }
+func (e GitCredentialsValidationError) ErrorName() string { return "GitCredentialsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ServiceValidationError) Error() string {
+func (e GitCredentialsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14998,14 +20773,14 @@ func (e ServiceValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sService.%s: %s%s",
+		"invalid %sGitCredentials.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ServiceValidationError{}
+var _ error = GitCredentialsValidationError{}
 
 var _ interface {
 	Field() string
@@ -15013,24 +20788,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ServiceValidationError{}
+} = GitCredentialsValidationError{}
 
-// Validate checks the field values on Organization with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on Deployment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Organization) Validate() error {
+func (m *Deployment) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Organization with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in OrganizationMultiError, or
+// ValidateAll checks the field values on Deployment with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeploymentMultiError, or
 // nil if none found.
-func (m *Organization) ValidateAll() error {
+func (m *Deployment) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Organization) validate(all bool) error {
+func (m *Deployment) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -15039,44 +20814,29 @@ func (m *Organization) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Name
+	// no validation rules for ProjectId
 
-	// no validation rules for Description
+	// no validation rules for Slots
 
-	if all {
-		switch v := interface{}(m.GetQuotas()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, OrganizationValidationError{
-					field:  "Quotas",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, OrganizationValidationError{
-					field:  "Quotas",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetQuotas()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return OrganizationValidationError{
-				field:  "Quotas",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Branch
+
+	// no validation rules for RuntimeHost
+
+	// no validation rules for RuntimeInstanceId
+
+	// no validation rules for Status
+
+	// no validation rules for Logs
+
+	// no validation rules for Preview
+
+	// no validation rules for PreviewTtlSeconds
 
 	if all {
 		switch v := interface{}(m.GetCreatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, OrganizationValidationError{
+				errors = append(errors, DeploymentValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -15084,7 +20844,7 @@ func (m *Organization) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, OrganizationValidationError{
+				errors = append(errors, DeploymentValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -15093,7 +20853,7 @@ func (m *Organization) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return OrganizationValidationError{
+			return DeploymentValidationError{
 				field:  "CreatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -15105,7 +20865,7 @@ func (m *Organization) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, OrganizationValidationError{
+				errors = append(errors, DeploymentValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -15113,7 +20873,7 @@ func (m *Organization) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, OrganizationValidationError{
+				errors = append(errors, DeploymentValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -15122,7 +20882,7 @@ func (m *Organization) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return OrganizationValidationError{
+			return DeploymentValidationError{
 				field:  "UpdatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -15131,119 +20891,18 @@ func (m *Organization) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return OrganizationMultiError(errors)
-	}
-
-	return nil
-}
-
-// OrganizationMultiError is an error wrapping multiple validation errors
-// returned by Organization.ValidateAll() if the designated constraints aren't met.
-type OrganizationMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m OrganizationMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m OrganizationMultiError) AllErrors() []error { return m }
-
-// OrganizationValidationError is the validation error returned by
-// Organization.Validate if the designated constraints aren't met.
-type OrganizationValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e OrganizationValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e OrganizationValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e OrganizationValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e OrganizationValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e OrganizationValidationError) ErrorName() string { return "OrganizationValidationError" }
-
-// Error satisfies the builtin error interface
-func (e OrganizationValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
-
-	key := ""
-	if e.key {
-		key = "key for "
-	}
-
-	return fmt.Sprintf(
-		"invalid %sOrganization.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = OrganizationValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = OrganizationValidationError{}
-
-// Validate checks the field values on UserQuotas with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *UserQuotas) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on UserQuotas with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in UserQuotasMultiError, or
-// nil if none found.
-func (m *UserQuotas) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *UserQuotas) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	// no validation rules for SingleuserOrgs
-
-	if len(errors) > 0 {
-		return UserQuotasMultiError(errors)
+		return DeploymentMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserQuotasMultiError is an error wrapping multiple validation errors
-// returned by UserQuotas.ValidateAll() if the designated constraints aren't met.
-type UserQuotasMultiError []error
+// DeploymentMultiError is an error wrapping multiple validation errors
+// returned by Deployment.ValidateAll() if the designated constraints aren't met.
+type DeploymentMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserQuotasMultiError) Error() string {
+func (m DeploymentMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15252,11 +20911,11 @@ func (m UserQuotasMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserQuotasMultiError) AllErrors() []error { return m }
+func (m DeploymentMultiError) AllErrors() []error { return m }
 
-// UserQuotasValidationError is the validation error returned by
-// UserQuotas.Validate if the designated constraints aren't met.
-type UserQuotasValidationError struct {
+// DeploymentValidationError is the validation error returned by
+// Deployment.Validate if the designated constraints aren't met.
+type DeploymentValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15264,22 +20923,22 @@ type UserQuotasValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserQuotasValidationError) Field() string { return e.field }
+func (e DeploymentValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserQuotasValidationError) Reason() string { return e.reason }
+func (e DeploymentValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserQuotasValidationError) Cause() error { return e.cause }
+func (e DeploymentValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserQuotasValidationError) Key() bool { return e.key }
+func (e DeploymentValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserQuotasValidationError) ErrorName() string { return "UserQuotasValidationError" }
+func (e DeploymentValidationError) ErrorName() string { return "DeploymentValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UserQuotasValidationError) Error() string {
+func (e DeploymentValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15291,14 +20950,14 @@ func (e UserQuotasValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserQuotas.%s: %s%s",
+		"invalid %sDeployment.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserQuotasValidationError{}
+var _ error = DeploymentValidationError{}
 
 var _ interface {
 	Field() string
@@ -15306,54 +20965,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserQuotasValidationError{}
+} = DeploymentValidationError{}
 
-// Validate checks the field values on OrganizationQuotas with the rules
+// Validate checks the field values on OrganizationPermissions with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *OrganizationQuotas) Validate() error {
+func (m *OrganizationPermissions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on OrganizationQuotas with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on OrganizationPermissions with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// OrganizationQuotasMultiError, or nil if none found.
-func (m *OrganizationQuotas) ValidateAll() error {
+// OrganizationPermissionsMultiError, or nil if none found.
+func (m *OrganizationPermissions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OrganizationQuotas) validate(all bool) error {
+func (m *OrganizationPermissions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Projects
+	// no validation rules for ReadOrg
 
-	// no validation rules for Deployments
+	// no validation rules for ManageOrg
 
-	// no validation rules for SlotsTotal
+	// no validation rules for ReadProjects
 
-	// no validation rules for SlotsPerDeployment
+	// no validation rules for CreateProjects
 
-	// no validation rules for OutstandingInvites
+	// no validation rules for ManageProjects
+
+	// no validation rules for ReadOrgMembers
+
+	// no validation rules for ManageOrgMembers
 
 	if len(errors) > 0 {
-		return OrganizationQuotasMultiError(errors)
+		return OrganizationPermissionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// OrganizationQuotasMultiError is an error wrapping multiple validation errors
-// returned by OrganizationQuotas.ValidateAll() if the designated constraints
-// aren't met.
-type OrganizationQuotasMultiError []error
+// OrganizationPermissionsMultiError is an error wrapping multiple validation
+// errors returned by OrganizationPermissions.ValidateAll() if the designated
+// constraints aren't met.
+type OrganizationPermissionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OrganizationQuotasMultiError) Error() string {
+func (m OrganizationPermissionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15362,11 +21025,11 @@ func (m OrganizationQuotasMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OrganizationQuotasMultiError) AllErrors() []error { return m }
+func (m OrganizationPermissionsMultiError) AllErrors() []error { return m }
 
-// OrganizationQuotasValidationError is the validation error returned by
-// OrganizationQuotas.Validate if the designated constraints aren't met.
-type OrganizationQuotasValidationError struct {
+// OrganizationPermissionsValidationError is the validation error returned by
+// OrganizationPermissions.Validate if the designated constraints aren't met.
+type OrganizationPermissionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15374,24 +21037,24 @@ type OrganizationQuotasValidationError struct {
 }
 
 // Field function returns field value.
-func (e OrganizationQuotasValidationError) Field() string { return e.field }
+func (e OrganizationPermissionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OrganizationQuotasValidationError) Reason() string { return e.reason }
+func (e OrganizationPermissionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OrganizationQuotasValidationError) Cause() error { return e.cause }
+func (e OrganizationPermissionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OrganizationQuotasValidationError) Key() bool { return e.key }
+func (e OrganizationPermissionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OrganizationQuotasValidationError) ErrorName() string {
-	return "OrganizationQuotasValidationError"
+func (e OrganizationPermissionsValidationError) ErrorName() string {
+	return "OrganizationPermissionsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e OrganizationQuotasValidationError) Error() string {
+func (e OrganizationPermissionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15403,14 +21066,14 @@ func (e OrganizationQuotasValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOrganizationQuotas.%s: %s%s",
+		"invalid %sOrganizationPermissions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OrganizationQuotasValidationError{}
+var _ error = OrganizationPermissionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -15418,132 +21081,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OrganizationQuotasValidationError{}
+} = OrganizationPermissionsValidationError{}
 
-// Validate checks the field values on Project with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Project) Validate() error {
+// Validate checks the field values on ProjectPermissions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProjectPermissions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Project with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in ProjectMultiError, or nil if none found.
-func (m *Project) ValidateAll() error {
+// ValidateAll checks the field values on ProjectPermissions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProjectPermissionsMultiError, or nil if none found.
+func (m *ProjectPermissions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Project) validate(all bool) error {
+func (m *ProjectPermissions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Name
-
-	// no validation rules for OrgId
-
-	// no validation rules for OrgName
-
-	// no validation rules for Description
-
-	// no validation rules for Public
-
-	// no validation rules for Region
-
-	// no validation rules for GithubUrl
+	// no validation rules for ReadProject
 
-	// no validation rules for Subpath
+	// no validation rules for ManageProject
 
-	// no validation rules for ProdBranch
+	// no validation rules for ReadProd
 
-	// no validation rules for ProdOlapDriver
+	// no validation rules for ReadProdStatus
 
-	// no validation rules for ProdOlapDsn
+	// no validation rules for ManageProd
 
-	// no validation rules for ProdSlots
+	// no validation rules for ReadDev
 
-	// no validation rules for ProdDeploymentId
+	// no validation rules for ReadDevStatus
 
-	// no validation rules for FrontendUrl
+	// no validation rules for ManageDev
 
-	// no validation rules for ProdTtlSeconds
+	// no validation rules for ReadProjectMembers
 
-	if all {
-		switch v := interface{}(m.GetCreatedOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ProjectValidationError{
-					field:  "CreatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ProjectValidationError{
-					field:  "CreatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ProjectValidationError{
-				field:  "CreatedOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for ManageProjectMembers
 
-	if all {
-		switch v := interface{}(m.GetUpdatedOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ProjectValidationError{
-					field:  "UpdatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ProjectValidationError{
-					field:  "UpdatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ProjectValidationError{
-				field:  "UpdatedOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for ReadProjectVariables
 
 	if len(errors) > 0 {
-		return ProjectMultiError(errors)
+		return ProjectPermissionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProjectMultiError is an error wrapping multiple validation errors returned
-// by Project.ValidateAll() if the designated constraints aren't met.
-type ProjectMultiError []error
+// ProjectPermissionsMultiError is an error wrapping multiple validation errors
+// returned by ProjectPermissions.ValidateAll() if the designated constraints
+// aren't met.
+type ProjectPermissionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProjectMultiError) Error() string {
+func (m ProjectPermissionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15552,11 +21149,11 @@ func (m ProjectMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProjectMultiError) AllErrors() []error { return m }
+func (m ProjectPermissionsMultiError) AllErrors() []error { return m }
 
-// ProjectValidationError is the validation error returned by Project.Validate
-// if the designated constraints aren't met.
-type ProjectValidationError struct {
+// ProjectPermissionsValidationError is the validation error returned by
+// ProjectPermissions.Validate if the designated constraints aren't met.
+type ProjectPermissionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15564,22 +21161,24 @@ type ProjectValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProjectValidationError) Field() string { return e.field }
+func (e ProjectPermissionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProjectValidationError) Reason() string { return e.reason }
+func (e ProjectPermissionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProjectValidationError) Cause() error { return e.cause }
+func (e ProjectPermissionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProjectValidationError) Key() bool { return e.key }
+func (e ProjectPermissionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProjectValidationError) ErrorName() string { return "ProjectValidationError" }
+func (e ProjectPermissionsValidationError) ErrorName() string {
+	return "ProjectPermissionsValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ProjectValidationError) Error() string {
+func (e ProjectPermissionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15591,14 +21190,14 @@ func (e ProjectValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProject.%s: %s%s",
+		"invalid %sProjectPermissions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProjectValidationError{}
+var _ error = ProjectPermissionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -15606,51 +21205,42 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProjectValidationError{}
+} = ProjectPermissionsValidationError{}
 
-// Validate checks the field values on Deployment with the rules defined in the
+// Validate checks the field values on Member with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Deployment) Validate() error {
+func (m *Member) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Deployment with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DeploymentMultiError, or
-// nil if none found.
-func (m *Deployment) ValidateAll() error {
+// ValidateAll checks the field values on Member with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MemberMultiError, or nil if none found.
+func (m *Member) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Deployment) validate(all bool) error {
+func (m *Member) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for ProjectId
-
-	// no validation rules for Slots
-
-	// no validation rules for Branch
-
-	// no validation rules for RuntimeHost
+	// no validation rules for UserId
 
-	// no validation rules for RuntimeInstanceId
+	// no validation rules for UserEmail
 
-	// no validation rules for Status
+	// no validation rules for UserName
 
-	// no validation rules for Logs
+	// no validation rules for RoleName
 
 	if all {
 		switch v := interface{}(m.GetCreatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DeploymentValidationError{
+				errors = append(errors, MemberValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -15658,7 +21248,7 @@ func (m *Deployment) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DeploymentValidationError{
+				errors = append(errors, MemberValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -15667,7 +21257,7 @@ func (m *Deployment) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DeploymentValidationError{
+			return MemberValidationError{
 				field:  "CreatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -15679,7 +21269,7 @@ func (m *Deployment) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DeploymentValidationError{
+				errors = append(errors, MemberValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -15687,7 +21277,7 @@ func (m *Deployment) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DeploymentValidationError{
+				errors = append(errors, MemberValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -15696,7 +21286,7 @@ func (m *Deployment) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DeploymentValidationError{
+			return MemberValidationError{
 				field:  "UpdatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -15705,18 +21295,18 @@ func (m *Deployment) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DeploymentMultiError(errors)
+		return MemberMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeploymentMultiError is an error wrapping multiple validation errors
-// returned by Deployment.ValidateAll() if the designated constraints aren't met.
-type DeploymentMultiError []error
+// MemberMultiError is an error wrapping multiple validation errors returned by
+// Member.ValidateAll() if the designated constraints aren't met.
+type MemberMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeploymentMultiError) Error() string {
+func (m MemberMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15725,11 +21315,11 @@ func (m DeploymentMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeploymentMultiError) AllErrors() []error { return m }
+func (m MemberMultiError) AllErrors() []error { return m }
 
-// DeploymentValidationError is the validation error returned by
-// Deployment.Validate if the designated constraints aren't met.
-type DeploymentValidationError struct {
+// MemberValidationError is the validation error returned by Member.Validate if
+// the designated constraints aren't met.
+type MemberValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15737,22 +21327,22 @@ type DeploymentValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeploymentValidationError) Field() string { return e.field }
+func (e MemberValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeploymentValidationError) Reason() string { return e.reason }
+func (e MemberValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeploymentValidationError) Cause() error { return e.cause }
+func (e MemberValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeploymentValidationError) Key() bool { return e.key }
+func (e MemberValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeploymentValidationError) ErrorName() string { return "DeploymentValidationError" }
+func (e MemberValidationError) ErrorName() string { return "MemberValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeploymentValidationError) Error() string {
+func (e MemberValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15764,14 +21354,14 @@ func (e DeploymentValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeployment.%s: %s%s",
+		"invalid %sMember.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeploymentValidationError{}
+var _ error = MemberValidationError{}
 
 var _ interface {
 	Field() string
@@ -15779,58 +21369,109 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeploymentValidationError{}
+} = MemberValidationError{}
 
-// Validate checks the field values on OrganizationPermissions with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *OrganizationPermissions) Validate() error {
+// Validate checks the field values on Usergroup with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Usergroup) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on OrganizationPermissions with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// OrganizationPermissionsMultiError, or nil if none found.
-func (m *OrganizationPermissions) ValidateAll() error {
+// ValidateAll checks the field values on Usergroup with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UsergroupMultiError, or nil
+// if none found.
+func (m *Usergroup) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OrganizationPermissions) validate(all bool) error {
+func (m *Usergroup) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ReadOrg
-
-	// no validation rules for ManageOrg
+	// no validation rules for Id
 
-	// no validation rules for ReadProjects
+	// no validation rules for Name
 
-	// no validation rules for CreateProjects
+	// no validation rules for Description
 
-	// no validation rules for ManageProjects
+	// no validation rules for Managed
 
-	// no validation rules for ReadOrgMembers
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UsergroupValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UsergroupValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UsergroupValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for ManageOrgMembers
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UsergroupValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UsergroupValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UsergroupValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return OrganizationPermissionsMultiError(errors)
+		return UsergroupMultiError(errors)
 	}
 
 	return nil
 }
 
-// OrganizationPermissionsMultiError is an error wrapping multiple validation
-// errors returned by OrganizationPermissions.ValidateAll() if the designated
-// constraints aren't met.
-type OrganizationPermissionsMultiError []error
+// UsergroupMultiError is an error wrapping multiple validation errors returned
+// by Usergroup.ValidateAll() if the designated constraints aren't met.
+type UsergroupMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OrganizationPermissionsMultiError) Error() string {
+func (m UsergroupMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15839,11 +21480,11 @@ func (m OrganizationPermissionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OrganizationPermissionsMultiError) AllErrors() []error { return m }
+func (m UsergroupMultiError) AllErrors() []error { return m }
 
-// OrganizationPermissionsValidationError is the validation error returned by
-// OrganizationPermissions.Validate if the designated constraints aren't met.
-type OrganizationPermissionsValidationError struct {
+// UsergroupValidationError is the validation error returned by
+// Usergroup.Validate if the designated constraints aren't met.
+type UsergroupValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15851,24 +21492,22 @@ type OrganizationPermissionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e OrganizationPermissionsValidationError) Field() string { return e.field }
+func (e UsergroupValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OrganizationPermissionsValidationError) Reason() string { return e.reason }
+func (e UsergroupValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OrganizationPermissionsValidationError) Cause() error { return e.cause }
+func (e UsergroupValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OrganizationPermissionsValidationError) Key() bool { return e.key }
+func (e UsergroupValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OrganizationPermissionsValidationError) ErrorName() string {
-	return "OrganizationPermissionsValidationError"
-}
+func (e UsergroupValidationError) ErrorName() string { return "UsergroupValidationError" }
 
 // Error satisfies the builtin error interface
-func (e OrganizationPermissionsValidationError) Error() string {
+func (e UsergroupValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15880,14 +21519,14 @@ func (e OrganizationPermissionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOrganizationPermissions.%s: %s%s",
+		"invalid %sUsergroup.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OrganizationPermissionsValidationError{}
+var _ error = UsergroupValidationError{}
 
 var _ interface {
 	Field() string
@@ -15895,64 +21534,108 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OrganizationPermissionsValidationError{}
+} = UsergroupValidationError{}
 
-// Validate checks the field values on ProjectPermissions with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ProjectPermissions) Validate() error {
+// Validate checks the field values on MemberUsergroup with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MemberUsergroup) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ProjectPermissions with the rules
+// ValidateAll checks the field values on MemberUsergroup with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ProjectPermissionsMultiError, or nil if none found.
-func (m *ProjectPermissions) ValidateAll() error {
+// MemberUsergroupMultiError, or nil if none found.
+func (m *MemberUsergroup) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ProjectPermissions) validate(all bool) error {
+func (m *MemberUsergroup) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ReadProject
-
-	// no validation rules for ManageProject
-
-	// no validation rules for ReadProd
-
-	// no validation rules for ReadProdStatus
-
-	// no validation rules for ManageProd
-
-	// no validation rules for ReadDev
+	// no validation rules for GroupId
 
-	// no validation rules for ReadDevStatus
+	// no validation rules for GroupName
 
-	// no validation rules for ManageDev
+	// no validation rules for RoleName
 
-	// no validation rules for ReadProjectMembers
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MemberUsergroupValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MemberUsergroupValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MemberUsergroupValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for ManageProjectMembers
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MemberUsergroupValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MemberUsergroupValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MemberUsergroupValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return ProjectPermissionsMultiError(errors)
+		return MemberUsergroupMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProjectPermissionsMultiError is an error wrapping multiple validation errors
-// returned by ProjectPermissions.ValidateAll() if the designated constraints
+// MemberUsergroupMultiError is an error wrapping multiple validation errors
+// returned by MemberUsergroup.ValidateAll() if the designated constraints
 // aren't met.
-type ProjectPermissionsMultiError []error
+type MemberUsergroupMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProjectPermissionsMultiError) Error() string {
+func (m MemberUsergroupMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15961,11 +21644,11 @@ func (m ProjectPermissionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProjectPermissionsMultiError) AllErrors() []error { return m }
+func (m MemberUsergroupMultiError) AllErrors() []error { return m }
 
-// ProjectPermissionsValidationError is the validation error returned by
-// ProjectPermissions.Validate if the designated constraints aren't met.
-type ProjectPermissionsValidationError struct {
+// MemberUsergroupValidationError is the validation error returned by
+// MemberUsergroup.Validate if the designated constraints aren't met.
+type MemberUsergroupValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15973,24 +21656,22 @@ type ProjectPermissionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProjectPermissionsValidationError) Field() string { return e.field }
+func (e MemberUsergroupValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProjectPermissionsValidationError) Reason() string { return e.reason }
+func (e MemberUsergroupValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProjectPermissionsValidationError) Cause() error { return e.cause }
+func (e MemberUsergroupValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProjectPermissionsValidationError) Key() bool { return e.key }
+func (e MemberUsergroupValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProjectPermissionsValidationError) ErrorName() string {
-	return "ProjectPermissionsValidationError"
-}
+func (e MemberUsergroupValidationError) ErrorName() string { return "MemberUsergroupValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ProjectPermissionsValidationError) Error() string {
+func (e MemberUsergroupValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16002,14 +21683,14 @@ func (e ProjectPermissionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProjectPermissions.%s: %s%s",
+		"invalid %sMemberUsergroup.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProjectPermissionsValidationError{}
+var _ error = MemberUsergroupValidationError{}
 
 var _ interface {
 	Field() string
@@ -16017,42 +21698,37 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProjectPermissionsValidationError{}
+} = MemberUsergroupValidationError{}
 
-// Validate checks the field values on Member with the rules defined in the
+// Validate checks the field values on AuditEvent with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Member) Validate() error {
+func (m *AuditEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Member with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in MemberMultiError, or nil if none found.
-func (m *Member) ValidateAll() error {
+// ValidateAll checks the field values on AuditEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditEventMultiError, or
+// nil if none found.
+func (m *AuditEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Member) validate(all bool) error {
+func (m *AuditEvent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
-
-	// no validation rules for UserEmail
-
-	// no validation rules for UserName
-
-	// no validation rules for RoleName
+	// no validation rules for Id
 
 	if all {
 		switch v := interface{}(m.GetCreatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MemberValidationError{
+				errors = append(errors, AuditEventValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -16060,7 +21736,7 @@ func (m *Member) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MemberValidationError{
+				errors = append(errors, AuditEventValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -16069,7 +21745,7 @@ func (m *Member) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MemberValidationError{
+			return AuditEventValidationError{
 				field:  "CreatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -16077,29 +21753,41 @@ func (m *Member) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Project
+
+	// no validation rules for ActorType
+
+	// no validation rules for ActorId
+
+	// no validation rules for ActorEmail
+
+	// no validation rules for Action
+
+	// no validation rules for Target
+
 	if all {
-		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		switch v := interface{}(m.GetDiff()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MemberValidationError{
-					field:  "UpdatedOn",
+				errors = append(errors, AuditEventValidationError{
+					field:  "Diff",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MemberValidationError{
-					field:  "UpdatedOn",
+				errors = append(errors, AuditEventValidationError{
+					field:  "Diff",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDiff()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MemberValidationError{
-				field:  "UpdatedOn",
+			return AuditEventValidationError{
+				field:  "Diff",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -16107,18 +21795,18 @@ func (m *Member) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return MemberMultiError(errors)
+		return AuditEventMultiError(errors)
 	}
 
 	return nil
 }
 
-// MemberMultiError is an error wrapping multiple validation errors returned by
-// Member.ValidateAll() if the designated constraints aren't met.
-type MemberMultiError []error
+// AuditEventMultiError is an error wrapping multiple validation errors
+// returned by AuditEvent.ValidateAll() if the designated constraints aren't met.
+type AuditEventMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MemberMultiError) Error() string {
+func (m AuditEventMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16127,11 +21815,11 @@ func (m MemberMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MemberMultiError) AllErrors() []error { return m }
+func (m AuditEventMultiError) AllErrors() []error { return m }
 
-// MemberValidationError is the validation error returned by Member.Validate if
-// the designated constraints aren't met.
-type MemberValidationError struct {
+// AuditEventValidationError is the validation error returned by
+// AuditEvent.Validate if the designated constraints aren't met.
+type AuditEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16139,22 +21827,22 @@ type MemberValidationError struct {
 }
 
 // Field function returns field value.
-func (e MemberValidationError) Field() string { return e.field }
+func (e AuditEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MemberValidationError) Reason() string { return e.reason }
+func (e AuditEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MemberValidationError) Cause() error { return e.cause }
+func (e AuditEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MemberValidationError) Key() bool { return e.key }
+func (e AuditEventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MemberValidationError) ErrorName() string { return "MemberValidationError" }
+func (e AuditEventValidationError) ErrorName() string { return "AuditEventValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MemberValidationError) Error() string {
+func (e AuditEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16166,14 +21854,14 @@ func (e MemberValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMember.%s: %s%s",
+		"invalid %sAuditEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MemberValidationError{}
+var _ error = AuditEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -16181,7 +21869,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MemberValidationError{}
+} = AuditEventValidationError{}
 
 // Validate checks the field values on UserInvite with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
@@ -19,63 +19,82 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	AdminService_Ping_FullMethodName                         = "/rill.admin.v1.AdminService/Ping"
-	AdminService_ListOrganizations_FullMethodName            = "/rill.admin.v1.AdminService/ListOrganizations"
-	AdminService_GetOrganization_FullMethodName              = "/rill.admin.v1.AdminService/GetOrganization"
-	AdminService_CreateOrganization_FullMethodName           = "/rill.admin.v1.AdminService/CreateOrganization"
-	AdminService_DeleteOrganization_FullMethodName           = "/rill.admin.v1.AdminService/DeleteOrganization"
-	AdminService_UpdateOrganization_FullMethodName           = "/rill.admin.v1.AdminService/UpdateOrganization"
-	AdminService_ListProjectsForOrganization_FullMethodName  = "/rill.admin.v1.AdminService/ListProjectsForOrganization"
-	AdminService_GetProject_FullMethodName                   = "/rill.admin.v1.AdminService/GetProject"
-	AdminService_SearchProjectNames_FullMethodName           = "/rill.admin.v1.AdminService/SearchProjectNames"
-	AdminService_GetProjectVariables_FullMethodName          = "/rill.admin.v1.AdminService/GetProjectVariables"
-	AdminService_CreateProject_FullMethodName                = "/rill.admin.v1.AdminService/CreateProject"
-	AdminService_DeleteProject_FullMethodName                = "/rill.admin.v1.AdminService/DeleteProject"
-	AdminService_UpdateProject_FullMethodName                = "/rill.admin.v1.AdminService/UpdateProject"
-	AdminService_UpdateProjectVariables_FullMethodName       = "/rill.admin.v1.AdminService/UpdateProjectVariables"
-	AdminService_TriggerReconcile_FullMethodName             = "/rill.admin.v1.AdminService/TriggerReconcile"
-	AdminService_TriggerRefreshSources_FullMethodName        = "/rill.admin.v1.AdminService/TriggerRefreshSources"
-	AdminService_TriggerRedeploy_FullMethodName              = "/rill.admin.v1.AdminService/TriggerRedeploy"
-	AdminService_ListOrganizationMembers_FullMethodName      = "/rill.admin.v1.AdminService/ListOrganizationMembers"
-	AdminService_ListOrganizationInvites_FullMethodName      = "/rill.admin.v1.AdminService/ListOrganizationInvites"
-	AdminService_AddOrganizationMember_FullMethodName        = "/rill.admin.v1.AdminService/AddOrganizationMember"
-	AdminService_RemoveOrganizationMember_FullMethodName     = "/rill.admin.v1.AdminService/RemoveOrganizationMember"
-	AdminService_LeaveOrganization_FullMethodName            = "/rill.admin.v1.AdminService/LeaveOrganization"
-	AdminService_SetOrganizationMemberRole_FullMethodName    = "/rill.admin.v1.AdminService/SetOrganizationMemberRole"
-	AdminService_ListProjectMembers_FullMethodName           = "/rill.admin.v1.AdminService/ListProjectMembers"
-	AdminService_ListProjectInvites_FullMethodName           = "/rill.admin.v1.AdminService/ListProjectInvites"
-	AdminService_AddProjectMember_FullMethodName             = "/rill.admin.v1.AdminService/AddProjectMember"
-	AdminService_RemoveProjectMember_FullMethodName          = "/rill.admin.v1.AdminService/RemoveProjectMember"
-	AdminService_SetProjectMemberRole_FullMethodName         = "/rill.admin.v1.AdminService/SetProjectMemberRole"
-	AdminService_GetCurrentUser_FullMethodName               = "/rill.admin.v1.AdminService/GetCurrentUser"
-	AdminService_IssueRepresentativeAuthToken_FullMethodName = "/rill.admin.v1.AdminService/IssueRepresentativeAuthToken"
-	AdminService_RevokeCurrentAuthToken_FullMethodName       = "/rill.admin.v1.AdminService/RevokeCurrentAuthToken"
-	AdminService_GetGithubRepoStatus_FullMethodName          = "/rill.admin.v1.AdminService/GetGithubRepoStatus"
-	AdminService_GetGitCredentials_FullMethodName            = "/rill.admin.v1.AdminService/GetGitCredentials"
-	AdminService_CreateWhitelistedDomain_FullMethodName      = "/rill.admin.v1.AdminService/CreateWhitelistedDomain"
-	AdminService_RemoveWhitelistedDomain_FullMethodName      = "/rill.admin.v1.AdminService/RemoveWhitelistedDomain"
-	AdminService_ListWhitelistedDomains_FullMethodName       = "/rill.admin.v1.AdminService/ListWhitelistedDomains"
-	AdminService_GetUser_FullMethodName                      = "/rill.admin.v1.AdminService/GetUser"
-	AdminService_SearchUsers_FullMethodName                  = "/rill.admin.v1.AdminService/SearchUsers"
-	AdminService_SearchProjectUsers_FullMethodName           = "/rill.admin.v1.AdminService/SearchProjectUsers"
-	AdminService_ListSuperusers_FullMethodName               = "/rill.admin.v1.AdminService/ListSuperusers"
-	AdminService_GetDeploymentCredentials_FullMethodName     = "/rill.admin.v1.AdminService/GetDeploymentCredentials"
-	AdminService_SetSuperuser_FullMethodName                 = "/rill.admin.v1.AdminService/SetSuperuser"
-	AdminService_SudoGetResource_FullMethodName              = "/rill.admin.v1.AdminService/SudoGetResource"
-	AdminService_SudoUpdateUserQuotas_FullMethodName         = "/rill.admin.v1.AdminService/SudoUpdateUserQuotas"
-	AdminService_SudoUpdateOrganizationQuotas_FullMethodName = "/rill.admin.v1.AdminService/SudoUpdateOrganizationQuotas"
-	AdminService_ListServices_FullMethodName                 = "/rill.admin.v1.AdminService/ListServices"
-	AdminService_CreateService_FullMethodName                = "/rill.admin.v1.AdminService/CreateService"
-	AdminService_UpdateService_FullMethodName                = "/rill.admin.v1.AdminService/UpdateService"
-	AdminService_DeleteService_FullMethodName                = "/rill.admin.v1.AdminService/DeleteService"
-	AdminService_ListServiceAuthTokens_FullMethodName        = "/rill.admin.v1.AdminService/ListServiceAuthTokens"
-	AdminService_IssueServiceAuthToken_FullMethodName        = "/rill.admin.v1.AdminService/IssueServiceAuthToken"
-	AdminService_RevokeServiceAuthToken_FullMethodName       = "/rill.admin.v1.AdminService/RevokeServiceAuthToken"
-	AdminService_UpdateUserPreferences_FullMethodName        = "/rill.admin.v1.AdminService/UpdateUserPreferences"
-	AdminService_ListBookmarks_FullMethodName                = "/rill.admin.v1.AdminService/ListBookmarks"
-	AdminService_GetBookmark_FullMethodName                  = "/rill.admin.v1.AdminService/GetBookmark"
-	AdminService_CreateBookmark_FullMethodName               = "/rill.admin.v1.AdminService/CreateBookmark"
-	AdminService_RemoveBookmark_FullMethodName               = "/rill.admin.v1.AdminService/RemoveBookmark"
+	AdminService_Ping_FullMethodName                               = "/rill.admin.v1.AdminService/Ping"
+	AdminService_ListOrganizations_FullMethodName                  = "/rill.admin.v1.AdminService/ListOrganizations"
+	AdminService_GetOrganization_FullMethodName                    = "/rill.admin.v1.AdminService/GetOrganization"
+	AdminService_CreateOrganization_FullMethodName                 = "/rill.admin.v1.AdminService/CreateOrganization"
+	AdminService_DeleteOrganization_FullMethodName                 = "/rill.admin.v1.AdminService/DeleteOrganization"
+	AdminService_UpdateOrganization_FullMethodName                 = "/rill.admin.v1.AdminService/UpdateOrganization"
+	AdminService_ListProjectsForOrganization_FullMethodName        = "/rill.admin.v1.AdminService/ListProjectsForOrganization"
+	AdminService_GetProject_FullMethodName                         = "/rill.admin.v1.AdminService/GetProject"
+	AdminService_SearchProjectNames_FullMethodName                 = "/rill.admin.v1.AdminService/SearchProjectNames"
+	AdminService_GetProjectVariables_FullMethodName                = "/rill.admin.v1.AdminService/GetProjectVariables"
+	AdminService_CreateProject_FullMethodName                      = "/rill.admin.v1.AdminService/CreateProject"
+	AdminService_DeleteProject_FullMethodName                      = "/rill.admin.v1.AdminService/DeleteProject"
+	AdminService_UpdateProject_FullMethodName                      = "/rill.admin.v1.AdminService/UpdateProject"
+	AdminService_UpdateProjectVariables_FullMethodName             = "/rill.admin.v1.AdminService/UpdateProjectVariables"
+	AdminService_TriggerReconcile_FullMethodName                   = "/rill.admin.v1.AdminService/TriggerReconcile"
+	AdminService_TriggerRefreshSources_FullMethodName              = "/rill.admin.v1.AdminService/TriggerRefreshSources"
+	AdminService_TriggerRedeploy_FullMethodName                    = "/rill.admin.v1.AdminService/TriggerRedeploy"
+	AdminService_ListPreviewDeployments_FullMethodName             = "/rill.admin.v1.AdminService/ListPreviewDeployments"
+	AdminService_GetPreviewDeployment_FullMethodName               = "/rill.admin.v1.AdminService/GetPreviewDeployment"
+	AdminService_CreatePreviewDeployment_FullMethodName            = "/rill.admin.v1.AdminService/CreatePreviewDeployment"
+	AdminService_DeletePreviewDeployment_FullMethodName            = "/rill.admin.v1.AdminService/DeletePreviewDeployment"
+	AdminService_ListOrganizationMembers_FullMethodName            = "/rill.admin.v1.AdminService/ListOrganizationMembers"
+	AdminService_ListOrganizationInvites_FullMethodName            = "/rill.admin.v1.AdminService/ListOrganizationInvites"
+	AdminService_ListAuditEvents_FullMethodName                    = "/rill.admin.v1.AdminService/ListAuditEvents"
+	AdminService_AddOrganizationMember_FullMethodName              = "/rill.admin.v1.AdminService/AddOrganizationMember"
+	AdminService_RemoveOrganizationMember_FullMethodName           = "/rill.admin.v1.AdminService/RemoveOrganizationMember"
+	AdminService_LeaveOrganization_FullMethodName                  = "/rill.admin.v1.AdminService/LeaveOrganization"
+	AdminService_SetOrganizationMemberRole_FullMethodName          = "/rill.admin.v1.AdminService/SetOrganizationMemberRole"
+	AdminService_CreateUsergroup_FullMethodName                    = "/rill.admin.v1.AdminService/CreateUsergroup"
+	AdminService_GetUsergroup_FullMethodName                       = "/rill.admin.v1.AdminService/GetUsergroup"
+	AdminService_ListUsergroups_FullMethodName                     = "/rill.admin.v1.AdminService/ListUsergroups"
+	AdminService_EditUsergroup_FullMethodName                      = "/rill.admin.v1.AdminService/EditUsergroup"
+	AdminService_DeleteUsergroup_FullMethodName                    = "/rill.admin.v1.AdminService/DeleteUsergroup"
+	AdminService_ListUsergroupMembers_FullMethodName               = "/rill.admin.v1.AdminService/ListUsergroupMembers"
+	AdminService_AddUsergroupMember_FullMethodName                 = "/rill.admin.v1.AdminService/AddUsergroupMember"
+	AdminService_RemoveUsergroupMember_FullMethodName              = "/rill.admin.v1.AdminService/RemoveUsergroupMember"
+	AdminService_ListOrganizationMemberUsergroups_FullMethodName   = "/rill.admin.v1.AdminService/ListOrganizationMemberUsergroups"
+	AdminService_SetOrganizationMemberUsergroupRole_FullMethodName = "/rill.admin.v1.AdminService/SetOrganizationMemberUsergroupRole"
+	AdminService_RemoveOrganizationMemberUsergroup_FullMethodName  = "/rill.admin.v1.AdminService/RemoveOrganizationMemberUsergroup"
+	AdminService_ListProjectMemberUsergroups_FullMethodName        = "/rill.admin.v1.AdminService/ListProjectMemberUsergroups"
+	AdminService_SetProjectMemberUsergroupRole_FullMethodName      = "/rill.admin.v1.AdminService/SetProjectMemberUsergroupRole"
+	AdminService_RemoveProjectMemberUsergroup_FullMethodName       = "/rill.admin.v1.AdminService/RemoveProjectMemberUsergroup"
+	AdminService_ListProjectMembers_FullMethodName                 = "/rill.admin.v1.AdminService/ListProjectMembers"
+	AdminService_ListProjectInvites_FullMethodName                 = "/rill.admin.v1.AdminService/ListProjectInvites"
+	AdminService_AddProjectMember_FullMethodName                   = "/rill.admin.v1.AdminService/AddProjectMember"
+	AdminService_RemoveProjectMember_FullMethodName                = "/rill.admin.v1.AdminService/RemoveProjectMember"
+	AdminService_SetProjectMemberRole_FullMethodName               = "/rill.admin.v1.AdminService/SetProjectMemberRole"
+	AdminService_GetCurrentUser_FullMethodName                     = "/rill.admin.v1.AdminService/GetCurrentUser"
+	AdminService_IssueRepresentativeAuthToken_FullMethodName       = "/rill.admin.v1.AdminService/IssueRepresentativeAuthToken"
+	AdminService_RevokeCurrentAuthToken_FullMethodName             = "/rill.admin.v1.AdminService/RevokeCurrentAuthToken"
+	AdminService_GetGithubRepoStatus_FullMethodName                = "/rill.admin.v1.AdminService/GetGithubRepoStatus"
+	AdminService_GetGitCredentials_FullMethodName                  = "/rill.admin.v1.AdminService/GetGitCredentials"
+	AdminService_CreateWhitelistedDomain_FullMethodName            = "/rill.admin.v1.AdminService/CreateWhitelistedDomain"
+	AdminService_RemoveWhitelistedDomain_FullMethodName            = "/rill.admin.v1.AdminService/RemoveWhitelistedDomain"
+	AdminService_ListWhitelistedDomains_FullMethodName             = "/rill.admin.v1.AdminService/ListWhitelistedDomains"
+	AdminService_GetUser_FullMethodName                            = "/rill.admin.v1.AdminService/GetUser"
+	AdminService_SearchUsers_FullMethodName                        = "/rill.admin.v1.AdminService/SearchUsers"
+	AdminService_SearchProjectUsers_FullMethodName                 = "/rill.admin.v1.AdminService/SearchProjectUsers"
+	AdminService_ListSuperusers_FullMethodName                     = "/rill.admin.v1.AdminService/ListSuperusers"
+	AdminService_GetDeploymentCredentials_FullMethodName           = "/rill.admin.v1.AdminService/GetDeploymentCredentials"
+	AdminService_SetSuperuser_FullMethodName                       = "/rill.admin.v1.AdminService/SetSuperuser"
+	AdminService_SudoGetResource_FullMethodName                    = "/rill.admin.v1.AdminService/SudoGetResource"
+	AdminService_SudoUpdateUserQuotas_FullMethodName               = "/rill.admin.v1.AdminService/SudoUpdateUserQuotas"
+	AdminService_SudoUpdateOrganizationQuotas_FullMethodName       = "/rill.admin.v1.AdminService/SudoUpdateOrganizationQuotas"
+	AdminService_ListServices_FullMethodName                       = "/rill.admin.v1.AdminService/ListServices"
+	AdminService_CreateService_FullMethodName                      = "/rill.admin.v1.AdminService/CreateService"
+	AdminService_UpdateService_FullMethodName                      = "/rill.admin.v1.AdminService/UpdateService"
+	AdminService_DeleteService_FullMethodName                      = "/rill.admin.v1.AdminService/DeleteService"
+	AdminService_ListServiceAuthTokens_FullMethodName              = "/rill.admin.v1.AdminService/ListServiceAuthTokens"
+	AdminService_IssueServiceAuthToken_FullMethodName              = "/rill.admin.v1.AdminService/IssueServiceAuthToken"
+	AdminService_RevokeServiceAuthToken_FullMethodName             = "/rill.admin.v1.AdminService/RevokeServiceAuthToken"
+	AdminService_UpdateUserPreferences_FullMethodName              = "/rill.admin.v1.AdminService/UpdateUserPreferences"
+	AdminService_ListBookmarks_FullMethodName                      = "/rill.admin.v1.AdminService/ListBookmarks"
+	AdminService_GetBookmark_FullMethodName                        = "/rill.admin.v1.AdminService/GetBookmark"
+	AdminService_CreateBookmark_FullMethodName                     = "/rill.admin.v1.AdminService/CreateBookmark"
+	AdminService_RemoveBookmark_FullMethodName                     = "/rill.admin.v1.AdminService/RemoveBookmark"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -116,10 +135,20 @@ type AdminServiceClient interface {
 	TriggerRefreshSources(ctx context.Context, in *TriggerRefreshSourcesRequest, opts ...grpc.CallOption) (*TriggerRefreshSourcesResponse, error)
 	// TriggerRedeploy creates a new deployment and teardown the old deployment for production deployment
 	TriggerRedeploy(ctx context.Context, in *TriggerRedeployRequest, opts ...grpc.CallOption) (*TriggerRedeployResponse, error)
+	// ListPreviewDeployments lists the preview deployments of a project's non-prod branches
+	ListPreviewDeployments(ctx context.Context, in *ListPreviewDeploymentsRequest, opts ...grpc.CallOption) (*ListPreviewDeploymentsResponse, error)
+	// GetPreviewDeployment returns the preview deployment for a branch and a token for accessing it
+	GetPreviewDeployment(ctx context.Context, in *GetPreviewDeploymentRequest, opts ...grpc.CallOption) (*GetPreviewDeploymentResponse, error)
+	// CreatePreviewDeployment provisions a preview deployment of a non-prod branch
+	CreatePreviewDeployment(ctx context.Context, in *CreatePreviewDeploymentRequest, opts ...grpc.CallOption) (*CreatePreviewDeploymentResponse, error)
+	// DeletePreviewDeployment tears down the preview deployment for a branch
+	DeletePreviewDeployment(ctx context.Context, in *DeletePreviewDeploymentRequest, opts ...grpc.CallOption) (*DeletePreviewDeploymentResponse, error)
 	// ListOrganizationMembers lists all the org members
 	ListOrganizationMembers(ctx context.Context, in *ListOrganizationMembersRequest, opts ...grpc.CallOption) (*ListOrganizationMembersResponse, error)
 	// ListOrganizationInvites lists all the org invites
 	ListOrganizationInvites(ctx context.Context, in *ListOrganizationInvitesRequest, opts ...grpc.CallOption) (*ListOrganizationInvitesResponse, error)
+	// ListAuditEvents lists the log of administrative actions taken in an org, newest first
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
 	// AddOrganizationMember lists all the org members
 	AddOrganizationMember(ctx context.Context, in *AddOrganizationMemberRequest, opts ...grpc.CallOption) (*AddOrganizationMemberResponse, error)
 	// RemoveOrganizationMember removes member from the organization
@@ -128,6 +157,34 @@ type AdminServiceClient interface {
 	LeaveOrganization(ctx context.Context, in *LeaveOrganizationRequest, opts ...grpc.CallOption) (*LeaveOrganizationResponse, error)
 	// SetOrganizationMemberRole sets the role for the member
 	SetOrganizationMemberRole(ctx context.Context, in *SetOrganizationMemberRoleRequest, opts ...grpc.CallOption) (*SetOrganizationMemberRoleResponse, error)
+	// CreateUsergroup creates a user group in the organization
+	CreateUsergroup(ctx context.Context, in *CreateUsergroupRequest, opts ...grpc.CallOption) (*CreateUsergroupResponse, error)
+	// GetUsergroup returns a user group in the organization
+	GetUsergroup(ctx context.Context, in *GetUsergroupRequest, opts ...grpc.CallOption) (*GetUsergroupResponse, error)
+	// ListUsergroups lists the user groups in the organization
+	ListUsergroups(ctx context.Context, in *ListUsergroupsRequest, opts ...grpc.CallOption) (*ListUsergroupsResponse, error)
+	// EditUsergroup renames a user group or changes its description
+	EditUsergroup(ctx context.Context, in *EditUsergroupRequest, opts ...grpc.CallOption) (*EditUsergroupResponse, error)
+	// DeleteUsergroup deletes a user group and its role bindings
+	DeleteUsergroup(ctx context.Context, in *DeleteUsergroupRequest, opts ...grpc.CallOption) (*DeleteUsergroupResponse, error)
+	// ListUsergroupMembers lists the users in a user group
+	ListUsergroupMembers(ctx context.Context, in *ListUsergroupMembersRequest, opts ...grpc.CallOption) (*ListUsergroupMembersResponse, error)
+	// AddUsergroupMember adds an org member to a user group
+	AddUsergroupMember(ctx context.Context, in *AddUsergroupMemberRequest, opts ...grpc.CallOption) (*AddUsergroupMemberResponse, error)
+	// RemoveUsergroupMember removes a user from a user group
+	RemoveUsergroupMember(ctx context.Context, in *RemoveUsergroupMemberRequest, opts ...grpc.CallOption) (*RemoveUsergroupMemberResponse, error)
+	// ListOrganizationMemberUsergroups lists the user groups that have a role on the organization
+	ListOrganizationMemberUsergroups(ctx context.Context, in *ListOrganizationMemberUsergroupsRequest, opts ...grpc.CallOption) (*ListOrganizationMemberUsergroupsResponse, error)
+	// SetOrganizationMemberUsergroupRole grants a user group a role on the organization (replacing its current role, if any)
+	SetOrganizationMemberUsergroupRole(ctx context.Context, in *SetOrganizationMemberUsergroupRoleRequest, opts ...grpc.CallOption) (*SetOrganizationMemberUsergroupRoleResponse, error)
+	// RemoveOrganizationMemberUsergroup removes a user group's role on the organization
+	RemoveOrganizationMemberUsergroup(ctx context.Context, in *RemoveOrganizationMemberUsergroupRequest, opts ...grpc.CallOption) (*RemoveOrganizationMemberUsergroupResponse, error)
+	// ListProjectMemberUsergroups lists the user groups that have a role on the project
+	ListProjectMemberUsergroups(ctx context.Context, in *ListProjectMemberUsergroupsRequest, opts ...grpc.CallOption) (*ListProjectMemberUsergroupsResponse, error)
+	// SetProjectMemberUsergroupRole grants a user group a role on the project (replacing its current role, if any)
+	SetProjectMemberUsergroupRole(ctx context.Context, in *SetProjectMemberUsergroupRoleRequest, opts ...grpc.CallOption) (*SetProjectMemberUsergroupRoleResponse, error)
+	// RemoveProjectMemberUsergroup removes a user group's role on the project
+	RemoveProjectMemberUsergroup(ctx context.Context, in *RemoveProjectMemberUsergroupRequest, opts ...grpc.CallOption) (*RemoveProjectMemberUsergroupResponse, error)
 	// ListProjectMembers lists all the project members
 	ListProjectMembers(ctx context.Context, in *ListProjectMembersRequest, opts ...grpc.CallOption) (*ListProjectMembersResponse, error)
 	// ListProjectInvites lists all the project invites
@@ -360,6 +417,42 @@ func (c *adminServiceClient) TriggerRedeploy(ctx context.Context, in *TriggerRed
 	return out, nil
 }
 
+func (c *adminServiceClient) ListPreviewDeployments(ctx context.Context, in *ListPreviewDeploymentsRequest, opts ...grpc.CallOption) (*ListPreviewDeploymentsResponse, error) {
+	out := new(ListPreviewDeploymentsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListPreviewDeployments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetPreviewDeployment(ctx context.Context, in *GetPreviewDeploymentRequest, opts ...grpc.CallOption) (*GetPreviewDeploymentResponse, error) {
+	out := new(GetPreviewDeploymentResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetPreviewDeployment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreatePreviewDeployment(ctx context.Context, in *CreatePreviewDeploymentRequest, opts ...grpc.CallOption) (*CreatePreviewDeploymentResponse, error) {
+	out := new(CreatePreviewDeploymentResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreatePreviewDeployment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeletePreviewDeployment(ctx context.Context, in *DeletePreviewDeploymentRequest, opts ...grpc.CallOption) (*DeletePreviewDeploymentResponse, error) {
+	out := new(DeletePreviewDeploymentResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeletePreviewDeployment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) ListOrganizationMembers(ctx context.Context, in *ListOrganizationMembersRequest, opts ...grpc.CallOption) (*ListOrganizationMembersResponse, error) {
 	out := new(ListOrganizationMembersResponse)
 	err := c.cc.Invoke(ctx, AdminService_ListOrganizationMembers_FullMethodName, in, out, opts...)
@@ -378,6 +471,15 @@ func (c *adminServiceClient) ListOrganizationInvites(ctx context.Context, in *Li
 	return out, nil
 }
 
+func (c *adminServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListAuditEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) AddOrganizationMember(ctx context.Context, in *AddOrganizationMemberRequest, opts ...grpc.CallOption) (*AddOrganizationMemberResponse, error) {
 	out := new(AddOrganizationMemberResponse)
 	err := c.cc.Invoke(ctx, AdminService_AddOrganizationMember_FullMethodName, in, out, opts...)
@@ -414,6 +516,132 @@ func (c *adminServiceClient) SetOrganizationMemberRole(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *adminServiceClient) CreateUsergroup(ctx context.Context, in *CreateUsergroupRequest, opts ...grpc.CallOption) (*CreateUsergroupResponse, error) {
+	out := new(CreateUsergroupResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateUsergroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetUsergroup(ctx context.Context, in *GetUsergroupRequest, opts ...grpc.CallOption) (*GetUsergroupResponse, error) {
+	out := new(GetUsergroupResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetUsergroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListUsergroups(ctx context.Context, in *ListUsergroupsRequest, opts ...grpc.CallOption) (*ListUsergroupsResponse, error) {
+	out := new(ListUsergroupsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListUsergroups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) EditUsergroup(ctx context.Context, in *EditUsergroupRequest, opts ...grpc.CallOption) (*EditUsergroupResponse, error) {
+	out := new(EditUsergroupResponse)
+	err := c.cc.Invoke(ctx, AdminService_EditUsergroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteUsergroup(ctx context.Context, in *DeleteUsergroupRequest, opts ...grpc.CallOption) (*DeleteUsergroupResponse, error) {
+	out := new(DeleteUsergroupResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteUsergroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListUsergroupMembers(ctx context.Context, in *ListUsergroupMembersRequest, opts ...grpc.CallOption) (*ListUsergroupMembersResponse, error) {
+	out := new(ListUsergroupMembersResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListUsergroupMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AddUsergroupMember(ctx context.Context, in *AddUsergroupMemberRequest, opts ...grpc.CallOption) (*AddUsergroupMemberResponse, error) {
+	out := new(AddUsergroupMemberResponse)
+	err := c.cc.Invoke(ctx, AdminService_AddUsergroupMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RemoveUsergroupMember(ctx context.Context, in *RemoveUsergroupMemberRequest, opts ...grpc.CallOption) (*RemoveUsergroupMemberResponse, error) {
+	out := new(RemoveUsergroupMemberResponse)
+	err := c.cc.Invoke(ctx, AdminService_RemoveUsergroupMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListOrganizationMemberUsergroups(ctx context.Context, in *ListOrganizationMemberUsergroupsRequest, opts ...grpc.CallOption) (*ListOrganizationMemberUsergroupsResponse, error) {
+	out := new(ListOrganizationMemberUsergroupsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListOrganizationMemberUsergroups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetOrganizationMemberUsergroupRole(ctx context.Context, in *SetOrganizationMemberUsergroupRoleRequest, opts ...grpc.CallOption) (*SetOrganizationMemberUsergroupRoleResponse, error) {
+	out := new(SetOrganizationMemberUsergroupRoleResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetOrganizationMemberUsergroupRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RemoveOrganizationMemberUsergroup(ctx context.Context, in *RemoveOrganizationMemberUsergroupRequest, opts ...grpc.CallOption) (*RemoveOrganizationMemberUsergroupResponse, error) {
+	out := new(RemoveOrganizationMemberUsergroupResponse)
+	err := c.cc.Invoke(ctx, AdminService_RemoveOrganizationMemberUsergroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListProjectMemberUsergroups(ctx context.Context, in *ListProjectMemberUsergroupsRequest, opts ...grpc.CallOption) (*ListProjectMemberUsergroupsResponse, error) {
+	out := new(ListProjectMemberUsergroupsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListProjectMemberUsergroups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetProjectMemberUsergroupRole(ctx context.Context, in *SetProjectMemberUsergroupRoleRequest, opts ...grpc.CallOption) (*SetProjectMemberUsergroupRoleResponse, error) {
+	out := new(SetProjectMemberUsergroupRoleResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetProjectMemberUsergroupRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RemoveProjectMemberUsergroup(ctx context.Context, in *RemoveProjectMemberUsergroupRequest, opts ...grpc.CallOption) (*RemoveProjectMemberUsergroupResponse, error) {
+	out := new(RemoveProjectMemberUsergroupResponse)
+	err := c.cc.Invoke(ctx, AdminService_RemoveProjectMemberUsergroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) ListProjectMembers(ctx context.Context, in *ListProjectMembersRequest, opts ...grpc.CallOption) (*ListProjectMembersResponse, error) {
 	out := new(ListProjectMembersResponse)
 	err := c.cc.Invoke(ctx, AdminService_ListProjectMembers_FullMethodName, in, out, opts...)
@@ -758,10 +986,20 @@ type AdminServiceServer interface {
 	TriggerRefreshSources(context.Context, *TriggerRefreshSourcesRequest) (*TriggerRefreshSourcesResponse, error)
 	// TriggerRedeploy creates a new deployment and teardown the old deployment for production deployment
 	TriggerRedeploy(context.Context, *TriggerRedeployRequest) (*TriggerRedeployResponse, error)
+	// ListPreviewDeployments lists the preview deployments of a project's non-prod branches
+	ListPreviewDeployments(context.Context, *ListPreviewDeploymentsRequest) (*ListPreviewDeploymentsResponse, error)
+	// GetPreviewDeployment returns the preview deployment for a branch and a token for accessing it
+	GetPreviewDeployment(context.Context, *GetPreviewDeploymentRequest) (*GetPreviewDeploymentResponse, error)
+	// CreatePreviewDeployment provisions a preview deployment of a non-prod branch
+	CreatePreviewDeployment(context.Context, *CreatePreviewDeploymentRequest) (*CreatePreviewDeploymentResponse, error)
+	// DeletePreviewDeployment tears down the preview deployment for a branch
+	DeletePreviewDeployment(context.Context, *DeletePreviewDeploymentRequest) (*DeletePreviewDeploymentResponse, error)
 	// ListOrganizationMembers lists all the org members
 	ListOrganizationMembers(context.Context, *ListOrganizationMembersRequest) (*ListOrganizationMembersResponse, error)
 	// ListOrganizationInvites lists all the org invites
 	ListOrganizationInvites(context.Context, *ListOrganizationInvitesRequest) (*ListOrganizationInvitesResponse, error)
+	// ListAuditEvents lists the log of administrative actions taken in an org, newest first
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
 	// AddOrganizationMember lists all the org members
 	AddOrganizationMember(context.Context, *AddOrganizationMemberRequest) (*AddOrganizationMemberResponse, error)
 	// RemoveOrganizationMember removes member from the organization
@@ -770,6 +1008,34 @@ type AdminServiceServer interface {
 	LeaveOrganization(context.Context, *LeaveOrganizationRequest) (*LeaveOrganizationResponse, error)
 	// SetOrganizationMemberRole sets the role for the member
 	SetOrganizationMemberRole(context.Context, *SetOrganizationMemberRoleRequest) (*SetOrganizationMemberRoleResponse, error)
+	// CreateUsergroup creates a user group in the organization
+	CreateUsergroup(context.Context, *CreateUsergroupRequest) (*CreateUsergroupResponse, error)
+	// GetUsergroup returns a user group in the organization
+	GetUsergroup(context.Context, *GetUsergroupRequest) (*GetUsergroupResponse, error)
+	// ListUsergroups lists the user groups in the organization
+	ListUsergroups(context.Context, *ListUsergroupsRequest) (*ListUsergroupsResponse, error)
+	// EditUsergroup renames a user group or changes its description
+	EditUsergroup(context.Context, *EditUsergroupRequest) (*EditUsergroupResponse, error)
+	// DeleteUsergroup deletes a user group and its role bindings
+	DeleteUsergroup(context.Context, *DeleteUsergroupRequest) (*DeleteUsergroupResponse, error)
+	// ListUsergroupMembers lists the users in a user group
+	ListUsergroupMembers(context.Context, *ListUsergroupMembersRequest) (*ListUsergroupMembersResponse, error)
+	// AddUsergroupMember adds an org member to a user group
+	AddUsergroupMember(context.Context, *AddUsergroupMemberRequest) (*AddUsergroupMemberResponse, error)
+	// RemoveUsergroupMember removes a user from a user group
+	RemoveUsergroupMember(context.Context, *RemoveUsergroupMemberRequest) (*RemoveUsergroupMemberResponse, error)
+	// ListOrganizationMemberUsergroups lists the user groups that have a role on the organization
+	ListOrganizationMemberUsergroups(context.Context, *ListOrganizationMemberUsergroupsRequest) (*ListOrganizationMemberUsergroupsResponse, error)
+	// SetOrganizationMemberUsergroupRole grants a user group a role on the organization (replacing its current role, if any)
+	SetOrganizationMemberUsergroupRole(context.Context, *SetOrganizationMemberUsergroupRoleRequest) (*SetOrganizationMemberUsergroupRoleResponse, error)
+	// RemoveOrganizationMemberUsergroup removes a user group's role on the organization
+	RemoveOrganizationMemberUsergroup(context.Context, *RemoveOrganizationMemberUsergroupRequest) (*RemoveOrganizationMemberUsergroupResponse, error)
+	// ListProjectMemberUsergroups lists the user groups that have a role on the project
+	ListProjectMemberUsergroups(context.Context, *ListProjectMemberUsergroupsRequest) (*ListProjectMemberUsergroupsResponse, error)
+	// SetProjectMemberUsergroupRole grants a user group a role on the project (replacing its current role, if any)
+	SetProjectMemberUsergroupRole(context.Context, *SetProjectMemberUsergroupRoleRequest) (*SetProjectMemberUsergroupRoleResponse, error)
+	// RemoveProjectMemberUsergroup removes a user group's role on the project
+	RemoveProjectMemberUsergroup(context.Context, *RemoveProjectMemberUsergroupRequest) (*RemoveProjectMemberUsergroupResponse, error)
 	// ListProjectMembers lists all the project members
 	ListProjectMembers(context.Context, *ListProjectMembersRequest) (*ListProjectMembersResponse, error)
 	// ListProjectInvites lists all the project invites
@@ -897,12 +1163,27 @@ func (UnimplementedAdminServiceServer) TriggerRefreshSources(context.Context, *T
 func (UnimplementedAdminServiceServer) TriggerRedeploy(context.Context, *TriggerRedeployRequest) (*TriggerRedeployResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TriggerRedeploy not implemented")
 }
+func (UnimplementedAdminServiceServer) ListPreviewDeployments(context.Context, *ListPreviewDeploymentsRequest) (*ListPreviewDeploymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPreviewDeployments not implemented")
+}
+func (UnimplementedAdminServiceServer) GetPreviewDeployment(context.Context, *GetPreviewDeploymentRequest) (*GetPreviewDeploymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPreviewDeployment not implemented")
+}
+func (UnimplementedAdminServiceServer) CreatePreviewDeployment(context.Context, *CreatePreviewDeploymentRequest) (*CreatePreviewDeploymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePreviewDeployment not implemented")
+}
+func (UnimplementedAdminServiceServer) DeletePreviewDeployment(context.Context, *DeletePreviewDeploymentRequest) (*DeletePreviewDeploymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePreviewDeployment not implemented")
+}
 func (UnimplementedAdminServiceServer) ListOrganizationMembers(context.Context, *ListOrganizationMembersRequest) (*ListOrganizationMembersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizationMembers not implemented")
 }
 func (UnimplementedAdminServiceServer) ListOrganizationInvites(context.Context, *ListOrganizationInvitesRequest) (*ListOrganizationInvitesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizationInvites not implemented")
 }
+func (UnimplementedAdminServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
 func (UnimplementedAdminServiceServer) AddOrganizationMember(context.Context, *AddOrganizationMemberRequest) (*AddOrganizationMemberResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddOrganizationMember not implemented")
 }
@@ -915,6 +1196,48 @@ func (UnimplementedAdminServiceServer) LeaveOrganization(context.Context, *Leave
 func (UnimplementedAdminServiceServer) SetOrganizationMemberRole(context.Context, *SetOrganizationMemberRoleRequest) (*SetOrganizationMemberRoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetOrganizationMemberRole not implemented")
 }
+func (UnimplementedAdminServiceServer) CreateUsergroup(context.Context, *CreateUsergroupRequest) (*CreateUsergroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUsergroup not implemented")
+}
+func (UnimplementedAdminServiceServer) GetUsergroup(context.Context, *GetUsergroupRequest) (*GetUsergroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsergroup not implemented")
+}
+func (UnimplementedAdminServiceServer) ListUsergroups(context.Context, *ListUsergroupsRequest) (*ListUsergroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsergroups not implemented")
+}
+func (UnimplementedAdminServiceServer) EditUsergroup(context.Context, *EditUsergroupRequest) (*EditUsergroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditUsergroup not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteUsergroup(context.Context, *DeleteUsergroupRequest) (*DeleteUsergroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUsergroup not implemented")
+}
+func (UnimplementedAdminServiceServer) ListUsergroupMembers(context.Context, *ListUsergroupMembersRequest) (*ListUsergroupMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsergroupMembers not implemented")
+}
+func (UnimplementedAdminServiceServer) AddUsergroupMember(context.Context, *AddUsergroupMemberRequest) (*AddUsergroupMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddUsergroupMember not implemented")
+}
+func (UnimplementedAdminServiceServer) RemoveUsergroupMember(context.Context, *RemoveUsergroupMemberRequest) (*RemoveUsergroupMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveUsergroupMember not implemented")
+}
+func (UnimplementedAdminServiceServer) ListOrganizationMemberUsergroups(context.Context, *ListOrganizationMemberUsergroupsRequest) (*ListOrganizationMemberUsergroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizationMemberUsergroups not implemented")
+}
+func (UnimplementedAdminServiceServer) SetOrganizationMemberUsergroupRole(context.Context, *SetOrganizationMemberUsergroupRoleRequest) (*SetOrganizationMemberUsergroupRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOrganizationMemberUsergroupRole not implemented")
+}
+func (UnimplementedAdminServiceServer) RemoveOrganizationMemberUsergroup(context.Context, *RemoveOrganizationMemberUsergroupRequest) (*RemoveOrganizationMemberUsergroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOrganizationMemberUsergroup not implemented")
+}
+func (UnimplementedAdminServiceServer) ListProjectMemberUsergroups(context.Context, *ListProjectMemberUsergroupsRequest) (*ListProjectMemberUsergroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjectMemberUsergroups not implemented")
+}
+func (UnimplementedAdminServiceServer) SetProjectMemberUsergroupRole(context.Context, *SetProjectMemberUsergroupRoleRequest) (*SetProjectMemberUsergroupRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProjectMemberUsergroupRole not implemented")
+}
+func (UnimplementedAdminServiceServer) RemoveProjectMemberUsergroup(context.Context, *RemoveProjectMemberUsergroupRequest) (*RemoveProjectMemberUsergroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveProjectMemberUsergroup not implemented")
+}
 func (UnimplementedAdminServiceServer) ListProjectMembers(context.Context, *ListProjectMembersRequest) (*ListProjectMembersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProjectMembers not implemented")
 }
@@ -1336,6 +1659,78 @@ func _AdminService_TriggerRedeploy_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ListPreviewDeployments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPreviewDeploymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListPreviewDeployments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListPreviewDeployments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListPreviewDeployments(ctx, req.(*ListPreviewDeploymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetPreviewDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPreviewDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetPreviewDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetPreviewDeployment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetPreviewDeployment(ctx, req.(*GetPreviewDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreatePreviewDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePreviewDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreatePreviewDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreatePreviewDeployment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreatePreviewDeployment(ctx, req.(*CreatePreviewDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeletePreviewDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePreviewDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeletePreviewDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeletePreviewDeployment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeletePreviewDeployment(ctx, req.(*DeletePreviewDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_ListOrganizationMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListOrganizationMembersRequest)
 	if err := dec(in); err != nil {
@@ -1372,6 +1767,24 @@ func _AdminService_ListOrganizationInvites_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_AddOrganizationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddOrganizationMemberRequest)
 	if err := dec(in); err != nil {
@@ -1444,6 +1857,258 @@ func _AdminService_SetOrganizationMemberRole_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_CreateUsergroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUsergroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateUsergroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateUsergroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateUsergroup(ctx, req.(*CreateUsergroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetUsergroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsergroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetUsergroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetUsergroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetUsergroup(ctx, req.(*GetUsergroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListUsergroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsergroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListUsergroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListUsergroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListUsergroups(ctx, req.(*ListUsergroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_EditUsergroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditUsergroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).EditUsergroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_EditUsergroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).EditUsergroup(ctx, req.(*EditUsergroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteUsergroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUsergroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteUsergroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteUsergroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteUsergroup(ctx, req.(*DeleteUsergroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListUsergroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsergroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListUsergroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListUsergroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListUsergroupMembers(ctx, req.(*ListUsergroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AddUsergroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddUsergroupMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AddUsergroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AddUsergroupMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AddUsergroupMember(ctx, req.(*AddUsergroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemoveUsergroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveUsergroupMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemoveUsergroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RemoveUsergroupMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemoveUsergroupMember(ctx, req.(*RemoveUsergroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListOrganizationMemberUsergroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationMemberUsergroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListOrganizationMemberUsergroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListOrganizationMemberUsergroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListOrganizationMemberUsergroups(ctx, req.(*ListOrganizationMemberUsergroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetOrganizationMemberUsergroupRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOrganizationMemberUsergroupRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetOrganizationMemberUsergroupRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetOrganizationMemberUsergroupRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetOrganizationMemberUsergroupRole(ctx, req.(*SetOrganizationMemberUsergroupRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemoveOrganizationMemberUsergroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveOrganizationMemberUsergroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemoveOrganizationMemberUsergroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RemoveOrganizationMemberUsergroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemoveOrganizationMemberUsergroup(ctx, req.(*RemoveOrganizationMemberUsergroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListProjectMemberUsergroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectMemberUsergroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListProjectMemberUsergroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListProjectMemberUsergroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListProjectMemberUsergroups(ctx, req.(*ListProjectMemberUsergroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetProjectMemberUsergroupRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProjectMemberUsergroupRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetProjectMemberUsergroupRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetProjectMemberUsergroupRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetProjectMemberUsergroupRole(ctx, req.(*SetProjectMemberUsergroupRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemoveProjectMemberUsergroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveProjectMemberUsergroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemoveProjectMemberUsergroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RemoveProjectMemberUsergroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemoveProjectMemberUsergroup(ctx, req.(*RemoveProjectMemberUsergroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_ListProjectMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListProjectMembersRequest)
 	if err := dec(in); err != nil {
@@ -2131,6 +2796,22 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TriggerRedeploy",
 			Handler:    _AdminService_TriggerRedeploy_Handler,
 		},
+		{
+			MethodName: "ListPreviewDeployments",
+			Handler:    _AdminService_ListPreviewDeployments_Handler,
+		},
+		{
+			MethodName: "GetPreviewDeployment",
+			Handler:    _AdminService_GetPreviewDeployment_Handler,
+		},
+		{
+			MethodName: "CreatePreviewDeployment",
+			Handler:    _AdminService_CreatePreviewDeployment_Handler,
+		},
+		{
+			MethodName: "DeletePreviewDeployment",
+			Handler:    _AdminService_DeletePreviewDeployment_Handler,
+		},
 		{
 			MethodName: "ListOrganizationMembers",
 			Handler:    _AdminService_ListOrganizationMembers_Handler,
@@ -2139,6 +2820,10 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListOrganizationInvites",
 			Handler:    _AdminService_ListOrganizationInvites_Handler,
 		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _AdminService_ListAuditEvents_Handler,
+		},
 		{
 			MethodName: "AddOrganizationMember",
 			Handler:    _AdminService_AddOrganizationMember_Handler,
@@ -2155,6 +2840,62 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetOrganizationMemberRole",
 			Handler:    _AdminService_SetOrganizationMemberRole_Handler,
 		},
+		{
+			MethodName: "CreateUsergroup",
+			Handler:    _AdminService_CreateUsergroup_Handler,
+		},
+		{
+			MethodName: "GetUsergroup",
+			Handler:    _AdminService_GetUsergroup_Handler,
+		},
+		{
+			MethodName: "ListUsergroups",
+			Handler:    _AdminService_ListUsergroups_Handler,
+		},
+		{
+			MethodName: "EditUsergroup",
+			Handler:    _AdminService_EditUsergroup_Handler,
+		},
+		{
+			MethodName: "DeleteUsergroup",
+			Handler:    _AdminService_DeleteUsergroup_Handler,
+		},
+		{
+			MethodName: "ListUsergroupMembers",
+			Handler:    _AdminService_ListUsergroupMembers_Handler,
+		},
+		{
+			MethodName: "AddUsergroupMember",
+			Handler:    _AdminService_AddUsergroupMember_Handler,
+		},
+		{
+			MethodName: "RemoveUsergroupMember",
+			Handler:    _AdminService_RemoveUsergroupMember_Handler,
+		},
+		{
+			MethodName: "ListOrganizationMemberUsergroups",
+			Handler:    _AdminService_ListOrganizationMemberUsergroups_Handler,
+		},
+		{
+			MethodName: "SetOrganizationMemberUsergroupRole",
+			Handler:    _AdminService_SetOrganizationMemberUsergroupRole_Handler,
+		},
+		{
+			MethodName: "RemoveOrganizationMemberUsergroup",
+			Handler:    _AdminService_RemoveOrganizationMemberUsergroup_Handler,
+		},
+		{
+			MethodName: "ListProjectMemberUsergroups",
+			Handler:    _AdminService_ListProjectMemberUsergroups_Handler,
+		},
+		{
+			MethodName: "SetProjectMemberUsergroupRole",
+			Handler:    _AdminService_SetProjectMemberUsergroupRole_Handler,
+		},
+		{
+			MethodName: "RemoveProjectMemberUsergroup",
+			Handler:    _AdminService_RemoveProjectMemberUsergroup_Handler,
+		},
 		{
 			MethodName: "ListProjectMembers",
 			Handler:    _AdminService_ListProjectMembers_Handler,
@@ -343,6 +343,35 @@ func (m *Instance) validate(all bool) error {
 
 	}
 
+	if all {
+		switch v := interface{}(m.GetQueryLimits()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InstanceValidationError{
+					field:  "QueryLimits",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InstanceValidationError{
+					field:  "QueryLimits",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQueryLimits()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InstanceValidationError{
+				field:  "QueryLimits",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return InstanceMultiError(errors)
 	}
@@ -527,6 +556,117 @@ var _ interface {
 	ErrorName() string
 } = ConnectorValidationError{}
 
+// Validate checks the field values on QueryLimits with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QueryLimits) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryLimits with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QueryLimitsMultiError, or
+// nil if none found.
+func (m *QueryLimits) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryLimits) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MaxScannedRows
+
+	// no validation rules for MaxScannedColumns
+
+	// no validation rules for MaxResultRows
+
+	// no validation rules for MaxResultBytes
+
+	// no validation rules for MaxConcurrentQueriesPerUser
+
+	// no validation rules for Downgrade
+
+	if len(errors) > 0 {
+		return QueryLimitsMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryLimitsMultiError is an error wrapping multiple validation errors
+// returned by QueryLimits.ValidateAll() if the designated constraints aren't met.
+type QueryLimitsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryLimitsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryLimitsMultiError) AllErrors() []error { return m }
+
+// QueryLimitsValidationError is the validation error returned by
+// QueryLimits.Validate if the designated constraints aren't met.
+type QueryLimitsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryLimitsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryLimitsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryLimitsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryLimitsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryLimitsValidationError) ErrorName() string { return "QueryLimitsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QueryLimitsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryLimits.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryLimitsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryLimitsValidationError{}
+
 // Validate checks the field values on ListInstancesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1100,6 +1240,35 @@ func (m *CreateInstanceRequest) validate(all bool) error {
 
 	}
 
+	if all {
+		switch v := interface{}(m.GetQueryLimits()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateInstanceRequestValidationError{
+					field:  "QueryLimits",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateInstanceRequestValidationError{
+					field:  "QueryLimits",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQueryLimits()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateInstanceRequestValidationError{
+				field:  "QueryLimits",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return CreateInstanceRequestMultiError(errors)
 	}
@@ -1601,6 +1770,35 @@ func (m *EditInstanceRequest) validate(all bool) error {
 
 	// no validation rules for Annotations
 
+	if all {
+		switch v := interface{}(m.GetQueryLimits()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EditInstanceRequestValidationError{
+					field:  "QueryLimits",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EditInstanceRequestValidationError{
+					field:  "QueryLimits",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQueryLimits()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EditInstanceRequestValidationError{
+				field:  "QueryLimits",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.OlapConnector != nil {
 		// no validation rules for OlapConnector
 	}
@@ -5931,6 +6129,749 @@ var _ interface {
 	ErrorName() string
 } = GetResourceResponseValidationError{}
 
+// Validate checks the field values on GetColumnLineageRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *GetColumnLineageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetColumnLineageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetColumnLineageRequestMultiError, or nil if none found.
+func (m *GetColumnLineageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetColumnLineageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	if all {
+		switch v := interface{}(m.GetName()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetColumnLineageRequestValidationError{
+					field:  "Name",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetColumnLineageRequestValidationError{
+					field:  "Name",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetName()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetColumnLineageRequestValidationError{
+				field:  "Name",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Column
+
+	if len(errors) > 0 {
+		return GetColumnLineageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetColumnLineageRequestMultiError is an error wrapping multiple validation
+// errors returned by GetColumnLineageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetColumnLineageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetColumnLineageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetColumnLineageRequestMultiError) AllErrors() []error { return m }
+
+// GetColumnLineageRequestValidationError is the validation error returned by
+// GetColumnLineageRequest.Validate if the designated constraints aren't met.
+type GetColumnLineageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetColumnLineageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetColumnLineageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetColumnLineageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetColumnLineageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetColumnLineageRequestValidationError) ErrorName() string {
+	return "GetColumnLineageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetColumnLineageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetColumnLineageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetColumnLineageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetColumnLineageRequestValidationError{}
+
+// Validate checks the field values on GetColumnLineageResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *GetColumnLineageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetColumnLineageResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetColumnLineageResponseMultiError, or nil if none found.
+func (m *GetColumnLineageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetColumnLineageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUpstream() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetColumnLineageResponseValidationError{
+						field:  fmt.Sprintf("Upstream[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetColumnLineageResponseValidationError{
+						field:  fmt.Sprintf("Upstream[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetColumnLineageResponseValidationError{
+					field:  fmt.Sprintf("Upstream[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetDownstream() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetColumnLineageResponseValidationError{
+						field:  fmt.Sprintf("Downstream[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetColumnLineageResponseValidationError{
+						field:  fmt.Sprintf("Downstream[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetColumnLineageResponseValidationError{
+					field:  fmt.Sprintf("Downstream[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetColumnLineageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetColumnLineageResponseMultiError is an error wrapping multiple validation
+// errors returned by GetColumnLineageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetColumnLineageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetColumnLineageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetColumnLineageResponseMultiError) AllErrors() []error { return m }
+
+// GetColumnLineageResponseValidationError is the validation error returned by
+// GetColumnLineageResponse.Validate if the designated constraints aren't met.
+type GetColumnLineageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetColumnLineageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetColumnLineageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetColumnLineageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetColumnLineageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetColumnLineageResponseValidationError) ErrorName() string {
+	return "GetColumnLineageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetColumnLineageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetColumnLineageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetColumnLineageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetColumnLineageResponseValidationError{}
+
+// Validate checks the field values on PlanReconcileRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *PlanReconcileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlanReconcileRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// PlanReconcileRequestMultiError, or nil if none found.
+func (m *PlanReconcileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlanReconcileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	// no validation rules for Files
+
+	if len(errors) > 0 {
+		return PlanReconcileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlanReconcileRequestMultiError is an error wrapping multiple validation
+// errors returned by PlanReconcileRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PlanReconcileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlanReconcileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlanReconcileRequestMultiError) AllErrors() []error { return m }
+
+// PlanReconcileRequestValidationError is the validation error returned by
+// PlanReconcileRequest.Validate if the designated constraints aren't met.
+type PlanReconcileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlanReconcileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlanReconcileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlanReconcileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlanReconcileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlanReconcileRequestValidationError) ErrorName() string {
+	return "PlanReconcileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlanReconcileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlanReconcileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlanReconcileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlanReconcileRequestValidationError{}
+
+// Validate checks the field values on PlanReconcileResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *PlanReconcileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlanReconcileResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// PlanReconcileResponseMultiError, or nil if none found.
+func (m *PlanReconcileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlanReconcileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlanReconcileResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlanReconcileResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlanReconcileResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetParseErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlanReconcileResponseValidationError{
+						field:  fmt.Sprintf("ParseErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlanReconcileResponseValidationError{
+						field:  fmt.Sprintf("ParseErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlanReconcileResponseValidationError{
+					field:  fmt.Sprintf("ParseErrors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PlanReconcileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlanReconcileResponseMultiError is an error wrapping multiple validation
+// errors returned by PlanReconcileResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PlanReconcileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlanReconcileResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlanReconcileResponseMultiError) AllErrors() []error { return m }
+
+// PlanReconcileResponseValidationError is the validation error returned by
+// PlanReconcileResponse.Validate if the designated constraints aren't met.
+type PlanReconcileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlanReconcileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlanReconcileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlanReconcileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlanReconcileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlanReconcileResponseValidationError) ErrorName() string {
+	return "PlanReconcileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlanReconcileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlanReconcileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlanReconcileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlanReconcileResponseValidationError{}
+
+// Validate checks the field values on PlannedChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PlannedChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlannedChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PlannedChangeMultiError, or
+// nil if none found.
+func (m *PlannedChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlannedChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetName()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PlannedChangeValidationError{
+					field:  "Name",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PlannedChangeValidationError{
+					field:  "Name",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetName()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PlannedChangeValidationError{
+				field:  "Name",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetRenamedFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PlannedChangeValidationError{
+					field:  "RenamedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PlannedChangeValidationError{
+					field:  "RenamedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRenamedFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PlannedChangeValidationError{
+				field:  "RenamedFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return PlannedChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlannedChangeMultiError is an error wrapping multiple validation errors
+// returned by PlannedChange.ValidateAll() if the designated constraints aren't
+// met.
+type PlannedChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlannedChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlannedChangeMultiError) AllErrors() []error { return m }
+
+// PlannedChangeValidationError is the validation error returned by
+// PlannedChange.Validate if the designated constraints aren't met.
+type PlannedChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlannedChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlannedChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlannedChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlannedChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlannedChangeValidationError) ErrorName() string { return "PlannedChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PlannedChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlannedChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlannedChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlannedChangeValidationError{}
+
 // Validate checks the field values on CreateTriggerRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -41,6 +41,8 @@ const (
 	RuntimeService_ListResources_FullMethodName           = "/rill.runtime.v1.RuntimeService/ListResources"
 	RuntimeService_WatchResources_FullMethodName          = "/rill.runtime.v1.RuntimeService/WatchResources"
 	RuntimeService_GetResource_FullMethodName             = "/rill.runtime.v1.RuntimeService/GetResource"
+	RuntimeService_GetColumnLineage_FullMethodName        = "/rill.runtime.v1.RuntimeService/GetColumnLineage"
+	RuntimeService_PlanReconcile_FullMethodName           = "/rill.runtime.v1.RuntimeService/PlanReconcile"
 	RuntimeService_CreateTrigger_FullMethodName           = "/rill.runtime.v1.RuntimeService/CreateTrigger"
 	RuntimeService_ListCatalogEntries_FullMethodName      = "/rill.runtime.v1.RuntimeService/ListCatalogEntries"
 	RuntimeService_GetCatalogEntry_FullMethodName         = "/rill.runtime.v1.RuntimeService/GetCatalogEntry"
@@ -104,6 +106,10 @@ type RuntimeServiceClient interface {
 	WatchResources(ctx context.Context, in *WatchResourcesRequest, opts ...grpc.CallOption) (RuntimeService_WatchResourcesClient, error)
 	// GetResource looks up a specific catalog resource
 	GetResource(ctx context.Context, in *GetResourceRequest, opts ...grpc.CallOption) (*GetResourceResponse, error)
+	// GetColumnLineage returns the upstream and downstream columns of a column in a source, model or metrics view
+	GetColumnLineage(ctx context.Context, in *GetColumnLineageRequest, opts ...grpc.CallOption) (*GetColumnLineageResponse, error)
+	// PlanReconcile parses the project and reports the changes a reconcile would make to the catalog, without making them
+	PlanReconcile(ctx context.Context, in *PlanReconcileRequest, opts ...grpc.CallOption) (*PlanReconcileResponse, error)
 	// CreateTrigger creates a trigger in the catalog.
 	// Triggers are ephemeral resources that will be cleaned up by the controller.
 	CreateTrigger(ctx context.Context, in *CreateTriggerRequest, opts ...grpc.CallOption) (*CreateTriggerResponse, error)
@@ -412,6 +418,24 @@ func (c *runtimeServiceClient) GetResource(ctx context.Context, in *GetResourceR
 	return out, nil
 }
 
+func (c *runtimeServiceClient) GetColumnLineage(ctx context.Context, in *GetColumnLineageRequest, opts ...grpc.CallOption) (*GetColumnLineageResponse, error) {
+	out := new(GetColumnLineageResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_GetColumnLineage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) PlanReconcile(ctx context.Context, in *PlanReconcileRequest, opts ...grpc.CallOption) (*PlanReconcileResponse, error) {
+	out := new(PlanReconcileResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_PlanReconcile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeServiceClient) CreateTrigger(ctx context.Context, in *CreateTriggerRequest, opts ...grpc.CallOption) (*CreateTriggerResponse, error) {
 	out := new(CreateTriggerResponse)
 	err := c.cc.Invoke(ctx, RuntimeService_CreateTrigger_FullMethodName, in, out, opts...)
@@ -569,6 +593,10 @@ type RuntimeServiceServer interface {
 	WatchResources(*WatchResourcesRequest, RuntimeService_WatchResourcesServer) error
 	// GetResource looks up a specific catalog resource
 	GetResource(context.Context, *GetResourceRequest) (*GetResourceResponse, error)
+	// GetColumnLineage returns the upstream and downstream columns of a column in a source, model or metrics view
+	GetColumnLineage(context.Context, *GetColumnLineageRequest) (*GetColumnLineageResponse, error)
+	// PlanReconcile parses the project and reports the changes a reconcile would make to the catalog, without making them
+	PlanReconcile(context.Context, *PlanReconcileRequest) (*PlanReconcileResponse, error)
 	// CreateTrigger creates a trigger in the catalog.
 	// Triggers are ephemeral resources that will be cleaned up by the controller.
 	CreateTrigger(context.Context, *CreateTriggerRequest) (*CreateTriggerResponse, error)
@@ -673,6 +701,12 @@ func (UnimplementedRuntimeServiceServer) WatchResources(*WatchResourcesRequest,
 func (UnimplementedRuntimeServiceServer) GetResource(context.Context, *GetResourceRequest) (*GetResourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetResource not implemented")
 }
+func (UnimplementedRuntimeServiceServer) GetColumnLineage(context.Context, *GetColumnLineageRequest) (*GetColumnLineageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetColumnLineage not implemented")
+}
+func (UnimplementedRuntimeServiceServer) PlanReconcile(context.Context, *PlanReconcileRequest) (*PlanReconcileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanReconcile not implemented")
+}
 func (UnimplementedRuntimeServiceServer) CreateTrigger(context.Context, *CreateTriggerRequest) (*CreateTriggerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTrigger not implemented")
 }
@@ -1127,6 +1161,42 @@ func _RuntimeService_GetResource_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_GetColumnLineage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetColumnLineageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).GetColumnLineage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_GetColumnLineage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).GetColumnLineage(ctx, req.(*GetColumnLineageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_PlanReconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanReconcileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).PlanReconcile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_PlanReconcile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).PlanReconcile(ctx, req.(*PlanReconcileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuntimeService_CreateTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateTriggerRequest)
 	if err := dec(in); err != nil {
@@ -1426,6 +1496,14 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetResource",
 			Handler:    _RuntimeService_GetResource_Handler,
 		},
+		{
+			MethodName: "GetColumnLineage",
+			Handler:    _RuntimeService_GetColumnLineage_Handler,
+		},
+		{
+			MethodName: "PlanReconcile",
+			Handler:    _RuntimeService_PlanReconcile_Handler,
+		},
 		{
 			MethodName: "CreateTrigger",
 			Handler:    _RuntimeService_CreateTrigger_Handler,
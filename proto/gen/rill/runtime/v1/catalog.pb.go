@@ -597,12 +597,14 @@ type MetricsView_Measure struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name                string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Label               string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	Expression          string `protobuf:"bytes,3,opt,name=expression,proto3" json:"expression,omitempty"`
-	Description         string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Format              string `protobuf:"bytes,5,opt,name=format,proto3" json:"format,omitempty"`
-	ValidPercentOfTotal bool   `protobuf:"varint,6,opt,name=valid_percent_of_total,json=validPercentOfTotal,proto3" json:"valid_percent_of_total,omitempty"`
+	Name                string                     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Label               string                     `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Expression          string                     `protobuf:"bytes,3,opt,name=expression,proto3" json:"expression,omitempty"`
+	Description         string                     `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Format              string                     `protobuf:"bytes,5,opt,name=format,proto3" json:"format,omitempty"`
+	ValidPercentOfTotal bool                       `protobuf:"varint,6,opt,name=valid_percent_of_total,json=validPercentOfTotal,proto3" json:"valid_percent_of_total,omitempty"`
+	ReferencedMeasures  []string                   `protobuf:"bytes,7,rep,name=referenced_measures,json=referencedMeasures,proto3" json:"referenced_measures,omitempty"`
+	Window              *MetricsView_MeasureWindow `protobuf:"bytes,8,opt,name=window,proto3" json:"window,omitempty"`
 }
 
 func (x *MetricsView_Measure) Reset() {
@@ -679,6 +681,75 @@ func (x *MetricsView_Measure) GetValidPercentOfTotal() bool {
 	return false
 }
 
+func (x *MetricsView_Measure) GetReferencedMeasures() []string {
+	if x != nil {
+		return x.ReferencedMeasures
+	}
+	return nil
+}
+
+func (x *MetricsView_Measure) GetWindow() *MetricsView_MeasureWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+type MetricsView_MeasureWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Partition bool   `protobuf:"varint,1,opt,name=partition,proto3" json:"partition,omitempty"`
+	Frame     string `protobuf:"bytes,2,opt,name=frame,proto3" json:"frame,omitempty"`
+}
+
+func (x *MetricsView_MeasureWindow) Reset() {
+	*x = MetricsView_MeasureWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_catalog_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetricsView_MeasureWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricsView_MeasureWindow) ProtoMessage() {}
+
+func (x *MetricsView_MeasureWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_catalog_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricsView_MeasureWindow.ProtoReflect.Descriptor instead.
+func (*MetricsView_MeasureWindow) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_catalog_proto_rawDescGZIP(), []int{3, 2}
+}
+
+func (x *MetricsView_MeasureWindow) GetPartition() bool {
+	if x != nil {
+		return x.Partition
+	}
+	return false
+}
+
+func (x *MetricsView_MeasureWindow) GetFrame() string {
+	if x != nil {
+		return x.Frame
+	}
+	return ""
+}
+
 // Security for the metrics view
 type MetricsView_Security struct {
 	state         protoimpl.MessageState
@@ -697,7 +768,7 @@ type MetricsView_Security struct {
 func (x *MetricsView_Security) Reset() {
 	*x = MetricsView_Security{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_catalog_proto_msgTypes[6]
+		mi := &file_rill_runtime_v1_catalog_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -710,7 +781,7 @@ func (x *MetricsView_Security) String() string {
 func (*MetricsView_Security) ProtoMessage() {}
 
 func (x *MetricsView_Security) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_catalog_proto_msgTypes[6]
+	mi := &file_rill_runtime_v1_catalog_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -723,7 +794,7 @@ func (x *MetricsView_Security) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MetricsView_Security.ProtoReflect.Descriptor instead.
 func (*MetricsView_Security) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_catalog_proto_rawDescGZIP(), []int{3, 2}
+	return file_rill_runtime_v1_catalog_proto_rawDescGZIP(), []int{3, 3}
 }
 
 func (x *MetricsView_Security) GetAccess() string {
@@ -767,7 +838,7 @@ type MetricsView_Security_FieldCondition struct {
 func (x *MetricsView_Security_FieldCondition) Reset() {
 	*x = MetricsView_Security_FieldCondition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_catalog_proto_msgTypes[7]
+		mi := &file_rill_runtime_v1_catalog_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -780,7 +851,7 @@ func (x *MetricsView_Security_FieldCondition) String() string {
 func (*MetricsView_Security_FieldCondition) ProtoMessage() {}
 
 func (x *MetricsView_Security_FieldCondition) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_catalog_proto_msgTypes[7]
+	mi := &file_rill_runtime_v1_catalog_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -793,7 +864,7 @@ func (x *MetricsView_Security_FieldCondition) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use MetricsView_Security_FieldCondition.ProtoReflect.Descriptor instead.
 func (*MetricsView_Security_FieldCondition) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_catalog_proto_rawDescGZIP(), []int{3, 2, 0}
+	return file_rill_runtime_v1_catalog_proto_rawDescGZIP(), []int{3, 3, 0}
 }
 
 func (x *MetricsView_Security_FieldCondition) GetCondition() string {
@@ -857,7 +928,7 @@ var file_rill_runtime_v1_catalog_proto_rawDesc = []byte{
 	0x36, 0x0a, 0x07, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x49,
 	0x41, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
 	0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x49, 0x41, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x44,
-	0x55, 0x43, 0x4b, 0x44, 0x42, 0x10, 0x01, 0x22, 0xc9, 0x09, 0x0a, 0x0b, 0x4d, 0x65, 0x74, 0x72,
+	0x55, 0x43, 0x4b, 0x44, 0x42, 0x10, 0x01, 0x22, 0x83, 0x0b, 0x0a, 0x0b, 0x4d, 0x65, 0x74, 0x72,
 	0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d,
 	0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65,
@@ -903,7 +974,7 @@ var file_rill_runtime_v1_catalog_proto_rawDesc = []byte{
 	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
 	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x1a, 0xc2, 0x01, 0x0a, 0x07, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
+	0x6c, 0x75, 0x6d, 0x6e, 0x1a, 0xb7, 0x02, 0x0a, 0x07, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
 	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
 	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78,
@@ -915,47 +986,58 @@ var file_rill_runtime_v1_catalog_proto_rawDesc = []byte{
 	0x72, 0x6d, 0x61, 0x74, 0x12, 0x33, 0x0a, 0x16, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x70, 0x65,
 	0x72, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x06,
 	0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65,
-	0x6e, 0x74, 0x4f, 0x66, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x1a, 0xa7, 0x02, 0x0a, 0x08, 0x53, 0x65,
-	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1d,
-	0x0a, 0x0a, 0x72, 0x6f, 0x77, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x77, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x4e, 0x0a,
-	0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34,
-	0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x2e, 0x53, 0x65, 0x63,
-	0x75, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x4e, 0x0a,
-	0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34,
-	0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x2e, 0x53, 0x65, 0x63,
-	0x75, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x1a, 0x44, 0x0a,
-	0x0e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
-	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x2a, 0x8d, 0x01, 0x0a, 0x0a, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x15, 0x0a, 0x11, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54,
-	0x41, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x02, 0x12, 0x15,
-	0x0a, 0x11, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f,
-	0x44, 0x45, 0x4c, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x56, 0x49, 0x45,
-	0x57, 0x10, 0x04, 0x42, 0xb5, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x69, 0x6c, 0x6c,
-	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x43, 0x61, 0x74,
-	0x61, 0x6c, 0x6f, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x32, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x69, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61,
-	0x2f, 0x72, 0x69, 0x6c, 0x6c, 0x2f, 0x72, 0x69, 0x6c, 0x6c, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x76, 0x31, 0xa2,
-	0x02, 0x03, 0x52, 0x52, 0x58, 0xaa, 0x02, 0x0f, 0x52, 0x69, 0x6c, 0x6c, 0x2e, 0x52, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x52, 0x69, 0x6c, 0x6c, 0x5c, 0x52,
-	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b, 0x52, 0x69, 0x6c, 0x6c,
-	0x5c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x52, 0x69, 0x6c, 0x6c, 0x3a, 0x3a,
-	0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x6e, 0x74, 0x4f, 0x66, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x06, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x72, 0x69, 0x6c,
+	0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x2e, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x1a, 0x43,
+	0x0a, 0x0d, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x72,
+	0x61, 0x6d, 0x65, 0x1a, 0xa7, 0x02, 0x0a, 0x08, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x77, 0x5f,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f,
+	0x77, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x4e, 0x0a, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x1a, 0x44, 0x0a, 0x0e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x2a, 0x8d, 0x01,
+	0x0a, 0x0a, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17,
+	0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x42, 0x4a,
+	0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x01,
+	0x12, 0x16, 0x0a, 0x12, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x42, 0x4a, 0x45,
+	0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x10, 0x03, 0x12,
+	0x1c, 0x0a, 0x18, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d,
+	0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x10, 0x04, 0x42, 0xb5, 0x01,
+	0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x72, 0x69, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x72, 0x69, 0x6c, 0x6c, 0x2f,
+	0x72, 0x69, 0x6c, 0x6c, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x3b,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x52, 0x52, 0x58, 0xaa,
+	0x02, 0x0f, 0x52, 0x69, 0x6c, 0x6c, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x56,
+	0x31, 0xca, 0x02, 0x0f, 0x52, 0x69, 0x6c, 0x6c, 0x5c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b, 0x52, 0x69, 0x6c, 0x6c, 0x5c, 0x52, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x11, 0x52, 0x69, 0x6c, 0x6c, 0x3a, 0x3a, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -971,7 +1053,7 @@ func file_rill_runtime_v1_catalog_proto_rawDescGZIP() []byte {
 }
 
 var file_rill_runtime_v1_catalog_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_rill_runtime_v1_catalog_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_rill_runtime_v1_catalog_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_rill_runtime_v1_catalog_proto_goTypes = []interface{}{
 	(ObjectType)(0),                             // 0: rill.runtime.v1.ObjectType
 	(Model_Dialect)(0),                          // 1: rill.runtime.v1.Model.Dialect
@@ -981,29 +1063,31 @@ var file_rill_runtime_v1_catalog_proto_goTypes = []interface{}{
 	(*MetricsView)(nil),                         // 5: rill.runtime.v1.MetricsView
 	(*MetricsView_Dimension)(nil),               // 6: rill.runtime.v1.MetricsView.Dimension
 	(*MetricsView_Measure)(nil),                 // 7: rill.runtime.v1.MetricsView.Measure
-	(*MetricsView_Security)(nil),                // 8: rill.runtime.v1.MetricsView.Security
-	(*MetricsView_Security_FieldCondition)(nil), // 9: rill.runtime.v1.MetricsView.Security.FieldCondition
-	(*StructType)(nil),                          // 10: rill.runtime.v1.StructType
-	(*structpb.Struct)(nil),                     // 11: google.protobuf.Struct
-	(TimeGrain)(0),                              // 12: rill.runtime.v1.TimeGrain
+	(*MetricsView_MeasureWindow)(nil),           // 8: rill.runtime.v1.MetricsView.MeasureWindow
+	(*MetricsView_Security)(nil),                // 9: rill.runtime.v1.MetricsView.Security
+	(*MetricsView_Security_FieldCondition)(nil), // 10: rill.runtime.v1.MetricsView.Security.FieldCondition
+	(*StructType)(nil),                          // 11: rill.runtime.v1.StructType
+	(*structpb.Struct)(nil),                     // 12: google.protobuf.Struct
+	(TimeGrain)(0),                              // 13: rill.runtime.v1.TimeGrain
 }
 var file_rill_runtime_v1_catalog_proto_depIdxs = []int32{
-	10, // 0: rill.runtime.v1.Table.schema:type_name -> rill.runtime.v1.StructType
-	11, // 1: rill.runtime.v1.Source.properties:type_name -> google.protobuf.Struct
-	10, // 2: rill.runtime.v1.Source.schema:type_name -> rill.runtime.v1.StructType
+	11, // 0: rill.runtime.v1.Table.schema:type_name -> rill.runtime.v1.StructType
+	12, // 1: rill.runtime.v1.Source.properties:type_name -> google.protobuf.Struct
+	11, // 2: rill.runtime.v1.Source.schema:type_name -> rill.runtime.v1.StructType
 	1,  // 3: rill.runtime.v1.Model.dialect:type_name -> rill.runtime.v1.Model.Dialect
-	10, // 4: rill.runtime.v1.Model.schema:type_name -> rill.runtime.v1.StructType
+	11, // 4: rill.runtime.v1.Model.schema:type_name -> rill.runtime.v1.StructType
 	6,  // 5: rill.runtime.v1.MetricsView.dimensions:type_name -> rill.runtime.v1.MetricsView.Dimension
 	7,  // 6: rill.runtime.v1.MetricsView.measures:type_name -> rill.runtime.v1.MetricsView.Measure
-	12, // 7: rill.runtime.v1.MetricsView.smallest_time_grain:type_name -> rill.runtime.v1.TimeGrain
-	8,  // 8: rill.runtime.v1.MetricsView.security:type_name -> rill.runtime.v1.MetricsView.Security
-	9,  // 9: rill.runtime.v1.MetricsView.Security.include:type_name -> rill.runtime.v1.MetricsView.Security.FieldCondition
-	9,  // 10: rill.runtime.v1.MetricsView.Security.exclude:type_name -> rill.runtime.v1.MetricsView.Security.FieldCondition
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	13, // 7: rill.runtime.v1.MetricsView.smallest_time_grain:type_name -> rill.runtime.v1.TimeGrain
+	9,  // 8: rill.runtime.v1.MetricsView.security:type_name -> rill.runtime.v1.MetricsView.Security
+	8,  // 9: rill.runtime.v1.MetricsView.Measure.window:type_name -> rill.runtime.v1.MetricsView.MeasureWindow
+	10, // 10: rill.runtime.v1.MetricsView.Security.include:type_name -> rill.runtime.v1.MetricsView.Security.FieldCondition
+	10, // 11: rill.runtime.v1.MetricsView.Security.exclude:type_name -> rill.runtime.v1.MetricsView.Security.FieldCondition
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_rill_runtime_v1_catalog_proto_init() }
@@ -1087,7 +1171,7 @@ func file_rill_runtime_v1_catalog_proto_init() {
 			}
 		}
 		file_rill_runtime_v1_catalog_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MetricsView_Security); i {
+			switch v := v.(*MetricsView_MeasureWindow); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1099,6 +1183,18 @@ func file_rill_runtime_v1_catalog_proto_init() {
 			}
 		}
 		file_rill_runtime_v1_catalog_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetricsView_Security); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rill_runtime_v1_catalog_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MetricsView_Security_FieldCondition); i {
 			case 0:
 				return &v.state
@@ -1117,7 +1213,7 @@ func file_rill_runtime_v1_catalog_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_rill_runtime_v1_catalog_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   8,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -821,6 +821,35 @@ func (m *MetricsView_Measure) validate(all bool) error {
 
 	// no validation rules for ValidPercentOfTotal
 
+	if all {
+		switch v := interface{}(m.GetWindow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsView_MeasureValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsView_MeasureValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWindow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsView_MeasureValidationError{
+				field:  "Window",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return MetricsView_MeasureMultiError(errors)
 	}
@@ -901,6 +930,112 @@ var _ interface {
 	ErrorName() string
 } = MetricsView_MeasureValidationError{}
 
+// Validate checks the field values on MetricsView_MeasureWindow with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsView_MeasureWindow) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsView_MeasureWindow with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsView_MeasureWindowMultiError, or nil if none found.
+func (m *MetricsView_MeasureWindow) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsView_MeasureWindow) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Partition
+
+	// no validation rules for Frame
+
+	if len(errors) > 0 {
+		return MetricsView_MeasureWindowMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsView_MeasureWindowMultiError is an error wrapping multiple validation
+// errors returned by MetricsView_MeasureWindow.ValidateAll() if the
+// designated constraints aren't met.
+type MetricsView_MeasureWindowMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsView_MeasureWindowMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsView_MeasureWindowMultiError) AllErrors() []error { return m }
+
+// MetricsView_MeasureWindowValidationError is the validation error returned by
+// MetricsView_MeasureWindow.Validate if the designated constraints aren't met.
+type MetricsView_MeasureWindowValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsView_MeasureWindowValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsView_MeasureWindowValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsView_MeasureWindowValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsView_MeasureWindowValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsView_MeasureWindowValidationError) ErrorName() string {
+	return "MetricsView_MeasureWindowValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsView_MeasureWindowValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsView_MeasureWindow.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsView_MeasureWindowValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsView_MeasureWindowValidationError{}
+
 // Validate checks the field values on MetricsView_Security with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
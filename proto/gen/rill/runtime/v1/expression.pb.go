@@ -0,0 +1,406 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        (unknown)
+// source: rill/runtime/v1/expression.proto
+
+package runtimev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Operation int32
+
+const (
+	Operation_OPERATION_UNSPECIFIED Operation = 0
+	Operation_OPERATION_EQ          Operation = 1
+	Operation_OPERATION_NEQ         Operation = 2
+	Operation_OPERATION_LT          Operation = 3
+	Operation_OPERATION_LTE         Operation = 4
+	Operation_OPERATION_GT          Operation = 5
+	Operation_OPERATION_GTE         Operation = 6
+	Operation_OPERATION_OR          Operation = 7
+	Operation_OPERATION_AND         Operation = 8
+	Operation_OPERATION_IN          Operation = 9
+	Operation_OPERATION_NIN         Operation = 10
+	Operation_OPERATION_LIKE        Operation = 11
+	Operation_OPERATION_NLIKE       Operation = 12
+	Operation_OPERATION_NOT         Operation = 13
+)
+
+// Enum value maps for Operation.
+var (
+	Operation_name = map[int32]string{
+		0:  "OPERATION_UNSPECIFIED",
+		1:  "OPERATION_EQ",
+		2:  "OPERATION_NEQ",
+		3:  "OPERATION_LT",
+		4:  "OPERATION_LTE",
+		5:  "OPERATION_GT",
+		6:  "OPERATION_GTE",
+		7:  "OPERATION_OR",
+		8:  "OPERATION_AND",
+		9:  "OPERATION_IN",
+		10: "OPERATION_NIN",
+		11: "OPERATION_LIKE",
+		12: "OPERATION_NLIKE",
+		13: "OPERATION_NOT",
+	}
+	Operation_value = map[string]int32{
+		"OPERATION_UNSPECIFIED": 0,
+		"OPERATION_EQ":          1,
+		"OPERATION_NEQ":         2,
+		"OPERATION_LT":          3,
+		"OPERATION_LTE":         4,
+		"OPERATION_GT":          5,
+		"OPERATION_GTE":         6,
+		"OPERATION_OR":          7,
+		"OPERATION_AND":         8,
+		"OPERATION_IN":          9,
+		"OPERATION_NIN":         10,
+		"OPERATION_LIKE":        11,
+		"OPERATION_NLIKE":       12,
+		"OPERATION_NOT":         13,
+	}
+)
+
+func (x Operation) Enum() *Operation {
+	p := new(Operation)
+	*p = x
+	return p
+}
+
+func (x Operation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Operation) Descriptor() protoreflect.EnumDescriptor {
+	return file_rill_runtime_v1_expression_proto_enumTypes[0].Descriptor()
+}
+
+func (Operation) Type() protoreflect.EnumType {
+	return &file_rill_runtime_v1_expression_proto_enumTypes[0]
+}
+
+func (x Operation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Operation.Descriptor instead.
+func (Operation) EnumDescriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_expression_proto_rawDescGZIP(), []int{0}
+}
+
+// Expression is a boolean or scalar expression used to filter metrics view queries.
+// Identifiers reference dimensions or measures of the metrics view by name.
+type Expression struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Expression:
+	//
+	//	*Expression_Ident
+	//	*Expression_Val
+	//	*Expression_Cond
+	Expression isExpression_Expression `protobuf_oneof:"expression"`
+}
+
+func (x *Expression) Reset() {
+	*x = Expression{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_expression_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Expression) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Expression) ProtoMessage() {}
+
+func (x *Expression) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_expression_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Expression.ProtoReflect.Descriptor instead.
+func (*Expression) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_expression_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *Expression) GetExpression() isExpression_Expression {
+	if m != nil {
+		return m.Expression
+	}
+	return nil
+}
+
+func (x *Expression) GetIdent() string {
+	if x, ok := x.GetExpression().(*Expression_Ident); ok {
+		return x.Ident
+	}
+	return ""
+}
+
+func (x *Expression) GetVal() *structpb.Value {
+	if x, ok := x.GetExpression().(*Expression_Val); ok {
+		return x.Val
+	}
+	return nil
+}
+
+func (x *Expression) GetCond() *Condition {
+	if x, ok := x.GetExpression().(*Expression_Cond); ok {
+		return x.Cond
+	}
+	return nil
+}
+
+type isExpression_Expression interface {
+	isExpression_Expression()
+}
+
+type Expression_Ident struct {
+	Ident string `protobuf:"bytes,1,opt,name=ident,proto3,oneof"`
+}
+
+type Expression_Val struct {
+	Val *structpb.Value `protobuf:"bytes,2,opt,name=val,proto3,oneof"`
+}
+
+type Expression_Cond struct {
+	Cond *Condition `protobuf:"bytes,3,opt,name=cond,proto3,oneof"`
+}
+
+func (*Expression_Ident) isExpression_Expression() {}
+
+func (*Expression_Val) isExpression_Expression() {}
+
+func (*Expression_Cond) isExpression_Expression() {}
+
+// Condition applies an operation to a list of sub-expressions.
+// Comparison operations take two expressions, IN/NIN take an expression followed by a list of values,
+// AND/OR take any number of expressions and NOT takes a single expression.
+// Comparing an expression to a null value with EQ or NEQ checks if it's null or not null.
+type Condition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Op    Operation     `protobuf:"varint,1,opt,name=op,proto3,enum=rill.runtime.v1.Operation" json:"op,omitempty"`
+	Exprs []*Expression `protobuf:"bytes,2,rep,name=exprs,proto3" json:"exprs,omitempty"`
+}
+
+func (x *Condition) Reset() {
+	*x = Condition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_expression_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Condition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Condition) ProtoMessage() {}
+
+func (x *Condition) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_expression_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Condition.ProtoReflect.Descriptor instead.
+func (*Condition) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_expression_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Condition) GetOp() Operation {
+	if x != nil {
+		return x.Op
+	}
+	return Operation_OPERATION_UNSPECIFIED
+}
+
+func (x *Condition) GetExprs() []*Expression {
+	if x != nil {
+		return x.Exprs
+	}
+	return nil
+}
+
+var File_rill_runtime_v1_expression_proto protoreflect.FileDescriptor
+
+var file_rill_runtime_v1_expression_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x72, 0x69, 0x6c, 0x6c, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76,
+	0x31, 0x2f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x0f, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x90, 0x01, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x05, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x05, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x03, 0x76, 0x61, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52,
+	0x03, 0x76, 0x61, 0x6c, 0x12, 0x30, 0x0a, 0x04, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
+	0x52, 0x04, 0x63, 0x6f, 0x6e, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x6a, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2a, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e,
+	0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x31, 0x0a,
+	0x05, 0x65, 0x78, 0x70, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72,
+	0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x65, 0x78, 0x70, 0x72, 0x73,
+	0x2a, 0x9b, 0x02, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19,
+	0x0a, 0x15, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x50, 0x45,
+	0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x51, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4f,
+	0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x45, 0x51, 0x10, 0x02, 0x12, 0x10,
+	0x0a, 0x0c, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x54, 0x10, 0x03,
+	0x12, 0x11, 0x0a, 0x0d, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x54,
+	0x45, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x47, 0x54, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x47, 0x54, 0x45, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x50, 0x45, 0x52,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x10, 0x07, 0x12, 0x11, 0x0a, 0x0d, 0x4f, 0x50,
+	0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4e, 0x44, 0x10, 0x08, 0x12, 0x10, 0x0a,
+	0x0c, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x10, 0x09, 0x12,
+	0x11, 0x0a, 0x0d, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x49, 0x4e,
+	0x10, 0x0a, 0x12, 0x12, 0x0a, 0x0e, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4c, 0x49, 0x4b, 0x45, 0x10, 0x0b, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4c, 0x49, 0x4b, 0x45, 0x10, 0x0c, 0x12, 0x11, 0x0a, 0x0d, 0x4f,
+	0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x10, 0x0d, 0x42, 0xb8,
+	0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0f, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x69, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x72,
+	0x69, 0x6c, 0x6c, 0x2f, 0x72, 0x69, 0x6c, 0x6c, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x2f, 0x76, 0x31, 0x3b, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03,
+	0x52, 0x52, 0x58, 0xaa, 0x02, 0x0f, 0x52, 0x69, 0x6c, 0x6c, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x52, 0x69, 0x6c, 0x6c, 0x5c, 0x52, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b, 0x52, 0x69, 0x6c, 0x6c, 0x5c, 0x52,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x52, 0x69, 0x6c, 0x6c, 0x3a, 0x3a, 0x52, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_rill_runtime_v1_expression_proto_rawDescOnce sync.Once
+	file_rill_runtime_v1_expression_proto_rawDescData = file_rill_runtime_v1_expression_proto_rawDesc
+)
+
+func file_rill_runtime_v1_expression_proto_rawDescGZIP() []byte {
+	file_rill_runtime_v1_expression_proto_rawDescOnce.Do(func() {
+		file_rill_runtime_v1_expression_proto_rawDescData = protoimpl.X.CompressGZIP(file_rill_runtime_v1_expression_proto_rawDescData)
+	})
+	return file_rill_runtime_v1_expression_proto_rawDescData
+}
+
+var file_rill_runtime_v1_expression_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_rill_runtime_v1_expression_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rill_runtime_v1_expression_proto_goTypes = []interface{}{
+	(Operation)(0),         // 0: rill.runtime.v1.Operation
+	(*Expression)(nil),     // 1: rill.runtime.v1.Expression
+	(*Condition)(nil),      // 2: rill.runtime.v1.Condition
+	(*structpb.Value)(nil), // 3: google.protobuf.Value
+}
+var file_rill_runtime_v1_expression_proto_depIdxs = []int32{
+	3, // 0: rill.runtime.v1.Expression.val:type_name -> google.protobuf.Value
+	2, // 1: rill.runtime.v1.Expression.cond:type_name -> rill.runtime.v1.Condition
+	0, // 2: rill.runtime.v1.Condition.op:type_name -> rill.runtime.v1.Operation
+	1, // 3: rill.runtime.v1.Condition.exprs:type_name -> rill.runtime.v1.Expression
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_rill_runtime_v1_expression_proto_init() }
+func file_rill_runtime_v1_expression_proto_init() {
+	if File_rill_runtime_v1_expression_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rill_runtime_v1_expression_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Expression); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rill_runtime_v1_expression_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Condition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_rill_runtime_v1_expression_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*Expression_Ident)(nil),
+		(*Expression_Val)(nil),
+		(*Expression_Cond)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rill_runtime_v1_expression_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rill_runtime_v1_expression_proto_goTypes,
+		DependencyIndexes: file_rill_runtime_v1_expression_proto_depIdxs,
+		EnumInfos:         file_rill_runtime_v1_expression_proto_enumTypes,
+		MessageInfos:      file_rill_runtime_v1_expression_proto_msgTypes,
+	}.Build()
+	File_rill_runtime_v1_expression_proto = out.File
+	file_rill_runtime_v1_expression_proto_rawDesc = nil
+	file_rill_runtime_v1_expression_proto_goTypes = nil
+	file_rill_runtime_v1_expression_proto_depIdxs = nil
+}
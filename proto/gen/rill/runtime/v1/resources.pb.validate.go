@@ -292,6 +292,170 @@ func (m *Resource) validate(all bool) error {
 			}
 		}
 
+	case *Resource_Report:
+		if v == nil {
+			err := ResourceValidationError{
+				field:  "Resource",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetReport()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ResourceValidationError{
+						field:  "Report",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ResourceValidationError{
+						field:  "Report",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetReport()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ResourceValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Resource_Alert:
+		if v == nil {
+			err := ResourceValidationError{
+				field:  "Resource",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAlert()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ResourceValidationError{
+						field:  "Alert",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ResourceValidationError{
+						field:  "Alert",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAlert()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ResourceValidationError{
+					field:  "Alert",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Resource_Test:
+		if v == nil {
+			err := ResourceValidationError{
+				field:  "Resource",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTest()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ResourceValidationError{
+						field:  "Test",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ResourceValidationError{
+						field:  "Test",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTest()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ResourceValidationError{
+					field:  "Test",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Resource_Macro:
+		if v == nil {
+			err := ResourceValidationError{
+				field:  "Resource",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMacro()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ResourceValidationError{
+						field:  "Macro",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ResourceValidationError{
+						field:  "Macro",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMacro()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ResourceValidationError{
+					field:  "Macro",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	case *Resource_PullTrigger:
 		if v == nil {
 			err := ResourceValidationError{
@@ -1639,12 +1803,16 @@ func (m *SourceSpec) validate(all bool) error {
 
 	// no validation rules for TimeoutSeconds
 
+	// no validation rules for Incremental
+
 	// no validation rules for StageChanges
 
 	// no validation rules for StreamIngestion
 
 	// no validation rules for Trigger
 
+	// no validation rules for TriggerFullRefresh
+
 	if len(errors) > 0 {
 		return SourceSpecMultiError(errors)
 	}
@@ -1779,6 +1947,40 @@ func (m *SourceState) validate(all bool) error {
 		}
 	}
 
+	for idx, item := range m.GetIngestedObjects() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SourceStateValidationError{
+						field:  fmt.Sprintf("IngestedObjects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SourceStateValidationError{
+						field:  fmt.Sprintf("IngestedObjects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SourceStateValidationError{
+					field:  fmt.Sprintf("IngestedObjects[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return SourceStateMultiError(errors)
 	}
@@ -1856,98 +2058,77 @@ var _ interface {
 	ErrorName() string
 } = SourceStateValidationError{}
 
-// Validate checks the field values on ModelV2 with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on IngestedObject with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *ModelV2) Validate() error {
+func (m *IngestedObject) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ModelV2 with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in ModelV2MultiError, or nil if none found.
-func (m *ModelV2) ValidateAll() error {
+// ValidateAll checks the field values on IngestedObject with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IngestedObjectMultiError,
+// or nil if none found.
+func (m *IngestedObject) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ModelV2) validate(all bool) error {
+func (m *IngestedObject) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSpec()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ModelV2ValidationError{
-					field:  "Spec",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ModelV2ValidationError{
-					field:  "Spec",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ModelV2ValidationError{
-				field:  "Spec",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Key
+
+	// no validation rules for Etag
 
 	if all {
-		switch v := interface{}(m.GetState()).(type) {
+		switch v := interface{}(m.GetLastModified()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ModelV2ValidationError{
-					field:  "State",
+				errors = append(errors, IngestedObjectValidationError{
+					field:  "LastModified",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ModelV2ValidationError{
-					field:  "State",
+				errors = append(errors, IngestedObjectValidationError{
+					field:  "LastModified",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetLastModified()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ModelV2ValidationError{
-				field:  "State",
+			return IngestedObjectValidationError{
+				field:  "LastModified",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for Size
+
 	if len(errors) > 0 {
-		return ModelV2MultiError(errors)
+		return IngestedObjectMultiError(errors)
 	}
 
 	return nil
 }
 
-// ModelV2MultiError is an error wrapping multiple validation errors returned
-// by ModelV2.ValidateAll() if the designated constraints aren't met.
-type ModelV2MultiError []error
+// IngestedObjectMultiError is an error wrapping multiple validation errors
+// returned by IngestedObject.ValidateAll() if the designated constraints
+// aren't met.
+type IngestedObjectMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ModelV2MultiError) Error() string {
+func (m IngestedObjectMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1956,11 +2137,11 @@ func (m ModelV2MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ModelV2MultiError) AllErrors() []error { return m }
+func (m IngestedObjectMultiError) AllErrors() []error { return m }
 
-// ModelV2ValidationError is the validation error returned by ModelV2.Validate
-// if the designated constraints aren't met.
-type ModelV2ValidationError struct {
+// IngestedObjectValidationError is the validation error returned by
+// IngestedObject.Validate if the designated constraints aren't met.
+type IngestedObjectValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1968,22 +2149,22 @@ type ModelV2ValidationError struct {
 }
 
 // Field function returns field value.
-func (e ModelV2ValidationError) Field() string { return e.field }
+func (e IngestedObjectValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ModelV2ValidationError) Reason() string { return e.reason }
+func (e IngestedObjectValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ModelV2ValidationError) Cause() error { return e.cause }
+func (e IngestedObjectValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ModelV2ValidationError) Key() bool { return e.key }
+func (e IngestedObjectValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ModelV2ValidationError) ErrorName() string { return "ModelV2ValidationError" }
+func (e IngestedObjectValidationError) ErrorName() string { return "IngestedObjectValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ModelV2ValidationError) Error() string {
+func (e IngestedObjectValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1995,14 +2176,14 @@ func (e ModelV2ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sModelV2.%s: %s%s",
+		"invalid %sIngestedObject.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ModelV2ValidationError{}
+var _ error = IngestedObjectValidationError{}
 
 var _ interface {
 	Field() string
@@ -2010,34 +2191,190 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ModelV2ValidationError{}
+} = IngestedObjectValidationError{}
 
-// Validate checks the field values on ModelSpec with the rules defined in the
+// Validate checks the field values on ModelV2 with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *ModelSpec) Validate() error {
+func (m *ModelV2) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ModelSpec with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ModelSpecMultiError, or nil
-// if none found.
-func (m *ModelSpec) ValidateAll() error {
+// ValidateAll checks the field values on ModelV2 with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ModelV2MultiError, or nil if none found.
+func (m *ModelV2) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ModelSpec) validate(all bool) error {
+func (m *ModelV2) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Connector
-
-	// no validation rules for Sql
-
+	if all {
+		switch v := interface{}(m.GetSpec()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelV2ValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModelV2ValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelV2ValidationError{
+				field:  "Spec",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetState()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelV2ValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModelV2ValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelV2ValidationError{
+				field:  "State",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ModelV2MultiError(errors)
+	}
+
+	return nil
+}
+
+// ModelV2MultiError is an error wrapping multiple validation errors returned
+// by ModelV2.ValidateAll() if the designated constraints aren't met.
+type ModelV2MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModelV2MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModelV2MultiError) AllErrors() []error { return m }
+
+// ModelV2ValidationError is the validation error returned by ModelV2.Validate
+// if the designated constraints aren't met.
+type ModelV2ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModelV2ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModelV2ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModelV2ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModelV2ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModelV2ValidationError) ErrorName() string { return "ModelV2ValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ModelV2ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModelV2.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModelV2ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModelV2ValidationError{}
+
+// Validate checks the field values on ModelSpec with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ModelSpec) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModelSpec with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ModelSpecMultiError, or nil
+// if none found.
+func (m *ModelSpec) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModelSpec) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Connector
+
+	// no validation rules for Sql
+
 	if all {
 		switch v := interface{}(m.GetRefreshSchedule()).(type) {
 		case interface{ ValidateAll() error }:
@@ -2071,12 +2408,20 @@ func (m *ModelSpec) validate(all bool) error {
 
 	// no validation rules for UsesTemplating
 
+	// no validation rules for Incremental
+
+	// no validation rules for Snapshot
+
+	// no validation rules for SnapshotUpdatedAt
+
 	// no validation rules for StageChanges
 
 	// no validation rules for MaterializeDelaySeconds
 
 	// no validation rules for Trigger
 
+	// no validation rules for TriggerFullRefresh
+
 	if m.Materialize != nil {
 		// no validation rules for Materialize
 	}
@@ -2215,6 +2560,71 @@ func (m *ModelState) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for IncrementalSpecHash
+
+	if all {
+		switch v := interface{}(m.GetIncrementalWatermark()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelStateValidationError{
+					field:  "IncrementalWatermark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModelStateValidationError{
+					field:  "IncrementalWatermark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIncrementalWatermark()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelStateValidationError{
+				field:  "IncrementalWatermark",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetColumnLineage() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ModelStateValidationError{
+						field:  fmt.Sprintf("ColumnLineage[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ModelStateValidationError{
+						field:  fmt.Sprintf("ColumnLineage[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ModelStateValidationError{
+					field:  fmt.Sprintf("ColumnLineage[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ModelStateMultiError(errors)
 	}
@@ -2588,19 +2998,2247 @@ func (m *MetricsViewSpec) validate(all bool) error {
 	// no validation rules for FirstMonthOfYear
 
 	if len(errors) > 0 {
-		return MetricsViewSpecMultiError(errors)
+		return MetricsViewSpecMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewSpecMultiError is an error wrapping multiple validation errors
+// returned by MetricsViewSpec.ValidateAll() if the designated constraints
+// aren't met.
+type MetricsViewSpecMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewSpecMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewSpecMultiError) AllErrors() []error { return m }
+
+// MetricsViewSpecValidationError is the validation error returned by
+// MetricsViewSpec.Validate if the designated constraints aren't met.
+type MetricsViewSpecValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewSpecValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewSpecValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewSpecValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewSpecValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewSpecValidationError) ErrorName() string { return "MetricsViewSpecValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MetricsViewSpecValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewSpec.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewSpecValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewSpecValidationError{}
+
+// Validate checks the field values on MetricsViewState with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewState with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewStateMultiError, or nil if none found.
+func (m *MetricsViewState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetValidSpec()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewStateValidationError{
+					field:  "ValidSpec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewStateValidationError{
+					field:  "ValidSpec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValidSpec()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewStateValidationError{
+				field:  "ValidSpec",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetColumnLineage() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewStateValidationError{
+						field:  fmt.Sprintf("ColumnLineage[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewStateValidationError{
+						field:  fmt.Sprintf("ColumnLineage[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewStateValidationError{
+					field:  fmt.Sprintf("ColumnLineage[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MetricsViewStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewStateMultiError is an error wrapping multiple validation errors
+// returned by MetricsViewState.ValidateAll() if the designated constraints
+// aren't met.
+type MetricsViewStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewStateMultiError) AllErrors() []error { return m }
+
+// MetricsViewStateValidationError is the validation error returned by
+// MetricsViewState.Validate if the designated constraints aren't met.
+type MetricsViewStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewStateValidationError) ErrorName() string { return "MetricsViewStateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MetricsViewStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewState.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewStateValidationError{}
+
+// Validate checks the field values on ColumnLineage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ColumnLineage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ColumnLineage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ColumnLineageMultiError, or
+// nil if none found.
+func (m *ColumnLineage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ColumnLineage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	for idx, item := range m.GetUpstream() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ColumnLineageValidationError{
+						field:  fmt.Sprintf("Upstream[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ColumnLineageValidationError{
+						field:  fmt.Sprintf("Upstream[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ColumnLineageValidationError{
+					field:  fmt.Sprintf("Upstream[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ColumnLineageMultiError(errors)
+	}
+
+	return nil
+}
+
+// ColumnLineageMultiError is an error wrapping multiple validation errors
+// returned by ColumnLineage.ValidateAll() if the designated constraints aren't
+// met.
+type ColumnLineageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ColumnLineageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ColumnLineageMultiError) AllErrors() []error { return m }
+
+// ColumnLineageValidationError is the validation error returned by
+// ColumnLineage.Validate if the designated constraints aren't met.
+type ColumnLineageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ColumnLineageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ColumnLineageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ColumnLineageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ColumnLineageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ColumnLineageValidationError) ErrorName() string { return "ColumnLineageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ColumnLineageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sColumnLineage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ColumnLineageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ColumnLineageValidationError{}
+
+// Validate checks the field values on LineageColumn with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LineageColumn) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LineageColumn with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LineageColumnMultiError, or
+// nil if none found.
+func (m *LineageColumn) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LineageColumn) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LineageColumnValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LineageColumnValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LineageColumnValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Column
+
+	if len(errors) > 0 {
+		return LineageColumnMultiError(errors)
+	}
+
+	return nil
+}
+
+// LineageColumnMultiError is an error wrapping multiple validation errors
+// returned by LineageColumn.ValidateAll() if the designated constraints aren't
+// met.
+type LineageColumnMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LineageColumnMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LineageColumnMultiError) AllErrors() []error { return m }
+
+// LineageColumnValidationError is the validation error returned by
+// LineageColumn.Validate if the designated constraints aren't met.
+type LineageColumnValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LineageColumnValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LineageColumnValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LineageColumnValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LineageColumnValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LineageColumnValidationError) ErrorName() string { return "LineageColumnValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LineageColumnValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLineageColumn.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LineageColumnValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LineageColumnValidationError{}
+
+// Validate checks the field values on Migration with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Migration) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Migration with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MigrationMultiError, or nil
+// if none found.
+func (m *Migration) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Migration) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSpec()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MigrationValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MigrationValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MigrationValidationError{
+				field:  "Spec",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetState()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MigrationValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MigrationValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MigrationValidationError{
+				field:  "State",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MigrationMultiError(errors)
+	}
+
+	return nil
+}
+
+// MigrationMultiError is an error wrapping multiple validation errors returned
+// by Migration.ValidateAll() if the designated constraints aren't met.
+type MigrationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MigrationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MigrationMultiError) AllErrors() []error { return m }
+
+// MigrationValidationError is the validation error returned by
+// Migration.Validate if the designated constraints aren't met.
+type MigrationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MigrationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MigrationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MigrationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MigrationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MigrationValidationError) ErrorName() string { return "MigrationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MigrationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMigration.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MigrationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MigrationValidationError{}
+
+// Validate checks the field values on MigrationSpec with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MigrationSpec) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MigrationSpec with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MigrationSpecMultiError, or
+// nil if none found.
+func (m *MigrationSpec) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MigrationSpec) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Connector
+
+	// no validation rules for Sql
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return MigrationSpecMultiError(errors)
+	}
+
+	return nil
+}
+
+// MigrationSpecMultiError is an error wrapping multiple validation errors
+// returned by MigrationSpec.ValidateAll() if the designated constraints
+// aren't met.
+type MigrationSpecMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MigrationSpecMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MigrationSpecMultiError) AllErrors() []error { return m }
+
+// MigrationSpecValidationError is the validation error returned by
+// MigrationSpec.Validate if the designated constraints aren't met.
+type MigrationSpecValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MigrationSpecValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MigrationSpecValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MigrationSpecValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MigrationSpecValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MigrationSpecValidationError) ErrorName() string { return "MigrationSpecValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MigrationSpecValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMigrationSpec.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MigrationSpecValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MigrationSpecValidationError{}
+
+// Validate checks the field values on MigrationState with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MigrationState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MigrationState with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MigrationStateMultiError,
+// or nil if none found.
+func (m *MigrationState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MigrationState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return MigrationStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// MigrationStateMultiError is an error wrapping multiple validation errors
+// returned by MigrationState.ValidateAll() if the designated constraints
+// aren't met.
+type MigrationStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MigrationStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MigrationStateMultiError) AllErrors() []error { return m }
+
+// MigrationStateValidationError is the validation error returned by
+// MigrationState.Validate if the designated constraints aren't met.
+type MigrationStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MigrationStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MigrationStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MigrationStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MigrationStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MigrationStateValidationError) ErrorName() string { return "MigrationStateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MigrationStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMigrationState.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MigrationStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MigrationStateValidationError{}
+
+// Validate checks the field values on Report with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Report) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Report with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ReportMultiError, or nil if none found.
+func (m *Report) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Report) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSpec()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportValidationError{
+				field:  "Spec",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetState()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportValidationError{
+				field:  "State",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportMultiError is an error wrapping multiple validation errors returned by
+// Report.ValidateAll() if the designated constraints aren't met.
+type ReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportMultiError) AllErrors() []error { return m }
+
+// ReportValidationError is the validation error returned by Report.Validate if
+// the designated constraints aren't met.
+type ReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportValidationError) ErrorName() string { return "ReportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportValidationError{}
+
+// Validate checks the field values on ReportSpec with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReportSpec) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportSpec with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReportSpecMultiError, or
+// nil if none found.
+func (m *ReportSpec) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportSpec) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Trigger
+
+	// no validation rules for Title
+
+	if all {
+		switch v := interface{}(m.GetRefreshSchedule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportSpecValidationError{
+					field:  "RefreshSchedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportSpecValidationError{
+					field:  "RefreshSchedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefreshSchedule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportSpecValidationError{
+				field:  "RefreshSchedule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TimeoutSeconds
+
+	// no validation rules for QueryName
+
+	// no validation rules for QueryArgsJson
+
+	// no validation rules for ExportLimit
+
+	// no validation rules for ExportFormat
+
+	if all {
+		switch v := interface{}(m.GetOwnerAttributes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportSpecValidationError{
+					field:  "OwnerAttributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportSpecValidationError{
+					field:  "OwnerAttributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOwnerAttributes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportSpecValidationError{
+				field:  "OwnerAttributes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReportSpecMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportSpecMultiError is an error wrapping multiple validation errors
+// returned by ReportSpec.ValidateAll() if the designated constraints aren't met.
+type ReportSpecMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportSpecMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportSpecMultiError) AllErrors() []error { return m }
+
+// ReportSpecValidationError is the validation error returned by
+// ReportSpec.Validate if the designated constraints aren't met.
+type ReportSpecValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportSpecValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportSpecValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportSpecValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportSpecValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportSpecValidationError) ErrorName() string { return "ReportSpecValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReportSpecValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportSpec.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportSpecValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportSpecValidationError{}
+
+// Validate checks the field values on ReportState with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReportState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportState with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReportStateMultiError, or
+// nil if none found.
+func (m *ReportState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetNextRunOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportStateValidationError{
+					field:  "NextRunOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportStateValidationError{
+					field:  "NextRunOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextRunOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportStateValidationError{
+				field:  "NextRunOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastRunOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportStateValidationError{
+					field:  "LastRunOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportStateValidationError{
+					field:  "LastRunOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastRunOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportStateValidationError{
+				field:  "LastRunOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LastError
+
+	// no validation rules for ExecutionCount
+
+	if len(errors) > 0 {
+		return ReportStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportStateMultiError is an error wrapping multiple validation errors
+// returned by ReportState.ValidateAll() if the designated constraints aren't met.
+type ReportStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportStateMultiError) AllErrors() []error { return m }
+
+// ReportStateValidationError is the validation error returned by
+// ReportState.Validate if the designated constraints aren't met.
+type ReportStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportStateValidationError) ErrorName() string { return "ReportStateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReportStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportState.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportStateValidationError{}
+
+// Validate checks the field values on Alert with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Alert) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Alert with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AlertMultiError, or nil if none found.
+func (m *Alert) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Alert) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSpec()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertValidationError{
+				field:  "Spec",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetState()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertValidationError{
+				field:  "State",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AlertMultiError(errors)
+	}
+
+	return nil
+}
+
+// AlertMultiError is an error wrapping multiple validation errors returned by
+// Alert.ValidateAll() if the designated constraints aren't met.
+type AlertMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AlertMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AlertMultiError) AllErrors() []error { return m }
+
+// AlertValidationError is the validation error returned by Alert.Validate if
+// the designated constraints aren't met.
+type AlertValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AlertValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AlertValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AlertValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AlertValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AlertValidationError) ErrorName() string { return "AlertValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AlertValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAlert.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AlertValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AlertValidationError{}
+
+// Validate checks the field values on AlertSpec with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AlertSpec) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AlertSpec with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AlertSpecMultiError, or nil
+// if none found.
+func (m *AlertSpec) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AlertSpec) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Trigger
+
+	// no validation rules for Title
+
+	if all {
+		switch v := interface{}(m.GetRefreshSchedule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertSpecValidationError{
+					field:  "RefreshSchedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertSpecValidationError{
+					field:  "RefreshSchedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefreshSchedule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertSpecValidationError{
+				field:  "RefreshSchedule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TimeoutSeconds
+
+	// no validation rules for QueryName
+
+	// no validation rules for QueryArgsJson
+
+	// no validation rules for QueryTimeRange
+
+	if all {
+		switch v := interface{}(m.GetCondition()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertSpecValidationError{
+					field:  "Condition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertSpecValidationError{
+					field:  "Condition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCondition()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertSpecValidationError{
+				field:  "Condition",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetOwnerAttributes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertSpecValidationError{
+					field:  "OwnerAttributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertSpecValidationError{
+					field:  "OwnerAttributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOwnerAttributes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertSpecValidationError{
+				field:  "OwnerAttributes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AlertSpecMultiError(errors)
+	}
+
+	return nil
+}
+
+// AlertSpecMultiError is an error wrapping multiple validation errors returned
+// by AlertSpec.ValidateAll() if the designated constraints aren't met.
+type AlertSpecMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AlertSpecMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AlertSpecMultiError) AllErrors() []error { return m }
+
+// AlertSpecValidationError is the validation error returned by
+// AlertSpec.Validate if the designated constraints aren't met.
+type AlertSpecValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AlertSpecValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AlertSpecValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AlertSpecValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AlertSpecValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AlertSpecValidationError) ErrorName() string { return "AlertSpecValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AlertSpecValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAlertSpec.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AlertSpecValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AlertSpecValidationError{}
+
+// Validate checks the field values on AlertState with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AlertState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AlertState with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AlertStateMultiError, or
+// nil if none found.
+func (m *AlertState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AlertState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetNextRunOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertStateValidationError{
+					field:  "NextRunOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertStateValidationError{
+					field:  "NextRunOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextRunOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertStateValidationError{
+				field:  "NextRunOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastRunOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertStateValidationError{
+					field:  "LastRunOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertStateValidationError{
+					field:  "LastRunOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastRunOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertStateValidationError{
+				field:  "LastRunOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LastError
+
+	// no validation rules for ExecutionCount
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetStatusChangedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertStateValidationError{
+					field:  "StatusChangedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertStateValidationError{
+					field:  "StatusChangedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatusChangedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertStateValidationError{
+				field:  "StatusChangedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for FiringCount
+
+	if len(errors) > 0 {
+		return AlertStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// AlertStateMultiError is an error wrapping multiple validation errors
+// returned by AlertState.ValidateAll() if the designated constraints aren't met.
+type AlertStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AlertStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AlertStateMultiError) AllErrors() []error { return m }
+
+// AlertStateValidationError is the validation error returned by
+// AlertState.Validate if the designated constraints aren't met.
+type AlertStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AlertStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AlertStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AlertStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AlertStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AlertStateValidationError) ErrorName() string { return "AlertStateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AlertStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAlertState.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AlertStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AlertStateValidationError{}
+
+// Validate checks the field values on Test with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Test) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Test with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TestMultiError, or nil if none found.
+func (m *Test) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Test) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSpec()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TestValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TestValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TestValidationError{
+				field:  "Spec",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetState()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TestValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TestValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TestValidationError{
+				field:  "State",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TestMultiError is an error wrapping multiple validation errors returned by
+// Test.ValidateAll() if the designated constraints aren't met.
+type TestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TestMultiError) AllErrors() []error { return m }
+
+// TestValidationError is the validation error returned by Test.Validate if the
+// designated constraints aren't met.
+type TestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestValidationError) ErrorName() string { return "TestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestValidationError{}
+
+// Validate checks the field values on TestSpec with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TestSpec) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TestSpec with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in TestSpecMultiError, or nil if none
+// found.
+func (m *TestSpec) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TestSpec) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Connector
+
+	// no validation rules for Model
+
+	for idx, item := range m.GetAssertions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TestSpecValidationError{
+						field:  fmt.Sprintf("Assertions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TestSpecValidationError{
+						field:  fmt.Sprintf("Assertions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TestSpecValidationError{
+					field:  fmt.Sprintf("Assertions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TestSpecMultiError(errors)
+	}
+
+	return nil
+}
+
+// TestSpecMultiError is an error wrapping multiple validation errors returned
+// by TestSpec.ValidateAll() if the designated constraints aren't met.
+type TestSpecMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TestSpecMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TestSpecMultiError) AllErrors() []error { return m }
+
+// TestSpecValidationError is the validation error returned by
+// TestSpec.Validate if the designated constraints aren't met.
+type TestSpecValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestSpecValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestSpecValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestSpecValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestSpecValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestSpecValidationError) ErrorName() string { return "TestSpecValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TestSpecValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestSpec.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestSpecValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestSpecValidationError{}
+
+// Validate checks the field values on TestAssertion with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TestAssertion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TestAssertion with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TestAssertionMultiError, or
+// nil if none found.
+func (m *TestAssertion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TestAssertion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Sql
+
+	if len(errors) > 0 {
+		return TestAssertionMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewSpecMultiError is an error wrapping multiple validation errors
-// returned by MetricsViewSpec.ValidateAll() if the designated constraints
-// aren't met.
-type MetricsViewSpecMultiError []error
+// TestAssertionMultiError is an error wrapping multiple validation errors
+// returned by TestAssertion.ValidateAll() if the designated constraints aren't
+// met.
+type TestAssertionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewSpecMultiError) Error() string {
+func (m TestAssertionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2609,11 +5247,11 @@ func (m MetricsViewSpecMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewSpecMultiError) AllErrors() []error { return m }
+func (m TestAssertionMultiError) AllErrors() []error { return m }
 
-// MetricsViewSpecValidationError is the validation error returned by
-// MetricsViewSpec.Validate if the designated constraints aren't met.
-type MetricsViewSpecValidationError struct {
+// TestAssertionValidationError is the validation error returned by
+// TestAssertion.Validate if the designated constraints aren't met.
+type TestAssertionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2621,22 +5259,22 @@ type MetricsViewSpecValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewSpecValidationError) Field() string { return e.field }
+func (e TestAssertionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewSpecValidationError) Reason() string { return e.reason }
+func (e TestAssertionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewSpecValidationError) Cause() error { return e.cause }
+func (e TestAssertionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewSpecValidationError) Key() bool { return e.key }
+func (e TestAssertionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewSpecValidationError) ErrorName() string { return "MetricsViewSpecValidationError" }
+func (e TestAssertionValidationError) ErrorName() string { return "TestAssertionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewSpecValidationError) Error() string {
+func (e TestAssertionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2648,14 +5286,14 @@ func (e MetricsViewSpecValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewSpec.%s: %s%s",
+		"invalid %sTestAssertion.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewSpecValidationError{}
+var _ error = TestAssertionValidationError{}
 
 var _ interface {
 	Field() string
@@ -2663,24 +5301,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewSpecValidationError{}
+} = TestAssertionValidationError{}
 
-// Validate checks the field values on MetricsViewState with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewState) Validate() error {
+// Validate checks the field values on TestState with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TestState) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewState with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// MetricsViewStateMultiError, or nil if none found.
-func (m *MetricsViewState) ValidateAll() error {
+// ValidateAll checks the field values on TestState with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in TestStateMultiError, or nil if none
+// found.
+func (m *TestState) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewState) validate(all bool) error {
+func (m *TestState) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2688,28 +5326,28 @@ func (m *MetricsViewState) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetValidSpec()).(type) {
+		switch v := interface{}(m.GetLastRunOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewStateValidationError{
-					field:  "ValidSpec",
+				errors = append(errors, TestStateValidationError{
+					field:  "LastRunOn",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewStateValidationError{
-					field:  "ValidSpec",
+				errors = append(errors, TestStateValidationError{
+					field:  "LastRunOn",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetValidSpec()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetLastRunOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MetricsViewStateValidationError{
-				field:  "ValidSpec",
+			return TestStateValidationError{
+				field:  "LastRunOn",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -2717,19 +5355,18 @@ func (m *MetricsViewState) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return MetricsViewStateMultiError(errors)
+		return TestStateMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewStateMultiError is an error wrapping multiple validation errors
-// returned by MetricsViewState.ValidateAll() if the designated constraints
-// aren't met.
-type MetricsViewStateMultiError []error
+// TestStateMultiError is an error wrapping multiple validation errors returned
+// by TestState.ValidateAll() if the designated constraints aren't met.
+type TestStateMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewStateMultiError) Error() string {
+func (m TestStateMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2738,11 +5375,11 @@ func (m MetricsViewStateMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewStateMultiError) AllErrors() []error { return m }
+func (m TestStateMultiError) AllErrors() []error { return m }
 
-// MetricsViewStateValidationError is the validation error returned by
-// MetricsViewState.Validate if the designated constraints aren't met.
-type MetricsViewStateValidationError struct {
+// TestStateValidationError is the validation error returned by
+// TestState.Validate if the designated constraints aren't met.
+type TestStateValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2750,22 +5387,22 @@ type MetricsViewStateValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewStateValidationError) Field() string { return e.field }
+func (e TestStateValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewStateValidationError) Reason() string { return e.reason }
+func (e TestStateValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewStateValidationError) Cause() error { return e.cause }
+func (e TestStateValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewStateValidationError) Key() bool { return e.key }
+func (e TestStateValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewStateValidationError) ErrorName() string { return "MetricsViewStateValidationError" }
+func (e TestStateValidationError) ErrorName() string { return "TestStateValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewStateValidationError) Error() string {
+func (e TestStateValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2777,14 +5414,14 @@ func (e MetricsViewStateValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewState.%s: %s%s",
+		"invalid %sTestState.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewStateValidationError{}
+var _ error = TestStateValidationError{}
 
 var _ interface {
 	Field() string
@@ -2792,24 +5429,23 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewStateValidationError{}
+} = TestStateValidationError{}
 
-// Validate checks the field values on Migration with the rules defined in the
+// Validate checks the field values on Macro with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Migration) Validate() error {
+func (m *Macro) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Migration with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in MigrationMultiError, or nil
-// if none found.
-func (m *Migration) ValidateAll() error {
+// ValidateAll checks the field values on Macro with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MacroMultiError, or nil if none found.
+func (m *Macro) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Migration) validate(all bool) error {
+func (m *Macro) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2820,7 +5456,7 @@ func (m *Migration) validate(all bool) error {
 		switch v := interface{}(m.GetSpec()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MigrationValidationError{
+				errors = append(errors, MacroValidationError{
 					field:  "Spec",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2828,7 +5464,7 @@ func (m *Migration) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MigrationValidationError{
+				errors = append(errors, MacroValidationError{
 					field:  "Spec",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2837,7 +5473,7 @@ func (m *Migration) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MigrationValidationError{
+			return MacroValidationError{
 				field:  "Spec",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2849,7 +5485,7 @@ func (m *Migration) validate(all bool) error {
 		switch v := interface{}(m.GetState()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MigrationValidationError{
+				errors = append(errors, MacroValidationError{
 					field:  "State",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2857,7 +5493,7 @@ func (m *Migration) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MigrationValidationError{
+				errors = append(errors, MacroValidationError{
 					field:  "State",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2866,7 +5502,7 @@ func (m *Migration) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MigrationValidationError{
+			return MacroValidationError{
 				field:  "State",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2875,18 +5511,18 @@ func (m *Migration) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return MigrationMultiError(errors)
+		return MacroMultiError(errors)
 	}
 
 	return nil
 }
 
-// MigrationMultiError is an error wrapping multiple validation errors returned
-// by Migration.ValidateAll() if the designated constraints aren't met.
-type MigrationMultiError []error
+// MacroMultiError is an error wrapping multiple validation errors returned by
+// Macro.ValidateAll() if the designated constraints aren't met.
+type MacroMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MigrationMultiError) Error() string {
+func (m MacroMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2895,11 +5531,11 @@ func (m MigrationMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MigrationMultiError) AllErrors() []error { return m }
+func (m MacroMultiError) AllErrors() []error { return m }
 
-// MigrationValidationError is the validation error returned by
-// Migration.Validate if the designated constraints aren't met.
-type MigrationValidationError struct {
+// MacroValidationError is the validation error returned by Macro.Validate if
+// the designated constraints aren't met.
+type MacroValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2907,22 +5543,22 @@ type MigrationValidationError struct {
 }
 
 // Field function returns field value.
-func (e MigrationValidationError) Field() string { return e.field }
+func (e MacroValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MigrationValidationError) Reason() string { return e.reason }
+func (e MacroValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MigrationValidationError) Cause() error { return e.cause }
+func (e MacroValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MigrationValidationError) Key() bool { return e.key }
+func (e MacroValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MigrationValidationError) ErrorName() string { return "MigrationValidationError" }
+func (e MacroValidationError) ErrorName() string { return "MacroValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MigrationValidationError) Error() string {
+func (e MacroValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2934,14 +5570,14 @@ func (e MigrationValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMigration.%s: %s%s",
+		"invalid %sMacro.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MigrationValidationError{}
+var _ error = MacroValidationError{}
 
 var _ interface {
 	Field() string
@@ -2949,50 +5585,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MigrationValidationError{}
+} = MacroValidationError{}
 
-// Validate checks the field values on MigrationSpec with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on MacroSpec with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *MigrationSpec) Validate() error {
+func (m *MacroSpec) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MigrationSpec with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in MigrationSpecMultiError, or
-// nil if none found.
-func (m *MigrationSpec) ValidateAll() error {
+// ValidateAll checks the field values on MacroSpec with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in MacroSpecMultiError, or nil if none
+// found.
+func (m *MacroSpec) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MigrationSpec) validate(all bool) error {
+func (m *MacroSpec) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Connector
-
 	// no validation rules for Sql
 
-	// no validation rules for Version
-
 	if len(errors) > 0 {
-		return MigrationSpecMultiError(errors)
+		return MacroSpecMultiError(errors)
 	}
 
 	return nil
 }
 
-// MigrationSpecMultiError is an error wrapping multiple validation errors
-// returned by MigrationSpec.ValidateAll() if the designated constraints
-// aren't met.
-type MigrationSpecMultiError []error
+// MacroSpecMultiError is an error wrapping multiple validation errors returned
+// by MacroSpec.ValidateAll() if the designated constraints aren't met.
+type MacroSpecMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MigrationSpecMultiError) Error() string {
+func (m MacroSpecMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3001,11 +5632,11 @@ func (m MigrationSpecMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MigrationSpecMultiError) AllErrors() []error { return m }
+func (m MacroSpecMultiError) AllErrors() []error { return m }
 
-// MigrationSpecValidationError is the validation error returned by
-// MigrationSpec.Validate if the designated constraints aren't met.
-type MigrationSpecValidationError struct {
+// MacroSpecValidationError is the validation error returned by
+// MacroSpec.Validate if the designated constraints aren't met.
+type MacroSpecValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3013,22 +5644,22 @@ type MigrationSpecValidationError struct {
 }
 
 // Field function returns field value.
-func (e MigrationSpecValidationError) Field() string { return e.field }
+func (e MacroSpecValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MigrationSpecValidationError) Reason() string { return e.reason }
+func (e MacroSpecValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MigrationSpecValidationError) Cause() error { return e.cause }
+func (e MacroSpecValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MigrationSpecValidationError) Key() bool { return e.key }
+func (e MacroSpecValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MigrationSpecValidationError) ErrorName() string { return "MigrationSpecValidationError" }
+func (e MacroSpecValidationError) ErrorName() string { return "MacroSpecValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MigrationSpecValidationError) Error() string {
+func (e MacroSpecValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3040,14 +5671,14 @@ func (e MigrationSpecValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMigrationSpec.%s: %s%s",
+		"invalid %sMacroSpec.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MigrationSpecValidationError{}
+var _ error = MacroSpecValidationError{}
 
 var _ interface {
 	Field() string
@@ -3055,46 +5686,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MigrationSpecValidationError{}
+} = MacroSpecValidationError{}
 
-// Validate checks the field values on MigrationState with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on MacroState with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *MigrationState) Validate() error {
+func (m *MacroState) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MigrationState with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in MigrationStateMultiError,
-// or nil if none found.
-func (m *MigrationState) ValidateAll() error {
+// ValidateAll checks the field values on MacroState with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in MacroStateMultiError, or nil if
+// none found.
+func (m *MacroState) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MigrationState) validate(all bool) error {
+func (m *MacroState) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Version
+	// no validation rules for SpecHash
 
 	if len(errors) > 0 {
-		return MigrationStateMultiError(errors)
+		return MacroStateMultiError(errors)
 	}
 
 	return nil
 }
 
-// MigrationStateMultiError is an error wrapping multiple validation errors
-// returned by MigrationState.ValidateAll() if the designated constraints
-// aren't met.
-type MigrationStateMultiError []error
+// MacroStateMultiError is an error wrapping multiple validation errors
+// returned by MacroState.ValidateAll() if the designated constraints aren't
+// met.
+type MacroStateMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MigrationStateMultiError) Error() string {
+func (m MacroStateMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3103,11 +5734,11 @@ func (m MigrationStateMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MigrationStateMultiError) AllErrors() []error { return m }
+func (m MacroStateMultiError) AllErrors() []error { return m }
 
-// MigrationStateValidationError is the validation error returned by
-// MigrationState.Validate if the designated constraints aren't met.
-type MigrationStateValidationError struct {
+// MacroStateValidationError is the validation error returned by
+// MacroState.Validate if the designated constraints aren't met.
+type MacroStateValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3115,22 +5746,22 @@ type MigrationStateValidationError struct {
 }
 
 // Field function returns field value.
-func (e MigrationStateValidationError) Field() string { return e.field }
+func (e MacroStateValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MigrationStateValidationError) Reason() string { return e.reason }
+func (e MacroStateValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MigrationStateValidationError) Cause() error { return e.cause }
+func (e MacroStateValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MigrationStateValidationError) Key() bool { return e.key }
+func (e MacroStateValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MigrationStateValidationError) ErrorName() string { return "MigrationStateValidationError" }
+func (e MacroStateValidationError) ErrorName() string { return "MacroStateValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MigrationStateValidationError) Error() string {
+func (e MacroStateValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3142,14 +5773,14 @@ func (e MigrationStateValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMigrationState.%s: %s%s",
+		"invalid %sMacroState.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MigrationStateValidationError{}
+var _ error = MacroStateValidationError{}
 
 var _ interface {
 	Field() string
@@ -3157,7 +5788,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MigrationStateValidationError{}
+} = MacroStateValidationError{}
 
 // Validate checks the field values on PullTrigger with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
@@ -3730,6 +6361,8 @@ func (m *RefreshTriggerSpec) validate(all bool) error {
 
 	}
 
+	// no validation rules for FullRefresh
+
 	if len(errors) > 0 {
 		return RefreshTriggerSpecMultiError(errors)
 	}
@@ -5204,6 +7837,35 @@ func (m *MetricsViewSpec_MeasureV2) validate(all bool) error {
 
 	// no validation rules for ValidPercentOfTotal
 
+	if all {
+		switch v := interface{}(m.GetWindow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewSpec_MeasureV2ValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewSpec_MeasureV2ValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWindow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewSpec_MeasureV2ValidationError{
+				field:  "Window",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return MetricsViewSpec_MeasureV2MultiError(errors)
 	}
@@ -5284,6 +7946,113 @@ var _ interface {
 	ErrorName() string
 } = MetricsViewSpec_MeasureV2ValidationError{}
 
+// Validate checks the field values on MetricsViewSpec_MeasureWindow with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewSpec_MeasureWindow) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewSpec_MeasureWindow with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// MetricsViewSpec_MeasureWindowMultiError, or nil if none found.
+func (m *MetricsViewSpec_MeasureWindow) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewSpec_MeasureWindow) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Partition
+
+	// no validation rules for Frame
+
+	if len(errors) > 0 {
+		return MetricsViewSpec_MeasureWindowMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewSpec_MeasureWindowMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewSpec_MeasureWindow.ValidateAll()
+// if the designated constraints aren't met.
+type MetricsViewSpec_MeasureWindowMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewSpec_MeasureWindowMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewSpec_MeasureWindowMultiError) AllErrors() []error { return m }
+
+// MetricsViewSpec_MeasureWindowValidationError is the validation error
+// returned by MetricsViewSpec_MeasureWindow.Validate if the designated
+// constraints aren't met.
+type MetricsViewSpec_MeasureWindowValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewSpec_MeasureWindowValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewSpec_MeasureWindowValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewSpec_MeasureWindowValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewSpec_MeasureWindowValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewSpec_MeasureWindowValidationError) ErrorName() string {
+	return "MetricsViewSpec_MeasureWindowValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewSpec_MeasureWindowValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewSpec_MeasureWindow.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewSpec_MeasureWindowValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewSpec_MeasureWindowValidationError{}
+
 // Validate checks the field values on MetricsViewSpec_SecurityV2 with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -0,0 +1,363 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"go.uber.org/zap"
+)
+
+// _defaultControllerLeaseTTL is the default duration a node owns an instance's controller without renewing its lease.
+const _defaultControllerLeaseTTL = 30 * time.Second
+
+// ErrControllerNotRunning is returned when an instance's controller is not running on the current node.
+var ErrControllerNotRunning = errors.New("controller is not running on this node")
+
+// controllerHandle tracks a controller that runs on the current node because it holds the instance's lease.
+type controllerHandle struct {
+	lease  *drivers.InstanceLease
+	ctrl   *Controller // Nil until the controller has been created
+	cancel context.CancelFunc
+	done   chan struct{}
+	// err and failedOn are set if the controller stopped unexpectedly. It's restarted after the lease TTL has passed.
+	err      error
+	failedOn time.Time
+}
+
+// NodeID returns the ID that identifies the current runtime process among processes sharing the same registry.
+func (r *Runtime) NodeID() string {
+	return r.nodeID
+}
+
+// Controller returns the controller for an instance. It returns ErrControllerNotRunning if the controller is not running on this node.
+func (r *Runtime) Controller(instanceID string) (*Controller, error) {
+	r.controllersMu.Lock()
+	defer r.controllersMu.Unlock()
+
+	h := r.controllers[instanceID]
+	if h != nil && h.err != nil {
+		return nil, fmt.Errorf("controller for instance %q failed: %w", instanceID, h.err)
+	}
+	if h == nil || h.ctrl == nil || !h.ctrl.running.Load() {
+		return nil, fmt.Errorf("instance %q: %w", instanceID, ErrControllerNotRunning)
+	}
+	return h.ctrl, nil
+}
+
+// ControllerNode returns the gRPC address of the node that runs the controller for an instance.
+// It returns local=true if requests for the instance should be handled by the current node.
+// That's also the case if controllers are not running or if no node currently holds the instance's lease.
+func (r *Runtime) ControllerNode(ctx context.Context, instanceID string) (address string, local bool, err error) {
+	l, err := r.controllerLease(ctx, instanceID)
+	if err != nil {
+		return "", false, err
+	}
+	if l == nil {
+		return "", true, nil
+	}
+	if l.NodeAddress == "" {
+		return "", false, fmt.Errorf("instance %q is owned by node %q, which has no registered address", instanceID, l.NodeID)
+	}
+	return l.NodeAddress, false, nil
+}
+
+// ControllerHTTPNode is like ControllerNode, but returns the HTTP address of the node that runs the controller for an instance.
+func (r *Runtime) ControllerHTTPNode(ctx context.Context, instanceID string) (address string, local bool, err error) {
+	l, err := r.controllerLease(ctx, instanceID)
+	if err != nil {
+		return "", false, err
+	}
+	if l == nil {
+		return "", true, nil
+	}
+	if l.NodeHTTPAddress == "" {
+		return "", false, fmt.Errorf("instance %q is owned by node %q, which has no registered HTTP address", instanceID, l.NodeID)
+	}
+	return l.NodeHTTPAddress, false, nil
+}
+
+// controllerLease returns the lease of the other node that runs the controller for an instance.
+// It returns nil if requests for the instance should be handled by the current node.
+func (r *Runtime) controllerLease(ctx context.Context, instanceID string) (*drivers.InstanceLease, error) {
+	r.controllersMu.Lock()
+	running := r.controllersRunning
+	_, owned := r.controllers[instanceID]
+	r.controllersMu.Unlock()
+	if !running || owned {
+		return nil, nil
+	}
+
+	l, err := r.Registry().FindInstanceLease(ctx, instanceID)
+	if err != nil {
+		if errors.Is(err, drivers.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if l.NodeID == r.nodeID || l.Expired() {
+		return nil, nil
+	}
+	return l, nil
+}
+
+// RunControllers registers the current node in the registry and runs controllers for the instances it holds leases for.
+// Leases are spread evenly across the live nodes sharing the registry. When a node stops renewing its leases
+// (e.g. because it crashed), the other nodes take over its instances once the leases expire.
+// It blocks until ctx is cancelled, at which point it stops all controllers and releases their leases.
+func (r *Runtime) RunControllers(ctx context.Context) error {
+	r.controllersMu.Lock()
+	if r.controllersRunning {
+		r.controllersMu.Unlock()
+		return errors.New("controllers are already running")
+	}
+	r.controllersRunning = true
+	r.controllersMu.Unlock()
+
+	ttl := r.opts.ControllerLeaseTTL
+	if ttl <= 0 {
+		ttl = _defaultControllerLeaseTTL
+	}
+
+	// Renew leases well before they expire
+	ticker := time.NewTicker(ttl / 3)
+	defer ticker.Stop()
+
+	for ctx.Err() == nil {
+		err := r.syncControllers(ctx, ttl)
+		if err != nil && ctx.Err() == nil {
+			r.logger.Warn("failed to sync controllers", zap.String("node_id", r.nodeID), zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-ticker.C:
+		}
+	}
+
+	// Stop all controllers and release their leases
+	r.controllersMu.Lock()
+	ids := make([]string, 0, len(r.controllers))
+	for id := range r.controllers {
+		ids = append(ids, id)
+	}
+	r.controllersMu.Unlock()
+	for _, id := range ids {
+		r.stopController(id, true)
+	}
+
+	// Remove the node from the registry so other nodes don't count it as live
+	err := r.Registry().DeleteNode(context.Background(), r.nodeID)
+
+	r.controllersMu.Lock()
+	r.controllersRunning = false
+	r.controllersMu.Unlock()
+
+	return err
+}
+
+// syncControllers records a heartbeat for the current node, renews the leases it holds,
+// and starts or stops controllers so the node holds its fair share of the instances in the registry.
+func (r *Runtime) syncControllers(ctx context.Context, ttl time.Duration) error {
+	reg := r.Registry()
+
+	err := reg.UpsertNode(ctx, &drivers.Node{ID: r.nodeID, Address: r.opts.NodeAddress, HTTPAddress: r.opts.NodeHTTPAddress})
+	if err != nil {
+		// We can't reach the registry, so stop any controllers whose leases may have been taken over by another node
+		r.stopExpiredControllers()
+		return err
+	}
+
+	insts, err := reg.FindInstances(ctx)
+	if err != nil {
+		return err
+	}
+
+	nodes, err := reg.FindNodes(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Compute the number of instances each live node should own
+	live := 0
+	for _, n := range nodes {
+		if n.ID == r.nodeID || time.Since(n.HeartbeatOn) < ttl {
+			live++
+		}
+	}
+	if live == 0 {
+		live = 1
+	}
+	share := (len(insts) + live - 1) / live
+
+	exists := make(map[string]bool, len(insts))
+	for _, inst := range insts {
+		exists[inst.ID] = true
+	}
+
+	// Renew leases for the controllers running on this node
+	r.controllersMu.Lock()
+	held := make([]string, 0, len(r.controllers))
+	for id := range r.controllers {
+		held = append(held, id)
+	}
+	r.controllersMu.Unlock()
+	sort.Strings(held)
+
+	var errs []error
+	owned := make([]string, 0, len(held))
+	for _, id := range held {
+		if !exists[id] {
+			r.stopController(id, true)
+			continue
+		}
+
+		l, err := reg.AcquireInstanceLease(ctx, id, r.nodeID, ttl)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to renew lease for instance %q: %w", id, err))
+			r.stopExpiredControllers()
+			continue
+		}
+		if l.NodeID != r.nodeID {
+			r.logger.Warn("lost controller lease", zap.String("instance_id", id), zap.String("node_id", r.nodeID), zap.String("new_node_id", l.NodeID))
+			r.stopController(id, false)
+			continue
+		}
+
+		r.controllersMu.Lock()
+		h := r.controllers[id]
+		restart := false
+		if h != nil {
+			h.lease = l
+			restart = h.err != nil && time.Since(h.failedOn) >= ttl
+		}
+		r.controllersMu.Unlock()
+		if restart {
+			r.startController(id, l)
+		}
+		owned = append(owned, id)
+	}
+
+	// Hand over instances in excess of our share so other nodes can pick them up.
+	// This rebalances instances when a new node joins.
+	for len(owned) > share {
+		id := owned[len(owned)-1]
+		owned = owned[:len(owned)-1]
+		r.logger.Info("releasing controller lease to rebalance", zap.String("instance_id", id), zap.String("node_id", r.nodeID))
+		r.stopController(id, true)
+	}
+
+	// Acquire leases for instances that are not owned by a live node
+	isOwned := make(map[string]bool, len(owned))
+	for _, id := range owned {
+		isOwned[id] = true
+	}
+	for _, inst := range insts {
+		if len(owned) >= share {
+			break
+		}
+		if isOwned[inst.ID] {
+			continue
+		}
+
+		l, err := reg.AcquireInstanceLease(ctx, inst.ID, r.nodeID, ttl)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to acquire lease for instance %q: %w", inst.ID, err))
+			continue
+		}
+		if l.NodeID != r.nodeID {
+			continue
+		}
+
+		r.startController(inst.ID, l)
+		owned = append(owned, inst.ID)
+	}
+
+	return errors.Join(errs...)
+}
+
+// startController starts a controller for an instance that the current node holds the lease for.
+func (r *Runtime) startController(instanceID string, lease *drivers.InstanceLease) {
+	ctx, cancel := context.WithCancel(context.Background())
+	h := &controllerHandle{
+		lease:  lease,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	r.controllersMu.Lock()
+	r.controllers[instanceID] = h
+	r.controllersMu.Unlock()
+
+	r.logger.Info("starting controller", zap.String("instance_id", instanceID), zap.String("node_id", r.nodeID), zap.Int64("lease_version", lease.Version))
+
+	go func() {
+		defer close(h.done)
+
+		ctrl, err := NewController(ctx, r, instanceID, r.logger)
+		if err == nil {
+			r.controllersMu.Lock()
+			h.ctrl = ctrl
+			r.controllersMu.Unlock()
+
+			err = ctrl.Run(ctx)
+		}
+		if ctx.Err() != nil {
+			// The controller was stopped by stopController
+			return
+		}
+
+		// The controller failed. We keep the lease and let syncControllers restart it after a delay.
+		if err == nil {
+			err = errors.New("controller stopped unexpectedly")
+		}
+		r.logger.Error("controller failed", zap.String("instance_id", instanceID), zap.String("node_id", r.nodeID), zap.Error(err))
+		r.controllersMu.Lock()
+		h.err = err
+		h.failedOn = time.Now()
+		r.controllersMu.Unlock()
+	}()
+}
+
+// stopController stops the controller for an instance and waits for it to exit.
+// If release is true, it also releases the instance's lease so another node can take over immediately.
+func (r *Runtime) stopController(instanceID string, release bool) {
+	r.controllersMu.Lock()
+	h := r.controllers[instanceID]
+	delete(r.controllers, instanceID)
+	r.controllersMu.Unlock()
+	if h == nil {
+		return
+	}
+
+	r.logger.Info("stopping controller", zap.String("instance_id", instanceID), zap.String("node_id", r.nodeID))
+	h.cancel()
+	<-h.done
+
+	if release {
+		err := r.Registry().ReleaseInstanceLease(context.Background(), instanceID, r.nodeID)
+		if err != nil {
+			r.logger.Warn("failed to release controller lease", zap.String("instance_id", instanceID), zap.Error(err))
+		}
+	}
+}
+
+// stopExpiredControllers stops controllers whose leases have expired without being renewed.
+// Another node may already have taken over their instances.
+func (r *Runtime) stopExpiredControllers() {
+	r.controllersMu.Lock()
+	var expired []string
+	for id, h := range r.controllers {
+		if h.lease.Expired() {
+			expired = append(expired, id)
+		}
+	}
+	r.controllersMu.Unlock()
+
+	for _, id := range expired {
+		r.stopController(id, false)
+	}
+}
@@ -0,0 +1,210 @@
+package rillv1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/duration"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gopkg.in/yaml.v3"
+)
+
+// alertYAML is the raw structure of an Alert resource defined in YAML
+type alertYAML struct {
+	commonYAML `yaml:",inline"` // Not accessed here, only setting it so we can use KnownFields for YAML parsing
+	Title      string           `yaml:"title"`
+	Refresh    *scheduleYAML    `yaml:"refresh"`
+	Timeout    string           `yaml:"timeout"`
+	Query      struct {
+		Name      string         `yaml:"name"`
+		Args      map[string]any `yaml:"args"`
+		TimeRange string         `yaml:"time_range"`
+	} `yaml:"query"`
+	Condition struct {
+		Measure  string    `yaml:"measure"`
+		Operator string    `yaml:"operator"`
+		Value    yaml.Node `yaml:"value"`
+	} `yaml:"condition"`
+	Owner map[string]any `yaml:"owner"`
+	Email struct {
+		Recipients []string `yaml:"recipients"`
+	} `yaml:"email"`
+	Webhooks []string `yaml:"webhooks"`
+}
+
+// parseAlert parses an alert definition and adds the resulting resource to p.Resources.
+func (p *Parser) parseAlert(ctx context.Context, node *Node) error {
+	// Parse YAML
+	tmp := &alertYAML{}
+	if node.YAMLRaw != "" {
+		// Can't use node.YAML because we need to set KnownFields for alerts
+		dec := yaml.NewDecoder(strings.NewReader(node.YAMLRaw))
+		dec.KnownFields(true)
+		if err := dec.Decode(tmp); err != nil {
+			return pathError{path: node.YAMLPath, err: newYAMLError(err)}
+		}
+	}
+
+	// Validate SQL or connector isn't set
+	if node.SQL != "" {
+		return fmt.Errorf("alerts cannot have SQL")
+	}
+	if !node.ConnectorInferred && node.Connector != "" {
+		return fmt.Errorf("alerts cannot have a connector")
+	}
+
+	// Parse refresh schedule
+	schedule, err := parseScheduleYAML(tmp.Refresh)
+	if err != nil {
+		return err
+	}
+	if schedule == nil {
+		return errors.New(`must set a "refresh" schedule for alerts`)
+	}
+
+	// Parse timeout
+	var timeout time.Duration
+	if tmp.Timeout != "" {
+		timeout, err = parseDuration(tmp.Timeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Parse and validate the query
+	if tmp.Query.Name != "MetricsViewAggregation" {
+		return fmt.Errorf(`invalid "query.name" %q: only "MetricsViewAggregation" is supported for alerts`, tmp.Query.Name)
+	}
+	if tmp.Query.Args == nil {
+		tmp.Query.Args = map[string]any{}
+	}
+	argsJSON, err := json.Marshal(tmp.Query.Args)
+	if err != nil {
+		return fmt.Errorf(`invalid "query.args": %w`, err)
+	}
+	req := &runtimev1.MetricsViewAggregationRequest{}
+	err = protojson.Unmarshal(argsJSON, req)
+	if err != nil {
+		return fmt.Errorf(`invalid "query.args" for %s: %w`, tmp.Query.Name, err)
+	}
+	if req.MetricsView == "" {
+		return errors.New(`must set "metrics_view" in "query.args"`)
+	}
+	if tmp.Query.TimeRange != "" {
+		_, err := duration.ParseISO8601(tmp.Query.TimeRange)
+		if err != nil {
+			return fmt.Errorf(`invalid "query.time_range": %w`, err)
+		}
+	}
+
+	// Parse condition
+	condition, err := parseAlertCondition(tmp.Condition.Measure, tmp.Condition.Operator, &tmp.Condition.Value, req.Measures)
+	if err != nil {
+		return err
+	}
+
+	// Parse owner attributes
+	var owner *structpb.Struct
+	if len(tmp.Owner) > 0 {
+		owner, err = structpb.NewStruct(tmp.Owner)
+		if err != nil {
+			return fmt.Errorf(`invalid "owner": %w`, err)
+		}
+	}
+
+	// Validate recipients
+	if len(tmp.Email.Recipients) == 0 && len(tmp.Webhooks) == 0 {
+		return errors.New(`must set at least one email recipient or webhook for alerts`)
+	}
+	err = validateRecipients(tmp.Email.Recipients, tmp.Webhooks)
+	if err != nil {
+		return err
+	}
+
+	node.Refs = append(node.Refs, ResourceName{Kind: ResourceKindMetricsView, Name: req.MetricsView})
+
+	// NOTE: After calling upsertResource, an error must not be returned. Any validation should be done before calling it.
+	r := p.upsertResource(ResourceKindAlert, node.Name, node.Paths, node.Refs...)
+	spec := r.AlertSpec
+
+	spec.Title = tmp.Title
+	spec.RefreshSchedule = schedule
+	if timeout != 0 {
+		spec.TimeoutSeconds = uint32(timeout.Seconds())
+	}
+	spec.QueryName = tmp.Query.Name
+	spec.QueryArgsJson = string(argsJSON)
+	spec.QueryTimeRange = tmp.Query.TimeRange
+	spec.Condition = condition
+	spec.OwnerAttributes = owner
+	spec.EmailRecipients = tmp.Email.Recipients
+	spec.WebhookUrls = tmp.Webhooks
+
+	return nil
+}
+
+// parseAlertCondition parses an alert's condition into an expression that can be used as a having clause.
+// The condition's measure must be one of the measures selected by the query.
+func parseAlertCondition(measure, operator string, value *yaml.Node, measures []*runtimev1.MetricsViewAggregationMeasure) (*runtimev1.Expression, error) {
+	if measure == "" {
+		return nil, errors.New(`must set "condition.measure" for alerts`)
+	}
+	found := false
+	for _, m := range measures {
+		if m.Name == measure {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf(`invalid "condition.measure": measure %q is not selected in "query.args"`, measure)
+	}
+
+	var op runtimev1.Operation
+	switch operator {
+	case ">":
+		op = runtimev1.Operation_OPERATION_GT
+	case ">=":
+		op = runtimev1.Operation_OPERATION_GTE
+	case "<":
+		op = runtimev1.Operation_OPERATION_LT
+	case "<=":
+		op = runtimev1.Operation_OPERATION_LTE
+	case "=", "==":
+		op = runtimev1.Operation_OPERATION_EQ
+	case "!=":
+		op = runtimev1.Operation_OPERATION_NEQ
+	default:
+		return nil, fmt.Errorf(`invalid "condition.operator" %q: must be one of ">", ">=", "<", "<=", "=" or "!="`, operator)
+	}
+
+	if value.IsZero() {
+		return nil, errors.New(`must set "condition.value" for alerts`)
+	}
+	var v any
+	if err := value.Decode(&v); err != nil {
+		return nil, fmt.Errorf(`invalid "condition.value": %w`, err)
+	}
+	val, err := structpb.NewValue(v)
+	if err != nil {
+		return nil, fmt.Errorf(`invalid "condition.value": %w`, err)
+	}
+
+	return &runtimev1.Expression{
+		Expression: &runtimev1.Expression_Cond{
+			Cond: &runtimev1.Condition{
+				Op: op,
+				Exprs: []*runtimev1.Expression{
+					{Expression: &runtimev1.Expression_Ident{Ident: measure}},
+					{Expression: &runtimev1.Expression_Val{Val: val}},
+				},
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,63 @@
+package rillv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// macroArgRegex matches valid macro argument names (they must be accessible as .args.<name> in templates)
+var macroArgRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// macroYAML is the raw structure of a Macro resource defined in YAML (does not include common fields)
+type macroYAML struct {
+	// Args may be a list or a comma-separated string (e.g. in a SQL annotation like "-- @args: col, country")
+	Args []string `yaml:"args" mapstructure:"args"`
+}
+
+// parseMacro parses a macro definition and adds the resulting resource to p.Resources.
+func (p *Parser) parseMacro(ctx context.Context, node *Node) error {
+	// Parse YAML
+	tmp := &macroYAML{}
+	if node.YAML != nil {
+		if err := node.YAML.Decode(tmp); err != nil {
+			return pathError{path: node.YAMLPath, err: newYAMLError(err)}
+		}
+	}
+
+	// Override YAML config with SQL annotations
+	err := mapstructureUnmarshal(node.SQLAnnotations, tmp)
+	if err != nil {
+		return pathError{path: node.SQLPath, err: fmt.Errorf("invalid SQL annotations: %w", err)}
+	}
+
+	// Parse and validate args
+	var args []string
+	seen := make(map[string]bool)
+	for _, arg := range tmp.Args {
+		for _, name := range strings.Split(arg, ",") {
+			name = strings.TrimSpace(name)
+			if !macroArgRegex.MatchString(name) {
+				return fmt.Errorf("invalid macro arg name %q", name)
+			}
+			if seen[name] {
+				return fmt.Errorf("duplicate macro arg name %q", name)
+			}
+			seen[name] = true
+			args = append(args, name)
+		}
+	}
+
+	if strings.TrimSpace(node.SQL) == "" {
+		return errors.New("a macro must have SQL")
+	}
+
+	// NOTE: After calling upsertResource, an error must not be returned. Any validation should be done before calling it.
+	r := p.upsertResource(ResourceKindMacro, node.Name, node.Paths, node.Refs...)
+	r.MacroSpec.Args = args
+	r.MacroSpec.Sql = strings.TrimSpace(node.SQL)
+
+	return nil
+}
@@ -39,9 +39,11 @@ type metricsViewYAML struct {
 		Label               string
 		Expression          string
 		Description         string
-		Format              string `yaml:"format_preset"`
-		Ignore              bool   `yaml:"ignore"`
-		ValidPercentOfTotal bool   `yaml:"valid_percent_of_total"`
+		Format              string    `yaml:"format_preset"`
+		Ignore              bool      `yaml:"ignore"`
+		ValidPercentOfTotal bool      `yaml:"valid_percent_of_total"`
+		Requires            []string  `yaml:"requires"`
+		Window              yaml.Node `yaml:"window"` // Either a bool or a metricsViewMeasureWindowYAML
 	}
 	Security *struct {
 		Access    string `yaml:"access"`
@@ -57,6 +59,82 @@ type metricsViewYAML struct {
 	}
 }
 
+// metricsViewMeasureWindowYAML is the raw structure of a measure's window defined in YAML
+type metricsViewMeasureWindowYAML struct {
+	Partition *bool  `yaml:"partition"`
+	Frame     string `yaml:"frame"`
+}
+
+// ParseMeasureWindow parses the "window" property of a measure.
+// It returns nil if the property is not set or false.
+func ParseMeasureWindow(node *yaml.Node) (*runtimev1.MetricsViewSpec_MeasureWindow, error) {
+	if node.IsZero() {
+		return nil, nil
+	}
+
+	if node.Kind == yaml.ScalarNode {
+		var enabled bool
+		if err := node.Decode(&enabled); err != nil {
+			return nil, fmt.Errorf(`invalid "window": must be a boolean or a mapping`)
+		}
+		if !enabled {
+			return nil, nil
+		}
+		return &runtimev1.MetricsViewSpec_MeasureWindow{Partition: true}, nil
+	}
+
+	tmp := &metricsViewMeasureWindowYAML{}
+	if err := node.Decode(tmp); err != nil {
+		return nil, fmt.Errorf(`invalid "window": %w`, newYAMLError(err))
+	}
+
+	res := &runtimev1.MetricsViewSpec_MeasureWindow{Partition: true, Frame: tmp.Frame}
+	if tmp.Partition != nil {
+		res.Partition = *tmp.Partition
+	}
+	return res, nil
+}
+
+// ValidateMeasureReferences checks that the measures referenced by other measures exist and don't form a cycle.
+// The names are checked in order, and the refs map is keyed by measure name.
+func ValidateMeasureReferences(names []string, refs map[string][]string) error {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(refs))
+
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case visiting:
+			return fmt.Errorf("found cycle in measure references: %s", strings.Join(append(path, name), " -> "))
+		case visited:
+			return nil
+		}
+
+		state[name] = visiting
+		for _, ref := range refs[name] {
+			if _, ok := refs[ref]; !ok {
+				return fmt.Errorf("measure %q references measure %q, which does not exist", name, ref)
+			}
+			if err := visit(ref, append(path, name)); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		return nil
+	}
+
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // parseMetricsView parses a metrics view (dashboard) definition and adds the resulting resource to p.Resources.
 func (p *Parser) parseMetricsView(ctx context.Context, node *Node) error {
 	// Parse YAML
@@ -146,6 +224,9 @@ func (p *Parser) parseMetricsView(ctx context.Context, node *Node) error {
 	}
 
 	measureCount := 0
+	var measureNames []string
+	measureRefs := make(map[string][]string)
+	measureWindows := make(map[string]*runtimev1.MetricsViewSpec_MeasureWindow)
 	for i, measure := range tmp.Measures {
 		if measure.Ignore {
 			continue
@@ -167,11 +248,29 @@ func (p *Parser) parseMetricsView(ctx context.Context, node *Node) error {
 		if ok := columns[lower]; ok {
 			return fmt.Errorf("measure name %q coincides with a dimension column name", measure.Name)
 		}
+
+		measureNames = append(measureNames, measure.Name)
+		measureRefs[measure.Name] = measure.Requires
+
+		window, err := ParseMeasureWindow(&measure.Window)
+		if err != nil {
+			return fmt.Errorf("invalid measure %q: %w", measure.Name, err)
+		}
+		if window != nil {
+			if tmp.TimeDimension == "" {
+				return fmt.Errorf("measure %q has a window, which requires the %q field to be set", measure.Name, "timeseries")
+			}
+			measureWindows[measure.Name] = window
+		}
 	}
 	if measureCount == 0 {
 		return fmt.Errorf("must define at least one measure")
 	}
 
+	if err := ValidateMeasureReferences(measureNames, measureRefs); err != nil {
+		return err
+	}
+
 	if tmp.Security != nil {
 		templateData := TemplateData{User: map[string]interface{}{
 			"name":   "dummy",
@@ -296,6 +395,8 @@ func (p *Parser) parseMetricsView(ctx context.Context, node *Node) error {
 			Description:         measure.Description,
 			Format:              measure.Format,
 			ValidPercentOfTotal: measure.ValidPercentOfTotal,
+			ReferencedMeasures:  measure.Requires,
+			Window:              measureWindows[measure.Name],
 		})
 	}
 
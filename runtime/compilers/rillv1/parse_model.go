@@ -14,6 +14,11 @@ type modelYAML struct {
 	Materialize  *bool         `yaml:"materialize" mapstructure:"materialize"`
 	Timeout      string        `yaml:"timeout" mapstructure:"timeout"`
 	Refresh      *scheduleYAML `yaml:"refresh" mapstructure:"refresh"`
+	Incremental  *bool         `yaml:"incremental" mapstructure:"incremental"`
+	UniqueKey    []string      `yaml:"unique_key" mapstructure:"unique_key"`
+	Snapshot     *bool         `yaml:"snapshot" mapstructure:"snapshot"`
+	UpdatedAt    string        `yaml:"updated_at" mapstructure:"updated_at"`
+	CheckColumns []string      `yaml:"check_columns" mapstructure:"check_columns"`
 	ParserConfig struct {
 		DuckDB struct {
 			InferRefs *bool `yaml:"infer_refs" mapstructure:"infer_refs"`
@@ -57,6 +62,44 @@ func (p *Parser) parseModel(ctx context.Context, node *Node) error {
 		return err
 	}
 
+	// Validate incremental config
+	incremental := tmp.Incremental != nil && *tmp.Incremental
+	snapshot := tmp.Snapshot != nil && *tmp.Snapshot
+	if incremental && tmp.Materialize != nil && !*tmp.Materialize {
+		return fmt.Errorf("an incremental model must be materialized")
+	}
+	if !incremental && !snapshot && len(tmp.UniqueKey) > 0 {
+		return fmt.Errorf(`the "unique_key" property can only be set for incremental or snapshot models`)
+	}
+	for _, k := range tmp.UniqueKey {
+		if k == "" {
+			return fmt.Errorf(`the "unique_key" property must not contain empty column names`)
+		}
+	}
+
+	// Validate snapshot config
+	if snapshot {
+		if incremental {
+			return fmt.Errorf("a model can't be both incremental and a snapshot")
+		}
+		if tmp.Materialize != nil && !*tmp.Materialize {
+			return fmt.Errorf("a snapshot model must be materialized")
+		}
+		if len(tmp.UniqueKey) == 0 {
+			return fmt.Errorf(`a snapshot model must set the "unique_key" property`)
+		}
+		if (tmp.UpdatedAt == "") == (len(tmp.CheckColumns) == 0) {
+			return fmt.Errorf(`a snapshot model must set exactly one of the "updated_at" and "check_columns" properties`)
+		}
+		for _, c := range tmp.CheckColumns {
+			if c == "" {
+				return fmt.Errorf(`the "check_columns" property must not contain empty column names`)
+			}
+		}
+	} else if tmp.UpdatedAt != "" || len(tmp.CheckColumns) > 0 {
+		return fmt.Errorf(`the "updated_at" and "check_columns" properties can only be set for snapshot models`)
+	}
+
 	// If the connector is a DuckDB connector, extract info using DuckDB SQL parsing.
 	// (If templating was used, we skip DuckDB inference because the DuckDB parser may not be able to parse the templated code.)
 	isDuckDB := false
@@ -72,18 +115,11 @@ func (p *Parser) parseModel(ctx context.Context, node *Node) error {
 	}
 	refs := node.Refs
 	if isDuckDB && !node.SQLUsesTemplating && node.SQL != "" && duckDBInferRefs {
-		// Parse the SQL
-		ast, err := duckdbsql.Parse(node.SQL)
+		tableRefs, err := duckDBTableRefs(node.SQL)
 		if err != nil {
-			return pathError{path: node.SQLPath, err: newDuckDBError(err)}
-		}
-
-		// Scan SQL for table references, tracking references in refs
-		for _, t := range ast.GetTableRefs() {
-			if !t.LocalAlias && t.Name != "" && t.Function == "" && len(t.Paths) == 0 {
-				refs = append(refs, ResourceName{Name: t.Name})
-			}
+			return pathError{path: node.SQLPath, err: err}
 		}
+		refs = append(refs, tableRefs...)
 	}
 
 	// NOTE: After calling upsertResource, an error must not be returned. Any validation should be done before calling it.
@@ -100,6 +136,16 @@ func (p *Parser) parseModel(ctx context.Context, node *Node) error {
 	if tmp.Materialize != nil {
 		r.ModelSpec.Materialize = tmp.Materialize
 	}
+	if tmp.Incremental != nil {
+		r.ModelSpec.Incremental = incremental
+		r.ModelSpec.UniqueKey = tmp.UniqueKey
+	}
+	if tmp.Snapshot != nil {
+		r.ModelSpec.Snapshot = snapshot
+		r.ModelSpec.UniqueKey = tmp.UniqueKey
+		r.ModelSpec.SnapshotUpdatedAt = tmp.UpdatedAt
+		r.ModelSpec.SnapshotCheckColumns = tmp.CheckColumns
+	}
 	if timeout > 0 {
 		r.ModelSpec.TimeoutSeconds = uint32(timeout.Seconds())
 	}
@@ -107,6 +153,12 @@ func (p *Parser) parseModel(ctx context.Context, node *Node) error {
 		r.ModelSpec.RefreshSchedule = schedule
 	}
 
+	// Incremental and snapshot models are always materialized
+	if (r.ModelSpec.Incremental || r.ModelSpec.Snapshot) && r.ModelSpec.Materialize == nil {
+		b := true
+		r.ModelSpec.Materialize = &b
+	}
+
 	// parseSource calls parseModel for SQL sources without a connector. Materialize such models.
 	if node.Kind == ResourceKindSource && r.ModelSpec.Materialize == nil {
 		b := true
@@ -115,3 +167,21 @@ func (p *Parser) parseModel(ctx context.Context, node *Node) error {
 
 	return nil
 }
+
+// duckDBTableRefs parses a DuckDB SQL query and returns refs for the tables it references.
+func duckDBTableRefs(sql string) ([]ResourceName, error) {
+	// Parse the SQL
+	ast, err := duckdbsql.Parse(sql)
+	if err != nil {
+		return nil, newDuckDBError(err)
+	}
+
+	// Scan SQL for table references
+	var refs []ResourceName
+	for _, t := range ast.GetTableRefs() {
+		if !t.LocalAlias && t.Name != "" && t.Function == "" && len(t.Paths) == 0 {
+			refs = append(refs, ResourceName{Name: t.Name})
+		}
+	}
+	return refs, nil
+}
@@ -40,6 +40,14 @@ func (p *Parser) parseNode(ctx context.Context, node *Node) error {
 		return p.parseMetricsView(ctx, node)
 	case ResourceKindMigration:
 		return p.parseMigration(ctx, node)
+	case ResourceKindReport:
+		return p.parseReport(ctx, node)
+	case ResourceKindAlert:
+		return p.parseAlert(ctx, node)
+	case ResourceKindTest:
+		return p.parseTest(ctx, node)
+	case ResourceKindMacro:
+		return p.parseMacro(ctx, node)
 	default:
 		panic(fmt.Errorf("unexpected resource kind: %s", node.Kind.String()))
 	}
@@ -216,6 +224,14 @@ func (p *Parser) parseStem(ctx context.Context, paths []string, ymlPath, yml, sq
 			res.Kind = ResourceKindMetricsView
 		} else if strings.HasPrefix(paths[0], "/init.sql") {
 			res.Kind = ResourceKindMigration
+		} else if strings.HasPrefix(paths[0], "/reports") {
+			res.Kind = ResourceKindReport
+		} else if strings.HasPrefix(paths[0], "/alerts") {
+			res.Kind = ResourceKindAlert
+		} else if strings.HasPrefix(paths[0], "/tests") {
+			res.Kind = ResourceKindTest
+		} else if strings.HasPrefix(paths[0], "/macros") {
+			res.Kind = ResourceKindMacro
 		} else {
 			path := ymlPath
 			if path == "" {
@@ -0,0 +1,198 @@
+package rillv1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gopkg.in/yaml.v3"
+)
+
+// reportYAML is the raw structure of a Report resource defined in YAML
+type reportYAML struct {
+	commonYAML `yaml:",inline"` // Not accessed here, only setting it so we can use KnownFields for YAML parsing
+	Title      string           `yaml:"title"`
+	Refresh    *scheduleYAML    `yaml:"refresh"`
+	Timeout    string           `yaml:"timeout"`
+	Query      struct {
+		Name string         `yaml:"name"`
+		Args map[string]any `yaml:"args"`
+	} `yaml:"query"`
+	Export struct {
+		Format string `yaml:"format"`
+		Limit  uint64 `yaml:"limit"`
+	} `yaml:"export"`
+	Owner map[string]any `yaml:"owner"`
+	Email struct {
+		Recipients []string `yaml:"recipients"`
+	} `yaml:"email"`
+	Webhooks []string `yaml:"webhooks"`
+}
+
+// parseReport parses a report definition and adds the resulting resource to p.Resources.
+func (p *Parser) parseReport(ctx context.Context, node *Node) error {
+	// Parse YAML
+	tmp := &reportYAML{}
+	if node.YAMLRaw != "" {
+		// Can't use node.YAML because we need to set KnownFields for reports
+		dec := yaml.NewDecoder(strings.NewReader(node.YAMLRaw))
+		dec.KnownFields(true)
+		if err := dec.Decode(tmp); err != nil {
+			return pathError{path: node.YAMLPath, err: newYAMLError(err)}
+		}
+	}
+
+	// Validate SQL or connector isn't set
+	if node.SQL != "" {
+		return fmt.Errorf("reports cannot have SQL")
+	}
+	if !node.ConnectorInferred && node.Connector != "" {
+		return fmt.Errorf("reports cannot have a connector")
+	}
+
+	// Parse refresh schedule
+	schedule, err := parseScheduleYAML(tmp.Refresh)
+	if err != nil {
+		return err
+	}
+	if schedule == nil {
+		return errors.New(`must set a "refresh" schedule for reports`)
+	}
+
+	// Parse timeout
+	var timeout time.Duration
+	if tmp.Timeout != "" {
+		timeout, err = parseDuration(tmp.Timeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Parse and validate the query
+	argsJSON, metricsViewName, err := parseReportQuery(tmp.Query.Name, tmp.Query.Args)
+	if err != nil {
+		return err
+	}
+
+	// Parse export format
+	exportFormat, err := parseExportFormat(tmp.Export.Format)
+	if err != nil {
+		return err
+	}
+
+	// Parse owner attributes
+	var owner *structpb.Struct
+	if len(tmp.Owner) > 0 {
+		owner, err = structpb.NewStruct(tmp.Owner)
+		if err != nil {
+			return fmt.Errorf(`invalid "owner": %w`, err)
+		}
+	}
+
+	// Validate recipients
+	if len(tmp.Email.Recipients) == 0 && len(tmp.Webhooks) == 0 {
+		return errors.New(`must set at least one email recipient or webhook for reports`)
+	}
+	err = validateRecipients(tmp.Email.Recipients, tmp.Webhooks)
+	if err != nil {
+		return err
+	}
+
+	node.Refs = append(node.Refs, ResourceName{Kind: ResourceKindMetricsView, Name: metricsViewName})
+
+	// NOTE: After calling upsertResource, an error must not be returned. Any validation should be done before calling it.
+	r := p.upsertResource(ResourceKindReport, node.Name, node.Paths, node.Refs...)
+	spec := r.ReportSpec
+
+	spec.Title = tmp.Title
+	spec.RefreshSchedule = schedule
+	if timeout != 0 {
+		spec.TimeoutSeconds = uint32(timeout.Seconds())
+	}
+	spec.QueryName = tmp.Query.Name
+	spec.QueryArgsJson = argsJSON
+	spec.ExportLimit = tmp.Export.Limit
+	spec.ExportFormat = exportFormat
+	spec.OwnerAttributes = owner
+	spec.EmailRecipients = tmp.Email.Recipients
+	spec.WebhookUrls = tmp.Webhooks
+
+	return nil
+}
+
+// parseReportQuery validates a report's query and returns its args serialized to JSON and the name of the metrics view it queries.
+func parseReportQuery(name string, args map[string]any) (string, string, error) {
+	if args == nil {
+		args = map[string]any{}
+	}
+
+	data, err := json.Marshal(args)
+	if err != nil {
+		return "", "", fmt.Errorf(`invalid "query.args": %w`, err)
+	}
+
+	var metricsViewName string
+	switch name {
+	case "MetricsViewToplist":
+		req := &runtimev1.MetricsViewToplistRequest{}
+		if err := protojson.Unmarshal(data, req); err != nil {
+			return "", "", fmt.Errorf(`invalid "query.args" for %s: %w`, name, err)
+		}
+		metricsViewName = req.MetricsViewName
+	case "MetricsViewRows":
+		req := &runtimev1.MetricsViewRowsRequest{}
+		if err := protojson.Unmarshal(data, req); err != nil {
+			return "", "", fmt.Errorf(`invalid "query.args" for %s: %w`, name, err)
+		}
+		metricsViewName = req.MetricsViewName
+	case "":
+		return "", "", errors.New(`must set "query.name" for reports`)
+	default:
+		return "", "", fmt.Errorf(`invalid "query.name" %q: must be one of "MetricsViewToplist" or "MetricsViewRows"`, name)
+	}
+
+	if metricsViewName == "" {
+		return "", "", errors.New(`must set "metrics_view_name" in "query.args"`)
+	}
+
+	return string(data), metricsViewName, nil
+}
+
+// parseExportFormat parses a file format for a data export.
+func parseExportFormat(s string) (runtimev1.ExportFormat, error) {
+	switch strings.ToLower(s) {
+	case "", "csv":
+		return runtimev1.ExportFormat_EXPORT_FORMAT_CSV, nil
+	case "xlsx":
+		return runtimev1.ExportFormat_EXPORT_FORMAT_XLSX, nil
+	case "parquet":
+		return runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET, nil
+	default:
+		return runtimev1.ExportFormat_EXPORT_FORMAT_UNSPECIFIED, fmt.Errorf(`invalid "export.format" %q: must be one of "csv", "xlsx" or "parquet"`, s)
+	}
+}
+
+// validateRecipients validates email addresses and webhook URLs to send notifications to.
+func validateRecipients(emails, webhooks []string) error {
+	for _, email := range emails {
+		_, err := mail.ParseAddress(email)
+		if err != nil {
+			return fmt.Errorf("invalid recipient email address %q", email)
+		}
+	}
+	for _, webhook := range webhooks {
+		u, err := url.Parse(webhook)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid webhook URL %q", webhook)
+		}
+	}
+	return nil
+}
@@ -15,11 +15,12 @@ import (
 
 // sourceYAML is the raw structure of a Source resource defined in YAML (does not include common fields)
 type sourceYAML struct {
-	commonYAML `yaml:",inline" mapstructure:",squash"` // Only to avoid loading common fields into Properties
-	Type       string                                  `yaml:"type"` // Backwards compatibility
-	Timeout    string                                  `yaml:"timeout"`
-	Refresh    *scheduleYAML                           `yaml:"refresh"`
-	Properties map[string]any                          `yaml:",inline" mapstructure:",remain"`
+	commonYAML  `yaml:",inline" mapstructure:",squash"` // Only to avoid loading common fields into Properties
+	Type        string                                  `yaml:"type"` // Backwards compatibility
+	Timeout     string                                  `yaml:"timeout"`
+	Refresh     *scheduleYAML                           `yaml:"refresh"`
+	Incremental bool                                    `yaml:"incremental"`
+	Properties  map[string]any                          `yaml:",inline" mapstructure:",remain"`
 }
 
 // parseSource parses a source definition and adds the resulting resource to p.Resources.
@@ -86,6 +87,11 @@ func (p *Parser) parseSource(ctx context.Context, node *Node) error {
 		return fmt.Errorf("must explicitly specify a connector for sources")
 	}
 
+	// Validate incremental config
+	if _, ok := tmp.Properties["partition_column"]; ok && !tmp.Incremental {
+		return fmt.Errorf(`the "partition_column" property can only be set for incremental sources`)
+	}
+
 	props, err := structpb.NewStruct(tmp.Properties)
 	if err != nil {
 		return fmt.Errorf("encountered invalid property type: %w", err)
@@ -105,6 +111,9 @@ func (p *Parser) parseSource(ctx context.Context, node *Node) error {
 	if schedule != nil {
 		r.SourceSpec.RefreshSchedule = schedule
 	}
+	if tmp.Incremental {
+		r.SourceSpec.Incremental = true
+	}
 
 	return nil
 }
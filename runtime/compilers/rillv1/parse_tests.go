@@ -0,0 +1,234 @@
+package rillv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"gopkg.in/yaml.v3"
+)
+
+// testYAML is the raw structure of a Test resource defined in YAML
+type testYAML struct {
+	commonYAML `yaml:",inline"`     // Not accessed here, only setting it so we can use KnownFields for YAML parsing
+	Model      string               `yaml:"model" mapstructure:"model"`
+	Assertions []*testAssertionYAML `yaml:"assertions"`
+}
+
+// testAssertionYAML is the raw structure of an assertion in a Test resource.
+// Exactly one of the assertion types must be set.
+type testAssertionYAML struct {
+	Name           string `yaml:"name"`
+	NotNull        string `yaml:"not_null"`
+	Unique         string `yaml:"unique"`
+	AcceptedValues *struct {
+		Column string      `yaml:"column"`
+		Values []yaml.Node `yaml:"values"`
+	} `yaml:"accepted_values"`
+	RowCount *struct {
+		Min *uint64 `yaml:"min"`
+		Max *uint64 `yaml:"max"`
+	} `yaml:"row_count"`
+	SQL string `yaml:"sql"`
+}
+
+// parseTest parses a test definition and adds the resulting resource to p.Resources.
+func (p *Parser) parseTest(ctx context.Context, node *Node) error {
+	// Parse YAML
+	tmp := &testYAML{}
+	if node.YAMLRaw != "" {
+		// Can't use node.YAML because we need to set KnownFields for tests
+		dec := yaml.NewDecoder(strings.NewReader(node.YAMLRaw))
+		dec.KnownFields(true)
+		if err := dec.Decode(tmp); err != nil {
+			return pathError{path: node.YAMLPath, err: newYAMLError(err)}
+		}
+	}
+
+	// Override YAML config with SQL annotations
+	err := mapstructureUnmarshal(node.SQLAnnotations, tmp)
+	if err != nil {
+		return pathError{path: node.SQLPath, err: fmt.Errorf("invalid SQL annotations: %w", err)}
+	}
+
+	// The SQL is resolved as-is when the test runs
+	if node.SQLUsesTemplating {
+		return pathError{path: node.SQLPath, err: errors.New("tests do not support templating")}
+	}
+
+	// Parse the assertions
+	var assertions []*runtimev1.TestAssertion
+	for i, a := range tmp.Assertions {
+		if a == nil {
+			return fmt.Errorf("invalid assertion at index %d: assertion is empty", i)
+		}
+		assertion, err := parseTestAssertion(tmp.Model, a)
+		if err != nil {
+			return fmt.Errorf("invalid assertion at index %d: %w", i, err)
+		}
+		assertions = append(assertions, assertion)
+	}
+
+	// SQL provided in a companion file or in the top-level "sql" field is a custom assertion
+	if node.SQL != "" {
+		assertions = append(assertions, &runtimev1.TestAssertion{
+			Name: "sql",
+			Sql:  trimTestSQL(node.SQL),
+		})
+	}
+
+	if len(assertions) == 0 {
+		return errors.New("tests must have at least one assertion")
+	}
+	names := make(map[string]bool, len(assertions))
+	for _, a := range assertions {
+		n := strings.ToLower(a.Name)
+		if names[n] {
+			return fmt.Errorf("duplicate assertion name %q (use the \"name\" property to give it a unique name)", a.Name)
+		}
+		names[n] = true
+	}
+
+	// Add refs for the model and for tables referenced in custom SQL (when possible)
+	refs := node.Refs
+	if tmp.Model != "" {
+		refs = append(refs, ResourceName{Name: tmp.Model})
+	}
+	isDuckDB := false
+	for _, c := range p.DuckDBConnectors {
+		if c == node.Connector {
+			isDuckDB = true
+			break
+		}
+	}
+	if isDuckDB {
+		for i, a := range tmp.Assertions {
+			if a.SQL == "" {
+				continue
+			}
+			tableRefs, err := duckDBTableRefs(a.SQL)
+			if err != nil {
+				return fmt.Errorf("invalid assertion at index %d: %w", i, err)
+			}
+			refs = append(refs, tableRefs...)
+		}
+		if node.SQL != "" {
+			tableRefs, err := duckDBTableRefs(node.SQL)
+			if err != nil {
+				return pathError{path: node.SQLPath, err: err}
+			}
+			refs = append(refs, tableRefs...)
+		}
+	}
+
+	// NOTE: After calling upsertResource, an error must not be returned. Any validation should be done before calling it.
+	r := p.upsertResource(ResourceKindTest, node.Name, node.Paths, refs...)
+	if node.Connector != "" {
+		r.TestSpec.Connector = node.Connector
+	}
+	if tmp.Model != "" {
+		r.TestSpec.Model = tmp.Model
+	}
+	r.TestSpec.Assertions = assertions
+
+	return nil
+}
+
+// parseTestAssertion compiles an assertion to a SQL query that returns the rows violating it.
+func parseTestAssertion(model string, a *testAssertionYAML) (*runtimev1.TestAssertion, error) {
+	n := 0
+	for _, set := range []bool{a.NotNull != "", a.Unique != "", a.AcceptedValues != nil, a.RowCount != nil, a.SQL != ""} {
+		if set {
+			n++
+		}
+	}
+	if n != 1 {
+		return nil, errors.New(`must set exactly one of "not_null", "unique", "accepted_values", "row_count" or "sql"`)
+	}
+	if a.SQL == "" && model == "" {
+		return nil, errors.New(`must set "model" to use built-in assertions`)
+	}
+
+	var name, sql string
+	switch {
+	case a.NotNull != "":
+		name = fmt.Sprintf("not_null(%s)", a.NotNull)
+		sql = fmt.Sprintf("SELECT * FROM %s WHERE %s IS NULL", safeSQLName(model), safeSQLName(a.NotNull))
+	case a.Unique != "":
+		col := safeSQLName(a.Unique)
+		name = fmt.Sprintf("unique(%s)", a.Unique)
+		sql = fmt.Sprintf("SELECT %s, COUNT(*) AS duplicates FROM %s WHERE %s IS NOT NULL GROUP BY %s HAVING COUNT(*) > 1", col, safeSQLName(model), col, col)
+	case a.AcceptedValues != nil:
+		if a.AcceptedValues.Column == "" {
+			return nil, errors.New(`must set "accepted_values.column"`)
+		}
+		if len(a.AcceptedValues.Values) == 0 {
+			return nil, errors.New(`must set at least one value in "accepted_values.values"`)
+		}
+		vals := make([]string, len(a.AcceptedValues.Values))
+		for i := range a.AcceptedValues.Values {
+			v, err := sqlLiteral(&a.AcceptedValues.Values[i])
+			if err != nil {
+				return nil, fmt.Errorf(`invalid "accepted_values.values": %w`, err)
+			}
+			vals[i] = v
+		}
+		col := safeSQLName(a.AcceptedValues.Column)
+		name = fmt.Sprintf("accepted_values(%s)", a.AcceptedValues.Column)
+		sql = fmt.Sprintf("SELECT * FROM %s WHERE %s IS NOT NULL AND %s NOT IN (%s)", safeSQLName(model), col, col, strings.Join(vals, ", "))
+	case a.RowCount != nil:
+		var conds []string
+		if a.RowCount.Min != nil {
+			conds = append(conds, fmt.Sprintf("row_count < %d", *a.RowCount.Min))
+		}
+		if a.RowCount.Max != nil {
+			conds = append(conds, fmt.Sprintf("row_count > %d", *a.RowCount.Max))
+		}
+		if len(conds) == 0 {
+			return nil, errors.New(`must set "row_count.min" or "row_count.max"`)
+		}
+		if a.RowCount.Min != nil && a.RowCount.Max != nil && *a.RowCount.Min > *a.RowCount.Max {
+			return nil, errors.New(`"row_count.min" must be less than or equal to "row_count.max"`)
+		}
+		name = "row_count"
+		sql = fmt.Sprintf("SELECT row_count FROM (SELECT COUNT(*) AS row_count FROM %s) AS counts WHERE %s", safeSQLName(model), strings.Join(conds, " OR "))
+	case a.SQL != "":
+		name = "sql"
+		sql = trimTestSQL(a.SQL)
+	}
+
+	if a.Name != "" {
+		name = a.Name
+	}
+
+	return &runtimev1.TestAssertion{Name: name, Sql: sql}, nil
+}
+
+// sqlLiteral converts a YAML scalar to a SQL literal.
+func sqlLiteral(n *yaml.Node) (string, error) {
+	if n.Kind != yaml.ScalarNode {
+		return "", fmt.Errorf("value at line %d is not a scalar", n.Line)
+	}
+	switch n.Tag {
+	case "!!int", "!!float":
+		return n.Value, nil
+	case "!!bool":
+		return strings.ToUpper(n.Value), nil
+	case "!!str":
+		return fmt.Sprintf("'%s'", strings.ReplaceAll(n.Value, "'", "''")), nil
+	default:
+		return "", fmt.Errorf("unsupported value %q at line %d", n.Value, n.Line)
+	}
+}
+
+// trimTestSQL trims whitespace and trailing semicolons so the SQL can be wrapped in a subquery.
+func trimTestSQL(sql string) string {
+	return strings.TrimRight(strings.TrimSpace(sql), "; \t\n")
+}
+
+// safeSQLName quotes an identifier for use in SQL.
+func safeSQLName(name string) string {
+	return fmt.Sprintf("\"%s\"", strings.ReplaceAll(name, "\"", "\"\""))
+}
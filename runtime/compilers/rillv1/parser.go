@@ -37,6 +37,10 @@ type Resource struct {
 	ModelSpec       *runtimev1.ModelSpec
 	MetricsViewSpec *runtimev1.MetricsViewSpec
 	MigrationSpec   *runtimev1.MigrationSpec
+	ReportSpec      *runtimev1.ReportSpec
+	AlertSpec       *runtimev1.AlertSpec
+	TestSpec        *runtimev1.TestSpec
+	MacroSpec       *runtimev1.MacroSpec
 }
 
 // ResourceName is a unique identifier for a resource
@@ -65,6 +69,10 @@ const (
 	ResourceKindModel
 	ResourceKindMetricsView
 	ResourceKindMigration
+	ResourceKindReport
+	ResourceKindAlert
+	ResourceKindTest
+	ResourceKindMacro
 )
 
 // ParseResourceKind maps a string to a ResourceKind.
@@ -81,6 +89,14 @@ func ParseResourceKind(kind string) (ResourceKind, error) {
 		return ResourceKindMetricsView, nil
 	case "migration":
 		return ResourceKindMigration, nil
+	case "report":
+		return ResourceKindReport, nil
+	case "alert":
+		return ResourceKindAlert, nil
+	case "test":
+		return ResourceKindTest, nil
+	case "macro":
+		return ResourceKindMacro, nil
 	default:
 		return ResourceKindUnspecified, fmt.Errorf("invalid resource kind %q", kind)
 	}
@@ -98,6 +114,14 @@ func (k ResourceKind) String() string {
 		return "MetricsView"
 	case ResourceKindMigration:
 		return "Migration"
+	case ResourceKindReport:
+		return "Report"
+	case ResourceKindAlert:
+		return "Alert"
+	case ResourceKindTest:
+		return "Test"
+	case ResourceKindMacro:
+		return "Macro"
 	default:
 		panic(fmt.Sprintf("unexpected resource kind: %d", k))
 	}
@@ -546,8 +570,8 @@ func (p *Parser) inferUnspecifiedRefs(r *Resource) {
 			}
 		}
 
-		// Rule 2: If it's a metrics view and there's a model or source with that name, use it
-		if r.Name.Kind == ResourceKindMetricsView {
+		// Rule 2: If it's a metrics view, test or macro and there's a model or source with that name, use it
+		if r.Name.Kind == ResourceKindMetricsView || r.Name.Kind == ResourceKindTest || r.Name.Kind == ResourceKindMacro {
 			n := ResourceName{Kind: ResourceKindModel, Name: ref.Name}
 			if _, ok := p.Resources[n.Normalized()]; ok {
 				refs = append(refs, n)
@@ -616,6 +640,14 @@ func (p *Parser) upsertResource(kind ResourceKind, name string, paths []string,
 			r.MetricsViewSpec = &runtimev1.MetricsViewSpec{}
 		case ResourceKindMigration:
 			r.MigrationSpec = &runtimev1.MigrationSpec{}
+		case ResourceKindReport:
+			r.ReportSpec = &runtimev1.ReportSpec{}
+		case ResourceKindAlert:
+			r.AlertSpec = &runtimev1.AlertSpec{}
+		case ResourceKindTest:
+			r.TestSpec = &runtimev1.TestSpec{}
+		case ResourceKindMacro:
+			r.MacroSpec = &runtimev1.MacroSpec{}
 		default:
 			panic(fmt.Errorf("unexpected resource kind: %s", kind.String()))
 		}
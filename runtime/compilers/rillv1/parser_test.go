@@ -571,6 +571,196 @@ SELECT * FROM t2
 	requireResourcesAndErrors(t, p, resources, nil)
 }
 
+func TestIncrementalModel(t *testing.T) {
+	ctx := context.Background()
+	truth := true
+
+	files := map[string]string{
+		`rill.yaml`: ``,
+		// Incremental model with a unique key
+		`models/m1.sql`: `
+-- @incremental: true
+-- @unique_key: id
+SELECT * FROM t1 {{ if incremental }} WHERE updated_on > '{{ .watermark }}' {{ end }}
+`,
+		// Unique key without incremental
+		`models/m2.sql`: `
+-- @unique_key: id
+SELECT * FROM t2 {{ if incremental }} WHERE true {{ end }}
+`,
+		// Incremental model that is explicitly not materialized
+		`models/m3.yaml`: `
+materialize: false
+incremental: true
+`,
+	}
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindModel, Name: "m1"},
+			Paths: []string{"/models/m1.sql"},
+			ModelSpec: &runtimev1.ModelSpec{
+				Sql:            strings.TrimSpace(files["models/m1.sql"]),
+				UsesTemplating: true,
+				Materialize:    &truth,
+				Incremental:    true,
+				UniqueKey:      []string{"id"},
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `"unique_key" property can only be set for incremental or snapshot models`,
+			FilePath: "/models/m2.sql",
+		},
+		{
+			Message:  "an incremental model must be materialized",
+			FilePath: "/models/m3.yaml",
+		},
+	}
+
+	repo := makeRepo(t, files)
+	p, err := Parse(ctx, repo, "", "", []string{""})
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
+func TestSnapshotModel(t *testing.T) {
+	ctx := context.Background()
+	truth := true
+
+	files := map[string]string{
+		`rill.yaml`: ``,
+		// Snapshot model with an updated_at column
+		`models/m1.sql`: `
+-- @snapshot: true
+-- @unique_key: id
+-- @updated_at: updated_on
+SELECT * FROM t1
+`,
+		// Snapshot model with check columns
+		`models/m2.yaml`: `
+snapshot: true
+unique_key: [id]
+check_columns: [plan, owner]
+sql: SELECT * FROM t2
+`,
+		// Snapshot model without a unique key
+		`models/m3.sql`: `
+-- @snapshot: true
+-- @updated_at: updated_on
+SELECT * FROM t3
+`,
+		// Snapshot model with both updated_at and check columns
+		`models/m4.yaml`: `
+snapshot: true
+unique_key: [id]
+updated_at: updated_on
+check_columns: [plan]
+sql: SELECT * FROM t4
+`,
+		// Check columns without snapshot
+		`models/m5.yaml`: `
+check_columns: [plan]
+sql: SELECT * FROM t5
+`,
+	}
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindModel, Name: "m1"},
+			Paths: []string{"/models/m1.sql"},
+			ModelSpec: &runtimev1.ModelSpec{
+				Sql:               strings.TrimSpace(files["models/m1.sql"]),
+				Materialize:       &truth,
+				Snapshot:          true,
+				UniqueKey:         []string{"id"},
+				SnapshotUpdatedAt: "updated_on",
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindModel, Name: "m2"},
+			Paths: []string{"/models/m2.yaml"},
+			ModelSpec: &runtimev1.ModelSpec{
+				Sql:                  "SELECT * FROM t2",
+				Materialize:          &truth,
+				Snapshot:             true,
+				UniqueKey:            []string{"id"},
+				SnapshotCheckColumns: []string{"plan", "owner"},
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `a snapshot model must set the "unique_key" property`,
+			FilePath: "/models/m3.sql",
+		},
+		{
+			Message:  `must set exactly one of the "updated_at" and "check_columns" properties`,
+			FilePath: "/models/m4.yaml",
+		},
+		{
+			Message:  `can only be set for snapshot models`,
+			FilePath: "/models/m5.yaml",
+		},
+	}
+
+	repo := makeRepo(t, files)
+	p, err := Parse(ctx, repo, "", "", nil)
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
+func TestIncrementalSource(t *testing.T) {
+	ctx := context.Background()
+
+	files := map[string]string{
+		`rill.yaml`: ``,
+		// Incremental source with a partition column
+		`sources/s1.yaml`: `
+connector: s3
+path: s3://bucket/events/**/*.parquet
+incremental: true
+partition_column: dt
+`,
+		// Partition column without incremental
+		`sources/s2.yaml`: `
+connector: s3
+path: s3://bucket/events/**/*.parquet
+partition_column: dt
+`,
+	}
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindSource, Name: "s1"},
+			Paths: []string{"/sources/s1.yaml"},
+			SourceSpec: &runtimev1.SourceSpec{
+				SourceConnector: "s3",
+				Properties: must(structpb.NewStruct(map[string]any{
+					"path":             "s3://bucket/events/**/*.parquet",
+					"partition_column": "dt",
+				})),
+				Incremental: true,
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `"partition_column" property can only be set for incremental sources`,
+			FilePath: "/sources/s2.yaml",
+		},
+	}
+
+	repo := makeRepo(t, files)
+	p, err := Parse(ctx, repo, "", "", []string{""})
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
 func TestProjectDashboardDefaults(t *testing.T) {
 	ctx := context.Background()
 	repo := makeRepo(t, map[string]string{
@@ -654,6 +844,573 @@ security:
 	requireResourcesAndErrors(t, p, resources, nil)
 }
 
+func TestMetricsViewDerivedMeasures(t *testing.T) {
+	ctx := context.Background()
+
+	files := map[string]string{
+		`rill.yaml`: ``,
+		// Derived and window measures
+		`dashboards/d1.yaml`: `
+table: t1
+timeseries: ts
+dimensions:
+  - name: a
+measures:
+  - name: revenue
+    expression: sum(price)
+  - name: users
+    expression: count(distinct user_id)
+  - name: revenue_per_user
+    expression: revenue / users
+    requires: [revenue, users]
+  - name: revenue_running_total
+    expression: sum(revenue)
+    requires: [revenue]
+    window: true
+  - name: revenue_rolling_avg
+    expression: avg(revenue)
+    requires: [revenue]
+    window:
+      partition: false
+      frame: ROWS BETWEEN 6 PRECEDING AND CURRENT ROW
+`,
+		// Cycle
+		`dashboards/d2.yaml`: `
+table: t2
+measures:
+  - name: a
+    expression: b
+    requires: [b]
+  - name: b
+    expression: a
+    requires: [a]
+`,
+		// Missing reference
+		`dashboards/d3.yaml`: `
+table: t3
+measures:
+  - name: a
+    expression: b + 1
+    requires: [b]
+`,
+		// Window without a time dimension
+		`dashboards/d4.yaml`: `
+table: t4
+measures:
+  - name: a
+    expression: count(*)
+  - name: b
+    expression: sum(a)
+    requires: [a]
+    window: true
+`,
+	}
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindMetricsView, Name: "d1"},
+			Paths: []string{"/dashboards/d1.yaml"},
+			MetricsViewSpec: &runtimev1.MetricsViewSpec{
+				Table:         "t1",
+				TimeDimension: "ts",
+				Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+					{Name: "a"},
+				},
+				Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+					{Name: "revenue", Expression: "sum(price)"},
+					{Name: "users", Expression: "count(distinct user_id)"},
+					{Name: "revenue_per_user", Expression: "revenue / users", ReferencedMeasures: []string{"revenue", "users"}},
+					{
+						Name:               "revenue_running_total",
+						Expression:         "sum(revenue)",
+						ReferencedMeasures: []string{"revenue"},
+						Window:             &runtimev1.MetricsViewSpec_MeasureWindow{Partition: true},
+					},
+					{
+						Name:               "revenue_rolling_avg",
+						Expression:         "avg(revenue)",
+						ReferencedMeasures: []string{"revenue"},
+						Window:             &runtimev1.MetricsViewSpec_MeasureWindow{Frame: "ROWS BETWEEN 6 PRECEDING AND CURRENT ROW"},
+					},
+				},
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `found cycle in measure references: a -> b -> a`,
+			FilePath: "/dashboards/d2.yaml",
+		},
+		{
+			Message:  `measure "a" references measure "b", which does not exist`,
+			FilePath: "/dashboards/d3.yaml",
+		},
+		{
+			Message:  `measure "b" has a window, which requires the "timeseries" field to be set`,
+			FilePath: "/dashboards/d4.yaml",
+		},
+	}
+
+	repo := makeRepo(t, files)
+	p, err := Parse(ctx, repo, "", "", []string{""})
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
+func TestReport(t *testing.T) {
+	ctx := context.Background()
+
+	files := map[string]string{
+		`rill.yaml`: ``,
+		`dashboards/d1.yaml`: `
+table: t1
+dimensions:
+  - name: pub
+measures:
+  - name: revenue
+    expression: sum(price)
+`,
+		`reports/r1.yaml`: `
+title: Weekly revenue
+refresh:
+  cron: 0 9 * * 1
+timeout: 5m
+query:
+  name: MetricsViewToplist
+  args:
+    metrics_view_name: d1
+    dimension_name: pub
+    measure_names: [revenue]
+    limit: 10
+export:
+  format: xlsx
+  limit: 1000
+owner:
+  email: jane@example.com
+email:
+  recipients: [jane@example.com]
+webhooks:
+  - https://example.com/hook
+`,
+		// Invalid query args
+		`reports/r2.yaml`: `
+refresh:
+  cron: 0 9 * * 1
+query:
+  name: MetricsViewRows
+  args:
+    metrics_view_name: d1
+    foo: bar
+email:
+  recipients: [jane@example.com]
+`,
+		// No recipients
+		`reports/r3.yaml`: `
+refresh:
+  cron: 0 9 * * 1
+query:
+  name: MetricsViewRows
+  args:
+    metrics_view_name: d1
+`,
+		// Invalid export format
+		`reports/r4.yaml`: `
+refresh:
+  cron: 0 9 * * 1
+query:
+  name: MetricsViewRows
+  args:
+    metrics_view_name: d1
+export:
+  format: json
+webhooks:
+  - https://example.com/hook
+`,
+	}
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindMetricsView, Name: "d1"},
+			Paths: []string{"/dashboards/d1.yaml"},
+			MetricsViewSpec: &runtimev1.MetricsViewSpec{
+				Table:      "t1",
+				Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{{Name: "pub"}},
+				Measures:   []*runtimev1.MetricsViewSpec_MeasureV2{{Name: "revenue", Expression: "sum(price)"}},
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindReport, Name: "r1"},
+			Paths: []string{"/reports/r1.yaml"},
+			Refs:  []ResourceName{{Kind: ResourceKindMetricsView, Name: "d1"}},
+			ReportSpec: &runtimev1.ReportSpec{
+				Title:           "Weekly revenue",
+				RefreshSchedule: &runtimev1.Schedule{Cron: "0 9 * * 1"},
+				TimeoutSeconds:  300,
+				QueryName:       "MetricsViewToplist",
+				QueryArgsJson:   `{"dimension_name":"pub","limit":10,"measure_names":["revenue"],"metrics_view_name":"d1"}`,
+				ExportLimit:     1000,
+				ExportFormat:    runtimev1.ExportFormat_EXPORT_FORMAT_XLSX,
+				OwnerAttributes: must(structpb.NewStruct(map[string]any{"email": "jane@example.com"})),
+				EmailRecipients: []string{"jane@example.com"},
+				WebhookUrls:     []string{"https://example.com/hook"},
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `invalid "query.args" for MetricsViewRows`,
+			FilePath: "/reports/r2.yaml",
+		},
+		{
+			Message:  `must set at least one email recipient or webhook for reports`,
+			FilePath: "/reports/r3.yaml",
+		},
+		{
+			Message:  `invalid "export.format" "json"`,
+			FilePath: "/reports/r4.yaml",
+		},
+	}
+
+	repo := makeRepo(t, files)
+	p, err := Parse(ctx, repo, "", "", []string{""})
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
+func TestAlert(t *testing.T) {
+	ctx := context.Background()
+
+	files := map[string]string{
+		`rill.yaml`: ``,
+		`dashboards/d1.yaml`: `
+table: t1
+dimensions:
+  - name: region
+measures:
+  - name: error_rate
+    expression: avg(is_error)
+`,
+		`alerts/a1.yaml`: `
+title: High error rate
+refresh:
+  cron: "*/5 * * * *"
+query:
+  name: MetricsViewAggregation
+  args:
+    metrics_view: d1
+    dimensions: [{name: region}]
+    measures: [{name: error_rate}]
+  time_range: PT1H
+condition:
+  measure: error_rate
+  operator: ">"
+  value: 0.02
+owner:
+  email: jane@example.com
+email:
+  recipients: [oncall@example.com]
+`,
+		// Condition on a measure that isn't selected
+		`alerts/a2.yaml`: `
+refresh:
+  cron: "*/5 * * * *"
+query:
+  name: MetricsViewAggregation
+  args:
+    metrics_view: d1
+    measures: [{name: error_rate}]
+condition:
+  measure: foo
+  operator: ">"
+  value: 1
+webhooks: [https://example.com/hook]
+`,
+		// Invalid operator
+		`alerts/a3.yaml`: `
+refresh:
+  cron: "*/5 * * * *"
+query:
+  name: MetricsViewAggregation
+  args:
+    metrics_view: d1
+    measures: [{name: error_rate}]
+condition:
+  measure: error_rate
+  operator: "~"
+  value: 1
+webhooks: [https://example.com/hook]
+`,
+		// Unsupported query
+		`alerts/a4.yaml`: `
+refresh:
+  cron: "*/5 * * * *"
+query:
+  name: MetricsViewRows
+  args:
+    metrics_view_name: d1
+webhooks: [https://example.com/hook]
+`,
+	}
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindMetricsView, Name: "d1"},
+			Paths: []string{"/dashboards/d1.yaml"},
+			MetricsViewSpec: &runtimev1.MetricsViewSpec{
+				Table:      "t1",
+				Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{{Name: "region"}},
+				Measures:   []*runtimev1.MetricsViewSpec_MeasureV2{{Name: "error_rate", Expression: "avg(is_error)"}},
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindAlert, Name: "a1"},
+			Paths: []string{"/alerts/a1.yaml"},
+			Refs:  []ResourceName{{Kind: ResourceKindMetricsView, Name: "d1"}},
+			AlertSpec: &runtimev1.AlertSpec{
+				Title:           "High error rate",
+				RefreshSchedule: &runtimev1.Schedule{Cron: "*/5 * * * *"},
+				QueryName:       "MetricsViewAggregation",
+				QueryArgsJson:   `{"dimensions":[{"name":"region"}],"measures":[{"name":"error_rate"}],"metrics_view":"d1"}`,
+				QueryTimeRange:  "PT1H",
+				Condition: &runtimev1.Expression{Expression: &runtimev1.Expression_Cond{Cond: &runtimev1.Condition{
+					Op: runtimev1.Operation_OPERATION_GT,
+					Exprs: []*runtimev1.Expression{
+						{Expression: &runtimev1.Expression_Ident{Ident: "error_rate"}},
+						{Expression: &runtimev1.Expression_Val{Val: structpb.NewNumberValue(0.02)}},
+					},
+				}}},
+				OwnerAttributes: must(structpb.NewStruct(map[string]any{"email": "jane@example.com"})),
+				EmailRecipients: []string{"oncall@example.com"},
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `measure "foo" is not selected`,
+			FilePath: "/alerts/a2.yaml",
+		},
+		{
+			Message:  `invalid "condition.operator"`,
+			FilePath: "/alerts/a3.yaml",
+		},
+		{
+			Message:  `only "MetricsViewAggregation" is supported`,
+			FilePath: "/alerts/a4.yaml",
+		},
+	}
+
+	repo := makeRepo(t, files)
+	p, err := Parse(ctx, repo, "", "", []string{""})
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
+func TestTests(t *testing.T) {
+	ctx := context.Background()
+
+	files := map[string]string{
+		`rill.yaml`: ``,
+		`sources/orders.yaml`: `
+connector: s3
+path: s3://bucket/orders.csv
+`,
+		`tests/t1.yaml`: `
+model: orders
+assertions:
+  - not_null: id
+  - unique: id
+  - accepted_values:
+      column: status
+      values: [open, "it's closed", 3, true]
+  - row_count:
+      min: 1
+  - name: positive_amounts
+    sql: SELECT * FROM orders WHERE amount < 0;
+`,
+		// Custom SQL test
+		`tests/t2.sql`: `
+-- @kind: test
+SELECT * FROM orders WHERE amount > 1000
+`,
+		// No assertions
+		`tests/t3.yaml`: `
+model: orders
+`,
+		// Built-in assertion without a model
+		`tests/t4.yaml`: `
+assertions:
+  - not_null: id
+`,
+		// Multiple assertion types in one assertion
+		`tests/t5.yaml`: `
+model: orders
+assertions:
+  - not_null: id
+    unique: id
+`,
+		// Duplicate assertion names
+		`tests/t6.yaml`: `
+model: orders
+assertions:
+  - not_null: id
+  - not_null: id
+`,
+	}
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindSource, Name: "orders"},
+			Paths: []string{"/sources/orders.yaml"},
+			SourceSpec: &runtimev1.SourceSpec{
+				SourceConnector: "s3",
+				Properties:      must(structpb.NewStruct(map[string]any{"path": "s3://bucket/orders.csv"})),
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindTest, Name: "t1"},
+			Paths: []string{"/tests/t1.yaml"},
+			Refs:  []ResourceName{{Kind: ResourceKindSource, Name: "orders"}},
+			TestSpec: &runtimev1.TestSpec{
+				Model: "orders",
+				Assertions: []*runtimev1.TestAssertion{
+					{Name: "not_null(id)", Sql: `SELECT * FROM "orders" WHERE "id" IS NULL`},
+					{Name: "unique(id)", Sql: `SELECT "id", COUNT(*) AS duplicates FROM "orders" WHERE "id" IS NOT NULL GROUP BY "id" HAVING COUNT(*) > 1`},
+					{Name: "accepted_values(status)", Sql: `SELECT * FROM "orders" WHERE "status" IS NOT NULL AND "status" NOT IN ('open', 'it''s closed', 3, TRUE)`},
+					{Name: "row_count", Sql: `SELECT row_count FROM (SELECT COUNT(*) AS row_count FROM "orders") AS counts WHERE row_count < 1`},
+					{Name: "positive_amounts", Sql: `SELECT * FROM orders WHERE amount < 0`},
+				},
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindTest, Name: "t2"},
+			Paths: []string{"/tests/t2.sql"},
+			TestSpec: &runtimev1.TestSpec{
+				Assertions: []*runtimev1.TestAssertion{
+					{Name: "sql", Sql: "-- @kind: test\nSELECT * FROM orders WHERE amount > 1000"},
+				},
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `must have at least one assertion`,
+			FilePath: "/tests/t3.yaml",
+		},
+		{
+			Message:  `must set "model" to use built-in assertions`,
+			FilePath: "/tests/t4.yaml",
+		},
+		{
+			Message:  `must set exactly one of`,
+			FilePath: "/tests/t5.yaml",
+		},
+		{
+			Message:  `duplicate assertion name "not_null(id)"`,
+			FilePath: "/tests/t6.yaml",
+		},
+	}
+
+	repo := makeRepo(t, files)
+	p, err := Parse(ctx, repo, "", "", nil)
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
+func TestMacros(t *testing.T) {
+	ctx := context.Background()
+
+	files := map[string]string{
+		`rill.yaml`: ``,
+		`sources/orders.yaml`: `
+connector: s3
+path: s3://bucket/orders.csv
+`,
+		// Macro in a SQL file with args in an annotation
+		`macros/clean_phone.sql`: `
+-- @args: col, prefix
+'{{ .args.prefix }}' || regexp_replace({{ .args.col }}, '[^0-9]', '', 'g')
+`,
+		// Macro in a YAML file that references a source and another macro
+		`macros/orders.yaml`: `
+args: [phone]
+sql: SELECT {{ macro "clean_phone" .args.phone "+1" }} AS phone FROM {{ ref "orders" }}
+`,
+		// Macro outside of the macros directory
+		`models/m1.sql`: `
+-- @kind: macro
+1
+`,
+		`models/m2.sql`: `
+{{ macro "orders" "phone_number" }}
+`,
+		// Invalid arg name
+		`macros/invalid.yaml`: `
+args: [1col]
+sql: SELECT 1
+`,
+	}
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindSource, Name: "orders"},
+			Paths: []string{"/sources/orders.yaml"},
+			SourceSpec: &runtimev1.SourceSpec{
+				SourceConnector: "s3",
+				Properties:      must(structpb.NewStruct(map[string]any{"path": "s3://bucket/orders.csv"})),
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindMacro, Name: "clean_phone"},
+			Paths: []string{"/macros/clean_phone.sql"},
+			MacroSpec: &runtimev1.MacroSpec{
+				Args: []string{"col", "prefix"},
+				Sql:  "-- @args: col, prefix\n'{{ .args.prefix }}' || regexp_replace({{ .args.col }}, '[^0-9]', '', 'g')",
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindMacro, Name: "orders"},
+			Paths: []string{"/macros/orders.yaml"},
+			Refs:  []ResourceName{{Kind: ResourceKindSource, Name: "orders"}, {Kind: ResourceKindMacro, Name: "clean_phone"}},
+			MacroSpec: &runtimev1.MacroSpec{
+				Args: []string{"phone"},
+				Sql:  `SELECT {{ macro "clean_phone" .args.phone "+1" }} AS phone FROM {{ ref "orders" }}`,
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindMacro, Name: "m1"},
+			Paths: []string{"/models/m1.sql"},
+			MacroSpec: &runtimev1.MacroSpec{
+				Sql: "-- @kind: macro\n1",
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindModel, Name: "m2"},
+			Paths: []string{"/models/m2.sql"},
+			Refs:  []ResourceName{{Kind: ResourceKindMacro, Name: "orders"}},
+			ModelSpec: &runtimev1.ModelSpec{
+				Sql:            `{{ macro "orders" "phone_number" }}`,
+				UsesTemplating: true,
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `invalid macro arg name "1col"`,
+			FilePath: "/macros/invalid.yaml",
+		},
+	}
+
+	repo := makeRepo(t, files)
+	p, err := Parse(ctx, repo, "", "", nil)
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
 func requireResourcesAndErrors(t testing.TB, p *Parser, wantResources []*Resource, wantErrors []*runtimev1.ParseError) {
 	// Check resources
 	gotResources := maps.Clone(p.Resources)
@@ -668,6 +1425,9 @@ func requireResourcesAndErrors(t testing.TB, p *Parser, wantResources []*Resourc
 				require.Equal(t, want.ModelSpec, got.ModelSpec, "for resource %q", want.Name)
 				require.Equal(t, want.MetricsViewSpec, got.MetricsViewSpec, "for resource %q", want.Name)
 				require.Equal(t, want.MigrationSpec, got.MigrationSpec, "for resource %q", want.Name)
+				require.Equal(t, want.ReportSpec, got.ReportSpec, "for resource %q", want.Name)
+				require.Equal(t, want.AlertSpec, got.AlertSpec, "for resource %q", want.Name)
+				require.Equal(t, want.TestSpec, got.TestSpec, "for resource %q", want.Name)
 
 				delete(gotResources, got.Name)
 				found = true
@@ -32,24 +32,35 @@ import (
 //     dependency [`kind`] `name`: register a dependency (parse time)
 //     ref [`kind`] `name`: register a dependency at parse-time, resolve it to a name at resolve time (parse time and resolve time)
 //     lookup [`kind`] `name`: lookup another resource (resolve time)
+//     macro `name` [`args`...]: register a dependency on a macro at parse-time, expand it at resolve time (parse time and resolve time)
+//     incremental: true if the model is being incrementally updated (resolve time)
 //     .env.name: access a variable (resolve time)
 //     .user.attribute: access an attribute from auth claims (resolve time)
 //     .meta: access the current resource's metadata (resolve time)
 //     .spec: access the current resource's spec (resolve time)
 //     .state: access the current resource's state (resolve time)
+//     .args.name: access an argument passed to the current macro (resolve time)
 //     (All functions from Sprig except OS functions. See http://masterminds.github.io/sprig/ for details.)
 //
 
 // TemplateData contains data for resolving a template.
 type TemplateData struct {
-	User       map[string]any
-	Variables  map[string]string
-	ExtraProps map[string]any
-	Self       TemplateResource
-	Resolve    func(ref ResourceName) (string, error)
-	Lookup     func(name ResourceName) (TemplateResource, error)
+	User        map[string]any
+	Variables   map[string]string
+	ExtraProps  map[string]any
+	Incremental bool
+	Self        TemplateResource
+	Resolve     func(ref ResourceName) (string, error)
+	Lookup      func(name ResourceName) (TemplateResource, error)
+	Macro       func(name string) (*runtimev1.MacroSpec, error)
+
+	// macroDepth tracks the nesting of macro expansions to guard against infinite recursion
+	macroDepth int
 }
 
+// maxMacroDepth is the maximum nesting of macro expansions
+const maxMacroDepth = 16
+
 // TemplateResource contains data for a resource for injection into a template.
 type TemplateResource struct {
 	Meta  *runtimev1.ResourceMeta
@@ -122,6 +133,13 @@ func AnalyzeTemplate(tmpl string) (*TemplateMetadata, error) {
 		refs[name] = true
 		return map[string]any{}, nil
 	}
+	funcMap["incremental"] = func() bool {
+		return false
+	}
+	funcMap["macro"] = func(name string, args ...any) (string, error) {
+		refs[ResourceName{Kind: ResourceKindMacro, Name: name}] = true
+		return "<no value>", nil
+	}
 
 	// Parse template (error on missing keys)
 	t, err := template.New("").Funcs(funcMap).Option("missingkey=default").Parse(tmpl)
@@ -136,6 +154,7 @@ func AnalyzeTemplate(tmpl string) (*TemplateMetadata, error) {
 		"meta":  map[string]any{},
 		"spec":  map[string]any{},
 		"state": map[string]any{},
+		"args":  map[string]any{},
 	}
 
 	// Resolve template
@@ -205,6 +224,49 @@ func ResolveTemplate(tmpl string, data TemplateData) (string, error) {
 		}, nil
 	}
 
+	// Add func to check if the current run is incremental
+	funcMap["incremental"] = func() bool {
+		return data.Incremental
+	}
+
+	// Add func to expand a macro
+	funcMap["macro"] = func(name string, args ...any) (string, error) {
+		if data.Macro == nil {
+			return "", fmt.Errorf(`function "macro" is not supported here`)
+		}
+		if data.macroDepth >= maxMacroDepth {
+			return "", fmt.Errorf(`function "macro" failed: exceeded max nesting of %d macros`, maxMacroDepth)
+		}
+
+		// Lookup the macro
+		spec, err := data.Macro(name)
+		if err != nil {
+			return "", fmt.Errorf(`function "macro" failed: %w`, err)
+		}
+		if len(args) != len(spec.Args) {
+			return "", fmt.Errorf(`function "macro" failed: macro %q takes %d args, but got %d`, name, len(spec.Args), len(args))
+		}
+
+		// Expand the macro with the same data as the caller, except for the args
+		argsMap := make(map[string]any, len(args))
+		for i, arg := range spec.Args {
+			argsMap[arg] = args[i]
+		}
+		nested := data
+		nested.ExtraProps = make(map[string]any, len(data.ExtraProps)+1)
+		for k, v := range data.ExtraProps {
+			nested.ExtraProps[k] = v
+		}
+		nested.ExtraProps["args"] = argsMap
+		nested.macroDepth++
+
+		res, err := ResolveTemplate(spec.Sql, nested)
+		if err != nil {
+			return "", fmt.Errorf(`function "macro" failed to expand %q: %w`, name, err)
+		}
+		return res, nil
+	}
+
 	// Parse template (error on missing keys)
 	// TODO: missingkey=error may be problematic for claims.
 	t, err := template.New("").Funcs(funcMap).Option("missingkey=error").Parse(tmpl)
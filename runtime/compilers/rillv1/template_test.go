@@ -1,9 +1,11 @@
 package rillv1
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/stretchr/testify/require"
 )
 
@@ -50,6 +52,23 @@ func TestAnalyze(t *testing.T) {
 				ResolvedWithPlaceholders: `SELECT * FROM <no value> WHERE hello='<no value>' AND world='<no value>'`,
 			},
 		},
+		{
+			name:     "macro",
+			template: `SELECT {{ macro "clean_phone" "phone" }} AS phone FROM {{ ref "foo" }}`,
+			want: &TemplateMetadata{
+				Refs:                     []ResourceName{{Kind: ResourceKindMacro, Name: "clean_phone"}, {Name: "foo"}},
+				UsesTemplating:           true,
+				ResolvedWithPlaceholders: `SELECT <no value> AS phone FROM <no value>`,
+			},
+		},
+		{
+			name:     "macro args",
+			template: `regexp_replace({{ .args.col }}, '[^0-9]', '', 'g')`,
+			want: &TemplateMetadata{
+				UsesTemplating:           true,
+				ResolvedWithPlaceholders: `regexp_replace(<no value>, '[^0-9]', '', 'g')`,
+			},
+		},
 	}
 
 	for _, tc := range tt {
@@ -82,3 +101,56 @@ func TestResolve(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "SELECT partner_id FROM domain_partner_mapping WHERE domain = 'rilldata.com' and groups IN ('admin', 'user')", resolved)
 }
+
+func TestResolveIncremental(t *testing.T) {
+	template := "SELECT * FROM events{{ if incremental }} WHERE ts > '{{ .watermark }}'{{ end }}"
+
+	resolved, err := ResolveTemplate(template, TemplateData{ExtraProps: map[string]any{"watermark": ""}})
+	require.NoError(t, err)
+	require.Equal(t, "SELECT * FROM events", resolved)
+
+	resolved, err = ResolveTemplate(template, TemplateData{
+		ExtraProps:  map[string]any{"watermark": "2023-10-01T00:00:00Z"},
+		Incremental: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "SELECT * FROM events WHERE ts > '2023-10-01T00:00:00Z'", resolved)
+}
+
+func TestResolveMacro(t *testing.T) {
+	macros := map[string]*runtimev1.MacroSpec{
+		"clean_phone": {Args: []string{"col"}, Sql: `regexp_replace({{ .args.col }}, '[^0-9]', '', 'g')`},
+		"clean":       {Args: []string{"col", "prefix"}, Sql: `'{{ .args.prefix }}' || {{ macro "clean_phone" .args.col }}`},
+		"from":        {Sql: `FROM {{ ref "orders" }} WHERE ts > '{{ .watermark }}'`},
+		"loop":        {Sql: `{{ macro "loop" }}`},
+	}
+	data := TemplateData{
+		ExtraProps: map[string]any{"watermark": "2023-10-01"},
+		Resolve: func(ref ResourceName) (string, error) {
+			return `"` + ref.Name + `"`, nil
+		},
+		Macro: func(name string) (*runtimev1.MacroSpec, error) {
+			spec, ok := macros[name]
+			if !ok {
+				return nil, fmt.Errorf("macro %q not found", name)
+			}
+			return spec, nil
+		},
+	}
+
+	resolved, err := ResolveTemplate(`SELECT {{ macro "clean" "phone" "+1" }} AS phone {{ macro "from" }}`, data)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT '+1' || regexp_replace(phone, '[^0-9]', '', 'g') AS phone FROM "orders" WHERE ts > '2023-10-01'`, resolved)
+
+	_, err = ResolveTemplate(`SELECT {{ macro "clean_phone" }}`, data)
+	require.ErrorContains(t, err, `macro "clean_phone" takes 1 args, but got 0`)
+
+	_, err = ResolveTemplate(`SELECT {{ macro "missing" }}`, data)
+	require.ErrorContains(t, err, `macro "missing" not found`)
+
+	_, err = ResolveTemplate(`SELECT {{ macro "loop" }}`, data)
+	require.ErrorContains(t, err, "exceeded max nesting")
+
+	_, err = ResolveTemplate(`SELECT {{ macro "clean_phone" "phone" }}`, TemplateData{})
+	require.ErrorContains(t, err, `function "macro" is not supported here`)
+}
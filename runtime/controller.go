@@ -26,6 +26,10 @@ const (
 	ResourceKindModel          string = "rill.runtime.v1.ModelV2"
 	ResourceKindMetricsView    string = "rill.runtime.v1.MetricsViewV2"
 	ResourceKindMigration      string = "rill.runtime.v1.Migration"
+	ResourceKindReport         string = "rill.runtime.v1.Report"
+	ResourceKindAlert          string = "rill.runtime.v1.Alert"
+	ResourceKindTest           string = "rill.runtime.v1.Test"
+	ResourceKindMacro          string = "rill.runtime.v1.Macro"
 	ResourceKindPullTrigger    string = "rill.runtime.v1.PullTrigger"
 	ResourceKindRefreshTrigger string = "rill.runtime.v1.RefreshTrigger"
 	ResourceKindBucketPlanner  string = "rill.runtime.v1.BucketPlanner"
@@ -96,10 +100,11 @@ func NewController(ctx context.Context, rt *Runtime, instanceID string, logger *
 	c := &Controller{
 		Runtime:        rt,
 		InstanceID:     instanceID,
+		Activity:       rt.activity,
 		reconcilers:    make(map[string]Reconciler),
 		subscribers:    make(map[string]chan map[string]catalogEvent),
 		queue:          make(map[string]*runtimev1.ResourceName),
-		queueUpdatedCh: make(chan struct{}),
+		queueUpdatedCh: make(chan struct{}, 1),
 		timeline:       schedule.New[string, *runtimev1.ResourceName](nameStr),
 		invocations:    make(map[string]*invocation),
 		completed:      make(chan *invocation),
@@ -194,6 +199,8 @@ func (c *Controller) Run(ctx context.Context) error {
 			if err != nil {
 				loopErr = err
 				stop = true
+			} else {
+				resetTimelineTimer() // The invocation may have asked to be retriggered
 			}
 			c.mu.Unlock()
 		case <-timelineTimer.C: // A previous reconciler invocation asked to be re-scheduled now
@@ -1013,7 +1020,14 @@ func (c *Controller) invoke(r *runtimev1.Resource) error {
 func (c *Controller) processCompletedInvocation(inv *invocation) error {
 	r, err := c.catalog.get(inv.name, true, false)
 	if err != nil {
-		return err
+		if !errors.Is(err, drivers.ErrResourceNotFound) {
+			return err
+		}
+
+		// The resource was hard deleted by its own reconciler (e.g. a refresh trigger deleting itself), so there's nothing left to update.
+		close(inv.done)
+		delete(c.invocations, nameStr(inv.name))
+		return nil
 	}
 
 	if inv.isDelete {
@@ -0,0 +1,266 @@
+package archive
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/eapache/go-resiliency/retrier"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/archiveutil"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/zap"
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+	"golang.org/x/sync/singleflight"
+
+	// Register the bucket URL schemes supported for archives
+	_ "gocloud.dev/blob/fileblob"
+	_ "gocloud.dev/blob/gcsblob"
+	_ "gocloud.dev/blob/s3blob"
+)
+
+const (
+	downloadTimeout = 10 * time.Minute
+	retryN          = 3
+	retryWait       = 500 * time.Millisecond
+	// maxExtractedBytes limits the total size of the files in an archive
+	maxExtractedBytes = 1 << 30
+)
+
+var tracer = otel.Tracer("github.com/rilldata/rill/runtime/drivers/archive")
+
+// DSN configures the archive to serve project files from.
+// BucketURL is a gocloud.dev bucket URL (such as gs://bucket or file:///path/to/dir) and Path is the archive's key in the bucket.
+// Archives are immutable, so each uploaded archive has a unique ID, which is used as the commit hash of the repo.
+type DSN struct {
+	BucketURL string `json:"bucket_url"`
+	Path      string `json:"path"`
+	ID        string `json:"id"`
+}
+
+func init() {
+	drivers.Register("archive", driver{})
+}
+
+type driver struct{}
+
+func (d driver) Open(config map[string]any, shared bool, client activity.Client, logger *zap.Logger) (drivers.Handle, error) {
+	if shared {
+		return nil, fmt.Errorf("archive driver can't be shared")
+	}
+	dsnStr, ok := config["dsn"].(string)
+	if !ok {
+		return nil, fmt.Errorf("require dsn to open archive connection")
+	}
+
+	var dsn DSN
+	err := json.Unmarshal([]byte(dsnStr), &dsn)
+	if err != nil {
+		return nil, err
+	}
+	if dsn.BucketURL == "" || dsn.Path == "" || dsn.ID == "" {
+		return nil, fmt.Errorf("archive dsn must set bucket_url, path and id")
+	}
+
+	tempdir, err := os.MkdirTemp("", "archive_repo_driver")
+	if err != nil {
+		return nil, err
+	}
+
+	tempdir, err = filepath.Abs(tempdir)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE :: archive isn't downloaded yet
+	return &connection{
+		config:       config,
+		dsn:          dsn,
+		projectdir:   tempdir,
+		singleflight: &singleflight.Group{},
+		shared:       shared,
+	}, nil
+}
+
+func (d driver) Drop(config map[string]any, logger *zap.Logger) error {
+	return drivers.ErrDropNotSupported
+}
+
+func (d driver) Spec() drivers.Spec {
+	return drivers.Spec{}
+}
+
+func (d driver) HasAnonymousSourceAccess(ctx context.Context, src map[string]any, logger *zap.Logger) (bool, error) {
+	return false, fmt.Errorf("not implemented")
+}
+
+func (d driver) TertiarySourceConnectors(ctx context.Context, src map[string]any, logger *zap.Logger) ([]string, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+type connection struct {
+	config       map[string]any
+	dsn          DSN
+	shared       bool
+	projectdir   string // projectdir path should be absolute
+	singleflight *singleflight.Group
+	downloaded   atomic.Bool
+}
+
+// Close implements drivers.Connection.
+func (c *connection) Close() error {
+	err := os.RemoveAll(c.projectdir)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Config implements drivers.Connection.
+func (c *connection) Config() map[string]any {
+	return c.config
+}
+
+// Registry implements drivers.Connection.
+func (c *connection) AsRegistry() (drivers.RegistryStore, bool) {
+	return nil, false
+}
+
+// Catalog implements drivers.Connection.
+func (c *connection) AsCatalogStore(instanceID string) (drivers.CatalogStore, bool) {
+	return nil, false
+}
+
+// Repo implements drivers.Connection.
+func (c *connection) AsRepoStore(instanceID string) (drivers.RepoStore, bool) {
+	if c.shared {
+		return nil, false
+	}
+	return c, true
+}
+
+// OLAP implements drivers.Connection.
+func (c *connection) AsOLAP(instanceID string) (drivers.OLAPStore, bool) {
+	return nil, false
+}
+
+// Migrate implements drivers.Connection.
+func (c *connection) Migrate(ctx context.Context) (err error) {
+	return nil
+}
+
+// MigrationStatus implements drivers.Connection.
+func (c *connection) MigrationStatus(ctx context.Context) (current, desired int, err error) {
+	return 0, 0, nil
+}
+
+// AsObjectStore implements drivers.Connection.
+func (c *connection) AsObjectStore() (drivers.ObjectStore, bool) {
+	return nil, false
+}
+
+// AsTransporter implements drivers.Connection.
+func (c *connection) AsTransporter(from, to drivers.Handle) (drivers.Transporter, bool) {
+	return nil, false
+}
+
+func (c *connection) AsSQLStore() (drivers.SQLStore, bool) {
+	return nil, false
+}
+
+func (c *connection) AsFileStore() (drivers.FileStore, bool) {
+	return nil, false
+}
+
+// download downloads and extracts the archive with an exponential backoff retry on retryable errors.
+// Archives are immutable, so it's a no-op after the first successful download.
+// It's safe for concurrent calls, which are deduplicated.
+func (c *connection) download(ctx context.Context) error {
+	if c.downloaded.Load() {
+		return nil
+	}
+
+	ctx, span := tracer.Start(ctx, "download")
+	defer span.End()
+
+	ch := c.singleflight.DoChan("download", func() (interface{}, error) {
+		if c.downloaded.Load() {
+			return nil, nil
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), downloadTimeout)
+		defer cancel()
+
+		r := retrier.New(retrier.ExponentialBackoff(retryN, retryWait), retryErrClassifier{})
+		err := r.Run(func() error { return c.downloadUnsafe(ctx) })
+		if err != nil {
+			return nil, err
+		}
+		c.downloaded.Store(true)
+		return nil, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case res := <-ch:
+		return res.Err
+	}
+}
+
+// downloadUnsafe downloads the archive and extracts it into the project directory.
+// Unsafe for concurrent use.
+func (c *connection) downloadUnsafe(ctx context.Context) error {
+	bucket, err := blob.OpenBucket(ctx, c.dsn.BucketURL)
+	if err != nil {
+		return fmt.Errorf("failed to open bucket: %w", err)
+	}
+	defer bucket.Close()
+
+	r, err := bucket.NewReader(ctx, c.dsn.Path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to read archive %q: %w", c.dsn.Path, err)
+	}
+	defer r.Close()
+
+	// Clear files extracted by a previous failed attempt
+	entries, err := os.ReadDir(c.projectdir)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if err := os.RemoveAll(filepath.Join(c.projectdir, e.Name())); err != nil {
+			return err
+		}
+	}
+
+	return archiveutil.Extract(r, c.projectdir, maxExtractedBytes)
+}
+
+// retryErrClassifier classifies archive download errors as retryable or not.
+type retryErrClassifier struct{}
+
+func (retryErrClassifier) Classify(err error) retrier.Action {
+	if err == nil {
+		return retrier.Succeed
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return retrier.Fail
+	}
+
+	switch gcerrors.Code(err) {
+	case gcerrors.NotFound, gcerrors.PermissionDenied, gcerrors.InvalidArgument:
+		return retrier.Fail
+	}
+
+	return retrier.Retry
+}
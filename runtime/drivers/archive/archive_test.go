@@ -0,0 +1,89 @@
+package archive
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/archiveutil"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestRepo(t *testing.T) {
+	ctx := context.Background()
+	bucketDir := t.TempDir()
+
+	uploadArchive(t, bucketDir, "v1.tar.gz", map[string]string{
+		"rill.yaml":      "title: Hello",
+		"models/foo.sql": "SELECT 1",
+	})
+	uploadArchive(t, bucketDir, "v2.tar.gz", map[string]string{
+		"rill.yaml":      "title: Hello",
+		"models/foo.sql": "SELECT 2",
+		"models/bar.sql": "SELECT 3",
+	})
+
+	repo := openRepo(t, &DSN{BucketURL: "file://" + bucketDir, Path: "v1.tar.gz", ID: "a"})
+	hash, err := repo.CommitHash(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "a", hash)
+
+	paths, err := repo.ListRecursive(ctx, "**/*.{sql,yaml}")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"/rill.yaml", "/models/foo.sql"}, paths)
+
+	data, err := repo.Get(ctx, "models/foo.sql")
+	require.NoError(t, err)
+	require.Equal(t, "SELECT 1", data)
+	require.NoError(t, repo.Sync(ctx))
+
+	// A new upload is deployed as a new version
+	repo = openRepo(t, &DSN{BucketURL: "file://" + bucketDir, Path: "v2.tar.gz", ID: "b"})
+	hash, err = repo.CommitHash(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "b", hash)
+	require.NoError(t, repo.Sync(ctx))
+	data, err = repo.Get(ctx, "models/foo.sql")
+	require.NoError(t, err)
+	require.Equal(t, "SELECT 2", data)
+
+	require.Error(t, repo.Put(ctx, "models/baz.sql", bytes.NewReader(nil)))
+
+	// Missing archives are not retried
+	repo = openRepo(t, &DSN{BucketURL: "file://" + bucketDir, Path: "missing.tar.gz", ID: "c"})
+	_, err = repo.Get(ctx, "rill.yaml")
+	require.ErrorContains(t, err, "failed to read archive")
+}
+
+func uploadArchive(t *testing.T, bucketDir, name string, files map[string]string) {
+	src := t.TempDir()
+	for p, data := range files {
+		path := filepath.Join(src, p)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(data), 0o644))
+	}
+
+	f, err := os.Create(filepath.Join(bucketDir, name))
+	require.NoError(t, err)
+	defer f.Close()
+	require.NoError(t, archiveutil.Create(f, src))
+}
+
+func openRepo(t *testing.T, dsn *DSN) drivers.RepoStore {
+	dsnJSON, err := json.Marshal(dsn)
+	require.NoError(t, err)
+
+	handle, err := driver{}.Open(map[string]any{"dsn": string(dsnJSON)}, false, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, handle.Close()) })
+
+	repo, ok := handle.AsRepoStore("")
+	require.True(t, ok)
+	return repo
+}
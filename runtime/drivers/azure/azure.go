@@ -205,7 +205,7 @@ func (c *Connection) AsSQLStore() (drivers.SQLStore, bool) {
 }
 
 // DownloadFiles returns a file iterator over objects stored in azure blob storage.
-func (c *Connection) DownloadFiles(ctx context.Context, props map[string]any) (drivers.FileIterator, error) {
+func (c *Connection) DownloadFiles(ctx context.Context, props map[string]any, downloadOpts *drivers.DownloadOptions) (drivers.FileIterator, error) {
 	conf, err := parseSourceProperties(props)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse config: %w", err)
@@ -231,6 +231,10 @@ func (c *Connection) DownloadFiles(ctx context.Context, props map[string]any) (d
 		GlobPageSize:          conf.GlobPageSize,
 		GlobPattern:           conf.url.Path,
 		ExtractPolicy:         conf.extractPolicy,
+		TableFormat:           conf.tableFormat,
+	}
+	if downloadOpts != nil {
+		opts.SelectObjects = downloadOpts.SelectObjects
 	}
 
 	iter, err := rillblob.NewIterator(ctx, bucketObj, opts, c.logger)
@@ -276,8 +280,12 @@ type sourceProperties struct {
 	GlobMaxObjectsMatched int            `mapstructure:"glob.max_objects_matched"`
 	GlobMaxObjectsListed  int64          `mapstructure:"glob.max_objects_listed"`
 	GlobPageSize          int            `mapstructure:"glob.page_size"`
+	Format                string         `mapstructure:"format"`
+	Version               *int64         `mapstructure:"version"`
+	SnapshotID            *int64         `mapstructure:"snapshot_id"`
 	url                   *globutil.URL
 	extractPolicy         *rillblob.ExtractPolicy
+	tableFormat           *rillblob.TableFormat
 }
 
 func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
@@ -298,6 +306,11 @@ func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
 	}
 
 	conf.url = bucketURL
+
+	conf.tableFormat, err = rillblob.ParseTableFormat(conf.Format, conf.Version, conf.SnapshotID)
+	if err != nil {
+		return nil, err
+	}
 	return conf, nil
 }
 
@@ -2,6 +2,7 @@ package blob
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -52,6 +53,12 @@ type Options struct {
 	BatchSizeBytes int64
 	// General blob format (json, csv, parquet, etc)
 	Format string
+	// SelectObjects optionally selects a subset of the matched objects to download (see drivers.DownloadOptions).
+	// When set, the limits on matched objects and total size apply to the selected objects.
+	SelectObjects func(objects []*drivers.ObjectInfo) ([]*drivers.ObjectInfo, error)
+	// TableFormat optionally configures ingestion of a Delta Lake or Iceberg table.
+	// When set, GlobPattern is the root of the table and the objects are resolved from the table's metadata.
+	TableFormat *TableFormat
 }
 
 // sets defaults if not set by user
@@ -257,7 +264,11 @@ func (it *blobIterator) plan() ([]*objectWithPlan, error) {
 		return nil, err
 	}
 
-	listOpts, ok := listOptions(it.opts.GlobPattern)
+	if it.opts.TableFormat != nil {
+		return it.planTable(planner)
+	}
+
+	listOpts, ok := listOptions(it.opts.GlobPattern, it.opts.SelectObjects != nil)
 	if !ok {
 		it.logger.Info("glob pattern corresponds to single object", zap.String("glob", it.opts.GlobPattern))
 		// required to fetch size to enforce disk limits
@@ -269,13 +280,23 @@ func (it *blobIterator) plan() ([]*objectWithPlan, error) {
 			size = attr.Size
 		}
 
-		planner.add(&blob.ListObject{Key: it.opts.GlobPattern, Size: size})
+		obj := &blob.ListObject{Key: it.opts.GlobPattern, Size: size}
+		if attr != nil {
+			obj.ModTime = attr.ModTime
+			obj.MD5 = attr.MD5
+		}
+		if it.opts.SelectObjects != nil {
+			return it.planSelected(planner, []*blob.ListObject{obj}, 1)
+		}
+
+		planner.add(obj)
 		if err := it.opts.validateLimits(size, 1, 1); err != nil {
 			return nil, err
 		}
 		return planner.items(), nil
 	}
 	it.logger.Info("planner started", zap.String("glob", it.opts.GlobPattern), zap.String("prefix", listOpts.Prefix), observability.ZapCtx(it.ctx))
+	var matched []*blob.ListObject
 	token := blob.FirstPageToken
 	for token != nil && !planner.done() {
 		objs, nextToken, err := it.bucket.ListPage(it.ctx, token, it.opts.GlobPageSize, listOpts)
@@ -286,19 +307,36 @@ func (it *blobIterator) plan() ([]*objectWithPlan, error) {
 		token = nextToken
 		fetched += int64(len(objs))
 		for _, obj := range objs {
-			if matched, _ := doublestar.Match(it.opts.GlobPattern, obj.Key); matched {
-				size += obj.Size
+			if ok, _ := doublestar.Match(it.opts.GlobPattern, obj.Key); ok {
 				matchCount++
+				if it.opts.SelectObjects != nil {
+					// Objects are planned after selection
+					matched = append(matched, obj)
+					continue
+				}
+				size += obj.Size
 				if !planner.add(obj) {
 					break
 				}
 			}
 		}
-		if err := it.opts.validateLimits(size, matchCount, fetched); err != nil {
+		if it.opts.SelectObjects != nil {
+			// Only the listing limit applies before selection
+			if err := it.opts.validateLimits(0, 0, fetched); err != nil {
+				return nil, err
+			}
+		} else if err := it.opts.validateLimits(size, matchCount, fetched); err != nil {
 			return nil, err
 		}
 	}
 
+	if it.opts.SelectObjects != nil {
+		if matchCount == 0 {
+			return nil, fmt.Errorf("no files found for glob pattern %q", it.opts.GlobPattern)
+		}
+		return it.planSelected(planner, matched, fetched)
+	}
+
 	items := planner.items()
 	if len(items) == 0 {
 		return nil, fmt.Errorf("no files found for glob pattern %q", it.opts.GlobPattern)
@@ -310,6 +348,77 @@ func (it *blobIterator) plan() ([]*objectWithPlan, error) {
 	return items, nil
 }
 
+// planTable plans the live data files of the table at the glob pattern (which is not matched as a glob).
+func (it *blobIterator) planTable(planner *planner) ([]*objectWithPlan, error) {
+	objs, err := it.opts.TableFormat.ListObjects(it.ctx, it.bucket, it.opts.GlobPattern)
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) == 0 {
+		return nil, fmt.Errorf("no data files found for %s table %q", it.opts.TableFormat.Name, it.opts.GlobPattern)
+	}
+
+	fetched := int64(len(objs))
+	if it.opts.SelectObjects != nil {
+		return it.planSelected(planner, objs, fetched)
+	}
+
+	var size int64
+	for _, obj := range objs {
+		size += obj.Size
+		if !planner.add(obj) {
+			break
+		}
+	}
+	if err := it.opts.validateLimits(size, len(objs), fetched); err != nil {
+		return nil, err
+	}
+
+	it.logger.Info("planner completed", zap.String("table", it.opts.GlobPattern), zap.String("format", it.opts.TableFormat.Name),
+		zap.Int("matched", len(objs)), zap.Int64("bytes_matched", size), observability.ZapCtx(it.ctx))
+	return planner.items(), nil
+}
+
+// planSelected plans the objects returned by opts.SelectObjects for the given matched objects.
+// Unlike the regular planning, it may return no objects (if none are selected).
+func (it *blobIterator) planSelected(planner *planner, matched []*blob.ListObject, fetched int64) ([]*objectWithPlan, error) {
+	infos := make([]*drivers.ObjectInfo, len(matched))
+	byKey := make(map[string]*blob.ListObject, len(matched))
+	for i, obj := range matched {
+		infos[i] = &drivers.ObjectInfo{
+			Key:          obj.Key,
+			ETag:         hex.EncodeToString(obj.MD5),
+			LastModified: obj.ModTime,
+			Size:         obj.Size,
+		}
+		byKey[obj.Key] = obj
+	}
+
+	selected, err := it.opts.SelectObjects(infos)
+	if err != nil {
+		return nil, err
+	}
+
+	var size int64
+	for _, info := range selected {
+		obj, ok := byKey[info.Key]
+		if !ok {
+			return nil, fmt.Errorf("selected object %q was not matched", info.Key)
+		}
+		size += obj.Size
+		if !planner.add(obj) {
+			break
+		}
+	}
+	if err := it.opts.validateLimits(size, len(selected), fetched); err != nil {
+		return nil, err
+	}
+
+	it.logger.Info("planner completed", zap.String("glob", it.opts.GlobPattern), zap.Int("matched", len(matched)),
+		zap.Int("selected", len(selected)), zap.Int64("bytes_selected", size), observability.ZapCtx(it.ctx))
+	return planner.items(), nil
+}
+
 func (it *blobIterator) downloadFiles() {
 	// Ensure the downloadsCh is closed when the function returns.
 	// This unblocks waiting calls to Next() or Close().
@@ -479,14 +588,19 @@ type downloadResult struct {
 	bytes int64
 }
 
-// listOptions for page listing api
-func listOptions(globPattern string) (*blob.ListOptions, bool) {
+// listOptions for page listing api.
+// If withVersion is true, attributes needed to detect changes to objects are also fetched.
+func listOptions(globPattern string, withVersion bool) (*blob.ListOptions, bool) {
 	listOptions := &blob.ListOptions{BeforeList: func(as func(interface{}) bool) error {
 		// Access storage.Query via q here.
 		var q *storage.Query
 		if as(&q) {
 			// we only need name and size, adding only required attributes to reduce data fetched
-			_ = q.SetAttrSelection([]string{"Name", "Size"})
+			attrs := []string{"Name", "Size"}
+			if withVersion {
+				attrs = append(attrs, "Updated", "MD5")
+			}
+			_ = q.SetAttrSelection(attrs)
 		}
 		return nil
 	}}
@@ -0,0 +1,331 @@
+package blob
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/apache/arrow/go/v13/arrow/array"
+	"github.com/apache/arrow/go/v13/arrow/memory"
+	"github.com/apache/arrow/go/v13/parquet"
+	"github.com/apache/arrow/go/v13/parquet/file"
+	"github.com/apache/arrow/go/v13/parquet/pqarrow"
+	"gocloud.dev/blob"
+)
+
+// Delta Lake reader protocol versions and table features that don't change how the data files are read
+const _deltaMaxReaderVersion = 3
+
+var _deltaReaderFeatures = map[string]bool{
+	"columnMapping":   true, // checked against the table configuration
+	"deletionVectors": true, // checked against the live files
+	"timestampNtz":    true,
+}
+
+var (
+	_deltaCommitRegex     = regexp.MustCompile(`^(\d{20})\.json$`)
+	_deltaCheckpointRegex = regexp.MustCompile(`^(\d{20})\.checkpoint(?:\.(\d{10})\.(\d{10}))?\.parquet$`)
+)
+
+// deltaAction is a line of a Delta Lake commit file or a row of a checkpoint.
+// See https://github.com/delta-io/delta/blob/master/PROTOCOL.md#actions
+type deltaAction struct {
+	Add *struct {
+		Path           string          `json:"path"`
+		Size           int64           `json:"size"`
+		DeletionVector json.RawMessage `json:"deletionVector"`
+	} `json:"add"`
+	Remove *struct {
+		Path string `json:"path"`
+	} `json:"remove"`
+	MetaData *struct {
+		Configuration deltaConfiguration `json:"configuration"`
+	} `json:"metaData"`
+	Protocol *struct {
+		MinReaderVersion int      `json:"minReaderVersion"`
+		ReaderFeatures   []string `json:"readerFeatures"`
+	} `json:"protocol"`
+}
+
+// deltaConfiguration is the configuration of a Delta Lake table.
+// Commit files store it as a JSON object, while checkpoints (converted to JSON from Arrow) store it as a list of key-value pairs.
+type deltaConfiguration map[string]string
+
+func (c *deltaConfiguration) UnmarshalJSON(data []byte) error {
+	var m map[string]string
+	if err := json.Unmarshal(data, &m); err == nil {
+		*c = m
+		return nil
+	}
+
+	var kvs []struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	}
+	if err := json.Unmarshal(data, &kvs); err != nil {
+		return err
+	}
+	*c = make(map[string]string, len(kvs))
+	for _, kv := range kvs {
+		(*c)[kv.Key] = kv.Value
+	}
+	return nil
+}
+
+// deltaSnapshot is the state of a Delta Lake table at a version.
+type deltaSnapshot struct {
+	root          string
+	files         map[string]*deltaFile
+	configuration map[string]string
+	readerVersion int
+	features      []string
+}
+
+type deltaFile struct {
+	size           int64
+	deletionVector bool
+}
+
+func (s *deltaSnapshot) apply(a *deltaAction) {
+	switch {
+	case a.Add != nil:
+		s.files[a.Add.Path] = &deltaFile{
+			size:           a.Add.Size,
+			deletionVector: len(a.Add.DeletionVector) > 0 && string(a.Add.DeletionVector) != "null",
+		}
+	case a.Remove != nil:
+		delete(s.files, a.Remove.Path)
+	case a.MetaData != nil:
+		s.configuration = a.MetaData.Configuration
+	case a.Protocol != nil:
+		s.readerVersion = a.Protocol.MinReaderVersion
+		s.features = a.Protocol.ReaderFeatures
+	}
+}
+
+func (s *deltaSnapshot) objects() ([]*blob.ListObject, error) {
+	if s.readerVersion > _deltaMaxReaderVersion {
+		return nil, fmt.Errorf("delta: reader version %d is not supported", s.readerVersion)
+	}
+	for _, f := range s.features {
+		if !_deltaReaderFeatures[f] {
+			return nil, fmt.Errorf("delta: table feature %q is not supported", f)
+		}
+	}
+	if mode := s.configuration["delta.columnMapping.mode"]; mode != "" && mode != "none" {
+		return nil, fmt.Errorf("delta: column mapping mode %q is not supported", mode)
+	}
+
+	objs := make([]*blob.ListObject, 0, len(s.files))
+	for p, f := range s.files {
+		if f.deletionVector {
+			return nil, fmt.Errorf("delta: file %q has a deletion vector, which is not supported", p)
+		}
+		key, err := tableFileKey(s.root, "", p)
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, &blob.ListObject{Key: key, Size: f.size})
+	}
+	return objs, nil
+}
+
+// deltaCheckpoint is a (possibly multi-part) checkpoint in the Delta Lake log
+type deltaCheckpoint struct {
+	parts map[int]*blob.ListObject
+	total int
+}
+
+func (c *deltaCheckpoint) complete() bool {
+	return len(c.parts) == c.total
+}
+
+// listDeltaObjects resolves the data files of the Delta Lake table at root for the given version (or the latest version if nil).
+// It reads the latest checkpoint at or before the version and replays the commits after it.
+func listDeltaObjects(ctx context.Context, bucket *blob.Bucket, root string, version *int64) ([]*blob.ListObject, error) {
+	logPrefix := joinKey(root, "_delta_log") + "/"
+	commits := make(map[int64]*blob.ListObject)
+	checkpoints := make(map[int64]*deltaCheckpoint)
+	latest := int64(-1)
+
+	iter := bucket.List(&blob.ListOptions{Prefix: logPrefix, Delimiter: "/"})
+	for {
+		obj, err := iter.Next(ctx)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+
+		var v int64
+		name := obj.Key[len(logPrefix):]
+		if m := _deltaCommitRegex.FindStringSubmatch(name); m != nil {
+			v, _ = strconv.ParseInt(m[1], 10, 64)
+			commits[v] = obj
+		} else if m := _deltaCheckpointRegex.FindStringSubmatch(name); m != nil {
+			v, _ = strconv.ParseInt(m[1], 10, 64)
+			part, total := 1, 1
+			if m[2] != "" {
+				part, _ = strconv.Atoi(m[2])
+				total, _ = strconv.Atoi(m[3])
+			}
+			cp, ok := checkpoints[v]
+			if !ok {
+				cp = &deltaCheckpoint{parts: make(map[int]*blob.ListObject), total: total}
+				checkpoints[v] = cp
+			}
+			cp.parts[part] = obj
+		} else {
+			continue
+		}
+		if v > latest {
+			latest = v
+		}
+	}
+	if latest < 0 {
+		return nil, fmt.Errorf("delta: no transaction log found at %q", logPrefix)
+	}
+
+	target := latest
+	if version != nil {
+		if *version > latest {
+			return nil, fmt.Errorf("delta: version %d not found, the latest version is %d", *version, latest)
+		}
+		target = *version
+	}
+
+	snapshot := &deltaSnapshot{root: root, files: make(map[string]*deltaFile)}
+
+	// Start from the latest complete checkpoint at or before the target version
+	start := int64(0)
+	var checkpoint *deltaCheckpoint
+	for v, cp := range checkpoints {
+		if v <= target && v >= start && cp.complete() {
+			start = v
+			checkpoint = cp
+		}
+	}
+	if checkpoint != nil {
+		for i := 1; i <= checkpoint.total; i++ {
+			if err := readDeltaCheckpoint(ctx, bucket, checkpoint.parts[i], snapshot); err != nil {
+				return nil, err
+			}
+		}
+		start++
+	}
+
+	for v := start; v <= target; v++ {
+		obj, ok := commits[v]
+		if !ok {
+			return nil, fmt.Errorf("delta: commit for version %d not found in %q", v, logPrefix)
+		}
+		if err := readDeltaCommit(ctx, bucket, obj.Key, snapshot); err != nil {
+			return nil, err
+		}
+	}
+
+	return snapshot.objects()
+}
+
+func readDeltaCommit(ctx context.Context, bucket *blob.Bucket, key string, snapshot *deltaSnapshot) error {
+	data, err := bucket.ReadAll(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, len(data)+1)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		a := &deltaAction{}
+		if err := json.Unmarshal(line, a); err != nil {
+			return fmt.Errorf("delta: failed to parse commit %q: %w", key, err)
+		}
+		snapshot.apply(a)
+	}
+	return scanner.Err()
+}
+
+func readDeltaCheckpoint(ctx context.Context, bucket *blob.Bucket, obj *blob.ListObject, snapshot *deltaSnapshot) error {
+	mem := memory.NewCheckedAllocator(memory.DefaultAllocator)
+	pf, err := file.NewParquetReader(NewBlobObjectReader(ctx, bucket, obj), file.WithReadProps(parquet.NewReaderProperties(mem)))
+	if err != nil {
+		return fmt.Errorf("delta: failed to read checkpoint %q: %w", obj.Key, err)
+	}
+	defer pf.Close()
+
+	fileReader, err := pqarrow.NewFileReader(pf, pqarrow.ArrowReadProperties{BatchSize: _batchSize}, mem)
+	if err != nil {
+		return err
+	}
+
+	// Only read the columns of the actions that are relevant for resolving data files
+	schema, err := fileReader.Schema()
+	if err != nil {
+		return err
+	}
+	var cols []int
+	for _, name := range []string{"add", "remove", "metaData", "protocol"} {
+		idx := schema.FieldIndices(name)
+		if len(idx) == 0 {
+			continue
+		}
+		cols = append(cols, leafColumns(fileReader.Manifest.Fields[idx[0]])...)
+	}
+
+	rr, err := fileReader.GetRecordReader(ctx, cols, nil)
+	if err != nil {
+		return err
+	}
+	defer rr.Release()
+
+	// Convert the rows to JSON to parse them in the same way as the commit files
+	var buf bytes.Buffer
+	for rr.Next() {
+		buf.Reset()
+		if err := array.RecordToJSON(rr.Record(), &buf); err != nil {
+			return err
+		}
+		dec := json.NewDecoder(&buf)
+		for dec.More() {
+			a := &deltaAction{}
+			if err := dec.Decode(a); err != nil {
+				return fmt.Errorf("delta: failed to parse checkpoint %q: %w", obj.Key, err)
+			}
+			snapshot.apply(a)
+		}
+	}
+	if err := rr.Err(); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	return nil
+}
+
+// leafColumns returns the indices of the parquet leaf columns of a field.
+func leafColumns(field pqarrow.SchemaField) []int {
+	if field.IsLeaf() {
+		return []int{field.ColIndex}
+	}
+	var cols []int
+	for _, child := range field.Children {
+		cols = append(cols, leafColumns(child)...)
+	}
+	return cols
+}
+
+func joinKey(root, name string) string {
+	if root == "" {
+		return name
+	}
+	return root + "/" + name
+}
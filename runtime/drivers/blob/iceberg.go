@@ -0,0 +1,224 @@
+package blob
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/linkedin/goavro/v2"
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+)
+
+// Status of a deleted entry in an Iceberg manifest
+const _icebergStatusDeleted = 2
+
+// Matches metadata files written by the Hadoop catalog (v1.metadata.json) and other catalogs (00001-<uuid>.metadata.json)
+var _icebergMetadataRegex = regexp.MustCompile(`^v?(\d+)[-.].*metadata\.json(\.gz)?$`)
+
+// icebergMetadata is the table metadata file of an Iceberg table.
+// See https://iceberg.apache.org/spec/#table-metadata-fields
+type icebergMetadata struct {
+	Location          string            `json:"location"`
+	CurrentSnapshotID *int64            `json:"current-snapshot-id"`
+	Snapshots         []icebergSnapshot `json:"snapshots"`
+}
+
+type icebergSnapshot struct {
+	SnapshotID   int64  `json:"snapshot-id"`
+	ManifestList string `json:"manifest-list"`
+	// Manifests is only used by format version 1 tables without a manifest list
+	Manifests []string `json:"manifests"`
+}
+
+// listIcebergObjects resolves the data files of the Iceberg table at root for the given snapshot (or the current snapshot if nil).
+// It reads the latest metadata file, then the snapshot's manifest list and manifests.
+func listIcebergObjects(ctx context.Context, bucket *blob.Bucket, root string, snapshotID *int64) ([]*blob.ListObject, error) {
+	metadataKey, err := icebergMetadataKey(ctx, bucket, root)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := readIcebergFile(ctx, bucket, metadataKey)
+	if err != nil {
+		return nil, err
+	}
+	md := &icebergMetadata{}
+	if err := json.Unmarshal(data, md); err != nil {
+		return nil, fmt.Errorf("iceberg: failed to parse metadata %q: %w", metadataKey, err)
+	}
+
+	id := md.CurrentSnapshotID
+	if snapshotID != nil {
+		id = snapshotID
+	}
+	if id == nil || *id == -1 {
+		// The table has no data
+		return nil, nil
+	}
+
+	var snapshot *icebergSnapshot
+	for i := range md.Snapshots {
+		if md.Snapshots[i].SnapshotID == *id {
+			snapshot = &md.Snapshots[i]
+			break
+		}
+	}
+	if snapshot == nil {
+		return nil, fmt.Errorf("iceberg: snapshot %d not found in %q", *id, metadataKey)
+	}
+
+	manifests := snapshot.Manifests
+	if snapshot.ManifestList != "" {
+		key, err := tableFileKey(root, md.Location, snapshot.ManifestList)
+		if err != nil {
+			return nil, err
+		}
+		manifests = nil
+		err = readAvroRecords(ctx, bucket, key, func(rec map[string]any) error {
+			p, _ := rec["manifest_path"].(string)
+			manifests = append(manifests, p)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var objs []*blob.ListObject
+	for _, manifest := range manifests {
+		key, err := tableFileKey(root, md.Location, manifest)
+		if err != nil {
+			return nil, err
+		}
+		err = readAvroRecords(ctx, bucket, key, func(entry map[string]any) error {
+			if status, _ := entry["status"].(int32); status == _icebergStatusDeleted {
+				return nil
+			}
+			df, ok := entry["data_file"].(map[string]any)
+			if !ok {
+				return fmt.Errorf("iceberg: invalid manifest %q", manifest)
+			}
+
+			p, _ := df["file_path"].(string)
+			if content, _ := df["content"].(int32); content != 0 {
+				return fmt.Errorf("iceberg: delete file %q found, tables with row-level deletes are not supported", p)
+			}
+			if format, _ := df["file_format"].(string); !strings.EqualFold(format, "parquet") {
+				return fmt.Errorf("iceberg: data file %q has format %q, only parquet is supported", p, format)
+			}
+
+			key, err := tableFileKey(root, md.Location, p)
+			if err != nil {
+				return err
+			}
+			size, _ := df["file_size_in_bytes"].(int64)
+			objs = append(objs, &blob.ListObject{Key: key, Size: size})
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return objs, nil
+}
+
+// icebergMetadataKey returns the key of the latest metadata file of the table at root.
+// It uses the version hint written by the Hadoop catalog if present, otherwise it picks the metadata file with the highest version.
+func icebergMetadataKey(ctx context.Context, bucket *blob.Bucket, root string) (string, error) {
+	prefix := joinKey(root, "metadata") + "/"
+
+	hint, err := bucket.ReadAll(ctx, prefix+"version-hint.text")
+	if err == nil {
+		v, err := strconv.ParseInt(strings.TrimSpace(string(hint)), 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("iceberg: invalid version hint %q", string(hint))
+		}
+		key := fmt.Sprintf("%sv%d.metadata.json", prefix, v)
+		if ok, err := bucket.Exists(ctx, key); err == nil && ok {
+			return key, nil
+		}
+	} else if gcerrors.Code(err) != gcerrors.NotFound {
+		return "", err
+	}
+
+	var key string
+	latest := int64(-1)
+	iter := bucket.List(&blob.ListOptions{Prefix: prefix, Delimiter: "/"})
+	for {
+		obj, err := iter.Next(ctx)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return "", err
+		}
+
+		m := _icebergMetadataRegex.FindStringSubmatch(obj.Key[len(prefix):])
+		if m == nil {
+			continue
+		}
+		v, _ := strconv.ParseInt(m[1], 10, 64)
+		if v > latest {
+			latest = v
+			key = obj.Key
+		}
+	}
+	if key == "" {
+		return "", fmt.Errorf("iceberg: no metadata found at %q", prefix)
+	}
+	return key, nil
+}
+
+// readIcebergFile reads a file and decompresses it if it's gzipped (metadata files may be compressed).
+func readIcebergFile(ctx context.Context, bucket *blob.Bucket, key string) ([]byte, error) {
+	data, err := bucket.ReadAll(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 2 || data[0] != 0x1f || data[1] != 0x8b {
+		return data, nil
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// readAvroRecords calls fn for each record in the Avro object container file at key.
+func readAvroRecords(ctx context.Context, bucket *blob.Bucket, key string, fn func(rec map[string]any) error) error {
+	r, err := bucket.NewReader(ctx, key, nil)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	ocf, err := goavro.NewOCFReader(r)
+	if err != nil {
+		return fmt.Errorf("iceberg: failed to read %q: %w", key, err)
+	}
+	for ocf.Scan() {
+		v, err := ocf.Read()
+		if err != nil {
+			return fmt.Errorf("iceberg: failed to read %q: %w", key, err)
+		}
+		rec, ok := v.(map[string]any)
+		if !ok {
+			return fmt.Errorf("iceberg: unexpected record in %q", key)
+		}
+		if err := fn(rec); err != nil {
+			return err
+		}
+	}
+	return ocf.Err()
+}
@@ -0,0 +1,101 @@
+package blob
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+
+	"gocloud.dev/blob"
+)
+
+const (
+	TableFormatDelta   = "delta"
+	TableFormatIceberg = "iceberg"
+)
+
+// TableFormat configures ingestion of a Delta Lake or Apache Iceberg table.
+// The files of such tables can't be selected by globbing since the live file set is defined by the table's transaction log or manifests.
+type TableFormat struct {
+	Name string
+	// Version optionally pins a Delta Lake table version. Defaults to the latest version.
+	Version *int64
+	// SnapshotID optionally pins an Iceberg snapshot. Defaults to the current snapshot.
+	SnapshotID *int64
+}
+
+// IsTableFormat returns true if format is a table format supported by ParseTableFormat.
+func IsTableFormat(format string) bool {
+	return format == TableFormatDelta || format == TableFormatIceberg
+}
+
+// ParseTableFormat parses the table format options of a source.
+// It returns nil if format is not a table format.
+func ParseTableFormat(format string, version, snapshotID *int64) (*TableFormat, error) {
+	if !IsTableFormat(format) {
+		if version != nil || snapshotID != nil {
+			return nil, fmt.Errorf("the properties 'version' and 'snapshot_id' are only supported for format 'delta' and 'iceberg'")
+		}
+		return nil, nil
+	}
+
+	if version != nil {
+		if format != TableFormatDelta {
+			return nil, fmt.Errorf("the property 'version' is only supported for format 'delta'")
+		}
+		if *version < 0 {
+			return nil, fmt.Errorf("invalid version %d", *version)
+		}
+	}
+	if snapshotID != nil && format != TableFormatIceberg {
+		return nil, fmt.Errorf("the property 'snapshot_id' is only supported for format 'iceberg'")
+	}
+
+	return &TableFormat{Name: format, Version: version, SnapshotID: snapshotID}, nil
+}
+
+// ListObjects returns the live data files of the table stored at root in the bucket.
+// The keys of the returned objects are relative to the bucket.
+func (f *TableFormat) ListObjects(ctx context.Context, bucket *blob.Bucket, root string) ([]*blob.ListObject, error) {
+	root = strings.Trim(root, "/")
+
+	var objs []*blob.ListObject
+	var err error
+	switch f.Name {
+	case TableFormatDelta:
+		objs, err = listDeltaObjects(ctx, bucket, root, f.Version)
+	case TableFormatIceberg:
+		objs, err = listIcebergObjects(ctx, bucket, root, f.SnapshotID)
+	default:
+		return nil, fmt.Errorf("unsupported table format %q", f.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(objs, func(i, j int) bool { return objs[i].Key < objs[j].Key })
+	return objs, nil
+}
+
+// tableFileKey returns the bucket key of a file referenced by table metadata.
+// Absolute URIs are resolved relative to the table location if possible, otherwise by dropping the scheme and bucket.
+// Relative paths are resolved relative to the table's root.
+func tableFileKey(root, location, p string) (string, error) {
+	u, err := url.Parse(p)
+	if err != nil {
+		return "", fmt.Errorf("invalid file path %q: %w", p, err)
+	}
+	if u.Scheme == "" {
+		return path.Join(root, u.Path), nil
+	}
+
+	if location != "" {
+		location = strings.TrimSuffix(location, "/") + "/"
+		if rel, ok := strings.CutPrefix(p, location); ok {
+			return path.Join(root, rel), nil
+		}
+	}
+	return strings.TrimPrefix(u.Path, "/"), nil
+}
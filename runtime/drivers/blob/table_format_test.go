@@ -0,0 +1,310 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/v13/arrow"
+	"github.com/apache/arrow/go/v13/arrow/array"
+	"github.com/apache/arrow/go/v13/arrow/memory"
+	"github.com/apache/arrow/go/v13/parquet/pqarrow"
+	"github.com/linkedin/goavro/v2"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gocloud.dev/blob"
+)
+
+func TestParseTableFormat(t *testing.T) {
+	v := int64(1)
+
+	f, err := ParseTableFormat("parquet", nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, f)
+
+	f, err = ParseTableFormat("delta", &v, nil)
+	require.NoError(t, err)
+	require.Equal(t, &TableFormat{Name: "delta", Version: &v}, f)
+
+	f, err = ParseTableFormat("iceberg", nil, &v)
+	require.NoError(t, err)
+	require.Equal(t, &TableFormat{Name: "iceberg", SnapshotID: &v}, f)
+
+	_, err = ParseTableFormat("parquet", &v, nil)
+	require.Error(t, err)
+	_, err = ParseTableFormat("delta", nil, &v)
+	require.Error(t, err)
+	_, err = ParseTableFormat("iceberg", &v, nil)
+	require.Error(t, err)
+}
+
+func TestDeltaListObjects(t *testing.T) {
+	ctx := context.Background()
+	bucket := prepareDeltaBucket(t)
+	defer bucket.Close()
+
+	tests := []struct {
+		name    string
+		version *int64
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "latest",
+			want: []string{"tbl/dt=2023-10-01/b.parquet", "tbl/dt=2023-10-02/c d.parquet"},
+		},
+		{
+			name:    "version 0",
+			version: ptr(0),
+			want:    []string{"tbl/dt=2023-10-01/a.parquet", "tbl/dt=2023-10-01/b.parquet"},
+		},
+		{
+			name:    "version not found",
+			version: ptr(2),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objs, err := (&TableFormat{Name: TableFormatDelta, Version: tt.version}).ListObjects(ctx, bucket, "tbl/")
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, objectKeys(objs))
+		})
+	}
+
+	// A checkpoint replaces the commits before it
+	require.NoError(t, bucket.Delete(ctx, "tbl/_delta_log/00000000000000000000.json"))
+	writeDeltaCheckpoint(t, bucket, "tbl/_delta_log/00000000000000000001.checkpoint.parquet", `[
+		{"add": {"path": "dt=2023-10-01/b.parquet", "size": 2}, "remove": null},
+		{"add": {"path": "dt=2023-10-02/c%20d.parquet", "size": 3}, "remove": null},
+		{"add": null, "remove": {"path": "dt=2023-10-01/a.parquet"}}
+	]`)
+	writeDeltaCommit(t, bucket, 2, `{"add": {"path": "dt=2023-10-02/e.parquet", "size": 4, "deletionVector": null}}`)
+
+	objs, err := (&TableFormat{Name: TableFormatDelta}).ListObjects(ctx, bucket, "tbl")
+	require.NoError(t, err)
+	require.Equal(t, []string{"tbl/dt=2023-10-01/b.parquet", "tbl/dt=2023-10-02/c d.parquet", "tbl/dt=2023-10-02/e.parquet"}, objectKeys(objs))
+
+	// The commits before the checkpoint are required for time travel
+	_, err = (&TableFormat{Name: TableFormatDelta, Version: ptr(0)}).ListObjects(ctx, bucket, "tbl")
+	require.Error(t, err)
+
+	// Deletion vectors and column mapping change how the data files must be read
+	writeDeltaCommit(t, bucket, 3, `{"add": {"path": "dt=2023-10-02/f.parquet", "size": 5, "deletionVector": {"storageType": "u", "cardinality": 1}}}`)
+	_, err = (&TableFormat{Name: TableFormatDelta}).ListObjects(ctx, bucket, "tbl")
+	require.ErrorContains(t, err, "deletion vector")
+
+	writeDeltaCommit(t, bucket, 4, `{"remove": {"path": "dt=2023-10-02/f.parquet"}}
+{"metaData": {"id": "1", "configuration": {"delta.columnMapping.mode": "name"}}}`)
+	_, err = (&TableFormat{Name: TableFormatDelta}).ListObjects(ctx, bucket, "tbl")
+	require.ErrorContains(t, err, "column mapping")
+}
+
+func TestIcebergListObjects(t *testing.T) {
+	ctx := context.Background()
+	bucket := prepareIcebergBucket(t)
+	defer bucket.Close()
+
+	objs, err := (&TableFormat{Name: TableFormatIceberg}).ListObjects(ctx, bucket, "warehouse/tbl")
+	require.NoError(t, err)
+	require.Equal(t, []string{"warehouse/tbl/data/a.parquet", "warehouse/tbl/data/c.parquet"}, objectKeys(objs))
+	require.Equal(t, int64(3), objs[1].Size)
+
+	objs, err = (&TableFormat{Name: TableFormatIceberg, SnapshotID: ptr(1)}).ListObjects(ctx, bucket, "warehouse/tbl")
+	require.NoError(t, err)
+	require.Equal(t, []string{"warehouse/tbl/data/a.parquet", "warehouse/tbl/data/b.parquet"}, objectKeys(objs))
+
+	_, err = (&TableFormat{Name: TableFormatIceberg, SnapshotID: ptr(3)}).ListObjects(ctx, bucket, "warehouse/tbl")
+	require.Error(t, err)
+
+	// The version hint takes precedence over listing
+	require.NoError(t, bucket.WriteAll(ctx, "warehouse/tbl/metadata/version-hint.text", []byte("1\n"), nil))
+	objs, err = (&TableFormat{Name: TableFormatIceberg}).ListObjects(ctx, bucket, "warehouse/tbl")
+	require.NoError(t, err)
+	require.Equal(t, []string{"warehouse/tbl/data/a.parquet", "warehouse/tbl/data/b.parquet"}, objectKeys(objs))
+}
+
+func TestIteratorTableFormat(t *testing.T) {
+	bucket := prepareDeltaBucket(t)
+	it, err := NewIterator(context.Background(), bucket, Options{GlobPattern: "tbl", TableFormat: &TableFormat{Name: TableFormatDelta}, KeepFilesUntilClose: true}, zap.NewNop())
+	require.NoError(t, err)
+	defer it.Close()
+
+	var paths []string
+	for {
+		next, err := it.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		paths = append(paths, next...)
+	}
+	require.Len(t, paths, 2)
+	for _, path := range paths {
+		// Partition directories are retained for hive partitioning
+		require.Contains(t, filepath.ToSlash(path), "tbl/dt=2023-10-0")
+		_, err := os.Stat(path)
+		require.NoError(t, err)
+	}
+}
+
+func prepareDeltaBucket(t *testing.T) *blob.Bucket {
+	ctx := context.Background()
+	bucket, err := blob.OpenBucket(ctx, "mem://")
+	require.NoError(t, err)
+
+	for key, data := range map[string]string{
+		"tbl/dt=2023-10-01/a.parquet":   "a",
+		"tbl/dt=2023-10-01/b.parquet":   "bb",
+		"tbl/dt=2023-10-02/c d.parquet": "ccc",
+		"tbl/orphan.parquet":            "orphan",
+	} {
+		require.NoError(t, bucket.WriteAll(ctx, key, []byte(data), nil))
+	}
+
+	writeDeltaCommit(t, bucket, 0, `{"protocol": {"minReaderVersion": 1, "minWriterVersion": 2}}
+{"metaData": {"id": "1", "format": {"provider": "parquet"}, "partitionColumns": ["dt"], "configuration": {}}}
+{"add": {"path": "dt=2023-10-01/a.parquet", "size": 1, "partitionValues": {"dt": "2023-10-01"}, "dataChange": true}}
+{"add": {"path": "dt=2023-10-01/b.parquet", "size": 2, "partitionValues": {"dt": "2023-10-01"}, "dataChange": true}}`)
+	writeDeltaCommit(t, bucket, 1, `{"commitInfo": {"operation": "DELETE"}}
+{"remove": {"path": "dt=2023-10-01/a.parquet", "dataChange": true}}
+{"add": {"path": "dt=2023-10-02/c%20d.parquet", "size": 3, "partitionValues": {"dt": "2023-10-02"}, "dataChange": true}}`)
+
+	return bucket
+}
+
+func writeDeltaCommit(t *testing.T, bucket *blob.Bucket, version int, actions string) {
+	key := fmt.Sprintf("tbl/_delta_log/%020d.json", version)
+	require.NoError(t, bucket.WriteAll(context.Background(), key, []byte(actions), nil))
+}
+
+func writeDeltaCheckpoint(t *testing.T, bucket *blob.Bucket, key, rows string) {
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "add", Type: arrow.StructOf(
+			arrow.Field{Name: "path", Type: arrow.BinaryTypes.String},
+			arrow.Field{Name: "size", Type: arrow.PrimitiveTypes.Int64},
+		), Nullable: true},
+		{Name: "remove", Type: arrow.StructOf(
+			arrow.Field{Name: "path", Type: arrow.BinaryTypes.String},
+		), Nullable: true},
+	}, nil)
+
+	rec, _, err := array.RecordFromJSON(memory.DefaultAllocator, schema, strings.NewReader(rows))
+	require.NoError(t, err)
+	defer rec.Release()
+
+	tbl := array.NewTableFromRecords(schema, []arrow.Record{rec})
+	defer tbl.Release()
+
+	var buf bytes.Buffer
+	require.NoError(t, pqarrow.WriteTable(tbl, &buf, 1024, nil, pqarrow.DefaultWriterProps()))
+	require.NoError(t, bucket.WriteAll(context.Background(), key, buf.Bytes(), nil))
+}
+
+const _testManifestListSchema = `{
+	"type": "record",
+	"name": "manifest_file",
+	"fields": [
+		{"name": "manifest_path", "type": "string"},
+		{"name": "manifest_length", "type": "long"},
+		{"name": "content", "type": "int"}
+	]
+}`
+
+const _testManifestSchema = `{
+	"type": "record",
+	"name": "manifest_entry",
+	"fields": [
+		{"name": "status", "type": "int"},
+		{"name": "snapshot_id", "type": ["null", "long"]},
+		{"name": "data_file", "type": {
+			"type": "record",
+			"name": "r2",
+			"fields": [
+				{"name": "content", "type": "int"},
+				{"name": "file_path", "type": "string"},
+				{"name": "file_format", "type": "string"},
+				{"name": "record_count", "type": "long"},
+				{"name": "file_size_in_bytes", "type": "long"}
+			]
+		}}
+	]
+}`
+
+func prepareIcebergBucket(t *testing.T) *blob.Bucket {
+	ctx := context.Background()
+	bucket, err := blob.OpenBucket(ctx, "mem://")
+	require.NoError(t, err)
+
+	location := "s3://bucket/warehouse/tbl"
+	dataFile := func(status int32, name string, size int64) map[string]any {
+		return map[string]any{
+			"status":      status,
+			"snapshot_id": goavro.Union("long", int64(1)),
+			"data_file": map[string]any{
+				"content":            int32(0),
+				"file_path":          location + "/data/" + name,
+				"file_format":        "PARQUET",
+				"record_count":       int64(1),
+				"file_size_in_bytes": size,
+			},
+		}
+	}
+	manifest := func(name string) map[string]any {
+		return map[string]any{"manifest_path": location + "/metadata/" + name, "manifest_length": int64(1), "content": int32(0)}
+	}
+
+	writeAvro(t, bucket, "warehouse/tbl/metadata/m1.avro", _testManifestSchema, dataFile(1, "a.parquet", 1), dataFile(1, "b.parquet", 2))
+	writeAvro(t, bucket, "warehouse/tbl/metadata/m2.avro", _testManifestSchema, dataFile(0, "a.parquet", 1), dataFile(2, "b.parquet", 2))
+	writeAvro(t, bucket, "warehouse/tbl/metadata/m3.avro", _testManifestSchema, dataFile(1, "c.parquet", 3))
+	writeAvro(t, bucket, "warehouse/tbl/metadata/snap-1.avro", _testManifestListSchema, manifest("m1.avro"))
+	writeAvro(t, bucket, "warehouse/tbl/metadata/snap-2.avro", _testManifestListSchema, manifest("m2.avro"), manifest("m3.avro"))
+
+	require.NoError(t, bucket.WriteAll(ctx, "warehouse/tbl/metadata/v1.metadata.json", []byte(`{
+		"format-version": 2,
+		"location": "s3://bucket/warehouse/tbl",
+		"current-snapshot-id": 1,
+		"snapshots": [{"snapshot-id": 1, "manifest-list": "s3://bucket/warehouse/tbl/metadata/snap-1.avro"}]
+	}`), nil))
+	require.NoError(t, bucket.WriteAll(ctx, "warehouse/tbl/metadata/v2.metadata.json", []byte(`{
+		"format-version": 2,
+		"location": "s3://bucket/warehouse/tbl",
+		"current-snapshot-id": 2,
+		"snapshots": [
+			{"snapshot-id": 1, "manifest-list": "s3://bucket/warehouse/tbl/metadata/snap-1.avro"},
+			{"snapshot-id": 2, "manifest-list": "s3://bucket/warehouse/tbl/metadata/snap-2.avro"}
+		]
+	}`), nil))
+
+	return bucket
+}
+
+func writeAvro(t *testing.T, bucket *blob.Bucket, key, schema string, records ...any) {
+	var buf bytes.Buffer
+	w, err := goavro.NewOCFWriter(goavro.OCFConfig{W: &buf, Schema: schema, CompressionName: goavro.CompressionDeflateLabel})
+	require.NoError(t, err)
+	require.NoError(t, w.Append(records))
+	require.NoError(t, bucket.WriteAll(context.Background(), key, buf.Bytes(), nil))
+}
+
+func objectKeys(objs []*blob.ListObject) []string {
+	keys := make([]string, len(objs))
+	for i, obj := range objs {
+		keys[i] = obj.Key
+	}
+	return keys
+}
+
+func ptr(v int64) *int64 {
+	return &v
+}
@@ -0,0 +1,210 @@
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os/exec"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// TestClickHouse starts a local ClickHouse server using the clickhouse binary, then runs all other tests
+// in this file as sub-tests (to prevent spawning many servers).
+// It is skipped if the clickhouse binary is not on the PATH.
+func TestClickHouse(t *testing.T) {
+	if testing.Short() {
+		t.Skip("clickhouse: skipping test in short mode")
+	}
+
+	bin, err := exec.LookPath("clickhouse")
+	if err != nil {
+		t.Skip("clickhouse: skipping test because the clickhouse binary was not found")
+	}
+
+	tcpPort := freePort(t)
+	httpPort := freePort(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, bin, "server", "--",
+		fmt.Sprintf("--path=%s/", t.TempDir()),
+		fmt.Sprintf("--tcp_port=%d", tcpPort),
+		fmt.Sprintf("--http_port=%d", httpPort),
+		"--listen_host=127.0.0.1",
+	)
+	cmd.Dir = t.TempDir()
+	require.NoError(t, cmd.Start())
+	defer func() {
+		cancel()
+		_ = cmd.Wait()
+	}()
+
+	dsn := fmt.Sprintf("clickhouse://default@127.0.0.1:%d/default", tcpPort)
+	conn, err := driver{}.Open(map[string]any{"dsn": dsn}, false, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return conn.(*connection).db.Ping() == nil
+	}, time.Minute, 100*time.Millisecond)
+
+	olap, ok := conn.AsOLAP("")
+	require.True(t, ok)
+
+	t.Run("create table", func(t *testing.T) { testCreateTableAsSelect(t, olap) })
+	t.Run("execute", func(t *testing.T) { testExecute(t, olap) })
+	t.Run("nullable", func(t *testing.T) { testNullable(t, olap) })
+	t.Run("schema all", func(t *testing.T) { testSchemaAll(t, olap) })
+	t.Run("schema lookup", func(t *testing.T) { testSchemaLookup(t, olap) })
+	t.Run("rename", func(t *testing.T) { testRenameTable(t, olap) })
+	// Add new tests here
+
+	require.NoError(t, conn.Close())
+	require.Error(t, conn.(*connection).db.Ping())
+}
+
+func testCreateTableAsSelect(t *testing.T, olap drivers.OLAPStore) {
+	ctx := context.Background()
+	err := olap.CreateTableAsSelect(ctx, "test_data", false, `
+		SELECT
+			toInt64(number) AS id,
+			toDateTime64('2023-01-01 00:00:00', 3, 'UTC') + toIntervalHour(number) AS timestamp,
+			if(number % 3 = 0, NULL, concat('publisher_', toString(number % 3))) AS publisher,
+			number / 10 AS bid_price
+		FROM numbers(10)
+	`)
+	require.NoError(t, err)
+
+	err = olap.CreateTableAsSelect(ctx, "test_view", true, "SELECT * FROM test_data WHERE id > 4")
+	require.NoError(t, err)
+}
+
+func testExecute(t *testing.T, olap drivers.OLAPStore) {
+	rows, err := olap.Execute(context.Background(), &drivers.Statement{
+		Query: "SELECT count(*), max(id) FROM test_view WHERE bid_price > ?",
+		Args:  []any{0.5},
+	})
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var count, max int
+	require.True(t, rows.Next())
+	require.NoError(t, rows.Scan(&count, &max))
+	require.Equal(t, 4, count)
+	require.Equal(t, 9, max)
+	require.NoError(t, rows.Err())
+
+	err = olap.Exec(context.Background(), &drivers.Statement{Query: "SELECT * FROM test_data", DryRun: true})
+	require.NoError(t, err)
+
+	err = olap.Exec(context.Background(), &drivers.Statement{Query: "SELECT * FROM missing_table", DryRun: true})
+	require.Error(t, err)
+}
+
+func testNullable(t *testing.T, olap drivers.OLAPStore) {
+	rows, err := olap.Execute(context.Background(), &drivers.Statement{
+		Query: "SELECT publisher FROM test_data ORDER BY id LIMIT 2",
+	})
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var publishers []any
+	for rows.Next() {
+		var publisher any
+		require.NoError(t, rows.Scan(&publisher))
+		publishers = append(publishers, publisher)
+	}
+	require.NoError(t, rows.Err())
+	require.Equal(t, []any{nil, "publisher_1"}, publishers)
+}
+
+func testSchemaAll(t *testing.T, olap drivers.OLAPStore) {
+	tables, err := olap.InformationSchema().All(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, 2, len(tables))
+	require.Equal(t, "test_data", tables[0].Name)
+	require.False(t, tables[0].View)
+	require.Equal(t, "test_view", tables[1].Name)
+	require.True(t, tables[1].View)
+
+	fields := tables[0].Schema.Fields
+	require.Equal(t, 4, len(fields))
+	require.Equal(t, "id", fields[0].Name)
+	require.Equal(t, runtimev1.Type_CODE_INT64, fields[0].Type.Code)
+	require.Equal(t, "timestamp", fields[1].Name)
+	require.Equal(t, runtimev1.Type_CODE_TIMESTAMP, fields[1].Type.Code)
+	require.Equal(t, "publisher", fields[2].Name)
+	require.Equal(t, runtimev1.Type_CODE_STRING, fields[2].Type.Code)
+	require.True(t, fields[2].Type.Nullable)
+	require.Equal(t, "bid_price", fields[3].Name)
+	require.Equal(t, runtimev1.Type_CODE_FLOAT64, fields[3].Type.Code)
+}
+
+func testSchemaLookup(t *testing.T, olap drivers.OLAPStore) {
+	ctx := context.Background()
+	table, err := olap.InformationSchema().Lookup(ctx, "test_data")
+	require.NoError(t, err)
+	require.Equal(t, "test_data", table.Name)
+
+	_, err = olap.InformationSchema().Lookup(ctx, "foo")
+	require.Equal(t, drivers.ErrNotFound, err)
+}
+
+func testRenameTable(t *testing.T, olap drivers.OLAPStore) {
+	ctx := context.Background()
+	err := olap.CreateTableAsSelect(ctx, "test_rename", false, "SELECT 1 AS id")
+	require.NoError(t, err)
+
+	err = olap.RenameTable(ctx, "test_rename", "test_renamed", false)
+	require.NoError(t, err)
+
+	_, err = olap.InformationSchema().Lookup(ctx, "test_rename")
+	require.Equal(t, drivers.ErrNotFound, err)
+
+	_, err = olap.InformationSchema().Lookup(ctx, "test_renamed")
+	require.NoError(t, err)
+
+	require.NoError(t, olap.DropTable(ctx, "test_renamed", false))
+}
+
+func TestDatabaseTypeToPB(t *testing.T) {
+	tests := []struct {
+		dbt      string
+		code     runtimev1.Type_Code
+		nullable bool
+	}{
+		{"Int64", runtimev1.Type_CODE_INT64, false},
+		{"Nullable(String)", runtimev1.Type_CODE_STRING, true},
+		{"LowCardinality(Nullable(String))", runtimev1.Type_CODE_STRING, true},
+		{"DateTime64(3, 'UTC')", runtimev1.Type_CODE_TIMESTAMP, false},
+		{"Decimal(18, 2)", runtimev1.Type_CODE_DECIMAL, false},
+		{"Map(String, UInt64)", runtimev1.Type_CODE_MAP, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dbt, func(t *testing.T) {
+			typ := databaseTypeToPB(tt.dbt)
+			require.Equal(t, tt.code, typ.Code)
+			require.Equal(t, tt.nullable, typ.Nullable)
+		})
+	}
+
+	typ := databaseTypeToPB("Array(Nullable(Float64))")
+	require.Equal(t, runtimev1.Type_CODE_ARRAY, typ.Code)
+	require.Equal(t, runtimev1.Type_CODE_FLOAT64, typ.ArrayElementType.Code)
+	require.True(t, typ.ArrayElementType.Nullable)
+}
+
+func freePort(t *testing.T) int {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port
+}
@@ -0,0 +1,208 @@
+package clickhouse
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+type informationSchema struct {
+	c *connection
+}
+
+func (c *connection) InformationSchema() drivers.InformationSchema {
+	return informationSchema{c: c}
+}
+
+func (i informationSchema) All(ctx context.Context) ([]*drivers.Table, error) {
+	q := `
+		SELECT
+			T.database AS DATABASE,
+			T.name AS NAME,
+			T.engine = 'View' AS VIEW,
+			C.name AS COLUMN_NAME,
+			C.type AS COLUMN_TYPE
+		FROM system.tables T
+		JOIN system.columns C ON T.database = C.database AND T.name = C.table
+		WHERE T.database = currentDatabase() AND NOT T.is_temporary
+		ORDER BY DATABASE, NAME, C.position
+	`
+
+	rows, err := i.c.db.QueryxContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tables, err := i.scanTables(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return tables, nil
+}
+
+func (i informationSchema) Lookup(ctx context.Context, name string) (*drivers.Table, error) {
+	q := `
+		SELECT
+			T.database AS DATABASE,
+			T.name AS NAME,
+			T.engine = 'View' AS VIEW,
+			C.name AS COLUMN_NAME,
+			C.type AS COLUMN_TYPE
+		FROM system.tables T
+		JOIN system.columns C ON T.database = C.database AND T.name = C.table
+		WHERE T.database = currentDatabase() AND T.name = ?
+		ORDER BY DATABASE, NAME, C.position
+	`
+
+	rows, err := i.c.db.QueryxContext(ctx, q, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tables, err := i.scanTables(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tables) == 0 {
+		return nil, drivers.ErrNotFound
+	}
+
+	return tables[0], nil
+}
+
+func (i informationSchema) scanTables(rows *sqlx.Rows) ([]*drivers.Table, error) {
+	var res []*drivers.Table
+
+	for rows.Next() {
+		var database string
+		var name string
+		var view bool
+		var columnName string
+		var columnType string
+
+		err := rows.Scan(&database, &name, &view, &columnName, &columnType)
+		if err != nil {
+			return nil, err
+		}
+
+		// set t to res[len(res)-1] if it's the same table, else set t to a new table and append it
+		var t *drivers.Table
+		if len(res) > 0 {
+			t = res[len(res)-1]
+			if !(t.Database == database && t.Name == name) {
+				t = nil
+			}
+		}
+		if t == nil {
+			t = &drivers.Table{
+				Database: database,
+				Name:     name,
+				View:     view,
+				Schema:   &runtimev1.StructType{},
+			}
+			res = append(res, t)
+		}
+
+		// append column
+		t.Schema.Fields = append(t.Schema.Fields, &runtimev1.StructType_Field{
+			Name: columnName,
+			Type: databaseTypeToPB(columnType),
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// databaseTypeToPB converts a ClickHouse type name, such as "Nullable(DateTime64(3, 'UTC'))", to a runtimev1.Type.
+func databaseTypeToPB(dbt string) *runtimev1.Type {
+	t := &runtimev1.Type{}
+
+	// Unwrap modifiers that don't change the type of the values
+	for {
+		if inner, ok := unwrapType(dbt, "Nullable"); ok {
+			t.Nullable = true
+			dbt = inner
+		} else if inner, ok := unwrapType(dbt, "LowCardinality"); ok {
+			dbt = inner
+		} else {
+			break
+		}
+	}
+
+	// Strip type parameters, such as the precision of "Decimal(18, 2)"
+	base := dbt
+	if idx := strings.Index(base, "("); idx >= 0 {
+		base = base[:idx]
+	}
+
+	switch base {
+	case "Bool":
+		t.Code = runtimev1.Type_CODE_BOOL
+	case "Int8":
+		t.Code = runtimev1.Type_CODE_INT8
+	case "Int16":
+		t.Code = runtimev1.Type_CODE_INT16
+	case "Int32":
+		t.Code = runtimev1.Type_CODE_INT32
+	case "Int64":
+		t.Code = runtimev1.Type_CODE_INT64
+	case "Int128", "Int256":
+		t.Code = runtimev1.Type_CODE_INT128
+	case "UInt8":
+		t.Code = runtimev1.Type_CODE_UINT8
+	case "UInt16":
+		t.Code = runtimev1.Type_CODE_UINT16
+	case "UInt32":
+		t.Code = runtimev1.Type_CODE_UINT32
+	case "UInt64":
+		t.Code = runtimev1.Type_CODE_UINT64
+	case "UInt128", "UInt256":
+		t.Code = runtimev1.Type_CODE_UINT128
+	case "Float32":
+		t.Code = runtimev1.Type_CODE_FLOAT32
+	case "Float64":
+		t.Code = runtimev1.Type_CODE_FLOAT64
+	case "Decimal", "Decimal32", "Decimal64", "Decimal128", "Decimal256":
+		t.Code = runtimev1.Type_CODE_DECIMAL
+	case "String", "FixedString", "Enum8", "Enum16", "IPv4", "IPv6":
+		t.Code = runtimev1.Type_CODE_STRING
+	case "UUID":
+		t.Code = runtimev1.Type_CODE_UUID
+	case "Date", "Date32":
+		t.Code = runtimev1.Type_CODE_DATE
+	case "DateTime", "DateTime64":
+		t.Code = runtimev1.Type_CODE_TIMESTAMP
+	case "Array":
+		t.Code = runtimev1.Type_CODE_ARRAY
+		if inner, ok := unwrapType(dbt, "Array"); ok {
+			t.ArrayElementType = databaseTypeToPB(inner)
+		}
+	case "Tuple":
+		t.Code = runtimev1.Type_CODE_STRUCT
+	case "Map":
+		t.Code = runtimev1.Type_CODE_MAP
+	case "JSON", "Object":
+		t.Code = runtimev1.Type_CODE_JSON
+	}
+
+	return t
+}
+
+// unwrapType returns the argument of a type with the given name and a single argument, such as "Nullable(String)".
+func unwrapType(dbt, name string) (string, bool) {
+	if strings.HasPrefix(dbt, name+"(") && strings.HasSuffix(dbt, ")") {
+		return dbt[len(name)+1 : len(dbt)-1], true
+	}
+	return "", false
+}
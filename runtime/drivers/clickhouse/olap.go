@@ -0,0 +1,245 @@
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"go.uber.org/zap"
+)
+
+var _ drivers.OLAPStore = &connection{}
+
+// AddTableColumn implements drivers.OLAPStore.
+func (c *connection) AddTableColumn(ctx context.Context, tableName, columnName, typ string) error {
+	panic("not implemented")
+}
+
+// AlterTableColumn implements drivers.OLAPStore.
+func (c *connection) AlterTableColumn(ctx context.Context, tableName, columnName, newType string) error {
+	panic("not implemented")
+}
+
+// CreateTableAsSelect implements drivers.OLAPStore.
+// Tables are created with the MergeTree engine without a sorting key.
+func (c *connection) CreateTableAsSelect(ctx context.Context, name string, view bool, sql string) error {
+	c.logger.Info("create table", zap.String("name", name), zap.Bool("view", view))
+	if view {
+		return c.Exec(ctx, &drivers.Statement{
+			Query:       fmt.Sprintf("CREATE OR REPLACE VIEW %s AS %s", safeSQLName(name), sql),
+			Priority:    1,
+			LongRunning: true,
+		})
+	}
+	return c.Exec(ctx, &drivers.Statement{
+		Query:       fmt.Sprintf("CREATE OR REPLACE TABLE %s ENGINE = MergeTree ORDER BY tuple() AS %s", safeSQLName(name), sql),
+		Priority:    1,
+		LongRunning: true,
+	})
+}
+
+// DropTable implements drivers.OLAPStore.
+func (c *connection) DropTable(ctx context.Context, name string, view bool) error {
+	c.logger.Info("drop table", zap.String("name", name), zap.Bool("view", view))
+	typ := "TABLE"
+	if view {
+		typ = "VIEW"
+	}
+	return c.Exec(ctx, &drivers.Statement{
+		Query:       fmt.Sprintf("DROP %s IF EXISTS %s", typ, safeSQLName(name)),
+		Priority:    100,
+		LongRunning: true,
+	})
+}
+
+// InsertTableAsSelect implements drivers.OLAPStore.
+func (c *connection) InsertTableAsSelect(ctx context.Context, name string, byName bool, sql string) error {
+	c.logger.Info("insert into table", zap.String("name", name), zap.Bool("byName", byName))
+	var columnsClause string
+	if byName {
+		// ClickHouse matches the selected columns by position, so we list them explicitly
+		cols, err := c.selectColumns(ctx, sql)
+		if err != nil {
+			return err
+		}
+		for i, col := range cols {
+			cols[i] = safeSQLName(col)
+		}
+		columnsClause = fmt.Sprintf("(%s)", strings.Join(cols, ", "))
+	}
+
+	return c.Exec(ctx, &drivers.Statement{
+		Query:       fmt.Sprintf("INSERT INTO %s %s %s", safeSQLName(name), columnsClause, sql),
+		Priority:    1,
+		LongRunning: true,
+	})
+}
+
+// selectColumns returns the names of the columns returned by a SELECT query.
+func (c *connection) selectColumns(ctx context.Context, sql string) ([]string, error) {
+	res, err := c.Execute(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("SELECT * FROM (%s) LIMIT 0", sql),
+		Priority: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	cols := make([]string, len(res.Schema.Fields))
+	for i, f := range res.Schema.Fields {
+		cols[i] = f.Name
+	}
+	return cols, nil
+}
+
+// MergeTableAsSelect implements drivers.OLAPStore.
+func (c *connection) MergeTableAsSelect(ctx context.Context, name string, keys []string, sql string) error {
+	return fmt.Errorf("clickhouse: merging into tables is not supported")
+}
+
+// SnapshotTableAsSelect implements drivers.OLAPStore.
+func (c *connection) SnapshotTableAsSelect(ctx context.Context, name string, opts *drivers.SnapshotOptions, sql string) error {
+	return fmt.Errorf("clickhouse: snapshot tables are not supported")
+}
+
+// DeleteFromTable implements drivers.OLAPStore.
+func (c *connection) DeleteFromTable(ctx context.Context, name, where string, args ...any) error {
+	c.logger.Info("delete from table", zap.String("name", name))
+	return c.Exec(ctx, &drivers.Statement{
+		Query:       fmt.Sprintf("DELETE FROM %s WHERE %s", safeSQLName(name), where),
+		Args:        args,
+		Priority:    1,
+		LongRunning: true,
+	})
+}
+
+// RenameTable implements drivers.OLAPStore.
+// If a table or view already exists with newName, it is dropped first.
+func (c *connection) RenameTable(ctx context.Context, oldName, newName string, view bool) error {
+	c.logger.Info("rename table", zap.String("from", oldName), zap.String("to", newName), zap.Bool("view", view))
+	if strings.EqualFold(oldName, newName) {
+		return fmt.Errorf("rename: old and new name are same case insensitive strings")
+	}
+
+	err := c.Exec(ctx, &drivers.Statement{
+		Query:       fmt.Sprintf("DROP TABLE IF EXISTS %s", safeSQLName(newName)),
+		Priority:    100,
+		LongRunning: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.Exec(ctx, &drivers.Statement{
+		Query:       fmt.Sprintf("RENAME TABLE %s TO %s", safeSQLName(oldName), safeSQLName(newName)),
+		Priority:    100,
+		LongRunning: true,
+	})
+}
+
+func (c *connection) Dialect() drivers.Dialect {
+	return drivers.DialectClickHouse
+}
+
+func (c *connection) WithConnection(ctx context.Context, priority int, longRunning, tx bool, fn drivers.WithConnectionFunc) error {
+	panic("not implemented")
+}
+
+func (c *connection) Exec(ctx context.Context, stmt *drivers.Statement) error {
+	if stmt.DryRun {
+		_, err := c.Execute(ctx, stmt)
+		return err
+	}
+
+	if stmt.ExecutionTimeout != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, stmt.ExecutionTimeout)
+		defer cancel()
+	}
+
+	_, err := c.db.ExecContext(ctx, stmt.Query, stmt.Args...)
+	return err
+}
+
+func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (*drivers.Result, error) {
+	if stmt.DryRun {
+		// EXPLAIN parses and analyzes the query without running it
+		rows, err := c.db.QueryxContext(ctx, "EXPLAIN "+stmt.Query, stmt.Args...)
+		if err != nil {
+			return nil, err
+		}
+		return nil, rows.Close()
+	}
+
+	var cancelFunc context.CancelFunc
+	if stmt.ExecutionTimeout != 0 {
+		ctx, cancelFunc = context.WithTimeout(ctx, stmt.ExecutionTimeout)
+	}
+
+	rows, err := c.db.QueryxContext(ctx, stmt.Query, stmt.Args...)
+	if err != nil {
+		if cancelFunc != nil {
+			cancelFunc()
+		}
+
+		return nil, err
+	}
+
+	schema, err := rowsToSchema(rows)
+	if err != nil {
+		_ = rows.Close()
+		if cancelFunc != nil {
+			cancelFunc()
+		}
+
+		return nil, err
+	}
+
+	r := &drivers.Result{Rows: rows, Schema: schema}
+	r.SetCleanupFunc(func() error {
+		if cancelFunc != nil {
+			cancelFunc()
+		}
+
+		return nil
+	})
+
+	return r, nil
+}
+
+func (c *connection) DropDB() error {
+	return fmt.Errorf("dropping database not supported")
+}
+
+func rowsToSchema(r *sqlx.Rows) (*runtimev1.StructType, error) {
+	if r == nil {
+		return nil, nil
+	}
+
+	cts, err := r.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]*runtimev1.StructType_Field, len(cts))
+	for i, ct := range cts {
+		fields[i] = &runtimev1.StructType_Field{
+			Name: ct.Name(),
+			Type: databaseTypeToPB(ct.DatabaseTypeName()),
+		}
+	}
+
+	return &runtimev1.StructType{Fields: fields}, nil
+}
+
+// safeSQLName returns a quoted SQL identifier.
+func safeSQLName(name string) string {
+	if name == "" {
+		return name
+	}
+	return fmt.Sprintf("\"%s\"", strings.ReplaceAll(name, "\"", "\"\""))
+}
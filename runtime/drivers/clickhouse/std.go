@@ -0,0 +1,134 @@
+package clickhouse
+
+import (
+	"context"
+	sqldriver "database/sql/driver"
+	"reflect"
+)
+
+// connector wraps the database/sql connector of clickhouse-go.
+// clickhouse-go returns pointers for values of nullable columns and typed slices and maps for composite columns,
+// which database/sql can't scan into typed destinations and pbutil can't convert to protobuf values.
+// The wrapper normalizes such values to the plain types returned by other drivers.
+type connector struct {
+	sqldriver.Connector
+}
+
+func (c connector) Connect(ctx context.Context) (sqldriver.Conn, error) {
+	conn, err := c.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &stdConn{Conn: conn}, nil
+}
+
+// stdConn forwards the optional database/sql interfaces implemented by the clickhouse-go connection.
+type stdConn struct {
+	sqldriver.Conn
+}
+
+func (c *stdConn) QueryContext(ctx context.Context, query string, args []sqldriver.NamedValue) (sqldriver.Rows, error) {
+	rows, err := c.Conn.(sqldriver.QueryerContext).QueryContext(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+	return &stdRows{Rows: rows}, nil
+}
+
+func (c *stdConn) ExecContext(ctx context.Context, query string, args []sqldriver.NamedValue) (sqldriver.Result, error) {
+	return c.Conn.(sqldriver.ExecerContext).ExecContext(ctx, query, args)
+}
+
+func (c *stdConn) PrepareContext(ctx context.Context, query string) (sqldriver.Stmt, error) {
+	return c.Conn.(sqldriver.ConnPrepareContext).PrepareContext(ctx, query)
+}
+
+func (c *stdConn) Ping(ctx context.Context) error {
+	return c.Conn.(sqldriver.Pinger).Ping(ctx)
+}
+
+func (c *stdConn) ResetSession(ctx context.Context) error {
+	return c.Conn.(sqldriver.SessionResetter).ResetSession(ctx)
+}
+
+func (c *stdConn) CheckNamedValue(nv *sqldriver.NamedValue) error {
+	return c.Conn.(sqldriver.NamedValueChecker).CheckNamedValue(nv)
+}
+
+// stdRows normalizes the values returned by the clickhouse-go rows.
+type stdRows struct {
+	sqldriver.Rows
+}
+
+func (r *stdRows) Next(dest []sqldriver.Value) error {
+	err := r.Rows.Next(dest)
+	if err != nil {
+		return err
+	}
+	for i, v := range dest {
+		dest[i] = normalizeValue(v)
+	}
+	return nil
+}
+
+func (r *stdRows) ColumnTypeDatabaseTypeName(idx int) string {
+	return r.Rows.(sqldriver.RowsColumnTypeDatabaseTypeName).ColumnTypeDatabaseTypeName(idx)
+}
+
+func (r *stdRows) ColumnTypeNullable(idx int) (nullable, ok bool) {
+	return r.Rows.(sqldriver.RowsColumnTypeNullable).ColumnTypeNullable(idx)
+}
+
+func (r *stdRows) ColumnTypePrecisionScale(idx int) (precision, scale int64, ok bool) {
+	return r.Rows.(sqldriver.RowsColumnTypePrecisionScale).ColumnTypePrecisionScale(idx)
+}
+
+// normalizeValue dereferences pointers and converts typed slices and maps to []any and map[string]any (or map[any]any for non-string keys).
+func normalizeValue(v any) any {
+	if v == nil {
+		return nil
+	}
+
+	if vr, ok := v.(sqldriver.Valuer); ok {
+		res, err := vr.Value()
+		if err != nil {
+			return v
+		}
+		return res
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return nil
+		}
+		return normalizeValue(rv.Elem().Interface())
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return v
+		}
+		res := make([]any, rv.Len())
+		for i := range res {
+			res[i] = normalizeValue(rv.Index(i).Interface())
+		}
+		return res
+	case reflect.Map:
+		if rv.Type().Key().Kind() == reflect.String {
+			res := make(map[string]any, rv.Len())
+			iter := rv.MapRange()
+			for iter.Next() {
+				res[iter.Key().String()] = normalizeValue(iter.Value().Interface())
+			}
+			return res
+		}
+		res := make(map[any]any, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			res[normalizeValue(iter.Key().Interface())] = normalizeValue(iter.Value().Interface())
+		}
+		return res
+	default:
+		return v
+	}
+}
@@ -45,6 +45,7 @@ func TestAll(t *testing.T) {
 			// Run applicable sub-tests
 			if registry, ok := conn.AsRegistry(); ok {
 				t.Run("registry_"+driver, func(t *testing.T) { testRegistry(t, registry) })
+				t.Run("registry_leases_"+driver, func(t *testing.T) { testRegistryLeases(t, registry) })
 			}
 			if catalog, ok := conn.AsCatalogStore(""); ok {
 				t.Run("catalog_"+driver, func(t *testing.T) { testCatalog(t, catalog) })
@@ -36,6 +36,21 @@ func (c *connection) InsertTableAsSelect(ctx context.Context, name string, byNam
 	panic("not implemented")
 }
 
+// MergeTableAsSelect implements drivers.OLAPStore.
+func (c *connection) MergeTableAsSelect(ctx context.Context, name string, keys []string, sql string) error {
+	panic("not implemented")
+}
+
+// SnapshotTableAsSelect implements drivers.OLAPStore.
+func (c *connection) SnapshotTableAsSelect(ctx context.Context, name string, opts *drivers.SnapshotOptions, sql string) error {
+	panic("not implemented")
+}
+
+// DeleteFromTable implements drivers.OLAPStore.
+func (c *connection) DeleteFromTable(ctx context.Context, name, where string, args ...any) error {
+	panic("not implemented")
+}
+
 // RenameTable implements drivers.OLAPStore.
 func (c *connection) RenameTable(ctx context.Context, name, newName string, view bool) error {
 	panic("not implemented")
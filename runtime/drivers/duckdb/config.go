@@ -21,6 +21,17 @@ type config struct {
 	ErrorOnIncompatibleVersion bool `mapstructure:"error_on_incompatible_version"`
 	// ExtTableStorage controls if every table is stored in a different db file
 	ExtTableStorage bool `mapstructure:"external_table_storage"`
+	// MemoryLimitGB caps the memory used by DuckDB (sets "memory_limit"). If 0, DuckDB's default of 80% of system memory applies.
+	MemoryLimitGB int `mapstructure:"memory_limit_gb"`
+	// CPU is the number of threads DuckDB uses to run queries (sets "threads"). If 0, DuckDB uses all available cores.
+	CPU int `mapstructure:"cpu"`
+	// TempDirectory is where DuckDB spills data that doesn't fit in memory (sets "temp_directory"). If empty, DuckDB uses "<db file>.tmp".
+	TempDirectory string `mapstructure:"temp_directory"`
+	// MaxTempDirectorySizeBytes is the max size of the temp directory. New long-running queries fail while it's exceeded. If 0, there is no limit.
+	MaxTempDirectorySizeBytes int64 `mapstructure:"max_temp_directory_size_bytes"`
+	// LongRunningPoolSize is the number of long-running queries (like source ingestion and model builds) allowed to run concurrently.
+	// Long-running queries get their own connections, so they don't take connections from the PoolSize queries.
+	LongRunningPoolSize int `mapstructure:"long_running_pool_size"`
 	// DBFilePath is the path where the database is stored. It is inferred from the DSN (can't be provided by user).
 	DBFilePath string `mapstructure:"-"`
 	// ExtStoragePath is the path where the database files are stored in case external_table_storage is true. It is inferred from the DSN (can't be provided by user).
@@ -29,7 +40,8 @@ type config struct {
 
 func newConfig(cfgMap map[string]any) (*config, error) {
 	cfg := &config{
-		PoolSize: 1, // Default value
+		PoolSize:            1, // Default value
+		LongRunningPoolSize: 1, // Default value
 	}
 	err := mapstructure.WeakDecode(cfgMap, cfg)
 	if err != nil {
@@ -72,6 +84,25 @@ func newConfig(cfgMap map[string]any) (*config, error) {
 	if cfg.PoolSize < 1 {
 		return nil, fmt.Errorf("duckdb pool size must be >= 1")
 	}
+	if cfg.LongRunningPoolSize < 1 {
+		return nil, fmt.Errorf("duckdb long-running pool size must be >= 1")
+	}
+
+	// Check resource limits
+	if cfg.MemoryLimitGB < 0 || cfg.CPU < 0 || cfg.MaxTempDirectorySizeBytes < 0 {
+		return nil, fmt.Errorf("duckdb memory_limit_gb, cpu and max_temp_directory_size_bytes must be >= 0")
+	}
 
 	return cfg, nil
 }
+
+// tempDirPath returns the directory DuckDB spills to. It returns an empty string for in-memory databases that don't set a temp directory.
+func (c *config) tempDirPath() string {
+	if c.TempDirectory != "" {
+		return c.TempDirectory
+	}
+	if c.DBFilePath != "" {
+		return c.DBFilePath + ".tmp"
+	}
+	return ""
+}
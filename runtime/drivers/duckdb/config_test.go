@@ -58,3 +58,35 @@ func TestConfig(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "duck.db", cfg.DBFilePath)
 }
+
+func TestConfigResourceLimits(t *testing.T) {
+	cfg, err := newConfig(map[string]any{"dsn": "duck.db"})
+	require.NoError(t, err)
+	require.Equal(t, 1, cfg.LongRunningPoolSize)
+	require.Equal(t, "duck.db.tmp", cfg.tempDirPath())
+
+	cfg, err = newConfig(map[string]any{
+		"dsn":                           "duck.db",
+		"memory_limit_gb":               "4",
+		"cpu":                           2,
+		"temp_directory":                "/scratch/duck",
+		"max_temp_directory_size_bytes": "1073741824",
+		"long_running_pool_size":        3,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 4, cfg.MemoryLimitGB)
+	require.Equal(t, 2, cfg.CPU)
+	require.Equal(t, "/scratch/duck", cfg.tempDirPath())
+	require.Equal(t, int64(1073741824), cfg.MaxTempDirectorySizeBytes)
+	require.Equal(t, 3, cfg.LongRunningPoolSize)
+
+	cfg, err = newConfig(map[string]any{})
+	require.NoError(t, err)
+	require.Equal(t, "", cfg.tempDirPath())
+
+	_, err = newConfig(map[string]any{"dsn": "duck.db", "long_running_pool_size": 0})
+	require.Error(t, err)
+
+	_, err = newConfig(map[string]any{"dsn": "duck.db", "memory_limit_gb": -1})
+	require.Error(t, err)
+}
@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/XSAM/otelsql"
@@ -130,7 +131,7 @@ func (d Driver) Open(cfgMap map[string]any, shared bool, ac activity.Client, log
 		activity:       ac,
 		metaSem:        semaphore.NewWeighted(1),
 		olapSem:        priorityqueue.NewSemaphore(olapSemSize),
-		longRunningSem: semaphore.NewWeighted(1), // Currently hard-coded to 1
+		longRunningSem: semaphore.NewWeighted(int64(cfg.LongRunningPoolSize)),
 		dbCond:         sync.NewCond(&sync.Mutex{}),
 		driverConfig:   cfgMap,
 		driverName:     d.name,
@@ -276,8 +277,11 @@ type connection struct {
 	metaSem *semaphore.Weighted
 	olapSem *priorityqueue.Semaphore
 	// The OLAP interface additionally provides an option to limit the number of long-running queries, as designated by the caller.
-	// longRunningSem enforces this limitation.
-	longRunningSem *semaphore.Weighted
+	// longRunningSem enforces this limitation. Long-running queries (like ingestion) are not gated by olapSem,
+	// so the pool has cfg.LongRunningPoolSize extra connections reserved for them.
+	// longRunningCount tracks the number of long-running queries currently holding a connection.
+	longRunningSem   *semaphore.Weighted
+	longRunningCount atomic.Int64
 	// The OLAP interface also provides an option to acquire a connection "transactionally".
 	// We've run into issues with DuckDB freezing up on transactions, so we just use a lock for now to serialize them (inconsistency in case of crashes is acceptable).
 	txMu sync.RWMutex
@@ -415,6 +419,17 @@ func (c *connection) reopenDB() error {
 		"SET timezone='UTC'",
 	}
 
+	// Apply the instance's resource limits
+	if c.config.MemoryLimitGB > 0 {
+		bootQueries = append(bootQueries, fmt.Sprintf("SET memory_limit='%dGB'", c.config.MemoryLimitGB))
+	}
+	if c.config.CPU > 0 {
+		bootQueries = append(bootQueries, fmt.Sprintf("SET threads=%d", c.config.CPU))
+	}
+	if c.config.TempDirectory != "" {
+		bootQueries = append(bootQueries, fmt.Sprintf("SET temp_directory=%s", safeSQLString(c.config.TempDirectory)))
+	}
+
 	// We want to set preserve_insertion_order=false in hosted environments only (where source data is never viewed directly). Setting it reduces batch data ingestion time by ~40%.
 	// Hack: Using AllowHostAccess as a proxy indicator for a hosted environment.
 	if !c.config.AllowHostAccess {
@@ -449,7 +464,7 @@ func (c *connection) reopenDB() error {
 	// Create new DB
 	sqlDB := otelsql.OpenDB(connector)
 	db := sqlx.NewDb(sqlDB, "duckdb")
-	db.SetMaxOpenConns(c.config.PoolSize)
+	db.SetMaxOpenConns(c.config.PoolSize + c.config.LongRunningPoolSize)
 	c.db = db
 
 	if !c.config.ExtTableStorage {
@@ -539,46 +554,61 @@ func (c *connection) acquireOLAPConn(ctx context.Context, priority int, longRunn
 		return conn, func() error { return nil }, nil
 	}
 
-	// Acquire long-running semaphore if applicable
+	// Long-running queries use the long-running semaphore instead of the OLAP semaphore
+	var releaseSem func()
 	if longRunning {
-		err := c.longRunningSem.Acquire(ctx, 1)
+		err := c.checkTempDirSize()
 		if err != nil {
 			return nil, nil, err
 		}
-	}
 
-	// Acquire semaphore
-	err := c.olapSem.Acquire(ctx, priority)
-	if err != nil {
-		if longRunning {
+		err = c.longRunningSem.Acquire(ctx, 1)
+		if err != nil {
+			return nil, nil, err
+		}
+		c.longRunningCount.Add(1)
+		releaseSem = func() {
+			c.longRunningCount.Add(-1)
 			c.longRunningSem.Release(1)
 		}
-		return nil, nil, err
+	} else {
+		err := c.olapSem.Acquire(ctx, priority)
+		if err != nil {
+			return nil, nil, err
+		}
+		releaseSem = c.olapSem.Release
 	}
 
 	// Get new conn
 	conn, releaseConn, err := c.acquireConn(ctx, tx)
 	if err != nil {
-		c.olapSem.Release()
-		if longRunning {
-			c.longRunningSem.Release(1)
-		}
+		releaseSem()
 		return nil, nil, err
 	}
 
 	// Build release func
 	release := func() error {
 		err := releaseConn()
-		c.olapSem.Release()
-		if longRunning {
-			c.longRunningSem.Release(1)
-		}
+		releaseSem()
 		return err
 	}
 
 	return conn, release, nil
 }
 
+// checkTempDirSize returns an error if the temp directory has grown beyond cfg.MaxTempDirectorySizeBytes.
+// The DuckDB version we use doesn't support capping the temp directory size, so we enforce it before starting long-running queries.
+func (c *connection) checkTempDirSize() error {
+	if c.config.MaxTempDirectorySizeBytes <= 0 {
+		return nil
+	}
+	size := dirSize(c.config.tempDirPath())
+	if size > c.config.MaxTempDirectorySizeBytes {
+		return fmt.Errorf("duckdb temp directory size (%s) exceeds the limit of %s", datasize.ByteSize(size).HR(), datasize.ByteSize(c.config.MaxTempDirectorySizeBytes).HR())
+	}
+	return nil
+}
+
 // acquireConn returns a DuckDB connection. It should only be used internally in acquireMetaConn and acquireOLAPConn.
 // acquireConn implements the connection tracking and DB reopening logic described in the struct definition for connection.
 func (c *connection) acquireConn(ctx context.Context, tx bool) (*sqlx.Conn, func() error, error) {
@@ -680,6 +710,22 @@ func (c *connection) periodicallyEmitStats(d time.Duration) {
 			estimatedDBSize, _ := c.EstimateSize()
 			c.activity.Emit(c.ctx, "duckdb_estimated_size_bytes", float64(estimatedDBSize))
 
+			// Emit the resource limits along with their usage, so usage can be compared to the instance's allocation
+			if path := c.config.tempDirPath(); path != "" {
+				c.activity.Emit(c.ctx, "duckdb_temp_directory_size_bytes", float64(dirSize(path)))
+			}
+			if c.config.MaxTempDirectorySizeBytes > 0 {
+				c.activity.Emit(c.ctx, "duckdb_max_temp_directory_size_bytes", float64(c.config.MaxTempDirectorySizeBytes))
+			}
+			if c.config.MemoryLimitGB > 0 {
+				c.activity.Emit(c.ctx, "duckdb_memory_limit_bytes", float64(c.config.MemoryLimitGB)*1000*1000*1000) // DuckDB uses decimal units
+			}
+			if c.config.CPU > 0 {
+				c.activity.Emit(c.ctx, "duckdb_threads", float64(c.config.CPU))
+			}
+			c.activity.Emit(c.ctx, "duckdb_long_running_queries", float64(c.longRunningCount.Load()))
+			c.activity.Emit(c.ctx, "duckdb_long_running_pool_size", float64(c.config.LongRunningPoolSize))
+
 			// NOTE :: running CALL pragma_database_size() while duckdb is ingesting data is causing the WAL file to explode.
 			// Commenting the below code for now. Verify with next duckdb release
 
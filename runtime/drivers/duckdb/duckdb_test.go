@@ -3,6 +3,7 @@ package duckdb
 import (
 	"context"
 	"database/sql"
+	"os"
 	"path/filepath"
 	"sync"
 	"testing"
@@ -209,6 +210,65 @@ func TestFatalErrConcurrent(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestResourceLimits(t *testing.T) {
+	dir := t.TempDir()
+	tempDir := filepath.Join(dir, "spill")
+
+	handle, err := Driver{}.Open(map[string]any{
+		"dsn":                           filepath.Join(dir, "tmp.db"),
+		"pool_size":                     2,
+		"memory_limit_gb":               1,
+		"cpu":                           2,
+		"temp_directory":                tempDir,
+		"max_temp_directory_size_bytes": 10,
+		"long_running_pool_size":        2,
+	}, false, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	defer handle.Close()
+
+	olap, ok := handle.AsOLAP("")
+	require.True(t, ok)
+
+	res, err := olap.Execute(context.Background(), &drivers.Statement{Query: "SELECT current_setting('memory_limit'), current_setting('threads'), current_setting('temp_directory')"})
+	require.NoError(t, err)
+	var memoryLimit, threads, tempDirectory string
+	require.True(t, res.Next())
+	require.NoError(t, res.Scan(&memoryLimit, &threads, &tempDirectory))
+	require.NoError(t, res.Close())
+	require.Equal(t, "1.0GB", memoryLimit)
+	require.Equal(t, "2", threads)
+	require.Equal(t, tempDir, tempDirectory)
+
+	// Long-running queries fail while the temp directory is larger than the limit
+	require.NoError(t, os.MkdirAll(tempDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "spill.block"), make([]byte, 100), 0o644))
+	err = olap.Exec(context.Background(), &drivers.Statement{Query: "SELECT 1", LongRunning: true})
+	require.ErrorContains(t, err, "exceeds the limit")
+	err = olap.Exec(context.Background(), &drivers.Statement{Query: "SELECT 1"})
+	require.NoError(t, err)
+
+	// Long-running queries have their own connections, so they don't block regular queries
+	require.NoError(t, os.RemoveAll(tempDir))
+	wg := sync.WaitGroup{}
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := olap.WithConnection(context.Background(), 0, true, false, func(ctx, ensuredCtx context.Context, _ *sql.Conn) error {
+				time.Sleep(500 * time.Millisecond)
+				return nil
+			})
+			require.NoError(t, err)
+		}()
+	}
+	time.Sleep(100 * time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), 250*time.Millisecond)
+	defer cancel()
+	err = olap.Exec(ctx, &drivers.Statement{Query: "SELECT 1"})
+	require.NoError(t, err)
+	wg.Wait()
+}
+
 func TestHumanReadableSizeToBytes(t *testing.T) {
 	tests := []struct {
 		input     string
@@ -394,6 +394,203 @@ func (c *connection) InsertTableAsSelect(ctx context.Context, name string, byNam
 	})
 }
 
+// MergeTableAsSelect implements drivers.OLAPStore.
+// The new rows are first staged in a temporary table, so the query only runs once.
+// Then existing rows with matching keys are deleted and the staged rows inserted in a single transaction.
+func (c *connection) MergeTableAsSelect(ctx context.Context, name string, keys []string, sql string) error {
+	c.logger.Info("merge into table", zap.String("name", name), zap.Strings("keys", keys))
+	if len(keys) == 0 {
+		return fmt.Errorf("merge: no keys provided for table %q", name)
+	}
+
+	// With external table storage, the data lives in an attached DB behind a view.
+	// The staging table is created in the same DB since a transaction can only write to one DB.
+	table := safeSQLName(name)
+	stagingTable := safeSQLName("__rill_tmp_merge_" + name)
+	if c.config.ExtTableStorage {
+		version, exist, err := c.tableVersion(name)
+		if err != nil {
+			return err
+		}
+		if !exist {
+			return fmt.Errorf("merge: table %q does not exist", name)
+		}
+		db := safeSQLName(dbName(name, version))
+		table = fmt.Sprintf("%s.default", db)
+		stagingTable = fmt.Sprintf("%s.__rill_tmp_merge", db)
+	}
+
+	conds := make([]string, len(keys))
+	for i, k := range keys {
+		conds[i] = fmt.Sprintf("t.%s = s.%s", safeSQLName(k), safeSQLName(k))
+	}
+
+	return c.WithConnection(ctx, 1, true, false, func(ctx, ensuredCtx context.Context, _ *dbsql.Conn) error {
+		err := c.Exec(ctx, &drivers.Statement{Query: fmt.Sprintf("CREATE OR REPLACE TABLE %s AS (%s)", stagingTable, sql)})
+		if err != nil {
+			return fmt.Errorf("merge: create staging table failed: %w", err)
+		}
+		defer func() {
+			err := c.Exec(ensuredCtx, &drivers.Statement{Query: fmt.Sprintf("DROP TABLE IF EXISTS %s", stagingTable)})
+			if err != nil {
+				c.logger.Error("merge: drop staging table failed", zap.String("name", name), zap.Error(err))
+			}
+		}()
+
+		err = c.Exec(ctx, &drivers.Statement{Query: "BEGIN TRANSACTION"})
+		if err != nil {
+			return err
+		}
+
+		err = c.Exec(ctx, &drivers.Statement{Query: fmt.Sprintf("DELETE FROM %s AS t USING %s AS s WHERE %s", table, stagingTable, strings.Join(conds, " AND "))})
+		if err == nil {
+			err = c.Exec(ctx, &drivers.Statement{Query: fmt.Sprintf("INSERT INTO %s BY NAME SELECT * FROM %s", table, stagingTable)})
+		}
+		if err != nil {
+			_ = c.Exec(ensuredCtx, &drivers.Statement{Query: "ROLLBACK"})
+			return fmt.Errorf("merge: merge into %q failed: %w", name, err)
+		}
+
+		return c.Exec(ensuredCtx, &drivers.Statement{Query: "COMMIT"})
+	})
+}
+
+// SnapshotTableAsSelect implements drivers.OLAPStore.
+// Like MergeTableAsSelect, the new rows are first staged in a temporary table and then merged in a single transaction.
+func (c *connection) SnapshotTableAsSelect(ctx context.Context, name string, opts *drivers.SnapshotOptions, sql string) error {
+	c.logger.Info("snapshot into table", zap.String("name", name), zap.Strings("keys", opts.UniqueKey))
+	if len(opts.UniqueKey) == 0 {
+		return fmt.Errorf("snapshot: no keys provided for table %q", name)
+	}
+	if opts.UpdatedAt == "" && len(opts.CheckColumns) == 0 {
+		return fmt.Errorf("snapshot: no updated_at or check columns provided for table %q", name)
+	}
+
+	// Rows that are changed without an updated_at column and rows that are deleted are closed at the time of the snapshot
+	now := fmt.Sprintf("TIMESTAMP '%s'", time.Now().UTC().Format("2006-01-02 15:04:05.999999"))
+	validFrom := now
+	if opts.UpdatedAt != "" {
+		validFrom = fmt.Sprintf("CAST(s.%s AS TIMESTAMP)", safeSQLName(opts.UpdatedAt))
+	}
+
+	table := safeSQLName(name)
+	stagingTable := safeSQLName("__rill_tmp_snapshot_" + name)
+	exists := true
+	if c.config.ExtTableStorage {
+		version, exist, err := c.tableVersion(name)
+		if err != nil {
+			return err
+		}
+		if exist {
+			db := safeSQLName(dbName(name, version))
+			table = fmt.Sprintf("%s.default", db)
+			stagingTable = fmt.Sprintf("%s.__rill_tmp_snapshot", db)
+		}
+		exists = exist
+	} else {
+		_, err := c.InformationSchema().Lookup(ctx, name)
+		if err != nil {
+			if !errors.Is(err, drivers.ErrNotFound) {
+				return err
+			}
+			exists = false
+		}
+	}
+
+	// The first snapshot creates the table with all rows valid from now (or their updated_at time)
+	if !exists {
+		return c.CreateTableAsSelect(ctx, name, false, fmt.Sprintf("SELECT s.*, %s AS valid_from, CAST(NULL AS TIMESTAMP) AS valid_to FROM (%s) AS s", validFrom, sql))
+	}
+
+	keyConds := make([]string, len(opts.UniqueKey))
+	for i, k := range opts.UniqueKey {
+		keyConds[i] = fmt.Sprintf("t.%s = s.%s", safeSQLName(k), safeSQLName(k))
+	}
+	keyCond := strings.Join(keyConds, " AND ")
+
+	var changedCond string
+	if opts.UpdatedAt != "" {
+		changedCond = fmt.Sprintf("s.%s > t.%s", safeSQLName(opts.UpdatedAt), safeSQLName(opts.UpdatedAt))
+	} else {
+		// Using NOT (... IS NOT DISTINCT FROM ...) since DuckDB evaluates IS DISTINCT FROM incorrectly when it's pushed into a join
+		changedConds := make([]string, len(opts.CheckColumns))
+		for i, col := range opts.CheckColumns {
+			changedConds[i] = fmt.Sprintf("NOT (t.%s IS NOT DISTINCT FROM s.%s)", safeSQLName(col), safeSQLName(col))
+		}
+		changedCond = strings.Join(changedConds, " OR ")
+	}
+
+	return c.WithConnection(ctx, 1, true, false, func(ctx, ensuredCtx context.Context, _ *dbsql.Conn) error {
+		err := c.Exec(ctx, &drivers.Statement{Query: fmt.Sprintf("CREATE OR REPLACE TABLE %s AS (%s)", stagingTable, sql)})
+		if err != nil {
+			return fmt.Errorf("snapshot: create staging table failed: %w", err)
+		}
+		defer func() {
+			err := c.Exec(ensuredCtx, &drivers.Statement{Query: fmt.Sprintf("DROP TABLE IF EXISTS %s", stagingTable)})
+			if err != nil {
+				c.logger.Error("snapshot: drop staging table failed", zap.String("name", name), zap.Error(err))
+			}
+		}()
+
+		err = c.Exec(ctx, &drivers.Statement{Query: "BEGIN TRANSACTION"})
+		if err != nil {
+			return err
+		}
+
+		// Close the current versions of changed rows
+		err = c.Exec(ctx, &drivers.Statement{Query: fmt.Sprintf(
+			"UPDATE %s AS t SET valid_to = (SELECT %s FROM %s AS s WHERE %s) WHERE t.valid_to IS NULL AND EXISTS (SELECT 1 FROM %s AS s WHERE %s AND (%s))",
+			table, validFrom, stagingTable, keyCond, stagingTable, keyCond, changedCond,
+		)})
+		// Close the current versions of deleted rows
+		if err == nil {
+			err = c.Exec(ctx, &drivers.Statement{Query: fmt.Sprintf(
+				"UPDATE %s AS t SET valid_to = %s WHERE t.valid_to IS NULL AND NOT EXISTS (SELECT 1 FROM %s AS s WHERE %s)",
+				table, now, stagingTable, keyCond,
+			)})
+		}
+		// Insert new versions of rows that don't have a current version (new or changed rows)
+		if err == nil {
+			err = c.Exec(ctx, &drivers.Statement{Query: fmt.Sprintf(
+				"INSERT INTO %s BY NAME SELECT s.*, %s AS valid_from, CAST(NULL AS TIMESTAMP) AS valid_to FROM %s AS s WHERE NOT EXISTS (SELECT 1 FROM %s AS t WHERE %s AND t.valid_to IS NULL)",
+				table, validFrom, stagingTable, table, keyCond,
+			)})
+		}
+		if err != nil {
+			_ = c.Exec(ensuredCtx, &drivers.Statement{Query: "ROLLBACK"})
+			return fmt.Errorf("snapshot: snapshot into %q failed: %w", name, err)
+		}
+
+		return c.Exec(ensuredCtx, &drivers.Statement{Query: "COMMIT"})
+	})
+}
+
+// DeleteFromTable implements drivers.OLAPStore.
+func (c *connection) DeleteFromTable(ctx context.Context, name, where string, args ...any) error {
+	c.logger.Info("delete from table", zap.String("name", name))
+	if !c.config.ExtTableStorage {
+		return c.Exec(ctx, &drivers.Statement{
+			Query:       fmt.Sprintf("DELETE FROM %s WHERE %s", safeSQLName(name), where),
+			Args:        args,
+			Priority:    1,
+			LongRunning: true,
+		})
+	}
+	version, exist, err := c.tableVersion(name)
+	if err != nil {
+		return err
+	}
+	if !exist {
+		return fmt.Errorf("DeleteFromTable: table %q does not exist", name)
+	}
+	return c.Exec(ctx, &drivers.Statement{
+		Query:       fmt.Sprintf("DELETE FROM %s.default WHERE %s", safeSQLName(dbName(name, version)), where),
+		Args:        args,
+		Priority:    1,
+		LongRunning: true,
+	})
+}
+
 // RenameTable implements drivers.OLAPStore.
 // For drop and replace (when running `RenameTable("__tmp_foo", "foo")`):
 // `DROP VIEW __tmp_foo`
@@ -585,6 +782,21 @@ func fileSize(paths []string) int64 {
 	return size
 }
 
+// dirSize returns the total size of the files in a directory and its sub-directories.
+func dirSize(path string) int64 {
+	var size int64
+	_ = filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil // ignoring errors since files in the directory may be removed while walking it
+		}
+		if info, err := d.Info(); err == nil && !d.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size
+}
+
 func dbName(name, version string) string {
 	return fmt.Sprintf("%s_%s", name, version)
 }
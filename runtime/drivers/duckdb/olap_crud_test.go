@@ -197,6 +197,69 @@ func Test_connection_InsertTableAsSelect(t *testing.T) {
 	require.NoError(t, res.Close())
 }
 
+func Test_connection_MergeTableAsSelect(t *testing.T) {
+	temp := t.TempDir()
+
+	dbPath := filepath.Join(temp, "view.db")
+	handle, err := Driver{}.Open(map[string]any{"dsn": dbPath, "external_table_storage": true}, false, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	c := handle.(*connection)
+	require.NoError(t, c.Migrate(context.Background()))
+	c.AsOLAP("default")
+
+	err = c.CreateTableAsSelect(context.Background(), "test-merge", false, "select 1 as id, 'a' as val union all select 2, 'b'")
+	require.NoError(t, err)
+
+	err = c.MergeTableAsSelect(context.Background(), "test-merge", []string{"id"}, "select 'c' as val, 2 as id union all select 'd', 3")
+	require.NoError(t, err)
+
+	res, err := c.Execute(context.Background(), &drivers.Statement{Query: "SELECT id, val FROM 'test-merge' ORDER BY id"})
+	require.NoError(t, err)
+	var rows []string
+	for res.Next() {
+		var id int
+		var val string
+		require.NoError(t, res.Scan(&id, &val))
+		rows = append(rows, fmt.Sprintf("%d:%s", id, val))
+	}
+	require.NoError(t, res.Close())
+	require.Equal(t, []string{"1:a", "2:c", "3:d"}, rows)
+}
+
+func Test_connection_SnapshotTableAsSelect(t *testing.T) {
+	temp := t.TempDir()
+
+	dbPath := filepath.Join(temp, "view.db")
+	handle, err := Driver{}.Open(map[string]any{"dsn": dbPath, "external_table_storage": true}, false, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	c := handle.(*connection)
+	require.NoError(t, c.Migrate(context.Background()))
+	c.AsOLAP("default")
+
+	opts := &drivers.SnapshotOptions{UniqueKey: []string{"id"}, CheckColumns: []string{"val"}}
+
+	// The first snapshot creates the table
+	err = c.SnapshotTableAsSelect(context.Background(), "test-snapshot", opts, "select 1 as id, 'a' as val union all select 2, 'b' union all select 3, 'c'")
+	require.NoError(t, err)
+
+	// 1 is unchanged, 2 is changed, 3 is deleted and 4 is new
+	err = c.SnapshotTableAsSelect(context.Background(), "test-snapshot", opts, "select 1 as id, 'a' as val union all select 2, 'x' union all select 4, 'd'")
+	require.NoError(t, err)
+
+	res, err := c.Execute(context.Background(), &drivers.Statement{Query: "SELECT id, val, valid_to IS NULL FROM 'test-snapshot' ORDER BY id, valid_from"})
+	require.NoError(t, err)
+	var rows []string
+	for res.Next() {
+		var id int
+		var val string
+		var current bool
+		require.NoError(t, res.Scan(&id, &val, &current))
+		rows = append(rows, fmt.Sprintf("%d:%s:%v", id, val, current))
+	}
+	require.NoError(t, res.Close())
+	require.Equal(t, []string{"1:a:true", "2:b:false", "2:x:true", "3:c:false", "4:d:true"}, rows)
+}
+
 func Test_connection_RenameTable(t *testing.T) {
 	temp := t.TempDir()
 	os.Mkdir(temp, fs.ModePerm)
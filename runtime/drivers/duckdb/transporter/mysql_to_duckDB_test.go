@@ -0,0 +1,135 @@
+package transporter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"go.uber.org/zap"
+
+	// Load mysql driver
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/rilldata/rill/runtime/drivers/mysql"
+)
+
+var mysqlInitStmt = `
+CREATE TABLE all_datatypes (
+	id INT AUTO_INCREMENT PRIMARY KEY,
+	tinyint_col TINYINT,
+	smallint_col SMALLINT,
+	mediumint_col MEDIUMINT,
+	bigint_col BIGINT,
+	unsigned_bigint_col BIGINT UNSIGNED,
+	float_col FLOAT,
+	double_col DOUBLE,
+	decimal_col DECIMAL(20, 5),
+	bit_col BIT(10),
+	char_col CHAR(10),
+	varchar_col VARCHAR(255),
+	text_col TEXT,
+	enum_col ENUM('a', 'b'),
+	blob_col BLOB,
+	date_col DATE,
+	datetime_col DATETIME,
+	timestamp_col TIMESTAMP NULL,
+	time_col TIME,
+	year_col YEAR,
+	json_col JSON
+);
+`
+
+var mysqlInsertStmt = `
+INSERT INTO all_datatypes (tinyint_col, smallint_col, mediumint_col, bigint_col, unsigned_bigint_col, float_col, double_col, decimal_col, bit_col, char_col, varchar_col, text_col, enum_col, blob_col, date_col, datetime_col, timestamp_col, time_col, year_col, json_col)
+VALUES
+	(-1, 256, 70000, 1234567890123, 18446744073709551615, 1.5, 123.45, 12345.6789, b'1010101010', 'char', 'varchar', 'text', 'b', x'DEADBEEF', '2023-09-12', '2023-09-12 12:46:55', '2023-09-12 12:46:55', '12:35:00', 2023, '{"name": "John Doe", "age": 30}'),
+	(NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL);
+`
+
+func TestMySQLTransfer(t *testing.T) {
+	testcontainers.SkipIfProviderIsNotHealthy(t)
+
+	ctx := context.Background()
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		Started: true,
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "mysql:8.0",
+			ExposedPorts: []string{"3306/tcp"},
+			Env: map[string]string{
+				"MYSQL_ROOT_PASSWORD": "mysql",
+				"MYSQL_DATABASE":      "mysql",
+			},
+			WaitingFor: wait.ForListeningPort("3306/tcp").WithStartupTimeout(2 * time.Minute),
+		},
+	})
+	require.NoError(t, err)
+	defer container.Terminate(ctx)
+
+	host, err := container.Host(ctx)
+	require.NoError(t, err)
+	port, err := container.MappedPort(ctx, "3306/tcp")
+	require.NoError(t, err)
+	dsn := fmt.Sprintf("root:mysql@tcp(%s:%d)/mysql?multiStatements=true", host, port.Int())
+
+	db, err := sql.Open("mysql", dsn)
+	require.NoError(t, err)
+	defer db.Close()
+
+	t.Run("AllDataTypes", func(t *testing.T) { mysqlAllDataTypesTest(t, db, dsn) })
+}
+
+func mysqlAllDataTypesTest(t *testing.T, db *sql.DB, dsn string) {
+	ctx := context.Background()
+	_, err := db.ExecContext(ctx, mysqlInitStmt)
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, mysqlInsertStmt)
+	require.NoError(t, err)
+
+	handle, err := drivers.Open("mysql", map[string]any{"dsn": dsn}, false, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	require.NotNil(t, handle)
+
+	sqlStore, _ := handle.AsSQLStore()
+	to, err := drivers.Open("duckdb", map[string]any{"dsn": ""}, false, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	olap, _ := to.AsOLAP("")
+
+	tr := NewSQLStoreToDuckDB(sqlStore, olap, zap.NewNop())
+	err = tr.Transfer(ctx, map[string]any{"sql": "select * from all_datatypes order by id;"}, map[string]any{"table": "sink"}, &drivers.TransferOptions{Progress: drivers.NoOpProgress{}})
+	require.NoError(t, err)
+
+	res, err := olap.Execute(ctx, &drivers.Statement{Query: "select count(*) from sink"})
+	require.NoError(t, err)
+	var count int
+	require.True(t, res.Next())
+	require.NoError(t, res.Scan(&count))
+	require.Equal(t, 2, count)
+	require.NoError(t, res.Close())
+
+	res, err = olap.Execute(ctx, &drivers.Statement{Query: "select tinyint_col, unsigned_bigint_col, bit_col, decimal_col, datetime_col, json_col from sink where id = 1"})
+	require.NoError(t, err)
+	var tinyint int8
+	var unsignedBigint uint64
+	var bit uint64
+	var decimal string
+	var datetime time.Time
+	var json string
+	require.True(t, res.Next())
+	require.NoError(t, res.Scan(&tinyint, &unsignedBigint, &bit, &decimal, &datetime, &json))
+	require.Equal(t, int8(-1), tinyint)
+	require.Equal(t, uint64(18446744073709551615), unsignedBigint)
+	require.Equal(t, uint64(0b1010101010), bit)
+	require.Equal(t, "12345.67890", decimal)
+	require.Equal(t, time.Date(2023, 9, 12, 12, 46, 55, 0, time.UTC), datetime.UTC())
+	require.JSONEq(t, `{"name": "John Doe", "age": 30}`, json)
+	require.NoError(t, res.Close())
+
+	require.NoError(t, to.Close())
+	require.NoError(t, handle.Close())
+}
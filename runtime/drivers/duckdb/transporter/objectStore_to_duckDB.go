@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/rilldata/rill/runtime/pkg/fileutil"
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
 )
 
 type objectStoreToDuckDB struct {
@@ -42,7 +44,18 @@ func (t *objectStoreToDuckDB) Transfer(ctx context.Context, srcProps, sinkProps
 		return err
 	}
 
-	iterator, err := t.from.DownloadFiles(ctx, srcProps)
+	// For incremental transfers, only new or changed objects are downloaded
+	var plan *incrementalPlan
+	var downloadOpts *drivers.DownloadOptions
+	if opts.Incremental != nil {
+		if srcCfg.SQL != "" {
+			return errors.New("incremental ingestion is not supported for sources with a sql property")
+		}
+		plan = newIncrementalPlan(opts.Incremental, srcCfg.PartitionColumn, srcCfg.Table)
+		downloadOpts = &drivers.DownloadOptions{SelectObjects: plan.selectObjects}
+	}
+
+	iterator, err := t.from.DownloadFiles(ctx, srcProps, downloadOpts)
 	if err != nil {
 		return err
 	}
@@ -72,6 +85,23 @@ func (t *objectStoreToDuckDB) Transfer(ctx context.Context, srcProps, sinkProps
 
 	a := newAppender(t.to, sinkCfg, srcCfg.DuckDB, srcCfg.AllowSchemaRelaxation, t.logger)
 
+	if plan != nil && !plan.full {
+		// Remove rows of partitions that are re-ingested, then append to the existing table
+		if len(plan.partitions) > 0 {
+			t.logger.Info("deleting partitions", zap.String("column", plan.partitionColumn), zap.Strings("partitions", plan.partitions), observability.ZapCtx(ctx))
+			args := make([]any, len(plan.partitions))
+			for i, p := range plan.partitions {
+				args[i] = p
+			}
+			placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(args)), ", ")
+			where := fmt.Sprintf("CAST(%s AS VARCHAR) IN (%s)", safeName(plan.partitionColumn), placeholders)
+			if err := t.to.DeleteFromTable(ctx, sinkCfg.Table, where, args...); err != nil {
+				return err
+			}
+		}
+		appendToTable = true
+	}
+
 	for {
 		files, err := iterator.Next()
 		if err != nil {
@@ -108,9 +138,112 @@ func (t *objectStoreToDuckDB) Transfer(ctx context.Context, srcProps, sinkProps
 		opts.Progress.Observe(size, drivers.ProgressUnitByte)
 		appendToTable = true
 	}
+
+	if plan != nil {
+		opts.Incremental.Full = plan.full
+		opts.Incremental.Objects = plan.objects
+	}
 	return nil
 }
 
+// incrementalPlan selects the objects to download in an incremental transfer.
+type incrementalPlan struct {
+	full            bool
+	partitionColumn string
+	// table is true for Delta Lake and Iceberg tables, where files are only removed when their rows are deleted or rewritten
+	table    bool
+	previous map[string]*drivers.ObjectInfo
+	// objects are all the objects matched by the transfer
+	objects []*drivers.ObjectInfo
+	// partitions are the values of partitionColumn to delete before appending the selected objects
+	partitions []string
+}
+
+func newIncrementalPlan(state *drivers.IncrementalState, partitionColumn string, table bool) *incrementalPlan {
+	previous := make(map[string]*drivers.ObjectInfo, len(state.Objects))
+	for _, obj := range state.Objects {
+		previous[obj.Key] = obj
+	}
+	return &incrementalPlan{
+		full:            state.Full,
+		partitionColumn: partitionColumn,
+		table:           table,
+		previous:        previous,
+	}
+}
+
+// selectObjects implements drivers.DownloadOptions.SelectObjects.
+// New objects are always selected. If a partition column is configured, all objects in partitions with changed or deleted objects are selected for re-ingestion.
+// Without a partition column, the rows of changed objects can't be identified, so it falls back to a full ingestion.
+// Rows of deleted objects are retained, except for tables (where it also falls back to a full ingestion).
+func (p *incrementalPlan) selectObjects(objects []*drivers.ObjectInfo) ([]*drivers.ObjectInfo, error) {
+	p.objects = objects
+	if p.full {
+		return objects, nil
+	}
+
+	current := make(map[string]bool, len(objects))
+	var added, stale []*drivers.ObjectInfo
+	for _, obj := range objects {
+		current[obj.Key] = true
+		prev, ok := p.previous[obj.Key]
+		if !ok {
+			added = append(added, obj)
+		} else if prev.ETag != obj.ETag || !prev.LastModified.Equal(obj.LastModified) || prev.Size != obj.Size {
+			stale = append(stale, obj)
+		}
+	}
+	for key, obj := range p.previous {
+		if !current[key] {
+			stale = append(stale, obj)
+		}
+	}
+
+	if p.partitionColumn == "" {
+		for _, obj := range stale {
+			if current[obj.Key] || p.table {
+				p.full = true
+				return objects, nil
+			}
+		}
+		return added, nil
+	}
+
+	partitions := make(map[string]bool)
+	for _, obj := range stale {
+		val, ok := partitionValue(obj.Key, p.partitionColumn)
+		if !ok {
+			return nil, fmt.Errorf("object %q is not in a partition for column %q", obj.Key, p.partitionColumn)
+		}
+		partitions[val] = true
+	}
+
+	selected := added
+	for _, obj := range objects {
+		if _, ok := p.previous[obj.Key]; !ok {
+			continue // Already in added
+		}
+		if val, ok := partitionValue(obj.Key, p.partitionColumn); ok && partitions[val] {
+			selected = append(selected, obj)
+		}
+	}
+
+	p.partitions = maps.Keys(partitions)
+	sort.Strings(p.partitions)
+	return selected, nil
+}
+
+// partitionValue extracts the value of a hive partition column from an object key (e.g. "2023" for column "year" in "data/year=2023/file.parquet").
+func partitionValue(key, column string) (string, bool) {
+	prefix := column + "="
+	for _, part := range strings.Split(key, "/") {
+		if strings.HasPrefix(part, prefix) {
+			return strings.TrimPrefix(part, prefix), true
+		}
+	}
+	return "", false
+}
+
 type appender struct {
 	to                    drivers.OLAPStore
 	sink                  *sinkProperties
@@ -0,0 +1,120 @@
+package transporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIncrementalPlan(t *testing.T) {
+	ts := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	obj := func(key, etag string) *drivers.ObjectInfo {
+		return &drivers.ObjectInfo{Key: key, ETag: etag, LastModified: ts, Size: 10}
+	}
+	keys := func(objs []*drivers.ObjectInfo) []string {
+		var res []string
+		for _, o := range objs {
+			res = append(res, o.Key)
+		}
+		return res
+	}
+
+	previous := []*drivers.ObjectInfo{
+		obj("events/dt=2023-10-01/a.parquet", "1"),
+		obj("events/dt=2023-10-01/b.parquet", "1"),
+		obj("events/dt=2023-10-02/a.parquet", "1"),
+		obj("events/dt=2023-10-03/a.parquet", "1"),
+	}
+
+	tt := []struct {
+		name       string
+		state      *drivers.IncrementalState
+		column     string
+		table      bool
+		objects    []*drivers.ObjectInfo
+		selected   []string
+		partitions []string
+		full       bool
+	}{
+		{
+			name:     "full",
+			state:    &drivers.IncrementalState{Full: true, Objects: previous},
+			objects:  previous,
+			selected: keys(previous),
+			full:     true,
+		},
+		{
+			name:  "new objects",
+			state: &drivers.IncrementalState{Objects: previous},
+			objects: append(previous[:4:4],
+				obj("events/dt=2023-10-04/a.parquet", "1"),
+			),
+			selected: []string{"events/dt=2023-10-04/a.parquet"},
+		},
+		{
+			name:  "changed object without partition column",
+			state: &drivers.IncrementalState{Objects: previous},
+			objects: []*drivers.ObjectInfo{
+				previous[0], previous[1], previous[2],
+				obj("events/dt=2023-10-03/a.parquet", "2"),
+			},
+			selected: []string{
+				"events/dt=2023-10-01/a.parquet",
+				"events/dt=2023-10-01/b.parquet",
+				"events/dt=2023-10-02/a.parquet",
+				"events/dt=2023-10-03/a.parquet",
+			},
+			full: true,
+		},
+		{
+			name:     "deleted object without partition column",
+			state:    &drivers.IncrementalState{Objects: previous},
+			objects:  previous[1:],
+			selected: nil,
+		},
+		{
+			name:     "deleted table file without partition column",
+			state:    &drivers.IncrementalState{Objects: previous},
+			table:    true,
+			objects:  previous[1:],
+			selected: keys(previous[1:]),
+			full:     true,
+		},
+		{
+			name:   "changed and deleted objects with partition column",
+			state:  &drivers.IncrementalState{Objects: previous},
+			column: "dt",
+			objects: []*drivers.ObjectInfo{
+				previous[1],
+				obj("events/dt=2023-10-02/a.parquet", "2"),
+				previous[3],
+				obj("events/dt=2023-10-04/a.parquet", "1"),
+			},
+			selected: []string{
+				"events/dt=2023-10-04/a.parquet",
+				"events/dt=2023-10-01/b.parquet",
+				"events/dt=2023-10-02/a.parquet",
+			},
+			partitions: []string{"2023-10-01", "2023-10-02"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newIncrementalPlan(tc.state, tc.column, tc.table)
+			selected, err := p.selectObjects(tc.objects)
+			require.NoError(t, err)
+			require.Equal(t, tc.selected, keys(selected))
+			require.Equal(t, tc.partitions, p.partitions)
+			require.Equal(t, tc.full, p.full)
+			require.Equal(t, tc.objects, p.objects)
+		})
+	}
+
+	// Objects outside a partition can't be re-ingested by partition
+	p := newIncrementalPlan(&drivers.IncrementalState{Objects: []*drivers.ObjectInfo{obj("events/a.parquet", "1")}}, "dt", false)
+	_, err := p.selectObjects([]*drivers.ObjectInfo{obj("events/a.parquet", "2")})
+	require.Error(t, err)
+}
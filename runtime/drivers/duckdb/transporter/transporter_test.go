@@ -20,7 +20,7 @@ type mockObjectStore struct {
 	mockIterator drivers.FileIterator
 }
 
-func (m *mockObjectStore) DownloadFiles(ctx context.Context, srcProps map[string]any) (drivers.FileIterator, error) {
+func (m *mockObjectStore) DownloadFiles(ctx context.Context, srcProps map[string]any, opts *drivers.DownloadOptions) (drivers.FileIterator, error) {
 	return m.mockIterator, nil
 }
 
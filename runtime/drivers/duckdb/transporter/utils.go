@@ -65,6 +65,8 @@ type fileSourceProperties struct {
 	AllowSchemaRelaxation bool           `mapstructure:"allow_schema_relaxation"`
 	BatchSize             string         `mapstructure:"batch_size"`
 	BatchSizeBytes        int64          `mapstructure:"-"` // Inferred from BatchSize
+	PartitionColumn       string         `mapstructure:"partition_column"`
+	Table                 bool           `mapstructure:"-"` // Inferred from Format
 
 	// Backwards compatibility
 	HivePartitioning            *bool  `mapstructure:"hive_partitioning"`
@@ -87,6 +89,20 @@ func parseFileSourceProperties(props map[string]any) (*fileSourceProperties, err
 		cfg.HivePartitioning = nil
 	}
 
+	// Delta Lake and Iceberg tables are ingested from the parquet data files resolved by the connector
+	switch cfg.Format {
+	case "delta":
+		cfg.Format = "parquet"
+		cfg.Table = true
+	case "iceberg":
+		cfg.Format = "parquet"
+		cfg.Table = true
+		// Iceberg data files contain the partition columns, so they must not be parsed from the paths
+		if _, ok := cfg.DuckDB["hive_partitioning"]; !ok {
+			cfg.DuckDB["hive_partitioning"] = false
+		}
+	}
+
 	if cfg.CSVDelimiter != "" {
 		cfg.DuckDB["delim"] = fmt.Sprintf("'%v'", cfg.CSVDelimiter)
 		cfg.CSVDelimiter = ""
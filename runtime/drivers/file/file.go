@@ -9,6 +9,7 @@ import (
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/rilldata/rill/runtime/drivers"
+	rillblob "github.com/rilldata/rill/runtime/drivers/blob"
 	"github.com/rilldata/rill/runtime/pkg/activity"
 	"github.com/rilldata/rill/runtime/pkg/fileutil"
 	"go.uber.org/zap"
@@ -37,7 +38,7 @@ var spec = drivers.Spec{
 			Type:        drivers.StringPropertyType,
 			Required:    false,
 			DisplayName: "Format",
-			Description: "Either CSV or Parquet. Inferred if not set.",
+			Description: "Either CSV, Parquet, JSON, Delta or Iceberg. Inferred if not set.",
 			Placeholder: "csv",
 		},
 	},
@@ -95,8 +96,11 @@ func (d driver) TertiarySourceConnectors(ctx context.Context, src map[string]any
 }
 
 type sourceProperties struct {
-	Path   string `mapstructure:"path"`
-	Format string `mapstructure:"format"`
+	Path        string `mapstructure:"path"`
+	Format      string `mapstructure:"format"`
+	Version     *int64 `mapstructure:"version"`
+	SnapshotID  *int64 `mapstructure:"snapshot_id"`
+	tableFormat *rillblob.TableFormat
 }
 
 func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
@@ -106,6 +110,11 @@ func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
 		return nil, err
 	}
 
+	conf.tableFormat, err = rillblob.ParseTableFormat(conf.Format, conf.Version, conf.SnapshotID)
+	if err != nil {
+		return nil, err
+	}
+
 	return conf, nil
 }
 
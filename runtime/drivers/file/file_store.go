@@ -3,9 +3,12 @@ package file
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 
 	"github.com/bmatcuk/doublestar/v4"
+	rillblob "github.com/rilldata/rill/runtime/drivers/blob"
 	"github.com/rilldata/rill/runtime/pkg/fileutil"
+	"gocloud.dev/blob/fileblob"
 )
 
 // FilePaths implements drivers.FileStore
@@ -20,6 +23,10 @@ func (c *connection) FilePaths(ctx context.Context, src map[string]any) ([]strin
 		return nil, err
 	}
 
+	if conf.tableFormat != nil {
+		return tablePaths(ctx, path, conf.tableFormat)
+	}
+
 	// get all files in case glob passed
 	localPaths, err := doublestar.FilepathGlob(path)
 	if err != nil {
@@ -32,6 +39,29 @@ func (c *connection) FilePaths(ctx context.Context, src map[string]any) ([]strin
 	return localPaths, nil
 }
 
+// tablePaths returns the paths of the live data files of the Delta Lake or Iceberg table stored in dir.
+func tablePaths(ctx context.Context, dir string, format *rillblob.TableFormat) ([]string, error) {
+	bucket, err := fileblob.OpenBucket(dir, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer bucket.Close()
+
+	objs, err := format.ListObjects(ctx, bucket, "")
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) == 0 {
+		return nil, fmt.Errorf("no data files found for %s table at %s", format.Name, dir)
+	}
+
+	paths := make([]string, len(objs))
+	for i, obj := range objs {
+		paths[i] = filepath.Join(dir, filepath.FromSlash(obj.Key))
+	}
+	return paths, nil
+}
+
 func (c *connection) resolveLocalPath(path string) (string, error) {
 	allowHostAccess := false
 	if val, ok := c.driverConfig["allow_host_access"].(bool); ok {
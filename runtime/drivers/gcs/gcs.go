@@ -151,8 +151,12 @@ type sourceProperties struct {
 	GlobMaxObjectsListed  int64          `mapstructure:"glob.max_objects_listed"`
 	GlobPageSize          int            `mapstructure:"glob.page_size"`
 	BatchSize             string         `mapstructure:"batch_size"`
+	Format                string         `mapstructure:"format"`
+	Version               *int64         `mapstructure:"version"`
+	SnapshotID            *int64         `mapstructure:"snapshot_id"`
 	url                   *globutil.URL
 	extractPolicy         *rillblob.ExtractPolicy
+	tableFormat           *rillblob.TableFormat
 }
 
 func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
@@ -188,6 +192,11 @@ func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
 		return nil, fmt.Errorf("failed to parse extract config: %w", err)
 	}
 
+	conf.tableFormat, err = rillblob.ParseTableFormat(conf.Format, conf.Version, conf.SnapshotID)
+	if err != nil {
+		return nil, err
+	}
+
 	return conf, nil
 }
 
@@ -267,7 +276,7 @@ func (c *Connection) AsSQLStore() (drivers.SQLStore, bool) {
 // DownloadFiles returns a file iterator over objects stored in gcs.
 // The credential json is read from config google_application_credentials.
 // Additionally in case `allow_host_credentials` is true it looks for "Application Default Credentials" as well
-func (c *Connection) DownloadFiles(ctx context.Context, props map[string]any) (drivers.FileIterator, error) {
+func (c *Connection) DownloadFiles(ctx context.Context, props map[string]any, downloadOpts *drivers.DownloadOptions) (drivers.FileIterator, error) {
 	conf, err := parseSourceProperties(props)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse config: %w", err)
@@ -295,8 +304,12 @@ func (c *Connection) DownloadFiles(ctx context.Context, props map[string]any) (d
 		GlobPageSize:          conf.GlobPageSize,
 		GlobPattern:           conf.url.Path,
 		ExtractPolicy:         conf.extractPolicy,
+		TableFormat:           conf.tableFormat,
 		BatchSizeBytes:        int64(batchSize.Bytes()),
 	}
+	if downloadOpts != nil {
+		opts.SelectObjects = downloadOpts.SelectObjects
+	}
 
 	iter, err := rillblob.NewIterator(ctx, bucketObj, opts, c.logger)
 	if err != nil {
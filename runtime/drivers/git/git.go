@@ -0,0 +1,408 @@
+package git
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/eapache/go-resiliency/retrier"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	pullTimeout = 10 * time.Minute
+	retryN      = 3
+	retryWait   = 500 * time.Millisecond
+)
+
+var tracer = otel.Tracer("github.com/rilldata/rill/runtime/drivers/git")
+
+// DSN configures the Git remote to clone.
+// The credentials are optional. Username and Password are used for HTTPS remotes (Password can also be an access token).
+// PrivateKey is a PEM-encoded SSH key (such as a deploy key) used for SSH remotes.
+type DSN struct {
+	RemoteURL          string `json:"remote_url"`
+	Subpath            string `json:"subpath"`
+	Branch             string `json:"branch"`
+	Username           string `json:"username,omitempty"`
+	Password           string `json:"password,omitempty"`
+	PrivateKey         string `json:"private_key,omitempty"`
+	PrivateKeyPassword string `json:"private_key_password,omitempty"`
+	// KnownHosts are the SSH host keys to accept in known_hosts format. If empty, the system's known_hosts files are used.
+	KnownHosts string `json:"known_hosts,omitempty"`
+}
+
+func init() {
+	drivers.Register("git", driver{})
+}
+
+type driver struct{}
+
+func (d driver) Open(config map[string]any, shared bool, client activity.Client, logger *zap.Logger) (drivers.Handle, error) {
+	if shared {
+		return nil, fmt.Errorf("git driver can't be shared")
+	}
+	dsnStr, ok := config["dsn"].(string)
+	if !ok {
+		return nil, fmt.Errorf("require dsn to open git connection")
+	}
+
+	var dsn DSN
+	err := json.Unmarshal([]byte(dsnStr), &dsn)
+	if err != nil {
+		return nil, err
+	}
+	if dsn.RemoteURL == "" || dsn.Branch == "" {
+		return nil, fmt.Errorf("git dsn must set remote_url and branch")
+	}
+
+	tempdir, err := os.MkdirTemp("", "git_repo_driver")
+	if err != nil {
+		return nil, err
+	}
+
+	tempdir, err = filepath.Abs(tempdir)
+	if err != nil {
+		return nil, err
+	}
+
+	// The repo is cloned into a sub-directory, so other files (like known_hosts) can be kept next to it
+	repodir := filepath.Join(tempdir, "repo")
+	projectDir := repodir
+	if dsn.Subpath != "" {
+		projectDir = filepath.Join(repodir, dsn.Subpath)
+	}
+
+	// NOTE :: project isn't cloned yet
+	return &connection{
+		config:       config,
+		dsn:          dsn,
+		tempdir:      tempdir,
+		repodir:      repodir,
+		projectdir:   projectDir,
+		singleflight: &singleflight.Group{},
+		shared:       shared,
+	}, nil
+}
+
+func (d driver) Drop(config map[string]any, logger *zap.Logger) error {
+	return drivers.ErrDropNotSupported
+}
+
+func (d driver) Spec() drivers.Spec {
+	return drivers.Spec{}
+}
+
+func (d driver) HasAnonymousSourceAccess(ctx context.Context, src map[string]any, logger *zap.Logger) (bool, error) {
+	return false, fmt.Errorf("not implemented")
+}
+
+func (d driver) TertiarySourceConnectors(ctx context.Context, src map[string]any, logger *zap.Logger) ([]string, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+type connection struct {
+	config       map[string]any
+	dsn          DSN
+	shared       bool
+	tempdir      string // tempdir path should be absolute
+	repodir      string
+	projectdir   string
+	singleflight *singleflight.Group
+	cloned       atomic.Bool
+}
+
+// Close implements drivers.Connection.
+func (c *connection) Close() error {
+	err := os.RemoveAll(c.tempdir)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Config implements drivers.Connection.
+func (c *connection) Config() map[string]any {
+	return c.config
+}
+
+// Registry implements drivers.Connection.
+func (c *connection) AsRegistry() (drivers.RegistryStore, bool) {
+	return nil, false
+}
+
+// Catalog implements drivers.Connection.
+func (c *connection) AsCatalogStore(instanceID string) (drivers.CatalogStore, bool) {
+	return nil, false
+}
+
+// Repo implements drivers.Connection.
+func (c *connection) AsRepoStore(instanceID string) (drivers.RepoStore, bool) {
+	if c.shared {
+		return nil, false
+	}
+	return c, true
+}
+
+// OLAP implements drivers.Connection.
+func (c *connection) AsOLAP(instanceID string) (drivers.OLAPStore, bool) {
+	return nil, false
+}
+
+// Migrate implements drivers.Connection.
+func (c *connection) Migrate(ctx context.Context) (err error) {
+	return nil
+}
+
+// MigrationStatus implements drivers.Connection.
+func (c *connection) MigrationStatus(ctx context.Context) (current, desired int, err error) {
+	return 0, 0, nil
+}
+
+// AsObjectStore implements drivers.Connection.
+func (c *connection) AsObjectStore() (drivers.ObjectStore, bool) {
+	return nil, false
+}
+
+// AsTransporter implements drivers.Connection.
+func (c *connection) AsTransporter(from, to drivers.Handle) (drivers.Transporter, bool) {
+	return nil, false
+}
+
+func (c *connection) AsSQLStore() (drivers.SQLStore, bool) {
+	return nil, false
+}
+
+func (c *connection) AsFileStore() (drivers.FileStore, bool) {
+	return nil, false
+}
+
+// cloneOrFetch clones or fetches the repo with an exponential backoff retry on retryable errors.
+// It's safe for concurrent calls, which are deduplicated.
+func (c *connection) cloneOrFetch(ctx context.Context, onlyClone bool) error {
+	if onlyClone && c.cloned.Load() {
+		return nil
+	}
+
+	ctx, span := tracer.Start(ctx, "cloneOrFetch", trace.WithAttributes(attribute.Bool("onlyClone", onlyClone)))
+	defer span.End()
+
+	ch := c.singleflight.DoChan("cloneOrFetch", func() (interface{}, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), pullTimeout)
+		defer cancel()
+
+		r := retrier.New(retrier.ExponentialBackoff(retryN, retryWait), retryErrClassifier{})
+		err := r.Run(func() error { return c.cloneOrFetchUnsafe(ctx) })
+		if err != nil {
+			return nil, err
+		}
+		return nil, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case res := <-ch:
+		return res.Err
+	}
+}
+
+// cloneOrFetchUnsafe fetches changes from the remote. Also clones the repo if it hasn't been cloned already.
+func (c *connection) cloneOrFetchUnsafe(ctx context.Context) error {
+	if !c.cloned.Load() {
+		err := c.cloneUnsafe(ctx)
+		c.cloned.Store(err == nil)
+		return err
+	}
+
+	return c.fetchUnsafe(ctx)
+}
+
+// cloneUnsafe runs the initial clone of the repo.
+func (c *connection) cloneUnsafe(ctx context.Context) error {
+	auth, err := c.dsn.auth(c.tempdir)
+	if err != nil {
+		return err
+	}
+
+	// Clean up after a previous failed attempt
+	err = os.RemoveAll(c.repodir)
+	if err != nil {
+		return err
+	}
+
+	_, err = git.PlainCloneContext(ctx, c.repodir, false, &git.CloneOptions{
+		URL:           c.dsn.RemoteURL,
+		Auth:          auth,
+		ReferenceName: plumbing.NewBranchReferenceName(c.dsn.Branch),
+		SingleBranch:  true,
+	})
+	return err
+}
+
+// fetchUnsafe fetches new commits on the branch and resets the worktree to the fetched head.
+// Only objects that are missing locally are fetched. Resetting (instead of merging) means force pushes to the branch are handled.
+// Requires repo to be cloned already. Unsafe for concurrent use.
+func (c *connection) fetchUnsafe(ctx context.Context) error {
+	auth, err := c.dsn.auth(c.tempdir)
+	if err != nil {
+		return err
+	}
+
+	repo, err := git.PlainOpen(c.repodir)
+	if err != nil {
+		return err
+	}
+
+	remoteRef := plumbing.NewRemoteReferenceName(git.DefaultRemoteName, c.dsn.Branch)
+	err = repo.FetchContext(ctx, &git.FetchOptions{
+		RemoteName: git.DefaultRemoteName,
+		Auth:       auth,
+		RefSpecs:   []config.RefSpec{config.RefSpec(fmt.Sprintf("+%s:%s", plumbing.NewBranchReferenceName(c.dsn.Branch), remoteRef))},
+		Force:      true,
+	})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return err
+	}
+
+	ref, err := repo.Reference(remoteRef, true)
+	if err != nil {
+		return err
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return err
+	}
+	if head.Hash() == ref.Hash() {
+		return nil
+	}
+
+	wt, err := repo.Worktree()
+	if err != nil {
+		return err
+	}
+
+	return wt.Reset(&git.ResetOptions{
+		Commit: ref.Hash(),
+		Mode:   git.HardReset,
+	})
+}
+
+// RemoteBranches lists the branches of the remote configured in dsn and the hashes of the commits they point to.
+// It doesn't clone the repo, so it's cheap enough to use for polling the remote for changes.
+func RemoteBranches(ctx context.Context, dsn *DSN) (map[string]string, error) {
+	tempdir, err := os.MkdirTemp("", "git_remote_branches")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tempdir)
+
+	auth, err := dsn.auth(tempdir)
+	if err != nil {
+		return nil, err
+	}
+
+	remote := git.NewRemote(memory.NewStorage(), &config.RemoteConfig{
+		Name: git.DefaultRemoteName,
+		URLs: []string{dsn.RemoteURL},
+	})
+
+	refs, err := remote.ListContext(ctx, &git.ListOptions{Auth: auth})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]string)
+	for _, ref := range refs {
+		if ref.Name().IsBranch() {
+			res[ref.Name().Short()] = ref.Hash().String()
+		}
+	}
+	return res, nil
+}
+
+// auth returns the auth method for the remote. It returns nil if no credentials are configured.
+// dir is used to store the known hosts file for SSH remotes.
+func (d *DSN) auth(dir string) (transport.AuthMethod, error) {
+	if d.PrivateKey != "" {
+		user := d.Username
+		if user == "" {
+			user = gitssh.DefaultUsername
+		}
+
+		keys, err := gitssh.NewPublicKeys(user, []byte(d.PrivateKey), d.PrivateKeyPassword)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse private key: %w", err)
+		}
+
+		if d.KnownHosts != "" {
+			path := filepath.Join(dir, "known_hosts")
+			err = os.WriteFile(path, []byte(d.KnownHosts), 0o600)
+			if err != nil {
+				return nil, err
+			}
+
+			keys.HostKeyCallback, err = gitssh.NewKnownHostsCallback(path)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse known hosts: %w", err)
+			}
+		}
+
+		return keys, nil
+	}
+
+	if d.Username != "" || d.Password != "" {
+		user := d.Username
+		if user == "" {
+			// Most Git hosts accept any non-empty username when authenticating with an access token
+			user = "git"
+		}
+		return &githttp.BasicAuth{Username: user, Password: d.Password}, nil
+	}
+
+	return nil, nil
+}
+
+// retryErrClassifier classifies Git errors as retryable or not.
+type retryErrClassifier struct{}
+
+func (retryErrClassifier) Classify(err error) retrier.Action {
+	if err == nil {
+		return retrier.Succeed
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return retrier.Fail
+	}
+
+	if errors.Is(err, transport.ErrAuthenticationRequired) ||
+		errors.Is(err, transport.ErrAuthorizationFailed) ||
+		errors.Is(err, transport.ErrRepositoryNotFound) ||
+		errors.Is(err, transport.ErrInvalidAuthMethod) ||
+		errors.Is(err, git.NoMatchingRefSpecError{}) ||
+		errors.Is(err, plumbing.ErrReferenceNotFound) {
+		return retrier.Fail
+	}
+
+	return retrier.Retry
+}
@@ -0,0 +1,175 @@
+package git
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestRepo(t *testing.T) {
+	ctx := context.Background()
+	remote := newRemote(t)
+
+	first := remote.commit(t, map[string]string{
+		"rill.yaml":              "title: Hello",
+		"project/rill.yaml":      "title: Sub",
+		"project/models/foo.sql": "SELECT 1",
+	}, nil)
+
+	repo := openRepo(t, &DSN{RemoteURL: remote.url, Branch: "main", Subpath: "project"})
+
+	hash, err := repo.CommitHash(ctx)
+	require.NoError(t, err)
+	require.Equal(t, first, hash)
+
+	paths, err := repo.ListRecursive(ctx, "**/*.{sql,yaml}")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"/rill.yaml", "/models/foo.sql"}, paths)
+
+	// New commits are only picked up on Sync
+	second := remote.commit(t, map[string]string{"project/models/foo.sql": "SELECT 2", "project/models/bar.sql": "SELECT 3"}, nil)
+	data, err := repo.Get(ctx, "models/foo.sql")
+	require.NoError(t, err)
+	require.Equal(t, "SELECT 1", data)
+
+	require.NoError(t, repo.Sync(ctx))
+	hash, err = repo.CommitHash(ctx)
+	require.NoError(t, err)
+	require.Equal(t, second, hash)
+	data, err = repo.Get(ctx, "models/foo.sql")
+	require.NoError(t, err)
+	require.Equal(t, "SELECT 2", data)
+
+	// Force pushes that rewrite history are handled
+	third := remote.commit(t, map[string]string{"project/models/baz.sql": "SELECT 4"}, []string{"project/models/bar.sql"})
+	forced := remote.forcePush(t, first)
+	require.NotEqual(t, third, forced)
+	require.NoError(t, repo.Sync(ctx))
+	hash, err = repo.CommitHash(ctx)
+	require.NoError(t, err)
+	require.Equal(t, forced, hash)
+	paths, err = repo.ListRecursive(ctx, "models/*.sql")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"/models/foo.sql"}, paths)
+
+	// Syncing without changes is a no-op
+	require.NoError(t, repo.Sync(ctx))
+
+	branches, err := RemoteBranches(ctx, &DSN{RemoteURL: remote.url})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"main": forced}, branches)
+}
+
+func TestRepoErrors(t *testing.T) {
+	remote := newRemote(t)
+	remote.commit(t, map[string]string{"rill.yaml": ""}, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	repo := openRepo(t, &DSN{RemoteURL: remote.url, Branch: "missing"})
+	_, err := repo.CommitHash(ctx)
+	require.ErrorContains(t, err, "couldn't find remote ref")
+
+	_, err = driver{}.Open(map[string]any{"dsn": `{"remote_url": "https://example.com/repo.git"}`}, false, activity.NewNoopClient(), zap.NewNop())
+	require.ErrorContains(t, err, "must set remote_url and branch")
+
+	_, err = (&DSN{PrivateKey: "invalid"}).auth(t.TempDir())
+	require.ErrorContains(t, err, "failed to parse private key")
+}
+
+type testRemote struct {
+	url  string
+	repo *git.Repository
+	dir  string
+}
+
+// newRemote creates a bare repository and a clone of it for pushing commits to the "main" branch.
+func newRemote(t *testing.T) *testRemote {
+	bare := filepath.Join(t.TempDir(), "remote.git")
+	_, err := git.PlainInit(bare, true)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	repo, err := git.PlainInit(dir, false)
+	require.NoError(t, err)
+	_, err = repo.CreateRemote(&config.RemoteConfig{Name: "origin", URLs: []string{bare}})
+	require.NoError(t, err)
+
+	return &testRemote{url: bare, repo: repo, dir: dir}
+}
+
+// commit writes and removes the given files, commits them and pushes the commit to the remote.
+func (r *testRemote) commit(t *testing.T, files map[string]string, remove []string) string {
+	wt, err := r.repo.Worktree()
+	require.NoError(t, err)
+
+	for name, data := range files {
+		path := filepath.Join(r.dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(data), 0o644))
+		_, err = wt.Add(name)
+		require.NoError(t, err)
+	}
+	for _, name := range remove {
+		_, err = wt.Remove(name)
+		require.NoError(t, err)
+	}
+
+	hash, err := wt.Commit("commit", &git.CommitOptions{Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()}})
+	require.NoError(t, err)
+
+	r.push(t, false)
+	return hash.String()
+}
+
+// forcePush resets the local branch to the given commit, adds a new commit on top of it and force pushes it.
+func (r *testRemote) forcePush(t *testing.T, base string) string {
+	wt, err := r.repo.Worktree()
+	require.NoError(t, err)
+
+	require.NoError(t, wt.Reset(&git.ResetOptions{Commit: plumbing.NewHash(base), Mode: git.HardReset}))
+
+	hash, err := wt.Commit("amended", &git.CommitOptions{Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()}, AllowEmptyCommits: true})
+	require.NoError(t, err)
+
+	r.push(t, true)
+	return hash.String()
+}
+
+func (r *testRemote) push(t *testing.T, force bool) {
+	head, err := r.repo.Head()
+	require.NoError(t, err)
+
+	err = r.repo.Push(&git.PushOptions{
+		RemoteName: "origin",
+		RefSpecs:   []config.RefSpec{config.RefSpec(head.Name().String() + ":refs/heads/main")},
+		Force:      force,
+	})
+	require.NoError(t, err)
+}
+
+func openRepo(t *testing.T, dsn *DSN) drivers.RepoStore {
+	dsnJSON, err := json.Marshal(dsn)
+	require.NoError(t, err)
+
+	handle, err := driver{}.Open(map[string]any{"dsn": string(dsnJSON)}, false, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, handle.Close()) })
+
+	repo, ok := handle.AsRepoStore("")
+	require.True(t, ok)
+	return repo
+}
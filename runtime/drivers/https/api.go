@@ -0,0 +1,475 @@
+package https
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const (
+	_defaultMaxPages   = 1000
+	_defaultMaxRetries = 5
+	_defaultPageSize   = 100
+	_maxRetryDelay     = 30 * time.Second
+)
+
+// paginationProperties configures how the https connector requests the pages of a REST API.
+type paginationProperties struct {
+	// Type is one of "cursor", "offset", "page" or "link"
+	Type string `mapstructure:"type"`
+	// CursorPath is the path of the next page's cursor in the response (for "cursor" pagination)
+	CursorPath string `mapstructure:"cursor_path"`
+	// CursorParam is the query parameter to pass the cursor in (for "cursor" pagination)
+	CursorParam string `mapstructure:"cursor_param"`
+	// OffsetParam and LimitParam are the query parameters to pass the offset and page size in (for "offset" pagination)
+	OffsetParam string `mapstructure:"offset_param"`
+	LimitParam  string `mapstructure:"limit_param"`
+	// PageSize is the number of records to request per page (for "offset" pagination)
+	PageSize int `mapstructure:"page_size"`
+	// PageParam is the query parameter to pass the page number in (for "page" pagination)
+	PageParam string `mapstructure:"page_param"`
+	// StartPage is the number of the first page (for "page" pagination)
+	StartPage *int `mapstructure:"start_page"`
+}
+
+// oauth2Properties configures the OAuth2 client credentials flow for requests.
+type oauth2Properties struct {
+	TokenURL     string   `mapstructure:"token_url"`
+	ClientID     string   `mapstructure:"client_id"`
+	ClientSecret string   `mapstructure:"client_secret"`
+	Scopes       []string `mapstructure:"scopes"`
+}
+
+func (p *paginationProperties) validate() error {
+	switch p.Type {
+	case "cursor":
+		if p.CursorPath == "" {
+			return errors.New(`"cursor" pagination requires "cursor_path"`)
+		}
+		if p.CursorParam == "" {
+			p.CursorParam = "cursor"
+		}
+	case "offset":
+		if p.OffsetParam == "" {
+			p.OffsetParam = "offset"
+		}
+		if p.LimitParam == "" {
+			p.LimitParam = "limit"
+		}
+		if p.PageSize == 0 {
+			p.PageSize = _defaultPageSize
+		}
+		if p.PageSize < 0 {
+			return errors.New(`"page_size" must be positive`)
+		}
+	case "page":
+		if p.PageParam == "" {
+			p.PageParam = "page"
+		}
+		if p.StartPage == nil {
+			start := 1
+			p.StartPage = &start
+		}
+	case "link":
+	default:
+		return fmt.Errorf("invalid pagination type %q (expected one of cursor, offset, page or link)", p.Type)
+	}
+	return nil
+}
+
+// httpClient returns the client to send requests with. It fetches OAuth2 tokens if configured.
+// The OAuth2 client ID and secret may also be set in the connector config (e.g. using the "connector.https.oauth2_client_secret" variable).
+func (c *connection) httpClient(ctx context.Context, conf *sourceProperties) *http.Client {
+	if conf.OAuth2 == nil {
+		return http.DefaultClient
+	}
+
+	cfg := &clientcredentials.Config{
+		TokenURL:     conf.OAuth2.TokenURL,
+		ClientID:     conf.OAuth2.ClientID,
+		ClientSecret: conf.OAuth2.ClientSecret,
+		Scopes:       conf.OAuth2.Scopes,
+	}
+	if cfg.ClientID == "" {
+		cfg.ClientID, _ = c.config["oauth2_client_id"].(string)
+	}
+	if cfg.ClientSecret == "" {
+		cfg.ClientSecret, _ = c.config["oauth2_client_secret"].(string)
+	}
+	return cfg.Client(ctx)
+}
+
+// requestURL returns the URL in path with the params added to its query.
+// The query is only re-encoded if there are params to not break pre-signed URLs.
+func requestURL(path string, params map[string]any) (*url.URL, error) {
+	u, err := url.Parse(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse path %s, %w", path, err)
+	}
+	if len(params) == 0 {
+		return u, nil
+	}
+
+	q := u.Query()
+	for k, v := range params {
+		switch v := v.(type) {
+		case string:
+			q.Set(k, v)
+		case float64:
+			q.Set(k, strconv.FormatFloat(v, 'f', -1, 64))
+		default:
+			q.Set(k, fmt.Sprint(v))
+		}
+	}
+	u.RawQuery = q.Encode()
+
+	return u, nil
+}
+
+// get sends a GET request and returns the response if it's successful.
+// Requests that fail with a network error, 429 or 5xx status are retried with exponential backoff (or after the delay in the Retry-After header).
+func (c *connection) get(ctx context.Context, client *http.Client, conf *sourceProperties, u string) (*http.Response, error) {
+	maxRetries := _defaultMaxRetries
+	if conf.MaxRetries != nil {
+		maxRetries = *conf.MaxRetries
+	}
+
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, http.NoBody)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch url %s:  %w", conf.Path, err)
+		}
+		for k, v := range conf.Headers {
+			req.Header.Set(k, v)
+		}
+
+		delay := _maxRetryDelay
+		if attempt < 5 {
+			delay = time.Second << attempt
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			// Don't retry if the OAuth2 token request was rejected
+			var tokenErr *oauth2.RetrieveError
+			if errors.As(err, &tokenErr) {
+				return nil, fmt.Errorf("failed to fetch url %s:  %w", conf.Path, err)
+			}
+			err = fmt.Errorf("failed to fetch url %s:  %w", conf.Path, err)
+		} else if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			return resp, nil
+		} else {
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode < 500 {
+				return nil, fmt.Errorf("failed to fetch url %s: %s", conf.Path, resp.Status)
+			}
+			if secs, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && secs >= 0 {
+				delay = time.Duration(secs) * time.Second
+			}
+			err = fmt.Errorf("failed to fetch url %s: %s", conf.Path, resp.Status)
+		}
+
+		if attempt >= maxRetries {
+			return nil, err
+		}
+		c.logger.Warn("https: retrying request", zap.Int("attempt", attempt+1), zap.Duration("delay", delay), zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// fetchRecords requests all pages of a REST API and writes the records they contain to a newline-delimited JSON file.
+// Pages are written to the file as they are received, so the records don't need to fit in memory.
+func (c *connection) fetchRecords(ctx context.Context, conf *sourceProperties) (string, int64, error) {
+	client := c.httpClient(ctx, conf)
+
+	u, err := requestURL(conf.Path, conf.Params)
+	if err != nil {
+		return "", 0, err
+	}
+
+	// Next links are only followed on the same origin, since the headers and OAuth2 token are sent with every request
+	scheme, host := u.Scheme, u.Host
+
+	pg := conf.Pagination
+	offset := 0
+	page := 0
+	if pg != nil {
+		switch pg.Type {
+		case "offset":
+			setQuery(u, pg.OffsetParam, "0")
+			setQuery(u, pg.LimitParam, strconv.Itoa(pg.PageSize))
+		case "page":
+			page = *pg.StartPage
+			setQuery(u, pg.PageParam, strconv.Itoa(page))
+		}
+	}
+
+	maxPages := _defaultMaxPages
+	if conf.MaxPages > 0 {
+		maxPages = conf.MaxPages
+	}
+
+	f, err := os.CreateTemp("", "api*.ndjson")
+	if err != nil {
+		return "", 0, fmt.Errorf("os.Create: %w", err)
+	}
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	fail := func(err error) (string, int64, error) {
+		f.Close()
+		os.Remove(f.Name())
+		return "", 0, err
+	}
+
+	var total int
+	for n := 0; ; n++ {
+		resp, err := c.get(ctx, client, conf, u.String())
+		if err != nil {
+			return fail(err)
+		}
+		var body any
+		dec := json.NewDecoder(resp.Body)
+		dec.UseNumber()
+		err = dec.Decode(&body)
+		header := resp.Header
+		resp.Body.Close()
+		if err != nil {
+			return fail(fmt.Errorf("failed to parse response from %s as JSON: %w", conf.Path, err))
+		}
+
+		records, err := recordsAtPath(body, conf.RecordsPath)
+		if err != nil {
+			return fail(err)
+		}
+
+		// Only pages that return records count towards the limit, since some APIs only signal the end with an empty page
+		if n >= maxPages {
+			if len(records) == 0 {
+				break
+			}
+			if !conf.AllowTruncation {
+				return fail(fmt.Errorf("the API has more than %d pages (increase \"max_pages\" or set \"allow_truncation\" to only ingest the first pages)", maxPages))
+			}
+			c.logger.Warn("https: stopped after reaching the page limit", zap.Int("max_pages", maxPages))
+			break
+		}
+
+		for _, r := range records {
+			if err := enc.Encode(r); err != nil {
+				return fail(err)
+			}
+		}
+		total += len(records)
+
+		// Find the next page
+		if pg == nil {
+			break
+		}
+		switch pg.Type {
+		case "cursor":
+			v, ok, err := lookupPath(body, pg.CursorPath)
+			if err != nil {
+				return fail(err)
+			}
+			cursor := ""
+			if ok && v != nil {
+				cursor = fmt.Sprint(v)
+			}
+			if cursor == "" {
+				return c.finishRecords(f, w, total)
+			}
+			setQuery(u, pg.CursorParam, cursor)
+		case "offset":
+			if len(records) < pg.PageSize {
+				return c.finishRecords(f, w, total)
+			}
+			offset += len(records)
+			setQuery(u, pg.OffsetParam, strconv.Itoa(offset))
+		case "page":
+			if len(records) == 0 {
+				return c.finishRecords(f, w, total)
+			}
+			page++
+			setQuery(u, pg.PageParam, strconv.Itoa(page))
+		case "link":
+			next, ok := nextLink(header)
+			if !ok {
+				return c.finishRecords(f, w, total)
+			}
+			nextURL, err := u.Parse(next)
+			if err != nil {
+				return fail(fmt.Errorf("invalid next link %q: %w", next, err))
+			}
+			if !strings.EqualFold(nextURL.Scheme, scheme) || !strings.EqualFold(nextURL.Host, host) {
+				return fail(fmt.Errorf("next link %q is not on the same scheme and host as %s", next, conf.Path))
+			}
+			u = nextURL
+		}
+	}
+
+	return c.finishRecords(f, w, total)
+}
+
+// finishRecords flushes and closes a file written by fetchRecords.
+func (c *connection) finishRecords(f *os.File, w *bufio.Writer, total int) (string, int64, error) {
+	err := w.Flush()
+	if err == nil && total == 0 {
+		err = errors.New("the API did not return any records")
+	}
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return "", 0, err
+	}
+
+	stat, err := f.Stat()
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return "", 0, err
+	}
+	f.Close()
+
+	return f.Name(), stat.Size(), nil
+}
+
+func setQuery(u *url.URL, key, value string) {
+	q := u.Query()
+	q.Set(key, value)
+	u.RawQuery = q.Encode()
+}
+
+// nextLink returns the URL of the "next" relation in a Link header (RFC 8288).
+func nextLink(header http.Header) (string, bool) {
+	for _, v := range header.Values("Link") {
+		for _, link := range strings.Split(v, ",") {
+			parts := strings.Split(link, ";")
+			target := strings.TrimSpace(parts[0])
+			if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+			for _, param := range parts[1:] {
+				k, v, ok := strings.Cut(strings.TrimSpace(param), "=")
+				if !ok || !strings.EqualFold(strings.TrimSpace(k), "rel") {
+					continue
+				}
+				for _, rel := range strings.Fields(strings.Trim(strings.TrimSpace(v), `"`)) {
+					if strings.EqualFold(rel, "next") {
+						return target[1 : len(target)-1], true
+					}
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+// recordsAtPath returns the records in a response body.
+// If path is empty, the body must be a list of records or a single record.
+// If nothing is found at the path, it returns no records (some APIs omit the records on the last page).
+func recordsAtPath(body any, path string) ([]any, error) {
+	v, ok, err := lookupPath(body, path)
+	if err != nil {
+		return nil, err
+	}
+	if !ok || v == nil {
+		return nil, nil
+	}
+
+	switch v := v.(type) {
+	case []any:
+		return v, nil
+	case map[string]any:
+		if path == "" {
+			return []any{v}, nil
+		}
+	}
+	return nil, fmt.Errorf("the value at records path %q is not a list", path)
+}
+
+// lookupPath returns the value at a simple JSONPath in v.
+// It supports child names and list indexes, such as "$.data.items", "results[0].rows" or "$['data']['next cursor']".
+func lookupPath(v any, path string) (any, bool, error) {
+	keys, err := parsePath(path)
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, k := range keys {
+		switch k := k.(type) {
+		case string:
+			m, ok := v.(map[string]any)
+			if !ok {
+				return nil, false, nil
+			}
+			v, ok = m[k]
+			if !ok {
+				return nil, false, nil
+			}
+		case int:
+			l, ok := v.([]any)
+			if !ok || k < 0 || k >= len(l) {
+				return nil, false, nil
+			}
+			v = l[k]
+		}
+	}
+
+	return v, true, nil
+}
+
+// parsePath parses a path for lookupPath into a list of map keys (strings) and list indexes (ints).
+func parsePath(path string) ([]any, error) {
+	rest := strings.TrimPrefix(strings.TrimSpace(path), "$")
+	var keys []any
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			continue
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid path %q: missing ']'", path)
+			}
+			inner := strings.TrimSpace(rest[1:end])
+			rest = rest[end+1:]
+			if len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0] {
+				keys = append(keys, inner[1:len(inner)-1])
+				continue
+			}
+			idx, err := strconv.Atoi(inner)
+			if err != nil {
+				return nil, fmt.Errorf("invalid path %q: %q is not a list index", path, inner)
+			}
+			keys = append(keys, idx)
+		default:
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			keys = append(keys, rest[:end])
+			rest = rest[end:]
+		}
+	}
+	return keys, nil
+}
@@ -0,0 +1,293 @@
+package https
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestAPIPagination(t *testing.T) {
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		require.Equal(t, "active", q.Get("status"))
+
+		switch r.URL.Path {
+		case "/cursor":
+			next := map[string]string{"": "b", "b": "c", "c": ""}[q.Get("after")]
+			writeJSON(t, w, map[string]any{
+				"data": map[string]any{"items": []any{map[string]any{"id": "cursor-" + q.Get("after")}}},
+				"meta": map[string]any{"next": next},
+			})
+		case "/offset":
+			offset, _ := strconv.Atoi(q.Get("offset"))
+			require.Equal(t, "2", q.Get("limit"))
+			var items []any
+			for i := offset; i < 5 && i < offset+2; i++ {
+				items = append(items, map[string]any{"id": i})
+			}
+			writeJSON(t, w, map[string]any{"results": items})
+		case "/page":
+			page, _ := strconv.Atoi(q.Get("p"))
+			var items []any
+			if page <= 3 {
+				items = append(items, map[string]any{"id": page})
+			}
+			writeJSON(t, w, items)
+		case "/link":
+			page, _ := strconv.Atoi(q.Get("page"))
+			if page < 2 {
+				w.Header().Set("Link", fmt.Sprintf(`<%s/link?status=active&page=%d>; rel="next", <%s/link?page=0>; rel="first"`, srv.URL, page+1, srv.URL))
+			}
+			writeJSON(t, w, map[string]any{"rows": []any{map[string]any{"id": page}}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	tests := []struct {
+		name  string
+		props map[string]any
+		want  []string
+	}{
+		{
+			name: "cursor",
+			props: map[string]any{
+				"path":         srv.URL + "/cursor",
+				"records_path": "$.data.items",
+				"pagination":   map[string]any{"type": "cursor", "cursor_path": "meta.next", "cursor_param": "after"},
+			},
+			want: []string{`{"id":"cursor-"}`, `{"id":"cursor-b"}`, `{"id":"cursor-c"}`},
+		},
+		{
+			name: "offset",
+			props: map[string]any{
+				"path":         srv.URL + "/offset",
+				"records_path": "results",
+				"pagination":   map[string]any{"type": "offset", "page_size": float64(2)},
+			},
+			want: []string{`{"id":0}`, `{"id":1}`, `{"id":2}`, `{"id":3}`, `{"id":4}`},
+		},
+		{
+			name: "page",
+			props: map[string]any{
+				"path":       srv.URL + "/page",
+				"pagination": map[string]any{"type": "page", "page_param": "p"},
+			},
+			want: []string{`{"id":1}`, `{"id":2}`, `{"id":3}`},
+		},
+		{
+			name: "link",
+			props: map[string]any{
+				"path":         srv.URL + "/link",
+				"records_path": "$['rows']",
+				"pagination":   map[string]any{"type": "link"},
+			},
+			want: []string{`{"id":0}`, `{"id":1}`, `{"id":2}`},
+		},
+		{
+			name: "max pages",
+			props: map[string]any{
+				"path":             srv.URL + "/page",
+				"pagination":       map[string]any{"type": "page", "page_param": "p"},
+				"max_pages":        float64(2),
+				"allow_truncation": true,
+			},
+			want: []string{`{"id":1}`, `{"id":2}`},
+		},
+		{
+			name: "exactly max pages",
+			props: map[string]any{
+				"path":       srv.URL + "/page",
+				"pagination": map[string]any{"type": "page", "page_param": "p"},
+				"max_pages":  float64(3),
+			},
+			want: []string{`{"id":1}`, `{"id":2}`, `{"id":3}`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.props["params"] = map[string]any{"status": "active"}
+			require.Equal(t, tt.want, fetchLines(t, tt.props, nil))
+		})
+	}
+
+	// Fails if there are more pages than max_pages and truncation isn't allowed
+	_, err := filePaths(t, map[string]any{
+		"path":       srv.URL + "/page",
+		"params":     map[string]any{"status": "active"},
+		"pagination": map[string]any{"type": "page", "page_param": "p"},
+		"max_pages":  float64(2),
+	}, nil)
+	require.ErrorContains(t, err, "more than 2 pages")
+}
+
+func TestAPILinkOtherHost(t *testing.T) {
+	var otherCalls atomic.Int32
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		otherCalls.Add(1)
+		writeJSON(t, w, []any{map[string]any{"id": 2}})
+	}))
+	defer other.Close()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Link", fmt.Sprintf(`<%s/data?page=2>; rel="next"`, other.URL))
+		writeJSON(t, w, []any{map[string]any{"id": 1}})
+	}))
+	defer srv.Close()
+
+	// The next link isn't followed, since the headers would be sent to another host
+	_, err := filePaths(t, map[string]any{
+		"path":       srv.URL + "/data",
+		"headers":    map[string]any{"Authorization": "Bearer secret"},
+		"pagination": map[string]any{"type": "link"},
+	}, nil)
+	require.ErrorContains(t, err, "not on the same scheme and host")
+	require.Equal(t, int32(0), otherCalls.Load())
+}
+
+func TestAPIRetries(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+		switch r.URL.Path {
+		case "/flaky":
+			if n == 1 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			if n == 2 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			writeJSON(t, w, []any{map[string]any{"id": 1}})
+		case "/down":
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	// Succeeds after retrying a 429 and a 503 response
+	lines := fetchLines(t, map[string]any{"path": srv.URL + "/flaky", "records_path": "$"}, nil)
+	require.Equal(t, []string{`{"id":1}`}, lines)
+	require.Equal(t, int32(3), calls.Load())
+
+	// Fails after the max number of retries
+	calls.Store(0)
+	_, err := filePaths(t, map[string]any{"path": srv.URL + "/down", "records_path": "$", "max_retries": float64(2)}, nil)
+	require.ErrorContains(t, err, "502 Bad Gateway")
+	require.Equal(t, int32(3), calls.Load())
+
+	// Client errors are not retried
+	calls.Store(0)
+	_, err = filePaths(t, map[string]any{"path": srv.URL + "/missing", "records_path": "$"}, nil)
+	require.ErrorContains(t, err, "404 Not Found")
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestAPIOAuth2(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			id, secret, ok := r.BasicAuth()
+			if !ok || id != "client" || secret != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			writeJSON(t, w, map[string]any{"access_token": "token", "token_type": "bearer", "expires_in": 3600})
+		case "/data":
+			if r.Header.Get("Authorization") != "Bearer token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			writeJSON(t, w, map[string]any{"data": []any{map[string]any{"id": 1}}})
+		}
+	}))
+	defer srv.Close()
+
+	props := map[string]any{
+		"path":         srv.URL + "/data",
+		"records_path": "data",
+		"oauth2":       map[string]any{"token_url": srv.URL + "/token", "client_id": "client"},
+	}
+
+	// The client secret is taken from the connector config
+	_, err := filePaths(t, props, nil)
+	require.ErrorContains(t, err, "401")
+	lines := fetchLines(t, props, map[string]any{"oauth2_client_secret": "secret"})
+	require.Equal(t, []string{`{"id":1}`}, lines)
+}
+
+func TestLookupPath(t *testing.T) {
+	var body any
+	require.NoError(t, json.Unmarshal([]byte(`{"a": {"b c": [{"d": 1}, {"d": 2}]}, "e": null}`), &body))
+
+	tests := []struct {
+		path  string
+		want  any
+		found bool
+	}{
+		{path: "", want: body, found: true},
+		{path: "$", want: body, found: true},
+		{path: "$.a['b c'][1].d", want: float64(2), found: true},
+		{path: `a["b c"][0]`, want: map[string]any{"d": float64(1)}, found: true},
+		{path: "$.e", want: nil, found: true},
+		{path: "$.a.x", found: false},
+		{path: "$.a['b c'][5]", found: false},
+	}
+	for _, tt := range tests {
+		v, found, err := lookupPath(body, tt.path)
+		require.NoError(t, err, tt.path)
+		require.Equal(t, tt.found, found, tt.path)
+		require.Equal(t, tt.want, v, tt.path)
+	}
+
+	_, _, err := lookupPath(body, "$.a[x]")
+	require.Error(t, err)
+}
+
+func filePaths(t *testing.T, props, config map[string]any) ([]string, error) {
+	if config == nil {
+		config = map[string]any{}
+	}
+	handle, err := driver{}.Open(config, false, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	fs, ok := handle.AsFileStore()
+	require.True(t, ok)
+	return fs.FilePaths(context.Background(), props)
+}
+
+func fetchLines(t *testing.T, props, config map[string]any) []string {
+	paths, err := filePaths(t, props, config)
+	require.NoError(t, err)
+	require.Len(t, paths, 1)
+	require.True(t, strings.HasSuffix(paths[0], ".ndjson"))
+	defer os.Remove(paths[0])
+
+	data, err := os.ReadFile(paths[0])
+	require.NoError(t, err)
+	return strings.Split(strings.TrimSpace(string(data)), "\n")
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(t, json.NewEncoder(w).Encode(v))
+}
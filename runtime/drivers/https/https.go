@@ -3,7 +3,6 @@ package https
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"net/url"
 	"time"
 
@@ -67,11 +66,33 @@ type sourceProperties struct {
 	Path    string            `mapstructure:"path"`
 	URI     string            `mapstructure:"uri"`
 	Headers map[string]string `mapstructure:"headers"`
+	// Params are added to the query of the URL (templates in their values are resolved by the source reconciler)
+	Params map[string]any `mapstructure:"params"`
+	// RecordsPath is the path of the list of records in a JSON response, such as "$.data.items"
+	RecordsPath string                `mapstructure:"records_path"`
+	Pagination  *paginationProperties `mapstructure:"pagination"`
+	MaxPages    int                   `mapstructure:"max_pages"`
+	// AllowTruncation ingests the first MaxPages pages instead of failing if the API has more pages
+	AllowTruncation bool              `mapstructure:"allow_truncation"`
+	MaxRetries      *int              `mapstructure:"max_retries"`
+	OAuth2          *oauth2Properties `mapstructure:"oauth2"`
+}
+
+// isAPI returns true if the source ingests records from a JSON REST API instead of downloading a single file.
+func (p *sourceProperties) isAPI() bool {
+	return p.RecordsPath != "" || p.Pagination != nil
 }
 
 func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
 	conf := &sourceProperties{}
-	err := mapstructure.Decode(props, conf)
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Result:           conf,
+		WeaklyTypedInput: true, // Numbers in source properties are float64
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = decoder.Decode(props)
 	if err != nil {
 		return nil, err
 	}
@@ -81,6 +102,17 @@ func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
 		conf.Path = conf.URI
 	}
 
+	if conf.Pagination != nil {
+		err = conf.Pagination.validate()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if conf.OAuth2 != nil && conf.OAuth2.TokenURL == "" {
+		return nil, fmt.Errorf(`"oauth2" requires "token_url"`)
+	}
+
 	return conf, nil
 }
 
@@ -162,30 +194,40 @@ func (c *connection) FilePaths(ctx context.Context, src map[string]any) ([]strin
 		return nil, fmt.Errorf("failed to parse config: %w", err)
 	}
 
+	start := time.Now()
+
+	// Records from REST APIs are written to a newline-delimited JSON file
+	if conf.isAPI() {
+		file, size, err := c.fetchRecords(ctx, conf)
+		if err != nil {
+			return nil, err
+		}
+
+		drivers.RecordDownloadMetrics(ctx, &drivers.DownloadMetrics{
+			Connector: "https",
+			Ext:       ".ndjson",
+			Duration:  time.Since(start),
+			Size:      size,
+		})
+
+		return []string{file}, nil
+	}
+
 	extension, err := urlExtension(conf.Path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse path %s, %w", conf.Path, err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, conf.Path, http.NoBody)
+	u, err := requestURL(conf.Path, conf.Params)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch url %s:  %w", conf.Path, err)
-	}
-
-	for k, v := range conf.Headers {
-		req.Header.Set(k, v)
+		return nil, err
 	}
 
-	start := time.Now()
-
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := c.get(ctx, c.httpClient(ctx, conf), conf, u.String())
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch url %s:  %w", conf.Path, err)
+		return nil, err
 	}
 	defer resp.Body.Close()
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("failed to fetch url %s: %s", conf.Path, resp.Status)
-	}
 
 	file, size, err := fileutil.CopyToTempFile(resp.Body, "", extension)
 	if err != nil {
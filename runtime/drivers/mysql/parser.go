@@ -0,0 +1,232 @@
+package mysql
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+)
+
+// typeToMapperMap maps the database type names returned by the go-sql-driver/mysql column types to mappers.
+var typeToMapperMap = make(map[string]mapper)
+
+// mapper converts values returned by the MySQL text protocol to the Go types expected for the mapped runtime type.
+// Except for dates and timestamps, which are parsed by the driver, all values are returned as []byte.
+type mapper interface {
+	runtimeType() *runtimev1.Type
+	value(v any) (any, error)
+}
+
+// refer https://github.com/go-sql-driver/mysql/blob/master/fields.go for the type names
+func init() {
+	typeToMapperMap["BIT"] = &bitMapper{}
+	typeToMapperMap["TINYINT"] = &intMapper{code: runtimev1.Type_CODE_INT8, bitSize: 8}
+	typeToMapperMap["SMALLINT"] = &intMapper{code: runtimev1.Type_CODE_INT16, bitSize: 16}
+	typeToMapperMap["MEDIUMINT"] = &intMapper{code: runtimev1.Type_CODE_INT32, bitSize: 32}
+	typeToMapperMap["INT"] = &intMapper{code: runtimev1.Type_CODE_INT32, bitSize: 32}
+	typeToMapperMap["BIGINT"] = &intMapper{code: runtimev1.Type_CODE_INT64, bitSize: 64}
+	typeToMapperMap["YEAR"] = &intMapper{code: runtimev1.Type_CODE_INT16, bitSize: 16}
+	typeToMapperMap["UNSIGNED TINYINT"] = &uintMapper{code: runtimev1.Type_CODE_UINT8, bitSize: 8}
+	typeToMapperMap["UNSIGNED SMALLINT"] = &uintMapper{code: runtimev1.Type_CODE_UINT16, bitSize: 16}
+	typeToMapperMap["UNSIGNED INT"] = &uintMapper{code: runtimev1.Type_CODE_UINT32, bitSize: 32}
+	typeToMapperMap["UNSIGNED BIGINT"] = &uintMapper{code: runtimev1.Type_CODE_UINT64, bitSize: 64}
+	typeToMapperMap["FLOAT"] = &floatMapper{code: runtimev1.Type_CODE_FLOAT32, bitSize: 32}
+	typeToMapperMap["DOUBLE"] = &floatMapper{code: runtimev1.Type_CODE_FLOAT64, bitSize: 64}
+	typeToMapperMap["DECIMAL"] = &stringMapper{}
+	typeToMapperMap["DATE"] = &timeMapper{code: runtimev1.Type_CODE_DATE}
+	typeToMapperMap["DATETIME"] = &timeMapper{code: runtimev1.Type_CODE_TIMESTAMP}
+	typeToMapperMap["TIMESTAMP"] = &timeMapper{code: runtimev1.Type_CODE_TIMESTAMP}
+	// TIME values are durations that can be negative or exceed 24 hours, so they are kept as strings
+	typeToMapperMap["TIME"] = &stringMapper{}
+	typeToMapperMap["CHAR"] = &stringMapper{}
+	typeToMapperMap["VARCHAR"] = &stringMapper{}
+	typeToMapperMap["TINYTEXT"] = &stringMapper{}
+	typeToMapperMap["TEXT"] = &stringMapper{}
+	typeToMapperMap["MEDIUMTEXT"] = &stringMapper{}
+	typeToMapperMap["LONGTEXT"] = &stringMapper{}
+	typeToMapperMap["ENUM"] = &stringMapper{}
+	typeToMapperMap["SET"] = &stringMapper{}
+	typeToMapperMap["NULL"] = &stringMapper{}
+	typeToMapperMap["BINARY"] = &bytesMapper{}
+	typeToMapperMap["VARBINARY"] = &bytesMapper{}
+	typeToMapperMap["TINYBLOB"] = &bytesMapper{}
+	typeToMapperMap["BLOB"] = &bytesMapper{}
+	typeToMapperMap["MEDIUMBLOB"] = &bytesMapper{}
+	typeToMapperMap["LONGBLOB"] = &bytesMapper{}
+	typeToMapperMap["GEOMETRY"] = &bytesMapper{}
+	typeToMapperMap["JSON"] = &jsonMapper{}
+}
+
+type bitMapper struct{}
+
+func (m *bitMapper) runtimeType() *runtimev1.Type {
+	return &runtimev1.Type{Code: runtimev1.Type_CODE_UINT64}
+}
+
+func (m *bitMapper) value(v any) (any, error) {
+	switch b := v.(type) {
+	case []byte:
+		// BIT(n) values are returned as big-endian bytes of length ceil(n/8)
+		if len(b) > 8 {
+			return nil, fmt.Errorf("bitMapper: value too large")
+		}
+		buf := make([]byte, 8)
+		copy(buf[8-len(b):], b)
+		return binary.BigEndian.Uint64(buf), nil
+	default:
+		return nil, fmt.Errorf("bitMapper: unsupported type %v", b)
+	}
+}
+
+type intMapper struct {
+	code    runtimev1.Type_Code
+	bitSize int
+}
+
+func (m *intMapper) runtimeType() *runtimev1.Type {
+	return &runtimev1.Type{Code: m.code}
+}
+
+func (m *intMapper) value(v any) (any, error) {
+	switch b := v.(type) {
+	case []byte:
+		i, err := strconv.ParseInt(string(b), 10, m.bitSize)
+		if err != nil {
+			return nil, err
+		}
+		switch m.bitSize {
+		case 8:
+			return int8(i), nil
+		case 16:
+			return int16(i), nil
+		case 32:
+			return int32(i), nil
+		default:
+			return i, nil
+		}
+	default:
+		return nil, fmt.Errorf("intMapper: unsupported type %v", b)
+	}
+}
+
+type uintMapper struct {
+	code    runtimev1.Type_Code
+	bitSize int
+}
+
+func (m *uintMapper) runtimeType() *runtimev1.Type {
+	return &runtimev1.Type{Code: m.code}
+}
+
+func (m *uintMapper) value(v any) (any, error) {
+	switch b := v.(type) {
+	case []byte:
+		i, err := strconv.ParseUint(string(b), 10, m.bitSize)
+		if err != nil {
+			return nil, err
+		}
+		switch m.bitSize {
+		case 8:
+			return uint8(i), nil
+		case 16:
+			return uint16(i), nil
+		case 32:
+			return uint32(i), nil
+		default:
+			return i, nil
+		}
+	default:
+		return nil, fmt.Errorf("uintMapper: unsupported type %v", b)
+	}
+}
+
+type floatMapper struct {
+	code    runtimev1.Type_Code
+	bitSize int
+}
+
+func (m *floatMapper) runtimeType() *runtimev1.Type {
+	return &runtimev1.Type{Code: m.code}
+}
+
+func (m *floatMapper) value(v any) (any, error) {
+	switch b := v.(type) {
+	case []byte:
+		f, err := strconv.ParseFloat(string(b), m.bitSize)
+		if err != nil {
+			return nil, err
+		}
+		if m.bitSize == 32 {
+			return float32(f), nil
+		}
+		return f, nil
+	default:
+		return nil, fmt.Errorf("floatMapper: unsupported type %v", b)
+	}
+}
+
+type stringMapper struct{}
+
+func (m *stringMapper) runtimeType() *runtimev1.Type {
+	return &runtimev1.Type{Code: runtimev1.Type_CODE_STRING}
+}
+
+func (m *stringMapper) value(v any) (any, error) {
+	switch b := v.(type) {
+	case []byte:
+		return string(b), nil
+	case string:
+		return b, nil
+	default:
+		return nil, fmt.Errorf("stringMapper: unsupported type %v", b)
+	}
+}
+
+type bytesMapper struct{}
+
+func (m *bytesMapper) runtimeType() *runtimev1.Type {
+	return &runtimev1.Type{Code: runtimev1.Type_CODE_BYTES}
+}
+
+func (m *bytesMapper) value(v any) (any, error) {
+	switch b := v.(type) {
+	case []byte:
+		return b, nil
+	default:
+		return nil, fmt.Errorf("bytesMapper: unsupported type %v", b)
+	}
+}
+
+type timeMapper struct {
+	code runtimev1.Type_Code
+}
+
+func (m *timeMapper) runtimeType() *runtimev1.Type {
+	return &runtimev1.Type{Code: m.code}
+}
+
+func (m *timeMapper) value(v any) (any, error) {
+	switch b := v.(type) {
+	case time.Time:
+		return b, nil
+	default:
+		return nil, fmt.Errorf("timeMapper: unsupported type %v", b)
+	}
+}
+
+type jsonMapper struct{}
+
+func (m *jsonMapper) runtimeType() *runtimev1.Type {
+	return &runtimev1.Type{Code: runtimev1.Type_CODE_JSON}
+}
+
+func (m *jsonMapper) value(v any) (any, error) {
+	switch b := v.(type) {
+	case []byte:
+		return string(b), nil
+	default:
+		return nil, fmt.Errorf("jsonMapper: unsupported type %v", b)
+	}
+}
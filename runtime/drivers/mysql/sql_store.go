@@ -0,0 +1,186 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	sqldriver "database/sql/driver"
+	"errors"
+	"fmt"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/mitchellh/mapstructure"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// Query implements drivers.SQLStore
+func (c *connection) Query(ctx context.Context, props map[string]any) (drivers.RowIterator, error) {
+	srcProps, err := parseSourceProperties(props)
+	if err != nil {
+		return nil, err
+	}
+
+	var dsn string
+	if srcProps.DSN != "" { // get from src properties
+		dsn = srcProps.DSN
+	} else if url, ok := c.config["dsn"].(string); ok && url != "" { // get from driver configs
+		dsn = url
+	} else {
+		return nil, fmt.Errorf("the property 'dsn' is required for MySQL. Provide 'dsn' in the YAML properties or pass '--env connectors.mysql.dsn=...' to 'rill start'")
+	}
+
+	cfg, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+	// the mappers expect dates and timestamps as time.Time
+	cfg.ParseTime = true
+
+	connector, err := mysql.NewConnector(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	db := sql.OpenDB(connector)
+	res, err := db.QueryContext(ctx, srcProps.SQL)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	schema, mappers, err := rowsToSchema(res)
+	if err != nil {
+		res.Close()
+		db.Close()
+		return nil, err
+	}
+
+	vals := make([]any, len(schema.Fields))
+	dest := make([]any, len(schema.Fields))
+	for i := range vals {
+		dest[i] = &vals[i]
+	}
+
+	return &rowIterator{
+		db:           db,
+		rows:         res,
+		schema:       schema,
+		fieldMappers: mappers,
+		vals:         vals,
+		dest:         dest,
+		row:          make([]sqldriver.Value, len(schema.Fields)),
+	}, nil
+}
+
+// QueryAsFiles implements drivers.SQLStore
+func (c *connection) QueryAsFiles(ctx context.Context, props map[string]any, opt *drivers.QueryOption, p drivers.Progress) (drivers.FileIterator, error) {
+	return nil, drivers.ErrNotImplemented
+}
+
+type rowIterator struct {
+	db     *sql.DB
+	rows   *sql.Rows
+	schema *runtimev1.StructType
+
+	vals         []any
+	dest         []any
+	row          []sqldriver.Value
+	fieldMappers []mapper
+}
+
+// Close implements drivers.RowIterator.
+func (r *rowIterator) Close() error {
+	r.rows.Close()
+	return r.db.Close()
+}
+
+// Next implements drivers.RowIterator.
+func (r *rowIterator) Next(ctx context.Context) ([]sqldriver.Value, error) {
+	if !r.rows.Next() {
+		err := r.rows.Err()
+		if err == nil {
+			return nil, drivers.ErrIteratorDone
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("no results found for the query")
+		}
+		return nil, err
+	}
+
+	err := r.rows.Scan(r.dest...)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range r.schema.Fields {
+		mapper := r.fieldMappers[i]
+		if r.vals[i] == nil {
+			r.row[i] = nil
+			continue
+		}
+		r.row[i], err = mapper.value(r.vals[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return r.row, nil
+}
+
+// Schema implements drivers.RowIterator.
+func (r *rowIterator) Schema(ctx context.Context) (*runtimev1.StructType, error) {
+	return r.schema, nil
+}
+
+// Size implements drivers.RowIterator.
+func (r *rowIterator) Size(unit drivers.ProgressUnit) (uint64, bool) {
+	return 0, false
+}
+
+var _ drivers.RowIterator = &rowIterator{}
+
+func rowsToSchema(r *sql.Rows) (*runtimev1.StructType, []mapper, error) {
+	cts, err := r.ColumnTypes()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mappers := make([]mapper, len(cts))
+	fields := make([]*runtimev1.StructType_Field, len(cts))
+	for i, ct := range cts {
+		dt := ct.DatabaseTypeName()
+		mapper, ok := typeToMapperMap[dt]
+		if !ok {
+			return nil, nil, fmt.Errorf("datatype %q is not supported", dt)
+		}
+		mappers[i] = mapper
+
+		t := mapper.runtimeType()
+		if nullable, ok := ct.Nullable(); ok {
+			t.Nullable = nullable
+		}
+		fields[i] = &runtimev1.StructType_Field{
+			Name: ct.Name(),
+			Type: t,
+		}
+	}
+
+	return &runtimev1.StructType{Fields: fields}, mappers, nil
+}
+
+type sourceProperties struct {
+	SQL string `mapstructure:"sql"`
+	DSN string `mapstructure:"dsn"`
+}
+
+func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
+	conf := &sourceProperties{}
+	err := mapstructure.Decode(props, conf)
+	if err != nil {
+		return nil, err
+	}
+	if conf.SQL == "" {
+		return nil, fmt.Errorf("property 'sql' is mandatory for connector \"mysql\"")
+	}
+	return conf, err
+}
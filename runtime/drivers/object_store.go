@@ -1,10 +1,28 @@
 package drivers
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type ObjectStore interface {
 	// DownloadFiles provides an iterator for downloading and consuming files
-	DownloadFiles(ctx context.Context, src map[string]any) (FileIterator, error)
+	DownloadFiles(ctx context.Context, src map[string]any, opts *DownloadOptions) (FileIterator, error)
+}
+
+// DownloadOptions provide optional configuration for ObjectStore.DownloadFiles
+type DownloadOptions struct {
+	// SelectObjects is called with all objects matching the source path before any downloads start.
+	// It returns the subset of objects to download. If nil, all matching objects are downloaded.
+	SelectObjects func(objects []*ObjectInfo) ([]*ObjectInfo, error)
+}
+
+// ObjectInfo describes an object in an object store
+type ObjectInfo struct {
+	Key          string
+	ETag         string
+	LastModified time.Time
+	Size         int64
 }
 
 // FileIterator provides ways to iteratively download files from external sources
@@ -31,6 +31,14 @@ type OLAPStore interface {
 
 	CreateTableAsSelect(ctx context.Context, name string, view bool, sql string) error
 	InsertTableAsSelect(ctx context.Context, name string, byName bool, sql string) error
+	// MergeTableAsSelect inserts the result of sql into the table, replacing existing rows that have the same values for keys
+	MergeTableAsSelect(ctx context.Context, name string, keys []string, sql string) error
+	// SnapshotTableAsSelect maintains a slowly changing dimension (type 2) table with the result of sql.
+	// It creates the table if it doesn't exist. Otherwise, changed and deleted rows are closed by setting their valid_to column,
+	// and new versions of rows are inserted with their valid_from column set.
+	SnapshotTableAsSelect(ctx context.Context, name string, opts *SnapshotOptions, sql string) error
+	// DeleteFromTable deletes the rows matching the where clause from the table
+	DeleteFromTable(ctx context.Context, name, where string, args ...any) error
 	DropTable(ctx context.Context, name string, view bool) error
 	// RenameTable is force rename
 	RenameTable(ctx context.Context, name, newName string, view bool) error
@@ -38,6 +46,16 @@ type OLAPStore interface {
 	AlterTableColumn(ctx context.Context, tableName, columnName string, newType string) error
 }
 
+// SnapshotOptions configures how SnapshotTableAsSelect detects changed rows.
+type SnapshotOptions struct {
+	// UniqueKey are the columns that identify a row across snapshots
+	UniqueKey []string
+	// UpdatedAt is a column that increases when a row changes. If set, it's also used as the row's valid_from time.
+	UpdatedAt string
+	// CheckColumns are compared to detect changed rows if UpdatedAt is not set
+	CheckColumns []string
+}
+
 // Statement wraps a query to execute against an OLAP driver.
 type Statement struct {
 	Query            string
@@ -102,6 +120,7 @@ const (
 	DialectUnspecified Dialect = iota
 	DialectDuckDB
 	DialectDruid
+	DialectClickHouse
 )
 
 func (d Dialect) String() string {
@@ -112,6 +131,8 @@ func (d Dialect) String() string {
 		return "duckdb"
 	case DialectDruid:
 		return "druid"
+	case DialectClickHouse:
+		return "clickhouse"
 	default:
 		panic("not implemented")
 	}
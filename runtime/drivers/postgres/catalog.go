@@ -0,0 +1,266 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"google.golang.org/protobuf/proto"
+)
+
+// catalogStore implements drivers.CatalogStore for a single instance.
+// All instances share the same tables, so every query is scoped by instance ID.
+type catalogStore struct {
+	*connection
+	instanceID string
+}
+
+var _ drivers.CatalogStore = &catalogStore{}
+
+func (c *catalogStore) FindEntries(ctx context.Context, typ drivers.ObjectType) ([]*drivers.CatalogEntry, error) {
+	if typ == drivers.ObjectTypeUnspecified {
+		return c.findEntries(ctx, "")
+	}
+	return c.findEntries(ctx, "AND type = $2", typ)
+}
+
+func (c *catalogStore) FindEntry(ctx context.Context, name string) (*drivers.CatalogEntry, error) {
+	// Names are stored with case everywhere, but the checks should be case-insensitive.
+	// Hence, the translation to lower case here.
+	es, err := c.findEntries(ctx, "AND lower(name) = lower($2)", name)
+	if err != nil {
+		return nil, err
+	}
+	if len(es) == 0 {
+		return nil, drivers.ErrNotFound
+	}
+	return es[0], nil
+}
+
+func (c *catalogStore) findEntries(ctx context.Context, whereClause string, args ...any) ([]*drivers.CatalogEntry, error) {
+	sql := fmt.Sprintf("SELECT name, type, object, path, bytes_ingested, embedded, created_on, updated_on, refreshed_on FROM catalog WHERE instance_id = $1 %s ORDER BY lower(name)", whereClause)
+	args = append([]any{c.instanceID}, args...)
+
+	rows, err := c.db.QueryxContext(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*drivers.CatalogEntry
+	for rows.Next() {
+		var objBlob []byte
+		e := &drivers.CatalogEntry{}
+
+		err := rows.Scan(&e.Name, &e.Type, &objBlob, &e.Path, &e.BytesIngested, &e.Embedded, &e.CreatedOn, &e.UpdatedOn, &e.RefreshedOn)
+		if err != nil {
+			return nil, err
+		}
+
+		// Parse object protobuf
+		if objBlob != nil {
+			switch e.Type {
+			case drivers.ObjectTypeTable:
+				e.Object = &runtimev1.Table{}
+			case drivers.ObjectTypeSource:
+				e.Object = &runtimev1.Source{}
+			case drivers.ObjectTypeModel:
+				e.Object = &runtimev1.Model{}
+			case drivers.ObjectTypeMetricsView:
+				e.Object = &runtimev1.MetricsView{}
+			default:
+				return nil, fmt.Errorf("unexpected object type: %v", e.Type)
+			}
+
+			err = proto.Unmarshal(objBlob, e.Object)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		res = append(res, e)
+	}
+
+	return res, rows.Err()
+}
+
+func (c *catalogStore) CreateEntry(ctx context.Context, e *drivers.CatalogEntry) error {
+	// Serialize object
+	obj, err := proto.Marshal(e.Object)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	_, err = c.db.ExecContext(
+		ctx,
+		"INSERT INTO catalog(instance_id, name, type, object, path, bytes_ingested, embedded, created_on, updated_on, refreshed_on) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $8, $8)",
+		c.instanceID,
+		e.Name,
+		e.Type,
+		obj,
+		e.Path,
+		e.BytesIngested,
+		e.Embedded,
+		now,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("catalog entry with name %q already exists", e.Name)
+		}
+		return err
+	}
+
+	e.CreatedOn = now
+	e.UpdatedOn = now
+	e.RefreshedOn = now
+	return nil
+}
+
+func (c *catalogStore) UpdateEntry(ctx context.Context, e *drivers.CatalogEntry) error {
+	// Serialize object
+	obj, err := proto.Marshal(e.Object)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.ExecContext(
+		ctx,
+		"UPDATE catalog SET type = $3, object = $4, path = $5, bytes_ingested = $6, embedded = $7, updated_on = $8, refreshed_on = $9 WHERE instance_id = $1 AND lower(name) = lower($2)",
+		c.instanceID,
+		e.Name,
+		e.Type,
+		obj,
+		e.Path,
+		e.BytesIngested,
+		e.Embedded,
+		e.UpdatedOn, // TODO: Use time.Now()
+		e.RefreshedOn,
+	)
+	return err
+}
+
+func (c *catalogStore) DeleteEntry(ctx context.Context, name string) error {
+	_, err := c.db.ExecContext(ctx, "DELETE FROM catalog WHERE instance_id = $1 AND lower(name) = lower($2)", c.instanceID, name)
+	return err
+}
+
+func (c *catalogStore) DeleteEntries(ctx context.Context) error {
+	_, err := c.db.ExecContext(ctx, "DELETE FROM catalog WHERE instance_id = $1", c.instanceID)
+	return err
+}
+
+func (c *catalogStore) NextControllerVersion(ctx context.Context) (int64, error) {
+	var version int64
+	err := c.db.QueryRowxContext(
+		ctx,
+		"INSERT INTO controller_versions(instance_id, version) VALUES ($1, 1) "+
+			"ON CONFLICT(instance_id) DO UPDATE SET version = controller_versions.version + 1 RETURNING version",
+		c.instanceID,
+	).Scan(&version)
+	if err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+func (c *catalogStore) CheckControllerVersion(ctx context.Context, v int64) error {
+	var version int64
+	err := c.db.QueryRowxContext(ctx, "SELECT version FROM controller_versions WHERE instance_id = $1", c.instanceID).Scan(&version)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	if version != v {
+		return drivers.ErrInconsistentControllerVersion
+	}
+
+	return nil
+}
+
+func (c *catalogStore) FindResources(ctx context.Context) ([]drivers.Resource, error) {
+	rows, err := c.db.QueryxContext(ctx, "SELECT kind, name, data FROM catalogv2 WHERE instance_id = $1 ORDER BY kind, name", c.instanceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []drivers.Resource
+	for rows.Next() {
+		r := drivers.Resource{}
+		err := rows.Scan(&r.Kind, &r.Name, &r.Data)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, r)
+	}
+
+	return res, rows.Err()
+}
+
+func (c *catalogStore) CreateResource(ctx context.Context, v int64, r drivers.Resource) error {
+	err := c.CheckControllerVersion(ctx, v)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.ExecContext(
+		ctx,
+		"INSERT INTO catalogv2(instance_id, kind, name, data, created_on, updated_on) VALUES ($1, $2, $3, $4, now(), now())",
+		c.instanceID,
+		r.Kind,
+		r.Name,
+		r.Data,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("catalog entry for kind=%q, name=%q already exists", r.Kind, r.Name)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (c *catalogStore) UpdateResource(ctx context.Context, v int64, r drivers.Resource) error {
+	err := c.CheckControllerVersion(ctx, v)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.ExecContext(
+		ctx,
+		"UPDATE catalogv2 SET data = $4, updated_on = now() WHERE instance_id = $1 AND kind = $2 AND name = $3",
+		c.instanceID,
+		r.Kind,
+		r.Name,
+		r.Data,
+	)
+	return err
+}
+
+func (c *catalogStore) DeleteResource(ctx context.Context, v int64, k, n string) error {
+	err := c.CheckControllerVersion(ctx, v)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.ExecContext(ctx, "DELETE FROM catalogv2 WHERE instance_id = $1 AND kind = $2 AND name = $3", c.instanceID, k, n)
+	return err
+}
+
+func (c *catalogStore) DeleteResources(ctx context.Context) error {
+	_, err := c.db.ExecContext(ctx, "DELETE FROM catalogv2 WHERE instance_id = $1", c.instanceID)
+	return err
+}
+
+// isUniqueViolation returns true if the error is a Postgres unique constraint violation.
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
@@ -0,0 +1,60 @@
+CREATE TABLE instances (
+	id TEXT PRIMARY KEY,
+	olap_connector TEXT NOT NULL,
+	repo_connector TEXT NOT NULL,
+	embed_catalog BOOLEAN NOT NULL,
+	ingestion_limit_bytes BIGINT DEFAULT 0 NOT NULL,
+	variables JSONB DEFAULT '{}'::JSONB NOT NULL,
+	project_variables JSONB DEFAULT '{}'::JSONB NOT NULL,
+	annotations JSONB DEFAULT '{}'::JSONB NOT NULL,
+	connectors JSONB DEFAULT '[]'::JSONB NOT NULL,
+	project_connectors JSONB DEFAULT '[]'::JSONB NOT NULL,
+	created_on TIMESTAMPTZ DEFAULT now() NOT NULL,
+	updated_on TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+CREATE TABLE runtime_nodes (
+	id TEXT PRIMARY KEY,
+	address TEXT NOT NULL,
+	heartbeat_on TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE instance_leases (
+	instance_id TEXT PRIMARY KEY,
+	node_id TEXT NOT NULL,
+	version BIGINT NOT NULL,
+	acquired_on TIMESTAMPTZ NOT NULL,
+	expires_on TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX instance_leases_node_id_idx ON instance_leases (node_id);
+
+CREATE TABLE catalog (
+	instance_id TEXT NOT NULL,
+	name TEXT NOT NULL,
+	type INTEGER NOT NULL,
+	object BYTEA NOT NULL,
+	path TEXT NOT NULL,
+	embedded BOOLEAN DEFAULT FALSE NOT NULL,
+	bytes_ingested BIGINT DEFAULT 0 NOT NULL,
+	created_on TIMESTAMPTZ NOT NULL,
+	updated_on TIMESTAMPTZ NOT NULL,
+	refreshed_on TIMESTAMPTZ NOT NULL
+);
+
+CREATE UNIQUE INDEX catalog_instance_id_lower_name_idx ON catalog (instance_id, lower(name));
+
+CREATE TABLE catalogv2 (
+	instance_id TEXT NOT NULL,
+	kind TEXT NOT NULL,
+	name TEXT NOT NULL,
+	data BYTEA NOT NULL,
+	created_on TIMESTAMPTZ NOT NULL,
+	updated_on TIMESTAMPTZ NOT NULL,
+	PRIMARY KEY (instance_id, kind, name)
+);
+
+CREATE TABLE controller_versions (
+	instance_id TEXT PRIMARY KEY,
+	version BIGINT NOT NULL
+);
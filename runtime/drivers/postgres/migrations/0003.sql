@@ -0,0 +1 @@
+ALTER TABLE runtime_nodes ADD COLUMN http_address TEXT NOT NULL DEFAULT '';
@@ -3,9 +3,14 @@ package postgres
 import (
 	"context"
 
+	"github.com/XSAM/otelsql"
+	"github.com/jmoiron/sqlx"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/activity"
 	"go.uber.org/zap"
+
+	// Load pgx driver for database/sql
+	_ "github.com/jackc/pgx/v5/stdlib"
 )
 
 func init() {
@@ -46,10 +51,21 @@ var spec = drivers.Spec{
 type driver struct{}
 
 func (d driver) Open(config map[string]any, shared bool, client activity.Client, logger *zap.Logger) (drivers.Handle, error) {
-	// actual db connection is opened during query
-	return &connection{
+	// When used as a source connector, the actual db connection is opened during query
+	conn := &connection{
 		config: config,
-	}, nil
+	}
+
+	// When a "dsn" is configured, the database is used as a metadata store (registry and catalog)
+	if dsn, ok := config["dsn"].(string); ok && dsn != "" {
+		db, err := otelsql.Open("pgx/v5", dsn)
+		if err != nil {
+			return nil, err
+		}
+		conn.db = sqlx.NewDb(db, "pgx")
+	}
+
+	return conn, nil
 }
 
 func (d driver) Drop(config map[string]any, logger *zap.Logger) error {
@@ -70,16 +86,8 @@ func (d driver) TertiarySourceConnectors(ctx context.Context, src map[string]any
 
 type connection struct {
 	config map[string]any
-}
-
-// Migrate implements drivers.Connection.
-func (c *connection) Migrate(ctx context.Context) (err error) {
-	return nil
-}
-
-// MigrationStatus implements drivers.Handle.
-func (c *connection) MigrationStatus(ctx context.Context) (current, desired int, err error) {
-	return 0, 0, nil
+	// db is the metadata store connection. It's nil unless a "dsn" is configured.
+	db *sqlx.DB
 }
 
 // Driver implements drivers.Connection.
@@ -94,17 +102,26 @@ func (c *connection) Config() map[string]any {
 
 // Close implements drivers.Connection.
 func (c *connection) Close() error {
+	if c.db != nil {
+		return c.db.Close()
+	}
 	return nil
 }
 
 // Registry implements drivers.Connection.
 func (c *connection) AsRegistry() (drivers.RegistryStore, bool) {
-	return nil, false
+	if c.db == nil {
+		return nil, false
+	}
+	return c, true
 }
 
 // Catalog implements drivers.Connection.
 func (c *connection) AsCatalogStore(instanceID string) (drivers.CatalogStore, bool) {
-	return nil, false
+	if c.db == nil {
+		return nil, false
+	}
+	return &catalogStore{connection: c, instanceID: instanceID}, true
 }
 
 // Repo implements drivers.Connection.
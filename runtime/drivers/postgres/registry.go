@@ -0,0 +1,266 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// FindInstances implements drivers.RegistryStore.
+func (c *connection) FindInstances(ctx context.Context) ([]*drivers.Instance, error) {
+	return c.findInstances(ctx, "")
+}
+
+// FindInstance implements drivers.RegistryStore.
+func (c *connection) FindInstance(ctx context.Context, id string) (*drivers.Instance, error) {
+	is, err := c.findInstances(ctx, "WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(is) == 0 {
+		return nil, drivers.ErrNotFound
+	}
+	return is[0], nil
+}
+
+func (c *connection) findInstances(ctx context.Context, whereClause string, args ...any) ([]*drivers.Instance, error) {
+	sql := fmt.Sprintf("SELECT id, olap_connector, repo_connector, embed_catalog, created_on, updated_on, variables, project_variables, ingestion_limit_bytes, annotations, connectors, project_connectors, query_limits FROM instances %s ORDER BY id", whereClause)
+
+	rows, err := c.db.QueryxContext(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*drivers.Instance
+	for rows.Next() {
+		// JSONB columns are read as bytes and unmarshaled
+		var variables, projectVariables, annotations, connectors, projectConnectors, queryLimits []byte
+		i := &drivers.Instance{}
+		err := rows.Scan(&i.ID, &i.OLAPConnector, &i.RepoConnector, &i.EmbedCatalog, &i.CreatedOn, &i.UpdatedOn, &variables, &projectVariables, &i.IngestionLimitBytes, &annotations, &connectors, &projectConnectors, &queryLimits)
+		if err != nil {
+			return nil, err
+		}
+
+		i.Variables, err = mapFromJSON(variables)
+		if err != nil {
+			return nil, err
+		}
+
+		i.ProjectVariables, err = mapFromJSON(projectVariables)
+		if err != nil {
+			return nil, err
+		}
+
+		i.Annotations, err = mapFromJSON(annotations)
+		if err != nil {
+			return nil, err
+		}
+
+		i.Connectors, err = unmarshalConnectors(connectors)
+		if err != nil {
+			return nil, err
+		}
+
+		i.ProjectConnectors, err = unmarshalConnectors(projectConnectors)
+		if err != nil {
+			return nil, err
+		}
+
+		i.QueryLimits, err = unmarshalQueryLimits(queryLimits)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, i)
+	}
+
+	return res, rows.Err()
+}
+
+// CreateInstance implements drivers.RegistryStore.
+func (c *connection) CreateInstance(ctx context.Context, inst *drivers.Instance) error {
+	if inst.ID == "" {
+		inst.ID = uuid.NewString()
+	}
+
+	variables, err := mapToJSON(inst.Variables)
+	if err != nil {
+		return err
+	}
+
+	projectVariables, err := mapToJSON(inst.ProjectVariables)
+	if err != nil {
+		return err
+	}
+
+	annotations, err := mapToJSON(inst.Annotations)
+	if err != nil {
+		return err
+	}
+
+	connectors, err := connectorsToJSON(inst.Connectors)
+	if err != nil {
+		return err
+	}
+
+	projectConnectors, err := connectorsToJSON(inst.ProjectConnectors)
+	if err != nil {
+		return err
+	}
+
+	queryLimits, err := json.Marshal(inst.QueryLimits)
+	if err != nil {
+		return err
+	}
+
+	err = c.db.QueryRowxContext(
+		ctx,
+		"INSERT INTO instances(id, olap_connector, repo_connector, embed_catalog, variables, project_variables, ingestion_limit_bytes, annotations, connectors, project_connectors, query_limits) "+
+			"VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING created_on, updated_on",
+		inst.ID,
+		inst.OLAPConnector,
+		inst.RepoConnector,
+		inst.EmbedCatalog,
+		variables,
+		projectVariables,
+		inst.IngestionLimitBytes,
+		annotations,
+		connectors,
+		projectConnectors,
+		string(queryLimits),
+	).Scan(&inst.CreatedOn, &inst.UpdatedOn)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// EditInstance implements drivers.RegistryStore.
+func (c *connection) EditInstance(ctx context.Context, inst *drivers.Instance) error {
+	variables, err := mapToJSON(inst.Variables)
+	if err != nil {
+		return err
+	}
+
+	projectVariables, err := mapToJSON(inst.ProjectVariables)
+	if err != nil {
+		return err
+	}
+
+	annotations, err := mapToJSON(inst.Annotations)
+	if err != nil {
+		return err
+	}
+
+	connectors, err := connectorsToJSON(inst.Connectors)
+	if err != nil {
+		return err
+	}
+
+	projectConnectors, err := connectorsToJSON(inst.ProjectConnectors)
+	if err != nil {
+		return err
+	}
+
+	queryLimits, err := json.Marshal(inst.QueryLimits)
+	if err != nil {
+		return err
+	}
+
+	err = c.db.QueryRowxContext(
+		ctx,
+		"UPDATE instances SET olap_connector = $2, repo_connector = $3, embed_catalog = $4, variables = $5, project_variables = $6, updated_on = now(), ingestion_limit_bytes = $7, annotations = $8, connectors = $9, project_connectors = $10, query_limits = $11 "+
+			"WHERE id = $1 RETURNING updated_on",
+		inst.ID,
+		inst.OLAPConnector,
+		inst.RepoConnector,
+		inst.EmbedCatalog,
+		variables,
+		projectVariables,
+		inst.IngestionLimitBytes,
+		annotations,
+		connectors,
+		projectConnectors,
+		string(queryLimits),
+	).Scan(&inst.UpdatedOn)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteInstance implements drivers.RegistryStore.
+func (c *connection) DeleteInstance(ctx context.Context, id string) error {
+	tx, err := c.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM instances WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+
+	// Delete the instance's leases and catalog, which are stored in the same database
+	for _, table := range []string{"instance_leases", "catalog", "catalogv2", "controller_versions"} {
+		_, err = tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE instance_id = $1", table), id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// mapToJSON serializes a map for a JSONB column.
+// It returns a string because pgx would otherwise encode a []byte as binary data.
+func mapToJSON(data map[string]string) (string, error) {
+	if data == nil {
+		return "{}", nil
+	}
+	res, err := json.Marshal(data)
+	return string(res), err
+}
+
+func mapFromJSON(data []byte) (map[string]string, error) {
+	if len(data) == 0 {
+		return map[string]string{}, nil
+	}
+	var m map[string]string
+	err := json.Unmarshal(data, &m)
+	return m, err
+}
+
+func connectorsToJSON(defs []*runtimev1.Connector) (string, error) {
+	if defs == nil {
+		return "[]", nil
+	}
+	res, err := json.Marshal(defs)
+	return string(res), err
+}
+
+func unmarshalConnectors(s []byte) ([]*runtimev1.Connector, error) {
+	if len(s) == 0 {
+		return make([]*runtimev1.Connector, 0), nil
+	}
+	var defs []*runtimev1.Connector
+	err := json.Unmarshal(s, &defs)
+	return defs, err
+}
+
+func unmarshalQueryLimits(s []byte) (*runtimev1.QueryLimits, error) {
+	if len(s) == 0 {
+		return nil, nil
+	}
+	var limits *runtimev1.QueryLimits
+	err := json.Unmarshal(s, &limits)
+	return limits, err
+}
@@ -14,6 +14,21 @@ type RegistryStore interface {
 	CreateInstance(ctx context.Context, instance *Instance) error
 	DeleteInstance(ctx context.Context, id string) error
 	EditInstance(ctx context.Context, instance *Instance) error
+
+	// UpsertNode registers a node or records a heartbeat for it.
+	UpsertNode(ctx context.Context, node *Node) error
+	// FindNodes returns all registered nodes, including nodes that have stopped sending heartbeats.
+	FindNodes(ctx context.Context) ([]*Node, error)
+	// DeleteNode removes a node and releases all instance leases held by it.
+	DeleteNode(ctx context.Context, id string) error
+	// FindInstanceLease returns the current lease for an instance. It returns ErrNotFound if the instance has never been leased.
+	FindInstanceLease(ctx context.Context, instanceID string) (*InstanceLease, error)
+	// AcquireInstanceLease acquires or renews the lease for an instance on behalf of a node.
+	// It only succeeds if the lease is not held by another node or has expired.
+	// It returns the current lease, so callers must check the lease's NodeID to determine if they hold it.
+	AcquireInstanceLease(ctx context.Context, instanceID, nodeID string, ttl time.Duration) (*InstanceLease, error)
+	// ReleaseInstanceLease releases an instance lease if it's held by the node.
+	ReleaseInstanceLease(ctx context.Context, instanceID, nodeID string) error
 }
 
 // Instance represents a single data project, meaning one OLAP connection, one repo connection,
@@ -44,6 +59,9 @@ type Instance struct {
 	// IngestionLimitBytes is total data allowed to ingest across all sources
 	// 0 means there is no limit
 	IngestionLimitBytes int64 `db:"ingestion_limit_bytes"`
+	// QueryLimits are guardrails for the cost and concurrency of queries against the instance.
+	// nil means there are no limits.
+	QueryLimits *runtimev1.QueryLimits `db:"query_limits"`
 	// Annotations to enrich activity events (like usage tracking)
 	Annotations map[string]string
 }
@@ -62,3 +80,35 @@ func (i *Instance) ResolveVariables() map[string]string {
 	}
 	return r
 }
+
+// Node represents a runtime process that shares the registry with other runtime processes.
+type Node struct {
+	// ID uniquely identifies the node
+	ID string
+	// Address is the gRPC address at which other nodes can reach the node
+	Address string
+	// HTTPAddress is the address of the node's HTTP server (empty if it doesn't serve HTTP)
+	HTTPAddress string
+	// HeartbeatOn is when the node last reported that it's alive
+	HeartbeatOn time.Time
+}
+
+// InstanceLease grants a node exclusive ownership of an instance's controller until it expires.
+// Requests that need the instance's controller should be routed to the node holding the lease.
+type InstanceLease struct {
+	InstanceID string
+	NodeID     string
+	// NodeAddress is the address of the node holding the lease (empty if the node has not registered itself)
+	NodeAddress string
+	// NodeHTTPAddress is the HTTP address of the node holding the lease (empty if unknown)
+	NodeHTTPAddress string
+	// Version is incremented every time the lease changes owner. It can be used as a fencing token.
+	Version    int64
+	AcquiredOn time.Time
+	ExpiresOn  time.Time
+}
+
+// Expired returns true if the lease has expired and can be acquired by another node.
+func (l *InstanceLease) Expired() bool {
+	return !time.Now().Before(l.ExpiresOn)
+}
@@ -30,6 +30,10 @@ func testRegistry(t *testing.T, reg drivers.RegistryStore) {
 				Config: map[string]string{"dsn": ":memory:"},
 			},
 		},
+		QueryLimits: &runtimev1.QueryLimits{
+			MaxScannedRows:              1000,
+			MaxConcurrentQueriesPerUser: 2,
+		},
 	}
 
 	err := reg.CreateInstance(ctx, inst)
@@ -49,6 +53,15 @@ func testRegistry(t *testing.T, reg drivers.RegistryStore) {
 	require.Equal(t, inst.EmbedCatalog, res.EmbedCatalog)
 	require.Equal(t, inst.IngestionLimitBytes, res.IngestionLimitBytes)
 	require.ElementsMatch(t, inst.Connectors, res.Connectors)
+	require.Equal(t, int64(1000), res.QueryLimits.MaxScannedRows)
+	require.Equal(t, int64(2), res.QueryLimits.MaxConcurrentQueriesPerUser)
+
+	inst.QueryLimits = nil
+	err = reg.EditInstance(ctx, inst)
+	require.NoError(t, err)
+	res, err = reg.FindInstance(ctx, inst.ID)
+	require.NoError(t, err)
+	require.Nil(t, res.QueryLimits)
 
 	err = reg.CreateInstance(ctx, &drivers.Instance{OLAPConnector: "druid"})
 	require.NoError(t, err)
@@ -67,3 +80,75 @@ func testRegistry(t *testing.T, reg drivers.RegistryStore) {
 	require.NoError(t, err)
 	require.Equal(t, 1, len(insts))
 }
+
+func testRegistryLeases(t *testing.T, reg drivers.RegistryStore) {
+	ctx := context.Background()
+
+	err := reg.UpsertNode(ctx, &drivers.Node{ID: "node1", Address: "localhost:9091", HTTPAddress: "localhost:8081"})
+	require.NoError(t, err)
+	err = reg.UpsertNode(ctx, &drivers.Node{ID: "node2", Address: "localhost:9092"})
+	require.NoError(t, err)
+
+	nodes, err := reg.FindNodes(ctx)
+	require.NoError(t, err)
+	require.Len(t, nodes, 2)
+	require.Equal(t, "localhost:9091", nodes[0].Address)
+	require.Equal(t, "localhost:8081", nodes[0].HTTPAddress)
+	require.Equal(t, "", nodes[1].HTTPAddress)
+	require.Greater(t, time.Minute, time.Since(nodes[0].HeartbeatOn))
+
+	_, err = reg.FindInstanceLease(ctx, "inst")
+	require.ErrorIs(t, err, drivers.ErrNotFound)
+
+	// Node 1 acquires the lease
+	l, err := reg.AcquireInstanceLease(ctx, "inst", "node1", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, "node1", l.NodeID)
+	require.Equal(t, "localhost:9091", l.NodeAddress)
+	require.Equal(t, "localhost:8081", l.NodeHTTPAddress)
+	require.Equal(t, int64(1), l.Version)
+	require.False(t, l.Expired())
+
+	// Node 2 can't acquire it while it's held by node 1
+	l, err = reg.AcquireInstanceLease(ctx, "inst", "node2", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, "node1", l.NodeID)
+
+	// Node 1 renews the lease without bumping the version
+	l, err = reg.AcquireInstanceLease(ctx, "inst", "node1", time.Millisecond)
+	require.NoError(t, err)
+	require.Equal(t, "node1", l.NodeID)
+	require.Equal(t, int64(1), l.Version)
+
+	// Node 2 takes over after the lease expires
+	time.Sleep(10 * time.Millisecond)
+	l, err = reg.AcquireInstanceLease(ctx, "inst", "node2", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, "node2", l.NodeID)
+	require.Equal(t, "localhost:9092", l.NodeAddress)
+	require.Equal(t, int64(2), l.Version)
+
+	// Releasing a lease held by another node is a no-op
+	err = reg.ReleaseInstanceLease(ctx, "inst", "node1")
+	require.NoError(t, err)
+	l, err = reg.FindInstanceLease(ctx, "inst")
+	require.NoError(t, err)
+	require.False(t, l.Expired())
+
+	// Deleting a node releases its leases
+	err = reg.DeleteNode(ctx, "node2")
+	require.NoError(t, err)
+	l, err = reg.FindInstanceLease(ctx, "inst")
+	require.NoError(t, err)
+	require.True(t, l.Expired())
+	require.Equal(t, "", l.NodeAddress)
+
+	l, err = reg.AcquireInstanceLease(ctx, "inst", "node1", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, "node1", l.NodeID)
+	require.Equal(t, int64(3), l.Version)
+
+	nodes, err = reg.FindNodes(ctx)
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+}
@@ -225,8 +225,12 @@ type sourceProperties struct {
 	S3Endpoint            string         `mapstructure:"endpoint"`
 	Extract               map[string]any `mapstructure:"extract"`
 	BatchSize             string         `mapstructure:"batch_size"`
+	Format                string         `mapstructure:"format"`
+	Version               *int64         `mapstructure:"version"`
+	SnapshotID            *int64         `mapstructure:"snapshot_id"`
 	url                   *globutil.URL
 	extractPolicy         *rillblob.ExtractPolicy
+	tableFormat           *rillblob.TableFormat
 }
 
 func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
@@ -260,6 +264,11 @@ func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
 		return nil, fmt.Errorf("failed to parse extract config: %w", err)
 	}
 
+	conf.tableFormat, err = rillblob.ParseTableFormat(conf.Format, conf.Version, conf.SnapshotID)
+	if err != nil {
+		return nil, err
+	}
+
 	return conf, nil
 }
 
@@ -271,7 +280,7 @@ func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
 //   - aws_session_token
 //
 // Additionally in case allow_host_credentials is true it looks for credentials stored on host machine as well
-func (c *Connection) DownloadFiles(ctx context.Context, src map[string]any) (drivers.FileIterator, error) {
+func (c *Connection) DownloadFiles(ctx context.Context, src map[string]any, downloadOpts *drivers.DownloadOptions) (drivers.FileIterator, error) {
 	conf, err := parseSourceProperties(src)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse config: %w", err)
@@ -299,8 +308,12 @@ func (c *Connection) DownloadFiles(ctx context.Context, src map[string]any) (dri
 		GlobPageSize:          conf.GlobPageSize,
 		GlobPattern:           conf.url.Path,
 		ExtractPolicy:         conf.extractPolicy,
+		TableFormat:           conf.tableFormat,
 		BatchSizeBytes:        int64(batchSize.Bytes()),
 	}
+	if downloadOpts != nil {
+		opts.SelectObjects = downloadOpts.SelectObjects
+	}
 
 	it, err := rillblob.NewIterator(ctx, bucketObj, opts, c.logger)
 	if err != nil {
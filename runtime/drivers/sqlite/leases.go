@@ -0,0 +1,151 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// NOTE: Timestamps are always written in UTC because SQLite compares them as strings.
+
+// UpsertNode implements drivers.RegistryStore.
+func (c *connection) UpsertNode(_ context.Context, node *drivers.Node) error {
+	// Override ctx because sqlite sometimes segfaults on context cancellation
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	_, err := c.db.ExecContext(
+		ctx,
+		"INSERT INTO runtime_nodes(id, address, http_address, heartbeat_on) VALUES ($1, $2, $3, $4) "+
+			"ON CONFLICT(id) DO UPDATE SET address = excluded.address, http_address = excluded.http_address, heartbeat_on = excluded.heartbeat_on",
+		node.ID,
+		node.Address,
+		node.HTTPAddress,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+
+	node.HeartbeatOn = now
+	return nil
+}
+
+// FindNodes implements drivers.RegistryStore.
+func (c *connection) FindNodes(_ context.Context) ([]*drivers.Node, error) {
+	// Override ctx because sqlite sometimes segfaults on context cancellation
+	ctx := context.Background()
+
+	rows, err := c.db.QueryxContext(ctx, "SELECT id, address, http_address, heartbeat_on FROM runtime_nodes ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*drivers.Node
+	for rows.Next() {
+		n := &drivers.Node{}
+		err := rows.Scan(&n.ID, &n.Address, &n.HTTPAddress, &n.HeartbeatOn)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, n)
+	}
+
+	return res, rows.Err()
+}
+
+// DeleteNode implements drivers.RegistryStore.
+func (c *connection) DeleteNode(_ context.Context, id string) error {
+	// Override ctx because sqlite sometimes segfaults on context cancellation
+	ctx := context.Background()
+
+	tx, err := c.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	now := time.Now().UTC()
+	_, err = tx.ExecContext(ctx, "UPDATE instance_leases SET expires_on = $2 WHERE node_id = $1 AND expires_on > $2", id, now)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM runtime_nodes WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// FindInstanceLease implements drivers.RegistryStore.
+func (c *connection) FindInstanceLease(_ context.Context, instanceID string) (*drivers.InstanceLease, error) {
+	// Override ctx because sqlite sometimes segfaults on context cancellation
+	ctx := context.Background()
+
+	l := &drivers.InstanceLease{}
+	err := c.db.QueryRowxContext(
+		ctx,
+		"SELECT l.instance_id, l.node_id, COALESCE(n.address, ''), COALESCE(n.http_address, ''), l.version, l.acquired_on, l.expires_on "+
+			"FROM instance_leases l LEFT JOIN runtime_nodes n ON l.node_id = n.id WHERE l.instance_id = $1",
+		instanceID,
+	).Scan(&l.InstanceID, &l.NodeID, &l.NodeAddress, &l.NodeHTTPAddress, &l.Version, &l.AcquiredOn, &l.ExpiresOn)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, drivers.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// AcquireInstanceLease implements drivers.RegistryStore.
+func (c *connection) AcquireInstanceLease(_ context.Context, instanceID, nodeID string, ttl time.Duration) (*drivers.InstanceLease, error) {
+	// Override ctx because sqlite sometimes segfaults on context cancellation
+	ctx := context.Background()
+
+	// The upsert only takes effect if the lease is already held by the node or has expired.
+	// The version is only incremented when the lease changes owner.
+	now := time.Now().UTC()
+	_, err := c.db.ExecContext(
+		ctx,
+		"INSERT INTO instance_leases(instance_id, node_id, version, acquired_on, expires_on) VALUES ($1, $2, 1, $3, $4) "+
+			"ON CONFLICT(instance_id) DO UPDATE SET "+
+			"version = CASE WHEN instance_leases.node_id = excluded.node_id THEN instance_leases.version ELSE instance_leases.version + 1 END, "+
+			"acquired_on = CASE WHEN instance_leases.node_id = excluded.node_id THEN instance_leases.acquired_on ELSE excluded.acquired_on END, "+
+			"node_id = excluded.node_id, "+
+			"expires_on = excluded.expires_on "+
+			"WHERE instance_leases.node_id = excluded.node_id OR instance_leases.expires_on <= excluded.acquired_on",
+		instanceID,
+		nodeID,
+		now,
+		now.Add(ttl),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.FindInstanceLease(ctx, instanceID)
+}
+
+// ReleaseInstanceLease implements drivers.RegistryStore.
+func (c *connection) ReleaseInstanceLease(_ context.Context, instanceID, nodeID string) error {
+	// Override ctx because sqlite sometimes segfaults on context cancellation
+	ctx := context.Background()
+
+	// We expire the lease instead of deleting it to keep the version monotonically increasing
+	_, err := c.db.ExecContext(
+		ctx,
+		"UPDATE instance_leases SET expires_on = $3 WHERE instance_id = $1 AND node_id = $2 AND expires_on > $3",
+		instanceID,
+		nodeID,
+		time.Now().UTC(),
+	)
+	return err
+}
@@ -0,0 +1,15 @@
+CREATE TABLE runtime_nodes (
+	id TEXT PRIMARY KEY,
+	address TEXT NOT NULL,
+	heartbeat_on TIMESTAMP NOT NULL
+);
+
+CREATE TABLE instance_leases (
+	instance_id TEXT PRIMARY KEY,
+	node_id TEXT NOT NULL,
+	version INTEGER NOT NULL,
+	acquired_on TIMESTAMP NOT NULL,
+	expires_on TIMESTAMP NOT NULL
+);
+
+CREATE INDEX instance_leases_node_id_idx ON instance_leases (node_id);
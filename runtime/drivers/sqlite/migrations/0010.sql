@@ -0,0 +1 @@
+ALTER TABLE instances ADD COLUMN query_limits TEXT NOT NULL DEFAULT '';
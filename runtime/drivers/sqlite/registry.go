@@ -32,7 +32,7 @@ func (c *connection) findInstances(_ context.Context, whereClause string, args .
 	// Override ctx because sqlite sometimes segfaults on context cancellation
 	ctx := context.Background()
 
-	sql := fmt.Sprintf("SELECT id, olap_connector, repo_connector, embed_catalog, created_on, updated_on, variables, project_variables, ingestion_limit_bytes, annotations, connectors, project_connectors FROM instances %s ORDER BY id", whereClause)
+	sql := fmt.Sprintf("SELECT id, olap_connector, repo_connector, embed_catalog, created_on, updated_on, variables, project_variables, ingestion_limit_bytes, annotations, connectors, project_connectors, query_limits FROM instances %s ORDER BY id", whereClause)
 
 	rows, err := c.db.QueryxContext(ctx, sql, args...)
 	if err != nil {
@@ -43,9 +43,9 @@ func (c *connection) findInstances(_ context.Context, whereClause string, args .
 	var res []*drivers.Instance
 	for rows.Next() {
 		// sqlite doesn't support maps need to read as bytes and convert to map
-		var variables, projectVariables, annotations, connectors, projectConnectors []byte
+		var variables, projectVariables, annotations, connectors, projectConnectors, queryLimits []byte
 		i := &drivers.Instance{}
-		err := rows.Scan(&i.ID, &i.OLAPConnector, &i.RepoConnector, &i.EmbedCatalog, &i.CreatedOn, &i.UpdatedOn, &variables, &projectVariables, &i.IngestionLimitBytes, &annotations, &connectors, &projectConnectors)
+		err := rows.Scan(&i.ID, &i.OLAPConnector, &i.RepoConnector, &i.EmbedCatalog, &i.CreatedOn, &i.UpdatedOn, &variables, &projectVariables, &i.IngestionLimitBytes, &annotations, &connectors, &projectConnectors, &queryLimits)
 		if err != nil {
 			return nil, err
 		}
@@ -73,6 +73,11 @@ func (c *connection) findInstances(_ context.Context, whereClause string, args .
 		if err != nil {
 			return nil, err
 		}
+
+		i.QueryLimits, err = unmarshalQueryLimits(queryLimits)
+		if err != nil {
+			return nil, err
+		}
 		res = append(res, i)
 	}
 
@@ -114,11 +119,16 @@ func (c *connection) CreateInstance(_ context.Context, inst *drivers.Instance) e
 		return err
 	}
 
+	queryLimits, err := json.Marshal(inst.QueryLimits)
+	if err != nil {
+		return err
+	}
+
 	now := time.Now()
 	_, err = c.db.ExecContext(
 		ctx,
-		"INSERT INTO instances(id, olap_connector, repo_connector, embed_catalog, created_on, updated_on, variables, project_variables, ingestion_limit_bytes, annotations, connectors, project_connectors) "+
-			"VALUES ($1, $2, $3, $4, $5, $5, $6, $7, $8, $9, $10, $11)",
+		"INSERT INTO instances(id, olap_connector, repo_connector, embed_catalog, created_on, updated_on, variables, project_variables, ingestion_limit_bytes, annotations, connectors, project_connectors, query_limits) "+
+			"VALUES ($1, $2, $3, $4, $5, $5, $6, $7, $8, $9, $10, $11, $12)",
 		inst.ID,
 		inst.OLAPConnector,
 		inst.RepoConnector,
@@ -130,6 +140,7 @@ func (c *connection) CreateInstance(_ context.Context, inst *drivers.Instance) e
 		annotations,
 		connectors,
 		projectConnectors,
+		queryLimits,
 	)
 	if err != nil {
 		return err
@@ -172,10 +183,15 @@ func (c *connection) EditInstance(_ context.Context, inst *drivers.Instance) err
 		return err
 	}
 
+	queryLimits, err := json.Marshal(inst.QueryLimits)
+	if err != nil {
+		return err
+	}
+
 	now := time.Now()
 	_, err = c.db.ExecContext(
 		ctx,
-		"UPDATE instances SET olap_connector = $2, repo_connector = $3, embed_catalog = $4, variables = $5, project_variables = $6, updated_on = $7, ingestion_limit_bytes = $8, annotations = $9, connectors = $10, project_connectors = $11 "+
+		"UPDATE instances SET olap_connector = $2, repo_connector = $3, embed_catalog = $4, variables = $5, project_variables = $6, updated_on = $7, ingestion_limit_bytes = $8, annotations = $9, connectors = $10, project_connectors = $11, query_limits = $12 "+
 			"WHERE id = $1",
 		inst.ID,
 		inst.OLAPConnector,
@@ -188,6 +204,7 @@ func (c *connection) EditInstance(_ context.Context, inst *drivers.Instance) err
 		annotations,
 		connectors,
 		projectConnectors,
+		queryLimits,
 	)
 	if err != nil {
 		return err
@@ -204,6 +221,11 @@ func (c *connection) DeleteInstance(_ context.Context, id string) error {
 	ctx := context.Background()
 
 	_, err := c.db.ExecContext(ctx, "DELETE FROM instances WHERE id=$1", id)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.ExecContext(ctx, "DELETE FROM instance_leases WHERE instance_id=$1", id)
 	return err
 }
 
@@ -228,3 +250,12 @@ func unmarshalConnectors(s []byte) ([]*runtimev1.Connector, error) {
 	err := json.Unmarshal(s, &defs)
 	return defs, err
 }
+
+func unmarshalQueryLimits(s []byte) (*runtimev1.QueryLimits, error) {
+	if len(s) == 0 {
+		return nil, nil
+	}
+	var limits *runtimev1.QueryLimits
+	err := json.Unmarshal(s, &limits)
+	return limits, err
+}
@@ -17,6 +17,17 @@ type TransferOptions struct {
 	LimitInBytes     int64
 	Progress         Progress
 	AcquireConnector func(string) (Handle, func(), error)
+	// Incremental is set for incremental transfers. Transporters that don't support incremental transfers ignore it and do a full transfer.
+	Incremental *IncrementalState
+}
+
+// IncrementalState tracks the objects ingested by incremental transfers from object stores.
+// It is passed with the objects ingested by previous transfers and updated by the transporter after a successful transfer.
+type IncrementalState struct {
+	// Full is true if the sink table should be created from scratch instead of appended to
+	Full bool
+	// Objects are the objects ingested into the sink table
+	Objects []*ObjectInfo
 }
 
 // Progress is an interface for communicating progress info
@@ -0,0 +1,187 @@
+// Package archiveutil creates and extracts gzipped tarballs of project directories.
+// It's used to deploy projects that are not in a Git repository.
+package archiveutil
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+)
+
+// Create writes a gzipped tarball of the files in root to w.
+// It skips the .git directory and the files matched by .gitignore files in root and its sub-directories.
+// Symlinks and other non-regular files are skipped.
+func Create(w io.Writer, root string) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	var patterns []gitignore.Pattern
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if rel != "." {
+			parts := strings.Split(rel, "/")
+			if d.IsDir() && d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			if gitignore.NewMatcher(patterns).Match(parts, d.IsDir()) {
+				if d.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+		}
+
+		if d.IsDir() {
+			// Patterns in a .gitignore file apply to the directory that contains it
+			var domain []string
+			if rel != "." {
+				domain = strings.Split(rel, "/")
+			}
+			ps, err := readGitignore(filepath.Join(path, ".gitignore"), domain)
+			if err != nil {
+				return err
+			}
+			patterns = append(patterns, ps...)
+			return nil
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		hdr, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = rel
+
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+// Extract extracts a gzipped tarball created with Create into dst.
+// It fails if the tarball contains paths outside dst or if the extracted files exceed maxBytes (if maxBytes > 0).
+func Extract(r io.Reader, dst string, maxBytes int64) error {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("invalid archive: %w", err)
+	}
+	defer gr.Close()
+
+	var total int64
+	tr := tar.NewReader(gr)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("invalid archive: %w", err)
+		}
+
+		name := filepath.Clean(filepath.FromSlash(hdr.Name))
+		if !filepath.IsLocal(name) {
+			return fmt.Errorf("invalid archive: path %q is outside the archive", hdr.Name)
+		}
+		path := filepath.Join(dst, name)
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(path, os.ModePerm); err != nil {
+				return err
+			}
+			continue
+		case tar.TypeReg:
+			// Handled below
+		default:
+			// Skip symlinks and other special files
+			continue
+		}
+
+		total += hdr.Size
+		if maxBytes > 0 && total > maxBytes {
+			return fmt.Errorf("archive exceeds the limit of %d bytes", maxBytes)
+		}
+
+		if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+			return err
+		}
+
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+		if err != nil {
+			return err
+		}
+		_, err = io.CopyN(f, tr, hdr.Size)
+		if err2 := f.Close(); err == nil {
+			err = err2
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// readGitignore parses the patterns in a .gitignore file. It returns nil if the file doesn't exist.
+func readGitignore(path string, domain []string) ([]gitignore.Pattern, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var ps []gitignore.Pattern
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") || strings.TrimSpace(line) == "" {
+			continue
+		}
+		ps = append(ps, gitignore.ParsePattern(line, domain))
+	}
+	return ps, scanner.Err()
+}
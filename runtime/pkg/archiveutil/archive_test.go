@@ -0,0 +1,103 @@
+package archiveutil
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateExtract(t *testing.T) {
+	src := t.TempDir()
+	writeFiles(t, src, map[string]string{
+		"rill.yaml":               "title: Hello",
+		".gitignore":              "*.db\n.env\n# comment\n/tmp\n",
+		".env":                    "secret=1",
+		"stage.db":                "data",
+		"tmp/foo.sql":             "SELECT 1",
+		".git/HEAD":               "ref: refs/heads/main",
+		"models/foo.sql":          "SELECT 1",
+		"models/nested.db":        "data",
+		"models/tmp/bar.sql":      "SELECT 2",
+		"sources/.gitignore":      "local.yaml",
+		"sources/local.yaml":      "type: local_file",
+		"sources/remote.yaml":     "type: s3",
+		"dashboards/.gitkeep":     "",
+		"dashboards/local.yaml":   "title: Not ignored outside sources",
+		"dashboards/dash.yaml":    "title: Dash",
+		"dashboards/sub/dash.sql": "SELECT 3",
+	})
+
+	var buf bytes.Buffer
+	require.NoError(t, Create(&buf, src))
+
+	dst := t.TempDir()
+	require.NoError(t, Extract(bytes.NewReader(buf.Bytes()), dst, 0))
+
+	require.ElementsMatch(t, []string{
+		"rill.yaml",
+		".gitignore",
+		"models/foo.sql",
+		"models/tmp/bar.sql",
+		"sources/.gitignore",
+		"sources/remote.yaml",
+		"dashboards/.gitkeep",
+		"dashboards/local.yaml",
+		"dashboards/dash.yaml",
+		"dashboards/sub/dash.sql",
+	}, listFiles(t, dst))
+
+	data, err := os.ReadFile(filepath.Join(dst, "models/foo.sql"))
+	require.NoError(t, err)
+	require.Equal(t, "SELECT 1", string(data))
+
+	// Enforces the size limit
+	err = Extract(bytes.NewReader(buf.Bytes()), t.TempDir(), 10)
+	require.ErrorContains(t, err, "exceeds the limit")
+}
+
+func TestExtractUnsafePath(t *testing.T) {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "../evil.sql", Typeflag: tar.TypeReg, Size: 1, Mode: 0o644}))
+	_, err := tw.Write([]byte("x"))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+
+	dir := t.TempDir()
+	err = Extract(&buf, filepath.Join(dir, "dst"), 0)
+	require.ErrorContains(t, err, "outside the archive")
+	_, err = os.Stat(filepath.Join(dir, "evil.sql"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func writeFiles(t *testing.T, root string, files map[string]string) {
+	for name, data := range files {
+		path := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(data), 0o644))
+	}
+}
+
+func listFiles(t *testing.T, root string) []string {
+	var res []string
+	err := filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		res = append(res, filepath.ToSlash(rel))
+		return nil
+	})
+	require.NoError(t, err)
+	return res
+}
@@ -0,0 +1,386 @@
+package duckdbsql
+
+import (
+	"sort"
+	"strings"
+)
+
+// ColumnLineage describes the source columns that an output column of a DuckDB SQL statement is derived from
+type ColumnLineage struct {
+	// Name of the output column. It is empty for expressions without an alias and "*" for star expressions that could not be expanded.
+	Name string
+	// Sources are the base table columns the output column is derived from. Column is "*" if all columns of the table are selected.
+	Sources []*ColumnSource
+}
+
+// ColumnSource is a column in a base table
+type ColumnSource struct {
+	Table  string
+	Column string
+}
+
+// ExtractColumnLineage extracts the lineage of the columns in the outermost SELECT of a DuckDB SQL statement.
+// It resolves column references through CTEs, subqueries, joins and aliases.
+// The schema func should return the columns of a base table, or nil if they are not known.
+// Stars over base tables with unknown columns are returned with the name "*".
+func (a *AST) ExtractColumnLineage(schema func(table string) []string) []*ColumnLineage {
+	statements := toNodeArray(a.ast, astKeyStatements)
+	if len(statements) == 0 {
+		return nil
+	}
+
+	l := &lineageResolver{schema: schema}
+	rel := l.resolveQueryNode(toNode(statements[0], astKeyNode), nil)
+	if rel == nil {
+		return nil
+	}
+
+	res := make([]*ColumnLineage, 0, len(rel.columns)+len(rel.stars))
+	for _, c := range rel.columns {
+		res = append(res, &ColumnLineage{Name: c.name, Sources: c.sources.list()})
+	}
+	for _, t := range rel.stars {
+		res = append(res, &ColumnLineage{Name: "*", Sources: []*ColumnSource{{Table: t, Column: "*"}}})
+	}
+	return res
+}
+
+// lineageResolver resolves column lineage for a DuckDB AST
+type lineageResolver struct {
+	schema func(table string) []string
+}
+
+// lineageRelation is a relation in a FROM clause or the output of a query node
+type lineageRelation struct {
+	alias   string
+	columns []*lineageColumn
+	// stars are base tables with unknown columns that all columns are selected from
+	stars []string
+}
+
+type lineageColumn struct {
+	name    string
+	sources columnSources
+}
+
+// lineageScope holds the CTEs visible to a query node
+type lineageScope struct {
+	parent *lineageScope
+	ctes   map[string]*lineageRelation
+}
+
+func (s *lineageScope) lookup(name string) (*lineageRelation, bool) {
+	for ; s != nil; s = s.parent {
+		if rel, ok := s.ctes[strings.ToLower(name)]; ok {
+			return rel, true
+		}
+	}
+	return nil, false
+}
+
+func (l *lineageResolver) resolveQueryNode(node astNode, scope *lineageScope) *lineageRelation {
+	if node == nil {
+		return nil
+	}
+
+	// CTEs are resolved in order since later CTEs may reference earlier ones
+	if entries := toNodeArray(toNode(node, astKeyCTE), astKeyMap); len(entries) > 0 {
+		scope = &lineageScope{parent: scope, ctes: make(map[string]*lineageRelation, len(entries))}
+		for _, entry := range entries {
+			name := toString(entry, astKeyKey)
+			rel := l.resolveQueryNode(toNode(toNode(toNode(entry, astKeyValue), astKeyQuery), astKeyNode), scope)
+			if rel == nil {
+				rel = &lineageRelation{}
+			}
+			scope.ctes[strings.ToLower(name)] = rel
+		}
+	}
+
+	switch toString(node, astKeyType) {
+	case "SELECT_NODE":
+		return l.resolveSelectNode(node, scope)
+	case "SET_OPERATION_NODE":
+		left := l.resolveQueryNode(toNode(node, astKeyLeft), scope)
+		right := l.resolveQueryNode(toNode(node, astKeyRight), scope)
+		if left == nil || right == nil {
+			return left
+		}
+		// Output columns are named by the left side and positionally combined with the right side
+		res := &lineageRelation{stars: mergeStrings(left.stars, right.stars)}
+		for i, c := range left.columns {
+			col := &lineageColumn{name: c.name, sources: c.sources.clone()}
+			if i < len(right.columns) && len(left.stars) == 0 && len(right.stars) == 0 {
+				col.sources.add(right.columns[i].sources)
+			}
+			res.columns = append(res.columns, col)
+		}
+		return res
+	}
+
+	return nil
+}
+
+func (l *lineageResolver) resolveSelectNode(node astNode, scope *lineageScope) *lineageRelation {
+	var from []*lineageRelation
+	l.resolveFromTable(toNode(node, astKeyFromTable), scope, &from)
+
+	res := &lineageRelation{}
+	for _, expr := range toNodeArray(node, astKeySelectColumnList) {
+		if toString(expr, astKeyClass) == "STAR" && !toBoolean(expr, "columns") {
+			l.expandStar(expr, from, res)
+			continue
+		}
+
+		name := toString(expr, astKeyAlias)
+		if name == "" && toString(expr, astKeyClass) == "COLUMN_REF" {
+			names := toTypedArray[string](expr, astKeyColumnNames)
+			if len(names) > 0 {
+				name = names[len(names)-1]
+			}
+		}
+
+		sources := columnSources{}
+		l.collectExpressionSources(expr, from, res, scope, sources)
+		res.columns = append(res.columns, &lineageColumn{name: name, sources: sources})
+	}
+	return res
+}
+
+// resolveFromTable resolves the relations in a FROM clause
+func (l *lineageResolver) resolveFromTable(node astNode, scope *lineageScope, from *[]*lineageRelation) {
+	if node == nil {
+		return
+	}
+
+	alias := toString(node, astKeyAlias)
+	switch toString(node, astKeyType) {
+	case "JOIN":
+		l.resolveFromTable(toNode(node, astKeyLeft), scope, from)
+		l.resolveFromTable(toNode(node, astKeyRight), scope, from)
+
+	case "SUBQUERY":
+		rel := l.resolveQueryNode(toNode(toNode(node, astKeySubQuery), astKeyNode), scope)
+		if rel == nil {
+			rel = &lineageRelation{}
+		}
+		rel = renameRelation(rel, alias, toTypedArray[string](node, astKeyColumnNameAlias))
+		*from = append(*from, rel)
+
+	case "BASE_TABLE":
+		name := toString(node, astKeyTableName)
+		if alias == "" {
+			alias = name
+		}
+
+		// CTEs shadow base tables
+		if rel, ok := scope.lookup(name); ok {
+			*from = append(*from, renameRelation(rel, alias, toTypedArray[string](node, astKeyColumnNameAlias)))
+			return
+		}
+
+		rel := &lineageRelation{alias: alias}
+		var cols []string
+		if l.schema != nil {
+			cols = l.schema(name)
+		}
+		if cols == nil {
+			rel.stars = []string{name}
+		}
+		for _, c := range cols {
+			sources := columnSources{}
+			sources.addOne(name, c)
+			rel.columns = append(rel.columns, &lineageColumn{name: c, sources: sources})
+		}
+		*from = append(*from, renameRelation(rel, alias, toTypedArray[string](node, astKeyColumnNameAlias)))
+
+	default:
+		// Table functions and VALUES lists don't have base table columns
+		*from = append(*from, &lineageRelation{alias: alias})
+	}
+}
+
+// expandStar adds the columns selected by a star expression to res
+func (l *lineageResolver) expandStar(expr astNode, from []*lineageRelation, res *lineageRelation) {
+	relation := toString(expr, astKetRelationName)
+	exclude := make(map[string]bool)
+	for _, name := range toTypedArray[string](expr, "exclude_list") {
+		exclude[strings.ToLower(name)] = true
+	}
+
+	for _, rel := range from {
+		if relation != "" && !strings.EqualFold(rel.alias, relation) {
+			continue
+		}
+		for _, c := range rel.columns {
+			if !exclude[strings.ToLower(c.name)] {
+				res.columns = append(res.columns, &lineageColumn{name: c.name, sources: c.sources.clone()})
+			}
+		}
+		res.stars = mergeStrings(res.stars, rel.stars)
+	}
+}
+
+// collectExpressionSources adds the sources of all column references in an expression to sources
+func (l *lineageResolver) collectExpressionSources(expr astNode, from []*lineageRelation, self *lineageRelation, scope *lineageScope, sources columnSources) {
+	switch toString(expr, astKeyClass) {
+	case "COLUMN_REF":
+		sources.add(resolveColumnRef(toTypedArray[string](expr, astKeyColumnNames), from, self))
+		return
+	case "SUBQUERY":
+		// Scalar subqueries contribute all their output columns
+		rel := l.resolveQueryNode(toNode(toNode(expr, astKeySubQuery), astKeyNode), scope)
+		if rel != nil {
+			for _, c := range rel.columns {
+				sources.add(c.sources)
+			}
+		}
+		return
+	case "LAMBDA":
+		// Skip the lambda parameters
+		l.collectExpressionSources(toNode(expr, "expr"), from, self, scope, sources)
+		return
+	}
+
+	for _, v := range expr {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			l.collectExpressionSources(v, from, self, scope, sources)
+		case []interface{}:
+			for _, e := range v {
+				if m, ok := e.(map[string]interface{}); ok {
+					l.collectExpressionSources(m, from, self, scope, sources)
+				}
+			}
+		}
+	}
+}
+
+// resolveColumnRef resolves a (possibly qualified) column reference against the relations in scope.
+// DuckDB also allows referencing aliases of earlier expressions in the select list, which are looked up in self.
+func resolveColumnRef(names []string, from []*lineageRelation, self *lineageRelation) columnSources {
+	res := columnSources{}
+	if len(names) == 0 {
+		return res
+	}
+
+	// Qualified reference, i.e. "alias.column" or "schema.table.column"
+	if len(names) >= 2 {
+		relation := names[len(names)-2]
+		column := names[len(names)-1]
+		for _, rel := range from {
+			if strings.EqualFold(rel.alias, relation) {
+				res.add(rel.resolve(column))
+				return res
+			}
+		}
+		// Not a relation, so it may be a struct field access on a column
+	}
+
+	column := names[0]
+	for _, rel := range from {
+		for _, c := range rel.columns {
+			if strings.EqualFold(c.name, column) {
+				res.add(c.sources)
+			}
+		}
+	}
+	if len(res) > 0 {
+		return res
+	}
+
+	if self != nil {
+		for _, c := range self.columns {
+			if strings.EqualFold(c.name, column) {
+				res.add(c.sources)
+				return res
+			}
+		}
+	}
+
+	// Fall back to base tables with unknown columns
+	for _, rel := range from {
+		for _, t := range rel.stars {
+			res.addOne(t, column)
+		}
+	}
+	return res
+}
+
+// resolve returns the sources of a column in the relation
+func (r *lineageRelation) resolve(column string) columnSources {
+	res := columnSources{}
+	for _, c := range r.columns {
+		if strings.EqualFold(c.name, column) {
+			res.add(c.sources)
+			return res
+		}
+	}
+	for _, t := range r.stars {
+		res.addOne(t, column)
+	}
+	return res
+}
+
+// renameRelation returns a copy of the relation with a new alias and column aliases applied
+func renameRelation(rel *lineageRelation, alias string, columnAliases []string) *lineageRelation {
+	res := &lineageRelation{alias: alias, stars: rel.stars}
+	for i, c := range rel.columns {
+		name := c.name
+		if i < len(columnAliases) {
+			name = columnAliases[i]
+		}
+		res.columns = append(res.columns, &lineageColumn{name: name, sources: c.sources})
+	}
+	return res
+}
+
+// columnSources is a set of base table columns
+type columnSources map[ColumnSource]bool
+
+func (s columnSources) addOne(table, column string) {
+	s[ColumnSource{Table: table, Column: column}] = true
+}
+
+func (s columnSources) add(other columnSources) {
+	for k := range other {
+		s[k] = true
+	}
+}
+
+func (s columnSources) clone() columnSources {
+	res := make(columnSources, len(s))
+	res.add(s)
+	return res
+}
+
+func (s columnSources) list() []*ColumnSource {
+	res := make([]*ColumnSource, 0, len(s))
+	for k := range s {
+		k := k
+		res = append(res, &k)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Table != res[j].Table {
+			return res[i].Table < res[j].Table
+		}
+		return res[i].Column < res[j].Column
+	})
+	return res
+}
+
+func mergeStrings(a, b []string) []string {
+	res := append([]string{}, a...)
+	for _, s := range b {
+		found := false
+		for _, t := range res {
+			if strings.EqualFold(s, t) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			res = append(res, s)
+		}
+	}
+	return res
+}
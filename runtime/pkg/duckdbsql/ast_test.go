@@ -237,6 +237,87 @@ with
 	}
 }
 
+func TestParse_ColumnLineage(t *testing.T) {
+	schemas := map[string][]string{
+		"orders":    {"id", "customer_id", "amount", "status"},
+		"customers": {"id", "name", "country"},
+	}
+	schema := func(table string) []string {
+		return schemas[table]
+	}
+
+	sqlVariations := []struct {
+		title   string
+		sql     string
+		lineage []*ColumnLineage
+	}{
+		{
+			"aliases and expressions",
+			`select id as order_id, amount * 2 as double_amount, upper(status) from orders`,
+			[]*ColumnLineage{
+				{Name: "order_id", Sources: []*ColumnSource{{Table: "orders", Column: "id"}}},
+				{Name: "double_amount", Sources: []*ColumnSource{{Table: "orders", Column: "amount"}}},
+				{Name: "", Sources: []*ColumnSource{{Table: "orders", Column: "status"}}},
+			},
+		},
+		{
+			"join with table aliases",
+			`select o.id, c.name as customer, sum(o.amount) as total from orders o join customers c on o.customer_id = c.id group by 1, 2`,
+			[]*ColumnLineage{
+				{Name: "id", Sources: []*ColumnSource{{Table: "orders", Column: "id"}}},
+				{Name: "customer", Sources: []*ColumnSource{{Table: "customers", Column: "name"}}},
+				{Name: "total", Sources: []*ColumnSource{{Table: "orders", Column: "amount"}}},
+			},
+		},
+		{
+			"CTEs and subqueries",
+			`
+with
+  o as (select customer_id as cid, amount from orders where status = 'paid'),
+  t as (select cid, sum(amount) as total from o group by 1)
+select s.country, s.total from (select c.country, t.total from t join customers c on t.cid = c.id) s`,
+			[]*ColumnLineage{
+				{Name: "country", Sources: []*ColumnSource{{Table: "customers", Column: "country"}}},
+				{Name: "total", Sources: []*ColumnSource{{Table: "orders", Column: "amount"}}},
+			},
+		},
+		{
+			"star with exclude",
+			`select * exclude (status) from orders`,
+			[]*ColumnLineage{
+				{Name: "id", Sources: []*ColumnSource{{Table: "orders", Column: "id"}}},
+				{Name: "customer_id", Sources: []*ColumnSource{{Table: "orders", Column: "customer_id"}}},
+				{Name: "amount", Sources: []*ColumnSource{{Table: "orders", Column: "amount"}}},
+			},
+		},
+		{
+			"star over unknown table",
+			`select e.*, e.kind as event_kind from events e`,
+			[]*ColumnLineage{
+				{Name: "event_kind", Sources: []*ColumnSource{{Table: "events", Column: "kind"}}},
+				{Name: "*", Sources: []*ColumnSource{{Table: "events", Column: "*"}}},
+			},
+		},
+		{
+			"union",
+			`select id, name from customers union all select id, status from orders`,
+			[]*ColumnLineage{
+				{Name: "id", Sources: []*ColumnSource{{Table: "customers", Column: "id"}, {Table: "orders", Column: "id"}}},
+				{Name: "name", Sources: []*ColumnSource{{Table: "customers", Column: "name"}, {Table: "orders", Column: "status"}}},
+			},
+		},
+	}
+
+	for _, tt := range sqlVariations {
+		t.Run(tt.title, func(t *testing.T) {
+			ast, err := Parse(tt.sql)
+			require.NoError(t, err)
+
+			require.EqualValues(t, tt.lineage, ast.ExtractColumnLineage(schema))
+		})
+	}
+}
+
 func TestAST_RewriteFunctionTableRefs(t *testing.T) {
 	sqlVariations := []struct {
 		title       string
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"time"
 )
 
 // Duration represents an ISO8601 duration with Rill-specific extensions.
@@ -77,3 +78,13 @@ func ParseISO8601(from string) (Duration, error) {
 
 	return d, nil
 }
+
+// Sub returns the time t minus the duration.
+// If the duration is infinite, it returns the zero time.
+func (d Duration) Sub(t time.Time) time.Time {
+	if d.Inf {
+		return time.Time{}
+	}
+	t = t.AddDate(-d.Year, -d.Month, -(d.Week*7 + d.Day))
+	return t.Add(-(time.Duration(d.Hour)*time.Hour + time.Duration(d.Minute)*time.Minute + time.Duration(d.Second)*time.Second))
+}
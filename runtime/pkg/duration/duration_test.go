@@ -2,6 +2,7 @@ package duration
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -28,3 +29,22 @@ func TestParseISO8601(t *testing.T) {
 		}
 	}
 }
+
+func TestSub(t *testing.T) {
+	ts := time.Date(2023, 3, 31, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		from     string
+		expected time.Time
+	}{
+		{from: "PT1H", expected: time.Date(2023, 3, 31, 11, 0, 0, 0, time.UTC)},
+		{from: "P1DT30M", expected: time.Date(2023, 3, 30, 11, 30, 0, 0, time.UTC)},
+		{from: "P2W", expected: time.Date(2023, 3, 17, 12, 0, 0, 0, time.UTC)},
+		{from: "P1Y", expected: time.Date(2022, 3, 31, 12, 0, 0, 0, time.UTC)},
+		{from: "inf", expected: time.Time{}},
+	}
+	for _, tt := range tests {
+		d, err := ParseISO8601(tt.from)
+		require.NoError(t, err)
+		require.Equal(t, tt.expected, d.Sub(ts), "for %q", tt.from)
+	}
+}
@@ -50,12 +50,16 @@ func (q *ColumnCardinality) Resolve(ctx context.Context, rt *runtime.Runtime, in
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB {
+	var requestSQL string
+	switch olap.Dialect() {
+	case drivers.DialectDuckDB:
+		requestSQL = fmt.Sprintf("SELECT approx_count_distinct(%s) as count from %s", safeName(q.ColumnName), safeName(q.TableName))
+	case drivers.DialectClickHouse:
+		requestSQL = fmt.Sprintf("SELECT uniq(%s) as count from %s", safeName(q.ColumnName), safeName(q.TableName))
+	default:
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
-	requestSQL := fmt.Sprintf("SELECT approx_count_distinct(%s) as count from %s", safeName(q.ColumnName), safeName(q.TableName))
-
 	rows, err := olap.Execute(ctx, &drivers.Statement{
 		Query:            requestSQL,
 		Priority:         priority,
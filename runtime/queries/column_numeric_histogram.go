@@ -74,15 +74,27 @@ func (q *ColumnNumericHistogram) Export(ctx context.Context, rt *runtime.Runtime
 
 func (q *ColumnNumericHistogram) calculateBucketSize(ctx context.Context, olap drivers.OLAPStore, instanceID string, priority int) (float64, error) {
 	sanitizedColumnName := safeName(q.ColumnName)
-	querySQL := fmt.Sprintf(
-		"SELECT (approx_quantile(%s, 0.75)-approx_quantile(%s, 0.25))::DOUBLE AS iqr, approx_count_distinct(%s) AS count, (max(%s) - min(%s))::DOUBLE AS range FROM %s",
-		sanitizedColumnName,
-		sanitizedColumnName,
-		sanitizedColumnName,
-		sanitizedColumnName,
-		sanitizedColumnName,
-		safeName(q.TableName),
-	)
+	var querySQL string
+	switch olap.Dialect() {
+	case drivers.DialectDuckDB:
+		querySQL = fmt.Sprintf(
+			"SELECT (approx_quantile(%s, 0.75)-approx_quantile(%s, 0.25))::DOUBLE AS iqr, approx_count_distinct(%s) AS count, (max(%s) - min(%s))::DOUBLE AS range FROM %s",
+			sanitizedColumnName,
+			sanitizedColumnName,
+			sanitizedColumnName,
+			sanitizedColumnName,
+			sanitizedColumnName,
+			safeName(q.TableName),
+		)
+	case drivers.DialectClickHouse:
+		querySQL = fmt.Sprintf(
+			"SELECT toFloat64(quantileOrNull(0.75)(%[1]s) - quantileOrNull(0.25)(%[1]s)) AS iqr, uniq(%[1]s) AS count, toFloat64(maxOrNull(%[1]s) - minOrNull(%[1]s)) AS range FROM %[2]s",
+			sanitizedColumnName,
+			safeName(q.TableName),
+		)
+	default:
+		return 0, fmt.Errorf("not available for dialect '%s'", olap.Dialect())
+	}
 
 	rows, err := olap.Execute(ctx, &drivers.Statement{
 		Query:            querySQL,
@@ -132,7 +144,7 @@ func (q *ColumnNumericHistogram) calculateFDMethod(ctx context.Context, rt *runt
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB {
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectClickHouse {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
@@ -146,6 +158,10 @@ func (q *ColumnNumericHistogram) calculateFDMethod(ctx context.Context, rt *runt
 		return nil
 	}
 
+	if olap.Dialect() == drivers.DialectClickHouse {
+		return q.calculateFDMethodClickHouse(ctx, olap, int(bucketSize), priority)
+	}
+
 	selectColumn := fmt.Sprintf("%s::DOUBLE", sanitizedColumnName)
 	histogramSQL := fmt.Sprintf(
 		`
@@ -244,13 +260,12 @@ func (q *ColumnNumericHistogram) calculateDiagnosticMethod(ctx context.Context,
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB {
-		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
-	}
-
+	var minMaxSQL string
 	sanitizedColumnName := safeName(q.ColumnName)
-	minMaxSQL := fmt.Sprintf(
-		`
+	switch olap.Dialect() {
+	case drivers.DialectDuckDB:
+		minMaxSQL = fmt.Sprintf(
+			`
 			SELECT
 				min(%[2]s) as min,
 				max(%[2]s) as max,
@@ -258,9 +273,25 @@ func (q *ColumnNumericHistogram) calculateDiagnosticMethod(ctx context.Context,
 			FROM %[1]s
 			WHERE %[2]s IS NOT NULL
 		`,
-		safeName(q.TableName),
-		sanitizedColumnName,
-	)
+			safeName(q.TableName),
+			sanitizedColumnName,
+		)
+	case drivers.DialectClickHouse:
+		// ClickHouse returns the default value of the type instead of NULL when aggregating no rows
+		minMaxSQL = fmt.Sprintf(
+			`
+			SELECT
+				toFloat64(minOrNull(%[2]s)) as min,
+				toFloat64(maxOrNull(%[2]s)) as max,
+				toFloat64(maxOrNull(%[2]s) - minOrNull(%[2]s)) as range
+			FROM %[1]s
+		`,
+			safeName(q.TableName),
+			sanitizedColumnName,
+		)
+	default:
+		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
+	}
 
 	minMaxRow, err := olap.Execute(ctx, &drivers.Statement{
 		Query:            minMaxSQL,
@@ -296,6 +327,26 @@ func (q *ColumnNumericHistogram) calculateDiagnosticMethod(ctx context.Context,
 		bucketCount++
 	}
 
+	if olap.Dialect() == drivers.DialectClickHouse {
+		counts, err := clickHouseHistogramCounts(ctx, olap, priority, q.TableName, q.ColumnName, startTick, endTick-startTick, bucketCount)
+		if err != nil {
+			return err
+		}
+
+		histogramBins := make([]*runtimev1.NumericHistogramBins_Bin, bucketCount)
+		for i := range histogramBins {
+			histogramBins[i] = &runtimev1.NumericHistogramBins_Bin{
+				Bucket:   int32(i),
+				Low:      float64(i)*gap + startTick,
+				Midpoint: float64(i)*gap + gap/2 + startTick,
+				High:     float64(i+1)*gap + startTick,
+				Count:    counts[i],
+			}
+		}
+		q.Result = histogramBins
+		return nil
+	}
+
 	selectColumn := fmt.Sprintf("%s::DOUBLE", sanitizedColumnName)
 	histogramSQL := fmt.Sprintf(
 		`
@@ -393,3 +444,100 @@ func (q *ColumnNumericHistogram) calculateDiagnosticMethod(ctx context.Context,
 
 	return nil
 }
+
+func (q *ColumnNumericHistogram) calculateFDMethodClickHouse(ctx context.Context, olap drivers.OLAPStore, bucketSize, priority int) error {
+	minVal, maxVal, err := clickHouseMinMax(ctx, olap, priority, q.TableName, q.ColumnName)
+	if err != nil {
+		return err
+	}
+	if !minVal.Valid || !maxVal.Valid {
+		return nil
+	}
+
+	rng := maxVal.Float64 - minVal.Float64
+	counts, err := clickHouseHistogramCounts(ctx, olap, priority, q.TableName, q.ColumnName, minVal.Float64, rng, bucketSize)
+	if err != nil {
+		return err
+	}
+
+	histogramBins := make([]*runtimev1.NumericHistogramBins_Bin, bucketSize)
+	for i := range histogramBins {
+		histogramBins[i] = &runtimev1.NumericHistogramBins_Bin{
+			Bucket: int32(i),
+			Low:    float64(i)*rng/float64(bucketSize) + minVal.Float64,
+			High:   float64(i+1)*rng/float64(bucketSize) + minVal.Float64,
+			Count:  counts[i],
+		}
+	}
+	q.Result = histogramBins
+
+	return nil
+}
+
+// clickHouseMinMax returns the min and max of the non-null values in a numeric column.
+// They are NULL if the column has no non-null values.
+func clickHouseMinMax(ctx context.Context, olap drivers.OLAPStore, priority int, tableName, columnName string) (sql.NullFloat64, sql.NullFloat64, error) {
+	rows, err := olap.Execute(ctx, &drivers.Statement{
+		Query:            fmt.Sprintf("SELECT toFloat64(minOrNull(%[1]s)), toFloat64(maxOrNull(%[1]s)) FROM %[2]s", safeName(columnName), safeName(tableName)),
+		Priority:         priority,
+		ExecutionTimeout: defaultExecutionTimeout,
+	})
+	if err != nil {
+		return sql.NullFloat64{}, sql.NullFloat64{}, err
+	}
+	defer rows.Close()
+
+	var minVal, maxVal sql.NullFloat64
+	if rows.Next() {
+		err = rows.Scan(&minVal, &maxVal)
+		if err != nil {
+			return sql.NullFloat64{}, sql.NullFloat64{}, err
+		}
+	}
+
+	return minVal, maxVal, rows.Err()
+}
+
+// clickHouseHistogramCounts counts the non-null values in a numeric column for n equal-width buckets starting at low and spanning rng.
+// The buckets are computed in ClickHouse, but the bins are assembled by the caller, which avoids joining against a generated bucket series.
+// Like the DuckDB queries, values on the upper edge (or all values if rng is 0) are counted in the last bucket.
+func clickHouseHistogramCounts(ctx context.Context, olap drivers.OLAPStore, priority int, tableName, columnName string, low, rng float64, n int) ([]float64, error) {
+	countsSQL := fmt.Sprintf(
+		`
+		SELECT
+			ifNull(least(toInt64(floor((toFloat64(%[1]s) - ?) / nullIf(?, 0) * ?)), ?), ?) as bucket,
+			count(*) as count
+		FROM %[2]s
+		WHERE %[1]s IS NOT NULL
+		GROUP BY bucket
+		`,
+		safeName(columnName),
+		safeName(tableName),
+	)
+
+	rows, err := olap.Execute(ctx, &drivers.Statement{
+		Query:            countsSQL,
+		Args:             []any{low, rng, n, n - 1, n - 1},
+		Priority:         priority,
+		ExecutionTimeout: defaultExecutionTimeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make([]float64, n)
+	for rows.Next() {
+		var bucket int64
+		var count float64
+		err = rows.Scan(&bucket, &count)
+		if err != nil {
+			return nil, err
+		}
+		if bucket >= 0 && bucket < int64(n) {
+			counts[bucket] += count
+		}
+	}
+
+	return counts, rows.Err()
+}
@@ -54,12 +54,16 @@ func (q *ColumnRugHistogram) Resolve(ctx context.Context, rt *runtime.Runtime, i
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB {
+	outlierPseudoBucketSize := 500
+	switch olap.Dialect() {
+	case drivers.DialectDuckDB:
+	case drivers.DialectClickHouse:
+		return q.resolveClickHouse(ctx, olap, outlierPseudoBucketSize, priority)
+	default:
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
 	sanitizedColumnName := safeName(q.ColumnName)
-	outlierPseudoBucketSize := 500
 	selectColumn := fmt.Sprintf("%s::DOUBLE", sanitizedColumnName)
 
 	rugSQL := fmt.Sprintf(`WITH data_table AS (
@@ -153,6 +157,41 @@ func (q *ColumnRugHistogram) Resolve(ctx context.Context, rt *runtime.Runtime, i
 	return nil
 }
 
+func (q *ColumnRugHistogram) resolveClickHouse(ctx context.Context, olap drivers.OLAPStore, outlierPseudoBucketSize, priority int) error {
+	minVal, maxVal, err := clickHouseMinMax(ctx, olap, priority, q.TableName, q.ColumnName)
+	if err != nil {
+		return err
+	}
+
+	outlierBins := make([]*runtimev1.NumericOutliers_Outlier, 0)
+	if !minVal.Valid || !maxVal.Valid {
+		q.Result = outlierBins
+		return nil
+	}
+
+	rng := maxVal.Float64 - minVal.Float64
+	counts, err := clickHouseHistogramCounts(ctx, olap, priority, q.TableName, q.ColumnName, minVal.Float64, rng, outlierPseudoBucketSize)
+	if err != nil {
+		return err
+	}
+
+	for i, count := range counts {
+		if count == 0 {
+			continue
+		}
+		outlierBins = append(outlierBins, &runtimev1.NumericOutliers_Outlier{
+			Bucket:  int32(i),
+			Low:     float64(i)*rng/float64(outlierPseudoBucketSize) + minVal.Float64,
+			High:    float64(i+1)*rng/float64(outlierPseudoBucketSize) + minVal.Float64,
+			Present: true,
+			Count:   int32(count),
+		})
+	}
+	q.Result = outlierBins
+
+	return nil
+}
+
 func (q *ColumnRugHistogram) Export(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions) error {
 	return ErrExportNotSupported
 }
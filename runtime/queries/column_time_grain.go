@@ -53,14 +53,68 @@ func (q *ColumnTimeGrain) Resolve(ctx context.Context, rt *runtime.Runtime, inst
 	if err != nil {
 		return err
 	}
-	var useSample string
-	if sampleSize > cq.Result {
-		useSample = ""
-	} else {
-		useSample = fmt.Sprintf("USING SAMPLE %d ROWS", sampleSize)
+
+	olap, release, err := rt.OLAP(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	var estimateSQL string
+	switch olap.Dialect() {
+	case drivers.DialectDuckDB:
+		var useSample string
+		if sampleSize > cq.Result {
+			useSample = ""
+		} else {
+			useSample = fmt.Sprintf("USING SAMPLE %d ROWS", sampleSize)
+		}
+		estimateSQL = q.duckDBEstimateSQL(useSample)
+	case drivers.DialectClickHouse:
+		// ClickHouse only supports SAMPLE for tables with a sampling key, so we take the first rows instead
+		var useSample string
+		if sampleSize <= cq.Result {
+			useSample = fmt.Sprintf("LIMIT %d", sampleSize)
+		}
+		estimateSQL = q.clickHouseEstimateSQL(useSample)
+	default:
+		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
+	}
+
+	rows, err := olap.Execute(ctx, &drivers.Statement{
+		Query:            estimateSQL,
+		Priority:         priority,
+		ExecutionTimeout: defaultExecutionTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var timeGrainString sql.NullString
+	if rows.Next() {
+		err := rows.Scan(&timeGrainString)
+		if err != nil {
+			return err
+		}
 	}
 
-	estimateSQL := fmt.Sprintf(`
+	err = rows.Err()
+	if err != nil {
+		return err
+	}
+
+	if !timeGrainString.Valid {
+		q.Result = runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED // It's the default value. This is just to clarify intended behavior.
+		return nil
+	}
+
+	q.Result = toTimeGrain(timeGrainString.String)
+	return nil
+}
+
+func (q *ColumnTimeGrain) duckDBEstimateSQL(useSample string) string {
+	return fmt.Sprintf(`
       WITH cleaned_column AS (
           SELECT %s as cd
           from %s
@@ -101,47 +155,50 @@ func (q *ColumnTimeGrain) Resolve(ctx context.Context, rt *runtime.Runtime, inst
 		safeName(q.TableName),
 		useSample,
 	)
+}
 
-	olap, release, err := rt.OLAP(ctx, instanceID)
-	if err != nil {
-		return err
-	}
-	defer release()
-
-	if olap.Dialect() != drivers.DialectDuckDB {
-		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
-	}
-
-	rows, err := olap.Execute(ctx, &drivers.Statement{
-		Query:            estimateSQL,
-		Priority:         priority,
-		ExecutionTimeout: defaultExecutionTimeout,
-	})
-	if err != nil {
-		return err
-	}
-	defer rows.Close()
-
-	var timeGrainString sql.NullString
-	if rows.Next() {
-		err := rows.Scan(&timeGrainString)
-		if err != nil {
-			return err
-		}
-	}
-
-	err = rows.Err()
-	if err != nil {
-		return err
-	}
-
-	if !timeGrainString.Valid {
-		q.Result = runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED // It's the default value. This is just to clarify intended behavior.
-		return nil
-	}
-
-	q.Result = toTimeGrain(timeGrainString.String)
-	return nil
+func (q *ColumnTimeGrain) clickHouseEstimateSQL(useSample string) string {
+	return fmt.Sprintf(`
+      WITH cleaned_column AS (
+          SELECT %s as cd
+          from %s
+          %s
+      ),
+      time_grains as (
+      SELECT 
+          uniq(toYear(cd)) as year,
+          uniq(toMonth(cd)) as month,
+          uniq(toDayOfYear(cd)) as dayofyear,
+          uniq(toDayOfMonth(cd)) as dayofmonth,
+          min(toDate(cd) = toLastDayOfMonth(cd)) = 1 as lastdayofmonth,
+          uniq(toISOWeek(cd)) as weekofyear,
+          uniq(toDayOfWeek(cd)) as dayofweek,
+          uniq(toHour(cd)) as hour,
+          uniq(toMinute(cd)) as minute,
+          uniq(toSecond(cd)) as second,
+          uniq(toUnixTimestamp64Milli(toDateTime64(cd, 3)) %% 1000) as ms
+      FROM cleaned_column
+      )
+      SELECT 
+        COALESCE(
+            case WHEN ms > 1 THEN 'MILLISECOND' else NULL END,
+            CASE WHEN second > 1 THEN 'SECOND' else NULL END,
+            CASE WHEN minute > 1 THEN 'MINUTE' else null END,
+            CASE WHEN hour > 1 THEN 'HOUR' else null END,
+            -- cases above, if equal to 1, then we have some candidates for
+            -- bigger time grains. We need to reverse from here
+            -- years, months, weeks, days.
+            CASE WHEN dayofyear = 1 and year > 1 THEN 'YEAR' else null END,
+            CASE WHEN (dayofmonth = 1 OR lastdayofmonth) and month > 1 THEN 'MONTH' else null END,
+            CASE WHEN dayofweek = 1 and weekofyear > 1 THEN 'WEEK' else null END,
+            CASE WHEN hour = 1 THEN 'DAY' else null END
+        ) as estimatedSmallestTimeGrain
+      FROM time_grains
+      `,
+		safeName(q.ColumnName),
+		safeName(q.TableName),
+		useSample,
+	)
 }
 
 func (q *ColumnTimeGrain) Export(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions) error {
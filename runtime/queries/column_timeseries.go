@@ -47,7 +47,10 @@ type ColumnTimeseries struct {
 	// MetricsView-related fields. These can be removed when MetricsViewTimeSeries is refactored to a standalone implementation.
 	MetricsView       *runtimev1.MetricsView               `json:"-"`
 	MetricsViewFilter *runtimev1.MetricsViewFilter         `json:"filters"`
+	MetricsViewWhere  *runtimev1.Expression                `json:"where,omitempty"`
 	MetricsViewPolicy *runtime.ResolvedMetricsViewSecurity `json:"security"`
+	// MetricsViewDerivedMeasures are computed in order from Measures after gaps in the series have been filled.
+	MetricsViewDerivedMeasures []*runtimev1.MetricsView_Measure `json:"derived_measures,omitempty"`
 }
 
 var _ runtime.Query = &ColumnTimeseries{}
@@ -87,7 +90,7 @@ func (q *ColumnTimeseries) Resolve(ctx context.Context, rt *runtime.Runtime, ins
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB {
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectClickHouse {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
@@ -101,8 +104,12 @@ func (q *ColumnTimeseries) Resolve(ctx context.Context, rt *runtime.Runtime, ins
 		return nil
 	}
 
+	if olap.Dialect() == drivers.DialectClickHouse {
+		return q.resolveClickHouse(ctx, olap, timeRange, priority)
+	}
+
 	return olap.WithConnection(ctx, priority, false, false, func(ctx context.Context, ensuredCtx context.Context, _ *sql.Conn) error {
-		filter, args, err := buildFilterClauseForMetricsViewFilter(q.MetricsView, q.MetricsViewFilter, olap.Dialect(), q.MetricsViewPolicy)
+		filter, args, err := buildFilterClauseForMetricsViewFilter(q.MetricsView, q.MetricsViewFilter, q.MetricsViewWhere, olap.Dialect(), q.MetricsViewPolicy)
 		if err != nil {
 			return err
 		}
@@ -197,8 +204,13 @@ func (q *ColumnTimeseries) Resolve(ctx context.Context, rt *runtime.Runtime, ins
 			})
 		}()
 
+		resultSQL, err := q.wrapDerivedMeasures(fmt.Sprintf(`SELECT * FROM %q`, temporaryTableName), tsAlias, olap.Dialect())
+		if err != nil {
+			return err
+		}
+
 		rows, err := olap.Execute(ctx, &drivers.Statement{
-			Query:            fmt.Sprintf(`SELECT * FROM %q`, temporaryTableName),
+			Query:            resultSQL,
 			Priority:         priority,
 			ExecutionTimeout: defaultExecutionTimeout,
 		})
@@ -273,6 +285,132 @@ func (q *ColumnTimeseries) Resolve(ctx context.Context, rt *runtime.Runtime, ins
 	})
 }
 
+// resolveClickHouse resolves the time series in a single query, using WITH FILL to fill in gaps in the series.
+// The spark line is not reduced like for DuckDB, so it has the same resolution as the time series.
+func (q *ColumnTimeseries) resolveClickHouse(ctx context.Context, olap drivers.OLAPStore, timeRange *runtimev1.TimeSeriesTimeRange, priority int) error {
+	filter, args, err := buildFilterClauseForMetricsViewFilter(q.MetricsView, q.MetricsViewFilter, q.MetricsViewWhere, olap.Dialect(), q.MetricsViewPolicy)
+	if err != nil {
+		return err
+	}
+
+	measures := normaliseMeasures(q.Measures, q.Pixels != 0)
+	tsAlias := tempName("_ts_")
+
+	timezone := "UTC"
+	if q.TimeZone != "" {
+		timezone = q.TimeZone
+	}
+
+	_, err = time.LoadLocation(timezone)
+	if err != nil {
+		return err
+	}
+
+	start := clickHouseTimeFloor("?", timeRange.Interval, timezone, q.FirstDayOfWeek, q.FirstMonthOfYear)
+	end := clickHouseTimeFloor("?", timeRange.Interval, timezone, q.FirstDayOfWeek, q.FirstMonthOfYear)
+	args = append(args, timeRange.Start.AsTime(), timeRange.End.AsTime(), timeRange.Start.AsTime(), timeRange.End.AsTime())
+
+	querySQL := fmt.Sprintf(
+		`SELECT %[1]s AS %[2]s, %[3]s FROM %[4]s WHERE 1=1 %[5]s GROUP BY %[2]s HAVING %[2]s >= %[6]s AND %[2]s < %[7]s ORDER BY %[2]s WITH FILL FROM %[6]s TO %[7]s STEP INTERVAL 1 %[8]s`,
+		clickHouseTimeFloor(safeName(q.TimestampColumnName), timeRange.Interval, timezone, q.FirstDayOfWeek, q.FirstMonthOfYear), // 1
+		tsAlias, // 2
+		getExpressionColumnsFromMeasures(measures), // 3
+		safeName(q.TableName),                      // 4
+		filter,                                     // 5
+		start,                                      // 6
+		end,                                        // 7
+		convertToDateTruncSpecifier(timeRange.Interval), // 8
+	)
+
+	querySQL, err = q.wrapDerivedMeasures(querySQL, tsAlias, olap.Dialect())
+	if err != nil {
+		return err
+	}
+
+	rows, err := olap.Execute(ctx, &drivers.Statement{
+		Query:            querySQL,
+		Args:             args,
+		Priority:         priority,
+		ExecutionTimeout: defaultExecutionTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	// Omit the time value from the result schema
+	schema := rows.Schema
+	if schema != nil {
+		for i, f := range schema.Fields {
+			if f.Name == tsAlias {
+				schema.Fields = slices.Delete(schema.Fields, i, i+1)
+				break
+			}
+		}
+	}
+
+	var data []*runtimev1.TimeSeriesValue
+	var sparkValues []*runtimev1.TimeSeriesValue
+	for rows.Next() {
+		rowMap := make(map[string]any)
+		err := rows.MapScan(rowMap)
+		if err != nil {
+			return err
+		}
+
+		t, ok := rowMap[tsAlias].(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type for timestamp column: %T", rowMap[tsAlias])
+		}
+		delete(rowMap, tsAlias)
+
+		records, err := pbutil.ToStruct(rowMap, schema)
+		if err != nil {
+			return err
+		}
+
+		data = append(data, &runtimev1.TimeSeriesValue{
+			Ts:      timestamppb.New(t),
+			Records: records,
+		})
+
+		if q.Pixels != 0 {
+			sparkValues = append(sparkValues, &runtimev1.TimeSeriesValue{
+				Ts: timestamppb.New(t),
+				Records: &structpb.Struct{
+					Fields: map[string]*structpb.Value{"count": records.Fields["count"]},
+				},
+			})
+		}
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return err
+	}
+
+	q.Result = &ColumnTimeseriesResult{
+		Meta:    structTypeToMetricsViewColumn(rows.Schema),
+		Results: data,
+		Spark:   sparkValues,
+	}
+	return nil
+}
+
+// wrapDerivedMeasures computes the derived measures on top of a query that returns the gap-filled series.
+// The result is ordered by the time bucket.
+func (q *ColumnTimeseries) wrapDerivedMeasures(sql, tsAlias string, dialect drivers.Dialect) (string, error) {
+	if len(q.MetricsViewDerivedMeasures) == 0 {
+		return sql, nil
+	}
+
+	sql, err := wrapDerivedMeasures(sql, q.MetricsViewDerivedMeasures, safeName(tsAlias), nil, dialect)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("SELECT * FROM (%s) ORDER BY %s", sql, safeName(tsAlias)), nil
+}
+
 func (q *ColumnTimeseries) Export(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions) error {
 	return ErrExportNotSupported
 }
@@ -139,28 +139,26 @@ func structTypeToMetricsViewColumn(v *runtimev1.StructType) []*runtimev1.Metrics
 }
 
 // buildFilterClauseForMetricsViewFilter builds a SQL string of conditions joined with AND.
+// The conditions are built from the where expression or the deprecated filter, which are mutually exclusive.
 // Unless the result is empty, it is prefixed with "AND".
 // I.e. it has the format "AND (...) AND (...) ...".
-func buildFilterClauseForMetricsViewFilter(mv *runtimev1.MetricsView, filter *runtimev1.MetricsViewFilter, dialect drivers.Dialect, policy *runtime.ResolvedMetricsViewSecurity) (string, []any, error) {
+func buildFilterClauseForMetricsViewFilter(mv *runtimev1.MetricsView, filter *runtimev1.MetricsViewFilter, where *runtimev1.Expression, dialect drivers.Dialect, policy *runtime.ResolvedMetricsViewSecurity) (string, []any, error) {
 	var clauses []string
 	var args []any
 
-	if filter != nil && filter.Include != nil {
-		clause, clauseArgs, err := buildFilterClauseForConditions(mv, filter.Include, false, dialect)
-		if err != nil {
-			return "", nil, err
-		}
-		clauses = append(clauses, clause)
-		args = append(args, clauseArgs...)
+	expr, err := metricsViewWhere(filter, where)
+	if err != nil {
+		return "", nil, err
 	}
-
-	if filter != nil && filter.Exclude != nil {
-		clause, clauseArgs, err := buildFilterClauseForConditions(mv, filter.Exclude, true, dialect)
+	if expr != nil {
+		clause, clauseArgs, err := buildExpression(expr, dialect, metricsViewWhereIdent(mv))
 		if err != nil {
 			return "", nil, err
 		}
-		clauses = append(clauses, clause)
-		args = append(args, clauseArgs...)
+		if clause != "" {
+			clauses = append(clauses, fmt.Sprintf("AND (%s)", clause))
+			args = append(args, clauseArgs...)
+		}
 	}
 
 	if policy != nil && policy.RowFilter != "" {
@@ -170,114 +168,20 @@ func buildFilterClauseForMetricsViewFilter(mv *runtimev1.MetricsView, filter *ru
 	return strings.Join(clauses, " "), args, nil
 }
 
-// buildFilterClauseForConditions returns a string with the format "AND (...) AND (...) ..."
-func buildFilterClauseForConditions(mv *runtimev1.MetricsView, conds []*runtimev1.MetricsViewFilter_Cond, exclude bool, dialect drivers.Dialect) (string, []any, error) {
-	var clauses []string
-	var args []any
-
-	for _, cond := range conds {
-		condClause, condArgs, err := buildFilterClauseForCondition(mv, cond, exclude, dialect)
-		if err != nil {
-			return "", nil, err
-		}
-		if condClause == "" {
-			continue
-		}
-		clauses = append(clauses, condClause)
-		args = append(args, condArgs...)
+// buildHavingClause builds a SQL string for a having expression of a grouped query.
+// Unless the result is empty, it is prefixed with "HAVING".
+func buildHavingClause(having *runtimev1.Expression, dialect drivers.Dialect, ident identResolver) (string, []any, error) {
+	if having == nil {
+		return "", nil, nil
 	}
-
-	return strings.Join(clauses, " "), args, nil
-}
-
-// buildFilterClauseForCondition returns a string with the format "AND (...)"
-func buildFilterClauseForCondition(mv *runtimev1.MetricsView, cond *runtimev1.MetricsViewFilter_Cond, exclude bool, dialect drivers.Dialect) (string, []any, error) {
-	var clauses []string
-	var args []any
-
-	// NOTE: Looking up for dimension like this will lead to O(nm).
-	//       Ideal way would be to create a map, but we need to find a clean solution down the line
-	name, err := metricsViewDimensionToSafeColumn(mv, cond.Name)
+	clause, args, err := buildExpression(having, dialect, ident)
 	if err != nil {
 		return "", nil, err
 	}
-
-	notKeyword := ""
-	if exclude {
-		notKeyword = "NOT"
-	}
-
-	// Tracks if we found NULL(s) in cond.In
-	inHasNull := false
-
-	// Build "dim [NOT] IN (?, ?, ...)" clause
-	if len(cond.In) > 0 {
-		// Add to args, skipping nulls
-		for _, val := range cond.In {
-			if _, ok := val.Kind.(*structpb.Value_NullValue); ok {
-				inHasNull = true
-				continue // Handled later using "dim IS [NOT] NULL" clause
-			}
-			arg, err := pbutil.FromValue(val)
-			if err != nil {
-				return "", nil, fmt.Errorf("filter error: %w", err)
-			}
-			args = append(args, arg)
-		}
-
-		// If there were non-null args, add a "dim [NOT] IN (...)" clause
-		if len(args) > 0 {
-			questionMarks := strings.Join(repeatString("?", len(args)), ",")
-			clause := fmt.Sprintf("%s %s IN (%s)", name, notKeyword, questionMarks)
-			clauses = append(clauses, clause)
-		}
-	}
-
-	// Build "dim [NOT] ILIKE ?"
-	if len(cond.Like) > 0 {
-		for _, val := range cond.Like {
-			var clause string
-			if dialect == drivers.DialectDruid {
-				// Druid does not support ILIKE
-				clause = fmt.Sprintf("LOWER(%s) %s LIKE LOWER(?)", name, notKeyword)
-			} else {
-				clause = fmt.Sprintf("%s %s ILIKE ?", name, notKeyword)
-			}
-
-			args = append(args, val)
-			clauses = append(clauses, clause)
-		}
-	}
-
-	// Add null check
-	// NOTE: DuckDB doesn't handle NULL values in an "IN" expression. They must be checked with a "dim IS [NOT] NULL" clause.
-	if inHasNull {
-		clauses = append(clauses, fmt.Sprintf("%s IS %s NULL", name, notKeyword))
-	}
-
-	// If no checks were added, exit
-	if len(clauses) == 0 {
+	if clause == "" {
 		return "", nil, nil
 	}
-
-	// Join conditions
-	var condJoiner string
-	if exclude {
-		condJoiner = " AND "
-	} else {
-		condJoiner = " OR "
-	}
-	condsClause := strings.Join(clauses, condJoiner)
-
-	// When you have "dim NOT IN (a, b, ...)", then NULL values are always excluded, even if NULL is not in the list.
-	// E.g. this returns zero rows: "select * from (select 1 as a union select null as a) where a not in (1)"
-	// We need to explicitly include it.
-	if exclude && !inHasNull && len(condsClause) > 0 {
-		condsClause += fmt.Sprintf(" OR %s IS NULL", name)
-	}
-
-	// Done
-	return fmt.Sprintf("AND (%s) ", condsClause), args, nil
+	return "HAVING " + clause, args, nil
 }
 
 func repeatString(val string, n int) []string {
@@ -588,7 +492,7 @@ func duckDBCopyExport(ctx context.Context, w io.Writer, opts *runtime.ExportOpti
 
 func (q *MetricsViewRows) generateFilename(mv *runtimev1.MetricsView) string {
 	filename := strings.ReplaceAll(mv.Model, `"`, `_`)
-	if q.TimeStart != nil || q.TimeEnd != nil || q.Filter != nil && (len(q.Filter.Include) > 0 || len(q.Filter.Exclude) > 0) {
+	if q.TimeStart != nil || q.TimeEnd != nil || q.Filter != nil && (len(q.Filter.Include) > 0 || len(q.Filter.Exclude) > 0) || q.Where != nil {
 		filename += "_filtered"
 	}
 	return filename
@@ -11,6 +11,7 @@ import (
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
+	"golang.org/x/exp/slices"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -22,6 +23,8 @@ type MetricsViewAggregation struct {
 	TimeStart          *timestamppb.Timestamp                       `json:"time_start,omitempty"`
 	TimeEnd            *timestamppb.Timestamp                       `json:"time_end,omitempty"`
 	Filter             *runtimev1.MetricsViewFilter                 `json:"filter,omitempty"`
+	Where              *runtimev1.Expression                        `json:"where,omitempty"`
+	Having             *runtimev1.Expression                        `json:"having,omitempty"`
 	Priority           int32                                        `json:"priority,omitempty"`
 	Limit              *int64                                       `json:"limit,omitempty"`
 	Offset             int64                                        `json:"offset,omitempty"`
@@ -68,7 +71,7 @@ func (q *MetricsViewAggregation) Resolve(ctx context.Context, rt *runtime.Runtim
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid {
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectClickHouse {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
@@ -103,7 +106,7 @@ func (q *MetricsViewAggregation) Export(ctx context.Context, rt *runtime.Runtime
 	}
 
 	filename := strings.ReplaceAll(q.MetricsView.Model, `"`, `_`)
-	if q.TimeStart != nil || q.TimeEnd != nil || q.Filter != nil && (len(q.Filter.Include) > 0 || len(q.Filter.Exclude) > 0) {
+	if q.TimeStart != nil || q.TimeEnd != nil || q.Filter != nil && (len(q.Filter.Include) > 0 || len(q.Filter.Exclude) > 0) || q.Where != nil || q.Having != nil {
 		filename += "_filtered"
 	}
 
@@ -139,7 +142,18 @@ func (q *MetricsViewAggregation) buildMetricsAggregationSQL(mv *runtimev1.Metric
 	groupCols := make([]string, 0, len(q.Dimensions))
 	args := []any{}
 
+	// Expressions that identifiers in the having clause resolve to
+	havingMeasures := metricsViewMeasureExpressions(mv, nil, policy)
+	havingDims := make(map[string]string, len(q.Dimensions))
+
+	// Output columns and the time column that window measures are computed over
+	outputCols := make([]string, 0, len(q.Dimensions)+len(q.Measures))
+	timeCol := ""
+	var partitionCols []string
+
 	for _, d := range q.Dimensions {
+		outputCols = append(outputCols, safeName(d.Name))
+
 		// Handle regular dimensions
 		if d.TimeGrain == runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED {
 			col, err := metricsViewDimensionToSafeColumn(mv, d.Name)
@@ -149,6 +163,8 @@ func (q *MetricsViewAggregation) buildMetricsAggregationSQL(mv *runtimev1.Metric
 
 			selectCols = append(selectCols, fmt.Sprintf("%s as %s", col, safeName(d.Name)))
 			groupCols = append(groupCols, col)
+			havingDims[d.Name] = col
+			partitionCols = append(partitionCols, safeName(d.Name))
 			continue
 		}
 
@@ -160,18 +176,35 @@ func (q *MetricsViewAggregation) buildMetricsAggregationSQL(mv *runtimev1.Metric
 		selectCols = append(selectCols, fmt.Sprintf("%s as %s", expr, safeName(d.Name)))
 		groupCols = append(groupCols, expr)
 		args = append(args, exprArgs...)
+
+		// Prefer the metrics view's time dimension if several time dimensions are selected
+		if timeCol == "" || d.Name == mv.TimeDimension {
+			if timeCol != "" {
+				partitionCols = append(partitionCols, timeCol)
+			}
+			timeCol = safeName(d.Name)
+		} else {
+			partitionCols = append(partitionCols, safeName(d.Name))
+		}
 	}
 
+	var selected []*runtimev1.MetricsView_Measure
 	for _, m := range q.Measures {
+		outputCols = append(outputCols, safeName(m.Name))
 		switch m.BuiltinMeasure {
 		case runtimev1.BuiltinMeasure_BUILTIN_MEASURE_UNSPECIFIED:
-			expr, err := metricsViewMeasureExpression(mv, m.Name)
+			mm, err := lookupMeasure(mv, m.Name)
 			if err != nil {
 				return "", nil, err
 			}
-			selectCols = append(selectCols, fmt.Sprintf("%s as %s", expr, safeName(m.Name)))
+			selected = append(selected, mm)
+			// Derived measures are computed on top of the grouped query below
+			if !isDerivedMeasure(mm) {
+				selectCols = append(selectCols, fmt.Sprintf("%s as %s", mm.Expression, safeName(m.Name)))
+			}
 		case runtimev1.BuiltinMeasure_BUILTIN_MEASURE_COUNT:
 			selectCols = append(selectCols, fmt.Sprintf("COUNT(*) as %s", safeName(m.Name)))
+			havingMeasures[m.Name] = "COUNT(*)"
 		case runtimev1.BuiltinMeasure_BUILTIN_MEASURE_COUNT_DISTINCT:
 			if len(m.BuiltinMeasureArgs) != 1 {
 				return "", nil, fmt.Errorf("builtin measure '%s' expects 1 argument", m.BuiltinMeasure.String())
@@ -181,11 +214,23 @@ func (q *MetricsViewAggregation) buildMetricsAggregationSQL(mv *runtimev1.Metric
 				return "", nil, fmt.Errorf("builtin measure '%s' expects non-empty string argument, got '%v'", m.BuiltinMeasure.String(), m.BuiltinMeasureArgs[0])
 			}
 			selectCols = append(selectCols, fmt.Sprintf("COUNT(DISTINCT %s) as %s", safeName(arg), safeName(m.Name)))
+			havingMeasures[m.Name] = fmt.Sprintf("COUNT(DISTINCT %s)", safeName(arg))
 		default:
 			return "", nil, fmt.Errorf("unknown builtin measure '%d'", m.BuiltinMeasure)
 		}
 	}
 
+	// Add the measures referenced by derived measures that weren't selected
+	base, derived, err := splitDerivedMeasures(mv, selected)
+	if err != nil {
+		return "", nil, err
+	}
+	for _, m := range base {
+		if !slices.Contains(selected, m) {
+			selectCols = append(selectCols, fmt.Sprintf("%s as %s", m.Expression, safeName(m.Name)))
+		}
+	}
+
 	groupClause := ""
 	if len(groupCols) > 0 {
 		groupClause = "GROUP BY " + strings.Join(groupCols, ", ")
@@ -202,8 +247,8 @@ func (q *MetricsViewAggregation) buildMetricsAggregationSQL(mv *runtimev1.Metric
 			args = append(args, q.TimeEnd.AsTime())
 		}
 	}
-	if q.Filter != nil {
-		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, dialect, policy)
+	if q.Filter != nil || q.Where != nil {
+		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, q.Where, dialect, policy)
 		if err != nil {
 			return "", nil, err
 		}
@@ -214,6 +259,12 @@ func (q *MetricsViewAggregation) buildMetricsAggregationSQL(mv *runtimev1.Metric
 		whereClause = "WHERE 1=1" + whereClause
 	}
 
+	havingClause, havingArgs, err := buildHavingClause(q.Having, dialect, metricsViewHavingIdent(mv, havingMeasures, havingDims))
+	if err != nil {
+		return "", nil, err
+	}
+	args = append(args, havingArgs...)
+
 	sortingCriteria := make([]string, 0, len(q.Sort))
 	for _, s := range q.Sort {
 		sortCriterion := safeName(s.Name)
@@ -238,11 +289,34 @@ func (q *MetricsViewAggregation) buildMetricsAggregationSQL(mv *runtimev1.Metric
 		limitClause = fmt.Sprintf("LIMIT %d", *q.Limit)
 	}
 
-	sql := fmt.Sprintf("SELECT %s FROM %s %s %s %s %s OFFSET %d",
+	if len(derived) == 0 {
+		sql := fmt.Sprintf("SELECT %s FROM %s %s %s %s %s %s OFFSET %d",
+			strings.Join(selectCols, ", "),
+			safeName(mv.Model),
+			whereClause,
+			groupClause,
+			havingClause,
+			orderClause,
+			limitClause,
+			q.Offset,
+		)
+		return sql, args, nil
+	}
+
+	sql := fmt.Sprintf("SELECT %s FROM %s %s %s %s",
 		strings.Join(selectCols, ", "),
 		safeName(mv.Model),
 		whereClause,
 		groupClause,
+		havingClause,
+	)
+	sql, err = wrapDerivedMeasures(sql, derived, timeCol, partitionCols, dialect)
+	if err != nil {
+		return "", nil, err
+	}
+	sql = fmt.Sprintf("SELECT %s FROM (%s) %s %s OFFSET %d",
+		strings.Join(outputCols, ", "),
+		sql,
 		orderClause,
 		limitClause,
 		q.Offset,
@@ -274,6 +348,8 @@ func (q *MetricsViewAggregation) buildTimestampExpr(dim *runtimev1.MetricsViewAg
 			return fmt.Sprintf("date_trunc('%s', %s)", convertToDateTruncSpecifier(dim.TimeGrain), safeName(colName)), nil, nil
 		}
 		return fmt.Sprintf("time_floor(%s, '%s', null, CAST(? AS VARCHAR)))", safeName(colName), convertToDruidTimeFloorSpecifier(dim.TimeGrain)), []any{dim.TimeZone}, nil
+	case drivers.DialectClickHouse:
+		return clickHouseTimeFloor(safeName(colName), dim.TimeGrain, dim.TimeZone, 0, 0), nil, nil
 	default:
 		return "", nil, fmt.Errorf("unsupported dialect %q", dialect)
 	}
@@ -25,6 +25,8 @@ type MetricsViewComparisonToplist struct {
 	Offset              int64                                  `json:"offset,omitempty"`
 	Sort                []*runtimev1.MetricsViewComparisonSort `json:"sort,omitempty"`
 	Filter              *runtimev1.MetricsViewFilter           `json:"filter,omitempty"`
+	Where               *runtimev1.Expression                  `json:"where,omitempty"`
+	Having              *runtimev1.Expression                  `json:"having,omitempty"`
 	MetricsView         *runtimev1.MetricsView                 `json:"-"`
 	ResolvedMVSecurity  *runtime.ResolvedMetricsViewSecurity   `json:"security"`
 
@@ -68,7 +70,7 @@ func (q *MetricsViewComparisonToplist) Resolve(ctx context.Context, rt *runtime.
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid {
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectClickHouse {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
@@ -233,6 +235,9 @@ func (q *MetricsViewComparisonToplist) buildMetricsTopListSQL(mv *runtimev1.Metr
 	if err != nil {
 		return "", nil, err
 	}
+	if err := requireNoDerivedMeasures(ms); err != nil {
+		return "", nil, err
+	}
 
 	colName, err := metricsViewDimensionToSafeColumn(mv, q.DimensionName)
 	if err != nil {
@@ -252,8 +257,8 @@ func (q *MetricsViewComparisonToplist) buildMetricsTopListSQL(mv *runtimev1.Metr
 	td := safeName(mv.TimeDimension)
 
 	baseWhereClause += timeRangeClause(q.BaseTimeRange, td, &args)
-	if q.Filter != nil {
-		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, dialect, policy)
+	if q.Filter != nil || q.Where != nil {
+		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, q.Where, dialect, policy)
 		if err != nil {
 			return "", nil, err
 		}
@@ -262,6 +267,13 @@ func (q *MetricsViewComparisonToplist) buildMetricsTopListSQL(mv *runtimev1.Metr
 		args = append(args, clauseArgs...)
 	}
 
+	havingIdent := metricsViewHavingIdent(mv, metricsViewMeasureExpressions(mv, q.InlineMeasures, policy), map[string]string{q.DimensionName: colName})
+	havingClause, havingArgs, err := buildHavingClause(q.Having, dialect, havingIdent)
+	if err != nil {
+		return "", nil, err
+	}
+	args = append(args, havingArgs...)
+
 	orderClause := "true"
 	for _, s := range q.Sort {
 		orderClause += ", "
@@ -279,7 +291,7 @@ func (q *MetricsViewComparisonToplist) buildMetricsTopListSQL(mv *runtimev1.Metr
 	}
 
 	sql := fmt.Sprintf(
-		`SELECT %[1]s FROM %[3]q WHERE %[4]s GROUP BY %[2]s ORDER BY %[5]s LIMIT %[6]d OFFSET %[7]d`,
+		`SELECT %[1]s FROM %[3]q WHERE %[4]s GROUP BY %[2]s %[8]s ORDER BY %[5]s LIMIT %[6]d OFFSET %[7]d`,
 		selectClause,    // 1
 		colName,         // 2
 		mv.Model,        // 3
@@ -287,6 +299,7 @@ func (q *MetricsViewComparisonToplist) buildMetricsTopListSQL(mv *runtimev1.Metr
 		orderClause,     // 5
 		q.Limit,         // 6
 		q.Offset,        // 7
+		havingClause,    // 8
 	)
 
 	return sql, args, nil
@@ -297,6 +310,9 @@ func (q *MetricsViewComparisonToplist) buildMetricsComparisonTopListSQL(mv *runt
 	if err != nil {
 		return "", nil, err
 	}
+	if err := requireNoDerivedMeasures(ms); err != nil {
+		return "", nil, err
+	}
 
 	colName, err := metricsViewDimensionToSafeColumn(mv, q.DimensionName)
 	if err != nil {
@@ -312,18 +328,28 @@ func (q *MetricsViewComparisonToplist) buildMetricsComparisonTopListSQL(mv *runt
 		expr := fmt.Sprintf(`%s as %s`, m.Expression, safeName(m.Name))
 		selectCols = append(selectCols, expr)
 		var columnsTuple string
-		if dialect != drivers.DialectDruid {
+		switch dialect {
+		case drivers.DialectDruid:
 			columnsTuple = fmt.Sprintf(
-				"base.%[1]s, comparison.%[1]s AS %[2]s, base.%[1]s - comparison.%[1]s AS %[3]s, (base.%[1]s - comparison.%[1]s)/comparison.%[1]s::DOUBLE AS %[4]s",
+				"ANY_VALUE(base.%[1]s), ANY_VALUE(comparison.%[1]s), ANY_VALUE(base.%[1]s - comparison.%[1]s), ANY_VALUE(SAFE_DIVIDE(base.%[1]s - comparison.%[1]s, CAST(comparison.%[1]s AS FLOAT))",
+				safeName(m.Name),
+			)
+		case drivers.DialectClickHouse:
+			// ClickHouse returns inf instead of NULL on division by zero
+			columnsTuple = fmt.Sprintf(
+				"base.%[1]s, comparison.%[1]s AS %[2]s, base.%[1]s - comparison.%[1]s AS %[3]s, (base.%[1]s - comparison.%[1]s)/nullIf(comparison.%[1]s, 0) AS %[4]s",
 				safeName(m.Name),
 				safeName(m.Name+"__previous"),
 				safeName(m.Name+"__delta_abs"),
 				safeName(m.Name+"__delta_rel"),
 			)
-		} else {
+		default:
 			columnsTuple = fmt.Sprintf(
-				"ANY_VALUE(base.%[1]s), ANY_VALUE(comparison.%[1]s), ANY_VALUE(base.%[1]s - comparison.%[1]s), ANY_VALUE(SAFE_DIVIDE(base.%[1]s - comparison.%[1]s, CAST(comparison.%[1]s AS FLOAT))",
+				"base.%[1]s, comparison.%[1]s AS %[2]s, base.%[1]s - comparison.%[1]s AS %[3]s, (base.%[1]s - comparison.%[1]s)/comparison.%[1]s::DOUBLE AS %[4]s",
 				safeName(m.Name),
+				safeName(m.Name+"__previous"),
+				safeName(m.Name+"__delta_abs"),
+				safeName(m.Name+"__delta_rel"),
 			)
 		}
 		finalSelectCols = append(
@@ -346,8 +372,8 @@ func (q *MetricsViewComparisonToplist) buildMetricsComparisonTopListSQL(mv *runt
 	td := safeName(mv.TimeDimension)
 
 	baseWhereClause += timeRangeClause(q.BaseTimeRange, td, &args)
-	if q.Filter != nil {
-		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, dialect, policy)
+	if q.Filter != nil || q.Where != nil {
+		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, q.Where, dialect, policy)
 		if err != nil {
 			return "", nil, err
 		}
@@ -357,8 +383,8 @@ func (q *MetricsViewComparisonToplist) buildMetricsComparisonTopListSQL(mv *runt
 	}
 
 	comparisonWhereClause += timeRangeClause(q.ComparisonTimeRange, td, &args)
-	if q.Filter != nil {
-		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, dialect, policy)
+	if q.Filter != nil || q.Where != nil {
+		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, q.Where, dialect, policy)
 		if err != nil {
 			return "", nil, err
 		}
@@ -367,6 +393,22 @@ func (q *MetricsViewComparisonToplist) buildMetricsComparisonTopListSQL(mv *runt
 		args = append(args, clauseArgs...)
 	}
 
+	// The having expression filters on the measures of the base time range.
+	// It's applied to the joined rows so that dimension values are filtered out of both time ranges.
+	havingMeasures := make(map[string]string, len(ms))
+	for _, m := range ms {
+		havingMeasures[m.Name] = "base." + safeName(m.Name)
+	}
+	havingDims := map[string]string{q.DimensionName: fmt.Sprintf("COALESCE(base.%[1]s, comparison.%[1]s)", colName)}
+	havingClause, havingArgs, err := buildExpression(q.Having, dialect, metricsViewHavingIdent(mv, havingMeasures, havingDims))
+	if err != nil {
+		return "", nil, err
+	}
+	if havingClause != "" {
+		havingClause = "WHERE " + havingClause
+	}
+	args = append(args, havingArgs...)
+
 	err = validateSort(q.Sort)
 	if err != nil {
 		return "", nil, err
@@ -418,6 +460,15 @@ func (q *MetricsViewComparisonToplist) buildMetricsComparisonTopListSQL(mv *runt
 		q.Limit = 100
 	}
 
+	// ClickHouse fills unmatched rows of outer joins with default values instead of NULL unless join_use_nulls is set,
+	// and only supports NULL-safe comparisons in join conditions using isNotDistinctFrom.
+	joinCondition := fmt.Sprintf("base.%[1]s = comparison.%[1]s OR (base.%[1]s is null and comparison.%[1]s is null)", colName)
+	settingsClause := ""
+	if dialect == drivers.DialectClickHouse {
+		joinCondition = fmt.Sprintf("isNotDistinctFrom(base.%[1]s, comparison.%[1]s)", colName)
+		settingsClause = "SETTINGS join_use_nulls = 1"
+	}
+
 	var sql string
 	if dialect != drivers.DialectDruid {
 		sql = fmt.Sprintf(`
@@ -430,13 +481,15 @@ func (q *MetricsViewComparisonToplist) buildMetricsComparisonTopListSQL(mv *runt
 				SELECT %[1]s FROM %[3]q WHERE %[5]s GROUP BY %[2]s
 			) comparison
 		ON
-				base.%[2]s = comparison.%[2]s OR (base.%[2]s is null and comparison.%[2]s is null)
+				%[11]s
+		%[13]s
 		ORDER BY
 			%[6]s
 		LIMIT
 			%[7]d
 		OFFSET
 			%[8]d
+		%[12]s
 		`,
 			subSelectClause,           // 1
 			colName,                   // 2
@@ -448,6 +501,9 @@ func (q *MetricsViewComparisonToplist) buildMetricsComparisonTopListSQL(mv *runt
 			q.Offset,                  // 8
 			finalSelectClause,         // 9
 			safeName(q.DimensionName), // 10
+			joinCondition,             // 11
+			settingsClause,            // 12
+			havingClause,              // 13
 		)
 	} else {
 		/*
@@ -479,7 +535,8 @@ func (q *MetricsViewComparisonToplist) buildMetricsComparisonTopListSQL(mv *runt
 			LIMIT 10
 		*/
 		// Apache Druid requires that one part of the JOIN fits in memory, that can be achieved by pushing down the limit clause to a subquery (works only if the sorting is based entirely on a single subquery result)
-		if q.Sort[0].Type == runtimev1.MetricsViewComparisonSortType_METRICS_VIEW_COMPARISON_SORT_TYPE_COMPARISON_VALUE || q.Sort[0].Type == runtimev1.MetricsViewComparisonSortType_METRICS_VIEW_COMPARISON_SORT_TYPE_BASE_VALUE {
+		// The limit can't be pushed down if the joined rows are filtered by a having expression.
+		if havingClause == "" && (q.Sort[0].Type == runtimev1.MetricsViewComparisonSortType_METRICS_VIEW_COMPARISON_SORT_TYPE_COMPARISON_VALUE || q.Sort[0].Type == runtimev1.MetricsViewComparisonSortType_METRICS_VIEW_COMPARISON_SORT_TYPE_BASE_VALUE) {
 			leftSubQueryAlias := "base"
 			rightSubQueryAlias := "comparison"
 			leftWhereClause := baseWhereClause
@@ -536,6 +593,7 @@ func (q *MetricsViewComparisonToplist) buildMetricsComparisonTopListSQL(mv *runt
 					) comparison
 				ON
 						base.%[2]s = comparison.%[2]s OR (base.%[2]s is null and comparison.%[2]s is null)
+				%[10]s
 				ORDER BY
 					%[6]s
 				LIMIT
@@ -552,6 +610,7 @@ func (q *MetricsViewComparisonToplist) buildMetricsComparisonTopListSQL(mv *runt
 				q.Limit,               // 7
 				q.Offset,              // 8
 				finalSelectClause,     // 9
+				havingClause,          // 10
 			)
 		}
 	}
@@ -592,7 +651,7 @@ func (q *MetricsViewComparisonToplist) Export(ctx context.Context, rt *runtime.R
 				return err
 			}
 		}
-	case drivers.DialectDruid:
+	case drivers.DialectDruid, drivers.DialectClickHouse:
 		if err := q.generalExport(ctx, rt, instanceID, w, opts, q.MetricsView); err != nil {
 			return err
 		}
@@ -710,7 +769,7 @@ func (q *MetricsViewComparisonToplist) generalExport(ctx context.Context, rt *ru
 func (q *MetricsViewComparisonToplist) generateFilename(mv *runtimev1.MetricsView) string {
 	filename := strings.ReplaceAll(mv.Name, `"`, `_`)
 	filename += "_" + q.DimensionName
-	if q.Filter != nil && (len(q.Filter.Include) > 0 || len(q.Filter.Exclude) > 0) {
+	if q.Filter != nil && (len(q.Filter.Include) > 0 || len(q.Filter.Exclude) > 0) || q.Where != nil || q.Having != nil {
 		filename += "_filtered"
 	}
 	return filename
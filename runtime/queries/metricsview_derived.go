@@ -0,0 +1,108 @@
+package queries
+
+import (
+	"fmt"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// isDerivedMeasure returns true if the measure is computed from other measures instead of directly from the model.
+func isDerivedMeasure(m *runtimev1.MetricsView_Measure) bool {
+	return len(m.ReferencedMeasures) > 0 || m.Window != nil
+}
+
+// splitDerivedMeasures splits the selected measures into the measures to aggregate from the model and the derived measures to compute on top of them.
+// Derived measures are returned in dependency order. Measures they reference are added to the aggregated measures even if they weren't selected.
+func splitDerivedMeasures(mv *runtimev1.MetricsView, selected []*runtimev1.MetricsView_Measure) ([]*runtimev1.MetricsView_Measure, []*runtimev1.MetricsView_Measure, error) {
+	var base, derived []*runtimev1.MetricsView_Measure
+	seen := make(map[string]bool)
+
+	var visit func(m *runtimev1.MetricsView_Measure, path []string) error
+	visit = func(m *runtimev1.MetricsView_Measure, path []string) error {
+		if seen[m.Name] {
+			return nil
+		}
+		for _, p := range path {
+			if p == m.Name {
+				return fmt.Errorf("found cycle in measure references: %s", strings.Join(append(path, m.Name), " -> "))
+			}
+		}
+
+		if !isDerivedMeasure(m) {
+			seen[m.Name] = true
+			base = append(base, m)
+			return nil
+		}
+
+		for _, ref := range m.ReferencedMeasures {
+			rm, err := lookupMeasure(mv, ref)
+			if err != nil {
+				return err
+			}
+			if err := visit(rm, append(path, m.Name)); err != nil {
+				return err
+			}
+		}
+		seen[m.Name] = true
+		derived = append(derived, m)
+		return nil
+	}
+
+	for _, m := range selected {
+		if err := visit(m, nil); err != nil {
+			return nil, nil, err
+		}
+	}
+	return base, derived, nil
+}
+
+// requireNoDerivedMeasures returns an error if any of the measures is a derived measure.
+// It is used by queries that don't support computing derived measures.
+func requireNoDerivedMeasures(ms []*runtimev1.MetricsView_Measure) error {
+	for _, m := range ms {
+		if isDerivedMeasure(m) {
+			return fmt.Errorf("measure %q references other measures, which is not supported for this query", m.Name)
+		}
+	}
+	return nil
+}
+
+func lookupMeasure(mv *runtimev1.MetricsView, name string) (*runtimev1.MetricsView_Measure, error) {
+	for _, m := range mv.Measures {
+		if strings.EqualFold(m.Name, name) {
+			return m, nil
+		}
+	}
+	return nil, fmt.Errorf("measure does not exist: '%s'", name)
+}
+
+// wrapDerivedMeasures wraps a query that outputs the measures referenced by the derived measures in subqueries that compute the derived measures.
+// Window measures are ordered by timeCol and partitioned by partitionCols (both must be safe SQL names).
+// If timeCol is empty, window measures are not supported.
+func wrapDerivedMeasures(sql string, derived []*runtimev1.MetricsView_Measure, timeCol string, partitionCols []string, dialect drivers.Dialect) (string, error) {
+	for _, m := range derived {
+		expr := m.Expression
+		if m.Window != nil {
+			if dialect == drivers.DialectDruid {
+				return "", fmt.Errorf("measure %q has a window, which is not supported for %s", m.Name, dialect.String())
+			}
+			if timeCol == "" {
+				return "", fmt.Errorf("measure %q has a window, which requires the query to be grouped by the time dimension", m.Name)
+			}
+
+			var over []string
+			if m.Window.Partition && len(partitionCols) > 0 {
+				over = append(over, "PARTITION BY "+strings.Join(partitionCols, ", "))
+			}
+			over = append(over, "ORDER BY "+timeCol)
+			if m.Window.Frame != "" {
+				over = append(over, m.Window.Frame)
+			}
+			expr = fmt.Sprintf("%s OVER (%s)", expr, strings.Join(over, " "))
+		}
+		sql = fmt.Sprintf("SELECT *, %s AS %s FROM (%s)", expr, safeName(m.Name), sql)
+	}
+	return sql, nil
+}
@@ -0,0 +1,82 @@
+package queries
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitDerivedMeasures(t *testing.T) {
+	mv := &runtimev1.MetricsView{
+		Measures: []*runtimev1.MetricsView_Measure{
+			{Name: "revenue", Expression: "sum(price)"},
+			{Name: "users", Expression: "count(distinct user_id)"},
+			{Name: "rpu", Expression: "revenue / users", ReferencedMeasures: []string{"revenue", "users"}},
+			{Name: "rpu_avg", Expression: "avg(rpu)", ReferencedMeasures: []string{"rpu"}, Window: &runtimev1.MetricsView_MeasureWindow{}},
+			{Name: "missing", Expression: "foo", ReferencedMeasures: []string{"foo"}},
+		},
+	}
+	revenue, users, rpu, rpuAvg := mv.Measures[0], mv.Measures[1], mv.Measures[2], mv.Measures[3]
+
+	base, derived, err := splitDerivedMeasures(mv, []*runtimev1.MetricsView_Measure{rpuAvg, users})
+	require.NoError(t, err)
+	require.Equal(t, []*runtimev1.MetricsView_Measure{revenue, users}, base)
+	require.Equal(t, []*runtimev1.MetricsView_Measure{rpu, rpuAvg}, derived)
+
+	base, derived, err = splitDerivedMeasures(mv, []*runtimev1.MetricsView_Measure{revenue})
+	require.NoError(t, err)
+	require.Equal(t, []*runtimev1.MetricsView_Measure{revenue}, base)
+	require.Empty(t, derived)
+
+	_, _, err = splitDerivedMeasures(mv, []*runtimev1.MetricsView_Measure{mv.Measures[4]})
+	require.Error(t, err)
+}
+
+func TestWrapDerivedMeasures(t *testing.T) {
+	derived := []*runtimev1.MetricsView_Measure{
+		{Name: "rpu", Expression: "revenue / users"},
+		{Name: "running", Expression: "sum(revenue)", Window: &runtimev1.MetricsView_MeasureWindow{Partition: true}},
+		{Name: "rolling", Expression: "avg(rpu)", Window: &runtimev1.MetricsView_MeasureWindow{Frame: "ROWS BETWEEN 6 PRECEDING AND CURRENT ROW"}},
+	}
+
+	sql, err := wrapDerivedMeasures("SELECT 1", derived, `"ts"`, []string{`"pub"`}, drivers.DialectDuckDB)
+	require.NoError(t, err)
+	require.Equal(t,
+		`SELECT *, avg(rpu) OVER (ORDER BY "ts" ROWS BETWEEN 6 PRECEDING AND CURRENT ROW) AS "rolling" FROM (`+
+			`SELECT *, sum(revenue) OVER (PARTITION BY "pub" ORDER BY "ts") AS "running" FROM (`+
+			`SELECT *, revenue / users AS "rpu" FROM (SELECT 1)))`,
+		sql,
+	)
+
+	_, err = wrapDerivedMeasures("SELECT 1", derived, "", nil, drivers.DialectDuckDB)
+	require.ErrorContains(t, err, "grouped by the time dimension")
+
+	_, err = wrapDerivedMeasures("SELECT 1", derived, `"ts"`, nil, drivers.DialectDruid)
+	require.ErrorContains(t, err, "not supported for druid")
+
+	sql, err = wrapDerivedMeasures("SELECT 1", derived[:1], "", nil, drivers.DialectDruid)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT *, revenue / users AS "rpu" FROM (SELECT 1)`, sql)
+}
+
+func TestMetricsViewHavingIdentDerivedMeasure(t *testing.T) {
+	mv := &runtimev1.MetricsView{
+		Measures: []*runtimev1.MetricsView_Measure{
+			{Name: "revenue", Expression: "sum(price)"},
+			{Name: "running", Expression: "sum(revenue)", ReferencedMeasures: []string{"revenue"}, Window: &runtimev1.MetricsView_MeasureWindow{}},
+		},
+	}
+	ident := metricsViewHavingIdent(mv, metricsViewMeasureExpressions(mv, nil, nil), map[string]string{"pub": `"pub"`})
+
+	expr, err := ident("revenue")
+	require.NoError(t, err)
+	require.Equal(t, "(sum(price))", expr)
+
+	_, err = ident("running")
+	require.ErrorContains(t, err, "not supported in having")
+
+	_, err = ident("missing")
+	require.ErrorContains(t, err, "not found")
+}
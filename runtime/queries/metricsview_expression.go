@@ -0,0 +1,450 @@
+package queries
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/pbutil"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// identResolver resolves an identifier in an expression to a SQL expression
+type identResolver func(name string) (string, error)
+
+// metricsViewWhere returns the expression to filter the rows of a metrics view query by.
+// The deprecated filter is converted to an expression. It can't be combined with an expression.
+func metricsViewWhere(filter *runtimev1.MetricsViewFilter, where *runtimev1.Expression) (*runtimev1.Expression, error) {
+	if filter != nil && where != nil {
+		return nil, errors.New("both filter and where is provided")
+	}
+	if where != nil {
+		return where, nil
+	}
+	return convertFilterToExpression(filter), nil
+}
+
+// convertFilterToExpression converts a MetricsViewFilter to an equivalent expression.
+// The include conditions match any of a dimension's values or patterns, the exclude conditions match none of them.
+// It returns nil if the filter doesn't contain any conditions.
+func convertFilterToExpression(filter *runtimev1.MetricsViewFilter) *runtimev1.Expression {
+	if filter == nil {
+		return nil
+	}
+
+	var exprs []*runtimev1.Expression
+	for _, cond := range filter.Include {
+		if e := convertFilterConditionToExpression(cond, false); e != nil {
+			exprs = append(exprs, e)
+		}
+	}
+	for _, cond := range filter.Exclude {
+		if e := convertFilterConditionToExpression(cond, true); e != nil {
+			exprs = append(exprs, e)
+		}
+	}
+
+	if len(exprs) == 0 {
+		return nil
+	}
+	if len(exprs) == 1 {
+		return exprs[0]
+	}
+	return conditionExpression(runtimev1.Operation_OPERATION_AND, exprs...)
+}
+
+func convertFilterConditionToExpression(cond *runtimev1.MetricsViewFilter_Cond, exclude bool) *runtimev1.Expression {
+	inOp, likeOp, joinOp := runtimev1.Operation_OPERATION_IN, runtimev1.Operation_OPERATION_LIKE, runtimev1.Operation_OPERATION_OR
+	if exclude {
+		inOp, likeOp, joinOp = runtimev1.Operation_OPERATION_NIN, runtimev1.Operation_OPERATION_NLIKE, runtimev1.Operation_OPERATION_AND
+	}
+
+	var exprs []*runtimev1.Expression
+	if len(cond.In) > 0 {
+		in := []*runtimev1.Expression{identExpression(cond.Name)}
+		for _, v := range cond.In {
+			in = append(in, valueExpression(v))
+		}
+		exprs = append(exprs, conditionExpression(inOp, in...))
+	}
+	for _, l := range cond.Like {
+		exprs = append(exprs, conditionExpression(likeOp, identExpression(cond.Name), valueExpression(structpb.NewStringValue(l))))
+	}
+
+	if len(exprs) == 0 {
+		return nil
+	}
+	if len(exprs) == 1 {
+		return exprs[0]
+	}
+	return conditionExpression(joinOp, exprs...)
+}
+
+func identExpression(name string) *runtimev1.Expression {
+	return &runtimev1.Expression{Expression: &runtimev1.Expression_Ident{Ident: name}}
+}
+
+func valueExpression(val *structpb.Value) *runtimev1.Expression {
+	return &runtimev1.Expression{Expression: &runtimev1.Expression_Val{Val: val}}
+}
+
+func conditionExpression(op runtimev1.Operation, exprs ...*runtimev1.Expression) *runtimev1.Expression {
+	return &runtimev1.Expression{Expression: &runtimev1.Expression_Cond{Cond: &runtimev1.Condition{Op: op, Exprs: exprs}}}
+}
+
+// metricsViewWhereIdent resolves identifiers in a where expression to dimension columns.
+// Measures can't be referenced before aggregation, so they must be filtered on using a having expression.
+func metricsViewWhereIdent(mv *runtimev1.MetricsView) identResolver {
+	return func(name string) (string, error) {
+		col, err := metricsViewDimensionToSafeColumn(mv, name)
+		if err == nil {
+			return col, nil
+		}
+		if mv.TimeDimension != "" && strings.EqualFold(name, mv.TimeDimension) {
+			return safeName(mv.TimeDimension), nil
+		}
+		if _, merr := metricsViewMeasureExpression(mv, name); merr == nil {
+			return "", fmt.Errorf("measure %s can only be filtered on in having", name)
+		}
+		return "", err
+	}
+}
+
+// metricsViewHavingIdent resolves identifiers in a having expression of a grouped query.
+// Measures resolve to their aggregate expressions and dimensions to their (grouped) columns.
+// Derived measures are computed on top of the grouped query, so they can only be referenced if they are in measures.
+func metricsViewHavingIdent(mv *runtimev1.MetricsView, measures, dimensions map[string]string) identResolver {
+	return func(name string) (string, error) {
+		if expr, ok := measures[name]; ok {
+			return expr, nil
+		}
+		if col, ok := dimensions[name]; ok {
+			return col, nil
+		}
+		if m, err := lookupMeasure(mv, name); err == nil && isDerivedMeasure(m) {
+			return "", fmt.Errorf("having: measure %s references other measures or has a window, which is not supported in having", name)
+		}
+		return "", fmt.Errorf("having: measure or dimension %s not found", name)
+	}
+}
+
+// metricsViewMeasureExpressions returns the aggregate expressions of the metrics view's measures and the inline measures by name.
+// Measures hidden by the security policy are omitted, so they can't be referenced in having.
+func metricsViewMeasureExpressions(mv *runtimev1.MetricsView, inlines []*runtimev1.InlineMeasure, policy *runtime.ResolvedMetricsViewSecurity) map[string]string {
+	res := make(map[string]string, len(mv.Measures)+len(inlines))
+	for _, m := range mv.Measures {
+		// Derived measures are computed after grouping, so they can't be referenced in having
+		if isDerivedMeasure(m) || !policy.CanAccessField(m.Name) {
+			continue
+		}
+		res[m.Name] = "(" + m.Expression + ")"
+	}
+	// Inline measures take precedence
+	for _, m := range inlines {
+		res[m.Name] = "(" + m.Expression + ")"
+	}
+	return res
+}
+
+// buildExpression builds a SQL boolean expression for an expression tree.
+// It returns an empty string if the expression is empty, e.g. an AND condition without sub-expressions.
+func buildExpression(expr *runtimev1.Expression, dialect drivers.Dialect, ident identResolver) (string, []any, error) {
+	b := &expressionBuilder{dialect: dialect, ident: ident}
+	return b.build(expr)
+}
+
+type expressionBuilder struct {
+	dialect drivers.Dialect
+	ident   identResolver
+}
+
+func (b *expressionBuilder) build(expr *runtimev1.Expression) (string, []any, error) {
+	if expr == nil {
+		return "", nil, nil
+	}
+
+	switch e := expr.Expression.(type) {
+	case *runtimev1.Expression_Ident:
+		clause, err := b.ident(e.Ident)
+		if err != nil {
+			return "", nil, err
+		}
+		return clause, nil, nil
+	case *runtimev1.Expression_Val:
+		if isNullValue(e.Val) {
+			return "NULL", nil, nil
+		}
+		arg, err := pbutil.FromValue(e.Val)
+		if err != nil {
+			return "", nil, fmt.Errorf("filter error: %w", err)
+		}
+		return "?", []any{arg}, nil
+	case *runtimev1.Expression_Cond:
+		return b.buildCondition(e.Cond)
+	case nil:
+		return "", nil, nil
+	default:
+		return "", nil, fmt.Errorf("filter error: unsupported expression %T", e)
+	}
+}
+
+func (b *expressionBuilder) buildCondition(cond *runtimev1.Condition) (string, []any, error) {
+	switch cond.Op {
+	case runtimev1.Operation_OPERATION_AND:
+		return b.buildJoin(cond.Exprs, " AND ")
+	case runtimev1.Operation_OPERATION_OR:
+		return b.buildJoin(cond.Exprs, " OR ")
+	case runtimev1.Operation_OPERATION_NOT:
+		if len(cond.Exprs) != 1 {
+			return "", nil, fmt.Errorf("filter error: %s expects 1 expression, got %d", cond.Op, len(cond.Exprs))
+		}
+		clause, args, err := b.build(cond.Exprs[0])
+		if err != nil || clause == "" {
+			return "", nil, err
+		}
+		return fmt.Sprintf("NOT (%s)", clause), args, nil
+	case runtimev1.Operation_OPERATION_IN, runtimev1.Operation_OPERATION_NIN:
+		return b.buildIn(cond)
+	case runtimev1.Operation_OPERATION_LIKE, runtimev1.Operation_OPERATION_NLIKE:
+		return b.buildLike(cond)
+	case runtimev1.Operation_OPERATION_EQ, runtimev1.Operation_OPERATION_NEQ, runtimev1.Operation_OPERATION_LT, runtimev1.Operation_OPERATION_LTE, runtimev1.Operation_OPERATION_GT, runtimev1.Operation_OPERATION_GTE:
+		return b.buildComparison(cond)
+	default:
+		return "", nil, fmt.Errorf("filter error: unsupported operation %s", cond.Op)
+	}
+}
+
+// buildJoin joins the non-empty sub-expressions with AND or OR
+func (b *expressionBuilder) buildJoin(exprs []*runtimev1.Expression, joiner string) (string, []any, error) {
+	var clauses []string
+	var args []any
+	for _, expr := range exprs {
+		clause, clauseArgs, err := b.build(expr)
+		if err != nil {
+			return "", nil, err
+		}
+		if clause == "" {
+			continue
+		}
+		clauses = append(clauses, clause)
+		args = append(args, clauseArgs...)
+	}
+
+	if len(clauses) == 0 {
+		return "", nil, nil
+	}
+	if len(clauses) == 1 {
+		return clauses[0], args, nil
+	}
+	return "(" + strings.Join(clauses, joiner) + ")", args, nil
+}
+
+func (b *expressionBuilder) buildComparison(cond *runtimev1.Condition) (string, []any, error) {
+	left, right, err := b.buildOperands(cond)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Comparisons with NULL are never true, so EQ and NEQ check for nulls instead
+	if isNullExpression(cond.Exprs[1]) || isNullExpression(cond.Exprs[0]) {
+		operand := left
+		if isNullExpression(cond.Exprs[0]) {
+			operand = right
+		}
+		switch cond.Op {
+		case runtimev1.Operation_OPERATION_EQ:
+			return fmt.Sprintf("%s IS NULL", operand.clause), operand.args, nil
+		case runtimev1.Operation_OPERATION_NEQ:
+			return fmt.Sprintf("%s IS NOT NULL", operand.clause), operand.args, nil
+		default:
+			return "", nil, fmt.Errorf("filter error: %s can't be applied to null", cond.Op)
+		}
+	}
+
+	var op string
+	switch cond.Op {
+	case runtimev1.Operation_OPERATION_EQ:
+		op = "="
+	case runtimev1.Operation_OPERATION_NEQ:
+		op = "!="
+	case runtimev1.Operation_OPERATION_LT:
+		op = "<"
+	case runtimev1.Operation_OPERATION_LTE:
+		op = "<="
+	case runtimev1.Operation_OPERATION_GT:
+		op = ">"
+	case runtimev1.Operation_OPERATION_GTE:
+		op = ">="
+	}
+
+	args := make([]any, 0, len(left.args)+len(right.args))
+	args = append(args, left.args...)
+	args = append(args, right.args...)
+	return fmt.Sprintf("%s %s %s", left.clause, op, right.clause), args, nil
+}
+
+// buildIn builds "x [NOT] IN (...)".
+// NOTE: DuckDB doesn't handle NULL values in an "IN" expression. They must be checked with a "x IS [NOT] NULL" clause.
+func (b *expressionBuilder) buildIn(cond *runtimev1.Condition) (string, []any, error) {
+	if len(cond.Exprs) == 0 {
+		return "", nil, fmt.Errorf("filter error: %s expects at least 1 expression", cond.Op)
+	}
+	exclude := cond.Op == runtimev1.Operation_OPERATION_NIN
+
+	left, err := b.buildOperand(cond.Exprs[0])
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Values may be passed as separate expressions or as a list value
+	var values []*runtimev1.Expression
+	for _, expr := range cond.Exprs[1:] {
+		if list := expr.GetVal().GetListValue(); list != nil {
+			for _, v := range list.Values {
+				values = append(values, valueExpression(v))
+			}
+			continue
+		}
+		values = append(values, expr)
+	}
+
+	hasNull := false
+	var placeholders []string
+	var valueArgs []any
+	for _, v := range values {
+		if isNullExpression(v) {
+			hasNull = true
+			continue
+		}
+		clause, args, err := b.build(v)
+		if err != nil {
+			return "", nil, err
+		}
+		placeholders = append(placeholders, clause)
+		valueArgs = append(valueArgs, args...)
+	}
+
+	var clauses []string
+	var args []any
+	notKeyword := ""
+	if exclude {
+		notKeyword = "NOT "
+	}
+	if len(placeholders) > 0 {
+		clauses = append(clauses, fmt.Sprintf("%s %sIN (%s)", left.clause, notKeyword, strings.Join(placeholders, ",")))
+		args = append(args, left.args...)
+		args = append(args, valueArgs...)
+	}
+
+	if !exclude {
+		if hasNull {
+			clauses = append(clauses, fmt.Sprintf("%s IS NULL", left.clause))
+			args = append(args, left.args...)
+		}
+		if len(clauses) == 0 {
+			// An empty list matches nothing
+			return "1=0", nil, nil
+		}
+		if len(clauses) == 1 {
+			return clauses[0], args, nil
+		}
+		return "(" + strings.Join(clauses, " OR ") + ")", args, nil
+	}
+
+	// When you have "x NOT IN (a, b, ...)", then NULL values are always excluded, even if NULL is not in the list.
+	// E.g. this returns zero rows: "select * from (select 1 as a union select null as a) where a not in (1)"
+	// We need to explicitly include them unless NULL is in the list.
+	if hasNull {
+		clauses = append(clauses, fmt.Sprintf("%s IS NOT NULL", left.clause))
+		args = append(args, left.args...)
+		if len(clauses) == 1 {
+			return clauses[0], args, nil
+		}
+		return "(" + strings.Join(clauses, " AND ") + ")", args, nil
+	}
+	if len(clauses) == 0 {
+		// An empty list excludes nothing
+		return "1=1", nil, nil
+	}
+	clauses = append(clauses, fmt.Sprintf("%s IS NULL", left.clause))
+	args = append(args, left.args...)
+	return "(" + strings.Join(clauses, " OR ") + ")", args, nil
+}
+
+// buildLike builds a case insensitive "x [NOT] ILIKE ?".
+// Like for NIN, NULL values are not excluded by NLIKE.
+func (b *expressionBuilder) buildLike(cond *runtimev1.Condition) (string, []any, error) {
+	left, right, err := b.buildOperands(cond)
+	if err != nil {
+		return "", nil, err
+	}
+
+	notKeyword := ""
+	if cond.Op == runtimev1.Operation_OPERATION_NLIKE {
+		notKeyword = "NOT "
+	}
+
+	var clause string
+	if b.dialect == drivers.DialectDruid {
+		// Druid does not support ILIKE
+		clause = fmt.Sprintf("LOWER(%s) %sLIKE LOWER(%s)", left.clause, notKeyword, right.clause)
+	} else {
+		clause = fmt.Sprintf("%s %sILIKE %s", left.clause, notKeyword, right.clause)
+	}
+	args := make([]any, 0, len(left.args)+len(right.args))
+	args = append(args, left.args...)
+	args = append(args, right.args...)
+
+	if cond.Op == runtimev1.Operation_OPERATION_NLIKE {
+		clause = fmt.Sprintf("(%s OR %s IS NULL)", clause, left.clause)
+		args = append(args, left.args...)
+	}
+	return clause, args, nil
+}
+
+type operand struct {
+	clause string
+	args   []any
+}
+
+func (b *expressionBuilder) buildOperands(cond *runtimev1.Condition) (operand, operand, error) {
+	if len(cond.Exprs) != 2 {
+		return operand{}, operand{}, fmt.Errorf("filter error: %s expects 2 expressions, got %d", cond.Op, len(cond.Exprs))
+	}
+	left, err := b.buildOperand(cond.Exprs[0])
+	if err != nil {
+		return operand{}, operand{}, err
+	}
+	right, err := b.buildOperand(cond.Exprs[1])
+	if err != nil {
+		return operand{}, operand{}, err
+	}
+	return left, right, nil
+}
+
+func (b *expressionBuilder) buildOperand(expr *runtimev1.Expression) (operand, error) {
+	clause, args, err := b.build(expr)
+	if err != nil {
+		return operand{}, err
+	}
+	if clause == "" {
+		return operand{}, errors.New("filter error: empty operand")
+	}
+	if expr.GetCond() != nil {
+		clause = "(" + clause + ")"
+	}
+	return operand{clause: clause, args: args}, nil
+}
+
+func isNullExpression(expr *runtimev1.Expression) bool {
+	val := expr.GetVal()
+	return val != nil && isNullValue(val)
+}
+
+func isNullValue(val *structpb.Value) bool {
+	_, ok := val.Kind.(*structpb.Value_NullValue)
+	return ok
+}
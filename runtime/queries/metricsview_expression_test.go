@@ -0,0 +1,183 @@
+package queries
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestBuildExpression(t *testing.T) {
+	mv := &runtimev1.MetricsView{
+		TimeDimension: "ts",
+		Dimensions: []*runtimev1.MetricsView_Dimension{
+			{Name: "pub", Column: "publisher"},
+			{Name: "dom", Column: "domain"},
+			{Name: "price", Column: "bid_price"},
+		},
+		Measures: []*runtimev1.MetricsView_Measure{
+			{Name: "revenue", Expression: "sum(bid_price)"},
+		},
+	}
+
+	ident := identExpression
+	str := func(s string) *runtimev1.Expression { return valueExpression(structpb.NewStringValue(s)) }
+	num := func(n float64) *runtimev1.Expression { return valueExpression(structpb.NewNumberValue(n)) }
+	null := valueExpression(structpb.NewNullValue())
+	cond := conditionExpression
+
+	tests := []struct {
+		name    string
+		expr    *runtimev1.Expression
+		dialect drivers.Dialect
+		sql     string
+		args    []any
+		wantErr bool
+	}{
+		{
+			name: "comparison",
+			expr: cond(runtimev1.Operation_OPERATION_GT, ident("price"), num(100)),
+			sql:  `"bid_price" > ?`,
+			args: []any{100.0},
+		},
+		{
+			name: "range",
+			expr: cond(runtimev1.Operation_OPERATION_AND,
+				cond(runtimev1.Operation_OPERATION_GTE, ident("price"), num(1)),
+				cond(runtimev1.Operation_OPERATION_LT, ident("price"), num(2)),
+			),
+			sql:  `("bid_price" >= ? AND "bid_price" < ?)`,
+			args: []any{1.0, 2.0},
+		},
+		{
+			name: "or across dimensions",
+			expr: cond(runtimev1.Operation_OPERATION_OR,
+				cond(runtimev1.Operation_OPERATION_EQ, ident("pub"), str("Yahoo")),
+				cond(runtimev1.Operation_OPERATION_NOT, cond(runtimev1.Operation_OPERATION_LIKE, ident("dom"), str("%msn%"))),
+			),
+			sql:  `("publisher" = ? OR NOT ("domain" ILIKE ?))`,
+			args: []any{"Yahoo", "%msn%"},
+		},
+		{
+			name:    "like druid",
+			expr:    cond(runtimev1.Operation_OPERATION_NLIKE, ident("dom"), str("%msn%")),
+			dialect: drivers.DialectDruid,
+			sql:     `(LOWER("domain") NOT LIKE LOWER(?) OR "domain" IS NULL)`,
+			args:    []any{"%msn%"},
+		},
+		{
+			name: "null checks",
+			expr: cond(runtimev1.Operation_OPERATION_AND,
+				cond(runtimev1.Operation_OPERATION_EQ, ident("pub"), null),
+				cond(runtimev1.Operation_OPERATION_NEQ, ident("dom"), null),
+			),
+			sql: `("publisher" IS NULL AND "domain" IS NOT NULL)`,
+		},
+		{
+			name: "in with null",
+			expr: cond(runtimev1.Operation_OPERATION_IN, ident("pub"), str("a"), null, str("b")),
+			sql:  `("publisher" IN (?,?) OR "publisher" IS NULL)`,
+			args: []any{"a", "b"},
+		},
+		{
+			name: "in list value",
+			expr: cond(runtimev1.Operation_OPERATION_IN, ident("pub"), valueExpression(structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("a"), structpb.NewStringValue("b")}}))),
+			sql:  `"publisher" IN (?,?)`,
+			args: []any{"a", "b"},
+		},
+		{
+			name: "not in",
+			expr: cond(runtimev1.Operation_OPERATION_NIN, ident("pub"), str("a")),
+			sql:  `("publisher" NOT IN (?) OR "publisher" IS NULL)`,
+			args: []any{"a"},
+		},
+		{
+			name: "not in with null",
+			expr: cond(runtimev1.Operation_OPERATION_NIN, ident("pub"), str("a"), null),
+			sql:  `("publisher" NOT IN (?) AND "publisher" IS NOT NULL)`,
+			args: []any{"a"},
+		},
+		{
+			name: "empty in",
+			expr: cond(runtimev1.Operation_OPERATION_IN, ident("pub")),
+			sql:  `1=0`,
+		},
+		{
+			name: "empty and",
+			expr: cond(runtimev1.Operation_OPERATION_AND, cond(runtimev1.Operation_OPERATION_OR)),
+			sql:  ``,
+		},
+		{
+			name: "time dimension",
+			expr: cond(runtimev1.Operation_OPERATION_GTE, ident("ts"), str("2023-01-01")),
+			sql:  `"ts" >= ?`,
+			args: []any{"2023-01-01"},
+		},
+		{
+			name:    "measure",
+			expr:    cond(runtimev1.Operation_OPERATION_GT, ident("revenue"), num(100)),
+			wantErr: true,
+		},
+		{
+			name:    "unknown dimension",
+			expr:    cond(runtimev1.Operation_OPERATION_EQ, ident("foo"), num(1)),
+			wantErr: true,
+		},
+		{
+			name:    "invalid operands",
+			expr:    cond(runtimev1.Operation_OPERATION_GT, ident("price")),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dialect := tt.dialect
+			if dialect == drivers.DialectUnspecified {
+				dialect = drivers.DialectDuckDB
+			}
+			sql, args, err := buildExpression(tt.expr, dialect, metricsViewWhereIdent(mv))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.sql, sql)
+			require.Equal(t, tt.args, args)
+		})
+	}
+}
+
+func TestConvertFilterToExpression(t *testing.T) {
+	mv := &runtimev1.MetricsView{
+		Dimensions: []*runtimev1.MetricsView_Dimension{
+			{Name: "pub", Column: "publisher"},
+			{Name: "dom", Column: "domain"},
+		},
+	}
+
+	filter := &runtimev1.MetricsViewFilter{
+		Include: []*runtimev1.MetricsViewFilter_Cond{
+			{Name: "pub", In: []*structpb.Value{structpb.NewStringValue("a"), structpb.NewNullValue()}, Like: []string{"b%"}},
+			{Name: "dom"},
+		},
+		Exclude: []*runtimev1.MetricsViewFilter_Cond{
+			{Name: "dom", In: []*structpb.Value{structpb.NewStringValue("c")}, Like: []string{"d%"}},
+		},
+	}
+
+	sql, args, err := buildFilterClauseForMetricsViewFilter(mv, filter, nil, drivers.DialectDuckDB, nil)
+	require.NoError(t, err)
+	require.Equal(t, `AND (((("publisher" IN (?) OR "publisher" IS NULL) OR "publisher" ILIKE ?) AND (("domain" NOT IN (?) OR "domain" IS NULL) AND ("domain" NOT ILIKE ? OR "domain" IS NULL))))`, sql)
+	require.Equal(t, []any{"a", "b%", "c", "d%"}, args)
+
+	_, _, err = buildFilterClauseForMetricsViewFilter(mv, filter, identExpression("pub"), drivers.DialectDuckDB, nil)
+	require.Error(t, err)
+
+	sql, args, err = buildFilterClauseForMetricsViewFilter(mv, &runtimev1.MetricsViewFilter{}, nil, drivers.DialectDuckDB, nil)
+	require.NoError(t, err)
+	require.Equal(t, "", sql)
+	require.Empty(t, args)
+}
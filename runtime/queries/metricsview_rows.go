@@ -20,6 +20,7 @@ type MetricsViewRows struct {
 	TimeEnd            *timestamppb.Timestamp               `json:"time_end,omitempty"`
 	TimeGranularity    runtimev1.TimeGrain                  `json:"time_granularity,omitempty"`
 	Filter             *runtimev1.MetricsViewFilter         `json:"filter,omitempty"`
+	Where              *runtimev1.Expression                `json:"where,omitempty"`
 	Sort               []*runtimev1.MetricsViewSort         `json:"sort,omitempty"`
 	Limit              *int64                               `json:"limit,omitempty"`
 	Offset             int64                                `json:"offset,omitempty"`
@@ -67,7 +68,7 @@ func (q *MetricsViewRows) Resolve(ctx context.Context, rt *runtime.Runtime, inst
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid {
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectClickHouse {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
@@ -131,7 +132,7 @@ func (q *MetricsViewRows) Export(ctx context.Context, rt *runtime.Runtime, insta
 				return err
 			}
 		}
-	case drivers.DialectDruid:
+	case drivers.DialectDruid, drivers.DialectClickHouse:
 		if err := q.generalExport(ctx, rt, instanceID, w, opts, olap, q.MetricsView); err != nil {
 			return err
 		}
@@ -230,8 +231,8 @@ func (q *MetricsViewRows) buildMetricsRowsSQL(mv *runtimev1.MetricsView, dialect
 		}
 	}
 
-	if q.Filter != nil {
-		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, dialect, policy)
+	if q.Filter != nil || q.Where != nil {
+		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, q.Where, dialect, policy)
 		if err != nil {
 			return "", nil, err
 		}
@@ -274,8 +275,13 @@ func (q *MetricsViewRows) buildMetricsRowsSQL(mv *runtimev1.MetricsView, dialect
 		if q.TimeZone != "" {
 			timezone = q.TimeZone
 		}
-		args = append([]any{timezone, timezone}, args...)
-		rollup := fmt.Sprintf("timezone(?, date_trunc('%s', timezone(?, %s::TIMESTAMPTZ))) AS %s", convertToDateTruncSpecifier(q.TimeGranularity), safeName(mv.TimeDimension), safeName(timeRollupColumnName))
+		var rollup string
+		if dialect == drivers.DialectClickHouse {
+			rollup = fmt.Sprintf("%s AS %s", clickHouseTimeFloor(safeName(mv.TimeDimension), q.TimeGranularity, timezone, 0, 0), safeName(timeRollupColumnName))
+		} else {
+			args = append([]any{timezone, timezone}, args...)
+			rollup = fmt.Sprintf("timezone(?, date_trunc('%s', timezone(?, %s::TIMESTAMPTZ))) AS %s", convertToDateTruncSpecifier(q.TimeGranularity), safeName(mv.TimeDimension), safeName(timeRollupColumnName))
+		}
 
 		// Prepend the rollup column
 		selectColumns = append([]string{rollup}, selectColumns...)
@@ -72,7 +72,7 @@ func (q *MetricsViewTimeRange) Resolve(ctx context.Context, rt *runtime.Runtime,
 	switch olap.Dialect() {
 	case drivers.DialectDuckDB:
 		return q.resolveDuckDB(ctx, olap, q.MetricsView.TimeDimension, q.MetricsView.Model, policyFilter, priority)
-	case drivers.DialectDruid:
+	case drivers.DialectDruid, drivers.DialectClickHouse:
 		return q.resolveDruid(ctx, olap, q.MetricsView.TimeDimension, q.MetricsView.Model, policyFilter, priority)
 	default:
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
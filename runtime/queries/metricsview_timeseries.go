@@ -27,6 +27,7 @@ type MetricsViewTimeSeries struct {
 	Offset             int64                                `json:"offset,omitempty"`
 	Sort               []*runtimev1.MetricsViewSort         `json:"sort,omitempty"`
 	Filter             *runtimev1.MetricsViewFilter         `json:"filter,omitempty"`
+	Where              *runtimev1.Expression                `json:"where,omitempty"`
 	TimeGranularity    runtimev1.TimeGrain                  `json:"time_granularity,omitempty"`
 	TimeZone           string                               `json:"time_zone,omitempty"`
 	MetricsView        *runtimev1.MetricsView               `json:"-"`
@@ -77,8 +78,8 @@ func (q *MetricsViewTimeSeries) Resolve(ctx context.Context, rt *runtime.Runtime
 	defer release()
 
 	switch olap.Dialect() {
-	case drivers.DialectDuckDB:
-		return q.resolveDuckDB(ctx, rt, instanceID, q.MetricsView, priority, q.ResolvedMVSecurity)
+	case drivers.DialectDuckDB, drivers.DialectClickHouse:
+		return q.resolveColumnTimeseries(ctx, rt, instanceID, q.MetricsView, priority, q.ResolvedMVSecurity)
 	case drivers.DialectDruid:
 		return q.resolveDruid(ctx, olap, q.MetricsView, priority, q.ResolvedMVSecurity)
 	default:
@@ -124,19 +125,25 @@ func (q *MetricsViewTimeSeries) Export(ctx context.Context, rt *runtime.Runtime,
 
 func (q *MetricsViewTimeSeries) generateFilename(mv *runtimev1.MetricsView) string {
 	filename := strings.ReplaceAll(mv.Name, `"`, `_`)
-	if q.TimeStart != nil || q.TimeEnd != nil || q.Filter != nil && (len(q.Filter.Include) > 0 || len(q.Filter.Exclude) > 0) {
+	if q.TimeStart != nil || q.TimeEnd != nil || q.Filter != nil && (len(q.Filter.Include) > 0 || len(q.Filter.Exclude) > 0) || q.Where != nil {
 		filename += "_filtered"
 	}
 	return filename
 }
 
-func (q *MetricsViewTimeSeries) resolveDuckDB(ctx context.Context, rt *runtime.Runtime, instanceID string, mv *runtimev1.MetricsView, priority int, policy *runtime.ResolvedMetricsViewSecurity) error {
+// resolveColumnTimeseries resolves the time series using ColumnTimeseries, which fills in gaps in the series.
+func (q *MetricsViewTimeSeries) resolveColumnTimeseries(ctx context.Context, rt *runtime.Runtime, instanceID string, mv *runtimev1.MetricsView, priority int, policy *runtime.ResolvedMetricsViewSecurity) error {
 	ms, err := resolveMeasures(mv, q.InlineMeasures, q.MeasureNames)
 	if err != nil {
 		return err
 	}
 
-	measures, err := toColumnTimeseriesMeasures(ms)
+	base, derived, err := splitDerivedMeasures(mv, ms)
+	if err != nil {
+		return err
+	}
+
+	measures, err := toColumnTimeseriesMeasures(base)
 	if err != nil {
 		return err
 	}
@@ -152,10 +159,13 @@ func (q *MetricsViewTimeSeries) resolveDuckDB(ctx context.Context, rt *runtime.R
 		Measures:          measures,
 		MetricsView:       mv,
 		MetricsViewFilter: q.Filter,
+		MetricsViewWhere:  q.Where,
 		TimeZone:          q.TimeZone,
 		MetricsViewPolicy: policy,
 		FirstDayOfWeek:    mv.FirstDayOfWeek,
 		FirstMonthOfYear:  mv.FirstMonthOfYear,
+
+		MetricsViewDerivedMeasures: derived,
 	}
 	err = rt.Query(ctx, instanceID, tsq, priority)
 	if err != nil {
@@ -169,9 +179,41 @@ func (q *MetricsViewTimeSeries) resolveDuckDB(ctx context.Context, rt *runtime.R
 		Data: r.Results,
 	}
 
+	if len(derived) > 0 {
+		q.Result = omitUnselectedMeasures(q.Result, ms)
+	}
+
 	return nil
 }
 
+// omitUnselectedMeasures removes the measures that were only computed as references for derived measures from a time series.
+// It doesn't modify the original response, which may be cached.
+func omitUnselectedMeasures(res *runtimev1.MetricsViewTimeSeriesResponse, selected []*runtimev1.MetricsView_Measure) *runtimev1.MetricsViewTimeSeriesResponse {
+	names := make(map[string]bool, len(selected))
+	for _, m := range selected {
+		names[m.Name] = true
+	}
+
+	out := &runtimev1.MetricsViewTimeSeriesResponse{
+		Data: make([]*runtimev1.TimeSeriesValue, len(res.Data)),
+	}
+	for _, c := range res.Meta {
+		if names[c.Name] {
+			out.Meta = append(out.Meta, c)
+		}
+	}
+	for i, v := range res.Data {
+		records := &structpb.Struct{Fields: make(map[string]*structpb.Value, len(names))}
+		for k, f := range v.Records.GetFields() {
+			if names[k] {
+				records.Fields[k] = f
+			}
+		}
+		out.Data[i] = &runtimev1.TimeSeriesValue{Ts: v.Ts, Bin: v.Bin, Records: records}
+	}
+	return out
+}
+
 func toColumnTimeseriesMeasures(measures []*runtimev1.MetricsView_Measure) ([]*runtimev1.ColumnTimeSeriesRequest_BasicMeasure, error) {
 	res := make([]*runtimev1.ColumnTimeSeriesRequest_BasicMeasure, len(measures))
 	for i, m := range measures {
@@ -255,8 +297,13 @@ func (q *MetricsViewTimeSeries) buildDruidMetricsTimeseriesSQL(mv *runtimev1.Met
 		return "", "", nil, err
 	}
 
+	base, derived, err := splitDerivedMeasures(mv, ms)
+	if err != nil {
+		return "", "", nil, err
+	}
+
 	selectCols := []string{}
-	for _, m := range ms {
+	for _, m := range base {
 		expr := fmt.Sprintf(`%s as "%s"`, m.Expression, m.Name)
 		selectCols = append(selectCols, expr)
 	}
@@ -272,8 +319,8 @@ func (q *MetricsViewTimeSeries) buildDruidMetricsTimeseriesSQL(mv *runtimev1.Met
 		args = append(args, q.TimeEnd.AsTime())
 	}
 
-	if q.Filter != nil {
-		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, drivers.DialectDruid, policy)
+	if q.Filter != nil || q.Where != nil {
+		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, q.Where, drivers.DialectDruid, policy)
 		if err != nil {
 			return "", "", nil, err
 		}
@@ -308,5 +355,18 @@ func (q *MetricsViewTimeSeries) buildDruidMetricsTimeseriesSQL(mv *runtimev1.Met
 		whereClause,
 	)
 
+	if len(derived) > 0 {
+		// Window measures are not supported on Druid, so the time column is not passed
+		sql, err = wrapDerivedMeasures(sql, derived, "", nil, drivers.DialectDruid)
+		if err != nil {
+			return "", "", nil, err
+		}
+		outputCols := []string{tsAlias}
+		for _, m := range ms {
+			outputCols = append(outputCols, safeName(m.Name))
+		}
+		sql = fmt.Sprintf("SELECT %s FROM (%s) ORDER BY 1", strings.Join(outputCols, ", "), sql)
+	}
+
 	return sql, tsAlias, args, nil
 }
@@ -24,6 +24,8 @@ type MetricsViewToplist struct {
 	Offset             int64                                `json:"offset,omitempty"`
 	Sort               []*runtimev1.MetricsViewSort         `json:"sort,omitempty"`
 	Filter             *runtimev1.MetricsViewFilter         `json:"filter,omitempty"`
+	Where              *runtimev1.Expression                `json:"where,omitempty"`
+	Having             *runtimev1.Expression                `json:"having,omitempty"`
 	MetricsView        *runtimev1.MetricsView               `json:"-"`
 	ResolvedMVSecurity *runtime.ResolvedMetricsViewSecurity `json:"security"`
 
@@ -67,7 +69,7 @@ func (q *MetricsViewToplist) Resolve(ctx context.Context, rt *runtime.Runtime, i
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid {
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectClickHouse {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
@@ -123,7 +125,7 @@ func (q *MetricsViewToplist) Export(ctx context.Context, rt *runtime.Runtime, in
 				return err
 			}
 		}
-	case drivers.DialectDruid:
+	case drivers.DialectDruid, drivers.DialectClickHouse:
 		if err := q.generalExport(ctx, rt, instanceID, w, opts, q.MetricsView); err != nil {
 			return err
 		}
@@ -164,7 +166,7 @@ func (q *MetricsViewToplist) generalExport(ctx context.Context, rt *runtime.Runt
 func (q *MetricsViewToplist) generateFilename(mv *runtimev1.MetricsView) string {
 	filename := strings.ReplaceAll(mv.Name, `"`, `_`)
 	filename += "_" + q.DimensionName
-	if q.TimeStart != nil || q.TimeEnd != nil || q.Filter != nil && (len(q.Filter.Include) > 0 || len(q.Filter.Exclude) > 0) {
+	if q.TimeStart != nil || q.TimeEnd != nil || q.Filter != nil && (len(q.Filter.Include) > 0 || len(q.Filter.Exclude) > 0) || q.Where != nil || q.Having != nil {
 		filename += "_filtered"
 	}
 	return filename
@@ -181,8 +183,13 @@ func (q *MetricsViewToplist) buildMetricsTopListSQL(mv *runtimev1.MetricsView, d
 		return "", nil, err
 	}
 
+	base, derived, err := splitDerivedMeasures(mv, ms)
+	if err != nil {
+		return "", nil, err
+	}
+
 	selectCols := []string{colName}
-	for _, m := range ms {
+	for _, m := range base {
 		expr := fmt.Sprintf(`%s as "%s"`, m.Expression, m.Name)
 		selectCols = append(selectCols, expr)
 	}
@@ -200,8 +207,8 @@ func (q *MetricsViewToplist) buildMetricsTopListSQL(mv *runtimev1.MetricsView, d
 		}
 	}
 
-	if q.Filter != nil {
-		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, dialect, policy)
+	if q.Filter != nil || q.Where != nil {
+		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, q.Where, dialect, policy)
 		if err != nil {
 			return "", nil, err
 		}
@@ -209,6 +216,13 @@ func (q *MetricsViewToplist) buildMetricsTopListSQL(mv *runtimev1.MetricsView, d
 		args = append(args, clauseArgs...)
 	}
 
+	havingIdent := metricsViewHavingIdent(mv, metricsViewMeasureExpressions(mv, q.InlineMeasures, policy), map[string]string{q.DimensionName: colName})
+	havingClause, havingArgs, err := buildHavingClause(q.Having, dialect, havingIdent)
+	if err != nil {
+		return "", nil, err
+	}
+	args = append(args, havingArgs...)
+
 	sortingCriteria := make([]string, 0, len(q.Sort))
 	for _, s := range q.Sort {
 		sortCriterion := safeName(s.Name)
@@ -233,11 +247,38 @@ func (q *MetricsViewToplist) buildMetricsTopListSQL(mv *runtimev1.MetricsView, d
 		limitClause = fmt.Sprintf("LIMIT %d", *q.Limit)
 	}
 
-	sql := fmt.Sprintf("SELECT %s FROM %q WHERE %s GROUP BY %s %s %s OFFSET %d",
+	if len(derived) == 0 {
+		sql := fmt.Sprintf("SELECT %s FROM %q WHERE %s GROUP BY %s %s %s %s OFFSET %d",
+			strings.Join(selectCols, ", "),
+			mv.Model,
+			whereClause,
+			colName,
+			havingClause,
+			orderClause,
+			limitClause,
+			q.Offset,
+		)
+		return sql, args, nil
+	}
+
+	sql := fmt.Sprintf("SELECT %s FROM %q WHERE %s GROUP BY %s %s",
 		strings.Join(selectCols, ", "),
 		mv.Model,
 		whereClause,
 		colName,
+		havingClause,
+	)
+	sql, err = wrapDerivedMeasures(sql, derived, "", nil, dialect)
+	if err != nil {
+		return "", nil, err
+	}
+	outputCols := []string{colName}
+	for _, m := range ms {
+		outputCols = append(outputCols, safeName(m.Name))
+	}
+	sql = fmt.Sprintf("SELECT %s FROM (%s) %s %s OFFSET %d",
+		strings.Join(outputCols, ", "),
+		sql,
 		orderClause,
 		limitClause,
 		q.Offset,
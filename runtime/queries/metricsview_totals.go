@@ -20,6 +20,7 @@ type MetricsViewTotals struct {
 	TimeStart          *timestamppb.Timestamp               `json:"time_start,omitempty"`
 	TimeEnd            *timestamppb.Timestamp               `json:"time_end,omitempty"`
 	Filter             *runtimev1.MetricsViewFilter         `json:"filter,omitempty"`
+	Where              *runtimev1.Expression                `json:"where,omitempty"`
 	MetricsView        *runtimev1.MetricsView               `json:"-"`
 	ResolvedMVSecurity *runtime.ResolvedMetricsViewSecurity `json:"security"`
 
@@ -63,7 +64,7 @@ func (q *MetricsViewTotals) Resolve(ctx context.Context, rt *runtime.Runtime, in
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid {
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectClickHouse {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
@@ -103,8 +104,13 @@ func (q *MetricsViewTotals) buildMetricsTotalsSQL(mv *runtimev1.MetricsView, dia
 		return "", nil, err
 	}
 
+	base, derived, err := splitDerivedMeasures(mv, ms)
+	if err != nil {
+		return "", nil, err
+	}
+
 	selectCols := []string{}
-	for _, m := range ms {
+	for _, m := range base {
 		expr := fmt.Sprintf(`%s as "%s"`, m.Expression, m.Name)
 		selectCols = append(selectCols, expr)
 	}
@@ -122,8 +128,8 @@ func (q *MetricsViewTotals) buildMetricsTotalsSQL(mv *runtimev1.MetricsView, dia
 		}
 	}
 
-	if q.Filter != nil {
-		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, dialect, policy)
+	if q.Filter != nil || q.Where != nil {
+		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, q.Where, dialect, policy)
 		if err != nil {
 			return "", nil, err
 		}
@@ -137,5 +143,18 @@ func (q *MetricsViewTotals) buildMetricsTotalsSQL(mv *runtimev1.MetricsView, dia
 		mv.Model,
 		whereClause,
 	)
+
+	if len(derived) > 0 {
+		sql, err = wrapDerivedMeasures(sql, derived, "", nil, dialect)
+		if err != nil {
+			return "", nil, err
+		}
+		outputCols := make([]string, len(ms))
+		for i, m := range ms {
+			outputCols[i] = safeName(m.Name)
+		}
+		sql = fmt.Sprintf("SELECT %s FROM (%s)", strings.Join(outputCols, ", "), sql)
+	}
+
 	return sql, args, nil
 }
@@ -0,0 +1,299 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+)
+
+// estimatedValueBytes is the approximate size of a single value in a query result.
+// It's used to estimate result sizes from row and column counts.
+const estimatedValueBytes = 8
+
+// estimateTableSize returns the number of rows and columns in a table.
+// The row count is cached with the TableCardinality query, so it's cheap after the first call.
+func estimateTableSize(ctx context.Context, rt *runtime.Runtime, instanceID, tableName string) (rows, cols int64, err error) {
+	olap, release, err := rt.OLAP(ctx, instanceID)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer release()
+
+	t, err := olap.InformationSchema().Lookup(ctx, tableName)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	q := &TableCardinality{TableName: tableName}
+	err = rt.Query(ctx, instanceID, q, 0)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return q.Result, int64(len(t.Schema.Fields)), nil
+}
+
+// estimateTimeBuckets returns the number of buckets in a time range.
+// It returns 0 if the time range is not fully resolved.
+func estimateTimeBuckets(tr *runtimev1.TimeSeriesTimeRange) int64 {
+	if tr == nil || tr.Start == nil || tr.End == nil {
+		return 0
+	}
+
+	var grain time.Duration
+	switch tr.Interval {
+	case runtimev1.TimeGrain_TIME_GRAIN_MILLISECOND:
+		grain = time.Millisecond
+	case runtimev1.TimeGrain_TIME_GRAIN_SECOND:
+		grain = time.Second
+	case runtimev1.TimeGrain_TIME_GRAIN_MINUTE:
+		grain = time.Minute
+	case runtimev1.TimeGrain_TIME_GRAIN_HOUR:
+		grain = time.Hour
+	case runtimev1.TimeGrain_TIME_GRAIN_DAY:
+		grain = time.Hour * 24
+	case runtimev1.TimeGrain_TIME_GRAIN_WEEK:
+		grain = time.Hour * 24 * 7
+	case runtimev1.TimeGrain_TIME_GRAIN_MONTH:
+		grain = time.Hour * 24 * 30
+	case runtimev1.TimeGrain_TIME_GRAIN_QUARTER:
+		grain = time.Hour * 24 * 91
+	case runtimev1.TimeGrain_TIME_GRAIN_YEAR:
+		grain = time.Hour * 24 * 365
+	default:
+		return 0
+	}
+
+	d := tr.End.AsTime().Sub(tr.Start.AsTime())
+	if d <= 0 {
+		return 0
+	}
+	return int64((d + grain - 1) / grain)
+}
+
+// EstimateCost implements runtime.QueryCostEstimator.
+func (q *MetricsViewRows) EstimateCost(ctx context.Context, rt *runtime.Runtime, instanceID string) (*runtime.QueryCost, error) {
+	if q.MetricsView == nil {
+		return nil, fmt.Errorf("metrics view %q not resolved", q.MetricsViewName)
+	}
+
+	rows, cols, err := estimateTableSize(ctx, rt, instanceID, q.MetricsView.Model)
+	if err != nil {
+		return nil, err
+	}
+
+	resultRows := rows - q.Offset
+	if resultRows < 0 {
+		resultRows = 0
+	}
+	if q.Limit != nil {
+		limit := *q.Limit
+		if limit == 0 {
+			limit = 100 // Default applied in Resolve
+		}
+		if limit < resultRows {
+			resultRows = limit
+		}
+	}
+
+	return &runtime.QueryCost{
+		ScannedRows:    rows,
+		ScannedColumns: cols,
+		ResultRows:     resultRows,
+		ResultBytes:    resultRows * cols * estimatedValueBytes,
+	}, nil
+}
+
+// EstimateCost implements runtime.QueryCostEstimator.
+func (q *ColumnTimeseries) EstimateCost(ctx context.Context, rt *runtime.Runtime, instanceID string) (*runtime.QueryCost, error) {
+	rows, _, err := estimateTableSize(ctx, rt, instanceID, q.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	cols := int64(1 + len(q.Measures))
+	resultRows := estimateTimeBuckets(q.TimeRange)
+
+	return &runtime.QueryCost{
+		ScannedRows:    rows,
+		ScannedColumns: cols,
+		ResultRows:     resultRows,
+		ResultBytes:    resultRows * cols * estimatedValueBytes,
+	}, nil
+}
+
+// estimateMetricsViewRows returns the number of rows in a metrics view's model.
+func estimateMetricsViewRows(ctx context.Context, rt *runtime.Runtime, instanceID string, mv *runtimev1.MetricsView, name string) (int64, error) {
+	if mv == nil {
+		return 0, fmt.Errorf("metrics view %q not resolved", name)
+	}
+
+	rows, _, err := estimateTableSize(ctx, rt, instanceID, mv.Model)
+	if err != nil {
+		return 0, err
+	}
+	return rows, nil
+}
+
+// estimateGroups returns the number of groups an aggregation over rows returns after applying the offset and limit.
+// The number of distinct values isn't known before the query is resolved, so it conservatively assumes every row is a group.
+// A limit of 0 means no limit.
+func estimateGroups(rows, offset, limit int64) int64 {
+	res := rows - offset
+	if res < 0 {
+		res = 0
+	}
+	if limit > 0 && limit < res {
+		res = limit
+	}
+	return res
+}
+
+// EstimateCost implements runtime.QueryCostEstimator.
+func (q *MetricsViewToplist) EstimateCost(ctx context.Context, rt *runtime.Runtime, instanceID string) (*runtime.QueryCost, error) {
+	rows, err := estimateMetricsViewRows(ctx, rt, instanceID, q.MetricsView, q.MetricsViewName)
+	if err != nil {
+		return nil, err
+	}
+
+	var limit int64
+	if q.Limit != nil {
+		limit = *q.Limit
+		if limit == 0 {
+			limit = 100 // Default applied in Resolve
+		}
+	}
+
+	cols := int64(1 + len(q.MeasureNames) + len(q.InlineMeasures))
+	resultRows := estimateGroups(rows, q.Offset, limit)
+
+	return &runtime.QueryCost{
+		ScannedRows:    rows,
+		ScannedColumns: cols,
+		ResultRows:     resultRows,
+		ResultBytes:    resultRows * cols * estimatedValueBytes,
+	}, nil
+}
+
+// EstimateCost implements runtime.QueryCostEstimator.
+func (q *MetricsViewAggregation) EstimateCost(ctx context.Context, rt *runtime.Runtime, instanceID string) (*runtime.QueryCost, error) {
+	rows, err := estimateMetricsViewRows(ctx, rt, instanceID, q.MetricsView, q.MetricsViewName)
+	if err != nil {
+		return nil, err
+	}
+
+	var limit int64
+	if q.Limit != nil {
+		limit = *q.Limit
+		if limit == 0 {
+			limit = 100 // Default applied in Resolve
+		}
+	}
+
+	cols := int64(len(q.Dimensions) + len(q.Measures))
+	resultRows := int64(1) // Without dimensions, the measures are aggregated into a single row
+	if len(q.Dimensions) > 0 {
+		resultRows = estimateGroups(rows, q.Offset, limit)
+	}
+
+	return &runtime.QueryCost{
+		ScannedRows:    rows,
+		ScannedColumns: cols,
+		ResultRows:     resultRows,
+		ResultBytes:    resultRows * cols * estimatedValueBytes,
+	}, nil
+}
+
+// EstimateCost implements runtime.QueryCostEstimator.
+func (q *MetricsViewComparisonToplist) EstimateCost(ctx context.Context, rt *runtime.Runtime, instanceID string) (*runtime.QueryCost, error) {
+	rows, err := estimateMetricsViewRows(ctx, rt, instanceID, q.MetricsView, q.MetricsViewName)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := q.Limit
+	if limit == 0 {
+		limit = 100 // Default applied in Resolve
+	}
+
+	// A comparison scans the table for both time ranges and returns the base and comparison values and their deltas for each measure
+	scannedRows := rows
+	measureCols := int64(len(q.MeasureNames) + len(q.InlineMeasures))
+	if q.ComparisonTimeRange != nil {
+		scannedRows *= 2
+		measureCols *= 4
+	}
+	cols := 1 + measureCols
+	resultRows := estimateGroups(rows, q.Offset, limit)
+
+	return &runtime.QueryCost{
+		ScannedRows:    scannedRows,
+		ScannedColumns: cols,
+		ResultRows:     resultRows,
+		ResultBytes:    resultRows * cols * estimatedValueBytes,
+	}, nil
+}
+
+// EstimateCost implements runtime.QueryCostEstimator.
+func (q *MetricsViewTotals) EstimateCost(ctx context.Context, rt *runtime.Runtime, instanceID string) (*runtime.QueryCost, error) {
+	rows, err := estimateMetricsViewRows(ctx, rt, instanceID, q.MetricsView, q.MetricsViewName)
+	if err != nil {
+		return nil, err
+	}
+
+	cols := int64(len(q.MeasureNames) + len(q.InlineMeasures))
+
+	return &runtime.QueryCost{
+		ScannedRows:    rows,
+		ScannedColumns: cols,
+		ResultRows:     1,
+		ResultBytes:    cols * estimatedValueBytes,
+	}, nil
+}
+
+// EstimateCost implements runtime.QueryCostEstimator.
+func (q *MetricsViewTimeSeries) EstimateCost(ctx context.Context, rt *runtime.Runtime, instanceID string) (*runtime.QueryCost, error) {
+	rows, err := estimateMetricsViewRows(ctx, rt, instanceID, q.MetricsView, q.MetricsViewName)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the time range is resolved later, conservatively assume every row is in a separate bucket
+	buckets := estimateTimeBuckets(&runtimev1.TimeSeriesTimeRange{Start: q.TimeStart, End: q.TimeEnd, Interval: q.TimeGranularity})
+	if buckets == 0 {
+		buckets = rows
+	}
+
+	cols := int64(1 + len(q.MeasureNames) + len(q.InlineMeasures))
+	resultRows := estimateGroups(buckets, q.Offset, q.Limit)
+
+	return &runtime.QueryCost{
+		ScannedRows:    rows,
+		ScannedColumns: cols,
+		ResultRows:     resultRows,
+		ResultBytes:    resultRows * cols * estimatedValueBytes,
+	}, nil
+}
+
+// EstimateCost implements runtime.QueryCostEstimator.
+func (q *TableHead) EstimateCost(ctx context.Context, rt *runtime.Runtime, instanceID string) (*runtime.QueryCost, error) {
+	rows, cols, err := estimateTableSize(ctx, rt, instanceID, q.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	resultRows := rows
+	if int64(q.Limit) < resultRows {
+		resultRows = int64(q.Limit)
+	}
+
+	return &runtime.QueryCost{
+		ScannedRows:    resultRows,
+		ScannedColumns: cols,
+		ResultRows:     resultRows,
+		ResultBytes:    resultRows * cols * estimatedValueBytes,
+	}, nil
+}
@@ -0,0 +1,70 @@
+package queries
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	_ "github.com/rilldata/rill/runtime/drivers/duckdb"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsViewQueryLimits(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceForProject(t, "ad_bids")
+	ctx := context.Background()
+
+	obj, err := rt.GetCatalogEntry(ctx, instanceID, "ad_bids_metrics")
+	require.NoError(t, err)
+	mv := obj.GetMetricsView()
+
+	inst, err := rt.FindInstance(ctx, instanceID)
+	require.NoError(t, err)
+	inst.QueryLimits = &runtimev1.QueryLimits{MaxResultRows: 10}
+	require.NoError(t, rt.Registry().EditInstance(ctx, inst))
+
+	// Without a limit, every row may be a separate group
+	q := &MetricsViewAggregation{
+		MetricsViewName: "ad_bids_metrics",
+		Dimensions:      []*runtimev1.MetricsViewAggregationDimension{{Name: "dom"}},
+		Measures:        []*runtimev1.MetricsViewAggregationMeasure{{Name: "measure_0"}},
+		MetricsView:     mv,
+	}
+	err = rt.Query(ctx, instanceID, q, 0)
+	var exceeded runtime.QueryLimitExceededError
+	require.True(t, errors.As(err, &exceeded), err)
+	require.Equal(t, "max_result_rows", exceeded.Limit)
+
+	// A limit below the max is admitted
+	limit := int64(5)
+	q.Limit = &limit
+	require.NoError(t, rt.Query(ctx, instanceID, q, 0))
+	require.NotEmpty(t, q.Result.Data)
+
+	// Totals always return a single row
+	totals := &MetricsViewTotals{
+		MetricsViewName: "ad_bids_metrics",
+		MeasureNames:    []string{"measure_0"},
+		MetricsView:     mv,
+	}
+	require.NoError(t, rt.Query(ctx, instanceID, totals, 0))
+
+	// Toplists without a limit are rejected
+	toplist := &MetricsViewToplist{
+		MetricsViewName: "ad_bids_metrics",
+		DimensionName:   "dom",
+		MeasureNames:    []string{"measure_0"},
+		MetricsView:     mv,
+	}
+	err = rt.Query(ctx, instanceID, toplist, 0)
+	require.True(t, errors.As(err, &exceeded), err)
+
+	// Table heads are limited by their limit
+	head := &TableHead{TableName: "ad_bids", Limit: 5}
+	require.NoError(t, rt.Query(ctx, instanceID, head, 0))
+	head = &TableHead{TableName: "ad_bids", Limit: 50}
+	err = rt.Query(ctx, instanceID, head, 0)
+	require.True(t, errors.As(err, &exceeded), err)
+}
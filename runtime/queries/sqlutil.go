@@ -78,6 +78,40 @@ func convertToDruidTimeFloorSpecifier(specifier runtimev1.TimeGrain) string {
 	panic(fmt.Errorf("unconvertable time grain specifier: %v", specifier))
 }
 
+// clickHouseTimeFloor returns a ClickHouse expression that truncates the timestamp expr to the start of the time grain in time zone tz.
+// The result is a DateTime64 in tz. Weeks start on firstDayOfWeek (1 is Monday) and years on firstMonthOfYear (1 is January).
+func clickHouseTimeFloor(expr string, grain runtimev1.TimeGrain, tz string, firstDayOfWeek, firstMonthOfYear uint32) string {
+	if tz == "" {
+		tz = "UTC"
+	}
+	tz = clickHouseString(tz)
+
+	// Like for DuckDB, custom week and year starts are handled by shifting the timestamp before and after truncating it
+	var offset string
+	if grain == runtimev1.TimeGrain_TIME_GRAIN_WEEK && firstDayOfWeek > 1 && firstDayOfWeek <= 7 {
+		offset = fmt.Sprintf("INTERVAL %d DAY", 8-firstDayOfWeek)
+	} else if grain == runtimev1.TimeGrain_TIME_GRAIN_YEAR && firstMonthOfYear > 1 && firstMonthOfYear <= 12 {
+		offset = fmt.Sprintf("INTERVAL %d MONTH", 13-firstMonthOfYear)
+	}
+
+	ts := fmt.Sprintf("toDateTime64(%s, 3, %s)", expr, tz)
+	if offset != "" {
+		ts = fmt.Sprintf("%s + %s", ts, offset)
+	}
+
+	// toStartOfInterval returns a Date for grains of a day or more, so we convert back to a timestamp at midnight in tz
+	res := fmt.Sprintf("toDateTime64(toStartOfInterval(%s, INTERVAL 1 %s), 3, %s)", ts, convertToDateTruncSpecifier(grain), tz)
+	if offset != "" {
+		res = fmt.Sprintf("(%s - %s)", res, offset)
+	}
+	return res
+}
+
+// clickHouseString returns a quoted ClickHouse string literal.
+func clickHouseString(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
+
 func toTimeGrain(val string) runtimev1.TimeGrain {
 	switch strings.ToUpper(val) {
 	case "MILLISECOND":
@@ -54,14 +54,21 @@ func (q *TableColumns) Resolve(ctx context.Context, rt *runtime.Runtime, instanc
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB {
+	switch olap.Dialect() {
+	case drivers.DialectDuckDB:
+		return q.resolveDuckDB(ctx, olap, priority)
+	case drivers.DialectClickHouse:
+		return q.resolveClickHouse(ctx, olap, priority)
+	default:
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
+}
 
+func (q *TableColumns) resolveDuckDB(ctx context.Context, olap drivers.OLAPStore, priority int) error {
 	return olap.WithConnection(ctx, priority, false, false, func(ctx context.Context, ensuredCtx context.Context, _ *sql.Conn) error {
 		// views return duplicate column names, so we need to create a temporary table
 		temporaryTableName := tempName("profile_columns_")
-		err = olap.Exec(ctx, &drivers.Statement{
+		err := olap.Exec(ctx, &drivers.Statement{
 			Query:            fmt.Sprintf(`CREATE TEMPORARY TABLE "%s" AS (SELECT * FROM "%s" LIMIT 1)`, temporaryTableName, q.TableName),
 			Priority:         priority,
 			ExecutionTimeout: defaultExecutionTimeout,
@@ -107,6 +114,40 @@ func (q *TableColumns) Resolve(ctx context.Context, rt *runtime.Runtime, instanc
 	})
 }
 
+func (q *TableColumns) resolveClickHouse(ctx context.Context, olap drivers.OLAPStore, priority int) error {
+	rows, err := olap.Execute(ctx, &drivers.Statement{
+		Query: `
+			SELECT name, type
+			FROM system.columns
+			WHERE database = currentDatabase() AND table = ?
+			ORDER BY position`,
+		Args:             []any{q.TableName},
+		Priority:         priority,
+		ExecutionTimeout: defaultExecutionTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var pcs []*runtimev1.ProfileColumn
+	for rows.Next() {
+		pc := runtimev1.ProfileColumn{}
+		if err := rows.StructScan(&pc); err != nil {
+			return err
+		}
+		pcs = append(pcs, &pc)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return err
+	}
+
+	q.Result = pcs
+	return nil
+}
+
 func (q *TableColumns) Export(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions) error {
 	return ErrExportNotSupported
 }
@@ -57,7 +57,7 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 	qk := query.Key()
 	// If key is empty, skip caching
 	if qk == "" {
-		return query.Resolve(ctx, r, instanceID, priority)
+		return r.resolveQuery(ctx, instanceID, query, priority)
 	}
 
 	// Skip caching for specific named drivers.
@@ -68,7 +68,7 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 	}
 	if olap.Dialect() == drivers.DialectDruid {
 		release()
-		return query.Resolve(ctx, r, instanceID, priority)
+		return r.resolveQuery(ctx, instanceID, query, priority)
 	}
 	release()
 
@@ -93,7 +93,7 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 
 	// If there were no known dependencies, skip caching
 	if len(depKeys) == 0 {
-		return query.Resolve(ctx, r, instanceID, priority)
+		return r.resolveQuery(ctx, instanceID, query, priority)
 	}
 
 	// Build cache key
@@ -120,7 +120,7 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 		}
 
 		// Load
-		err := query.Resolve(ctx, r, instanceID, priority)
+		err := r.resolveQuery(ctx, instanceID, query, priority)
 		if err != nil {
 			return nil, err
 		}
@@ -141,6 +141,17 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 	return nil
 }
 
+// resolveQuery resolves a query that was not found in the cache, subject to the instance's query limits.
+func (r *Runtime) resolveQuery(ctx context.Context, instanceID string, query Query, priority int) error {
+	ctx, priority, release, err := r.AdmitQuery(ctx, instanceID, query, priority)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return query.Resolve(ctx, r, instanceID, priority)
+}
+
 type queryCacheKey struct {
 	instanceID    string
 	queryKey      string
@@ -0,0 +1,199 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/priorityqueue"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// downgradedQueryPriority is the priority used for queries that exceed a cost limit when the instance's
+// query limits are configured to downgrade instead of reject. It makes them yield to all other queries.
+const downgradedQueryPriority = math.MinInt32
+
+// QueryCost is an estimate of the resources a query will use.
+// Zero values mean the dimension is unknown.
+type QueryCost struct {
+	ScannedRows    int64
+	ScannedColumns int64
+	ResultRows     int64
+	ResultBytes    int64
+}
+
+// QueryCostEstimator is implemented by queries that can estimate their cost before they are resolved.
+// Queries that don't implement it are only subject to the per-user concurrency limit.
+type QueryCostEstimator interface {
+	// EstimateCost should return a cheap estimate of the cost of resolving the query.
+	// It must not resolve the query itself.
+	EstimateCost(ctx context.Context, rt *Runtime, instanceID string) (*QueryCost, error)
+}
+
+// QueryLimitExceededError is returned when a query is rejected because its estimated cost exceeds one of the instance's query limits.
+type QueryLimitExceededError struct {
+	Query    string
+	Limit    string
+	Estimate int64
+	Max      int64
+}
+
+func (e QueryLimitExceededError) Error() string {
+	return fmt.Sprintf("query %s exceeds the instance's %s limit: estimated %d, max %d", e.Query, e.Limit, e.Estimate, e.Max)
+}
+
+// queryAdmittedCtxKey is set on the context of queries that have passed admission.
+// Queries resolved as part of an admitted query (e.g. for cost estimation or time range resolution) are not admitted again.
+// This prevents nested queries from deadlocking on the per-user concurrency limit.
+type queryAdmittedCtxKey struct{}
+
+// querySubjectCtxKey is set on the context of queries issued on behalf of a user. See WithQuerySubject.
+type querySubjectCtxKey struct{}
+
+// WithQuerySubject returns a context that attributes the queries resolved with it to subject.
+// Queries without a subject are internal (e.g. from reconcilers) and are not subject to per-user query limits.
+func WithQuerySubject(ctx context.Context, subject string) context.Context {
+	return context.WithValue(ctx, querySubjectCtxKey{}, subject)
+}
+
+func querySubject(ctx context.Context) string {
+	subject, _ := ctx.Value(querySubjectCtxKey{}).(string)
+	return subject
+}
+
+// queryAdmission tracks the per-user concurrency semaphores for all instances.
+type queryAdmission struct {
+	mu   sync.Mutex
+	sems map[string]*userSemaphore
+}
+
+type userSemaphore struct {
+	sem  *priorityqueue.Semaphore
+	refs int
+}
+
+func newQueryAdmission() *queryAdmission {
+	return &queryAdmission{sems: make(map[string]*userSemaphore)}
+}
+
+// acquire acquires a slot in the user's semaphore. The returned function must be called to release it.
+// The size is part of the key, so queries started after a limit change use a new semaphore.
+func (a *queryAdmission) acquire(ctx context.Context, instanceID, subject string, size, priority int) (func(), error) {
+	key := fmt.Sprintf("%s:%s:%d", instanceID, subject, size)
+
+	a.mu.Lock()
+	us, ok := a.sems[key]
+	if !ok {
+		us = &userSemaphore{sem: priorityqueue.NewSemaphore(size)}
+		a.sems[key] = us
+	}
+	us.refs++
+	a.mu.Unlock()
+
+	deref := func() {
+		a.mu.Lock()
+		us.refs--
+		if us.refs == 0 {
+			delete(a.sems, key)
+		}
+		a.mu.Unlock()
+	}
+
+	if err := us.sem.Acquire(ctx, priority); err != nil {
+		deref()
+		return nil, err
+	}
+
+	return func() {
+		us.sem.Release()
+		deref()
+	}, nil
+}
+
+// AdmitQuery applies the instance's query limits to a query before it is resolved.
+// It returns the context and priority to resolve the query with, and a function that must be called when the query is done.
+// It's called by Query, but must also be called by callers that resolve queries directly (e.g. exports).
+func (r *Runtime) AdmitQuery(ctx context.Context, instanceID string, query Query, priority int) (context.Context, int, func(), error) {
+	noop := func() {}
+	if ctx.Value(queryAdmittedCtxKey{}) != nil {
+		return ctx, priority, noop, nil
+	}
+
+	inst, err := r.FindInstance(ctx, instanceID)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	limits := inst.QueryLimits
+	if limits == nil {
+		return ctx, priority, noop, nil
+	}
+
+	ctx = context.WithValue(ctx, queryAdmittedCtxKey{}, true)
+	name := queryName(query)
+	dims := []attribute.KeyValue{attribute.String("instance_id", instanceID), attribute.String("query", name)}
+
+	// Check the estimated cost
+	if est, ok := query.(QueryCostEstimator); ok && hasCostLimits(limits) {
+		cost, err := est.EstimateCost(ctx, r, instanceID)
+		if err != nil {
+			// Don't block the query if the estimate fails. If the query is invalid, resolving it will fail with a better error.
+			r.logger.Warn("failed to estimate query cost", zap.String("instance_id", instanceID), zap.String("query", name), zap.Error(err))
+		} else if exceeded := checkQueryCost(name, cost, limits); exceeded != nil {
+			if !limits.Downgrade {
+				r.emitQueryActivity(ctx, "runtime_query_rejected", 1, append(dims, attribute.String("reason", exceeded.Limit))...)
+				return nil, 0, nil, *exceeded
+			}
+			r.emitQueryActivity(ctx, "runtime_query_downgraded", 1, append(dims, attribute.String("reason", exceeded.Limit))...)
+			priority = downgradedQueryPriority
+		}
+	}
+
+	// Wait for a slot in the user's concurrency quota
+	subject := querySubject(ctx)
+	if limits.MaxConcurrentQueriesPerUser <= 0 || subject == "" {
+		return ctx, priority, noop, nil
+	}
+
+	start := time.Now()
+	release, err := r.queryAdmission.acquire(ctx, instanceID, subject, int(limits.MaxConcurrentQueriesPerUser), priority)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	r.emitQueryActivity(ctx, "runtime_query_queue_wait_ms", float64(time.Since(start).Milliseconds()), dims...)
+
+	return ctx, priority, release, nil
+}
+
+func (r *Runtime) emitQueryActivity(ctx context.Context, name string, value float64, dims ...attribute.KeyValue) {
+	if r.activity != nil {
+		r.activity.Emit(ctx, name, value, dims...)
+	}
+}
+
+func hasCostLimits(limits *runtimev1.QueryLimits) bool {
+	return limits.MaxScannedRows > 0 || limits.MaxScannedColumns > 0 || limits.MaxResultRows > 0 || limits.MaxResultBytes > 0
+}
+
+// checkQueryCost returns an error for the first limit that the cost exceeds.
+func checkQueryCost(query string, cost *QueryCost, limits *runtimev1.QueryLimits) *QueryLimitExceededError {
+	checks := []struct {
+		limit    string
+		estimate int64
+		max      int64
+	}{
+		{"max_scanned_rows", cost.ScannedRows, limits.MaxScannedRows},
+		{"max_scanned_columns", cost.ScannedColumns, limits.MaxScannedColumns},
+		{"max_result_rows", cost.ResultRows, limits.MaxResultRows},
+		{"max_result_bytes", cost.ResultBytes, limits.MaxResultBytes},
+	}
+	for _, c := range checks {
+		if c.max > 0 && c.estimate > c.max {
+			return &QueryLimitExceededError{Query: query, Limit: c.limit, Estimate: c.estimate, Max: c.max}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckQueryCost(t *testing.T) {
+	limits := &runtimev1.QueryLimits{MaxScannedRows: 1000, MaxResultBytes: 800}
+
+	require.Nil(t, checkQueryCost("Q", &QueryCost{ScannedRows: 1000, ScannedColumns: 50, ResultRows: 100, ResultBytes: 800}, limits))
+
+	exceeded := checkQueryCost("Q", &QueryCost{ScannedRows: 1001}, limits)
+	require.NotNil(t, exceeded)
+	require.Equal(t, "max_scanned_rows", exceeded.Limit)
+
+	exceeded = checkQueryCost("Q", &QueryCost{ResultBytes: 801}, limits)
+	require.NotNil(t, exceeded)
+	require.Equal(t, "max_result_bytes", exceeded.Limit)
+
+	var err error = *exceeded
+	require.True(t, errors.As(err, &QueryLimitExceededError{}))
+	require.ErrorContains(t, err, "estimated 801, max 800")
+}
+
+func TestQueryAdmissionPerUser(t *testing.T) {
+	a := newQueryAdmission()
+	ctx := context.Background()
+
+	release1, err := a.acquire(ctx, "inst", "alice", 1, 0)
+	require.NoError(t, err)
+
+	// Other users and instances have separate quotas
+	release2, err := a.acquire(ctx, "inst", "bob", 1, 0)
+	require.NoError(t, err)
+	release3, err := a.acquire(ctx, "other", "alice", 1, 0)
+	require.NoError(t, err)
+	release2()
+	release3()
+
+	// The same user waits for a slot
+	waitCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, err = a.acquire(waitCtx, "inst", "alice", 1, 0)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	acquired := make(chan func())
+	go func() {
+		release, err := a.acquire(ctx, "inst", "alice", 1, 0)
+		require.NoError(t, err)
+		acquired <- release
+	}()
+	select {
+	case <-acquired:
+		t.Fatal("acquired while the quota was full")
+	case <-time.After(50 * time.Millisecond):
+	}
+	release1()
+	(<-acquired)()
+
+	// Semaphores are removed when they're no longer used
+	require.Empty(t, a.sems)
+}
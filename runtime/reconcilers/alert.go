@@ -0,0 +1,359 @@
+package reconcilers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rilldata/rill/admin/email"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/pkg/duration"
+	"github.com/rilldata/rill/runtime/queries"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	_defaultAlertTimeout = 5 * time.Minute
+	// _alertMaxDetails is the max number of firing rows to include in notifications
+	_alertMaxDetails = 10
+)
+
+func init() {
+	runtime.RegisterReconcilerInitializer(runtime.ResourceKindAlert, newAlertReconciler)
+}
+
+type AlertReconciler struct {
+	C *runtime.Controller
+}
+
+func newAlertReconciler(c *runtime.Controller) runtime.Reconciler {
+	return &AlertReconciler{C: c}
+}
+
+func (r *AlertReconciler) Close(ctx context.Context) error {
+	return nil
+}
+
+func (r *AlertReconciler) AssignSpec(from, to *runtimev1.Resource) error {
+	a := from.GetAlert()
+	b := to.GetAlert()
+	if a == nil || b == nil {
+		return fmt.Errorf("cannot assign spec from %T to %T", from.Resource, to.Resource)
+	}
+	b.Spec = a.Spec
+	return nil
+}
+
+func (r *AlertReconciler) AssignState(from, to *runtimev1.Resource) error {
+	a := from.GetAlert()
+	b := to.GetAlert()
+	if a == nil || b == nil {
+		return fmt.Errorf("cannot assign state from %T to %T", from.Resource, to.Resource)
+	}
+	b.State = a.State
+	return nil
+}
+
+func (r *AlertReconciler) ResetState(res *runtimev1.Resource) error {
+	res.GetAlert().State = &runtimev1.AlertState{}
+	return nil
+}
+
+func (r *AlertReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceName) runtime.ReconcileResult {
+	self, err := r.C.Get(ctx, n, true)
+	if err != nil {
+		return runtime.ReconcileResult{Err: err}
+	}
+	a := self.GetAlert()
+	if a == nil {
+		return runtime.ReconcileResult{Err: errors.New("not an alert")}
+	}
+
+	// Nothing to clean up on deletion
+	if self.Meta.DeletedOn != nil {
+		return runtime.ReconcileResult{}
+	}
+
+	// Check refs - stop if any of them are invalid
+	err = checkRefs(ctx, r.C, self.Meta.Refs)
+	if err != nil {
+		return runtime.ReconcileResult{Err: err}
+	}
+
+	// Exit early if it's not time to evaluate
+	run, runOn, err := scheduledRun(self, a.State.LastRunOn, a.Spec.RefreshSchedule, a.Spec.Trigger)
+	if err != nil {
+		return runtime.ReconcileResult{Err: err}
+	}
+	if !run {
+		nextRunOn := timestampOrNil(runOn)
+		if !proto.Equal(a.State.NextRunOn, nextRunOn) {
+			a.State.NextRunOn = nextRunOn
+			err = r.C.UpdateState(ctx, self.Meta.Name, self)
+			if err != nil {
+				return runtime.ReconcileResult{Err: err}
+			}
+		}
+		return runtime.ReconcileResult{Retrigger: runOn}
+	}
+
+	// Evaluate the alert and notify on status transitions
+	runErr := r.evaluateAndNotify(ctx, self.Meta.Name.Name, a, self.Meta.Refs)
+	if runErr != nil && errors.Is(runErr, ctx.Err()) {
+		// The controller guarantees a new call to Reconcile, which will re-evaluate the alert.
+		return runtime.ReconcileResult{Err: runErr}
+	}
+
+	// Update state
+	now := time.Now()
+	runOn, err = nextRefreshTime(now, a.Spec.RefreshSchedule)
+	if err != nil {
+		return runtime.ReconcileResult{Err: err}
+	}
+	a.State.LastRunOn = timestamppb.New(now)
+	a.State.NextRunOn = timestampOrNil(runOn)
+	a.State.ExecutionCount++
+	a.State.LastError = ""
+	if runErr != nil {
+		a.State.LastError = runErr.Error()
+	}
+	err = r.C.UpdateState(ctx, self.Meta.Name, self)
+	if err != nil {
+		return runtime.ReconcileResult{Err: err}
+	}
+
+	// Reset spec.Trigger
+	if a.Spec.Trigger {
+		err := setSpecTriggerFalse(ctx, r.C, n, func(res *runtimev1.Resource) error {
+			a := res.GetAlert()
+			if a == nil {
+				return fmt.Errorf("not an alert")
+			}
+			a.Spec.Trigger = false
+			return nil
+		})
+		if err != nil {
+			return runtime.ReconcileResult{Err: err}
+		}
+	}
+
+	return runtime.ReconcileResult{Err: runErr, Retrigger: runOn}
+}
+
+// evaluateAndNotify evaluates the alert's query and sends notifications if its status changed.
+// The status in the alert's state is only updated if the notifications were delivered, so failed notifications are retried on the next evaluation.
+func (r *AlertReconciler) evaluateAndNotify(ctx context.Context, name string, a *runtimev1.Alert, refs []*runtimev1.ResourceName) error {
+	timeout := _defaultAlertTimeout
+	if a.Spec.TimeoutSeconds > 0 {
+		timeout = time.Duration(a.Spec.TimeoutSeconds) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	rows, err := r.evaluate(ctx, a.Spec, refs)
+	if err != nil {
+		return fmt.Errorf("failed to evaluate alert: %w", err)
+	}
+	a.State.FiringCount = uint32(len(rows))
+
+	status := runtimev1.AlertStatus_ALERT_STATUS_OK
+	if len(rows) > 0 {
+		status = runtimev1.AlertStatus_ALERT_STATUS_FIRING
+	}
+
+	// Notify when the alert starts firing (ok -> firing) or is resolved (firing -> ok)
+	prev := a.State.Status
+	notify := status == runtimev1.AlertStatus_ALERT_STATUS_FIRING && prev != runtimev1.AlertStatus_ALERT_STATUS_FIRING
+	notify = notify || status == runtimev1.AlertStatus_ALERT_STATUS_OK && prev == runtimev1.AlertStatus_ALERT_STATUS_FIRING
+	if notify {
+		err := r.notify(ctx, name, a.Spec, status == runtimev1.AlertStatus_ALERT_STATUS_FIRING, rows)
+		if err != nil {
+			return err
+		}
+	}
+
+	if status != prev {
+		a.State.Status = status
+		a.State.StatusChangedOn = timestamppb.Now()
+	}
+	return nil
+}
+
+// evaluate runs the alert's query with its condition and returns the rows that satisfy the condition.
+func (r *AlertReconciler) evaluate(ctx context.Context, spec *runtimev1.AlertSpec, refs []*runtimev1.ResourceName) ([]*structpb.Struct, error) {
+	if spec.QueryName != "MetricsViewAggregation" {
+		return nil, fmt.Errorf("unsupported alert query %q", spec.QueryName)
+	}
+
+	req := &runtimev1.MetricsViewAggregationRequest{}
+	err := protojson.Unmarshal([]byte(spec.QueryArgsJson), req)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query args: %w", err)
+	}
+
+	mv, updatedOn, err := resolveMetricsView(ctx, r.C, req.MetricsView, refs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply the security policy of the alert owner
+	security, err := resolveOwnerSecurity(r.C, spec.OwnerAttributes, mv, updatedOn)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range req.Dimensions {
+		if d.Name != mv.TimeDimension && !security.CanAccessField(d.Name) {
+			return nil, fmt.Errorf("the alert owner does not have access to dimension %q", d.Name)
+		}
+	}
+	for _, m := range req.Measures {
+		if m.BuiltinMeasure == runtimev1.BuiltinMeasure_BUILTIN_MEASURE_UNSPECIFIED && !security.CanAccessField(m.Name) {
+			return nil, fmt.Errorf("the alert owner does not have access to measure %q", m.Name)
+		}
+	}
+
+	// Combine the condition with the query's own having clause (if any)
+	having := spec.Condition
+	if req.Having != nil {
+		having = &runtimev1.Expression{Expression: &runtimev1.Expression_Cond{Cond: &runtimev1.Condition{
+			Op:    runtimev1.Operation_OPERATION_AND,
+			Exprs: []*runtimev1.Expression{req.Having, spec.Condition},
+		}}}
+	}
+
+	// Resolve the time range relative to now
+	timeStart, timeEnd := req.TimeStart, req.TimeEnd
+	if spec.QueryTimeRange != "" {
+		d, err := duration.ParseISO8601(spec.QueryTimeRange)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time range: %w", err)
+		}
+		now := time.Now()
+		timeStart = timestampOrNil(d.Sub(now))
+		timeEnd = timestamppb.New(now)
+	}
+
+	q := &queries.MetricsViewAggregation{
+		MetricsViewName:    req.MetricsView,
+		Dimensions:         req.Dimensions,
+		Measures:           req.Measures,
+		Sort:               req.Sort,
+		TimeStart:          timeStart,
+		TimeEnd:            timeEnd,
+		Filter:             req.Filter,
+		Where:              req.Where,
+		Having:             having,
+		Limit:              &req.Limit,
+		Offset:             req.Offset,
+		MetricsView:        mv,
+		ResolvedMVSecurity: security,
+	}
+
+	// Not using rt.Query since alerts must not be evaluated against cached results
+	err = q.Resolve(ctx, r.C.Runtime, r.C.InstanceID, int(req.Priority))
+	if err != nil {
+		return nil, err
+	}
+	if q.Result == nil {
+		return nil, nil
+	}
+	return q.Result.Data, nil
+}
+
+// notify sends a firing or resolved notification to the alert's recipients.
+func (r *AlertReconciler) notify(ctx context.Context, name string, spec *runtimev1.AlertSpec, firing bool, rows []*structpb.Struct) error {
+	title := spec.Title
+	if title == "" {
+		title = name
+	}
+
+	var details []string
+	for i, row := range rows {
+		if i == _alertMaxDetails {
+			details = append(details, fmt.Sprintf("and %d more", len(rows)-i))
+			break
+		}
+		details = append(details, formatAlertRow(row))
+	}
+
+	var errs []error
+	if len(spec.EmailRecipients) > 0 {
+		client := r.C.Runtime.Email()
+		if client == nil {
+			errs = append(errs, errors.New("cannot send alert emails: email is not configured for the runtime"))
+		} else {
+			for _, recipient := range spec.EmailRecipients {
+				err := client.SendAlert(&email.Alert{
+					ToEmail: recipient,
+					Title:   title,
+					Firing:  firing,
+					Details: details,
+				})
+				if err != nil {
+					errs = append(errs, fmt.Errorf("failed to send alert to %q: %w", recipient, err))
+				}
+			}
+		}
+	}
+
+	if len(spec.WebhookUrls) > 0 {
+		status := "resolved"
+		if firing {
+			status = "firing"
+		}
+		data := make([]map[string]any, len(rows))
+		for i, row := range rows {
+			data[i] = row.AsMap()
+		}
+		body, err := json.Marshal(map[string]any{
+			"alert":  name,
+			"title":  title,
+			"status": status,
+			"time":   time.Now().UTC().Format(time.RFC3339),
+			"rows":   data,
+		})
+		if err != nil {
+			return err
+		}
+
+		header := http.Header{}
+		header.Set("Content-Type", "application/json")
+		header.Set("X-Rill-Alert", name)
+		for _, u := range spec.WebhookUrls {
+			err := postWebhook(ctx, u, header, body)
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// formatAlertRow formats a row returned by an alert's query for display in a notification.
+func formatAlertRow(row *structpb.Struct) string {
+	keys := make([]string, 0, len(row.Fields))
+	for k := range row.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		v, err := row.Fields[k].MarshalJSON()
+		if err != nil {
+			v = []byte("?")
+		}
+		parts[i] = fmt.Sprintf("%s=%s", k, v)
+	}
+	return strings.Join(parts, ", ")
+}
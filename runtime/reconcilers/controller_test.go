@@ -0,0 +1,332 @@
+package reconcilers_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/reconcilers"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestAssignState(t *testing.T) {
+	tests := []struct {
+		name       string
+		reconciler runtime.Reconciler
+		new        func() *runtimev1.Resource
+		spec       func(r *runtimev1.Resource) any
+		state      func(r *runtimev1.Resource) any
+	}{
+		{
+			name:       "metrics view",
+			reconciler: &reconcilers.MetricsViewReconciler{},
+			new: func() *runtimev1.Resource {
+				return &runtimev1.Resource{Resource: &runtimev1.Resource_MetricsView{MetricsView: &runtimev1.MetricsViewV2{
+					Spec:  &runtimev1.MetricsViewSpec{Table: uuid.NewString()},
+					State: &runtimev1.MetricsViewState{ValidSpec: &runtimev1.MetricsViewSpec{Table: uuid.NewString()}},
+				}}}
+			},
+			spec:  func(r *runtimev1.Resource) any { return r.GetMetricsView().Spec },
+			state: func(r *runtimev1.Resource) any { return r.GetMetricsView().State },
+		},
+		{
+			name:       "migration",
+			reconciler: &reconcilers.MigrationReconciler{},
+			new: func() *runtimev1.Resource {
+				return &runtimev1.Resource{Resource: &runtimev1.Resource_Migration{Migration: &runtimev1.Migration{
+					Spec:  &runtimev1.MigrationSpec{Sql: uuid.NewString()},
+					State: &runtimev1.MigrationState{Version: 1},
+				}}}
+			},
+			spec:  func(r *runtimev1.Resource) any { return r.GetMigration().Spec },
+			state: func(r *runtimev1.Resource) any { return r.GetMigration().State },
+		},
+		{
+			name:       "model",
+			reconciler: &reconcilers.ModelReconciler{},
+			new: func() *runtimev1.Resource {
+				return &runtimev1.Resource{Resource: &runtimev1.Resource_Model{Model: &runtimev1.ModelV2{
+					Spec:  &runtimev1.ModelSpec{Sql: uuid.NewString()},
+					State: &runtimev1.ModelState{Table: uuid.NewString()},
+				}}}
+			},
+			spec:  func(r *runtimev1.Resource) any { return r.GetModel().Spec },
+			state: func(r *runtimev1.Resource) any { return r.GetModel().State },
+		},
+		{
+			name:       "project parser",
+			reconciler: &reconcilers.ProjectParserReconciler{},
+			new: func() *runtimev1.Resource {
+				return &runtimev1.Resource{Resource: &runtimev1.Resource_ProjectParser{ProjectParser: &runtimev1.ProjectParser{
+					Spec:  &runtimev1.ProjectParserSpec{Compiler: uuid.NewString()},
+					State: &runtimev1.ProjectParserState{CurrentCommitSha: uuid.NewString()},
+				}}}
+			},
+			spec:  func(r *runtimev1.Resource) any { return r.GetProjectParser().Spec },
+			state: func(r *runtimev1.Resource) any { return r.GetProjectParser().State },
+		},
+		{
+			name:       "pull trigger",
+			reconciler: &reconcilers.PullTriggerReconciler{},
+			new: func() *runtimev1.Resource {
+				return &runtimev1.Resource{Resource: &runtimev1.Resource_PullTrigger{PullTrigger: &runtimev1.PullTrigger{
+					Spec:  &runtimev1.PullTriggerSpec{},
+					State: &runtimev1.PullTriggerState{},
+				}}}
+			},
+			spec:  func(r *runtimev1.Resource) any { return r.GetPullTrigger().Spec },
+			state: func(r *runtimev1.Resource) any { return r.GetPullTrigger().State },
+		},
+		{
+			name:       "refresh trigger",
+			reconciler: &reconcilers.RefreshTriggerReconciler{},
+			new: func() *runtimev1.Resource {
+				return &runtimev1.Resource{Resource: &runtimev1.Resource_RefreshTrigger{RefreshTrigger: &runtimev1.RefreshTrigger{
+					Spec:  &runtimev1.RefreshTriggerSpec{OnlyNames: []*runtimev1.ResourceName{{Name: uuid.NewString()}}},
+					State: &runtimev1.RefreshTriggerState{},
+				}}}
+			},
+			spec:  func(r *runtimev1.Resource) any { return r.GetRefreshTrigger().Spec },
+			state: func(r *runtimev1.Resource) any { return r.GetRefreshTrigger().State },
+		},
+		{
+			name:       "source",
+			reconciler: &reconcilers.SourceReconciler{},
+			new: func() *runtimev1.Resource {
+				return &runtimev1.Resource{Resource: &runtimev1.Resource_Source{Source: &runtimev1.SourceV2{
+					Spec:  &runtimev1.SourceSpec{SourceConnector: uuid.NewString()},
+					State: &runtimev1.SourceState{Table: uuid.NewString()},
+				}}}
+			},
+			spec:  func(r *runtimev1.Resource) any { return r.GetSource().Spec },
+			state: func(r *runtimev1.Resource) any { return r.GetSource().State },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to := tt.new(), tt.new()
+			spec := tt.spec(to)
+
+			// The state is carried over (e.g. on rename), but the new resource keeps its own spec
+			require.NoError(t, tt.reconciler.AssignState(from, to))
+			require.Same(t, tt.state(from), tt.state(to))
+			require.Same(t, spec, tt.spec(to))
+
+			require.Error(t, tt.reconciler.AssignState(&runtimev1.Resource{}, to))
+		})
+	}
+}
+
+func TestControllerClearsReconcileError(t *testing.T) {
+	_, ctrl := newController(t)
+	name := &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "model"}
+
+	truth := true
+	createModel(t, ctrl, name, &runtimev1.ModelSpec{Connector: "duckdb", Sql: "SELECT * FROM missing_table", Materialize: &truth})
+	require.Eventually(t, func() bool {
+		r, err := ctrl.Get(context.Background(), name, true)
+		return err == nil && r.Meta.ReconcileStatus == runtimev1.ReconcileStatus_RECONCILE_STATUS_IDLE && r.Meta.ReconcileError != ""
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// A successful reconcile clears the previous error
+	r, err := ctrl.Get(context.Background(), name, true)
+	require.NoError(t, err)
+	r.GetModel().Spec.Sql = "SELECT 1 AS id"
+	require.NoError(t, ctrl.UpdateSpec(context.Background(), name, r))
+	waitForModel(t, ctrl, name, 0)
+}
+
+func TestControllerRetrigger(t *testing.T) {
+	_, ctrl := newController(t)
+	name := &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "model"}
+
+	// The refresh schedule re-enqueues the model from the controller's event loop
+	truth := true
+	createModel(t, ctrl, name, &runtimev1.ModelSpec{
+		Connector:       "duckdb",
+		Sql:             "SELECT 1 AS id",
+		Materialize:     &truth,
+		RefreshSchedule: &runtimev1.Schedule{TickerSeconds: 1},
+	})
+	v := waitForModel(t, ctrl, name, 0)
+	waitForModel(t, ctrl, name, v)
+}
+
+func TestControllerDependents(t *testing.T) {
+	_, ctrl := newController(t)
+	parent := &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "parent"}
+	child := &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "child"}
+
+	truth := true
+	createModel(t, ctrl, parent, &runtimev1.ModelSpec{Connector: "duckdb", Sql: "SELECT 1 AS id", Materialize: &truth})
+	r := &runtimev1.Resource{Resource: &runtimev1.Resource_Model{Model: &runtimev1.ModelV2{
+		Spec:  &runtimev1.ModelSpec{Connector: "duckdb", Sql: "SELECT * FROM parent", Materialize: &truth},
+		State: &runtimev1.ModelState{},
+	}}}
+	require.NoError(t, ctrl.Create(context.Background(), child, []*runtimev1.ResourceName{parent}, nil, nil, r))
+	waitForModel(t, ctrl, parent, 0)
+	v := waitForModel(t, ctrl, child, 0)
+
+	// When the parent completes, the controller's event loop enqueues the child
+	createRefreshTrigger(t, ctrl, false, parent)
+	waitForModel(t, ctrl, child, v)
+}
+
+func TestControllerSource(t *testing.T) {
+	rt, ctrl := newController(t)
+	name := &runtimev1.ResourceName{Kind: runtime.ResourceKindSource, Name: "src"}
+
+	// Ingestion emits activity metrics, so the controller must have an activity client
+	require.NoError(t, rt.PutFile(context.Background(), ctrl.InstanceID, "data/src.csv", strings.NewReader("id\n1\n"), true, false))
+	props, err := structpb.NewStruct(map[string]any{"path": "data/src.csv"})
+	require.NoError(t, err)
+	r := &runtimev1.Resource{Resource: &runtimev1.Resource_Source{Source: &runtimev1.SourceV2{
+		Spec:  &runtimev1.SourceSpec{SourceConnector: "local_file", SinkConnector: "duckdb", Properties: props},
+		State: &runtimev1.SourceState{},
+	}}}
+	require.NoError(t, ctrl.Create(context.Background(), name, nil, nil, nil, r))
+
+	require.Eventually(t, func() bool {
+		r, err := ctrl.Get(context.Background(), name, true)
+		if err != nil || r.Meta.ReconcileStatus != runtimev1.ReconcileStatus_RECONCILE_STATUS_IDLE || r.GetSource().State.Table == "" {
+			return false
+		}
+		require.Empty(t, r.Meta.ReconcileError)
+		return true
+	}, 10*time.Second, 10*time.Millisecond)
+	require.Equal(t, []string{"1"}, queryValues(t, rt, ctrl.InstanceID, "SELECT id FROM src"))
+}
+
+func TestControllerSubscribe(t *testing.T) {
+	_, ctrl := newController(t)
+	name := &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "model"}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	var events []runtimev1.ResourceEvent
+	go func() {
+		_ = ctrl.Subscribe(ctx, func(e runtimev1.ResourceEvent, n *runtimev1.ResourceName, r *runtimev1.Resource) {
+			if n.Name == name.Name {
+				mu.Lock()
+				events = append(events, e)
+				mu.Unlock()
+			}
+		})
+	}()
+
+	// Events are emitted when the model is created and after it has been reconciled
+	truth := true
+	createModel(t, ctrl, name, &runtimev1.ModelSpec{Connector: "duckdb", Sql: "SELECT 1 AS id", Materialize: &truth})
+	waitForModel(t, ctrl, name, 0)
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(events) > 0
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+func TestControllerSelfDeletingResource(t *testing.T) {
+	_, ctrl := newController(t)
+
+	// The refresh trigger deletes itself after it has been reconciled
+	trigger := createRefreshTrigger(t, ctrl, false)
+	require.Eventually(t, func() bool {
+		_, err := ctrl.Get(context.Background(), trigger, false)
+		return errors.Is(err, drivers.ErrResourceNotFound)
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// The controller keeps running
+	name := &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "model"}
+	truth := true
+	createModel(t, ctrl, name, &runtimev1.ModelSpec{Connector: "duckdb", Sql: "SELECT 1 AS id", Materialize: &truth})
+	waitForModel(t, ctrl, name, 0)
+}
+
+// newController starts the controller for a new instance and stops it when the test completes.
+func newController(t *testing.T) (*runtime.Runtime, *runtime.Controller) {
+	rt, id := testruntime.NewInstance(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	go func() { _ = rt.RunControllers(ctx) }()
+	var ctrl *runtime.Controller
+	require.Eventually(t, func() bool {
+		var err error
+		ctrl, err = rt.Controller(id)
+		return err == nil
+	}, 10*time.Second, 10*time.Millisecond)
+
+	return rt, ctrl
+}
+
+func createModel(t *testing.T, ctrl *runtime.Controller, name *runtimev1.ResourceName, spec *runtimev1.ModelSpec) {
+	r := &runtimev1.Resource{Resource: &runtimev1.Resource_Model{Model: &runtimev1.ModelV2{
+		Spec:  spec,
+		State: &runtimev1.ModelState{},
+	}}}
+	require.NoError(t, ctrl.Create(context.Background(), name, nil, nil, nil, r))
+}
+
+func createRefreshTrigger(t *testing.T, ctrl *runtime.Controller, fullRefresh bool, names ...*runtimev1.ResourceName) *runtimev1.ResourceName {
+	name := &runtimev1.ResourceName{Kind: runtime.ResourceKindRefreshTrigger, Name: "trigger_" + uuid.NewString()}
+	r := &runtimev1.Resource{Resource: &runtimev1.Resource_RefreshTrigger{RefreshTrigger: &runtimev1.RefreshTrigger{
+		Spec:  &runtimev1.RefreshTriggerSpec{OnlyNames: names, FullRefresh: fullRefresh},
+		State: &runtimev1.RefreshTriggerState{},
+	}}}
+	require.NoError(t, ctrl.Create(context.Background(), name, nil, nil, nil, r))
+	return name
+}
+
+// waitForModel waits for a model to finish a reconcile that bumps its state version past the given version.
+// It returns the new state version.
+func waitForModel(t *testing.T, ctrl *runtime.Controller, name *runtimev1.ResourceName, afterVersion int64) int64 {
+	var version int64
+	require.Eventually(t, func() bool {
+		r, err := ctrl.Get(context.Background(), name, true)
+		if err != nil {
+			return false
+		}
+		model := r.GetModel()
+		if r.Meta.ReconcileStatus != runtimev1.ReconcileStatus_RECONCILE_STATUS_IDLE || model.Spec.Trigger || model.Spec.TriggerFullRefresh {
+			return false
+		}
+		if r.Meta.StateVersion <= afterVersion || model.State.Table == "" {
+			return false
+		}
+		require.Empty(t, r.Meta.ReconcileError)
+		version = r.Meta.StateVersion
+		return true
+	}, 10*time.Second, 10*time.Millisecond)
+	return version
+}
+
+func queryValues(t *testing.T, rt *runtime.Runtime, instanceID, sql string) []string {
+	olap, release, err := rt.OLAP(context.Background(), instanceID)
+	require.NoError(t, err)
+	defer release()
+
+	rows, err := olap.Execute(context.Background(), &drivers.Statement{Query: sql})
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var res []string
+	for rows.Next() {
+		var v string
+		require.NoError(t, rows.Scan(&v))
+		res = append(res, v)
+	}
+	require.NoError(t, rows.Err())
+	return res
+}
@@ -0,0 +1,181 @@
+package reconcilers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/duckdbsql"
+	"golang.org/x/exp/slog"
+)
+
+// modelColumnLineage computes the lineage of the columns in a model's table from the SQL that created it.
+// Lineage is best effort: it returns nil if the connector is not DuckDB or the SQL can't be analyzed.
+func modelColumnLineage(ctx context.Context, c *runtime.Controller, refs []*runtimev1.ResourceName, connector, table, sql string) []*runtimev1.ColumnLineage {
+	olap, release, err := c.AcquireOLAP(ctx, connector)
+	if err != nil {
+		return nil
+	}
+	defer release()
+
+	if olap.Dialect() != drivers.DialectDuckDB {
+		return nil
+	}
+
+	t, err := olap.InformationSchema().Lookup(ctx, table)
+	if err != nil {
+		return nil
+	}
+
+	ast, err := duckdbsql.Parse(sql)
+	if err != nil {
+		c.Logger.Debug("failed to parse model SQL for lineage", slog.String("name", table), slog.Any("err", err))
+		return nil
+	}
+
+	lineage := ast.ExtractColumnLineage(tableColumnsFunc(ctx, olap))
+
+	// Name unnamed expressions by their position in the output, which is only possible when there are no unexpanded stars
+	var stars []*duckdbsql.ColumnSource
+	for _, l := range lineage {
+		if l.Name == "*" {
+			stars = append(stars, l.Sources...)
+		}
+	}
+	if len(stars) == 0 && len(lineage) == len(t.Schema.Fields) {
+		for i, f := range t.Schema.Fields {
+			lineage[i].Name = f.Name
+		}
+	}
+
+	// Resolve the upstream tables to resources
+	resources := make(map[string]*runtimev1.ResourceName)
+	resolve := func(sources []*duckdbsql.ColumnSource) []*runtimev1.LineageColumn {
+		var res []*runtimev1.LineageColumn
+		for _, s := range sources {
+			key := strings.ToLower(s.Table)
+			n, ok := resources[key]
+			if !ok {
+				n = lineageResourceName(ctx, c, refs, s.Table)
+				resources[key] = n
+			}
+			if n != nil {
+				res = append(res, &runtimev1.LineageColumn{Resource: n, Column: s.Column})
+			}
+		}
+		return res
+	}
+
+	var res []*runtimev1.ColumnLineage
+	named := make(map[string]bool)
+	for _, l := range lineage {
+		if l.Name == "" || l.Name == "*" {
+			continue
+		}
+		named[strings.ToLower(l.Name)] = true
+		res = append(res, &runtimev1.ColumnLineage{Name: l.Name, Upstream: resolve(l.Sources)})
+	}
+
+	// Output columns not named in the SELECT list are selected by the unexpanded stars
+	for _, f := range t.Schema.Fields {
+		if len(stars) == 0 || named[strings.ToLower(f.Name)] {
+			continue
+		}
+		var sources []*duckdbsql.ColumnSource
+		for _, s := range stars {
+			sources = append(sources, &duckdbsql.ColumnSource{Table: s.Table, Column: f.Name})
+		}
+		res = append(res, &runtimev1.ColumnLineage{Name: f.Name, Upstream: resolve(sources)})
+	}
+
+	return res
+}
+
+// metricsViewColumnLineage computes the lineage of the dimensions and measures of a metrics view.
+// Measures that reference other measures have the referenced measures of the metrics view itself as upstream columns.
+func metricsViewColumnLineage(ctx context.Context, c *runtime.Controller, self *runtimev1.Resource, mv *runtimev1.MetricsViewSpec) []*runtimev1.ColumnLineage {
+	olap, release, err := c.AcquireOLAP(ctx, mv.Connector)
+	if err != nil {
+		return nil
+	}
+	defer release()
+
+	n := lineageResourceName(ctx, c, self.Meta.Refs, mv.Table)
+	if n == nil {
+		return nil
+	}
+
+	var res []*runtimev1.ColumnLineage
+	if mv.TimeDimension != "" {
+		res = append(res, &runtimev1.ColumnLineage{
+			Name:     mv.TimeDimension,
+			Upstream: []*runtimev1.LineageColumn{{Resource: n, Column: mv.TimeDimension}},
+		})
+	}
+
+	for _, d := range mv.Dimensions {
+		res = append(res, &runtimev1.ColumnLineage{
+			Name:     d.Name,
+			Upstream: []*runtimev1.LineageColumn{{Resource: n, Column: d.Column}},
+		})
+	}
+
+	schema := tableColumnsFunc(ctx, olap)
+	for _, m := range mv.Measures {
+		l := &runtimev1.ColumnLineage{Name: m.Name}
+		if olap.Dialect() == drivers.DialectDuckDB {
+			ast, err := duckdbsql.Parse(fmt.Sprintf("SELECT %s FROM %s", m.Expression, safeSQLName(mv.Table)))
+			if err == nil {
+				for _, cl := range ast.ExtractColumnLineage(schema) {
+					for _, s := range cl.Sources {
+						l.Upstream = append(l.Upstream, &runtimev1.LineageColumn{Resource: n, Column: s.Column})
+					}
+				}
+			}
+		}
+		for _, ref := range m.ReferencedMeasures {
+			l.Upstream = append(l.Upstream, &runtimev1.LineageColumn{Resource: self.Meta.Name, Column: ref})
+		}
+		res = append(res, l)
+	}
+
+	return res
+}
+
+// tableColumnsFunc returns a func that looks up the column names of a table in an OLAP store.
+func tableColumnsFunc(ctx context.Context, olap drivers.OLAPStore) func(table string) []string {
+	return func(table string) []string {
+		t, err := olap.InformationSchema().Lookup(ctx, table)
+		if err != nil {
+			return nil
+		}
+		cols := make([]string, len(t.Schema.Fields))
+		for i, f := range t.Schema.Fields {
+			cols[i] = f.Name
+		}
+		return cols
+	}
+}
+
+// lineageResourceName resolves a table name to the source or model that created it.
+// It returns nil if the table was not created by a resource.
+func lineageResourceName(ctx context.Context, c *runtime.Controller, refs []*runtimev1.ResourceName, table string) *runtimev1.ResourceName {
+	for _, ref := range refs {
+		if (ref.Kind == runtime.ResourceKindSource || ref.Kind == runtime.ResourceKindModel) && strings.EqualFold(ref.Name, table) {
+			return ref
+		}
+	}
+
+	for _, kind := range []string{runtime.ResourceKindSource, runtime.ResourceKindModel} {
+		n := &runtimev1.ResourceName{Kind: kind, Name: table}
+		r, err := c.Get(ctx, n, false)
+		if err == nil {
+			return r.Meta.Name
+		}
+	}
+
+	return nil
+}
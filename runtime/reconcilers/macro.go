@@ -0,0 +1,149 @@
+package reconcilers
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+)
+
+func init() {
+	runtime.RegisterReconcilerInitializer(runtime.ResourceKindMacro, newMacroReconciler)
+}
+
+// MacroReconciler reconciles macros, which are SQL templates expanded by the models and sources that call them.
+// Nothing is created for a macro. Instead, its state's spec hash changes when its expansion may have changed,
+// which changes the execution spec hash of the models that call it and causes them to be rebuilt.
+type MacroReconciler struct {
+	C *runtime.Controller
+}
+
+func newMacroReconciler(c *runtime.Controller) runtime.Reconciler {
+	return &MacroReconciler{C: c}
+}
+
+func (r *MacroReconciler) Close(ctx context.Context) error {
+	return nil
+}
+
+func (r *MacroReconciler) AssignSpec(from, to *runtimev1.Resource) error {
+	a := from.GetMacro()
+	b := to.GetMacro()
+	if a == nil || b == nil {
+		return fmt.Errorf("cannot assign spec from %T to %T", from.Resource, to.Resource)
+	}
+	b.Spec = a.Spec
+	return nil
+}
+
+func (r *MacroReconciler) AssignState(from, to *runtimev1.Resource) error {
+	a := from.GetMacro()
+	b := to.GetMacro()
+	if a == nil || b == nil {
+		return fmt.Errorf("cannot assign state from %T to %T", from.Resource, to.Resource)
+	}
+	b.State = a.State
+	return nil
+}
+
+func (r *MacroReconciler) ResetState(res *runtimev1.Resource) error {
+	res.GetMacro().State = &runtimev1.MacroState{}
+	return nil
+}
+
+func (r *MacroReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceName) runtime.ReconcileResult {
+	self, err := r.C.Get(ctx, n, true)
+	if err != nil {
+		return runtime.ReconcileResult{Err: err}
+	}
+	m := self.GetMacro()
+	if m == nil {
+		return runtime.ReconcileResult{Err: errors.New("not a macro")}
+	}
+
+	// Nothing to clean up on deletion or rename
+	if self.Meta.DeletedOn != nil {
+		return runtime.ReconcileResult{}
+	}
+
+	// Check refs - stop if any of them are invalid.
+	// The controller calls Reconcile again when a ref is reconciled, so the hash below picks up changes to nested macros and referenced resources.
+	err = checkRefs(ctx, r.C, self.Meta.Refs)
+	if err != nil {
+		return runtime.ReconcileResult{Err: err}
+	}
+
+	hash, err := r.specHash(ctx, self.Meta.Refs, m.Spec)
+	if err != nil {
+		return runtime.ReconcileResult{Err: fmt.Errorf("failed to compute hash: %w", err)}
+	}
+
+	// Updating the state bumps the macro's state version, which the callers' spec hashes depend on
+	if m.State.SpecHash != hash {
+		m.State.SpecHash = hash
+		err = r.C.UpdateState(ctx, self.Meta.Name, self)
+		if err != nil {
+			return runtime.ReconcileResult{Err: err}
+		}
+	}
+
+	return runtime.ReconcileResult{}
+}
+
+// specHash computes a hash of the macro's spec and the state versions of its refs.
+func (r *MacroReconciler) specHash(ctx context.Context, refs []*runtimev1.ResourceName, spec *runtimev1.MacroSpec) (string, error) {
+	hash := md5.New()
+
+	for _, ref := range refs { // Refs are always sorted
+		_, err := hash.Write([]byte(ref.Kind))
+		if err != nil {
+			return "", err
+		}
+		_, err = hash.Write([]byte(ref.Name))
+		if err != nil {
+			return "", err
+		}
+
+		r, err := r.C.Get(ctx, ref, false)
+		var stateVersion int64
+		if err == nil {
+			stateVersion = r.Meta.StateVersion
+		} else {
+			stateVersion = -1
+		}
+		err = binary.Write(hash, binary.BigEndian, stateVersion)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	for _, arg := range spec.Args {
+		_, err := hash.Write([]byte(arg))
+		if err != nil {
+			return "", err
+		}
+	}
+
+	_, err := hash.Write([]byte(spec.Sql))
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// resolveMacro returns a func that looks up the spec of a macro in the catalog for use in compilerv1.TemplateData.
+func resolveMacro(ctx context.Context, c *runtime.Controller) func(name string) (*runtimev1.MacroSpec, error) {
+	return func(name string) (*runtimev1.MacroSpec, error) {
+		res, err := c.Get(ctx, &runtimev1.ResourceName{Kind: runtime.ResourceKindMacro, Name: name}, false)
+		if err != nil {
+			return nil, fmt.Errorf("macro %q: %w", name, err)
+		}
+		return res.GetMacro().Spec, nil
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
@@ -43,7 +44,7 @@ func (r *MetricsViewReconciler) AssignState(from, to *runtimev1.Resource) error
 	if a == nil || b == nil {
 		return fmt.Errorf("cannot assign state from %T to %T", from.Resource, to.Resource)
 	}
-	b.Spec = a.Spec
+	b.State = a.State
 	return nil
 }
 
@@ -67,14 +68,16 @@ func (r *MetricsViewReconciler) Reconcile(ctx context.Context, n *runtimev1.Reso
 
 	validateErr := r.validate(ctx, mv.Spec)
 
-	if errors.Is(validateErr, ctx.Err()) {
+	if validateErr != nil && errors.Is(validateErr, ctx.Err()) {
 		return runtime.ReconcileResult{Err: validateErr}
 	}
 
 	if validateErr == nil {
 		mv.State.ValidSpec = mv.Spec
+		mv.State.ColumnLineage = metricsViewColumnLineage(ctx, r.C, self, mv.Spec)
 	} else {
 		mv.State.ValidSpec = nil
+		mv.State.ColumnLineage = nil
 	}
 
 	err = r.C.UpdateState(ctx, self.Meta.Name, self)
@@ -125,7 +128,12 @@ func (r *MetricsViewReconciler) validate(ctx context.Context, mv *runtimev1.Metr
 
 	// Check measure expressions are valid
 	for _, d := range mv.Measures {
-		err := validateMeasure(ctx, olap, t, d)
+		var err error
+		if len(d.ReferencedMeasures) > 0 || d.Window != nil {
+			err = validateDerivedMeasure(ctx, olap, t, mv, d)
+		} else {
+			err = validateMeasure(ctx, olap, t, d)
+		}
 		if err != nil {
 			errs = append(errs, fmt.Errorf("invalid expression for measure %q: %w", d.Name, err))
 		}
@@ -141,3 +149,101 @@ func validateMeasure(ctx context.Context, olap drivers.OLAPStore, t *drivers.Tab
 	})
 	return err
 }
+
+// validateDerivedMeasure dry-runs a measure that references other measures or has a window.
+// Like in queries, it computes the derived measures in subqueries on top of the referenced measures grouped by the time dimension.
+func validateDerivedMeasure(ctx context.Context, olap drivers.OLAPStore, t *drivers.Table, mv *runtimev1.MetricsViewSpec, m *runtimev1.MetricsViewSpec_MeasureV2) error {
+	measures := make(map[string]*runtimev1.MetricsViewSpec_MeasureV2, len(mv.Measures))
+	for _, mm := range mv.Measures {
+		measures[mm.Name] = mm
+	}
+
+	// Collect the referenced measures in dependency order
+	var base, derived []*runtimev1.MetricsViewSpec_MeasureV2
+	seen := make(map[string]bool)
+	var visit func(m *runtimev1.MetricsViewSpec_MeasureV2) error
+	visit = func(m *runtimev1.MetricsViewSpec_MeasureV2) error {
+		if seen[m.Name] {
+			return nil
+		}
+		seen[m.Name] = true
+
+		if len(m.ReferencedMeasures) == 0 && m.Window == nil {
+			base = append(base, m)
+			return nil
+		}
+		for _, ref := range m.ReferencedMeasures {
+			rm, ok := measures[ref]
+			if !ok {
+				return fmt.Errorf("referenced measure %q not found", ref)
+			}
+			if err := visit(rm); err != nil {
+				return err
+			}
+		}
+		derived = append(derived, m)
+		return nil
+	}
+	if err := visit(m); err != nil {
+		return err
+	}
+
+	// Windows are partitioned by the other dimensions in the query, so we group by all of them if any derived measure needs it
+	var partitionCols []string
+	for _, mm := range derived {
+		if mm.Window == nil {
+			continue
+		}
+		if mv.TimeDimension == "" {
+			return fmt.Errorf("measure %q has a window, which requires a time dimension", mm.Name)
+		}
+		if mm.Window.Partition && partitionCols == nil {
+			seenCols := make(map[string]bool, len(mv.Dimensions))
+			for _, d := range mv.Dimensions {
+				col := safeSQLName(d.Column)
+				if !seenCols[col] && !strings.EqualFold(d.Column, mv.TimeDimension) {
+					seenCols[col] = true
+					partitionCols = append(partitionCols, col)
+				}
+			}
+		}
+	}
+
+	var cols, groupCols []string
+	if mv.TimeDimension != "" {
+		cols = append(cols, safeSQLName(mv.TimeDimension))
+	}
+	cols = append(cols, partitionCols...)
+	for i := range cols {
+		groupCols = append(groupCols, strconv.Itoa(i+1))
+	}
+	for _, mm := range base {
+		cols = append(cols, fmt.Sprintf("%s AS %s", mm.Expression, safeSQLName(mm.Name)))
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s", strings.Join(cols, ", "), safeSQLName(t.Name))
+	if len(groupCols) > 0 {
+		query += " GROUP BY " + strings.Join(groupCols, ", ")
+	}
+
+	for _, mm := range derived {
+		expr := mm.Expression
+		if mm.Window != nil {
+			var over []string
+			if mm.Window.Partition && len(partitionCols) > 0 {
+				over = append(over, "PARTITION BY "+strings.Join(partitionCols, ", "))
+			}
+			over = append(over, "ORDER BY "+safeSQLName(mv.TimeDimension))
+			if mm.Window.Frame != "" {
+				over = append(over, mm.Window.Frame)
+			}
+			expr = fmt.Sprintf("%s OVER (%s)", expr, strings.Join(over, " "))
+		}
+		query = fmt.Sprintf("SELECT *, %s AS %s FROM (%s)", expr, safeSQLName(mm.Name), query)
+	}
+
+	return olap.Exec(ctx, &drivers.Statement{
+		Query:  query,
+		DryRun: true,
+	})
+}
@@ -0,0 +1,48 @@
+package reconcilers
+
+import (
+	"context"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	_ "github.com/rilldata/rill/runtime/drivers/duckdb"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestValidateDerivedMeasure(t *testing.T) {
+	ctx := context.Background()
+	conn, err := drivers.Open("duckdb", map[string]any{"dsn": "?access_mode=read_write"}, false, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	defer conn.Close()
+	olap, ok := conn.AsOLAP("")
+	require.True(t, ok)
+
+	err = olap.Exec(ctx, &drivers.Statement{Query: "CREATE TABLE bids AS SELECT now() AS ts, 'a' AS pub, 1 AS price"})
+	require.NoError(t, err)
+	tbl := &drivers.Table{Name: "bids"}
+
+	revenue := &runtimev1.MetricsViewSpec_MeasureV2{Name: "revenue", Expression: "sum(price)"}
+	running := &runtimev1.MetricsViewSpec_MeasureV2{
+		Name:               "running",
+		Expression:         "sum(revenue)",
+		ReferencedMeasures: []string{"revenue"},
+		Window:             &runtimev1.MetricsViewSpec_MeasureWindow{Partition: true, Frame: "ROWS BETWEEN 6 PRECEDING AND CURRENT ROW"},
+	}
+	mv := &runtimev1.MetricsViewSpec{
+		TimeDimension: "ts",
+		Dimensions:    []*runtimev1.MetricsViewSpec_DimensionV2{{Name: "pub", Column: "pub"}},
+		Measures:      []*runtimev1.MetricsViewSpec_MeasureV2{revenue, running},
+	}
+	require.NoError(t, validateDerivedMeasure(ctx, olap, tbl, mv, running))
+
+	// The partition columns are part of the dry run
+	mv.Dimensions = []*runtimev1.MetricsViewSpec_DimensionV2{{Name: "pub", Column: "missing"}}
+	require.Error(t, validateDerivedMeasure(ctx, olap, tbl, mv, running))
+
+	// Windows require a time dimension
+	mv.TimeDimension = ""
+	require.ErrorContains(t, validateDerivedMeasure(ctx, olap, tbl, mv, running), "requires a time dimension")
+}
@@ -43,7 +43,7 @@ func (r *MigrationReconciler) AssignState(from, to *runtimev1.Resource) error {
 	if a == nil || b == nil {
 		return fmt.Errorf("cannot assign state from %T to %T", from.Resource, to.Resource)
 	}
-	b.Spec = a.Spec
+	b.State = a.State
 	return nil
 }
 
@@ -131,6 +131,7 @@ func (r *MigrationReconciler) executeMigration(ctx context.Context, self *runtim
 				State: res.Resource.(*runtimev1.Resource_Model).Model.State,
 			}, nil
 		},
+		Macro: resolveMacro(ctx, r.C),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to resolve template: %w", err)
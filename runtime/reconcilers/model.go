@@ -12,6 +12,7 @@ import (
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	compilerv1 "github.com/rilldata/rill/runtime/compilers/rillv1"
+	"github.com/rilldata/rill/runtime/drivers"
 	"golang.org/x/exp/slog"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -50,7 +51,7 @@ func (r *ModelReconciler) AssignState(from, to *runtimev1.Resource) error {
 	if a == nil || b == nil {
 		return fmt.Errorf("cannot assign state from %T to %T", from.Resource, to.Resource)
 	}
-	b.Spec = a.Spec
+	b.State = a.State
 	return nil
 }
 
@@ -102,9 +103,10 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 	}
 
 	// Check refs - stop if any of them are invalid
+	// (Snapshot tables are retained since their history can't be rebuilt.)
 	err = checkRefs(ctx, r.C, self.Meta.Refs)
 	if err != nil {
-		if !model.Spec.StageChanges && model.State.Table != "" {
+		if !model.Spec.StageChanges && !model.Spec.Snapshot && model.State.Table != "" {
 			// Remove previously ingested table
 			if t, ok := olapTableInfo(ctx, r.C, model.State.Connector, model.State.Table); ok {
 				olapDropTableIfExists(ctx, r.C, model.State.Connector, model.State.Table, t.View)
@@ -113,6 +115,9 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 			model.State.Table = ""
 			model.State.SpecHash = ""
 			model.State.RefreshedOn = nil
+			model.State.IncrementalSpecHash = ""
+			model.State.IncrementalWatermark = nil
+			model.State.ColumnLineage = nil
 			err = r.C.UpdateState(ctx, self.Meta.Name, self)
 			if err != nil {
 				r.C.Logger.Error("refs check: failed to update state", slog.Any("err", err))
@@ -127,6 +132,22 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		return runtime.ReconcileResult{Err: fmt.Errorf("failed to compute hash: %w", err)}
 	}
 
+	// For incremental models, we also compute a hash that excludes refs.
+	// Changes to refs trigger a new incremental run, but other changes to the spec require a full refresh.
+	// For snapshot models, only changes to the snapshot config require a full refresh since it discards the table's history.
+	var incrementalHash string
+	if model.Spec.Incremental {
+		incrementalHash, err = r.executionSpecHash(ctx, nil, model.Spec)
+		if err != nil {
+			return runtime.ReconcileResult{Err: fmt.Errorf("failed to compute hash: %w", err)}
+		}
+	} else if model.Spec.Snapshot {
+		incrementalHash, err = r.snapshotSpecHash(model.Spec)
+		if err != nil {
+			return runtime.ReconcileResult{Err: fmt.Errorf("failed to compute hash: %w", err)}
+		}
+	}
+
 	// Compute next time to refresh based on the RefreshSchedule (if any)
 	var refreshOn time.Time
 	if model.State.RefreshedOn != nil {
@@ -138,7 +159,7 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 
 	// Determine if we should materialize
 	var materialize bool
-	if model.Spec.Materialize != nil {
+	if model.Spec.Materialize != nil || model.Spec.Incremental || model.Spec.Snapshot {
 		materialize = true
 	}
 
@@ -147,6 +168,7 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 
 	// Decide if we should trigger an update
 	trigger := model.Spec.Trigger
+	trigger = trigger || model.Spec.TriggerFullRefresh
 	trigger = trigger || model.State.Table == ""
 	trigger = trigger || model.State.Table != tableName
 	trigger = trigger || model.State.RefreshedOn == nil
@@ -158,7 +180,8 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 	// This is useful to support keystroke-by-keystroke editing.
 	var delayedMaterializeOn time.Time
 	var delayedMaterialize bool
-	if !trigger && materialize && t.View {
+	// (Snapshot tables may be exposed as views with external table storage, but they're never delayed.)
+	if !trigger && materialize && t.View && !model.Spec.Snapshot {
 		delayedMaterializeOn, delayedMaterialize = r.delayedMaterializeTime(model.Spec, model.State.RefreshedOn.AsTime())
 	}
 
@@ -171,6 +194,15 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		return runtime.ReconcileResult{Retrigger: refreshOn}
 	}
 
+	// Determine if we can run incrementally, i.e. insert into or merge with the existing table instead of replacing it
+	// (With external table storage, DuckDB tables are exposed as views. Snapshot models are never views, so we allow views for them to not lose their history.)
+	incremental := (model.Spec.Incremental || model.Spec.Snapshot) && !model.Spec.TriggerFullRefresh
+	incremental = incremental && exists && (!t.View || model.Spec.Snapshot)
+	incremental = incremental && model.State.Connector == model.Spec.Connector
+	incremental = incremental && model.State.Table == tableName
+	incremental = incremental && model.State.IncrementalSpecHash == incrementalHash
+	incremental = incremental && model.State.IncrementalWatermark != nil
+
 	// If the Connector was changed, drop data in the old connector
 	if model.State.Table != "" && model.State.Connector != model.Spec.Connector {
 		if t, ok := olapTableInfo(ctx, r.C, model.State.Connector, model.State.Table); ok {
@@ -182,7 +214,8 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 	}
 
 	// Always stage changes if running a delayed materialization
-	stage := model.Spec.StageChanges || delayedMaterialize
+	// Incremental runs never stage changes since they build on the existing table
+	stage := (model.Spec.StageChanges || delayedMaterialize) && !incremental
 	stagingTableName := tableName
 	if stage {
 		stagingTableName = r.stagingTableName(tableName)
@@ -190,7 +223,7 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 
 	// Determine if we should delay materialization (note difference between "delayedMaterialize" and "delayingMaterialize")
 	delayingMaterialize := false
-	if materialize && model.State.SpecHash != hash && model.Spec.MaterializeDelaySeconds > 0 {
+	if materialize && !model.Spec.Incremental && !model.Spec.Snapshot && model.State.SpecHash != hash && model.Spec.MaterializeDelaySeconds > 0 {
 		delayingMaterialize = true
 		materialize = false
 	}
@@ -200,14 +233,20 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		return runtime.ReconcileResult{Err: fmt.Errorf("internal error: delayed and delaying materialization")}
 	}
 
-	// Drop the staging table if it exists
+	// Drop the staging table if it exists (unless running incrementally, where it is the main table)
 	connector := model.Spec.Connector
-	if t, ok := olapTableInfo(ctx, r.C, connector, stagingTableName); ok {
-		olapDropTableIfExists(ctx, r.C, connector, t.Name, t.View)
+	if !incremental {
+		if t, ok := olapTableInfo(ctx, r.C, connector, stagingTableName); ok {
+			olapDropTableIfExists(ctx, r.C, connector, t.Name, t.View)
+		}
 	}
 
 	// Create the model
-	createErr := r.createModel(ctx, self, stagingTableName, !materialize)
+	startedOn := time.Now()
+	sql, createErr := r.resolveSQL(ctx, self, incremental && model.Spec.Incremental)
+	if createErr == nil {
+		createErr = r.createModel(ctx, self, sql, stagingTableName, !materialize, incremental)
+	}
 	if createErr != nil {
 		createErr = fmt.Errorf("failed to create model: %w", createErr)
 	}
@@ -223,6 +262,7 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 	// If ctx was cancelled, we cleanup and exit
 	// If StageChanges is true, we retain the existing table, but still return the error.
 	// If StageChanges is false, we clear the existing table and return the error.
+	// If running incrementally, we retain the existing table and state, but still return the error.
 
 	// ctx will only be cancelled in cases where the Controller guarantees a new call to Reconcile.
 	// We just clean up temp tables and state, then return.
@@ -242,6 +282,15 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		model.State.Table = tableName
 		model.State.SpecHash = hash
 		model.State.RefreshedOn = timestamppb.Now()
+		model.State.IncrementalSpecHash = incrementalHash
+		model.State.IncrementalWatermark = nil
+		if model.Spec.Incremental || model.Spec.Snapshot {
+			model.State.IncrementalWatermark = timestamppb.New(startedOn)
+		}
+		model.State.ColumnLineage = modelColumnLineage(ctx, r.C, self.Meta.Refs, connector, tableName, sql)
+	} else if incremental {
+		// Failed incremental run, the existing table and state are retained
+		update = false
 	} else if model.Spec.StageChanges {
 		// Failed ingestion to staging table
 		olapDropTableIfExists(cleanupCtx, r.C, connector, stagingTableName, !materialize)
@@ -253,6 +302,9 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		model.State.Table = ""
 		model.State.SpecHash = ""
 		model.State.RefreshedOn = nil
+		model.State.IncrementalSpecHash = ""
+		model.State.IncrementalWatermark = nil
+		model.State.ColumnLineage = nil
 	}
 	if update {
 		err = r.C.UpdateState(ctx, self.Meta.Name, self)
@@ -266,8 +318,8 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		return runtime.ReconcileResult{Err: createErr}
 	}
 
-	// Reset spec.Trigger
-	if model.Spec.Trigger {
+	// Reset spec.Trigger and spec.TriggerFullRefresh
+	if model.Spec.Trigger || model.Spec.TriggerFullRefresh {
 		err := r.setTriggerFalse(ctx, n)
 		if err != nil {
 			return runtime.ReconcileResult{Err: err}
@@ -359,10 +411,66 @@ func (r *ModelReconciler) executionSpecHash(ctx context.Context, refs []*runtime
 		return "", err
 	}
 
+	// Only written for incremental and snapshot models to not change the hash of existing models
+	if spec.Incremental {
+		err = binary.Write(hash, binary.BigEndian, spec.Incremental)
+		if err != nil {
+			return "", err
+		}
+
+		for _, k := range spec.UniqueKey {
+			_, err = hash.Write([]byte(k))
+			if err != nil {
+				return "", err
+			}
+		}
+	}
+	if spec.Snapshot {
+		snapshotHash, err := r.snapshotSpecHash(spec)
+		if err != nil {
+			return "", err
+		}
+		_, err = hash.Write([]byte(snapshotHash))
+		if err != nil {
+			return "", err
+		}
+	}
+
 	return hex.EncodeToString(hash.Sum(nil)), nil
 }
 
-// setTriggerFalse sets the model's spec.Trigger to false.
+// snapshotSpecHash computes a hash of the model properties that determine the structure of a snapshot model's table.
+func (r *ModelReconciler) snapshotSpecHash(spec *runtimev1.ModelSpec) (string, error) {
+	hash := md5.New()
+
+	_, err := hash.Write([]byte(spec.Connector))
+	if err != nil {
+		return "", err
+	}
+
+	for _, k := range spec.UniqueKey {
+		_, err = hash.Write([]byte(k))
+		if err != nil {
+			return "", err
+		}
+	}
+
+	_, err = hash.Write([]byte(spec.SnapshotUpdatedAt))
+	if err != nil {
+		return "", err
+	}
+
+	for _, c := range spec.SnapshotCheckColumns {
+		_, err = hash.Write([]byte(c))
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// setTriggerFalse sets the model's spec.Trigger and spec.TriggerFullRefresh to false.
 // Unlike the State, the Spec may be edited concurrently with a Reconcile call, so we need to read and edit it under a lock.
 func (r *ModelReconciler) setTriggerFalse(ctx context.Context, n *runtimev1.ResourceName) error {
 	r.C.Lock(ctx)
@@ -379,53 +487,69 @@ func (r *ModelReconciler) setTriggerFalse(ctx context.Context, n *runtimev1.Reso
 	}
 
 	model.Spec.Trigger = false
+	model.Spec.TriggerFullRefresh = false
 	return r.C.UpdateSpec(ctx, self.Meta.Name, self)
 }
 
-// createModel creates or updates the model in the OLAP connector.
-func (r *ModelReconciler) createModel(ctx context.Context, self *runtimev1.Resource, tableName string, view bool) error {
+// resolveSQL resolves the model's SQL, including templating (if any).
+func (r *ModelReconciler) resolveSQL(ctx context.Context, self *runtimev1.Resource, incremental bool) (string, error) {
+	spec := self.Resource.(*runtimev1.Resource_Model).Model.Spec
+	state := self.Resource.(*runtimev1.Resource_Model).Model.State
+
+	if !spec.UsesTemplating {
+		return spec.Sql, nil
+	}
+
 	inst, err := r.C.Runtime.FindInstance(ctx, r.C.InstanceID)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	spec := self.Resource.(*runtimev1.Resource_Model).Model.Spec
-	state := self.Resource.(*runtimev1.Resource_Model).Model.State
-
-	var sql string
-	if spec.UsesTemplating {
-		sql, err = compilerv1.ResolveTemplate(spec.Sql, compilerv1.TemplateData{
-			User:      map[string]interface{}{},
-			Variables: inst.ResolveVariables(),
-			Self: compilerv1.TemplateResource{
-				Meta:  self.Meta,
-				Spec:  spec,
-				State: state,
-			},
-			Resolve: func(ref compilerv1.ResourceName) (string, error) {
-				return safeSQLName(ref.Name), nil
-			},
-			Lookup: func(name compilerv1.ResourceName) (compilerv1.TemplateResource, error) {
-				if name.Kind == compilerv1.ResourceKindUnspecified {
-					return compilerv1.TemplateResource{}, fmt.Errorf("can't resolve name %q without kind specified", name.Name)
-				}
-				res, err := r.C.Get(ctx, resourceNameFromCompiler(name), false)
-				if err != nil {
-					return compilerv1.TemplateResource{}, err
-				}
-				return compilerv1.TemplateResource{
-					Meta:  res.Meta,
-					Spec:  res.Resource.(*runtimev1.Resource_Model).Model.Spec,
-					State: res.Resource.(*runtimev1.Resource_Model).Model.State,
-				}, nil
-			},
-		})
-		if err != nil {
-			return fmt.Errorf("failed to resolve template: %w", err)
-		}
-	} else {
-		sql = spec.Sql
+	var watermark string
+	if state.IncrementalWatermark != nil {
+		watermark = state.IncrementalWatermark.AsTime().Format(time.RFC3339Nano)
+	}
+
+	sql, err := compilerv1.ResolveTemplate(spec.Sql, compilerv1.TemplateData{
+		User:        map[string]interface{}{},
+		Variables:   inst.ResolveVariables(),
+		ExtraProps:  map[string]any{"watermark": watermark},
+		Incremental: incremental,
+		Self: compilerv1.TemplateResource{
+			Meta:  self.Meta,
+			Spec:  spec,
+			State: state,
+		},
+		Resolve: func(ref compilerv1.ResourceName) (string, error) {
+			return safeSQLName(ref.Name), nil
+		},
+		Lookup: func(name compilerv1.ResourceName) (compilerv1.TemplateResource, error) {
+			if name.Kind == compilerv1.ResourceKindUnspecified {
+				return compilerv1.TemplateResource{}, fmt.Errorf("can't resolve name %q without kind specified", name.Name)
+			}
+			res, err := r.C.Get(ctx, resourceNameFromCompiler(name), false)
+			if err != nil {
+				return compilerv1.TemplateResource{}, err
+			}
+			return compilerv1.TemplateResource{
+				Meta:  res.Meta,
+				Spec:  res.Resource.(*runtimev1.Resource_Model).Model.Spec,
+				State: res.Resource.(*runtimev1.Resource_Model).Model.State,
+			}, nil
+		},
+		Macro: resolveMacro(ctx, r.C),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve template: %w", err)
 	}
+	return sql, nil
+}
+
+// createModel creates or updates the model in the OLAP connector using the resolved SQL.
+// If incremental is true, the model's output is inserted into (or merged with) the existing table.
+// Snapshot models are always merged with the existing table (if any), so the table must be dropped beforehand to rebuild it.
+func (r *ModelReconciler) createModel(ctx context.Context, self *runtimev1.Resource, sql, tableName string, view, incremental bool) error {
+	spec := self.Resource.(*runtimev1.Resource_Model).Model.Spec
 
 	olap, release, err := r.C.AcquireOLAP(ctx, spec.Connector)
 	if err != nil {
@@ -444,5 +568,20 @@ func (r *ModelReconciler) createModel(ctx context.Context, self *runtimev1.Resou
 		defer cancel()
 	}
 
+	if spec.Snapshot {
+		return olap.SnapshotTableAsSelect(ctx, tableName, &drivers.SnapshotOptions{
+			UniqueKey:    spec.UniqueKey,
+			UpdatedAt:    spec.SnapshotUpdatedAt,
+			CheckColumns: spec.SnapshotCheckColumns,
+		}, sql)
+	}
+
+	if incremental {
+		if len(spec.UniqueKey) == 0 {
+			return olap.InsertTableAsSelect(ctx, tableName, true, sql)
+		}
+		return olap.MergeTableAsSelect(ctx, tableName, spec.UniqueKey, sql)
+	}
+
 	return olap.CreateTableAsSelect(ctx, tableName, view, sql)
 }
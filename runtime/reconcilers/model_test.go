@@ -0,0 +1,53 @@
+package reconcilers_test
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIncrementalModel(t *testing.T) {
+	rt, ctrl := newController(t)
+	id := ctrl.InstanceID
+
+	truth := true
+	appendName := &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "append_model"}
+	mergeName := &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "merge_model"}
+
+	// The append model adds a row on each incremental run, the merge model replaces its only row
+	createModel(t, ctrl, appendName, &runtimev1.ModelSpec{
+		Connector:      "duckdb",
+		Sql:            "SELECT {{ if incremental }} 2 {{ else }} 1 {{ end }} AS id",
+		UsesTemplating: true,
+		Materialize:    &truth,
+		Incremental:    true,
+	})
+	createModel(t, ctrl, mergeName, &runtimev1.ModelSpec{
+		Connector:      "duckdb",
+		Sql:            "SELECT 1 AS id, {{ if incremental }} 'incremental' {{ else }} 'full' {{ end }} AS val",
+		UsesTemplating: true,
+		Materialize:    &truth,
+		Incremental:    true,
+		UniqueKey:      []string{"id"},
+	})
+	v1 := waitForModel(t, ctrl, appendName, 0)
+	v2 := waitForModel(t, ctrl, mergeName, 0)
+	require.Equal(t, []string{"1"}, queryValues(t, rt, id, "SELECT id::VARCHAR FROM append_model ORDER BY id"))
+	require.Equal(t, []string{"full"}, queryValues(t, rt, id, "SELECT val FROM merge_model"))
+
+	// The second run appends to or merges with the existing table
+	createRefreshTrigger(t, ctrl, false, appendName, mergeName)
+	v1 = waitForModel(t, ctrl, appendName, v1)
+	v2 = waitForModel(t, ctrl, mergeName, v2)
+	require.Equal(t, []string{"1", "2"}, queryValues(t, rt, id, "SELECT id::VARCHAR FROM append_model ORDER BY id"))
+	require.Equal(t, []string{"incremental"}, queryValues(t, rt, id, "SELECT val FROM merge_model"))
+
+	// A full refresh rebuilds the tables from scratch
+	createRefreshTrigger(t, ctrl, true, appendName, mergeName)
+	waitForModel(t, ctrl, appendName, v1)
+	waitForModel(t, ctrl, mergeName, v2)
+	require.Equal(t, []string{"1"}, queryValues(t, rt, id, "SELECT id::VARCHAR FROM append_model ORDER BY id"))
+	require.Equal(t, []string{"full"}, queryValues(t, rt, id, "SELECT val FROM merge_model"))
+}
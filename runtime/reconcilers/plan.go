@@ -0,0 +1,394 @@
+package reconcilers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing/fstest"
+	"time"
+
+	"github.com/bmatcuk/doublestar/v4"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	compilerv1 "github.com/rilldata/rill/runtime/compilers/rillv1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"golang.org/x/exp/slices"
+)
+
+// PlanProject parses the project and diffs the parsed resources against the resources in the catalog.
+// It returns the changes that a reconcile of the project parser would make, without making any of them.
+// If files is not empty, the project is parsed from files (keyed by path) instead of from the instance's repo.
+func PlanProject(ctx context.Context, c *runtime.Controller, files map[string]string) ([]*runtimev1.PlannedChange, []*runtimev1.ParseError, error) {
+	self, err := c.Get(ctx, GlobalProjectParserName, false)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get project parser: %w", err)
+	}
+	pp := self.GetProjectParser()
+
+	var repo drivers.RepoStore
+	if len(files) > 0 {
+		repo = newPlanRepo(files)
+	} else {
+		instanceRepo, release, err := c.Runtime.Repo(ctx, c.InstanceID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to access repo: %w", err)
+		}
+		defer release()
+		repo = instanceRepo
+	}
+
+	inst, err := c.Runtime.FindInstance(ctx, c.InstanceID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find instance: %w", err)
+	}
+
+	var duckdbConnectors []string
+	for _, connector := range inst.Connectors {
+		if connector.Type == "duckdb" {
+			duckdbConnectors = append(duckdbConnectors, connector.Name)
+		}
+	}
+
+	parser, err := compilerv1.Parse(ctx, repo, c.InstanceID, inst.OLAPConnector, duckdbConnectors)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse repo: %w", err)
+	}
+
+	resources, err := c.List(ctx, "", false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p := &planner{
+		c:       c,
+		pp:      pp,
+		changes: make(map[compilerv1.ResourceName]*runtimev1.PlannedChange),
+	}
+	err = p.plan(ctx, self, parser, resources)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	changes := make([]*runtimev1.PlannedChange, 0, len(p.changes))
+	for _, change := range p.changes {
+		changes = append(changes, change)
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		a, b := changes[i].Name, changes[j].Name
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Name < b.Name
+	})
+
+	return changes, parser.Errors, nil
+}
+
+// planner computes the changes that ProjectParserReconciler.reconcileResources would make to the catalog.
+type planner struct {
+	c       *runtime.Controller
+	pp      *runtimev1.ProjectParser
+	changes map[compilerv1.ResourceName]*runtimev1.PlannedChange
+}
+
+func (p *planner) plan(ctx context.Context, self *runtimev1.Resource, parser *compilerv1.Parser, resources []*runtimev1.Resource) error {
+	// Pass over all existing resources in the catalog (mirrors reconcileResources)
+	var deleteResources []*runtimev1.Resource
+	seen := make(map[compilerv1.ResourceName]bool, len(resources))
+	for _, rr := range resources {
+		cn, ok := parserResourceName(rr.Meta.Name)
+		if !ok {
+			continue
+		}
+		n := cn.Normalized()
+		def, ok := parser.Resources[n]
+		if ok {
+			seen[n] = true
+			applyProjectParserDefaults(p.pp, def)
+			err := p.planUpdate(ctx, def, rr)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if equalResourceName(rr.Meta.Owner, self.Meta.Name) {
+			deleteResources = append(deleteResources, rr)
+		}
+	}
+
+	// Parser outputs that were not seen are renamed or created
+	for _, def := range parser.Resources {
+		if seen[def.Name.Normalized()] {
+			continue
+		}
+
+		// NOTE: Like in attemptRename, the rename check happens before the ProjectParser's defaults are applied.
+		renamed := false
+		for idx, rr := range deleteResources {
+			if rr != nil && canRename(def, rr) {
+				p.add(def.Name, &runtimev1.PlannedChange{
+					Type:        runtimev1.PlannedChangeType_PLANNED_CHANGE_TYPE_RENAME,
+					RenamedFrom: rr.Meta.Name,
+					Reason:      fmt.Sprintf("renamed from %q", rr.Meta.Name.Name),
+				})
+				deleteResources[idx] = nil
+				renamed = true
+				break
+			}
+		}
+		if renamed {
+			continue
+		}
+
+		p.add(def.Name, &runtimev1.PlannedChange{
+			Type:   runtimev1.PlannedChangeType_PLANNED_CHANGE_TYPE_CREATE,
+			Reason: "added to the project",
+		})
+	}
+
+	for _, rr := range deleteResources {
+		if rr == nil {
+			continue
+		}
+		p.add(resourceNameToCompiler(rr.Meta.Name), &runtimev1.PlannedChange{
+			Type:   runtimev1.PlannedChangeType_PLANNED_CHANGE_TYPE_DELETE,
+			Reason: "removed from the project",
+		})
+	}
+
+	// Resources whose hashes depend on the state of a changed ref are also affected. Repeat until no more resources are affected.
+	for {
+		changed := false
+		for _, def := range parser.Resources {
+			if p.changes[def.Name.Normalized()] != nil {
+				continue
+			}
+
+			var typ runtimev1.PlannedChangeType
+			switch def.Name.Kind {
+			case compilerv1.ResourceKindModel:
+				typ = runtimev1.PlannedChangeType_PLANNED_CHANGE_TYPE_REBUILD
+			case compilerv1.ResourceKindSource:
+				typ = runtimev1.PlannedChangeType_PLANNED_CHANGE_TYPE_REINGEST
+			case compilerv1.ResourceKindMacro:
+				typ = runtimev1.PlannedChangeType_PLANNED_CHANGE_TYPE_UPDATE
+			default:
+				continue
+			}
+
+			for _, ref := range def.Refs {
+				change := p.changes[ref.Normalized()]
+				if change == nil || !affectsDependent(def.Name.Kind, ref.Kind, change.Type) {
+					continue
+				}
+				p.add(def.Name, &runtimev1.PlannedChange{
+					Type:   typ,
+					Reason: fmt.Sprintf("upstream %s %q changes", strings.ToLower(ref.Kind.String()), ref.Name),
+				})
+				changed = true
+				break
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+
+	return nil
+}
+
+// planUpdate plans the change for a parser resource definition that already exists in the catalog.
+// The ProjectParser's defaults must already be applied to def.
+func (p *planner) planUpdate(ctx context.Context, def *compilerv1.Resource, existing *runtimev1.Resource) error {
+	refs := make([]*runtimev1.ResourceName, 0, len(def.Refs))
+	for _, r := range def.Refs {
+		refs = append(refs, resourceNameFromCompiler(r))
+	}
+	refsChanged := !equalResourceNames(existing.Meta.Refs, refs)
+
+	var specChanged bool
+	switch def.Name.Kind {
+	case compilerv1.ResourceKindSource:
+		src := existing.GetSource()
+		hash, err := (&SourceReconciler{C: p.c}).ingestionSpecHash(ctx, refs, def.SourceSpec)
+		if err != nil {
+			return fmt.Errorf("failed to compute hash: %w", err)
+		}
+		if src.State.SpecHash != hash {
+			reason := "ingestion spec changed"
+			if src.State.SpecHash == "" {
+				reason = "not ingested yet"
+			}
+			p.add(def.Name, &runtimev1.PlannedChange{
+				Type:   runtimev1.PlannedChangeType_PLANNED_CHANGE_TYPE_REINGEST,
+				Reason: reason,
+			})
+			return nil
+		}
+		specChanged = !equalSourceSpec(src.Spec, def.SourceSpec)
+	case compilerv1.ResourceKindModel:
+		model := existing.GetModel()
+		hash, err := (&ModelReconciler{C: p.c}).executionSpecHash(ctx, refs, def.ModelSpec)
+		if err != nil {
+			return fmt.Errorf("failed to compute hash: %w", err)
+		}
+		if model.State.SpecHash != hash {
+			reason := "execution spec or refs changed"
+			if model.State.SpecHash == "" {
+				reason = "not built yet"
+			}
+			p.add(def.Name, &runtimev1.PlannedChange{
+				Type:   runtimev1.PlannedChangeType_PLANNED_CHANGE_TYPE_REBUILD,
+				Reason: reason,
+			})
+			return nil
+		}
+		specChanged = !equalModelSpec(model.Spec, def.ModelSpec)
+	case compilerv1.ResourceKindMetricsView:
+		specChanged = !equalMetricsViewSpec(existing.GetMetricsView().Spec, def.MetricsViewSpec)
+	case compilerv1.ResourceKindMigration:
+		specChanged = !equalMigrationSpec(existing.GetMigration().Spec, def.MigrationSpec)
+	case compilerv1.ResourceKindReport:
+		specChanged = !equalReportSpec(existing.GetReport().Spec, def.ReportSpec)
+	case compilerv1.ResourceKindAlert:
+		specChanged = !equalAlertSpec(existing.GetAlert().Spec, def.AlertSpec)
+	case compilerv1.ResourceKindTest:
+		specChanged = !equalTestSpec(existing.GetTest().Spec, def.TestSpec)
+	case compilerv1.ResourceKindMacro:
+		specChanged = !equalMacroSpec(existing.GetMacro().Spec, def.MacroSpec)
+	default:
+		return fmt.Errorf("unknown resource kind %q", def.Name.Kind)
+	}
+
+	if specChanged || refsChanged {
+		reason := "spec changed"
+		if !specChanged {
+			reason = "refs changed"
+		}
+		p.add(def.Name, &runtimev1.PlannedChange{
+			Type:   runtimev1.PlannedChangeType_PLANNED_CHANGE_TYPE_UPDATE,
+			Reason: reason,
+		})
+	}
+
+	return nil
+}
+
+func (p *planner) add(n compilerv1.ResourceName, change *runtimev1.PlannedChange) {
+	change.Name = resourceNameFromCompiler(n)
+	p.changes[n.Normalized()] = change
+}
+
+// affectsDependent returns true if a change to a ref affects a resource that depends on it.
+// Models and macros depend on the state of all their refs, so they are affected by refs that get new data.
+// Models, sources and macros that call a macro are affected by any change to it.
+func affectsDependent(kind, refKind compilerv1.ResourceKind, t runtimev1.PlannedChangeType) bool {
+	if refKind == compilerv1.ResourceKindMacro {
+		return t != runtimev1.PlannedChangeType_PLANNED_CHANGE_TYPE_DELETE
+	}
+	if kind == compilerv1.ResourceKindSource {
+		return false
+	}
+	switch t {
+	case runtimev1.PlannedChangeType_PLANNED_CHANGE_TYPE_CREATE,
+		runtimev1.PlannedChangeType_PLANNED_CHANGE_TYPE_REINGEST,
+		runtimev1.PlannedChangeType_PLANNED_CHANGE_TYPE_REBUILD:
+		return true
+	}
+	return false
+}
+
+// planRepo is a read-only, in-memory drivers.RepoStore used to plan against project files that are not in the instance's repo.
+type planRepo struct {
+	fs      fstest.MapFS
+	updated time.Time
+}
+
+var _ drivers.RepoStore = (*planRepo)(nil)
+
+func newPlanRepo(files map[string]string) *planRepo {
+	mfs := make(fstest.MapFS, len(files))
+	for path, data := range files {
+		path = strings.TrimPrefix(filepath.ToSlash(filepath.Clean(path)), "/")
+		mfs[path] = &fstest.MapFile{Data: []byte(data)}
+	}
+	return &planRepo{fs: mfs, updated: time.Now()}
+}
+
+func (r *planRepo) Driver() string {
+	return "plan"
+}
+
+func (r *planRepo) Root() string {
+	return ""
+}
+
+func (r *planRepo) CommitHash(ctx context.Context) (string, error) {
+	return "", nil
+}
+
+func (r *planRepo) ListRecursive(ctx context.Context, glob string) ([]string, error) {
+	glob = strings.TrimPrefix(filepath.ToSlash(filepath.Clean(glob)), "/")
+
+	var paths []string
+	err := doublestar.GlobWalk(r.fs, glob, func(p string, d fs.DirEntry) error {
+		if !d.IsDir() {
+			paths = append(paths, "/"+p)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.Sort(paths)
+	return paths, nil
+}
+
+func (r *planRepo) Get(ctx context.Context, path string) (string, error) {
+	b, err := fs.ReadFile(r.fs, r.fsPath(path))
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (r *planRepo) Stat(ctx context.Context, path string) (*drivers.RepoObjectStat, error) {
+	_, err := fs.Stat(r.fs, r.fsPath(path))
+	if err != nil {
+		return nil, err
+	}
+	return &drivers.RepoObjectStat{LastUpdated: r.updated}, nil
+}
+
+func (r *planRepo) Put(ctx context.Context, path string, reader io.Reader) error {
+	return errPlanRepoReadOnly
+}
+
+func (r *planRepo) Rename(ctx context.Context, fromPath, toPath string) error {
+	return errPlanRepoReadOnly
+}
+
+func (r *planRepo) Delete(ctx context.Context, path string) error {
+	return errPlanRepoReadOnly
+}
+
+func (r *planRepo) Sync(ctx context.Context) error {
+	return nil
+}
+
+func (r *planRepo) Watch(ctx context.Context, cb drivers.WatchCallback) error {
+	return errPlanRepoReadOnly
+}
+
+func (r *planRepo) fsPath(path string) string {
+	return strings.TrimPrefix(filepath.ToSlash(filepath.Clean(path)), "/")
+}
+
+var errPlanRepoReadOnly = errors.New("plan repo is read-only")
@@ -0,0 +1,228 @@
+package reconcilers_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	compilerv1 "github.com/rilldata/rill/runtime/compilers/rillv1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/reconcilers"
+	"github.com/stretchr/testify/require"
+)
+
+// planTestFiles is a project with a source, a chain of two models and a metrics view on the first model.
+var planTestFiles = map[string]string{
+	"rill.yaml":    "",
+	"data/src.csv": "id,name\n1,a\n2,b\n",
+	"sources/src.yaml": `
+connector: local_file
+path: data/src.csv
+`,
+	"models/m1.sql": `SELECT * FROM {{ ref "src" }}`,
+	"models/m2.sql": `SELECT count(*) AS n FROM {{ ref "m1" }}`,
+	"dashboards/mv.yaml": `
+model: m1
+dimensions:
+  - name: name
+    column: name
+measures:
+  - name: total
+    expression: count(*)
+`,
+}
+
+func TestPlanProject(t *testing.T) {
+	_, ctrl := newProjectController(t, planTestFiles)
+
+	// Planning against the reconciled project finds no changes
+	require.Empty(t, planChanges(t, ctrl, planTestFiles))
+
+	tests := []struct {
+		name    string
+		edit    func(files map[string]string)
+		changes map[string]runtimev1.PlannedChangeType
+	}{
+		{
+			name: "create",
+			edit: func(files map[string]string) {
+				files["models/m3.sql"] = `SELECT id FROM {{ ref "src" }}`
+			},
+			changes: map[string]runtimev1.PlannedChangeType{
+				"m3": runtimev1.PlannedChangeType_PLANNED_CHANGE_TYPE_CREATE,
+			},
+		},
+		{
+			name: "delete",
+			edit: func(files map[string]string) {
+				delete(files, "models/m2.sql")
+			},
+			changes: map[string]runtimev1.PlannedChangeType{
+				"m2": runtimev1.PlannedChangeType_PLANNED_CHANGE_TYPE_DELETE,
+			},
+		},
+		{
+			name: "rename",
+			edit: func(files map[string]string) {
+				files["dashboards/mv_renamed.yaml"] = files["dashboards/mv.yaml"]
+				delete(files, "dashboards/mv.yaml")
+			},
+			changes: map[string]runtimev1.PlannedChangeType{
+				"mv_renamed": runtimev1.PlannedChangeType_PLANNED_CHANGE_TYPE_RENAME,
+			},
+		},
+		{
+			name: "source hash changed",
+			edit: func(files map[string]string) {
+				files["data/src2.csv"] = files["data/src.csv"]
+				files["sources/src.yaml"] = strings.Replace(files["sources/src.yaml"], "src.csv", "src2.csv", 1)
+			},
+			changes: map[string]runtimev1.PlannedChangeType{
+				"src": runtimev1.PlannedChangeType_PLANNED_CHANGE_TYPE_REINGEST,
+				"m1":  runtimev1.PlannedChangeType_PLANNED_CHANGE_TYPE_REBUILD,
+				"m2":  runtimev1.PlannedChangeType_PLANNED_CHANGE_TYPE_REBUILD,
+			},
+		},
+		{
+			name: "model hash changed",
+			edit: func(files map[string]string) {
+				files["models/m1.sql"] = `SELECT * FROM {{ ref "src" }} WHERE id > 0`
+			},
+			changes: map[string]runtimev1.PlannedChangeType{
+				"m1": runtimev1.PlannedChangeType_PLANNED_CHANGE_TYPE_REBUILD,
+				"m2": runtimev1.PlannedChangeType_PLANNED_CHANGE_TYPE_REBUILD,
+			},
+		},
+		{
+			name: "metrics view spec changed",
+			edit: func(files map[string]string) {
+				files["dashboards/mv.yaml"] = strings.Replace(files["dashboards/mv.yaml"], "count(*)", "sum(id)", 1)
+			},
+			changes: map[string]runtimev1.PlannedChangeType{
+				"mv": runtimev1.PlannedChangeType_PLANNED_CHANGE_TYPE_UPDATE,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := make(map[string]string, len(planTestFiles))
+			for k, v := range planTestFiles {
+				files[k] = v
+			}
+			tt.edit(files)
+
+			changes := planChanges(t, ctrl, files)
+			res := make(map[string]runtimev1.PlannedChangeType, len(changes))
+			for name, change := range changes {
+				res[name] = change.Type
+			}
+			require.Equal(t, tt.changes, res)
+
+			if c := changes["mv_renamed"]; c != nil {
+				require.Equal(t, "mv", c.RenamedFrom.Name)
+			}
+		})
+	}
+
+	// Planning doesn't change the catalog
+	require.Empty(t, planChanges(t, ctrl, planTestFiles))
+}
+
+func TestProjectParserRename(t *testing.T) {
+	rt, ctrl := newProjectController(t, planTestFiles)
+	ctx := context.Background()
+
+	mvName := &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: "mv"}
+	mv, err := ctrl.Get(ctx, mvName, true)
+	require.NoError(t, err)
+
+	require.NoError(t, rt.RenameFile(ctx, ctrl.InstanceID, "dashboards/mv.yaml", "dashboards/mv_renamed.yaml"))
+	require.NoError(t, ctrl.Reconcile(ctx, reconcilers.GlobalProjectParserName))
+
+	renamedName := &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: "mv_renamed"}
+	var renamed *runtimev1.Resource
+	require.Eventually(t, func() bool {
+		renamed, err = ctrl.Get(ctx, renamedName, true)
+		return err == nil && resourceReconciled(t, renamed)
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// The resource was renamed, not deleted and re-created
+	_, err = ctrl.Get(ctx, mvName, false)
+	require.ErrorIs(t, err, drivers.ErrResourceNotFound)
+	require.Equal(t, mv.Meta.CreatedOn.AsTime(), renamed.Meta.CreatedOn.AsTime())
+	require.Equal(t, []string{"/dashboards/mv_renamed.yaml"}, renamed.Meta.FilePaths)
+}
+
+// newProjectController writes files to a new instance's repo and waits for the project parser to reconcile them.
+func newProjectController(t *testing.T, files map[string]string) (*runtime.Runtime, *runtime.Controller) {
+	rt, ctrl := newController(t)
+	ctx := context.Background()
+
+	for path, data := range files {
+		require.NoError(t, rt.PutFile(ctx, ctrl.InstanceID, path, strings.NewReader(data), true, false))
+	}
+
+	pp := &runtimev1.Resource{Resource: &runtimev1.Resource_ProjectParser{ProjectParser: &runtimev1.ProjectParser{
+		Spec:  &runtimev1.ProjectParserSpec{Compiler: compilerv1.Version},
+		State: &runtimev1.ProjectParserState{},
+	}}}
+	require.NoError(t, ctrl.Create(ctx, reconcilers.GlobalProjectParserName, nil, nil, nil, pp))
+
+	// Wait for the source, both models and the metrics view to be reconciled
+	require.Eventually(t, func() bool {
+		rs, err := ctrl.List(ctx, "", true)
+		require.NoError(t, err)
+		if len(rs) != 5 {
+			return false
+		}
+		for _, r := range rs {
+			if !resourceReconciled(t, r) {
+				return false
+			}
+		}
+		return true
+	}, 10*time.Second, 10*time.Millisecond)
+
+	return rt, ctrl
+}
+
+// resourceReconciled returns true if r is idle and has been reconciled successfully.
+// Resources are idle from when they're created until they're scheduled, so it also checks their state.
+func resourceReconciled(t *testing.T, r *runtimev1.Resource) bool {
+	if r.Meta.ReconcileStatus != runtimev1.ReconcileStatus_RECONCILE_STATUS_IDLE {
+		return false
+	}
+	switch {
+	case r.GetSource() != nil:
+		if r.GetSource().State.Table == "" {
+			return false
+		}
+	case r.GetModel() != nil:
+		if r.GetModel().State.Table == "" {
+			return false
+		}
+	case r.GetMetricsView() != nil:
+		if r.GetMetricsView().State.ValidSpec == nil {
+			return false
+		}
+	}
+	require.Empty(t, r.Meta.ReconcileError, r.Meta.Name.Name)
+	return true
+}
+
+// planChanges plans the project in files and returns the planned changes keyed by resource name.
+func planChanges(t *testing.T, ctrl *runtime.Controller, files map[string]string) map[string]*runtimev1.PlannedChange {
+	changes, parseErrs, err := reconcilers.PlanProject(context.Background(), ctrl, files)
+	require.NoError(t, err)
+	require.Empty(t, parseErrs)
+
+	res := make(map[string]*runtimev1.PlannedChange, len(changes))
+	for _, c := range changes {
+		res[c.Name.Name] = c
+	}
+	return res
+}
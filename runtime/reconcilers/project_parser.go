@@ -10,6 +10,7 @@ import (
 	"github.com/rilldata/rill/runtime"
 	compilerv1 "github.com/rilldata/rill/runtime/compilers/rillv1"
 	"github.com/rilldata/rill/runtime/drivers"
+	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 	"golang.org/x/exp/slog"
 	"google.golang.org/protobuf/proto"
@@ -49,7 +50,7 @@ func (r *ProjectParserReconciler) AssignState(from, to *runtimev1.Resource) erro
 	if a == nil || b == nil {
 		return fmt.Errorf("cannot assign state from %T to %T", from.Resource, to.Resource)
 	}
-	b.Spec = a.Spec
+	b.State = a.State
 	return nil
 }
 
@@ -252,6 +253,11 @@ func (r *ProjectParserReconciler) reconcileProjectConfig(ctx context.Context, pa
 		vars[k] = v
 	}
 
+	// Editing the instance evicts its connections, so only do it if something changed
+	if maps.Equal(inst.ProjectVariables, vars) {
+		return nil
+	}
+
 	inst.ProjectVariables = vars
 	err = r.C.Runtime.EditInstance(ctx, inst)
 	if err != nil {
@@ -273,7 +279,12 @@ func (r *ProjectParserReconciler) reconcileResources(ctx context.Context, self *
 	}
 	seen := make(map[compilerv1.ResourceName]bool, len(resources))
 	for _, rr := range resources {
-		n := resourceNameToCompiler(rr.Meta.Name).Normalized()
+		// Skip resources that are not defined in code files (including self)
+		cn, ok := parserResourceName(rr.Meta.Name)
+		if !ok {
+			continue
+		}
+		n := cn.Normalized()
 		def, ok := parser.Resources[n]
 
 		// If the existing resource is in the parser output, update it.
@@ -410,27 +421,17 @@ func (r *ProjectParserReconciler) reconcileResourcesDiff(ctx context.Context, se
 // It does an insert if existing is nil, otherwise it does an update.
 // If existing is not nil, it compares values and only updates meta/spec values if they have changed (ensuring stable resource version numbers).
 func (r *ProjectParserReconciler) putParserResourceDef(ctx context.Context, self *runtimev1.Resource, def *compilerv1.Resource, existing *runtimev1.Resource) error {
-	// NOTE: Some resource config is not set in code files, but instead exist on the ProjectParser.
-	// E.g. stage_changes, stream_source_ingestion, materialize_model_default, etc.
-	// Those fields are applied to the resource specs in this function.
-	pp := self.GetProjectParser()
+	applyProjectParserDefaults(self.GetProjectParser(), def)
 
 	// Make resource spec to insert/update.
 	// res should be nil if no spec changes are needed.
 	var res *runtimev1.Resource
 	switch def.Name.Kind {
 	case compilerv1.ResourceKindSource:
-		def.SourceSpec.StageChanges = pp.Spec.StageChanges
-		def.SourceSpec.StreamIngestion = pp.Spec.SourceStreamIngestion
 		if existing == nil || !equalSourceSpec(existing.GetSource().Spec, def.SourceSpec) {
 			res = &runtimev1.Resource{Resource: &runtimev1.Resource_Source{Source: &runtimev1.SourceV2{Spec: def.SourceSpec}}}
 		}
 	case compilerv1.ResourceKindModel:
-		def.ModelSpec.StageChanges = pp.Spec.StageChanges
-		if def.ModelSpec.Materialize == nil {
-			def.ModelSpec.Materialize = &pp.Spec.ModelDefaultMaterialize
-		}
-		def.ModelSpec.MaterializeDelaySeconds = pp.Spec.ModelMaterializeDelaySeconds
 		if existing == nil || !equalModelSpec(existing.GetModel().Spec, def.ModelSpec) {
 			res = &runtimev1.Resource{Resource: &runtimev1.Resource_Model{Model: &runtimev1.ModelV2{Spec: def.ModelSpec}}}
 		}
@@ -442,6 +443,22 @@ func (r *ProjectParserReconciler) putParserResourceDef(ctx context.Context, self
 		if existing == nil || !equalMigrationSpec(existing.GetMigration().Spec, def.MigrationSpec) {
 			res = &runtimev1.Resource{Resource: &runtimev1.Resource_Migration{Migration: &runtimev1.Migration{Spec: def.MigrationSpec}}}
 		}
+	case compilerv1.ResourceKindReport:
+		if existing == nil || !equalReportSpec(existing.GetReport().Spec, def.ReportSpec) {
+			res = &runtimev1.Resource{Resource: &runtimev1.Resource_Report{Report: &runtimev1.Report{Spec: def.ReportSpec}}}
+		}
+	case compilerv1.ResourceKindAlert:
+		if existing == nil || !equalAlertSpec(existing.GetAlert().Spec, def.AlertSpec) {
+			res = &runtimev1.Resource{Resource: &runtimev1.Resource_Alert{Alert: &runtimev1.Alert{Spec: def.AlertSpec}}}
+		}
+	case compilerv1.ResourceKindTest:
+		if existing == nil || !equalTestSpec(existing.GetTest().Spec, def.TestSpec) {
+			res = &runtimev1.Resource{Resource: &runtimev1.Resource_Test{Test: &runtimev1.Test{Spec: def.TestSpec}}}
+		}
+	case compilerv1.ResourceKindMacro:
+		if existing == nil || !equalMacroSpec(existing.GetMacro().Spec, def.MacroSpec) {
+			res = &runtimev1.Resource{Resource: &runtimev1.Resource_Macro{Macro: &runtimev1.Macro{Spec: def.MacroSpec}}}
+		}
 	default:
 		panic(fmt.Errorf("unknown resource kind %q", def.Name.Kind))
 	}
@@ -485,18 +502,51 @@ func (r *ProjectParserReconciler) putParserResourceDef(ctx context.Context, self
 // It returns false if no rename was done.
 // In addition to renaming, it also updates the resource's meta to match the parser resource definition.
 func (r *ProjectParserReconciler) attemptRename(ctx context.Context, self *runtimev1.Resource, def *compilerv1.Resource, existing *runtimev1.Resource) (bool, error) {
-	newName := resourceNameFromCompiler(def.Name)
-	if existing.Meta.Name.Kind != newName.Kind {
+	if !canRename(def, existing) {
 		return false, nil
 	}
 
+	// NOTE: Not comparing owner and paths since changing those are allowed when renaming.
+
+	// Run rename
+	err := r.C.UpdateName(ctx, existing.Meta.Name, resourceNameFromCompiler(def.Name), self.Meta.Name, def.Paths)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// applyProjectParserDefaults applies config that is not set in code files, but instead exist on the ProjectParser, to a parser resource definition.
+// E.g. stage_changes, stream_source_ingestion, materialize_model_default, etc.
+func applyProjectParserDefaults(pp *runtimev1.ProjectParser, def *compilerv1.Resource) {
+	switch def.Name.Kind {
+	case compilerv1.ResourceKindSource:
+		def.SourceSpec.StageChanges = pp.Spec.StageChanges
+		def.SourceSpec.StreamIngestion = pp.Spec.SourceStreamIngestion
+	case compilerv1.ResourceKindModel:
+		def.ModelSpec.StageChanges = pp.Spec.StageChanges
+		if def.ModelSpec.Materialize == nil {
+			def.ModelSpec.Materialize = &pp.Spec.ModelDefaultMaterialize
+		}
+		def.ModelSpec.MaterializeDelaySeconds = pp.Spec.ModelMaterializeDelaySeconds
+	}
+}
+
+// canRename returns true if an existing resource can be renamed to a parser resource definition.
+// That's the case if it has the same kind, refs and spec.
+func canRename(def *compilerv1.Resource, existing *runtimev1.Resource) bool {
+	if existing.Meta.Name.Kind != resourceNameFromCompiler(def.Name).Kind {
+		return false
+	}
+
 	// Check refs are the same (note: refs are always sorted)
 	if len(existing.Meta.Refs) != len(def.Refs) {
-		return false, nil
+		return false
 	}
 	for i, n := range existing.Meta.Refs {
 		if resourceNameToCompiler(n) != def.Refs[i] {
-			return false, nil
+			return false
 		}
 	}
 
@@ -504,35 +554,43 @@ func (r *ProjectParserReconciler) attemptRename(ctx context.Context, self *runti
 	switch def.Name.Kind {
 	case compilerv1.ResourceKindSource:
 		if !equalSourceSpec(existing.GetSource().Spec, def.SourceSpec) {
-			return false, nil
+			return false
 		}
 	case compilerv1.ResourceKindModel:
 		if !equalModelSpec(existing.GetModel().Spec, def.ModelSpec) {
-			return false, nil
+			return false
 		}
 	case compilerv1.ResourceKindMetricsView:
 		if !equalMetricsViewSpec(existing.GetMetricsView().Spec, def.MetricsViewSpec) {
-			return false, nil
+			return false
 		}
 	case compilerv1.ResourceKindMigration:
 		if !equalMigrationSpec(existing.GetMigration().Spec, def.MigrationSpec) {
-			return false, nil
+			return false
+		}
+	case compilerv1.ResourceKindReport:
+		if !equalReportSpec(existing.GetReport().Spec, def.ReportSpec) {
+			return false
+		}
+	case compilerv1.ResourceKindAlert:
+		if !equalAlertSpec(existing.GetAlert().Spec, def.AlertSpec) {
+			return false
+		}
+	case compilerv1.ResourceKindTest:
+		if !equalTestSpec(existing.GetTest().Spec, def.TestSpec) {
+			return false
+		}
+	case compilerv1.ResourceKindMacro:
+		if !equalMacroSpec(existing.GetMacro().Spec, def.MacroSpec) {
+			return false
 		}
 	default:
 		// NOTE: No panic because we don't need to support renames for all resource kinds.
 		// If renaming is not supported, it will just do a delete + insert instead.
-		return false, nil
-	}
-
-	// NOTE: Not comparing owner and paths since changing those are allowed when renaming.
-
-	// Run rename
-	err := r.C.UpdateName(ctx, existing.Meta.Name, newName, self.Meta.Name, def.Paths)
-	if err != nil {
-		return false, err
+		return false
 	}
 
-	return true, nil
+	return true
 }
 
 func resourceNameFromCompiler(name compilerv1.ResourceName) *runtimev1.ResourceName {
@@ -545,23 +603,49 @@ func resourceNameFromCompiler(name compilerv1.ResourceName) *runtimev1.ResourceN
 		return &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: name.Name}
 	case compilerv1.ResourceKindMigration:
 		return &runtimev1.ResourceName{Kind: runtime.ResourceKindMigration, Name: name.Name}
+	case compilerv1.ResourceKindReport:
+		return &runtimev1.ResourceName{Kind: runtime.ResourceKindReport, Name: name.Name}
+	case compilerv1.ResourceKindAlert:
+		return &runtimev1.ResourceName{Kind: runtime.ResourceKindAlert, Name: name.Name}
+	case compilerv1.ResourceKindTest:
+		return &runtimev1.ResourceName{Kind: runtime.ResourceKindTest, Name: name.Name}
+	case compilerv1.ResourceKindMacro:
+		return &runtimev1.ResourceName{Kind: runtime.ResourceKindMacro, Name: name.Name}
 	default:
 		panic(fmt.Errorf("unknown resource kind %q", name.Kind))
 	}
 }
 
 func resourceNameToCompiler(name *runtimev1.ResourceName) compilerv1.ResourceName {
+	n, ok := parserResourceName(name)
+	if !ok {
+		panic(fmt.Errorf("unknown resource kind %q", name.Kind))
+	}
+	return n
+}
+
+// parserResourceName converts a resource name to a compiler resource name.
+// It returns false for resource kinds that can't be defined in code files, like the project parser itself or triggers.
+func parserResourceName(name *runtimev1.ResourceName) (compilerv1.ResourceName, bool) {
 	switch name.Kind {
 	case runtime.ResourceKindSource:
-		return compilerv1.ResourceName{Kind: compilerv1.ResourceKindSource, Name: name.Name}
+		return compilerv1.ResourceName{Kind: compilerv1.ResourceKindSource, Name: name.Name}, true
 	case runtime.ResourceKindModel:
-		return compilerv1.ResourceName{Kind: compilerv1.ResourceKindModel, Name: name.Name}
+		return compilerv1.ResourceName{Kind: compilerv1.ResourceKindModel, Name: name.Name}, true
 	case runtime.ResourceKindMetricsView:
-		return compilerv1.ResourceName{Kind: compilerv1.ResourceKindMetricsView, Name: name.Name}
+		return compilerv1.ResourceName{Kind: compilerv1.ResourceKindMetricsView, Name: name.Name}, true
 	case runtime.ResourceKindMigration:
-		return compilerv1.ResourceName{Kind: compilerv1.ResourceKindMigration, Name: name.Name}
+		return compilerv1.ResourceName{Kind: compilerv1.ResourceKindMigration, Name: name.Name}, true
+	case runtime.ResourceKindReport:
+		return compilerv1.ResourceName{Kind: compilerv1.ResourceKindReport, Name: name.Name}, true
+	case runtime.ResourceKindAlert:
+		return compilerv1.ResourceName{Kind: compilerv1.ResourceKindAlert, Name: name.Name}, true
+	case runtime.ResourceKindTest:
+		return compilerv1.ResourceName{Kind: compilerv1.ResourceKindTest, Name: name.Name}, true
+	case runtime.ResourceKindMacro:
+		return compilerv1.ResourceName{Kind: compilerv1.ResourceKindMacro, Name: name.Name}, true
 	default:
-		panic(fmt.Errorf("unknown resource kind %q", name.Kind))
+		return compilerv1.ResourceName{}, false
 	}
 }
 
@@ -596,3 +680,19 @@ func equalMetricsViewSpec(a, b *runtimev1.MetricsViewSpec) bool {
 func equalMigrationSpec(a, b *runtimev1.MigrationSpec) bool {
 	return proto.Equal(a, b)
 }
+
+func equalReportSpec(a, b *runtimev1.ReportSpec) bool {
+	return proto.Equal(a, b)
+}
+
+func equalAlertSpec(a, b *runtimev1.AlertSpec) bool {
+	return proto.Equal(a, b)
+}
+
+func equalTestSpec(a, b *runtimev1.TestSpec) bool {
+	return proto.Equal(a, b)
+}
+
+func equalMacroSpec(a, b *runtimev1.MacroSpec) bool {
+	return proto.Equal(a, b)
+}
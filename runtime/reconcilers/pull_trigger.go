@@ -43,7 +43,7 @@ func (r *PullTriggerReconciler) AssignState(from, to *runtimev1.Resource) error
 	if a == nil || b == nil {
 		return fmt.Errorf("cannot assign state from %T to %T", from.Resource, to.Resource)
 	}
-	b.Spec = a.Spec
+	b.State = a.State
 	return nil
 }
 
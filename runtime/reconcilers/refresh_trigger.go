@@ -45,7 +45,7 @@ func (r *RefreshTriggerReconciler) AssignState(from, to *runtimev1.Resource) err
 	if a == nil || b == nil {
 		return fmt.Errorf("cannot assign state from %T to %T", from.Resource, to.Resource)
 	}
-	b.Spec = a.Spec
+	b.State = a.State
 	return nil
 }
 
@@ -95,9 +95,15 @@ func (r *RefreshTriggerReconciler) Reconcile(ctx context.Context, n *runtimev1.R
 		case runtime.ResourceKindSource:
 			source := res.GetSource()
 			source.Spec.Trigger = true
+			if trigger.Spec.FullRefresh {
+				source.Spec.TriggerFullRefresh = true
+			}
 		case runtime.ResourceKindModel:
 			model := res.GetModel()
 			model.Spec.Trigger = true
+			if trigger.Spec.FullRefresh {
+				model.Spec.TriggerFullRefresh = true
+			}
 		default:
 			updated = false
 		}
@@ -0,0 +1,338 @@
+package reconcilers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rilldata/rill/admin/email"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/queries"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const _defaultReportTimeout = 5 * time.Minute
+
+func init() {
+	runtime.RegisterReconcilerInitializer(runtime.ResourceKindReport, newReportReconciler)
+}
+
+type ReportReconciler struct {
+	C *runtime.Controller
+}
+
+func newReportReconciler(c *runtime.Controller) runtime.Reconciler {
+	return &ReportReconciler{C: c}
+}
+
+func (r *ReportReconciler) Close(ctx context.Context) error {
+	return nil
+}
+
+func (r *ReportReconciler) AssignSpec(from, to *runtimev1.Resource) error {
+	a := from.GetReport()
+	b := to.GetReport()
+	if a == nil || b == nil {
+		return fmt.Errorf("cannot assign spec from %T to %T", from.Resource, to.Resource)
+	}
+	b.Spec = a.Spec
+	return nil
+}
+
+func (r *ReportReconciler) AssignState(from, to *runtimev1.Resource) error {
+	a := from.GetReport()
+	b := to.GetReport()
+	if a == nil || b == nil {
+		return fmt.Errorf("cannot assign state from %T to %T", from.Resource, to.Resource)
+	}
+	b.State = a.State
+	return nil
+}
+
+func (r *ReportReconciler) ResetState(res *runtimev1.Resource) error {
+	res.GetReport().State = &runtimev1.ReportState{}
+	return nil
+}
+
+func (r *ReportReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceName) runtime.ReconcileResult {
+	self, err := r.C.Get(ctx, n, true)
+	if err != nil {
+		return runtime.ReconcileResult{Err: err}
+	}
+	rep := self.GetReport()
+	if rep == nil {
+		return runtime.ReconcileResult{Err: errors.New("not a report")}
+	}
+
+	// Nothing to clean up on deletion
+	if self.Meta.DeletedOn != nil {
+		return runtime.ReconcileResult{}
+	}
+
+	// Check refs - stop if any of them are invalid
+	err = checkRefs(ctx, r.C, self.Meta.Refs)
+	if err != nil {
+		return runtime.ReconcileResult{Err: err}
+	}
+
+	// Exit early if it's not time to run
+	run, runOn, err := scheduledRun(self, rep.State.LastRunOn, rep.Spec.RefreshSchedule, rep.Spec.Trigger)
+	if err != nil {
+		return runtime.ReconcileResult{Err: err}
+	}
+	if !run {
+		nextRunOn := timestampOrNil(runOn)
+		if !proto.Equal(rep.State.NextRunOn, nextRunOn) {
+			rep.State.NextRunOn = nextRunOn
+			err = r.C.UpdateState(ctx, self.Meta.Name, self)
+			if err != nil {
+				return runtime.ReconcileResult{Err: err}
+			}
+		}
+		return runtime.ReconcileResult{Retrigger: runOn}
+	}
+
+	// Run and deliver the report
+	runErr := r.executeAndDeliver(ctx, self.Meta.Name.Name, rep.Spec, self.Meta.Refs)
+	if runErr != nil && errors.Is(runErr, ctx.Err()) {
+		// The controller guarantees a new call to Reconcile, which will re-run the report.
+		return runtime.ReconcileResult{Err: runErr}
+	}
+
+	// Update state
+	now := time.Now()
+	runOn, err = nextRefreshTime(now, rep.Spec.RefreshSchedule)
+	if err != nil {
+		return runtime.ReconcileResult{Err: err}
+	}
+	rep.State.LastRunOn = timestamppb.New(now)
+	rep.State.NextRunOn = timestampOrNil(runOn)
+	rep.State.ExecutionCount++
+	rep.State.LastError = ""
+	if runErr != nil {
+		rep.State.LastError = runErr.Error()
+	}
+	err = r.C.UpdateState(ctx, self.Meta.Name, self)
+	if err != nil {
+		return runtime.ReconcileResult{Err: err}
+	}
+
+	// Reset spec.Trigger
+	if rep.Spec.Trigger {
+		err := setSpecTriggerFalse(ctx, r.C, n, func(res *runtimev1.Resource) error {
+			rep := res.GetReport()
+			if rep == nil {
+				return fmt.Errorf("not a report")
+			}
+			rep.Spec.Trigger = false
+			return nil
+		})
+		if err != nil {
+			return runtime.ReconcileResult{Err: err}
+		}
+	}
+
+	return runtime.ReconcileResult{Err: runErr, Retrigger: runOn}
+}
+
+// executeAndDeliver runs the report's query, exports the result and sends it to the report's recipients.
+func (r *ReportReconciler) executeAndDeliver(ctx context.Context, name string, spec *runtimev1.ReportSpec, refs []*runtimev1.ResourceName) error {
+	timeout := _defaultReportTimeout
+	if spec.TimeoutSeconds > 0 {
+		timeout = time.Duration(spec.TimeoutSeconds) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	q, err := r.buildQuery(ctx, spec, refs)
+	if err != nil {
+		return err
+	}
+
+	var filename string
+	buf := &bytes.Buffer{}
+	err = q.Export(ctx, r.C.Runtime, r.C.InstanceID, buf, &runtime.ExportOptions{
+		Format: spec.ExportFormat,
+		PreWriteHook: func(f string) error {
+			filename = f
+			return nil
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to export report: %w", err)
+	}
+	if filename == "" {
+		filename = name
+	}
+
+	ext, contentType := exportFileType(spec.ExportFormat)
+	attachment := &email.Attachment{
+		Filename:    fmt.Sprintf("%s_%s.%s", filename, time.Now().Format("20060102150405"), ext),
+		ContentType: contentType,
+		Data:        buf.Bytes(),
+	}
+
+	title := spec.Title
+	if title == "" {
+		title = name
+	}
+
+	var errs []error
+	if len(spec.EmailRecipients) > 0 {
+		client := r.C.Runtime.Email()
+		if client == nil {
+			errs = append(errs, errors.New("cannot send report emails: email is not configured for the runtime"))
+		} else {
+			for _, recipient := range spec.EmailRecipients {
+				err := client.SendScheduledReport(&email.ScheduledReport{
+					ToEmail:    recipient,
+					Title:      title,
+					ReportTime: time.Now(),
+					Attachment: attachment,
+				})
+				if err != nil {
+					errs = append(errs, fmt.Errorf("failed to send report to %q: %w", recipient, err))
+				}
+			}
+		}
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", attachment.ContentType)
+	header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", attachment.Filename))
+	header.Set("X-Rill-Report", name)
+	for _, u := range spec.WebhookUrls {
+		err := postWebhook(ctx, u, header, attachment.Data)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// buildQuery builds an exportable query from the report's query name and args.
+func (r *ReportReconciler) buildQuery(ctx context.Context, spec *runtimev1.ReportSpec, refs []*runtimev1.ResourceName) (runtime.Query, error) {
+	switch spec.QueryName {
+	case "MetricsViewToplist":
+		req := &runtimev1.MetricsViewToplistRequest{}
+		err := protojson.Unmarshal([]byte(spec.QueryArgsJson), req)
+		if err != nil {
+			return nil, fmt.Errorf("invalid query args: %w", err)
+		}
+
+		mv, updatedOn, err := resolveMetricsView(ctx, r.C, req.MetricsViewName, refs)
+		if err != nil {
+			return nil, err
+		}
+
+		// Apply the security policy of the report owner
+		security, err := resolveOwnerSecurity(r.C, spec.OwnerAttributes, mv, updatedOn)
+		if err != nil {
+			return nil, err
+		}
+		if !security.CanAccessField(req.DimensionName) {
+			return nil, fmt.Errorf("the report owner does not have access to dimension %q", req.DimensionName)
+		}
+		for _, m := range req.MeasureNames {
+			if !security.CanAccessField(m) {
+				return nil, fmt.Errorf("the report owner does not have access to measure %q", m)
+			}
+		}
+
+		var limit *int64
+		if req.Limit > 0 {
+			limit = &req.Limit
+		}
+		if spec.ExportLimit > 0 && (limit == nil || uint64(*limit) > spec.ExportLimit) {
+			l := int64(spec.ExportLimit)
+			limit = &l
+		}
+
+		return &queries.MetricsViewToplist{
+			MetricsViewName:    req.MetricsViewName,
+			DimensionName:      req.DimensionName,
+			MeasureNames:       req.MeasureNames,
+			InlineMeasures:     req.InlineMeasures,
+			TimeStart:          req.TimeStart,
+			TimeEnd:            req.TimeEnd,
+			Limit:              limit,
+			Offset:             req.Offset,
+			Sort:               req.Sort,
+			Filter:             req.Filter,
+			Where:              req.Where,
+			Having:             req.Having,
+			MetricsView:        mv,
+			ResolvedMVSecurity: security,
+		}, nil
+	case "MetricsViewRows":
+		req := &runtimev1.MetricsViewRowsRequest{}
+		err := protojson.Unmarshal([]byte(spec.QueryArgsJson), req)
+		if err != nil {
+			return nil, fmt.Errorf("invalid query args: %w", err)
+		}
+
+		mv, updatedOn, err := resolveMetricsView(ctx, r.C, req.MetricsViewName, refs)
+		if err != nil {
+			return nil, err
+		}
+
+		// Apply the security policy of the report owner
+		security, err := resolveOwnerSecurity(r.C, spec.OwnerAttributes, mv, updatedOn)
+		if err != nil {
+			return nil, err
+		}
+
+		var limit *int64
+		if req.Limit > 0 {
+			l := int64(req.Limit)
+			limit = &l
+		}
+		if spec.ExportLimit > 0 && (limit == nil || uint64(*limit) > spec.ExportLimit) {
+			l := int64(spec.ExportLimit)
+			limit = &l
+		}
+
+		return &queries.MetricsViewRows{
+			MetricsViewName:    req.MetricsViewName,
+			TimeStart:          req.TimeStart,
+			TimeEnd:            req.TimeEnd,
+			TimeGranularity:    req.TimeGranularity,
+			Filter:             req.Filter,
+			Where:              req.Where,
+			Sort:               req.Sort,
+			Limit:              limit,
+			Offset:             req.Offset,
+			TimeZone:           req.TimeZone,
+			MetricsView:        mv,
+			ResolvedMVSecurity: security,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported report query %q", spec.QueryName)
+	}
+}
+
+// exportFileType returns the file extension and content type for an export format.
+func exportFileType(format runtimev1.ExportFormat) (string, string) {
+	switch format {
+	case runtimev1.ExportFormat_EXPORT_FORMAT_XLSX:
+		return "xlsx", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
+		return "parquet", "application/vnd.apache.parquet"
+	default:
+		return "csv", "text/csv"
+	}
+}
+
+func timestampOrNil(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
@@ -11,6 +11,7 @@ import (
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
+	compilerv1 "github.com/rilldata/rill/runtime/compilers/rillv1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"go.opentelemetry.io/otel/attribute"
@@ -53,7 +54,7 @@ func (r *SourceReconciler) AssignState(from, to *runtimev1.Resource) error {
 	if a == nil || b == nil {
 		return fmt.Errorf("cannot assign state from %T to %T", from.Resource, to.Resource)
 	}
-	b.Spec = a.Spec
+	b.State = a.State
 	return nil
 }
 
@@ -113,6 +114,7 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 			src.State.Table = ""
 			src.State.SpecHash = ""
 			src.State.RefreshedOn = nil
+			src.State.IngestedObjects = nil
 			err = r.C.UpdateState(ctx, self.Meta.Name, self)
 			if err != nil {
 				r.C.Logger.Error("refs check: failed to update state", slog.Any("err", err))
@@ -122,7 +124,7 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 	}
 
 	// Use a hash of ingestion-related fields from the spec to determine if we need to re-ingest
-	hash, err := r.ingestionSpecHash(src.Spec)
+	hash, err := r.ingestionSpecHash(ctx, self.Meta.Refs, src.Spec)
 	if err != nil {
 		return runtime.ReconcileResult{Err: fmt.Errorf("failed to compute hash: %w", err)}
 	}
@@ -145,6 +147,7 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 
 	// Decide if we should trigger a refresh
 	trigger := src.Spec.Trigger                                             // If Trigger is set
+	trigger = trigger || src.Spec.TriggerFullRefresh                        // If a full refresh is requested
 	trigger = trigger || src.State.Table == ""                              // If table is missing
 	trigger = trigger || src.State.RefreshedOn == nil                       // If never refreshed
 	trigger = trigger || src.State.SpecHash != hash                         // If the spec has changed
@@ -162,24 +165,40 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 		olapDropTableIfExists(ctx, r.C, src.State.Connector, r.stagingTableName(src.State.Table), false)
 	}
 
-	// Prepare for ingestion
+	// Determine if we can ingest incrementally, i.e. only ingest new or changed objects into the existing table
+	incremental := src.Spec.Incremental && !src.Spec.TriggerFullRefresh
+	incremental = incremental && tableExists
+	incremental = incremental && src.State.Connector == src.Spec.SinkConnector
+	incremental = incremental && src.State.Table == tableName
+	incremental = incremental && src.State.SpecHash == hash
+
+	// Prepare for ingestion (incremental ingestion never stages changes since it builds on the existing table)
 	stagingTableName := tableName
 	connector := src.Spec.SinkConnector
-	if src.Spec.StageChanges {
+	if src.Spec.StageChanges && !incremental {
 		stagingTableName = r.stagingTableName(tableName)
 	}
 
+	// Track ingested objects for incremental sources
+	var incState *drivers.IncrementalState
+	if src.Spec.Incremental {
+		incState = &drivers.IncrementalState{Full: !incremental}
+		if incremental {
+			incState.Objects = objectsFromProto(src.State.IngestedObjects)
+		}
+	}
+
 	// Should never happen, but if somehow the staging table was corrupted into a view, drop it
 	if t, ok := olapTableInfo(ctx, r.C, connector, stagingTableName); ok && t.View {
 		olapDropTableIfExists(ctx, r.C, connector, stagingTableName, t.View)
 	}
 
 	// Execute ingestion
-	ingestErr := r.ingestSource(ctx, src.Spec, stagingTableName)
+	ingestErr := r.ingestSource(ctx, src.Spec, self.Meta.Refs, stagingTableName, incState)
 	if ingestErr != nil {
 		ingestErr = fmt.Errorf("failed to ingest source: %w", ingestErr)
 	}
-	if ingestErr == nil && src.Spec.StageChanges {
+	if ingestErr == nil && stagingTableName != tableName {
 		// Rename staging table to main table
 		err = olapForceRenameTable(ctx, r.C, connector, stagingTableName, false, tableName)
 		if err != nil {
@@ -191,6 +210,7 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 	// If ctx was cancelled, we cleanup and exit
 	// If StageChanges is true, we retain the existing table, but still return the error.
 	// If StageChanges is false, we clear the existing table and return the error.
+	// If ingesting incrementally, we retain the existing table, but clear the spec hash to force a full ingestion on the next refresh.
 
 	// ctx will only be cancelled in cases where the Controller guarantees a new call to Reconcile.
 	// We just clean up temp tables and state, then return.
@@ -210,6 +230,14 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 		src.State.Table = tableName
 		src.State.SpecHash = hash
 		src.State.RefreshedOn = timestamppb.Now()
+		src.State.IngestedObjects = nil
+		if incState != nil {
+			src.State.IngestedObjects = objectsToProto(incState.Objects)
+		}
+	} else if incremental {
+		// Failed incremental ingestion (the table may contain partially ingested objects)
+		update = true
+		src.State.SpecHash = ""
 	} else if src.Spec.StageChanges {
 		// Failed ingestion to staging table
 		olapDropTableIfExists(cleanupCtx, r.C, connector, stagingTableName, false)
@@ -221,6 +249,7 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 		src.State.Table = ""
 		src.State.SpecHash = ""
 		src.State.RefreshedOn = nil
+		src.State.IngestedObjects = nil
 	}
 	if update {
 		err = r.C.UpdateState(ctx, self.Meta.Name, self)
@@ -234,8 +263,8 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 		return runtime.ReconcileResult{Err: ingestErr}
 	}
 
-	// Reset spec.Trigger
-	if src.Spec.Trigger {
+	// Reset spec.Trigger and spec.TriggerFullRefresh
+	if src.Spec.Trigger || src.Spec.TriggerFullRefresh {
 		err := r.setTriggerFalse(ctx, n)
 		if err != nil {
 			return runtime.ReconcileResult{Err: err}
@@ -252,7 +281,7 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 }
 
 // ingestionSpecHash computes a hash of only those source spec properties that impact ingestion.
-func (r *SourceReconciler) ingestionSpecHash(spec *runtimev1.SourceSpec) (string, error) {
+func (r *SourceReconciler) ingestionSpecHash(ctx context.Context, refs []*runtimev1.ResourceName, spec *runtimev1.SourceSpec) (string, error) {
 	hash := md5.New()
 
 	_, err := hash.Write([]byte(spec.SourceConnector))
@@ -275,6 +304,39 @@ func (r *SourceReconciler) ingestionSpecHash(spec *runtimev1.SourceSpec) (string
 		return "", err
 	}
 
+	// Only written for incremental sources to not change the hash of existing sources
+	if spec.Incremental {
+		err = binary.Write(hash, binary.BigEndian, spec.Incremental)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	// The SQL of sources that call macros changes when the macros change.
+	// Only written for macro refs to not change the hash of existing sources.
+	for _, ref := range refs { // Refs are always sorted
+		if ref.Kind != runtime.ResourceKindMacro {
+			continue
+		}
+
+		_, err = hash.Write([]byte(ref.Name))
+		if err != nil {
+			return "", err
+		}
+
+		r, err := r.C.Get(ctx, ref, false)
+		var stateVersion int64
+		if err == nil {
+			stateVersion = r.Meta.StateVersion
+		} else {
+			stateVersion = -1
+		}
+		err = binary.Write(hash, binary.BigEndian, stateVersion)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	return hex.EncodeToString(hash.Sum(nil)), nil
 }
 
@@ -284,7 +346,7 @@ func (r *SourceReconciler) stagingTableName(table string) string {
 	return "__rill_tmp_src_" + table
 }
 
-// setTriggerFalse sets the source's spec.Trigger to false.
+// setTriggerFalse sets the source's spec.Trigger and spec.TriggerFullRefresh to false.
 // Unlike the State, the Spec may be edited concurrently with a Reconcile call, so we need to read and edit it under a lock.
 func (r *SourceReconciler) setTriggerFalse(ctx context.Context, n *runtimev1.ResourceName) error {
 	r.C.Lock(ctx)
@@ -301,13 +363,15 @@ func (r *SourceReconciler) setTriggerFalse(ctx context.Context, n *runtimev1.Res
 	}
 
 	source.Spec.Trigger = false
+	source.Spec.TriggerFullRefresh = false
 	return r.C.UpdateSpec(ctx, self.Meta.Name, self)
 }
 
 // ingestSource ingests the source into a table with tableName.
 // It does NOT drop the table if ingestion fails after the table has been created.
 // It will return an error if the sink connector is not an OLAP.
-func (r *SourceReconciler) ingestSource(ctx context.Context, src *runtimev1.SourceSpec, tableName string) (outErr error) {
+// If incState is not nil, the ingestion is incremental and incState is updated with the ingested objects.
+func (r *SourceReconciler) ingestSource(ctx context.Context, src *runtimev1.SourceSpec, refs []*runtimev1.ResourceName, tableName string, incState *drivers.IncrementalState) (outErr error) {
 	// Get connections and transporter
 	srcConn, release1, err := r.C.AcquireConn(ctx, src.SourceConnector)
 	if err != nil {
@@ -332,6 +396,14 @@ func (r *SourceReconciler) ingestSource(ctx context.Context, src *runtimev1.Sour
 	if err != nil {
 		return err
 	}
+	err = r.resolveMacros(ctx, refs, srcConfig)
+	if err != nil {
+		return err
+	}
+	err = r.resolveParams(ctx, srcConfig)
+	if err != nil {
+		return err
+	}
 	sinkConfig, err := driversSink(sinkConn, tableName)
 	if err != nil {
 		return err
@@ -401,7 +473,8 @@ func (r *SourceReconciler) ingestSource(ctx context.Context, src *runtimev1.Sour
 		AcquireConnector: func(name string) (drivers.Handle, func(), error) {
 			return r.C.AcquireConn(ctx, name)
 		},
-		Progress: drivers.NoOpProgress{},
+		Progress:    drivers.NoOpProgress{},
+		Incremental: incState,
 	}
 	if ingestionLimit != nil {
 		opts.LimitInBytes = *ingestionLimit
@@ -417,6 +490,7 @@ func (r *SourceReconciler) ingestSource(ctx context.Context, src *runtimev1.Sour
 			attribute.Bool("failed", outErr != nil),
 			attribute.Bool("limit_exceeded", limitExceeded),
 			attribute.Int64("limit_bytes", opts.LimitInBytes),
+			attribute.Bool("incremental", incState != nil && !incState.Full),
 		}
 		r.C.Activity.Emit(ctx, "ingestion_ms", float64(transferLatency), commonDims...)
 
@@ -430,6 +504,87 @@ func (r *SourceReconciler) ingestSource(ctx context.Context, src *runtimev1.Sour
 	return err
 }
 
+// resolveMacros expands macros called from the "sql" property of a source.
+// Only the SQL of sources that call macros is resolved as a template to not change the behavior of existing sources.
+func (r *SourceReconciler) resolveMacros(ctx context.Context, refs []*runtimev1.ResourceName, props map[string]any) error {
+	callsMacro := false
+	for _, ref := range refs {
+		if ref.Kind == runtime.ResourceKindMacro {
+			callsMacro = true
+			break
+		}
+	}
+	sql, ok := props["sql"].(string)
+	if !callsMacro || !ok {
+		return nil
+	}
+
+	inst, err := r.C.Runtime.FindInstance(ctx, r.C.InstanceID)
+	if err != nil {
+		return err
+	}
+
+	resolved, err := compilerv1.ResolveTemplate(sql, compilerv1.TemplateData{
+		User:      map[string]interface{}{},
+		Variables: inst.ResolveVariables(),
+		Resolve: func(ref compilerv1.ResourceName) (string, error) {
+			return safeSQLName(ref.Name), nil
+		},
+		Lookup: func(name compilerv1.ResourceName) (compilerv1.TemplateResource, error) {
+			return compilerv1.TemplateResource{}, fmt.Errorf("lookup is not supported in source SQL")
+		},
+		Macro: resolveMacro(ctx, r.C),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to resolve template: %w", err)
+	}
+
+	props["sql"] = resolved
+	return nil
+}
+
+// resolveParams resolves templates in the values of the "params" property of a source, which the https connector adds to the query of requests.
+// This lets params reference project variables, such as "{{ .env.start_date }}".
+func (r *SourceReconciler) resolveParams(ctx context.Context, props map[string]any) error {
+	params, ok := props["params"].(map[string]any)
+	if !ok || len(params) == 0 {
+		return nil
+	}
+
+	inst, err := r.C.Runtime.FindInstance(ctx, r.C.InstanceID)
+	if err != nil {
+		return err
+	}
+
+	data := compilerv1.TemplateData{
+		User:      map[string]interface{}{},
+		Variables: inst.ResolveVariables(),
+		Resolve: func(ref compilerv1.ResourceName) (string, error) {
+			return "", fmt.Errorf("ref is not supported in source params")
+		},
+		Lookup: func(name compilerv1.ResourceName) (compilerv1.TemplateResource, error) {
+			return compilerv1.TemplateResource{}, fmt.Errorf("lookup is not supported in source params")
+		},
+	}
+
+	resolved := make(map[string]any, len(params))
+	for k, v := range params {
+		s, ok := v.(string)
+		if !ok {
+			resolved[k] = v
+			continue
+		}
+		res, err := compilerv1.ResolveTemplate(s, data)
+		if err != nil {
+			return fmt.Errorf("failed to resolve template in param %q: %w", k, err)
+		}
+		resolved[k] = res
+	}
+
+	props["params"] = resolved
+	return nil
+}
+
 func driversSource(conn drivers.Handle, propsPB *structpb.Struct) (map[string]any, error) {
 	props := propsPB.AsMap()
 	return props, nil
@@ -438,3 +593,29 @@ func driversSource(conn drivers.Handle, propsPB *structpb.Struct) (map[string]an
 func driversSink(conn drivers.Handle, tableName string) (map[string]any, error) {
 	return map[string]any{"table": tableName}, nil
 }
+
+func objectsFromProto(objs []*runtimev1.IngestedObject) []*drivers.ObjectInfo {
+	res := make([]*drivers.ObjectInfo, len(objs))
+	for i, obj := range objs {
+		res[i] = &drivers.ObjectInfo{
+			Key:          obj.Key,
+			ETag:         obj.Etag,
+			LastModified: obj.LastModified.AsTime(),
+			Size:         obj.Size,
+		}
+	}
+	return res
+}
+
+func objectsToProto(objs []*drivers.ObjectInfo) []*runtimev1.IngestedObject {
+	res := make([]*runtimev1.IngestedObject, len(objs))
+	for i, obj := range objs {
+		res[i] = &runtimev1.IngestedObject{
+			Key:          obj.Key,
+			Etag:         obj.ETag,
+			LastModified: timestamppb.New(obj.LastModified),
+			Size:         obj.Size,
+		}
+	}
+	return res
+}
@@ -0,0 +1,166 @@
+package reconcilers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const _defaultTestTimeout = 5 * time.Minute
+
+func init() {
+	runtime.RegisterReconcilerInitializer(runtime.ResourceKindTest, newTestReconciler)
+}
+
+type TestReconciler struct {
+	C *runtime.Controller
+}
+
+func newTestReconciler(c *runtime.Controller) runtime.Reconciler {
+	return &TestReconciler{C: c}
+}
+
+func (r *TestReconciler) Close(ctx context.Context) error {
+	return nil
+}
+
+func (r *TestReconciler) AssignSpec(from, to *runtimev1.Resource) error {
+	a := from.GetTest()
+	b := to.GetTest()
+	if a == nil || b == nil {
+		return fmt.Errorf("cannot assign spec from %T to %T", from.Resource, to.Resource)
+	}
+	b.Spec = a.Spec
+	return nil
+}
+
+func (r *TestReconciler) AssignState(from, to *runtimev1.Resource) error {
+	a := from.GetTest()
+	b := to.GetTest()
+	if a == nil || b == nil {
+		return fmt.Errorf("cannot assign state from %T to %T", from.Resource, to.Resource)
+	}
+	b.State = a.State
+	return nil
+}
+
+func (r *TestReconciler) ResetState(res *runtimev1.Resource) error {
+	res.GetTest().State = &runtimev1.TestState{}
+	return nil
+}
+
+func (r *TestReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceName) runtime.ReconcileResult {
+	self, err := r.C.Get(ctx, n, true)
+	if err != nil {
+		return runtime.ReconcileResult{Err: err}
+	}
+	t := self.GetTest()
+	if t == nil {
+		return runtime.ReconcileResult{Err: errors.New("not a test")}
+	}
+
+	// Nothing to clean up on deletion
+	if self.Meta.DeletedOn != nil {
+		return runtime.ReconcileResult{}
+	}
+
+	// Check refs - stop if any of them are invalid.
+	// The controller calls Reconcile again when a ref is reconciled, so the test re-runs after the model it checks changes.
+	err = checkRefs(ctx, r.C, self.Meta.Refs)
+	if err != nil {
+		return runtime.ReconcileResult{Err: err}
+	}
+
+	olap, release, err := r.C.AcquireOLAP(ctx, t.Spec.Connector)
+	if err != nil {
+		return runtime.ReconcileResult{Err: err}
+	}
+	defer release()
+
+	failed, runErr := RunTestAssertions(ctx, olap, t.Spec)
+	if runErr != nil && errors.Is(runErr, ctx.Err()) {
+		// The controller guarantees a new call to Reconcile, which will re-run the test.
+		return runtime.ReconcileResult{Err: runErr}
+	}
+
+	// Update state
+	t.State.LastRunOn = timestamppb.Now()
+	t.State.FailedAssertions = nil
+	for _, f := range failed {
+		t.State.FailedAssertions = append(t.State.FailedAssertions, f.Name)
+	}
+	err = r.C.UpdateState(ctx, self.Meta.Name, self)
+	if err != nil {
+		return runtime.ReconcileResult{Err: err}
+	}
+
+	// Failed assertions are surfaced as the reconcile error of the test
+	if runErr == nil && len(failed) > 0 {
+		var errs []error
+		for _, f := range failed {
+			errs = append(errs, f)
+		}
+		runErr = errors.Join(errs...)
+	}
+	return runtime.ReconcileResult{Err: runErr}
+}
+
+// TestAssertionFailure describes an assertion that returned one or more violating rows.
+type TestAssertionFailure struct {
+	Name string
+	Rows int64
+}
+
+func (f *TestAssertionFailure) Error() string {
+	if f.Rows == 1 {
+		return fmt.Sprintf("assertion %q failed: 1 row", f.Name)
+	}
+	return fmt.Sprintf("assertion %q failed: %d rows", f.Name, f.Rows)
+}
+
+// RunTestAssertions runs the assertions of a test against the OLAP store and returns the assertions that failed.
+// Each assertion's SQL returns the rows that violate it, so an assertion passes if its SQL returns no rows.
+// It returns an error if any of the assertions could not be executed.
+func RunTestAssertions(ctx context.Context, olap drivers.OLAPStore, spec *runtimev1.TestSpec) ([]*TestAssertionFailure, error) {
+	ctx, cancel := context.WithTimeout(ctx, _defaultTestTimeout)
+	defer cancel()
+
+	var failed []*TestAssertionFailure
+	for _, a := range spec.Assertions {
+		n, err := countTestFailures(ctx, olap, a.Sql)
+		if err != nil {
+			return failed, fmt.Errorf("assertion %q: %w", a.Name, err)
+		}
+		if n > 0 {
+			failed = append(failed, &TestAssertionFailure{Name: a.Name, Rows: n})
+		}
+	}
+	return failed, nil
+}
+
+// countTestFailures returns the number of rows returned by an assertion's SQL.
+func countTestFailures(ctx context.Context, olap drivers.OLAPStore, sql string) (int64, error) {
+	res, err := olap.Execute(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("SELECT COUNT(*) FROM (%s) AS failures", sql),
+		Priority: 1,
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer res.Close()
+
+	var n int64
+	if res.Next() {
+		err = res.Scan(&n)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return n, res.Err()
+}
@@ -1,9 +1,11 @@
 package reconcilers
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
@@ -11,6 +13,8 @@ import (
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/robfig/cron/v3"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // checkRefs checks that all refs exist, are idle, and have no errors.
@@ -66,6 +70,49 @@ func nextRefreshTime(t time.Time, schedule *runtimev1.Schedule) (time.Time, erro
 	return t2, nil
 }
 
+// scheduledRun determines whether a resource that runs on a schedule (like a report or alert) should run now.
+// The next run time is computed from the last run (or the last spec change, so that schedule changes take effect immediately).
+// It returns true if the resource was triggered or the next run time has passed, and the next run time.
+func scheduledRun(self *runtimev1.Resource, lastRunOn *timestamppb.Timestamp, schedule *runtimev1.Schedule, trigger bool) (bool, time.Time, error) {
+	var anchor time.Time
+	if self.Meta.SpecUpdatedOn != nil {
+		anchor = self.Meta.SpecUpdatedOn.AsTime()
+	}
+	if lastRunOn != nil && lastRunOn.AsTime().After(anchor) {
+		anchor = lastRunOn.AsTime()
+	}
+	if anchor.IsZero() {
+		anchor = time.Now()
+	}
+
+	runOn, err := nextRefreshTime(anchor, schedule)
+	if err != nil {
+		return false, time.Time{}, err
+	}
+
+	run := trigger || !runOn.IsZero() && !time.Now().Before(runOn)
+	return run, runOn, nil
+}
+
+// setSpecTriggerFalse sets a resource's spec.Trigger to false using the provided function.
+// Unlike the State, the Spec may be edited concurrently with a Reconcile call, so we need to read and edit it under a lock.
+func setSpecTriggerFalse(ctx context.Context, c *runtime.Controller, n *runtimev1.ResourceName, fn func(r *runtimev1.Resource) error) error {
+	c.Lock(ctx)
+	defer c.Unlock(ctx)
+
+	self, err := c.Get(ctx, n, false)
+	if err != nil {
+		return err
+	}
+
+	err = fn(self)
+	if err != nil {
+		return err
+	}
+
+	return c.UpdateSpec(ctx, self.Meta.Name, self)
+}
+
 // olapTableInfo returns info about a table in an OLAP connector.
 func olapTableInfo(ctx context.Context, c *runtime.Controller, connector, table string) (*drivers.Table, bool) {
 	if table == "" {
@@ -146,3 +193,161 @@ func safeSQLName(name string) string {
 	}
 	return fmt.Sprintf("\"%s\"", strings.ReplaceAll(name, "\"", "\"\""))
 }
+
+// resolveMetricsView finds the valid spec of a metrics view and converts it to the catalog representation used by the queries package.
+// It looks for the metrics view among refs first, so that the name's casing matches the ref.
+// It also returns the time the metrics view's state was last updated, which can be used as a cache key for its security policy.
+func resolveMetricsView(ctx context.Context, c *runtime.Controller, name string, refs []*runtimev1.ResourceName) (*runtimev1.MetricsView, time.Time, error) {
+	n := &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: name}
+	for _, ref := range refs {
+		if equalResourceName(ref, n) {
+			n = ref
+			break
+		}
+	}
+
+	res, err := c.Get(ctx, n, false)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to find metrics view %q: %w", name, err)
+	}
+
+	spec := res.GetMetricsView().State.ValidSpec
+	if spec == nil {
+		return nil, time.Time{}, fmt.Errorf("metrics view %q is not valid", name)
+	}
+
+	var updatedOn time.Time
+	if res.Meta.StateUpdatedOn != nil {
+		updatedOn = res.Meta.StateUpdatedOn.AsTime()
+	}
+
+	return metricsViewFromSpec(n.Name, spec), updatedOn, nil
+}
+
+// resolveOwnerSecurity resolves a metrics view's security policy for the owner of a report or alert.
+// It fails closed: if the metrics view has a security policy, the owner's attributes are required and must grant access to the metrics view.
+func resolveOwnerSecurity(c *runtime.Controller, owner *structpb.Struct, mv *runtimev1.MetricsView, updatedOn time.Time) (*runtime.ResolvedMetricsViewSecurity, error) {
+	if mv.Security == nil {
+		return nil, nil
+	}
+
+	// The security engine grants open access when there are no attributes, so we must not resolve the policy without them
+	attrs := ownerAttributes(owner)
+	if len(attrs) == 0 {
+		return nil, fmt.Errorf(`an "owner" is required since metrics view %q has a security policy`, mv.Name)
+	}
+
+	security, err := c.Runtime.ResolveMetricsViewSecurity(attrs, c.InstanceID, mv, updatedOn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve security policy: %w", err)
+	}
+	if security == nil || !security.Access {
+		return nil, fmt.Errorf("the owner does not have access to metrics view %q", mv.Name)
+	}
+	return security, nil
+}
+
+// ownerAttributes returns the user attributes of a report or alert owner for resolving security policies.
+func ownerAttributes(owner *structpb.Struct) map[string]any {
+	if owner == nil {
+		return nil
+	}
+	attrs := owner.AsMap()
+
+	// Infer the domain from the email (like for authenticated users)
+	if email, ok := attrs["email"].(string); ok && attrs["domain"] == nil {
+		if i := strings.LastIndex(email, "@"); i >= 0 {
+			attrs["domain"] = email[i+1:]
+		}
+	}
+
+	return attrs
+}
+
+// metricsViewFromSpec converts a metrics view resource spec to the catalog representation used by the queries package.
+func metricsViewFromSpec(name string, spec *runtimev1.MetricsViewSpec) *runtimev1.MetricsView {
+	mv := &runtimev1.MetricsView{
+		Name:               name,
+		Model:              spec.Table,
+		TimeDimension:      spec.TimeDimension,
+		Label:              spec.Title,
+		Description:        spec.Description,
+		SmallestTimeGrain:  spec.SmallestTimeGrain,
+		DefaultTimeRange:   spec.DefaultTimeRange,
+		AvailableTimeZones: spec.AvailableTimeZones,
+		FirstDayOfWeek:     spec.FirstDayOfWeek,
+		FirstMonthOfYear:   spec.FirstMonthOfYear,
+	}
+
+	for _, d := range spec.Dimensions {
+		mv.Dimensions = append(mv.Dimensions, &runtimev1.MetricsView_Dimension{
+			Name:        d.Name,
+			Label:       d.Label,
+			Description: d.Description,
+			Column:      d.Column,
+		})
+	}
+
+	for _, m := range spec.Measures {
+		measure := &runtimev1.MetricsView_Measure{
+			Name:                m.Name,
+			Label:               m.Label,
+			Expression:          m.Expression,
+			Description:         m.Description,
+			Format:              m.Format,
+			ValidPercentOfTotal: m.ValidPercentOfTotal,
+			ReferencedMeasures:  m.ReferencedMeasures,
+		}
+		if m.Window != nil {
+			measure.Window = &runtimev1.MetricsView_MeasureWindow{
+				Partition: m.Window.Partition,
+				Frame:     m.Window.Frame,
+			}
+		}
+		mv.Measures = append(mv.Measures, measure)
+	}
+
+	if spec.Security != nil {
+		mv.Security = &runtimev1.MetricsView_Security{
+			Access:    spec.Security.Access,
+			RowFilter: spec.Security.RowFilter,
+		}
+		for _, inc := range spec.Security.Include {
+			mv.Security.Include = append(mv.Security.Include, &runtimev1.MetricsView_Security_FieldCondition{
+				Condition: inc.Condition,
+				Names:     inc.Names,
+			})
+		}
+		for _, exc := range spec.Security.Exclude {
+			mv.Security.Exclude = append(mv.Security.Exclude, &runtimev1.MetricsView_Security_FieldCondition{
+				Condition: exc.Condition,
+				Names:     exc.Names,
+			})
+		}
+	}
+
+	return mv
+}
+
+// postWebhook POSTs a notification payload to a webhook URL.
+// It returns an error if the webhook doesn't respond with a 2xx status code.
+func postWebhook(ctx context.Context, url string, header http.Header, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("invalid webhook URL %q: %w", url, err)
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post to webhook %q: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook %q responded with status %d", url, resp.StatusCode)
+	}
+	return nil
+}
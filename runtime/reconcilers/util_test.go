@@ -0,0 +1,73 @@
+package reconcilers
+
+import (
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestResolveOwnerSecurity(t *testing.T) {
+	rt, instanceID := testruntime.NewInstance(t)
+	c := &runtime.Controller{Runtime: rt, InstanceID: instanceID}
+
+	mv := &runtimev1.MetricsView{Name: "mv"}
+	security, err := resolveOwnerSecurity(c, nil, mv, time.Time{})
+	require.NoError(t, err)
+	require.Nil(t, security)
+
+	mv.Security = &runtimev1.MetricsView_Security{
+		Access:    "'{{ .user.domain }}' = 'example.com'",
+		RowFilter: "domain = '{{ .user.domain }}'",
+		Exclude:   []*runtimev1.MetricsView_Security_FieldCondition{{Condition: "true", Names: []string{"revenue"}}},
+	}
+
+	// Fails closed without an owner
+	_, err = resolveOwnerSecurity(c, nil, mv, time.Time{})
+	require.ErrorContains(t, err, `an "owner" is required`)
+
+	owner, err := structpb.NewStruct(map[string]any{"email": "jane@example.com"})
+	require.NoError(t, err)
+	security, err = resolveOwnerSecurity(c, owner, mv, time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, "domain = 'example.com'", security.RowFilter)
+	require.False(t, security.CanAccessField("revenue"))
+
+	owner, err = structpb.NewStruct(map[string]any{"email": "jane@other.com"})
+	require.NoError(t, err)
+	_, err = resolveOwnerSecurity(c, owner, mv, time.Time{})
+	require.ErrorContains(t, err, "does not have access")
+}
+
+func TestScheduledRun(t *testing.T) {
+	schedule := &runtimev1.Schedule{TickerSeconds: 3600}
+	self := &runtimev1.Resource{Meta: &runtimev1.ResourceMeta{SpecUpdatedOn: timestamppb.New(time.Now().Add(-30 * time.Minute))}}
+
+	// Not due yet
+	run, runOn, err := scheduledRun(self, nil, schedule, false)
+	require.NoError(t, err)
+	require.False(t, run)
+	require.WithinDuration(t, time.Now().Add(30*time.Minute), runOn, time.Minute)
+
+	// Triggered
+	run, _, err = scheduledRun(self, nil, schedule, true)
+	require.NoError(t, err)
+	require.True(t, run)
+
+	// Due based on the last run
+	self.Meta.SpecUpdatedOn = timestamppb.New(time.Now().Add(-3 * time.Hour))
+	run, _, err = scheduledRun(self, timestamppb.New(time.Now().Add(-2*time.Hour)), schedule, false)
+	require.NoError(t, err)
+	require.True(t, run)
+
+	// No schedule
+	run, runOn, err = scheduledRun(self, nil, nil, false)
+	require.NoError(t, err)
+	require.False(t, run)
+	require.True(t, runOn.IsZero())
+}
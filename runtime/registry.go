@@ -47,6 +47,9 @@ func (r *Runtime) DeleteInstance(ctx context.Context, instanceID string, dropDB
 		catalog, release, err := r.Catalog(ctx, instanceID)
 		if err == nil {
 			err = catalog.DeleteEntries(ctx)
+			if err == nil {
+				err = catalog.DeleteResources(ctx)
+			}
 			release()
 		}
 		if err != nil {
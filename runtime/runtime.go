@@ -5,8 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sync"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/rilldata/rill/admin/email"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/activity"
@@ -22,6 +25,16 @@ type Options struct {
 	SafeSourceRefresh       bool
 	// SystemConnectors are drivers whose handles are shared with all instances
 	SystemConnectors []*runtimev1.Connector
+	// Email is used to deliver scheduled reports. It may be nil if email is not configured.
+	Email *email.Client
+	// NodeID identifies the runtime process among processes sharing the same registry. Defaults to a random ID.
+	NodeID string
+	// NodeAddress is the gRPC address other nodes use to forward requests for instances whose controllers run on this node.
+	NodeAddress string
+	// NodeHTTPAddress is the HTTP address other nodes use to forward HTTP-only requests (like downloads) to this node.
+	NodeHTTPAddress string
+	// ControllerLeaseTTL is how long the node owns an instance's controller without renewing its lease.
+	ControllerLeaseTTL time.Duration
 }
 type Runtime struct {
 	opts               *Options
@@ -30,8 +43,13 @@ type Runtime struct {
 	connCache          *connectionCache
 	migrationMetaCache *migrationMetaCache
 	queryCache         *queryCache
+	queryAdmission     *queryAdmission
 	securityEngine     *securityEngine
 	activity           activity.Client
+	nodeID             string
+	controllersMu      sync.Mutex
+	controllers        map[string]*controllerHandle
+	controllersRunning bool
 }
 
 func New(opts *Options, logger *zap.Logger, client activity.Client) (*Runtime, error) {
@@ -40,8 +58,14 @@ func New(opts *Options, logger *zap.Logger, client activity.Client) (*Runtime, e
 		logger:             logger,
 		migrationMetaCache: newMigrationMetaCache(math.MaxInt),
 		queryCache:         newQueryCache(opts.QueryCacheSizeBytes),
+		queryAdmission:     newQueryAdmission(),
 		securityEngine:     newSecurityEngine(opts.SecurityEngineCacheSize, logger),
 		activity:           client,
+		nodeID:             opts.NodeID,
+		controllers:        make(map[string]*controllerHandle),
+	}
+	if rt.nodeID == "" {
+		rt.nodeID = uuid.NewString()
 	}
 	rt.connCache = newConnectionCache(opts.ConnectionCacheSize, logger, rt, client)
 	store, _, err := rt.AcquireSystemHandle(context.Background(), opts.MetastoreConnector)
@@ -62,6 +86,11 @@ func (r *Runtime) AllowHostAccess() bool {
 	return r.opts.AllowHostAccess
 }
 
+// Email returns the client for sending emails, or nil if email is not configured.
+func (r *Runtime) Email() *email.Client {
+	return r.opts.Email
+}
+
 func (r *Runtime) Close() error {
 	return errors.Join(
 		r.metastore.Close(),
@@ -70,10 +99,6 @@ func (r *Runtime) Close() error {
 	)
 }
 
-func (r *Runtime) Controller(instanceID string) (*Controller, error) {
-	panic("not implemented")
-}
-
 func (r *Runtime) ResolveMetricsViewSecurity(attributes map[string]any, instanceID string, mv *runtimev1.MetricsView, lastUpdatedOn time.Time) (*ResolvedMetricsViewSecurity, error) {
 	return r.securityEngine.resolveMetricsViewSecurity(attributes, instanceID, mv, lastUpdatedOn)
 }
@@ -12,6 +12,7 @@ import (
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/compilers/rillv1"
 	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
 )
 
 type securityEngine struct {
@@ -42,6 +43,21 @@ type ResolvedMetricsViewSecurity struct {
 	Exclude   []string
 }
 
+// CanAccessField returns true if the policy allows access to the metrics view's dimension or measure.
+// A nil policy allows access to all fields.
+func (p *ResolvedMetricsViewSecurity) CanAccessField(field string) bool {
+	if p == nil {
+		return true
+	}
+	if !p.Access {
+		return false
+	}
+	if len(p.Include) > 0 {
+		return slices.Contains(p.Include, field)
+	}
+	return !slices.Contains(p.Exclude, field)
+}
+
 func computeCacheKey(instanceID string, mv *runtimev1.MetricsView, lastUpdatedOn time.Time, attributes map[string]any) (string, error) {
 	hash := md5.New()
 	_, err := hash.Write([]byte(instanceID))
@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 
+	"github.com/google/uuid"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/reconcilers"
 	"github.com/rilldata/rill/runtime/server/auth"
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
@@ -40,7 +42,7 @@ func (s *Server) ListResources(ctx context.Context, req *runtimev1.ListResources
 
 	ctrl, err := s.runtime.Controller(req.InstanceId)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, controllerError(err)
 	}
 
 	rs, err := ctrl.List(ctx, req.Kind, false)
@@ -80,7 +82,7 @@ func (s *Server) WatchResources(req *runtimev1.WatchResourcesRequest, ss runtime
 
 	ctrl, err := s.runtime.Controller(req.InstanceId)
 	if err != nil {
-		return status.Error(codes.InvalidArgument, err.Error())
+		return controllerError(err)
 	}
 
 	if req.Replay {
@@ -144,7 +146,7 @@ func (s *Server) GetResource(ctx context.Context, req *runtimev1.GetResourceRequ
 
 	ctrl, err := s.runtime.Controller(req.InstanceId)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, controllerError(err)
 	}
 
 	r, err := ctrl.Get(ctx, req.Name, false)
@@ -168,7 +170,83 @@ func (s *Server) GetResource(ctx context.Context, req *runtimev1.GetResourceRequ
 
 // CreateTrigger implements runtimev1.RuntimeServiceServer
 func (s *Server) CreateTrigger(ctx context.Context, req *runtimev1.CreateTriggerRequest) (*runtimev1.CreateTriggerResponse, error) {
-	panic("not implemented")
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+	)
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.EditInstance) {
+		return nil, ErrForbidden
+	}
+
+	ctrl, err := s.runtime.Controller(req.InstanceId)
+	if err != nil {
+		return nil, controllerError(err)
+	}
+
+	// Triggers are ephemeral resources that delete themselves after reconciling, so we give them a random name
+	var name *runtimev1.ResourceName
+	var r *runtimev1.Resource
+	switch trg := req.Trigger.(type) {
+	case *runtimev1.CreateTriggerRequest_PullTriggerSpec:
+		name = &runtimev1.ResourceName{Kind: runtime.ResourceKindPullTrigger, Name: "trigger_" + uuid.NewString()}
+		spec := trg.PullTriggerSpec
+		if spec == nil {
+			spec = &runtimev1.PullTriggerSpec{}
+		}
+		r = &runtimev1.Resource{Resource: &runtimev1.Resource_PullTrigger{PullTrigger: &runtimev1.PullTrigger{
+			Spec:  spec,
+			State: &runtimev1.PullTriggerState{},
+		}}}
+	case *runtimev1.CreateTriggerRequest_RefreshTriggerSpec:
+		name = &runtimev1.ResourceName{Kind: runtime.ResourceKindRefreshTrigger, Name: "trigger_" + uuid.NewString()}
+		spec := trg.RefreshTriggerSpec
+		if spec == nil {
+			spec = &runtimev1.RefreshTriggerSpec{}
+		}
+		r = &runtimev1.Resource{Resource: &runtimev1.Resource_RefreshTrigger{RefreshTrigger: &runtimev1.RefreshTrigger{
+			Spec:  spec,
+			State: &runtimev1.RefreshTriggerState{},
+		}}}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "missing trigger spec")
+	}
+
+	err = ctrl.Create(ctx, name, nil, nil, nil, r)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &runtimev1.CreateTriggerResponse{}, nil
+}
+
+// PlanReconcile implements runtimev1.RuntimeServiceServer
+func (s *Server) PlanReconcile(ctx context.Context, req *runtimev1.PlanReconcileRequest) (*runtimev1.PlanReconcileResponse, error) {
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.Int("args.files", len(req.Files)),
+	)
+
+	claims := auth.GetClaims(ctx)
+	if !claims.CanInstance(req.InstanceId, auth.ReadObjects) || !claims.CanInstance(req.InstanceId, auth.ReadRepo) {
+		return nil, ErrForbidden
+	}
+
+	ctrl, err := s.runtime.Controller(req.InstanceId)
+	if err != nil {
+		return nil, controllerError(err)
+	}
+
+	changes, parseErrs, err := reconcilers.PlanProject(ctx, ctrl, req.Files)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &runtimev1.PlanReconcileResponse{
+		Changes:     changes,
+		ParseErrors: parseErrs,
+	}, nil
 }
 
 // applySecurityPolicy applies relevant security policies to the resource.
@@ -293,3 +371,12 @@ func (s *Server) applySecurityPolicyIncludesAndExcludes(mv *runtimev1.MetricsVie
 
 	return mv, true
 }
+
+// controllerError maps an error from runtime.Controller to a gRPC error.
+// A controller that isn't running (yet) is reported as unavailable so clients can retry.
+func controllerError(err error) error {
+	if errors.Is(err, runtime.ErrControllerNotRunning) {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return status.Error(codes.InvalidArgument, err.Error())
+}
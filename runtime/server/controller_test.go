@@ -0,0 +1,20 @@
+package server
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateTriggerControllerNotRunning(t *testing.T) {
+	server, instanceID := getTestServer(t)
+
+	_, err := server.CreateTrigger(testCtx(), &runtimev1.CreateTriggerRequest{
+		InstanceId: instanceID,
+		Trigger:    &runtimev1.CreateTriggerRequest_RefreshTriggerSpec{RefreshTriggerSpec: &runtimev1.RefreshTriggerSpec{}},
+	})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"reflect"
 	"time"
 
@@ -39,7 +40,8 @@ func (s *Server) Export(ctx context.Context, req *runtimev1.ExportRequest) (*run
 		return nil, status.Errorf(codes.Internal, "failed to generate download token: %s", err.Error())
 	}
 
-	out := fmt.Sprintf("/v1/download?token=%s", tkn)
+	// The instance ID is passed in the clear so that nodes that can't decrypt the token can forward it to the instance's node
+	out := fmt.Sprintf("/v1/download?instance_id=%s&token=%s", url.QueryEscape(req.InstanceId), tkn)
 
 	return &runtimev1.ExportResponse{
 		DownloadUrlPath: out,
@@ -47,12 +49,21 @@ func (s *Server) Export(ctx context.Context, req *runtimev1.ExportRequest) (*run
 }
 
 func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
+	instanceID := req.URL.Query().Get("instance_id")
+	if s.forwardHTTP(w, req, instanceID) {
+		return
+	}
+
 	rawTkn := req.URL.Query().Get("token")
 	request, attrs, err := s.parseDownloadToken(rawTkn)
 	if err != nil {
 		http.Error(w, fmt.Sprintf("failed to parse download token: %s", err.Error()), http.StatusBadRequest)
 		return
 	}
+	if instanceID != "" && instanceID != request.InstanceId {
+		http.Error(w, "instance_id does not match the download token", http.StatusBadRequest)
+		return
+	}
 
 	if s.opts.DownloadRowLimit != nil && (request.Limit == nil || *request.Limit > *s.opts.DownloadRowLimit) {
 		http.Error(w, fmt.Sprintf("limit must be less than or equal to %d", *s.opts.DownloadRowLimit), http.StatusBadRequest)
@@ -98,6 +109,8 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 			TimeStart:          r.TimeStart,
 			TimeEnd:            r.TimeEnd,
 			Filter:             r.Filter,
+			Where:              r.Where,
+			Having:             r.Having,
 			Limit:              &r.Limit,
 			Offset:             r.Offset,
 			MetricsView:        mv,
@@ -149,6 +162,8 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 			TimeEnd:            r.TimeEnd,
 			Sort:               r.Sort,
 			Filter:             r.Filter,
+			Where:              r.Where,
+			Having:             r.Having,
 			Limit:              limit,
 			MetricsView:        mv,
 			ResolvedMVSecurity: security,
@@ -170,6 +185,7 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 			TimeStart:          r.TimeStart,
 			TimeEnd:            r.TimeEnd,
 			Filter:             r.Filter,
+			Where:              r.Where,
 			Sort:               r.Sort,
 			Limit:              request.Limit,
 			TimeZone:           r.TimeZone,
@@ -203,6 +219,7 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 			TimeEnd:            r.TimeEnd,
 			TimeGranularity:    r.TimeGranularity,
 			Filter:             r.Filter,
+			Where:              r.Where,
 			TimeZone:           r.TimeZone,
 			MetricsView:        mv,
 			ResolvedMVSecurity: security,
@@ -256,6 +273,8 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 			Offset:              r.Offset,
 			Sort:                r.Sort,
 			Filter:              r.Filter,
+			Where:               r.Where,
+			Having:              r.Having,
 			MetricsView:         mv,
 			ResolvedMVSecurity:  security,
 		}
@@ -264,8 +283,21 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	err = q.Export(req.Context(), s.runtime, request.InstanceId, w, &runtime.ExportOptions{
-		Format: request.Format,
+	// Exports resolve queries directly, so they must be admitted explicitly
+	ctx, priority, release, err := s.runtime.AdmitQuery(withQuerySubject(req.Context()), request.InstanceId, q, 0)
+	if err != nil {
+		if errors.As(err, &runtime.QueryLimitExceededError{}) {
+			http.Error(w, err.Error(), http.StatusTooManyRequests)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer release()
+
+	err = q.Export(ctx, s.runtime, request.InstanceId, w, &runtime.ExportOptions{
+		Format:   request.Format,
+		Priority: priority,
 		PreWriteHook: func(filename string) error {
 			// Add timestamp to filename
 			filename += "_" + time.Now().Format("20060102150405")
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownloadQueryLimits(t *testing.T) {
+	server, instanceID := getMetricsTestServer(t, "ad_bids")
+	ctx := context.Background()
+
+	inst, err := server.runtime.FindInstance(ctx, instanceID)
+	require.NoError(t, err)
+	inst.QueryLimits = &runtimev1.QueryLimits{MaxResultRows: 2}
+	require.NoError(t, server.runtime.Registry().EditInstance(ctx, inst))
+
+	download := func(limit int64) *httptest.ResponseRecorder {
+		exp, err := server.Export(testCtx(), &runtimev1.ExportRequest{
+			InstanceId: instanceID,
+			Format:     runtimev1.ExportFormat_EXPORT_FORMAT_CSV,
+			Request: &runtimev1.ExportRequest_MetricsViewToplistRequest{
+				MetricsViewToplistRequest: &runtimev1.MetricsViewToplistRequest{
+					InstanceId:      instanceID,
+					MetricsViewName: "ad_bids_metrics",
+					DimensionName:   "dom",
+					MeasureNames:    []string{"measure_0"},
+					Limit:           limit,
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		rec := httptest.NewRecorder()
+		server.downloadHandler(rec, httptest.NewRequest(http.MethodGet, exp.DownloadUrlPath, nil))
+		return rec
+	}
+
+	// A download within the limit is served
+	rec := download(1)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	// A download over max_result_rows is rejected
+	rec = download(10)
+	require.Equal(t, http.StatusTooManyRequests, rec.Code, rec.Body.String())
+	require.Contains(t, rec.Body.String(), "max_result_rows")
+
+	// So is a table export
+	rec = httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/v1/instances/"+instanceID+"/table/ad_bids/export/csv", nil).WithContext(testCtx())
+	server.ExportTable(rec, req, map[string]string{"instance_id": instanceID, "table_name": "ad_bids", "format": "csv"})
+	require.Equal(t, http.StatusTooManyRequests, rec.Code, rec.Body.String())
+}
@@ -1,13 +1,17 @@
 package server
 
 import (
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"os"
 	"path"
 
+	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/queries"
 	"github.com/rilldata/rill/runtime/server/auth"
 )
 
@@ -22,6 +26,11 @@ func (s *Server) ExportTable(w http.ResponseWriter, req *http.Request, pathParam
 		return
 	}
 
+	// The table is in the OLAP database of the node that runs the instance's controller
+	if s.forwardHTTP(w, req, pathParams["instance_id"]) {
+		return
+	}
+
 	var exportString string
 	switch pathParams["format"] {
 	case "csv":
@@ -37,19 +46,32 @@ func (s *Server) ExportTable(w http.ResponseWriter, req *http.Request, pathParam
 		return
 	}
 
+	// The export reads the whole table, so it's admitted like a table head without a limit
+	ctx, priority, release, err := s.runtime.AdmitQuery(withQuerySubject(req.Context()), pathParams["instance_id"], &queries.TableHead{TableName: pathParams["table_name"], Limit: math.MaxInt}, 0)
+	if err != nil {
+		if errors.As(err, &runtime.QueryLimitExceededError{}) {
+			http.Error(w, err.Error(), http.StatusTooManyRequests)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer release()
+
 	fileName := fmt.Sprintf("%s.%s", pathParams["table_name"], pathParams["format"])
 	filePath := path.Join(os.TempDir(), fileName)
 	defer os.Remove(filePath)
 
 	// select * from the table and write to the temp file (DuckDB only)
-	olap, release, err := s.runtime.OLAP(req.Context(), pathParams["instance_id"])
+	olap, releaseOLAP, err := s.runtime.OLAP(ctx, pathParams["instance_id"])
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	defer release()
-	err = olap.Exec(req.Context(), &drivers.Statement{
-		Query: fmt.Sprintf("COPY (SELECT * FROM %q) TO '%s' (%s)", pathParams["table_name"], filePath, exportString),
+	defer releaseOLAP()
+	err = olap.Exec(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("COPY (SELECT * FROM %q) TO '%s' (%s)", pathParams["table_name"], filePath, exportString),
+		Priority: priority,
 	})
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
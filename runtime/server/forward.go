@@ -0,0 +1,266 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// forwardedByHeader is set on requests forwarded from another node. Forwarded requests are always handled locally to prevent loops.
+const forwardedByHeader = "x-rill-forwarded-by"
+
+// forwardingUnaryServerInterceptor forwards requests for instances whose controllers run on another node to that node.
+func (s *Server) forwardingUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !forwardableMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		conn, err := s.peerConn(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if conn == nil {
+			return handler(ctx, req)
+		}
+
+		_, outType, err := methodTypes(info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		resp := outType.New().Interface()
+		err = conn.Invoke(s.forwardContext(ctx), info.FullMethod, req, resp)
+		if err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}
+}
+
+// forwardingStreamServerInterceptor is the streaming counterpart of forwardingUnaryServerInterceptor.
+// It only supports server-streaming methods, since it needs to read the request to determine the instance ID.
+func (s *Server) forwardingStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !forwardableMethod(info.FullMethod) || info.IsClientStream || !info.IsServerStream {
+			return handler(srv, ss)
+		}
+
+		inType, outType, err := methodTypes(info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		req := inType.New().Interface()
+		err = ss.RecvMsg(req)
+		if err != nil {
+			return err
+		}
+
+		conn, err := s.peerConn(ss.Context(), req)
+		if err != nil {
+			return err
+		}
+		if conn == nil {
+			return handler(srv, &replayServerStream{ServerStream: ss, req: req})
+		}
+
+		cs, err := conn.NewStream(s.forwardContext(ss.Context()), &grpc.StreamDesc{ServerStreams: true}, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		err = cs.SendMsg(req)
+		if err != nil {
+			return err
+		}
+		err = cs.CloseSend()
+		if err != nil {
+			return err
+		}
+
+		for {
+			resp := outType.New().Interface()
+			err := cs.RecvMsg(resp)
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				return err
+			}
+
+			err = ss.SendMsg(resp)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// peerConn returns a connection to the node that should handle the request.
+// It returns nil if the request should be handled by the current node.
+func (s *Server) peerConn(ctx context.Context, req interface{}) (*grpc.ClientConn, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if len(md.Get(forwardedByHeader)) > 0 {
+		return nil, nil
+	}
+
+	r, ok := req.(interface{ GetInstanceId() string })
+	if !ok || r.GetInstanceId() == "" {
+		return nil, nil
+	}
+
+	addr, local, err := s.runtime.ControllerNode(ctx, r.GetInstanceId())
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	if local {
+		return nil, nil
+	}
+
+	s.peersMu.Lock()
+	defer s.peersMu.Unlock()
+
+	conn, ok := s.peers[addr]
+	if ok {
+		return conn, nil
+	}
+
+	conn, err = grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, fmt.Sprintf("failed to connect to node at %q: %s", addr, err.Error()))
+	}
+	s.peers[addr] = conn
+	return conn, nil
+}
+
+// forwardHTTP proxies an HTTP request for an instance whose controller runs on another node to that node's HTTP server.
+// It's the counterpart of the forwarding interceptors for REST-only handlers that are not served through gRPC.
+// It returns false if the request should be handled by the current node.
+func (s *Server) forwardHTTP(w http.ResponseWriter, req *http.Request, instanceID string) bool {
+	if instanceID == "" || req.Header.Get(forwardedByHeader) != "" {
+		return false
+	}
+
+	addr, local, err := s.runtime.ControllerHTTPNode(req.Context(), instanceID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return true
+	}
+	if local {
+		return false
+	}
+
+	proxy := &httputil.ReverseProxy{
+		Rewrite: func(r *httputil.ProxyRequest) {
+			r.SetURL(&url.URL{Scheme: "http", Host: addr})
+			r.Out.Header.Set(forwardedByHeader, s.runtime.NodeID())
+		},
+	}
+	proxy.ServeHTTP(w, req)
+	return true
+}
+
+// forwardContext returns a context for forwarding a request to another node.
+// It passes on the incoming metadata (including the authorization header) and marks the request as forwarded.
+func (s *Server) forwardContext(ctx context.Context) context.Context {
+	in, _ := metadata.FromIncomingContext(ctx)
+	out := metadata.MD{}
+	for k, v := range in {
+		// Skip pseudo-headers like ":authority"
+		if strings.HasPrefix(k, ":") {
+			continue
+		}
+		out[k] = v
+	}
+	out.Set(forwardedByHeader, s.runtime.NodeID())
+	return metadata.NewOutgoingContext(ctx, out)
+}
+
+// forwardableMethod returns true for methods that should be handled by the node that runs the instance's controller.
+func forwardableMethod(fullMethod string) bool {
+	if strings.HasPrefix(fullMethod, "/rill.runtime.v1.QueryService/") {
+		return true
+	}
+
+	switch fullMethod {
+	case runtimev1.RuntimeService_GetLogs_FullMethodName,
+		runtimev1.RuntimeService_WatchLogs_FullMethodName,
+		runtimev1.RuntimeService_ListResources_FullMethodName,
+		runtimev1.RuntimeService_WatchResources_FullMethodName,
+		runtimev1.RuntimeService_GetResource_FullMethodName,
+		runtimev1.RuntimeService_GetColumnLineage_FullMethodName,
+		runtimev1.RuntimeService_PlanReconcile_FullMethodName,
+		runtimev1.RuntimeService_CreateTrigger_FullMethodName:
+		return true
+	}
+
+	return false
+}
+
+// methodTypes looks up the request and response message types of a gRPC method.
+func methodTypes(fullMethod string) (protoreflect.MessageType, protoreflect.MessageType, error) {
+	name := strings.TrimPrefix(fullMethod, "/")
+	idx := strings.LastIndex(name, "/")
+	if idx < 0 {
+		return nil, nil, fmt.Errorf("invalid method name %q", fullMethod)
+	}
+
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name[:idx]))
+	if err != nil {
+		return nil, nil, fmt.Errorf("service for method %q not found: %w", fullMethod, err)
+	}
+	svc, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid method name %q", fullMethod)
+	}
+	md := svc.Methods().ByName(protoreflect.Name(name[idx+1:]))
+	if md == nil {
+		return nil, nil, fmt.Errorf("method %q not found", fullMethod)
+	}
+
+	in, err := protoregistry.GlobalTypes.FindMessageByName(md.Input().FullName())
+	if err != nil {
+		return nil, nil, err
+	}
+	out, err := protoregistry.GlobalTypes.FindMessageByName(md.Output().FullName())
+	if err != nil {
+		return nil, nil, err
+	}
+	return in, out, nil
+}
+
+// replayServerStream is a grpc.ServerStream that returns an already received request on the first call to RecvMsg.
+type replayServerStream struct {
+	grpc.ServerStream
+	req      proto.Message
+	replayed bool
+}
+
+func (s *replayServerStream) RecvMsg(m interface{}) error {
+	if s.replayed {
+		return s.ServerStream.RecvMsg(m)
+	}
+	s.replayed = true
+
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", m)
+	}
+	proto.Merge(msg, s.req)
+	return nil
+}
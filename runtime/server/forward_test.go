@@ -0,0 +1,178 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	goruntime "runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/c2h5oh/datasize"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/ratelimit"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestForwardableMethod(t *testing.T) {
+	require.True(t, forwardableMethod(runtimev1.QueryService_MetricsViewAggregation_FullMethodName))
+	require.True(t, forwardableMethod(runtimev1.QueryService_QueryBatch_FullMethodName))
+	require.True(t, forwardableMethod(runtimev1.RuntimeService_GetResource_FullMethodName))
+	require.False(t, forwardableMethod(runtimev1.RuntimeService_CreateInstance_FullMethodName))
+	require.False(t, forwardableMethod(runtimev1.RuntimeService_Ping_FullMethodName))
+}
+
+func TestMethodTypes(t *testing.T) {
+	in, out, err := methodTypes(runtimev1.QueryService_MetricsViewToplist_FullMethodName)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(&runtimev1.MetricsViewToplistRequest{}, in.New().Interface()))
+	require.True(t, proto.Equal(&runtimev1.MetricsViewToplistResponse{}, out.New().Interface()))
+
+	_, _, err = methodTypes("/rill.runtime.v1.QueryService/Missing")
+	require.Error(t, err)
+
+	_, _, err = methodTypes("invalid")
+	require.Error(t, err)
+}
+
+func TestForwardDownload(t *testing.T) {
+	ctx := context.Background()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name())
+
+	// Node 1 owns the instance and has ingested its data
+	rt1, srv1, url1 := newTestNode(t, dsn, "node1")
+	_, currentFile, _, _ := goruntime.Caller(0)
+	inst := &drivers.Instance{
+		OLAPConnector: "duckdb",
+		RepoConnector: "repo",
+		EmbedCatalog:  true,
+		Connectors: []*runtimev1.Connector{
+			{
+				Type:   "file",
+				Name:   "repo",
+				Config: map[string]string{"dsn": filepath.Join(currentFile, "..", "..", "testruntime", "testdata", "ad_bids")},
+			},
+			{
+				Type:   "duckdb",
+				Name:   "duckdb",
+				Config: map[string]string{"dsn": "?access_mode=read_write"},
+			},
+		},
+	}
+	require.NoError(t, rt1.CreateInstance(ctx, inst))
+	res, err := rt1.Reconcile(ctx, inst.ID, nil, nil, false, false)
+	require.NoError(t, err)
+	require.Empty(t, res.Errors)
+
+	reg := rt1.Registry()
+	require.NoError(t, reg.UpsertNode(ctx, &drivers.Node{ID: "node1", Address: "localhost:0", HTTPAddress: strings.TrimPrefix(url1, "http://")}))
+	l, err := reg.AcquireInstanceLease(ctx, inst.ID, "node1", time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, "node1", l.NodeID)
+
+	// Node 2 shares the registry, but its OLAP database for the instance is empty
+	rt2, _, url2 := newTestNode(t, dsn, "node2")
+	cctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = rt2.RunControllers(cctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+	require.Eventually(t, func() bool {
+		_, local, err := rt2.ControllerHTTPNode(ctx, inst.ID)
+		return err == nil && !local
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// A download requested from node 2 is served by node 1
+	exp, err := srv1.Export(testCtx(), &runtimev1.ExportRequest{
+		InstanceId: inst.ID,
+		Format:     runtimev1.ExportFormat_EXPORT_FORMAT_CSV,
+		Request: &runtimev1.ExportRequest_MetricsViewToplistRequest{
+			MetricsViewToplistRequest: &runtimev1.MetricsViewToplistRequest{
+				InstanceId:      inst.ID,
+				MetricsViewName: "ad_bids_metrics",
+				DimensionName:   "dom",
+				MeasureNames:    []string{"measure_0"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	body, code := httpGet(t, url2+exp.DownloadUrlPath)
+	require.Equal(t, http.StatusOK, code, body)
+	require.True(t, strings.HasPrefix(body, "domain,measure_0"), body)
+	require.Contains(t, body, "sports.yahoo.com")
+
+	// So is a table export
+	body, code = httpGet(t, fmt.Sprintf("%s/v1/instances/%s/table/ad_bids/export/csv", url2, inst.ID))
+	require.Equal(t, http.StatusOK, code, body)
+	require.Contains(t, body, "sports.yahoo.com")
+
+	// Node 2 can't serve the download itself once it's already been forwarded
+	req, err := http.NewRequest(http.MethodGet, url2+exp.DownloadUrlPath, nil)
+	require.NoError(t, err)
+	req.Header.Set(forwardedByHeader, "node1")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+}
+
+// newTestNode creates a runtime and HTTP server that share the registry identified by dsn with other nodes.
+// It returns the base URL of the node's HTTP server.
+func newTestNode(t *testing.T, dsn, nodeID string) (*runtime.Runtime, *Server, string) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	rt, err := runtime.New(&runtime.Options{
+		ConnectionCacheSize:     100,
+		MetastoreConnector:      "metastore",
+		QueryCacheSizeBytes:     int64(datasize.MB * 100),
+		AllowHostAccess:         true,
+		SecurityEngineCacheSize: 100,
+		SystemConnectors: []*runtimev1.Connector{
+			{Type: "sqlite", Name: "metastore", Config: map[string]string{"dsn": dsn}},
+		},
+		NodeID:          nodeID,
+		NodeHTTPAddress: ln.Addr().String(),
+	}, zap.NewNop(), activity.NewNoopClient())
+	require.NoError(t, err)
+	t.Cleanup(func() { rt.Close() })
+
+	srv, err := NewServer(context.Background(), &Options{}, rt, zap.NewNop(), ratelimit.NewNoop(), activity.NewNoopClient())
+	require.NoError(t, err)
+
+	handler, err := srv.HTTPHandler(context.Background(), nil)
+	require.NoError(t, err)
+
+	hs := httptest.NewUnstartedServer(handler)
+	hs.Listener.Close()
+	hs.Listener = ln
+	hs.Start()
+	t.Cleanup(hs.Close)
+
+	return rt, srv, hs.URL
+}
+
+func httpGet(t *testing.T, u string) (string, int) {
+	resp, err := http.Get(u)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(data), resp.StatusCode
+}
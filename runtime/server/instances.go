@@ -80,6 +80,7 @@ func (s *Server) CreateInstance(ctx context.Context, req *runtimev1.CreateInstan
 		EmbedCatalog:        req.EmbedCatalog,
 		Variables:           req.Variables,
 		IngestionLimitBytes: req.IngestionLimitBytes,
+		QueryLimits:         req.QueryLimits,
 		Annotations:         req.Annotations,
 		Connectors:          req.Connectors,
 	}
@@ -130,6 +131,7 @@ func (s *Server) EditInstance(ctx context.Context, req *runtimev1.EditInstanceRe
 		EmbedCatalog:        valOrDefault(req.EmbedCatalog, oldInst.EmbedCatalog),
 		Variables:           oldInst.Variables,
 		IngestionLimitBytes: valOrDefault(req.IngestionLimitBytes, oldInst.IngestionLimitBytes),
+		QueryLimits:         oldInst.QueryLimits,
 		Annotations:         annotations,
 	}
 	if len(req.Connectors) == 0 {
@@ -137,6 +139,9 @@ func (s *Server) EditInstance(ctx context.Context, req *runtimev1.EditInstanceRe
 	} else {
 		inst.Connectors = req.Connectors
 	}
+	if req.QueryLimits != nil {
+		inst.QueryLimits = req.QueryLimits
+	}
 
 	err = s.runtime.EditInstance(ctx, inst)
 	if err != nil {
@@ -169,6 +174,7 @@ func (s *Server) EditInstanceVariables(ctx context.Context, req *runtimev1.EditI
 		RepoConnector:       oldInst.RepoConnector,
 		EmbedCatalog:        oldInst.EmbedCatalog,
 		IngestionLimitBytes: oldInst.IngestionLimitBytes,
+		QueryLimits:         oldInst.QueryLimits,
 		Variables:           req.Variables,
 		Annotations:         oldInst.Annotations,
 		Connectors:          oldInst.Connectors,
@@ -205,6 +211,7 @@ func (s *Server) EditInstanceAnnotations(ctx context.Context, req *runtimev1.Edi
 		RepoConnector:       oldInst.RepoConnector,
 		EmbedCatalog:        oldInst.EmbedCatalog,
 		IngestionLimitBytes: oldInst.IngestionLimitBytes,
+		QueryLimits:         oldInst.QueryLimits,
 		Variables:           oldInst.Variables,
 		Connectors:          oldInst.Connectors,
 		Annotations:         req.Annotations,
@@ -251,6 +258,7 @@ func instanceToPB(inst *drivers.Instance) *runtimev1.Instance {
 		ProjectVariables:    inst.ProjectVariables,
 		IngestionLimitBytes: inst.IngestionLimitBytes,
 		Connectors:          inst.Connectors,
+		QueryLimits:         inst.QueryLimits,
 	}
 }
 
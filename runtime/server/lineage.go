@@ -0,0 +1,142 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetColumnLineage implements runtimev1.RuntimeServiceServer
+func (s *Server) GetColumnLineage(ctx context.Context, req *runtimev1.GetColumnLineageRequest) (*runtimev1.GetColumnLineageResponse, error) {
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.name.kind", req.Name.GetKind()),
+		attribute.String("args.name.name", req.Name.GetName()),
+		attribute.String("args.column", req.Column),
+	)
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.ReadObjects) {
+		return nil, ErrForbidden
+	}
+
+	if req.Name == nil || req.Column == "" {
+		return nil, status.Error(codes.InvalidArgument, "name and column must be provided")
+	}
+
+	ctrl, err := s.runtime.Controller(req.InstanceId)
+	if err != nil {
+		return nil, controllerError(err)
+	}
+
+	rs, err := ctrl.List(ctx, "", false)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Only include resources the caller has access to
+	accessible := make([]*runtimev1.Resource, 0, len(rs))
+	for _, r := range rs {
+		r, access, err := s.applySecurityPolicy(ctx, req.InstanceId, r)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if access {
+			accessible = append(accessible, r)
+		}
+	}
+
+	upstream, downstream := columnLineage(accessible, req.Name, req.Column)
+	return &runtimev1.GetColumnLineageResponse{
+		Upstream:   upstream,
+		Downstream: downstream,
+	}, nil
+}
+
+// columnLineage computes the transitive upstream and downstream columns of a column from the lineage stored on the resources' states.
+func columnLineage(rs []*runtimev1.Resource, name *runtimev1.ResourceName, column string) ([]*runtimev1.LineageColumn, []*runtimev1.LineageColumn) {
+	// Index the edges in both directions
+	up := make(map[string][]*runtimev1.LineageColumn)
+	down := make(map[string][]*runtimev1.LineageColumn)
+	for _, r := range rs {
+		var lineage []*runtimev1.ColumnLineage
+		switch r := r.Resource.(type) {
+		case *runtimev1.Resource_Model:
+			lineage = r.Model.State.GetColumnLineage()
+		case *runtimev1.Resource_MetricsView:
+			lineage = r.MetricsView.State.GetColumnLineage()
+		}
+
+		for _, l := range lineage {
+			self := &runtimev1.LineageColumn{Resource: r.Meta.Name, Column: l.Name}
+			for _, u := range l.Upstream {
+				up[lineageKey(self)] = append(up[lineageKey(self)], u)
+				down[lineageKey(u)] = append(down[lineageKey(u)], self)
+			}
+		}
+	}
+
+	start := &runtimev1.LineageColumn{Resource: name, Column: column}
+
+	// A column named "*" passes through all columns of its upstream resources with the same name
+	upstream := walkLineage(start, func(c *runtimev1.LineageColumn) []*runtimev1.LineageColumn {
+		return withStars(c, up)
+	})
+	downstream := walkLineage(start, func(c *runtimev1.LineageColumn) []*runtimev1.LineageColumn {
+		return withStars(c, down)
+	})
+
+	return upstream, downstream
+}
+
+// withStars returns the edges of a column, including edges of the "*" column of its resource.
+// Edges from "*" to "*" are resolved to the column's name.
+func withStars(c *runtimev1.LineageColumn, edges map[string][]*runtimev1.LineageColumn) []*runtimev1.LineageColumn {
+	res := append([]*runtimev1.LineageColumn{}, edges[lineageKey(c)]...)
+	if c.Column == "*" {
+		return res
+	}
+	for _, e := range edges[lineageKey(&runtimev1.LineageColumn{Resource: c.Resource, Column: "*"})] {
+		if e.Column == "*" {
+			e = &runtimev1.LineageColumn{Resource: e.Resource, Column: c.Column}
+		}
+		res = append(res, e)
+	}
+	return res
+}
+
+// walkLineage returns the columns reachable from start (excluding start itself) in a stable order.
+func walkLineage(start *runtimev1.LineageColumn, next func(c *runtimev1.LineageColumn) []*runtimev1.LineageColumn) []*runtimev1.LineageColumn {
+	seen := map[string]bool{lineageKey(start): true}
+	queue := []*runtimev1.LineageColumn{start}
+	var res []*runtimev1.LineageColumn
+	for len(queue) > 0 {
+		c := queue[0]
+		queue = queue[1:]
+		for _, n := range next(c) {
+			k := lineageKey(n)
+			if seen[k] {
+				continue
+			}
+			seen[k] = true
+			res = append(res, n)
+			queue = append(queue, n)
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return lineageKey(res[i]) < lineageKey(res[j])
+	})
+	return res
+}
+
+func lineageKey(c *runtimev1.LineageColumn) string {
+	return strings.ToLower(c.Resource.GetKind() + "/" + c.Resource.GetName() + "/" + c.Column)
+}
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestColumnLineage(t *testing.T) {
+	src := &runtimev1.ResourceName{Kind: runtime.ResourceKindSource, Name: "orders"}
+	raw := &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "raw_orders"}
+	mdl := &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "daily_orders"}
+	mv := &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: "orders_dashboard"}
+
+	col := func(n *runtimev1.ResourceName, c string) *runtimev1.LineageColumn {
+		return &runtimev1.LineageColumn{Resource: n, Column: c}
+	}
+
+	rs := []*runtimev1.Resource{
+		{
+			Meta:     &runtimev1.ResourceMeta{Name: src},
+			Resource: &runtimev1.Resource_Source{Source: &runtimev1.SourceV2{State: &runtimev1.SourceState{}}},
+		},
+		{
+			Meta: &runtimev1.ResourceMeta{Name: raw},
+			Resource: &runtimev1.Resource_Model{Model: &runtimev1.ModelV2{State: &runtimev1.ModelState{ColumnLineage: []*runtimev1.ColumnLineage{
+				{Name: "*", Upstream: []*runtimev1.LineageColumn{col(src, "*")}},
+			}}}},
+		},
+		{
+			Meta: &runtimev1.ResourceMeta{Name: mdl},
+			Resource: &runtimev1.Resource_Model{Model: &runtimev1.ModelV2{State: &runtimev1.ModelState{ColumnLineage: []*runtimev1.ColumnLineage{
+				{Name: "day", Upstream: []*runtimev1.LineageColumn{col(raw, "created_on")}},
+				{Name: "revenue", Upstream: []*runtimev1.LineageColumn{col(raw, "amount"), col(raw, "discount")}},
+			}}}},
+		},
+		{
+			Meta: &runtimev1.ResourceMeta{Name: mv},
+			Resource: &runtimev1.Resource_MetricsView{MetricsView: &runtimev1.MetricsViewV2{State: &runtimev1.MetricsViewState{ColumnLineage: []*runtimev1.ColumnLineage{
+				{Name: "total_revenue", Upstream: []*runtimev1.LineageColumn{col(mdl, "revenue")}},
+				{Name: "avg_revenue", Upstream: []*runtimev1.LineageColumn{col(mv, "total_revenue")}},
+				{Name: "day", Upstream: []*runtimev1.LineageColumn{col(mdl, "day")}},
+			}}}},
+		},
+	}
+
+	up, down := columnLineage(rs, mv, "avg_revenue")
+	require.Equal(t, []*runtimev1.LineageColumn{
+		col(mv, "total_revenue"),
+		col(mdl, "revenue"),
+		col(raw, "amount"),
+		col(raw, "discount"),
+		col(src, "amount"),
+		col(src, "discount"),
+	}, up)
+	require.Empty(t, down)
+
+	up, down = columnLineage(rs, src, "amount")
+	require.Empty(t, up)
+	require.Equal(t, []*runtimev1.LineageColumn{
+		col(mv, "avg_revenue"),
+		col(mv, "total_revenue"),
+		col(mdl, "revenue"),
+		col(raw, "amount"),
+	}, down)
+
+	up, _ = columnLineage(rs, mdl, "day")
+	require.Equal(t, []*runtimev1.LineageColumn{
+		col(raw, "created_on"),
+		col(src, "created_on"),
+	}, up)
+}
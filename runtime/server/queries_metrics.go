@@ -12,6 +12,7 @@ import (
 	"github.com/rilldata/rill/runtime/queries"
 	"github.com/rilldata/rill/runtime/server/auth"
 	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -52,8 +53,10 @@ func (s *Server) MetricsViewAggregation(ctx context.Context, req *runtimev1.Metr
 		}
 	}
 
+	exempt := []string{mv.TimeDimension}
 	for _, m := range req.Measures {
 		if m.BuiltinMeasure != runtimev1.BuiltinMeasure_BUILTIN_MEASURE_UNSPECIFIED {
+			exempt = append(exempt, m.Name)
 			continue
 		}
 		if !checkFieldAccess(m.Name, security) {
@@ -61,6 +64,10 @@ func (s *Server) MetricsViewAggregation(ctx context.Context, req *runtimev1.Metr
 		}
 	}
 
+	if !checkExpressionFieldAccess(req.Having, security, exempt...) {
+		return nil, errHavingForbidden
+	}
+
 	q := &queries.MetricsViewAggregation{
 		MetricsViewName:    req.MetricsView,
 		Dimensions:         req.Dimensions,
@@ -69,6 +76,8 @@ func (s *Server) MetricsViewAggregation(ctx context.Context, req *runtimev1.Metr
 		TimeStart:          req.TimeStart,
 		TimeEnd:            req.TimeEnd,
 		Filter:             req.Filter,
+		Where:              req.Where,
+		Having:             req.Having,
 		Limit:              &req.Limit,
 		Offset:             req.Offset,
 		MetricsView:        mv,
@@ -126,6 +135,10 @@ func (s *Server) MetricsViewToplist(ctx context.Context, req *runtimev1.MetricsV
 		return nil, err
 	}
 
+	if !checkExpressionFieldAccess(req.Having, security, inlineMeasureNames(req.InlineMeasures)...) {
+		return nil, errHavingForbidden
+	}
+
 	q := &queries.MetricsViewToplist{
 		MetricsViewName:    req.MetricsViewName,
 		DimensionName:      req.DimensionName,
@@ -137,6 +150,8 @@ func (s *Server) MetricsViewToplist(ctx context.Context, req *runtimev1.MetricsV
 		Offset:             req.Offset,
 		Sort:               req.Sort,
 		Filter:             req.Filter,
+		Where:              req.Where,
+		Having:             req.Having,
 		MetricsView:        mv,
 		ResolvedMVSecurity: security,
 	}
@@ -199,6 +214,10 @@ func (s *Server) MetricsViewComparisonToplist(ctx context.Context, req *runtimev
 		return nil, err
 	}
 
+	if !checkExpressionFieldAccess(req.Having, security, inlineMeasureNames(req.InlineMeasures)...) {
+		return nil, errHavingForbidden
+	}
+
 	q := &queries.MetricsViewComparisonToplist{
 		MetricsViewName:     req.MetricsViewName,
 		DimensionName:       req.DimensionName,
@@ -210,6 +229,8 @@ func (s *Server) MetricsViewComparisonToplist(ctx context.Context, req *runtimev
 		Offset:              req.Offset,
 		Sort:                req.Sort,
 		Filter:              req.Filter,
+		Where:               req.Where,
+		Having:              req.Having,
 		MetricsView:         mv,
 		ResolvedMVSecurity:  security,
 	}
@@ -266,6 +287,7 @@ func (s *Server) MetricsViewTimeSeries(ctx context.Context, req *runtimev1.Metri
 		TimeEnd:            req.TimeEnd,
 		TimeGranularity:    req.TimeGranularity,
 		Filter:             req.Filter,
+		Where:              req.Where,
 		TimeZone:           req.TimeZone,
 		MetricsView:        mv,
 		ResolvedMVSecurity: security,
@@ -320,6 +342,7 @@ func (s *Server) MetricsViewTotals(ctx context.Context, req *runtimev1.MetricsVi
 		TimeStart:          req.TimeStart,
 		TimeEnd:            req.TimeEnd,
 		Filter:             req.Filter,
+		Where:              req.Where,
 		MetricsView:        mv,
 		ResolvedMVSecurity: security,
 	}
@@ -364,6 +387,7 @@ func (s *Server) MetricsViewRows(ctx context.Context, req *runtimev1.MetricsView
 		TimeEnd:            req.TimeEnd,
 		TimeGranularity:    req.TimeGranularity,
 		Filter:             req.Filter,
+		Where:              req.Where,
 		Sort:               req.Sort,
 		Limit:              &limit,
 		Offset:             req.Offset,
@@ -411,6 +435,14 @@ func (s *Server) MetricsViewTimeRange(ctx context.Context, req *runtimev1.Metric
 	return q.Result, nil
 }
 
+func inlineMeasureNames(ms []*runtimev1.InlineMeasure) []string {
+	res := make([]string, len(ms))
+	for i, m := range ms {
+		res[i] = m.Name
+	}
+	return res
+}
+
 // inlineMeasureRegexp is used by validateInlineMeasures.
 var inlineMeasureRegexp = regexp.MustCompile(`(?i)^COUNT\((DISTINCT)? *.+\)$`)
 
@@ -474,27 +506,25 @@ func lookupMetricsView(ctx context.Context, rt *runtime.Runtime, instanceID, nam
 	return mv, obj.UpdatedOn, nil
 }
 
+// errHavingForbidden is returned when a having expression references a dimension or measure that the security policy hides.
+var errHavingForbidden = status.Error(codes.PermissionDenied, "having references a dimension or measure that is not accessible")
+
 func checkFieldAccess(field string, policy *runtime.ResolvedMetricsViewSecurity) bool {
-	if policy != nil {
-		if !policy.Access {
-			return false
-		}
+	return policy.CanAccessField(field)
+}
 
-		if len(policy.Include) > 0 {
-			for _, include := range policy.Include {
-				if include == field {
-					return true
-				}
-			}
-		} else if len(policy.Exclude) > 0 {
-			for _, exclude := range policy.Exclude {
-				if exclude == field {
-					return false
-				}
-			}
-		} else {
-			// if no include/exclude is specified, then all fields are allowed
-			return true
+// checkExpressionFieldAccess checks access to every dimension and measure referenced in an expression.
+// Names in exempt (e.g. the time dimension or aliases of builtin measures) are not checked.
+func checkExpressionFieldAccess(expr *runtimev1.Expression, policy *runtime.ResolvedMetricsViewSecurity, exempt ...string) bool {
+	if expr == nil {
+		return true
+	}
+	if ident := expr.GetIdent(); ident != "" {
+		return slices.Contains(exempt, ident) || checkFieldAccess(ident, policy)
+	}
+	for _, e := range expr.GetCond().GetExprs() {
+		if !checkExpressionFieldAccess(e, policy, exempt...) {
+			return false
 		}
 	}
 	return true
@@ -5,6 +5,7 @@ import (
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func TestMetricsViewAggregation_Toplist(t *testing.T) {
@@ -107,3 +108,55 @@ func TestMetricsViewAggregation_Timeseries(t *testing.T) {
 	require.Equal(t, 1.0, tr.Data[1].Fields["measure_0"].GetNumberValue())
 	require.Equal(t, 1.0, tr.Data[1].Fields["measure_2"].GetNumberValue())
 }
+
+func TestMetricsViewAggregation_having(t *testing.T) {
+	t.Parallel()
+	server, instanceId := getMetricsTestServer(t, "ad_bids_2rows")
+
+	tr, err := server.MetricsViewAggregation(testCtx(), &runtimev1.MetricsViewAggregationRequest{
+		InstanceId:  instanceId,
+		MetricsView: "ad_bids_metrics",
+		Dimensions: []*runtimev1.MetricsViewAggregationDimension{
+			{Name: "domain"},
+		},
+		Measures: []*runtimev1.MetricsViewAggregationMeasure{
+			{Name: "measure_2"},
+			{Name: "__count", BuiltinMeasure: runtimev1.BuiltinMeasure_BUILTIN_MEASURE_COUNT},
+		},
+		Having: &runtimev1.Expression{
+			Expression: &runtimev1.Expression_Cond{
+				Cond: &runtimev1.Condition{
+					Op: runtimev1.Operation_OPERATION_AND,
+					Exprs: []*runtimev1.Expression{
+						{
+							Expression: &runtimev1.Expression_Cond{
+								Cond: &runtimev1.Condition{
+									Op: runtimev1.Operation_OPERATION_LT,
+									Exprs: []*runtimev1.Expression{
+										{Expression: &runtimev1.Expression_Ident{Ident: "measure_2"}},
+										{Expression: &runtimev1.Expression_Val{Val: structpb.NewNumberValue(2)}},
+									},
+								},
+							},
+						},
+						{
+							Expression: &runtimev1.Expression_Cond{
+								Cond: &runtimev1.Condition{
+									Op: runtimev1.Operation_OPERATION_EQ,
+									Exprs: []*runtimev1.Expression{
+										{Expression: &runtimev1.Expression_Ident{Ident: "__count"}},
+										{Expression: &runtimev1.Expression_Val{Val: structpb.NewNumberValue(1)}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tr.Data))
+	require.Equal(t, "yahoo.com", tr.Data[0].Fields["domain"].GetStringValue())
+	require.Equal(t, 1.0, tr.Data[0].Fields["measure_2"].GetNumberValue())
+}
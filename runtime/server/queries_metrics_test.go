@@ -4,7 +4,9 @@ import (
 	"testing"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func TestInlineMeasureValidation(t *testing.T) {
@@ -14,3 +16,31 @@ func TestInlineMeasureValidation(t *testing.T) {
 	require.NoError(t, validateInlineMeasures([]*runtimev1.InlineMeasure{{Expression: "count(distinct my_dim)"}}))
 	require.Error(t, validateInlineMeasures([]*runtimev1.InlineMeasure{{Expression: "SUM(my_dim)"}}))
 }
+
+func TestCheckExpressionFieldAccess(t *testing.T) {
+	having := &runtimev1.Expression{Expression: &runtimev1.Expression_Cond{Cond: &runtimev1.Condition{
+		Op: runtimev1.Operation_OPERATION_AND,
+		Exprs: []*runtimev1.Expression{
+			{Expression: &runtimev1.Expression_Cond{Cond: &runtimev1.Condition{
+				Op: runtimev1.Operation_OPERATION_GT,
+				Exprs: []*runtimev1.Expression{
+					{Expression: &runtimev1.Expression_Ident{Ident: "measure_0"}},
+					{Expression: &runtimev1.Expression_Val{Val: structpb.NewNumberValue(1)}},
+				},
+			}}},
+			{Expression: &runtimev1.Expression_Cond{Cond: &runtimev1.Condition{
+				Op: runtimev1.Operation_OPERATION_GT,
+				Exprs: []*runtimev1.Expression{
+					{Expression: &runtimev1.Expression_Ident{Ident: "measure_2"}},
+					{Expression: &runtimev1.Expression_Val{Val: structpb.NewNumberValue(1)}},
+				},
+			}}},
+		},
+	}}}
+
+	require.True(t, checkExpressionFieldAccess(having, nil))
+	require.True(t, checkExpressionFieldAccess(having, &runtime.ResolvedMetricsViewSecurity{Access: true}))
+	require.False(t, checkExpressionFieldAccess(having, &runtime.ResolvedMetricsViewSecurity{Access: true, Exclude: []string{"measure_2"}}))
+	require.False(t, checkExpressionFieldAccess(having, &runtime.ResolvedMetricsViewSecurity{Access: true, Include: []string{"measure_0"}}))
+	require.True(t, checkExpressionFieldAccess(having, &runtime.ResolvedMetricsViewSecurity{Access: true, Include: []string{"measure_0"}}, "measure_2"))
+}
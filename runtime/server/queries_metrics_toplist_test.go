@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/queries"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/structpb"
 )
@@ -379,3 +381,126 @@ func TestServer_MetricsViewToplist_DimensionsByName(t *testing.T) {
 	require.True(t, len(tr.Data) > 1)
 	require.Equal(t, 2, len(tr.Data[0].Fields))
 }
+
+func TestServer_MetricsViewToplist_where_having(t *testing.T) {
+	t.Parallel()
+	server, instanceId := getMetricsTestServer(t, "ad_bids_2rows")
+
+	tr, err := server.MetricsViewToplist(testCtx(), &runtimev1.MetricsViewToplistRequest{
+		InstanceId:      instanceId,
+		MetricsViewName: "ad_bids_metrics",
+		DimensionName:   "domain",
+		MeasureNames:    []string{"measure_2"},
+		Sort: []*runtimev1.MetricsViewSort{
+			{
+				Name: "measure_2",
+			},
+		},
+		Where: &runtimev1.Expression{
+			Expression: &runtimev1.Expression_Cond{
+				Cond: &runtimev1.Condition{
+					Op: runtimev1.Operation_OPERATION_OR,
+					Exprs: []*runtimev1.Expression{
+						{
+							Expression: &runtimev1.Expression_Cond{
+								Cond: &runtimev1.Condition{
+									Op: runtimev1.Operation_OPERATION_EQ,
+									Exprs: []*runtimev1.Expression{
+										{Expression: &runtimev1.Expression_Ident{Ident: "publisher"}},
+										{Expression: &runtimev1.Expression_Val{Val: structpb.NewStringValue("Yahoo")}},
+									},
+								},
+							},
+						},
+						{
+							Expression: &runtimev1.Expression_Cond{
+								Cond: &runtimev1.Condition{
+									Op: runtimev1.Operation_OPERATION_LIKE,
+									Exprs: []*runtimev1.Expression{
+										{Expression: &runtimev1.Expression_Ident{Ident: "domain"}},
+										{Expression: &runtimev1.Expression_Val{Val: structpb.NewStringValue("MSN%")}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Having: &runtimev1.Expression{
+			Expression: &runtimev1.Expression_Cond{
+				Cond: &runtimev1.Condition{
+					Op: runtimev1.Operation_OPERATION_GT,
+					Exprs: []*runtimev1.Expression{
+						{Expression: &runtimev1.Expression_Ident{Ident: "measure_2"}},
+						{Expression: &runtimev1.Expression_Val{Val: structpb.NewNumberValue(1)}},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tr.Data))
+	require.Equal(t, "msn.com", tr.Data[0].Fields["domain"].GetStringValue())
+	require.Equal(t, 2.0, tr.Data[0].Fields["measure_2"].GetNumberValue())
+}
+
+func TestServer_MetricsViewToplist_filter_and_where(t *testing.T) {
+	t.Parallel()
+	server, instanceId := getMetricsTestServer(t, "ad_bids_2rows")
+
+	_, err := server.MetricsViewToplist(testCtx(), &runtimev1.MetricsViewToplistRequest{
+		InstanceId:      instanceId,
+		MetricsViewName: "ad_bids_metrics",
+		DimensionName:   "domain",
+		MeasureNames:    []string{"measure_2"},
+		Filter: &runtimev1.MetricsViewFilter{
+			Include: []*runtimev1.MetricsViewFilter_Cond{
+				{
+					Name: "domain",
+					In:   []*structpb.Value{structpb.NewStringValue("msn.com")},
+				},
+			},
+		},
+		Where: &runtimev1.Expression{
+			Expression: &runtimev1.Expression_Cond{
+				Cond: &runtimev1.Condition{
+					Op: runtimev1.Operation_OPERATION_NEQ,
+					Exprs: []*runtimev1.Expression{
+						{Expression: &runtimev1.Expression_Ident{Ident: "publisher"}},
+						{Expression: &runtimev1.Expression_Val{Val: structpb.NewNullValue()}},
+					},
+				},
+			},
+		},
+	})
+	require.ErrorContains(t, err, "both filter and where")
+}
+
+func TestServer_MetricsViewToplist_having_restricted_measure(t *testing.T) {
+	t.Parallel()
+	server, instanceId := getMetricsTestServer(t, "ad_bids_2rows")
+
+	ctx := testCtx()
+	mv, _, err := resolveMVAndSecurity(ctx, server.runtime, instanceId, "ad_bids_metrics")
+	require.NoError(t, err)
+
+	limit := int64(250)
+	q := &queries.MetricsViewToplist{
+		MetricsViewName: "ad_bids_metrics",
+		DimensionName:   "domain",
+		MeasureNames:    []string{"measure_0"},
+		Limit:           &limit,
+		Having: &runtimev1.Expression{Expression: &runtimev1.Expression_Cond{Cond: &runtimev1.Condition{
+			Op: runtimev1.Operation_OPERATION_GT,
+			Exprs: []*runtimev1.Expression{
+				{Expression: &runtimev1.Expression_Ident{Ident: "measure_2"}},
+				{Expression: &runtimev1.Expression_Val{Val: structpb.NewNumberValue(1)}},
+			},
+		}}},
+		MetricsView:        mv,
+		ResolvedMVSecurity: &runtime.ResolvedMetricsViewSecurity{Access: true, Exclude: []string{"measure_2"}},
+	}
+	err = q.Resolve(ctx, server.runtime, instanceId, 0)
+	require.ErrorContains(t, err, "measure or dimension measure_2 not found")
+}
@@ -586,3 +586,48 @@ func TestServer_MetricsViewTotals_1dim_include_and_exclude_in_and_like(t *testin
 	require.Equal(t, 1, len(tr.Data.Fields))
 	require.Equal(t, 0.0, tr.Data.Fields["measure_0"].GetNumberValue())
 }
+
+func TestServer_MetricsViewTotals_where(t *testing.T) {
+	t.Parallel()
+	server, instanceId := getMetricsTestServer(t, "ad_bids_2rows")
+
+	tr, err := server.MetricsViewTotals(testCtx(), &runtimev1.MetricsViewTotalsRequest{
+		InstanceId:      instanceId,
+		MetricsViewName: "ad_bids_metrics",
+		MeasureNames:    []string{"measure_0"},
+		Where: &runtimev1.Expression{
+			Expression: &runtimev1.Expression_Cond{
+				Cond: &runtimev1.Condition{
+					Op: runtimev1.Operation_OPERATION_AND,
+					Exprs: []*runtimev1.Expression{
+						{
+							Expression: &runtimev1.Expression_Cond{
+								Cond: &runtimev1.Condition{
+									Op: runtimev1.Operation_OPERATION_EQ,
+									Exprs: []*runtimev1.Expression{
+										{Expression: &runtimev1.Expression_Ident{Ident: "device"}},
+										{Expression: &runtimev1.Expression_Val{Val: structpb.NewNullValue()}},
+									},
+								},
+							},
+						},
+						{
+							Expression: &runtimev1.Expression_Cond{
+								Cond: &runtimev1.Condition{
+									Op: runtimev1.Operation_OPERATION_GTE,
+									Exprs: []*runtimev1.Expression{
+										{Expression: &runtimev1.Expression_Ident{Ident: "numeric_dim"}},
+										{Expression: &runtimev1.Expression_Val{Val: structpb.NewNumberValue(1)}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tr.Data.Fields))
+	require.Equal(t, 1.0, tr.Data.Fields["measure_0"].GetNumberValue())
+}
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	grpc_validator "github.com/grpc-ecosystem/go-grpc-middleware/validator"
 	gateway "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -53,6 +55,9 @@ type Server struct {
 	limiter ratelimit.Limiter
 	// activity is used for usage tracking
 	activity activity.Client
+	// peers caches connections to other nodes for forwarding requests
+	peersMu sync.Mutex
+	peers   map[string]*grpc.ClientConn
 }
 
 var (
@@ -70,6 +75,7 @@ func NewServer(ctx context.Context, opts *Options, rt *runtime.Runtime, logger *
 		logger:   logger,
 		limiter:  limiter,
 		activity: activityClient,
+		peers:    make(map[string]*grpc.ClientConn),
 	}
 
 	if opts.AuthEnable {
@@ -91,6 +97,13 @@ func (s *Server) Close() error {
 		s.aud.Close()
 	}
 
+	s.peersMu.Lock()
+	for _, conn := range s.peers {
+		_ = conn.Close()
+	}
+	s.peers = make(map[string]*grpc.ClientConn)
+	s.peersMu.Unlock()
+
 	err := s.activity.Close()
 
 	return err
@@ -115,8 +128,10 @@ func (s *Server) ServeGRPC(ctx context.Context) error {
 			errorMappingStreamServerInterceptor(),
 			grpc_validator.StreamServerInterceptor(),
 			auth.StreamServerInterceptor(s.aud),
+			querySubjectStreamServerInterceptor(),
 			middleware.ActivityStreamServerInterceptor(s.activity),
 			grpc_auth.StreamServerInterceptor(s.checkRateLimit),
+			s.forwardingStreamServerInterceptor(),
 		),
 		grpc.ChainUnaryInterceptor(
 			middleware.TimeoutUnaryServerInterceptor(timeoutSelector),
@@ -125,8 +140,10 @@ func (s *Server) ServeGRPC(ctx context.Context) error {
 			errorMappingUnaryServerInterceptor(),
 			grpc_validator.UnaryServerInterceptor(),
 			auth.UnaryServerInterceptor(s.aud),
+			querySubjectUnaryServerInterceptor(),
 			middleware.ActivityUnaryServerInterceptor(s.activity),
 			grpc_auth.UnaryServerInterceptor(s.checkRateLimit),
+			s.forwardingUnaryServerInterceptor(),
 		),
 	)
 
@@ -288,6 +305,31 @@ func errorMappingStreamServerInterceptor() grpc.StreamServerInterceptor {
 	}
 }
 
+// querySubjectUnaryServerInterceptor attributes the queries of a request to the caller. It must run after the auth interceptor.
+func querySubjectUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(withQuerySubject(ctx), req)
+	}
+}
+
+// querySubjectStreamServerInterceptor attributes the queries of a request to the caller. It must run after the auth interceptor.
+func querySubjectStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = withQuerySubject(ss.Context())
+		return handler(srv, wrapped)
+	}
+}
+
+// withQuerySubject sets the subject of the claims in ctx as the subject of the runtime's per-user query limits.
+func withQuerySubject(ctx context.Context) context.Context {
+	claims := auth.GetClaims(ctx)
+	if claims == nil {
+		return ctx
+	}
+	return runtime.WithQuerySubject(ctx, claims.Subject())
+}
+
 // mapGRPCError rewrites errors returned from gRPC handlers before they are returned to the client.
 func mapGRPCError(err error) error {
 	if err == nil {
@@ -299,6 +341,9 @@ func mapGRPCError(err error) error {
 	if errors.Is(err, context.Canceled) {
 		return status.Error(codes.Canceled, err.Error())
 	}
+	if errors.As(err, &runtime.QueryLimitExceededError{}) {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
 	return err
 }
 
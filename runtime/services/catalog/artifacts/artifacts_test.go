@@ -166,6 +166,72 @@ measures:
       expression: avg(c1)
       description: Mea1_D
       format_preset: humanise
+`,
+		},
+		{
+			"DerivedMeasures",
+			&drivers.CatalogEntry{
+				Name: "DerivedMeasures",
+				Path: "dashboards/DerivedMeasures.yaml",
+				Type: drivers.ObjectTypeMetricsView,
+				Object: &runtimev1.MetricsView{
+					Name:          "DerivedMeasures",
+					Model:         "Model",
+					TimeDimension: "time",
+					Measures: []*runtimev1.MetricsView_Measure{
+						{
+							Name:       "revenue",
+							Expression: "sum(price)",
+						},
+						{
+							Name:               "revenue_per_order",
+							Expression:         "revenue / count(*)",
+							ReferencedMeasures: []string{"revenue"},
+						},
+						{
+							Name:               "revenue_7d",
+							Expression:         "sum(revenue)",
+							ReferencedMeasures: []string{"revenue"},
+							Window: &runtimev1.MetricsView_MeasureWindow{
+								Partition: true,
+								Frame:     "ROWS BETWEEN 6 PRECEDING AND CURRENT ROW",
+							},
+						},
+					},
+				},
+			},
+			`title: ""
+description: ""
+model: Model
+timeseries: time
+smallest_time_grain: ""
+default_time_range: ""
+first_day_of_week: 0
+first_month_of_year: 0
+dimensions: []
+measures:
+    - label: ""
+      name: revenue
+      expression: sum(price)
+      description: ""
+      format_preset: ""
+    - label: ""
+      name: revenue_per_order
+      expression: revenue / count(*)
+      description: ""
+      format_preset: ""
+      requires:
+        - revenue
+    - label: ""
+      name: revenue_7d
+      expression: sum(revenue)
+      description: ""
+      format_preset: ""
+      requires:
+        - revenue
+      window:
+        partition: true
+        frame: ROWS BETWEEN 6 PRECEDING AND CURRENT ROW
 `,
 		},
 	}
@@ -583,6 +649,70 @@ available_time_zones:
 	}
 }
 
+func TestMetricsViewMeasureReferences(t *testing.T) {
+	repoStore := repoStore(t)
+	registryStore := registryStore(t)
+	tests := []struct {
+		name    string
+		content string
+		want    []*runtimev1.MetricsView_Measure
+		wantErr bool
+	}{
+		{
+			name: "window shorthand",
+			content: `
+timeseries: time
+measures:
+- name: a
+  expression: count(*)
+- name: b
+  expression: sum(a)
+  requires: [a]
+  window: true
+`,
+			want: []*runtimev1.MetricsView_Measure{
+				{Name: "a", Expression: "count(*)"},
+				{Name: "b", Expression: "sum(a)", ReferencedMeasures: []string{"a"}, Window: &runtimev1.MetricsView_MeasureWindow{Partition: true}},
+			},
+		},
+		{
+			name: "window without time dimension",
+			content: `
+measures:
+- name: a
+  expression: count(*)
+  window: true
+`,
+			wantErr: true,
+		},
+		{
+			name: "cycle",
+			content: `
+measures:
+- name: a
+  expression: b
+  requires: [b]
+- name: b
+  expression: a
+  requires: [a]
+`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, repoStore.Put(context.Background(), "dashboards/dashboard.yaml", bytes.NewReader([]byte(tt.content))))
+			got, err := artifacts.Read(context.Background(), repoStore, registryStore, "test", "dashboards/dashboard.yaml")
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got.GetMetricsView().Measures)
+		})
+	}
+}
+
 func repoStore(t *testing.T) drivers.RepoStore {
 	dir := t.TempDir()
 	fileStore, err := drivers.Open("file", map[string]any{"dsn": dir}, false, activity.NewNoopClient(), zap.NewNop())
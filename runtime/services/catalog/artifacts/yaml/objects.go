@@ -14,6 +14,7 @@ import (
 	"github.com/rilldata/rill/runtime/pkg/duration"
 	"github.com/rilldata/rill/runtime/pkg/fileutil"
 	"google.golang.org/protobuf/types/known/structpb"
+	"gopkg.in/yaml.v3"
 
 	// Load IANA time zone data
 	_ "time/tzdata"
@@ -37,6 +38,8 @@ type Source struct {
 	HivePartition               *bool          `yaml:"hive_partitioning,omitempty" mapstructure:"hive_partitioning,omitempty"`
 	Timeout                     int32          `yaml:"timeout,omitempty"`
 	Format                      string         `yaml:"format,omitempty" mapstructure:"format,omitempty"`
+	Version                     *int64         `yaml:"version,omitempty" mapstructure:"version,omitempty"`
+	SnapshotID                  *int64         `yaml:"snapshot_id,omitempty" mapstructure:"snapshot_id,omitempty"`
 	Extract                     map[string]any `yaml:"extract,omitempty" mapstructure:"extract,omitempty"`
 	DuckDBProps                 map[string]any `yaml:"duckdb,omitempty" mapstructure:"duckdb,omitempty"`
 	Headers                     map[string]any `yaml:"headers,omitempty" mapstructure:"headers,omitempty"`
@@ -84,9 +87,16 @@ type Measure struct {
 	Name                string
 	Expression          string
 	Description         string
-	Format              string `yaml:"format_preset"`
-	Ignore              bool   `yaml:"ignore,omitempty"`
-	ValidPercentOfTotal bool   `yaml:"valid_percent_of_total,omitempty"`
+	Format              string    `yaml:"format_preset"`
+	Ignore              bool      `yaml:"ignore,omitempty"`
+	ValidPercentOfTotal bool      `yaml:"valid_percent_of_total,omitempty"`
+	Requires            []string  `yaml:"requires,omitempty" copier:"-"`
+	Window              yaml.Node `yaml:"window,omitempty" copier:"-"`
+}
+
+type MeasureWindow struct {
+	Partition *bool  `yaml:"partition,omitempty"`
+	Frame     string `yaml:"frame,omitempty"`
 }
 
 type Dimension struct {
@@ -127,6 +137,19 @@ func toMetricsViewArtifact(catalog *drivers.CatalogEntry) (*MetricsView, error)
 		return nil, err
 	}
 
+	for i, measure := range catalog.GetMetricsView().Measures {
+		metricsArtifact.Measures[i].Requires = measure.ReferencedMeasures
+		if measure.Window != nil {
+			err := metricsArtifact.Measures[i].Window.Encode(&MeasureWindow{
+				Partition: &measure.Window.Partition,
+				Frame:     measure.Window.Frame,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	return metricsArtifact, nil
 }
 
@@ -189,6 +212,14 @@ func fromSourceArtifact(source *Source, path string) (*drivers.CatalogEntry, err
 		props["format"] = source.Format
 	}
 
+	if source.Version != nil {
+		props["version"] = *source.Version
+	}
+
+	if source.SnapshotID != nil {
+		props["snapshot_id"] = *source.SnapshotID
+	}
+
 	if source.Headers != nil {
 		props["headers"] = source.Headers
 	}
@@ -361,6 +392,29 @@ func fromMetricsViewArtifact(metrics *MetricsView, path string) (*drivers.Catalo
 		}
 	}
 
+	// copier can't map requires and window to the proto fields
+	measureNames := make([]string, len(apiMetrics.Measures))
+	measureRefs := make(map[string][]string, len(apiMetrics.Measures))
+	for i, measure := range apiMetrics.Measures {
+		window, err := rillv1.ParseMeasureWindow(&metrics.Measures[i].Window)
+		if err != nil {
+			return nil, fmt.Errorf("invalid measure %q: %w", measure.Name, err)
+		}
+		measure.Window = nil
+		if window != nil {
+			if metrics.TimeDimension == "" {
+				return nil, fmt.Errorf("measure %q has a window, which requires the %q field to be set", measure.Name, "timeseries")
+			}
+			measure.Window = &runtimev1.MetricsView_MeasureWindow{Partition: window.Partition, Frame: window.Frame}
+		}
+		measure.ReferencedMeasures = metrics.Measures[i].Requires
+		measureNames[i] = measure.Name
+		measureRefs[measure.Name] = measure.ReferencedMeasures
+	}
+	if err := rillv1.ValidateMeasureReferences(measureNames, measureRefs); err != nil {
+		return nil, err
+	}
+
 	// backwards compatibility where name was used as property
 	for i, dimension := range apiMetrics.Dimensions {
 		if dimension.Name == "" {
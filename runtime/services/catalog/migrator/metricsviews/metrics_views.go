@@ -139,6 +139,12 @@ func (m *metricsViewMigrator) Validate(ctx context.Context, olap drivers.OLAPSto
 			continue
 		}
 
+		// Measures that reference other measures can't be evaluated on their own.
+		// References are checked when parsing, and the expressions are only validated by the metrics view reconciler.
+		if len(measure.ReferencedMeasures) > 0 || measure.Window != nil {
+			continue
+		}
+
 		err := validateMeasure(ctx, olap, model, measure)
 		if err != nil {
 			validationErrors = append(validationErrors, &runtimev1.ReconcileError{
@@ -369,6 +369,9 @@ func connectorVariables(src *runtimev1.Source, env map[string]string, repoRoot s
 	case "postgres":
 		// this is only required till sources can't call AcquireHandle directly
 		vars["database_url"] = env["connectors.postgres.database_url"]
+	case "mysql":
+		// this is only required till sources can't call AcquireHandle directly
+		vars["dsn"] = env["connectors.mysql.dsn"]
 	}
 	return vars
 }
@@ -57,6 +57,9 @@ export type AdminServiceUpdateProjectBody = {
   region?: string;
   newName?: string;
   prodTtlSeconds?: string;
+  gitRemote?: string;
+  gitCredentials?: V1GitCredentials;
+  archiveAssetId?: string;
 };
 
 export type AdminServiceCreateProjectBodyVariables = { [key: string]: string };
@@ -73,6 +76,9 @@ export type AdminServiceCreateProjectBody = {
   prodBranch?: string;
   githubUrl?: string;
   variables?: AdminServiceCreateProjectBodyVariables;
+  gitRemote?: string;
+  gitCredentials?: V1GitCredentials;
+  archiveAssetId?: string;
 };
 
 export type AdminServiceListProjectsForOrganizationParams = {
@@ -341,6 +347,7 @@ export interface V1ProjectPermissions {
   manageDev?: boolean;
   readProjectMembers?: boolean;
   manageProjectMembers?: boolean;
+  readProjectVariables?: boolean;
 }
 
 export interface V1Project {
@@ -360,6 +367,9 @@ export interface V1Project {
   prodDeploymentId?: string;
   frontendUrl?: string;
   prodTtlSeconds?: string;
+  gitRemote?: string;
+  gitWebhookSecret?: string;
+  archiveAssetId?: string;
   createdOn?: string;
   updatedOn?: string;
 }
@@ -472,6 +482,18 @@ export interface V1IssueRepresentativeAuthTokenRequest {
   ttlMinutes?: string;
 }
 
+/**
+ * GitCredentials authenticate access to a Git remote that's not on Github.
+Username and password (or an access token) are used for HTTPS remotes. An SSH private key (such as a deploy key) is used for SSH remotes.
+ */
+export interface V1GitCredentials {
+  username?: string;
+  password?: string;
+  privateKey?: string;
+  privateKeyPassword?: string;
+  knownHosts?: string;
+}
+
 export interface V1GetUserResponse {
   user?: V1User;
 }
@@ -1485,6 +1485,25 @@ export class CreateProjectRequest extends Message<CreateProjectRequest> {
    */
   variables: { [key: string]: string } = {};
 
+  /**
+   * Git remote to deploy from instead of Github, e.g. a self-hosted GitLab or Gitea repository (HTTPS or SSH URL)
+   *
+   * @generated from field: string git_remote = 13;
+   */
+  gitRemote = "";
+
+  /**
+   * @generated from field: rill.admin.v1.GitCredentials git_credentials = 14;
+   */
+  gitCredentials?: GitCredentials;
+
+  /**
+   * ID of an archive of the project files uploaded to /archives/upload, to deploy instead of a Git repository
+   *
+   * @generated from field: string archive_asset_id = 15;
+   */
+  archiveAssetId = "";
+
   constructor(data?: PartialMessage<CreateProjectRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1505,6 +1524,9 @@ export class CreateProjectRequest extends Message<CreateProjectRequest> {
     { no: 9, name: "prod_branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 10, name: "github_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 11, name: "variables", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 13, name: "git_remote", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 14, name: "git_credentials", kind: "message", T: GitCredentials },
+    { no: 15, name: "archive_asset_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateProjectRequest {
@@ -1689,6 +1711,35 @@ export class UpdateProjectRequest extends Message<UpdateProjectRequest> {
    */
   prodTtlSeconds?: bigint;
 
+  /**
+   * @generated from field: optional bool preview_enabled = 11;
+   */
+  previewEnabled?: boolean;
+
+  /**
+   * @generated from field: optional int64 preview_ttl_seconds = 12;
+   */
+  previewTtlSeconds?: bigint;
+
+  /**
+   * @generated from field: optional string git_remote = 13;
+   */
+  gitRemote?: string;
+
+  /**
+   * Replaces the credentials for the Git remote if set
+   *
+   * @generated from field: rill.admin.v1.GitCredentials git_credentials = 14;
+   */
+  gitCredentials?: GitCredentials;
+
+  /**
+   * Deploys a newly uploaded archive of the project files
+   *
+   * @generated from field: optional string archive_asset_id = 15;
+   */
+  archiveAssetId?: string;
+
   constructor(data?: PartialMessage<UpdateProjectRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1707,6 +1758,11 @@ export class UpdateProjectRequest extends Message<UpdateProjectRequest> {
     { no: 8, name: "region", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 9, name: "new_name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 10, name: "prod_ttl_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 11, name: "preview_enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 12, name: "preview_ttl_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 13, name: "git_remote", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 14, name: "git_credentials", kind: "message", T: GitCredentials },
+    { no: 15, name: "archive_asset_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateProjectRequest {
@@ -2075,385 +2131,855 @@ export class TriggerRedeployResponse extends Message<TriggerRedeployResponse> {
 }
 
 /**
- * @generated from message rill.admin.v1.ListOrganizationMembersRequest
+ * @generated from message rill.admin.v1.ListPreviewDeploymentsRequest
  */
-export class ListOrganizationMembersRequest extends Message<ListOrganizationMembersRequest> {
-  /**
-   * @generated from field: string organization = 1;
-   */
-  organization = "";
-
+export class ListPreviewDeploymentsRequest extends Message<ListPreviewDeploymentsRequest> {
   /**
-   * @generated from field: uint32 page_size = 2;
+   * @generated from field: string organization_name = 1;
    */
-  pageSize = 0;
+  organizationName = "";
 
   /**
-   * @generated from field: string page_token = 3;
+   * @generated from field: string project = 2;
    */
-  pageToken = "";
+  project = "";
 
-  constructor(data?: PartialMessage<ListOrganizationMembersRequest>) {
+  constructor(data?: PartialMessage<ListPreviewDeploymentsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.ListOrganizationMembersRequest";
+  static readonly typeName = "rill.admin.v1.ListPreviewDeploymentsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationMembersRequest {
-    return new ListOrganizationMembersRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListPreviewDeploymentsRequest {
+    return new ListPreviewDeploymentsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationMembersRequest {
-    return new ListOrganizationMembersRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListPreviewDeploymentsRequest {
+    return new ListPreviewDeploymentsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationMembersRequest {
-    return new ListOrganizationMembersRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListPreviewDeploymentsRequest {
+    return new ListPreviewDeploymentsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListOrganizationMembersRequest | PlainMessage<ListOrganizationMembersRequest> | undefined, b: ListOrganizationMembersRequest | PlainMessage<ListOrganizationMembersRequest> | undefined): boolean {
-    return proto3.util.equals(ListOrganizationMembersRequest, a, b);
+  static equals(a: ListPreviewDeploymentsRequest | PlainMessage<ListPreviewDeploymentsRequest> | undefined, b: ListPreviewDeploymentsRequest | PlainMessage<ListPreviewDeploymentsRequest> | undefined): boolean {
+    return proto3.util.equals(ListPreviewDeploymentsRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.ListOrganizationMembersResponse
+ * @generated from message rill.admin.v1.ListPreviewDeploymentsResponse
  */
-export class ListOrganizationMembersResponse extends Message<ListOrganizationMembersResponse> {
-  /**
-   * @generated from field: repeated rill.admin.v1.Member members = 1;
-   */
-  members: Member[] = [];
-
+export class ListPreviewDeploymentsResponse extends Message<ListPreviewDeploymentsResponse> {
   /**
-   * @generated from field: string next_page_token = 2;
+   * @generated from field: repeated rill.admin.v1.Deployment deployments = 1;
    */
-  nextPageToken = "";
+  deployments: Deployment[] = [];
 
-  constructor(data?: PartialMessage<ListOrganizationMembersResponse>) {
+  constructor(data?: PartialMessage<ListPreviewDeploymentsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.ListOrganizationMembersResponse";
+  static readonly typeName = "rill.admin.v1.ListPreviewDeploymentsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "members", kind: "message", T: Member, repeated: true },
-    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "deployments", kind: "message", T: Deployment, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationMembersResponse {
-    return new ListOrganizationMembersResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListPreviewDeploymentsResponse {
+    return new ListPreviewDeploymentsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationMembersResponse {
-    return new ListOrganizationMembersResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListPreviewDeploymentsResponse {
+    return new ListPreviewDeploymentsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationMembersResponse {
-    return new ListOrganizationMembersResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListPreviewDeploymentsResponse {
+    return new ListPreviewDeploymentsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListOrganizationMembersResponse | PlainMessage<ListOrganizationMembersResponse> | undefined, b: ListOrganizationMembersResponse | PlainMessage<ListOrganizationMembersResponse> | undefined): boolean {
-    return proto3.util.equals(ListOrganizationMembersResponse, a, b);
+  static equals(a: ListPreviewDeploymentsResponse | PlainMessage<ListPreviewDeploymentsResponse> | undefined, b: ListPreviewDeploymentsResponse | PlainMessage<ListPreviewDeploymentsResponse> | undefined): boolean {
+    return proto3.util.equals(ListPreviewDeploymentsResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.ListOrganizationInvitesRequest
+ * @generated from message rill.admin.v1.GetPreviewDeploymentRequest
  */
-export class ListOrganizationInvitesRequest extends Message<ListOrganizationInvitesRequest> {
+export class GetPreviewDeploymentRequest extends Message<GetPreviewDeploymentRequest> {
   /**
-   * @generated from field: string organization = 1;
+   * @generated from field: string organization_name = 1;
    */
-  organization = "";
+  organizationName = "";
 
   /**
-   * @generated from field: uint32 page_size = 2;
+   * @generated from field: string project = 2;
    */
-  pageSize = 0;
+  project = "";
 
   /**
-   * @generated from field: string page_token = 3;
+   * @generated from field: string branch = 3;
    */
-  pageToken = "";
+  branch = "";
 
-  constructor(data?: PartialMessage<ListOrganizationInvitesRequest>) {
+  constructor(data?: PartialMessage<GetPreviewDeploymentRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.ListOrganizationInvitesRequest";
+  static readonly typeName = "rill.admin.v1.GetPreviewDeploymentRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationInvitesRequest {
-    return new ListOrganizationInvitesRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetPreviewDeploymentRequest {
+    return new GetPreviewDeploymentRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationInvitesRequest {
-    return new ListOrganizationInvitesRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetPreviewDeploymentRequest {
+    return new GetPreviewDeploymentRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationInvitesRequest {
-    return new ListOrganizationInvitesRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetPreviewDeploymentRequest {
+    return new GetPreviewDeploymentRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListOrganizationInvitesRequest | PlainMessage<ListOrganizationInvitesRequest> | undefined, b: ListOrganizationInvitesRequest | PlainMessage<ListOrganizationInvitesRequest> | undefined): boolean {
-    return proto3.util.equals(ListOrganizationInvitesRequest, a, b);
+  static equals(a: GetPreviewDeploymentRequest | PlainMessage<GetPreviewDeploymentRequest> | undefined, b: GetPreviewDeploymentRequest | PlainMessage<GetPreviewDeploymentRequest> | undefined): boolean {
+    return proto3.util.equals(GetPreviewDeploymentRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.ListOrganizationInvitesResponse
+ * @generated from message rill.admin.v1.GetPreviewDeploymentResponse
  */
-export class ListOrganizationInvitesResponse extends Message<ListOrganizationInvitesResponse> {
+export class GetPreviewDeploymentResponse extends Message<GetPreviewDeploymentResponse> {
   /**
-   * @generated from field: repeated rill.admin.v1.UserInvite invites = 1;
+   * @generated from field: rill.admin.v1.Deployment deployment = 1;
    */
-  invites: UserInvite[] = [];
+  deployment?: Deployment;
 
   /**
-   * @generated from field: string next_page_token = 2;
+   * @generated from field: string jwt = 2;
    */
-  nextPageToken = "";
+  jwt = "";
 
-  constructor(data?: PartialMessage<ListOrganizationInvitesResponse>) {
+  constructor(data?: PartialMessage<GetPreviewDeploymentResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.ListOrganizationInvitesResponse";
+  static readonly typeName = "rill.admin.v1.GetPreviewDeploymentResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "invites", kind: "message", T: UserInvite, repeated: true },
-    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "deployment", kind: "message", T: Deployment },
+    { no: 2, name: "jwt", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationInvitesResponse {
-    return new ListOrganizationInvitesResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetPreviewDeploymentResponse {
+    return new GetPreviewDeploymentResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationInvitesResponse {
-    return new ListOrganizationInvitesResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetPreviewDeploymentResponse {
+    return new GetPreviewDeploymentResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationInvitesResponse {
-    return new ListOrganizationInvitesResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetPreviewDeploymentResponse {
+    return new GetPreviewDeploymentResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListOrganizationInvitesResponse | PlainMessage<ListOrganizationInvitesResponse> | undefined, b: ListOrganizationInvitesResponse | PlainMessage<ListOrganizationInvitesResponse> | undefined): boolean {
-    return proto3.util.equals(ListOrganizationInvitesResponse, a, b);
+  static equals(a: GetPreviewDeploymentResponse | PlainMessage<GetPreviewDeploymentResponse> | undefined, b: GetPreviewDeploymentResponse | PlainMessage<GetPreviewDeploymentResponse> | undefined): boolean {
+    return proto3.util.equals(GetPreviewDeploymentResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.AddOrganizationMemberRequest
+ * @generated from message rill.admin.v1.CreatePreviewDeploymentRequest
  */
-export class AddOrganizationMemberRequest extends Message<AddOrganizationMemberRequest> {
+export class CreatePreviewDeploymentRequest extends Message<CreatePreviewDeploymentRequest> {
   /**
-   * @generated from field: string organization = 1;
+   * @generated from field: string organization_name = 1;
    */
-  organization = "";
+  organizationName = "";
 
   /**
-   * @generated from field: string email = 2;
+   * @generated from field: string project = 2;
    */
-  email = "";
+  project = "";
 
   /**
-   * @generated from field: string role = 3;
+   * @generated from field: string branch = 3;
    */
-  role = "";
+  branch = "";
 
-  constructor(data?: PartialMessage<AddOrganizationMemberRequest>) {
+  /**
+   * Variables override the project's variables for the preview deployment
+   *
+   * @generated from field: map<string, string> variables = 4;
+   */
+  variables: { [key: string]: string } = {};
+
+  /**
+   * TTL for the preview deployment. Defaults to the project's preview_ttl_seconds.
+   *
+   * @generated from field: optional int64 ttl_seconds = 5;
+   */
+  ttlSeconds?: bigint;
+
+  constructor(data?: PartialMessage<CreatePreviewDeploymentRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.AddOrganizationMemberRequest";
+  static readonly typeName = "rill.admin.v1.CreatePreviewDeploymentRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "variables", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 5, name: "ttl_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddOrganizationMemberRequest {
-    return new AddOrganizationMemberRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreatePreviewDeploymentRequest {
+    return new CreatePreviewDeploymentRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddOrganizationMemberRequest {
-    return new AddOrganizationMemberRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreatePreviewDeploymentRequest {
+    return new CreatePreviewDeploymentRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddOrganizationMemberRequest {
-    return new AddOrganizationMemberRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreatePreviewDeploymentRequest {
+    return new CreatePreviewDeploymentRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: AddOrganizationMemberRequest | PlainMessage<AddOrganizationMemberRequest> | undefined, b: AddOrganizationMemberRequest | PlainMessage<AddOrganizationMemberRequest> | undefined): boolean {
-    return proto3.util.equals(AddOrganizationMemberRequest, a, b);
+  static equals(a: CreatePreviewDeploymentRequest | PlainMessage<CreatePreviewDeploymentRequest> | undefined, b: CreatePreviewDeploymentRequest | PlainMessage<CreatePreviewDeploymentRequest> | undefined): boolean {
+    return proto3.util.equals(CreatePreviewDeploymentRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.AddOrganizationMemberResponse
+ * @generated from message rill.admin.v1.CreatePreviewDeploymentResponse
  */
-export class AddOrganizationMemberResponse extends Message<AddOrganizationMemberResponse> {
+export class CreatePreviewDeploymentResponse extends Message<CreatePreviewDeploymentResponse> {
   /**
-   * @generated from field: bool pending_signup = 1;
+   * @generated from field: rill.admin.v1.Deployment deployment = 1;
    */
-  pendingSignup = false;
+  deployment?: Deployment;
 
-  constructor(data?: PartialMessage<AddOrganizationMemberResponse>) {
+  constructor(data?: PartialMessage<CreatePreviewDeploymentResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.AddOrganizationMemberResponse";
+  static readonly typeName = "rill.admin.v1.CreatePreviewDeploymentResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "pending_signup", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "deployment", kind: "message", T: Deployment },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddOrganizationMemberResponse {
-    return new AddOrganizationMemberResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreatePreviewDeploymentResponse {
+    return new CreatePreviewDeploymentResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddOrganizationMemberResponse {
-    return new AddOrganizationMemberResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreatePreviewDeploymentResponse {
+    return new CreatePreviewDeploymentResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddOrganizationMemberResponse {
-    return new AddOrganizationMemberResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreatePreviewDeploymentResponse {
+    return new CreatePreviewDeploymentResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: AddOrganizationMemberResponse | PlainMessage<AddOrganizationMemberResponse> | undefined, b: AddOrganizationMemberResponse | PlainMessage<AddOrganizationMemberResponse> | undefined): boolean {
-    return proto3.util.equals(AddOrganizationMemberResponse, a, b);
+  static equals(a: CreatePreviewDeploymentResponse | PlainMessage<CreatePreviewDeploymentResponse> | undefined, b: CreatePreviewDeploymentResponse | PlainMessage<CreatePreviewDeploymentResponse> | undefined): boolean {
+    return proto3.util.equals(CreatePreviewDeploymentResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.RemoveOrganizationMemberRequest
+ * @generated from message rill.admin.v1.DeletePreviewDeploymentRequest
  */
-export class RemoveOrganizationMemberRequest extends Message<RemoveOrganizationMemberRequest> {
+export class DeletePreviewDeploymentRequest extends Message<DeletePreviewDeploymentRequest> {
   /**
-   * @generated from field: string organization = 1;
+   * @generated from field: string organization_name = 1;
    */
-  organization = "";
+  organizationName = "";
 
   /**
-   * @generated from field: string email = 2;
+   * @generated from field: string project = 2;
    */
-  email = "";
+  project = "";
 
   /**
-   * @generated from field: bool keep_project_roles = 3;
+   * @generated from field: string branch = 3;
    */
-  keepProjectRoles = false;
+  branch = "";
 
-  constructor(data?: PartialMessage<RemoveOrganizationMemberRequest>) {
+  constructor(data?: PartialMessage<DeletePreviewDeploymentRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.RemoveOrganizationMemberRequest";
+  static readonly typeName = "rill.admin.v1.DeletePreviewDeploymentRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "keep_project_roles", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "organization_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveOrganizationMemberRequest {
-    return new RemoveOrganizationMemberRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeletePreviewDeploymentRequest {
+    return new DeletePreviewDeploymentRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveOrganizationMemberRequest {
-    return new RemoveOrganizationMemberRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeletePreviewDeploymentRequest {
+    return new DeletePreviewDeploymentRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveOrganizationMemberRequest {
-    return new RemoveOrganizationMemberRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeletePreviewDeploymentRequest {
+    return new DeletePreviewDeploymentRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RemoveOrganizationMemberRequest | PlainMessage<RemoveOrganizationMemberRequest> | undefined, b: RemoveOrganizationMemberRequest | PlainMessage<RemoveOrganizationMemberRequest> | undefined): boolean {
-    return proto3.util.equals(RemoveOrganizationMemberRequest, a, b);
+  static equals(a: DeletePreviewDeploymentRequest | PlainMessage<DeletePreviewDeploymentRequest> | undefined, b: DeletePreviewDeploymentRequest | PlainMessage<DeletePreviewDeploymentRequest> | undefined): boolean {
+    return proto3.util.equals(DeletePreviewDeploymentRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.RemoveOrganizationMemberResponse
+ * @generated from message rill.admin.v1.DeletePreviewDeploymentResponse
  */
-export class RemoveOrganizationMemberResponse extends Message<RemoveOrganizationMemberResponse> {
-  constructor(data?: PartialMessage<RemoveOrganizationMemberResponse>) {
+export class DeletePreviewDeploymentResponse extends Message<DeletePreviewDeploymentResponse> {
+  constructor(data?: PartialMessage<DeletePreviewDeploymentResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.RemoveOrganizationMemberResponse";
+  static readonly typeName = "rill.admin.v1.DeletePreviewDeploymentResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveOrganizationMemberResponse {
-    return new RemoveOrganizationMemberResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeletePreviewDeploymentResponse {
+    return new DeletePreviewDeploymentResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveOrganizationMemberResponse {
-    return new RemoveOrganizationMemberResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeletePreviewDeploymentResponse {
+    return new DeletePreviewDeploymentResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveOrganizationMemberResponse {
-    return new RemoveOrganizationMemberResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeletePreviewDeploymentResponse {
+    return new DeletePreviewDeploymentResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RemoveOrganizationMemberResponse | PlainMessage<RemoveOrganizationMemberResponse> | undefined, b: RemoveOrganizationMemberResponse | PlainMessage<RemoveOrganizationMemberResponse> | undefined): boolean {
-    return proto3.util.equals(RemoveOrganizationMemberResponse, a, b);
+  static equals(a: DeletePreviewDeploymentResponse | PlainMessage<DeletePreviewDeploymentResponse> | undefined, b: DeletePreviewDeploymentResponse | PlainMessage<DeletePreviewDeploymentResponse> | undefined): boolean {
+    return proto3.util.equals(DeletePreviewDeploymentResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.LeaveOrganizationRequest
+ * @generated from message rill.admin.v1.ListOrganizationMembersRequest
  */
-export class LeaveOrganizationRequest extends Message<LeaveOrganizationRequest> {
+export class ListOrganizationMembersRequest extends Message<ListOrganizationMembersRequest> {
   /**
    * @generated from field: string organization = 1;
    */
   organization = "";
 
-  constructor(data?: PartialMessage<LeaveOrganizationRequest>) {
+  /**
+   * @generated from field: uint32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListOrganizationMembersRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.LeaveOrganizationRequest";
+  static readonly typeName = "rill.admin.v1.ListOrganizationMembersRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LeaveOrganizationRequest {
-    return new LeaveOrganizationRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationMembersRequest {
+    return new ListOrganizationMembersRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LeaveOrganizationRequest {
-    return new LeaveOrganizationRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationMembersRequest {
+    return new ListOrganizationMembersRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LeaveOrganizationRequest {
-    return new LeaveOrganizationRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationMembersRequest {
+    return new ListOrganizationMembersRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListOrganizationMembersRequest | PlainMessage<ListOrganizationMembersRequest> | undefined, b: ListOrganizationMembersRequest | PlainMessage<ListOrganizationMembersRequest> | undefined): boolean {
+    return proto3.util.equals(ListOrganizationMembersRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListOrganizationMembersResponse
+ */
+export class ListOrganizationMembersResponse extends Message<ListOrganizationMembersResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.Member members = 1;
+   */
+  members: Member[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListOrganizationMembersResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListOrganizationMembersResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "members", kind: "message", T: Member, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationMembersResponse {
+    return new ListOrganizationMembersResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationMembersResponse {
+    return new ListOrganizationMembersResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationMembersResponse {
+    return new ListOrganizationMembersResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListOrganizationMembersResponse | PlainMessage<ListOrganizationMembersResponse> | undefined, b: ListOrganizationMembersResponse | PlainMessage<ListOrganizationMembersResponse> | undefined): boolean {
+    return proto3.util.equals(ListOrganizationMembersResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListOrganizationInvitesRequest
+ */
+export class ListOrganizationInvitesRequest extends Message<ListOrganizationInvitesRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: uint32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListOrganizationInvitesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListOrganizationInvitesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationInvitesRequest {
+    return new ListOrganizationInvitesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationInvitesRequest {
+    return new ListOrganizationInvitesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationInvitesRequest {
+    return new ListOrganizationInvitesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListOrganizationInvitesRequest | PlainMessage<ListOrganizationInvitesRequest> | undefined, b: ListOrganizationInvitesRequest | PlainMessage<ListOrganizationInvitesRequest> | undefined): boolean {
+    return proto3.util.equals(ListOrganizationInvitesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListOrganizationInvitesResponse
+ */
+export class ListOrganizationInvitesResponse extends Message<ListOrganizationInvitesResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.UserInvite invites = 1;
+   */
+  invites: UserInvite[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListOrganizationInvitesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListOrganizationInvitesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "invites", kind: "message", T: UserInvite, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationInvitesResponse {
+    return new ListOrganizationInvitesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationInvitesResponse {
+    return new ListOrganizationInvitesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationInvitesResponse {
+    return new ListOrganizationInvitesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListOrganizationInvitesResponse | PlainMessage<ListOrganizationInvitesResponse> | undefined, b: ListOrganizationInvitesResponse | PlainMessage<ListOrganizationInvitesResponse> | undefined): boolean {
+    return proto3.util.equals(ListOrganizationInvitesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListAuditEventsRequest
+ */
+export class ListAuditEventsRequest extends Message<ListAuditEventsRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * Only return events for the given project
+   *
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * Only return events for the given action (e.g. "UpdateProject")
+   *
+   * @generated from field: string action = 3;
+   */
+  action = "";
+
+  /**
+   * Only return events by the user with the given email
+   *
+   * @generated from field: string actor_email = 4;
+   */
+  actorEmail = "";
+
+  /**
+   * @generated from field: uint32 page_size = 5;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 6;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListAuditEventsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListAuditEventsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "action", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "actor_email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 6, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAuditEventsRequest {
+    return new ListAuditEventsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAuditEventsRequest {
+    return new ListAuditEventsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAuditEventsRequest {
+    return new ListAuditEventsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAuditEventsRequest | PlainMessage<ListAuditEventsRequest> | undefined, b: ListAuditEventsRequest | PlainMessage<ListAuditEventsRequest> | undefined): boolean {
+    return proto3.util.equals(ListAuditEventsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListAuditEventsResponse
+ */
+export class ListAuditEventsResponse extends Message<ListAuditEventsResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.AuditEvent events = 1;
+   */
+  events: AuditEvent[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListAuditEventsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListAuditEventsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "events", kind: "message", T: AuditEvent, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAuditEventsResponse {
+    return new ListAuditEventsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAuditEventsResponse {
+    return new ListAuditEventsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAuditEventsResponse {
+    return new ListAuditEventsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAuditEventsResponse | PlainMessage<ListAuditEventsResponse> | undefined, b: ListAuditEventsResponse | PlainMessage<ListAuditEventsResponse> | undefined): boolean {
+    return proto3.util.equals(ListAuditEventsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.AddOrganizationMemberRequest
+ */
+export class AddOrganizationMemberRequest extends Message<AddOrganizationMemberRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string email = 2;
+   */
+  email = "";
+
+  /**
+   * @generated from field: string role = 3;
+   */
+  role = "";
+
+  constructor(data?: PartialMessage<AddOrganizationMemberRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.AddOrganizationMemberRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddOrganizationMemberRequest {
+    return new AddOrganizationMemberRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddOrganizationMemberRequest {
+    return new AddOrganizationMemberRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddOrganizationMemberRequest {
+    return new AddOrganizationMemberRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddOrganizationMemberRequest | PlainMessage<AddOrganizationMemberRequest> | undefined, b: AddOrganizationMemberRequest | PlainMessage<AddOrganizationMemberRequest> | undefined): boolean {
+    return proto3.util.equals(AddOrganizationMemberRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.AddOrganizationMemberResponse
+ */
+export class AddOrganizationMemberResponse extends Message<AddOrganizationMemberResponse> {
+  /**
+   * @generated from field: bool pending_signup = 1;
+   */
+  pendingSignup = false;
+
+  constructor(data?: PartialMessage<AddOrganizationMemberResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.AddOrganizationMemberResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pending_signup", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddOrganizationMemberResponse {
+    return new AddOrganizationMemberResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddOrganizationMemberResponse {
+    return new AddOrganizationMemberResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddOrganizationMemberResponse {
+    return new AddOrganizationMemberResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddOrganizationMemberResponse | PlainMessage<AddOrganizationMemberResponse> | undefined, b: AddOrganizationMemberResponse | PlainMessage<AddOrganizationMemberResponse> | undefined): boolean {
+    return proto3.util.equals(AddOrganizationMemberResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.RemoveOrganizationMemberRequest
+ */
+export class RemoveOrganizationMemberRequest extends Message<RemoveOrganizationMemberRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string email = 2;
+   */
+  email = "";
+
+  /**
+   * @generated from field: bool keep_project_roles = 3;
+   */
+  keepProjectRoles = false;
+
+  constructor(data?: PartialMessage<RemoveOrganizationMemberRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RemoveOrganizationMemberRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "keep_project_roles", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveOrganizationMemberRequest {
+    return new RemoveOrganizationMemberRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveOrganizationMemberRequest {
+    return new RemoveOrganizationMemberRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveOrganizationMemberRequest {
+    return new RemoveOrganizationMemberRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveOrganizationMemberRequest | PlainMessage<RemoveOrganizationMemberRequest> | undefined, b: RemoveOrganizationMemberRequest | PlainMessage<RemoveOrganizationMemberRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveOrganizationMemberRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.RemoveOrganizationMemberResponse
+ */
+export class RemoveOrganizationMemberResponse extends Message<RemoveOrganizationMemberResponse> {
+  constructor(data?: PartialMessage<RemoveOrganizationMemberResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RemoveOrganizationMemberResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveOrganizationMemberResponse {
+    return new RemoveOrganizationMemberResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveOrganizationMemberResponse {
+    return new RemoveOrganizationMemberResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveOrganizationMemberResponse {
+    return new RemoveOrganizationMemberResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveOrganizationMemberResponse | PlainMessage<RemoveOrganizationMemberResponse> | undefined, b: RemoveOrganizationMemberResponse | PlainMessage<RemoveOrganizationMemberResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveOrganizationMemberResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.LeaveOrganizationRequest
+ */
+export class LeaveOrganizationRequest extends Message<LeaveOrganizationRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  constructor(data?: PartialMessage<LeaveOrganizationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.LeaveOrganizationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LeaveOrganizationRequest {
+    return new LeaveOrganizationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LeaveOrganizationRequest {
+    return new LeaveOrganizationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LeaveOrganizationRequest {
+    return new LeaveOrganizationRequest().fromJsonString(jsonString, options);
   }
 
   static equals(a: LeaveOrganizationRequest | PlainMessage<LeaveOrganizationRequest> | undefined, b: LeaveOrganizationRequest | PlainMessage<LeaveOrganizationRequest> | undefined): boolean {
@@ -2462,593 +2988,1785 @@ export class LeaveOrganizationRequest extends Message<LeaveOrganizationRequest>
 }
 
 /**
- * @generated from message rill.admin.v1.LeaveOrganizationResponse
+ * @generated from message rill.admin.v1.LeaveOrganizationResponse
+ */
+export class LeaveOrganizationResponse extends Message<LeaveOrganizationResponse> {
+  constructor(data?: PartialMessage<LeaveOrganizationResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.LeaveOrganizationResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LeaveOrganizationResponse {
+    return new LeaveOrganizationResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LeaveOrganizationResponse {
+    return new LeaveOrganizationResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LeaveOrganizationResponse {
+    return new LeaveOrganizationResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LeaveOrganizationResponse | PlainMessage<LeaveOrganizationResponse> | undefined, b: LeaveOrganizationResponse | PlainMessage<LeaveOrganizationResponse> | undefined): boolean {
+    return proto3.util.equals(LeaveOrganizationResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.SetOrganizationMemberRoleRequest
+ */
+export class SetOrganizationMemberRoleRequest extends Message<SetOrganizationMemberRoleRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string email = 2;
+   */
+  email = "";
+
+  /**
+   * @generated from field: string role = 3;
+   */
+  role = "";
+
+  constructor(data?: PartialMessage<SetOrganizationMemberRoleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.SetOrganizationMemberRoleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetOrganizationMemberRoleRequest {
+    return new SetOrganizationMemberRoleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetOrganizationMemberRoleRequest {
+    return new SetOrganizationMemberRoleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetOrganizationMemberRoleRequest {
+    return new SetOrganizationMemberRoleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetOrganizationMemberRoleRequest | PlainMessage<SetOrganizationMemberRoleRequest> | undefined, b: SetOrganizationMemberRoleRequest | PlainMessage<SetOrganizationMemberRoleRequest> | undefined): boolean {
+    return proto3.util.equals(SetOrganizationMemberRoleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.SetOrganizationMemberRoleResponse
+ */
+export class SetOrganizationMemberRoleResponse extends Message<SetOrganizationMemberRoleResponse> {
+  constructor(data?: PartialMessage<SetOrganizationMemberRoleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.SetOrganizationMemberRoleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetOrganizationMemberRoleResponse {
+    return new SetOrganizationMemberRoleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetOrganizationMemberRoleResponse {
+    return new SetOrganizationMemberRoleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetOrganizationMemberRoleResponse {
+    return new SetOrganizationMemberRoleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetOrganizationMemberRoleResponse | PlainMessage<SetOrganizationMemberRoleResponse> | undefined, b: SetOrganizationMemberRoleResponse | PlainMessage<SetOrganizationMemberRoleResponse> | undefined): boolean {
+    return proto3.util.equals(SetOrganizationMemberRoleResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListSuperusersRequest
+ */
+export class ListSuperusersRequest extends Message<ListSuperusersRequest> {
+  constructor(data?: PartialMessage<ListSuperusersRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListSuperusersRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSuperusersRequest {
+    return new ListSuperusersRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSuperusersRequest {
+    return new ListSuperusersRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSuperusersRequest {
+    return new ListSuperusersRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSuperusersRequest | PlainMessage<ListSuperusersRequest> | undefined, b: ListSuperusersRequest | PlainMessage<ListSuperusersRequest> | undefined): boolean {
+    return proto3.util.equals(ListSuperusersRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListSuperusersResponse
+ */
+export class ListSuperusersResponse extends Message<ListSuperusersResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.User users = 1;
+   */
+  users: User[] = [];
+
+  constructor(data?: PartialMessage<ListSuperusersResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListSuperusersResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "users", kind: "message", T: User, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSuperusersResponse {
+    return new ListSuperusersResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSuperusersResponse {
+    return new ListSuperusersResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSuperusersResponse {
+    return new ListSuperusersResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSuperusersResponse | PlainMessage<ListSuperusersResponse> | undefined, b: ListSuperusersResponse | PlainMessage<ListSuperusersResponse> | undefined): boolean {
+    return proto3.util.equals(ListSuperusersResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.SetSuperuserRequest
+ */
+export class SetSuperuserRequest extends Message<SetSuperuserRequest> {
+  /**
+   * @generated from field: string email = 1;
+   */
+  email = "";
+
+  /**
+   * @generated from field: bool superuser = 2;
+   */
+  superuser = false;
+
+  constructor(data?: PartialMessage<SetSuperuserRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.SetSuperuserRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "superuser", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetSuperuserRequest {
+    return new SetSuperuserRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetSuperuserRequest {
+    return new SetSuperuserRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetSuperuserRequest {
+    return new SetSuperuserRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetSuperuserRequest | PlainMessage<SetSuperuserRequest> | undefined, b: SetSuperuserRequest | PlainMessage<SetSuperuserRequest> | undefined): boolean {
+    return proto3.util.equals(SetSuperuserRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.SetSuperuserResponse
+ */
+export class SetSuperuserResponse extends Message<SetSuperuserResponse> {
+  constructor(data?: PartialMessage<SetSuperuserResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.SetSuperuserResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetSuperuserResponse {
+    return new SetSuperuserResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetSuperuserResponse {
+    return new SetSuperuserResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetSuperuserResponse {
+    return new SetSuperuserResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetSuperuserResponse | PlainMessage<SetSuperuserResponse> | undefined, b: SetSuperuserResponse | PlainMessage<SetSuperuserResponse> | undefined): boolean {
+    return proto3.util.equals(SetSuperuserResponse, a, b);
+  }
+}
+
+/**
+ * SudoGetResourceRequest
+ *
+ * @generated from message rill.admin.v1.SudoGetResourceRequest
+ */
+export class SudoGetResourceRequest extends Message<SudoGetResourceRequest> {
+  /**
+   * @generated from oneof rill.admin.v1.SudoGetResourceRequest.id
+   */
+  id: {
+    /**
+     * @generated from field: string user_id = 1;
+     */
+    value: string;
+    case: "userId";
+  } | {
+    /**
+     * @generated from field: string org_id = 2;
+     */
+    value: string;
+    case: "orgId";
+  } | {
+    /**
+     * @generated from field: string project_id = 3;
+     */
+    value: string;
+    case: "projectId";
+  } | {
+    /**
+     * @generated from field: string deployment_id = 4;
+     */
+    value: string;
+    case: "deploymentId";
+  } | {
+    /**
+     * @generated from field: string instance_id = 5;
+     */
+    value: string;
+    case: "instanceId";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<SudoGetResourceRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.SudoGetResourceRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "id" },
+    { no: 2, name: "org_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "id" },
+    { no: 3, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "id" },
+    { no: 4, name: "deployment_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "id" },
+    { no: 5, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "id" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SudoGetResourceRequest {
+    return new SudoGetResourceRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SudoGetResourceRequest {
+    return new SudoGetResourceRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SudoGetResourceRequest {
+    return new SudoGetResourceRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SudoGetResourceRequest | PlainMessage<SudoGetResourceRequest> | undefined, b: SudoGetResourceRequest | PlainMessage<SudoGetResourceRequest> | undefined): boolean {
+    return proto3.util.equals(SudoGetResourceRequest, a, b);
+  }
+}
+
+/**
+ * SudoGetResourceResponse
+ *
+ * @generated from message rill.admin.v1.SudoGetResourceResponse
+ */
+export class SudoGetResourceResponse extends Message<SudoGetResourceResponse> {
+  /**
+   * @generated from oneof rill.admin.v1.SudoGetResourceResponse.resource
+   */
+  resource: {
+    /**
+     * @generated from field: rill.admin.v1.User user = 1;
+     */
+    value: User;
+    case: "user";
+  } | {
+    /**
+     * @generated from field: rill.admin.v1.Organization org = 2;
+     */
+    value: Organization;
+    case: "org";
+  } | {
+    /**
+     * @generated from field: rill.admin.v1.Project project = 3;
+     */
+    value: Project;
+    case: "project";
+  } | {
+    /**
+     * @generated from field: rill.admin.v1.Deployment deployment = 4;
+     */
+    value: Deployment;
+    case: "deployment";
+  } | {
+    /**
+     * @generated from field: rill.admin.v1.Deployment instance = 5;
+     */
+    value: Deployment;
+    case: "instance";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<SudoGetResourceResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.SudoGetResourceResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user", kind: "message", T: User, oneof: "resource" },
+    { no: 2, name: "org", kind: "message", T: Organization, oneof: "resource" },
+    { no: 3, name: "project", kind: "message", T: Project, oneof: "resource" },
+    { no: 4, name: "deployment", kind: "message", T: Deployment, oneof: "resource" },
+    { no: 5, name: "instance", kind: "message", T: Deployment, oneof: "resource" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SudoGetResourceResponse {
+    return new SudoGetResourceResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SudoGetResourceResponse {
+    return new SudoGetResourceResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SudoGetResourceResponse {
+    return new SudoGetResourceResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SudoGetResourceResponse | PlainMessage<SudoGetResourceResponse> | undefined, b: SudoGetResourceResponse | PlainMessage<SudoGetResourceResponse> | undefined): boolean {
+    return proto3.util.equals(SudoGetResourceResponse, a, b);
+  }
+}
+
+/**
+ * SudoUpdateOrganizationQuotasRequest
+ *
+ * @generated from message rill.admin.v1.SudoUpdateOrganizationQuotasRequest
+ */
+export class SudoUpdateOrganizationQuotasRequest extends Message<SudoUpdateOrganizationQuotasRequest> {
+  /**
+   * @generated from field: string org_name = 1;
+   */
+  orgName = "";
+
+  /**
+   * @generated from field: optional uint32 projects = 2;
+   */
+  projects?: number;
+
+  /**
+   * @generated from field: optional uint32 deployments = 3;
+   */
+  deployments?: number;
+
+  /**
+   * @generated from field: optional uint32 slots_total = 4;
+   */
+  slotsTotal?: number;
+
+  /**
+   * @generated from field: optional uint32 slots_per_deployment = 5;
+   */
+  slotsPerDeployment?: number;
+
+  /**
+   * @generated from field: optional uint32 outstanding_invites = 6;
+   */
+  outstandingInvites?: number;
+
+  constructor(data?: PartialMessage<SudoUpdateOrganizationQuotasRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.SudoUpdateOrganizationQuotasRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "org_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "projects", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 3, name: "deployments", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 4, name: "slots_total", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 5, name: "slots_per_deployment", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 6, name: "outstanding_invites", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SudoUpdateOrganizationQuotasRequest {
+    return new SudoUpdateOrganizationQuotasRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SudoUpdateOrganizationQuotasRequest {
+    return new SudoUpdateOrganizationQuotasRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SudoUpdateOrganizationQuotasRequest {
+    return new SudoUpdateOrganizationQuotasRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SudoUpdateOrganizationQuotasRequest | PlainMessage<SudoUpdateOrganizationQuotasRequest> | undefined, b: SudoUpdateOrganizationQuotasRequest | PlainMessage<SudoUpdateOrganizationQuotasRequest> | undefined): boolean {
+    return proto3.util.equals(SudoUpdateOrganizationQuotasRequest, a, b);
+  }
+}
+
+/**
+ * SudoUpdateOrganizationQuotasResponse
+ *
+ * @generated from message rill.admin.v1.SudoUpdateOrganizationQuotasResponse
+ */
+export class SudoUpdateOrganizationQuotasResponse extends Message<SudoUpdateOrganizationQuotasResponse> {
+  /**
+   * @generated from field: rill.admin.v1.Organization organization = 1;
+   */
+  organization?: Organization;
+
+  constructor(data?: PartialMessage<SudoUpdateOrganizationQuotasResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.SudoUpdateOrganizationQuotasResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "message", T: Organization },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SudoUpdateOrganizationQuotasResponse {
+    return new SudoUpdateOrganizationQuotasResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SudoUpdateOrganizationQuotasResponse {
+    return new SudoUpdateOrganizationQuotasResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SudoUpdateOrganizationQuotasResponse {
+    return new SudoUpdateOrganizationQuotasResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SudoUpdateOrganizationQuotasResponse | PlainMessage<SudoUpdateOrganizationQuotasResponse> | undefined, b: SudoUpdateOrganizationQuotasResponse | PlainMessage<SudoUpdateOrganizationQuotasResponse> | undefined): boolean {
+    return proto3.util.equals(SudoUpdateOrganizationQuotasResponse, a, b);
+  }
+}
+
+/**
+ * SudoUpdateUserQuotasRequest
+ *
+ * @generated from message rill.admin.v1.SudoUpdateUserQuotasRequest
+ */
+export class SudoUpdateUserQuotasRequest extends Message<SudoUpdateUserQuotasRequest> {
+  /**
+   * @generated from field: string email = 1;
+   */
+  email = "";
+
+  /**
+   * @generated from field: optional uint32 singleuser_orgs = 2;
+   */
+  singleuserOrgs?: number;
+
+  constructor(data?: PartialMessage<SudoUpdateUserQuotasRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.SudoUpdateUserQuotasRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "singleuser_orgs", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SudoUpdateUserQuotasRequest {
+    return new SudoUpdateUserQuotasRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SudoUpdateUserQuotasRequest {
+    return new SudoUpdateUserQuotasRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SudoUpdateUserQuotasRequest {
+    return new SudoUpdateUserQuotasRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SudoUpdateUserQuotasRequest | PlainMessage<SudoUpdateUserQuotasRequest> | undefined, b: SudoUpdateUserQuotasRequest | PlainMessage<SudoUpdateUserQuotasRequest> | undefined): boolean {
+    return proto3.util.equals(SudoUpdateUserQuotasRequest, a, b);
+  }
+}
+
+/**
+ * SudoUpdateUserQuotasResponse
+ *
+ * @generated from message rill.admin.v1.SudoUpdateUserQuotasResponse
+ */
+export class SudoUpdateUserQuotasResponse extends Message<SudoUpdateUserQuotasResponse> {
+  /**
+   * @generated from field: rill.admin.v1.User user = 1;
+   */
+  user?: User;
+
+  constructor(data?: PartialMessage<SudoUpdateUserQuotasResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.SudoUpdateUserQuotasResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user", kind: "message", T: User },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SudoUpdateUserQuotasResponse {
+    return new SudoUpdateUserQuotasResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SudoUpdateUserQuotasResponse {
+    return new SudoUpdateUserQuotasResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SudoUpdateUserQuotasResponse {
+    return new SudoUpdateUserQuotasResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SudoUpdateUserQuotasResponse | PlainMessage<SudoUpdateUserQuotasResponse> | undefined, b: SudoUpdateUserQuotasResponse | PlainMessage<SudoUpdateUserQuotasResponse> | undefined): boolean {
+    return proto3.util.equals(SudoUpdateUserQuotasResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.CreateUsergroupRequest
+ */
+export class CreateUsergroupRequest extends Message<CreateUsergroupRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description = "";
+
+  constructor(data?: PartialMessage<CreateUsergroupRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.CreateUsergroupRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateUsergroupRequest {
+    return new CreateUsergroupRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateUsergroupRequest {
+    return new CreateUsergroupRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateUsergroupRequest {
+    return new CreateUsergroupRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateUsergroupRequest | PlainMessage<CreateUsergroupRequest> | undefined, b: CreateUsergroupRequest | PlainMessage<CreateUsergroupRequest> | undefined): boolean {
+    return proto3.util.equals(CreateUsergroupRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.CreateUsergroupResponse
+ */
+export class CreateUsergroupResponse extends Message<CreateUsergroupResponse> {
+  /**
+   * @generated from field: rill.admin.v1.Usergroup usergroup = 1;
+   */
+  usergroup?: Usergroup;
+
+  constructor(data?: PartialMessage<CreateUsergroupResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.CreateUsergroupResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "usergroup", kind: "message", T: Usergroup },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateUsergroupResponse {
+    return new CreateUsergroupResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateUsergroupResponse {
+    return new CreateUsergroupResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateUsergroupResponse {
+    return new CreateUsergroupResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateUsergroupResponse | PlainMessage<CreateUsergroupResponse> | undefined, b: CreateUsergroupResponse | PlainMessage<CreateUsergroupResponse> | undefined): boolean {
+    return proto3.util.equals(CreateUsergroupResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GetUsergroupRequest
+ */
+export class GetUsergroupRequest extends Message<GetUsergroupRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string usergroup = 2;
+   */
+  usergroup = "";
+
+  constructor(data?: PartialMessage<GetUsergroupRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetUsergroupRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUsergroupRequest {
+    return new GetUsergroupRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUsergroupRequest {
+    return new GetUsergroupRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUsergroupRequest {
+    return new GetUsergroupRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetUsergroupRequest | PlainMessage<GetUsergroupRequest> | undefined, b: GetUsergroupRequest | PlainMessage<GetUsergroupRequest> | undefined): boolean {
+    return proto3.util.equals(GetUsergroupRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GetUsergroupResponse
+ */
+export class GetUsergroupResponse extends Message<GetUsergroupResponse> {
+  /**
+   * @generated from field: rill.admin.v1.Usergroup usergroup = 1;
+   */
+  usergroup?: Usergroup;
+
+  constructor(data?: PartialMessage<GetUsergroupResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetUsergroupResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "usergroup", kind: "message", T: Usergroup },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUsergroupResponse {
+    return new GetUsergroupResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUsergroupResponse {
+    return new GetUsergroupResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUsergroupResponse {
+    return new GetUsergroupResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetUsergroupResponse | PlainMessage<GetUsergroupResponse> | undefined, b: GetUsergroupResponse | PlainMessage<GetUsergroupResponse> | undefined): boolean {
+    return proto3.util.equals(GetUsergroupResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListUsergroupsRequest
+ */
+export class ListUsergroupsRequest extends Message<ListUsergroupsRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: uint32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListUsergroupsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListUsergroupsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListUsergroupsRequest {
+    return new ListUsergroupsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListUsergroupsRequest {
+    return new ListUsergroupsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListUsergroupsRequest {
+    return new ListUsergroupsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListUsergroupsRequest | PlainMessage<ListUsergroupsRequest> | undefined, b: ListUsergroupsRequest | PlainMessage<ListUsergroupsRequest> | undefined): boolean {
+    return proto3.util.equals(ListUsergroupsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListUsergroupsResponse
+ */
+export class ListUsergroupsResponse extends Message<ListUsergroupsResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.Usergroup usergroups = 1;
+   */
+  usergroups: Usergroup[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListUsergroupsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListUsergroupsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "usergroups", kind: "message", T: Usergroup, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListUsergroupsResponse {
+    return new ListUsergroupsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListUsergroupsResponse {
+    return new ListUsergroupsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListUsergroupsResponse {
+    return new ListUsergroupsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListUsergroupsResponse | PlainMessage<ListUsergroupsResponse> | undefined, b: ListUsergroupsResponse | PlainMessage<ListUsergroupsResponse> | undefined): boolean {
+    return proto3.util.equals(ListUsergroupsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.EditUsergroupRequest
+ */
+export class EditUsergroupRequest extends Message<EditUsergroupRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string usergroup = 2;
+   */
+  usergroup = "";
+
+  /**
+   * @generated from field: optional string new_name = 3;
+   */
+  newName?: string;
+
+  /**
+   * @generated from field: optional string description = 4;
+   */
+  description?: string;
+
+  constructor(data?: PartialMessage<EditUsergroupRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.EditUsergroupRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "new_name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EditUsergroupRequest {
+    return new EditUsergroupRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EditUsergroupRequest {
+    return new EditUsergroupRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EditUsergroupRequest {
+    return new EditUsergroupRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: EditUsergroupRequest | PlainMessage<EditUsergroupRequest> | undefined, b: EditUsergroupRequest | PlainMessage<EditUsergroupRequest> | undefined): boolean {
+    return proto3.util.equals(EditUsergroupRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.EditUsergroupResponse
+ */
+export class EditUsergroupResponse extends Message<EditUsergroupResponse> {
+  /**
+   * @generated from field: rill.admin.v1.Usergroup usergroup = 1;
+   */
+  usergroup?: Usergroup;
+
+  constructor(data?: PartialMessage<EditUsergroupResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.EditUsergroupResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "usergroup", kind: "message", T: Usergroup },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EditUsergroupResponse {
+    return new EditUsergroupResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EditUsergroupResponse {
+    return new EditUsergroupResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EditUsergroupResponse {
+    return new EditUsergroupResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: EditUsergroupResponse | PlainMessage<EditUsergroupResponse> | undefined, b: EditUsergroupResponse | PlainMessage<EditUsergroupResponse> | undefined): boolean {
+    return proto3.util.equals(EditUsergroupResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.DeleteUsergroupRequest
+ */
+export class DeleteUsergroupRequest extends Message<DeleteUsergroupRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string usergroup = 2;
+   */
+  usergroup = "";
+
+  constructor(data?: PartialMessage<DeleteUsergroupRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.DeleteUsergroupRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteUsergroupRequest {
+    return new DeleteUsergroupRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteUsergroupRequest {
+    return new DeleteUsergroupRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteUsergroupRequest {
+    return new DeleteUsergroupRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteUsergroupRequest | PlainMessage<DeleteUsergroupRequest> | undefined, b: DeleteUsergroupRequest | PlainMessage<DeleteUsergroupRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteUsergroupRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.DeleteUsergroupResponse
  */
-export class LeaveOrganizationResponse extends Message<LeaveOrganizationResponse> {
-  constructor(data?: PartialMessage<LeaveOrganizationResponse>) {
+export class DeleteUsergroupResponse extends Message<DeleteUsergroupResponse> {
+  constructor(data?: PartialMessage<DeleteUsergroupResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.LeaveOrganizationResponse";
+  static readonly typeName = "rill.admin.v1.DeleteUsergroupResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LeaveOrganizationResponse {
-    return new LeaveOrganizationResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteUsergroupResponse {
+    return new DeleteUsergroupResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LeaveOrganizationResponse {
-    return new LeaveOrganizationResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteUsergroupResponse {
+    return new DeleteUsergroupResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LeaveOrganizationResponse {
-    return new LeaveOrganizationResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteUsergroupResponse {
+    return new DeleteUsergroupResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: LeaveOrganizationResponse | PlainMessage<LeaveOrganizationResponse> | undefined, b: LeaveOrganizationResponse | PlainMessage<LeaveOrganizationResponse> | undefined): boolean {
-    return proto3.util.equals(LeaveOrganizationResponse, a, b);
+  static equals(a: DeleteUsergroupResponse | PlainMessage<DeleteUsergroupResponse> | undefined, b: DeleteUsergroupResponse | PlainMessage<DeleteUsergroupResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteUsergroupResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.SetOrganizationMemberRoleRequest
+ * @generated from message rill.admin.v1.ListUsergroupMembersRequest
  */
-export class SetOrganizationMemberRoleRequest extends Message<SetOrganizationMemberRoleRequest> {
+export class ListUsergroupMembersRequest extends Message<ListUsergroupMembersRequest> {
   /**
    * @generated from field: string organization = 1;
    */
   organization = "";
 
   /**
-   * @generated from field: string email = 2;
+   * @generated from field: string usergroup = 2;
+   */
+  usergroup = "";
+
+  /**
+   * @generated from field: uint32 page_size = 3;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 4;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListUsergroupMembersRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListUsergroupMembersRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListUsergroupMembersRequest {
+    return new ListUsergroupMembersRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListUsergroupMembersRequest {
+    return new ListUsergroupMembersRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListUsergroupMembersRequest {
+    return new ListUsergroupMembersRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListUsergroupMembersRequest | PlainMessage<ListUsergroupMembersRequest> | undefined, b: ListUsergroupMembersRequest | PlainMessage<ListUsergroupMembersRequest> | undefined): boolean {
+    return proto3.util.equals(ListUsergroupMembersRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListUsergroupMembersResponse
+ */
+export class ListUsergroupMembersResponse extends Message<ListUsergroupMembersResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.Member members = 1;
+   */
+  members: Member[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListUsergroupMembersResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListUsergroupMembersResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "members", kind: "message", T: Member, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListUsergroupMembersResponse {
+    return new ListUsergroupMembersResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListUsergroupMembersResponse {
+    return new ListUsergroupMembersResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListUsergroupMembersResponse {
+    return new ListUsergroupMembersResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListUsergroupMembersResponse | PlainMessage<ListUsergroupMembersResponse> | undefined, b: ListUsergroupMembersResponse | PlainMessage<ListUsergroupMembersResponse> | undefined): boolean {
+    return proto3.util.equals(ListUsergroupMembersResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.AddUsergroupMemberRequest
+ */
+export class AddUsergroupMemberRequest extends Message<AddUsergroupMemberRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string usergroup = 2;
+   */
+  usergroup = "";
+
+  /**
+   * @generated from field: string email = 3;
+   */
+  email = "";
+
+  constructor(data?: PartialMessage<AddUsergroupMemberRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.AddUsergroupMemberRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddUsergroupMemberRequest {
+    return new AddUsergroupMemberRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddUsergroupMemberRequest {
+    return new AddUsergroupMemberRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddUsergroupMemberRequest {
+    return new AddUsergroupMemberRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddUsergroupMemberRequest | PlainMessage<AddUsergroupMemberRequest> | undefined, b: AddUsergroupMemberRequest | PlainMessage<AddUsergroupMemberRequest> | undefined): boolean {
+    return proto3.util.equals(AddUsergroupMemberRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.AddUsergroupMemberResponse
+ */
+export class AddUsergroupMemberResponse extends Message<AddUsergroupMemberResponse> {
+  constructor(data?: PartialMessage<AddUsergroupMemberResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.AddUsergroupMemberResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddUsergroupMemberResponse {
+    return new AddUsergroupMemberResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddUsergroupMemberResponse {
+    return new AddUsergroupMemberResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddUsergroupMemberResponse {
+    return new AddUsergroupMemberResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddUsergroupMemberResponse | PlainMessage<AddUsergroupMemberResponse> | undefined, b: AddUsergroupMemberResponse | PlainMessage<AddUsergroupMemberResponse> | undefined): boolean {
+    return proto3.util.equals(AddUsergroupMemberResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.RemoveUsergroupMemberRequest
+ */
+export class RemoveUsergroupMemberRequest extends Message<RemoveUsergroupMemberRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string usergroup = 2;
+   */
+  usergroup = "";
+
+  /**
+   * @generated from field: string email = 3;
    */
   email = "";
 
+  constructor(data?: PartialMessage<RemoveUsergroupMemberRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RemoveUsergroupMemberRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveUsergroupMemberRequest {
+    return new RemoveUsergroupMemberRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveUsergroupMemberRequest {
+    return new RemoveUsergroupMemberRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveUsergroupMemberRequest {
+    return new RemoveUsergroupMemberRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveUsergroupMemberRequest | PlainMessage<RemoveUsergroupMemberRequest> | undefined, b: RemoveUsergroupMemberRequest | PlainMessage<RemoveUsergroupMemberRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveUsergroupMemberRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.RemoveUsergroupMemberResponse
+ */
+export class RemoveUsergroupMemberResponse extends Message<RemoveUsergroupMemberResponse> {
+  constructor(data?: PartialMessage<RemoveUsergroupMemberResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RemoveUsergroupMemberResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveUsergroupMemberResponse {
+    return new RemoveUsergroupMemberResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveUsergroupMemberResponse {
+    return new RemoveUsergroupMemberResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveUsergroupMemberResponse {
+    return new RemoveUsergroupMemberResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveUsergroupMemberResponse | PlainMessage<RemoveUsergroupMemberResponse> | undefined, b: RemoveUsergroupMemberResponse | PlainMessage<RemoveUsergroupMemberResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveUsergroupMemberResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListOrganizationMemberUsergroupsRequest
+ */
+export class ListOrganizationMemberUsergroupsRequest extends Message<ListOrganizationMemberUsergroupsRequest> {
   /**
-   * @generated from field: string role = 3;
+   * @generated from field: string organization = 1;
    */
-  role = "";
+  organization = "";
 
-  constructor(data?: PartialMessage<SetOrganizationMemberRoleRequest>) {
+  /**
+   * @generated from field: uint32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListOrganizationMemberUsergroupsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.SetOrganizationMemberRoleRequest";
+  static readonly typeName = "rill.admin.v1.ListOrganizationMemberUsergroupsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetOrganizationMemberRoleRequest {
-    return new SetOrganizationMemberRoleRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationMemberUsergroupsRequest {
+    return new ListOrganizationMemberUsergroupsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetOrganizationMemberRoleRequest {
-    return new SetOrganizationMemberRoleRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationMemberUsergroupsRequest {
+    return new ListOrganizationMemberUsergroupsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetOrganizationMemberRoleRequest {
-    return new SetOrganizationMemberRoleRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationMemberUsergroupsRequest {
+    return new ListOrganizationMemberUsergroupsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SetOrganizationMemberRoleRequest | PlainMessage<SetOrganizationMemberRoleRequest> | undefined, b: SetOrganizationMemberRoleRequest | PlainMessage<SetOrganizationMemberRoleRequest> | undefined): boolean {
-    return proto3.util.equals(SetOrganizationMemberRoleRequest, a, b);
+  static equals(a: ListOrganizationMemberUsergroupsRequest | PlainMessage<ListOrganizationMemberUsergroupsRequest> | undefined, b: ListOrganizationMemberUsergroupsRequest | PlainMessage<ListOrganizationMemberUsergroupsRequest> | undefined): boolean {
+    return proto3.util.equals(ListOrganizationMemberUsergroupsRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.SetOrganizationMemberRoleResponse
+ * @generated from message rill.admin.v1.ListOrganizationMemberUsergroupsResponse
  */
-export class SetOrganizationMemberRoleResponse extends Message<SetOrganizationMemberRoleResponse> {
-  constructor(data?: PartialMessage<SetOrganizationMemberRoleResponse>) {
+export class ListOrganizationMemberUsergroupsResponse extends Message<ListOrganizationMemberUsergroupsResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.MemberUsergroup members = 1;
+   */
+  members: MemberUsergroup[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListOrganizationMemberUsergroupsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.SetOrganizationMemberRoleResponse";
+  static readonly typeName = "rill.admin.v1.ListOrganizationMemberUsergroupsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "members", kind: "message", T: MemberUsergroup, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetOrganizationMemberRoleResponse {
-    return new SetOrganizationMemberRoleResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationMemberUsergroupsResponse {
+    return new ListOrganizationMemberUsergroupsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetOrganizationMemberRoleResponse {
-    return new SetOrganizationMemberRoleResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationMemberUsergroupsResponse {
+    return new ListOrganizationMemberUsergroupsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetOrganizationMemberRoleResponse {
-    return new SetOrganizationMemberRoleResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationMemberUsergroupsResponse {
+    return new ListOrganizationMemberUsergroupsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SetOrganizationMemberRoleResponse | PlainMessage<SetOrganizationMemberRoleResponse> | undefined, b: SetOrganizationMemberRoleResponse | PlainMessage<SetOrganizationMemberRoleResponse> | undefined): boolean {
-    return proto3.util.equals(SetOrganizationMemberRoleResponse, a, b);
+  static equals(a: ListOrganizationMemberUsergroupsResponse | PlainMessage<ListOrganizationMemberUsergroupsResponse> | undefined, b: ListOrganizationMemberUsergroupsResponse | PlainMessage<ListOrganizationMemberUsergroupsResponse> | undefined): boolean {
+    return proto3.util.equals(ListOrganizationMemberUsergroupsResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.ListSuperusersRequest
+ * @generated from message rill.admin.v1.SetOrganizationMemberUsergroupRoleRequest
  */
-export class ListSuperusersRequest extends Message<ListSuperusersRequest> {
-  constructor(data?: PartialMessage<ListSuperusersRequest>) {
+export class SetOrganizationMemberUsergroupRoleRequest extends Message<SetOrganizationMemberUsergroupRoleRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string usergroup = 2;
+   */
+  usergroup = "";
+
+  /**
+   * @generated from field: string role = 3;
+   */
+  role = "";
+
+  constructor(data?: PartialMessage<SetOrganizationMemberUsergroupRoleRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.ListSuperusersRequest";
+  static readonly typeName = "rill.admin.v1.SetOrganizationMemberUsergroupRoleRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSuperusersRequest {
-    return new ListSuperusersRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetOrganizationMemberUsergroupRoleRequest {
+    return new SetOrganizationMemberUsergroupRoleRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSuperusersRequest {
-    return new ListSuperusersRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetOrganizationMemberUsergroupRoleRequest {
+    return new SetOrganizationMemberUsergroupRoleRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSuperusersRequest {
-    return new ListSuperusersRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetOrganizationMemberUsergroupRoleRequest {
+    return new SetOrganizationMemberUsergroupRoleRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListSuperusersRequest | PlainMessage<ListSuperusersRequest> | undefined, b: ListSuperusersRequest | PlainMessage<ListSuperusersRequest> | undefined): boolean {
-    return proto3.util.equals(ListSuperusersRequest, a, b);
+  static equals(a: SetOrganizationMemberUsergroupRoleRequest | PlainMessage<SetOrganizationMemberUsergroupRoleRequest> | undefined, b: SetOrganizationMemberUsergroupRoleRequest | PlainMessage<SetOrganizationMemberUsergroupRoleRequest> | undefined): boolean {
+    return proto3.util.equals(SetOrganizationMemberUsergroupRoleRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.ListSuperusersResponse
+ * @generated from message rill.admin.v1.SetOrganizationMemberUsergroupRoleResponse
  */
-export class ListSuperusersResponse extends Message<ListSuperusersResponse> {
-  /**
-   * @generated from field: repeated rill.admin.v1.User users = 1;
-   */
-  users: User[] = [];
-
-  constructor(data?: PartialMessage<ListSuperusersResponse>) {
+export class SetOrganizationMemberUsergroupRoleResponse extends Message<SetOrganizationMemberUsergroupRoleResponse> {
+  constructor(data?: PartialMessage<SetOrganizationMemberUsergroupRoleResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.ListSuperusersResponse";
+  static readonly typeName = "rill.admin.v1.SetOrganizationMemberUsergroupRoleResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "users", kind: "message", T: User, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSuperusersResponse {
-    return new ListSuperusersResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetOrganizationMemberUsergroupRoleResponse {
+    return new SetOrganizationMemberUsergroupRoleResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSuperusersResponse {
-    return new ListSuperusersResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetOrganizationMemberUsergroupRoleResponse {
+    return new SetOrganizationMemberUsergroupRoleResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSuperusersResponse {
-    return new ListSuperusersResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetOrganizationMemberUsergroupRoleResponse {
+    return new SetOrganizationMemberUsergroupRoleResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListSuperusersResponse | PlainMessage<ListSuperusersResponse> | undefined, b: ListSuperusersResponse | PlainMessage<ListSuperusersResponse> | undefined): boolean {
-    return proto3.util.equals(ListSuperusersResponse, a, b);
+  static equals(a: SetOrganizationMemberUsergroupRoleResponse | PlainMessage<SetOrganizationMemberUsergroupRoleResponse> | undefined, b: SetOrganizationMemberUsergroupRoleResponse | PlainMessage<SetOrganizationMemberUsergroupRoleResponse> | undefined): boolean {
+    return proto3.util.equals(SetOrganizationMemberUsergroupRoleResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.SetSuperuserRequest
+ * @generated from message rill.admin.v1.RemoveOrganizationMemberUsergroupRequest
  */
-export class SetSuperuserRequest extends Message<SetSuperuserRequest> {
+export class RemoveOrganizationMemberUsergroupRequest extends Message<RemoveOrganizationMemberUsergroupRequest> {
   /**
-   * @generated from field: string email = 1;
+   * @generated from field: string organization = 1;
    */
-  email = "";
+  organization = "";
 
   /**
-   * @generated from field: bool superuser = 2;
+   * @generated from field: string usergroup = 2;
    */
-  superuser = false;
+  usergroup = "";
 
-  constructor(data?: PartialMessage<SetSuperuserRequest>) {
+  constructor(data?: PartialMessage<RemoveOrganizationMemberUsergroupRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.SetSuperuserRequest";
+  static readonly typeName = "rill.admin.v1.RemoveOrganizationMemberUsergroupRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "superuser", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetSuperuserRequest {
-    return new SetSuperuserRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveOrganizationMemberUsergroupRequest {
+    return new RemoveOrganizationMemberUsergroupRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetSuperuserRequest {
-    return new SetSuperuserRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveOrganizationMemberUsergroupRequest {
+    return new RemoveOrganizationMemberUsergroupRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetSuperuserRequest {
-    return new SetSuperuserRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveOrganizationMemberUsergroupRequest {
+    return new RemoveOrganizationMemberUsergroupRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SetSuperuserRequest | PlainMessage<SetSuperuserRequest> | undefined, b: SetSuperuserRequest | PlainMessage<SetSuperuserRequest> | undefined): boolean {
-    return proto3.util.equals(SetSuperuserRequest, a, b);
+  static equals(a: RemoveOrganizationMemberUsergroupRequest | PlainMessage<RemoveOrganizationMemberUsergroupRequest> | undefined, b: RemoveOrganizationMemberUsergroupRequest | PlainMessage<RemoveOrganizationMemberUsergroupRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveOrganizationMemberUsergroupRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.SetSuperuserResponse
+ * @generated from message rill.admin.v1.RemoveOrganizationMemberUsergroupResponse
  */
-export class SetSuperuserResponse extends Message<SetSuperuserResponse> {
-  constructor(data?: PartialMessage<SetSuperuserResponse>) {
+export class RemoveOrganizationMemberUsergroupResponse extends Message<RemoveOrganizationMemberUsergroupResponse> {
+  constructor(data?: PartialMessage<RemoveOrganizationMemberUsergroupResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.SetSuperuserResponse";
+  static readonly typeName = "rill.admin.v1.RemoveOrganizationMemberUsergroupResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetSuperuserResponse {
-    return new SetSuperuserResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveOrganizationMemberUsergroupResponse {
+    return new RemoveOrganizationMemberUsergroupResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetSuperuserResponse {
-    return new SetSuperuserResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveOrganizationMemberUsergroupResponse {
+    return new RemoveOrganizationMemberUsergroupResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetSuperuserResponse {
-    return new SetSuperuserResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveOrganizationMemberUsergroupResponse {
+    return new RemoveOrganizationMemberUsergroupResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SetSuperuserResponse | PlainMessage<SetSuperuserResponse> | undefined, b: SetSuperuserResponse | PlainMessage<SetSuperuserResponse> | undefined): boolean {
-    return proto3.util.equals(SetSuperuserResponse, a, b);
+  static equals(a: RemoveOrganizationMemberUsergroupResponse | PlainMessage<RemoveOrganizationMemberUsergroupResponse> | undefined, b: RemoveOrganizationMemberUsergroupResponse | PlainMessage<RemoveOrganizationMemberUsergroupResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveOrganizationMemberUsergroupResponse, a, b);
   }
 }
 
 /**
- * SudoGetResourceRequest
- *
- * @generated from message rill.admin.v1.SudoGetResourceRequest
+ * @generated from message rill.admin.v1.ListProjectMemberUsergroupsRequest
  */
-export class SudoGetResourceRequest extends Message<SudoGetResourceRequest> {
+export class ListProjectMemberUsergroupsRequest extends Message<ListProjectMemberUsergroupsRequest> {
   /**
-   * @generated from oneof rill.admin.v1.SudoGetResourceRequest.id
+   * @generated from field: string organization = 1;
    */
-  id: {
-    /**
-     * @generated from field: string user_id = 1;
-     */
-    value: string;
-    case: "userId";
-  } | {
-    /**
-     * @generated from field: string org_id = 2;
-     */
-    value: string;
-    case: "orgId";
-  } | {
-    /**
-     * @generated from field: string project_id = 3;
-     */
-    value: string;
-    case: "projectId";
-  } | {
-    /**
-     * @generated from field: string deployment_id = 4;
-     */
-    value: string;
-    case: "deploymentId";
-  } | {
-    /**
-     * @generated from field: string instance_id = 5;
-     */
-    value: string;
-    case: "instanceId";
-  } | { case: undefined; value?: undefined } = { case: undefined };
+  organization = "";
 
-  constructor(data?: PartialMessage<SudoGetResourceRequest>) {
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: uint32 page_size = 3;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 4;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListProjectMemberUsergroupsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.SudoGetResourceRequest";
+  static readonly typeName = "rill.admin.v1.ListProjectMemberUsergroupsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "id" },
-    { no: 2, name: "org_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "id" },
-    { no: 3, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "id" },
-    { no: 4, name: "deployment_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "id" },
-    { no: 5, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "id" },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SudoGetResourceRequest {
-    return new SudoGetResourceRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProjectMemberUsergroupsRequest {
+    return new ListProjectMemberUsergroupsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SudoGetResourceRequest {
-    return new SudoGetResourceRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListProjectMemberUsergroupsRequest {
+    return new ListProjectMemberUsergroupsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SudoGetResourceRequest {
-    return new SudoGetResourceRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListProjectMemberUsergroupsRequest {
+    return new ListProjectMemberUsergroupsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SudoGetResourceRequest | PlainMessage<SudoGetResourceRequest> | undefined, b: SudoGetResourceRequest | PlainMessage<SudoGetResourceRequest> | undefined): boolean {
-    return proto3.util.equals(SudoGetResourceRequest, a, b);
+  static equals(a: ListProjectMemberUsergroupsRequest | PlainMessage<ListProjectMemberUsergroupsRequest> | undefined, b: ListProjectMemberUsergroupsRequest | PlainMessage<ListProjectMemberUsergroupsRequest> | undefined): boolean {
+    return proto3.util.equals(ListProjectMemberUsergroupsRequest, a, b);
   }
 }
 
 /**
- * SudoGetResourceResponse
- *
- * @generated from message rill.admin.v1.SudoGetResourceResponse
+ * @generated from message rill.admin.v1.ListProjectMemberUsergroupsResponse
  */
-export class SudoGetResourceResponse extends Message<SudoGetResourceResponse> {
+export class ListProjectMemberUsergroupsResponse extends Message<ListProjectMemberUsergroupsResponse> {
   /**
-   * @generated from oneof rill.admin.v1.SudoGetResourceResponse.resource
+   * @generated from field: repeated rill.admin.v1.MemberUsergroup members = 1;
    */
-  resource: {
-    /**
-     * @generated from field: rill.admin.v1.User user = 1;
-     */
-    value: User;
-    case: "user";
-  } | {
-    /**
-     * @generated from field: rill.admin.v1.Organization org = 2;
-     */
-    value: Organization;
-    case: "org";
-  } | {
-    /**
-     * @generated from field: rill.admin.v1.Project project = 3;
-     */
-    value: Project;
-    case: "project";
-  } | {
-    /**
-     * @generated from field: rill.admin.v1.Deployment deployment = 4;
-     */
-    value: Deployment;
-    case: "deployment";
-  } | {
-    /**
-     * @generated from field: rill.admin.v1.Deployment instance = 5;
-     */
-    value: Deployment;
-    case: "instance";
-  } | { case: undefined; value?: undefined } = { case: undefined };
+  members: MemberUsergroup[] = [];
 
-  constructor(data?: PartialMessage<SudoGetResourceResponse>) {
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListProjectMemberUsergroupsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.SudoGetResourceResponse";
+  static readonly typeName = "rill.admin.v1.ListProjectMemberUsergroupsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "user", kind: "message", T: User, oneof: "resource" },
-    { no: 2, name: "org", kind: "message", T: Organization, oneof: "resource" },
-    { no: 3, name: "project", kind: "message", T: Project, oneof: "resource" },
-    { no: 4, name: "deployment", kind: "message", T: Deployment, oneof: "resource" },
-    { no: 5, name: "instance", kind: "message", T: Deployment, oneof: "resource" },
+    { no: 1, name: "members", kind: "message", T: MemberUsergroup, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SudoGetResourceResponse {
-    return new SudoGetResourceResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProjectMemberUsergroupsResponse {
+    return new ListProjectMemberUsergroupsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SudoGetResourceResponse {
-    return new SudoGetResourceResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListProjectMemberUsergroupsResponse {
+    return new ListProjectMemberUsergroupsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SudoGetResourceResponse {
-    return new SudoGetResourceResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListProjectMemberUsergroupsResponse {
+    return new ListProjectMemberUsergroupsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SudoGetResourceResponse | PlainMessage<SudoGetResourceResponse> | undefined, b: SudoGetResourceResponse | PlainMessage<SudoGetResourceResponse> | undefined): boolean {
-    return proto3.util.equals(SudoGetResourceResponse, a, b);
+  static equals(a: ListProjectMemberUsergroupsResponse | PlainMessage<ListProjectMemberUsergroupsResponse> | undefined, b: ListProjectMemberUsergroupsResponse | PlainMessage<ListProjectMemberUsergroupsResponse> | undefined): boolean {
+    return proto3.util.equals(ListProjectMemberUsergroupsResponse, a, b);
   }
 }
 
 /**
- * SudoUpdateOrganizationQuotasRequest
- *
- * @generated from message rill.admin.v1.SudoUpdateOrganizationQuotasRequest
+ * @generated from message rill.admin.v1.SetProjectMemberUsergroupRoleRequest
  */
-export class SudoUpdateOrganizationQuotasRequest extends Message<SudoUpdateOrganizationQuotasRequest> {
-  /**
-   * @generated from field: string org_name = 1;
-   */
-  orgName = "";
-
-  /**
-   * @generated from field: optional uint32 projects = 2;
-   */
-  projects?: number;
-
+export class SetProjectMemberUsergroupRoleRequest extends Message<SetProjectMemberUsergroupRoleRequest> {
   /**
-   * @generated from field: optional uint32 deployments = 3;
+   * @generated from field: string organization = 1;
    */
-  deployments?: number;
+  organization = "";
 
   /**
-   * @generated from field: optional uint32 slots_total = 4;
+   * @generated from field: string project = 2;
    */
-  slotsTotal?: number;
+  project = "";
 
   /**
-   * @generated from field: optional uint32 slots_per_deployment = 5;
+   * @generated from field: string usergroup = 3;
    */
-  slotsPerDeployment?: number;
+  usergroup = "";
 
   /**
-   * @generated from field: optional uint32 outstanding_invites = 6;
+   * @generated from field: string role = 4;
    */
-  outstandingInvites?: number;
+  role = "";
 
-  constructor(data?: PartialMessage<SudoUpdateOrganizationQuotasRequest>) {
+  constructor(data?: PartialMessage<SetProjectMemberUsergroupRoleRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.SudoUpdateOrganizationQuotasRequest";
+  static readonly typeName = "rill.admin.v1.SetProjectMemberUsergroupRoleRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "org_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "projects", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
-    { no: 3, name: "deployments", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
-    { no: 4, name: "slots_total", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
-    { no: 5, name: "slots_per_deployment", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
-    { no: 6, name: "outstanding_invites", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SudoUpdateOrganizationQuotasRequest {
-    return new SudoUpdateOrganizationQuotasRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetProjectMemberUsergroupRoleRequest {
+    return new SetProjectMemberUsergroupRoleRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SudoUpdateOrganizationQuotasRequest {
-    return new SudoUpdateOrganizationQuotasRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetProjectMemberUsergroupRoleRequest {
+    return new SetProjectMemberUsergroupRoleRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SudoUpdateOrganizationQuotasRequest {
-    return new SudoUpdateOrganizationQuotasRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetProjectMemberUsergroupRoleRequest {
+    return new SetProjectMemberUsergroupRoleRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SudoUpdateOrganizationQuotasRequest | PlainMessage<SudoUpdateOrganizationQuotasRequest> | undefined, b: SudoUpdateOrganizationQuotasRequest | PlainMessage<SudoUpdateOrganizationQuotasRequest> | undefined): boolean {
-    return proto3.util.equals(SudoUpdateOrganizationQuotasRequest, a, b);
+  static equals(a: SetProjectMemberUsergroupRoleRequest | PlainMessage<SetProjectMemberUsergroupRoleRequest> | undefined, b: SetProjectMemberUsergroupRoleRequest | PlainMessage<SetProjectMemberUsergroupRoleRequest> | undefined): boolean {
+    return proto3.util.equals(SetProjectMemberUsergroupRoleRequest, a, b);
   }
 }
 
 /**
- * SudoUpdateOrganizationQuotasResponse
- *
- * @generated from message rill.admin.v1.SudoUpdateOrganizationQuotasResponse
+ * @generated from message rill.admin.v1.SetProjectMemberUsergroupRoleResponse
  */
-export class SudoUpdateOrganizationQuotasResponse extends Message<SudoUpdateOrganizationQuotasResponse> {
-  /**
-   * @generated from field: rill.admin.v1.Organization organization = 1;
-   */
-  organization?: Organization;
-
-  constructor(data?: PartialMessage<SudoUpdateOrganizationQuotasResponse>) {
+export class SetProjectMemberUsergroupRoleResponse extends Message<SetProjectMemberUsergroupRoleResponse> {
+  constructor(data?: PartialMessage<SetProjectMemberUsergroupRoleResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.SudoUpdateOrganizationQuotasResponse";
+  static readonly typeName = "rill.admin.v1.SetProjectMemberUsergroupRoleResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization", kind: "message", T: Organization },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SudoUpdateOrganizationQuotasResponse {
-    return new SudoUpdateOrganizationQuotasResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetProjectMemberUsergroupRoleResponse {
+    return new SetProjectMemberUsergroupRoleResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SudoUpdateOrganizationQuotasResponse {
-    return new SudoUpdateOrganizationQuotasResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetProjectMemberUsergroupRoleResponse {
+    return new SetProjectMemberUsergroupRoleResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SudoUpdateOrganizationQuotasResponse {
-    return new SudoUpdateOrganizationQuotasResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetProjectMemberUsergroupRoleResponse {
+    return new SetProjectMemberUsergroupRoleResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SudoUpdateOrganizationQuotasResponse | PlainMessage<SudoUpdateOrganizationQuotasResponse> | undefined, b: SudoUpdateOrganizationQuotasResponse | PlainMessage<SudoUpdateOrganizationQuotasResponse> | undefined): boolean {
-    return proto3.util.equals(SudoUpdateOrganizationQuotasResponse, a, b);
+  static equals(a: SetProjectMemberUsergroupRoleResponse | PlainMessage<SetProjectMemberUsergroupRoleResponse> | undefined, b: SetProjectMemberUsergroupRoleResponse | PlainMessage<SetProjectMemberUsergroupRoleResponse> | undefined): boolean {
+    return proto3.util.equals(SetProjectMemberUsergroupRoleResponse, a, b);
   }
 }
 
 /**
- * SudoUpdateUserQuotasRequest
- *
- * @generated from message rill.admin.v1.SudoUpdateUserQuotasRequest
+ * @generated from message rill.admin.v1.RemoveProjectMemberUsergroupRequest
  */
-export class SudoUpdateUserQuotasRequest extends Message<SudoUpdateUserQuotasRequest> {
+export class RemoveProjectMemberUsergroupRequest extends Message<RemoveProjectMemberUsergroupRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
   /**
-   * @generated from field: string email = 1;
+   * @generated from field: string project = 2;
    */
-  email = "";
+  project = "";
 
   /**
-   * @generated from field: optional uint32 singleuser_orgs = 2;
+   * @generated from field: string usergroup = 3;
    */
-  singleuserOrgs?: number;
+  usergroup = "";
 
-  constructor(data?: PartialMessage<SudoUpdateUserQuotasRequest>) {
+  constructor(data?: PartialMessage<RemoveProjectMemberUsergroupRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.SudoUpdateUserQuotasRequest";
+  static readonly typeName = "rill.admin.v1.RemoveProjectMemberUsergroupRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "singleuser_orgs", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SudoUpdateUserQuotasRequest {
-    return new SudoUpdateUserQuotasRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveProjectMemberUsergroupRequest {
+    return new RemoveProjectMemberUsergroupRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SudoUpdateUserQuotasRequest {
-    return new SudoUpdateUserQuotasRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveProjectMemberUsergroupRequest {
+    return new RemoveProjectMemberUsergroupRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SudoUpdateUserQuotasRequest {
-    return new SudoUpdateUserQuotasRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveProjectMemberUsergroupRequest {
+    return new RemoveProjectMemberUsergroupRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SudoUpdateUserQuotasRequest | PlainMessage<SudoUpdateUserQuotasRequest> | undefined, b: SudoUpdateUserQuotasRequest | PlainMessage<SudoUpdateUserQuotasRequest> | undefined): boolean {
-    return proto3.util.equals(SudoUpdateUserQuotasRequest, a, b);
+  static equals(a: RemoveProjectMemberUsergroupRequest | PlainMessage<RemoveProjectMemberUsergroupRequest> | undefined, b: RemoveProjectMemberUsergroupRequest | PlainMessage<RemoveProjectMemberUsergroupRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveProjectMemberUsergroupRequest, a, b);
   }
 }
 
 /**
- * SudoUpdateUserQuotasResponse
- *
- * @generated from message rill.admin.v1.SudoUpdateUserQuotasResponse
+ * @generated from message rill.admin.v1.RemoveProjectMemberUsergroupResponse
  */
-export class SudoUpdateUserQuotasResponse extends Message<SudoUpdateUserQuotasResponse> {
-  /**
-   * @generated from field: rill.admin.v1.User user = 1;
-   */
-  user?: User;
-
-  constructor(data?: PartialMessage<SudoUpdateUserQuotasResponse>) {
+export class RemoveProjectMemberUsergroupResponse extends Message<RemoveProjectMemberUsergroupResponse> {
+  constructor(data?: PartialMessage<RemoveProjectMemberUsergroupResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.SudoUpdateUserQuotasResponse";
+  static readonly typeName = "rill.admin.v1.RemoveProjectMemberUsergroupResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "user", kind: "message", T: User },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SudoUpdateUserQuotasResponse {
-    return new SudoUpdateUserQuotasResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveProjectMemberUsergroupResponse {
+    return new RemoveProjectMemberUsergroupResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SudoUpdateUserQuotasResponse {
-    return new SudoUpdateUserQuotasResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveProjectMemberUsergroupResponse {
+    return new RemoveProjectMemberUsergroupResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SudoUpdateUserQuotasResponse {
-    return new SudoUpdateUserQuotasResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveProjectMemberUsergroupResponse {
+    return new RemoveProjectMemberUsergroupResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SudoUpdateUserQuotasResponse | PlainMessage<SudoUpdateUserQuotasResponse> | undefined, b: SudoUpdateUserQuotasResponse | PlainMessage<SudoUpdateUserQuotasResponse> | undefined): boolean {
-    return proto3.util.equals(SudoUpdateUserQuotasResponse, a, b);
+  static equals(a: RemoveProjectMemberUsergroupResponse | PlainMessage<RemoveProjectMemberUsergroupResponse> | undefined, b: RemoveProjectMemberUsergroupResponse | PlainMessage<RemoveProjectMemberUsergroupResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveProjectMemberUsergroupResponse, a, b);
   }
 }
 
@@ -5358,6 +7076,35 @@ export class Project extends Message<Project> {
    */
   prodTtlSeconds = protoInt64.zero;
 
+  /**
+   * @generated from field: bool preview_enabled = 19;
+   */
+  previewEnabled = false;
+
+  /**
+   * @generated from field: int64 preview_ttl_seconds = 20;
+   */
+  previewTtlSeconds = protoInt64.zero;
+
+  /**
+   * @generated from field: string git_remote = 21;
+   */
+  gitRemote = "";
+
+  /**
+   * Secret for authenticating push webhooks from the Git remote (only set for users that can read project variables)
+   *
+   * @generated from field: string git_webhook_secret = 22;
+   */
+  gitWebhookSecret = "";
+
+  /**
+   * ID of the uploaded archive the project is deployed from (if not deployed from a Git repository)
+   *
+   * @generated from field: string archive_asset_id = 23;
+   */
+  archiveAssetId = "";
+
   /**
    * @generated from field: google.protobuf.Timestamp created_on = 14;
    */
@@ -5392,6 +7139,11 @@ export class Project extends Message<Project> {
     { no: 13, name: "prod_deployment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 16, name: "frontend_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 18, name: "prod_ttl_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 19, name: "preview_enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 20, name: "preview_ttl_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 21, name: "git_remote", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 22, name: "git_webhook_secret", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 23, name: "archive_asset_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 14, name: "created_on", kind: "message", T: Timestamp },
     { no: 15, name: "updated_on", kind: "message", T: Timestamp },
   ]);
@@ -5413,6 +7165,72 @@ export class Project extends Message<Project> {
   }
 }
 
+/**
+ * GitCredentials authenticate access to a Git remote that's not on Github.
+ * Username and password (or an access token) are used for HTTPS remotes. An SSH private key (such as a deploy key) is used for SSH remotes.
+ *
+ * @generated from message rill.admin.v1.GitCredentials
+ */
+export class GitCredentials extends Message<GitCredentials> {
+  /**
+   * @generated from field: string username = 1;
+   */
+  username = "";
+
+  /**
+   * @generated from field: string password = 2;
+   */
+  password = "";
+
+  /**
+   * @generated from field: string private_key = 3;
+   */
+  privateKey = "";
+
+  /**
+   * @generated from field: string private_key_password = 4;
+   */
+  privateKeyPassword = "";
+
+  /**
+   * SSH host keys to accept in known_hosts format
+   *
+   * @generated from field: string known_hosts = 5;
+   */
+  knownHosts = "";
+
+  constructor(data?: PartialMessage<GitCredentials>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GitCredentials";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "password", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "private_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "private_key_password", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "known_hosts", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GitCredentials {
+    return new GitCredentials().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GitCredentials {
+    return new GitCredentials().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GitCredentials {
+    return new GitCredentials().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GitCredentials | PlainMessage<GitCredentials> | undefined, b: GitCredentials | PlainMessage<GitCredentials> | undefined): boolean {
+    return proto3.util.equals(GitCredentials, a, b);
+  }
+}
+
 /**
  * @generated from message rill.admin.v1.Deployment
  */
@@ -5457,6 +7275,16 @@ export class Deployment extends Message<Deployment> {
    */
   logs = "";
 
+  /**
+   * @generated from field: bool preview = 11;
+   */
+  preview = false;
+
+  /**
+   * @generated from field: int64 preview_ttl_seconds = 12;
+   */
+  previewTtlSeconds = protoInt64.zero;
+
   /**
    * @generated from field: google.protobuf.Timestamp created_on = 9;
    */
@@ -5483,6 +7311,8 @@ export class Deployment extends Message<Deployment> {
     { no: 6, name: "runtime_instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 7, name: "status", kind: "enum", T: proto3.getEnumType(DeploymentStatus) },
     { no: 8, name: "logs", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "preview", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 12, name: "preview_ttl_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 9, name: "created_on", kind: "message", T: Timestamp },
     { no: 10, name: "updated_on", kind: "message", T: Timestamp },
   ]);
@@ -5631,6 +7461,11 @@ export class ProjectPermissions extends Message<ProjectPermissions> {
    */
   manageProjectMembers = false;
 
+  /**
+   * @generated from field: bool read_project_variables = 11;
+   */
+  readProjectVariables = false;
+
   constructor(data?: PartialMessage<ProjectPermissions>) {
     super();
     proto3.util.initPartial(data, this);
@@ -5649,6 +7484,7 @@ export class ProjectPermissions extends Message<ProjectPermissions> {
     { no: 8, name: "manage_dev", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 9, name: "read_project_members", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 10, name: "manage_project_members", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 11, name: "read_project_variables", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProjectPermissions {
@@ -5735,6 +7571,227 @@ export class Member extends Message<Member> {
   }
 }
 
+/**
+ * @generated from message rill.admin.v1.Usergroup
+ */
+export class Usergroup extends Message<Usergroup> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description = "";
+
+  /**
+   * Managed groups (like the group of all org members) can't be edited or deleted
+   *
+   * @generated from field: bool managed = 4;
+   */
+  managed = false;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 5;
+   */
+  createdOn?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_on = 6;
+   */
+  updatedOn?: Timestamp;
+
+  constructor(data?: PartialMessage<Usergroup>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.Usergroup";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "managed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "created_on", kind: "message", T: Timestamp },
+    { no: 6, name: "updated_on", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Usergroup {
+    return new Usergroup().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Usergroup {
+    return new Usergroup().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Usergroup {
+    return new Usergroup().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Usergroup | PlainMessage<Usergroup> | undefined, b: Usergroup | PlainMessage<Usergroup> | undefined): boolean {
+    return proto3.util.equals(Usergroup, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.MemberUsergroup
+ */
+export class MemberUsergroup extends Message<MemberUsergroup> {
+  /**
+   * @generated from field: string group_id = 1;
+   */
+  groupId = "";
+
+  /**
+   * @generated from field: string group_name = 2;
+   */
+  groupName = "";
+
+  /**
+   * @generated from field: string role_name = 3;
+   */
+  roleName = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 4;
+   */
+  createdOn?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_on = 5;
+   */
+  updatedOn?: Timestamp;
+
+  constructor(data?: PartialMessage<MemberUsergroup>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.MemberUsergroup";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "group_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "group_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "role_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "created_on", kind: "message", T: Timestamp },
+    { no: 5, name: "updated_on", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MemberUsergroup {
+    return new MemberUsergroup().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MemberUsergroup {
+    return new MemberUsergroup().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MemberUsergroup {
+    return new MemberUsergroup().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MemberUsergroup | PlainMessage<MemberUsergroup> | undefined, b: MemberUsergroup | PlainMessage<MemberUsergroup> | undefined): boolean {
+    return proto3.util.equals(MemberUsergroup, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.AuditEvent
+ */
+export class AuditEvent extends Message<AuditEvent> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 2;
+   */
+  createdOn?: Timestamp;
+
+  /**
+   * @generated from field: string project = 3;
+   */
+  project = "";
+
+  /**
+   * Type of the actor that took the action ("user", "service" or "anon")
+   *
+   * @generated from field: string actor_type = 4;
+   */
+  actorType = "";
+
+  /**
+   * @generated from field: string actor_id = 5;
+   */
+  actorId = "";
+
+  /**
+   * @generated from field: string actor_email = 6;
+   */
+  actorEmail = "";
+
+  /**
+   * Name of the API method that was called
+   *
+   * @generated from field: string action = 7;
+   */
+  action = "";
+
+  /**
+   * @generated from field: string target = 8;
+   */
+  target = "";
+
+  /**
+   * State before and after the action, with secrets redacted
+   *
+   * @generated from field: google.protobuf.Struct diff = 9;
+   */
+  diff?: Struct;
+
+  constructor(data?: PartialMessage<AuditEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.AuditEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "created_on", kind: "message", T: Timestamp },
+    { no: 3, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "actor_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "actor_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "actor_email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "action", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "target", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "diff", kind: "message", T: Struct },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AuditEvent {
+    return new AuditEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AuditEvent {
+    return new AuditEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AuditEvent {
+    return new AuditEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AuditEvent | PlainMessage<AuditEvent> | undefined, b: AuditEvent | PlainMessage<AuditEvent> | undefined): boolean {
+    return proto3.util.equals(AuditEvent, a, b);
+  }
+}
+
 /**
  * @generated from message rill.admin.v1.UserInvite
  */
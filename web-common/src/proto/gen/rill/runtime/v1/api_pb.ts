@@ -5,7 +5,7 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64, Struct, Timestamp } from "@bufbuild/protobuf";
-import { PullTriggerSpec, RefreshTriggerSpec, Resource, ResourceName } from "./resources_pb.js";
+import { LineageColumn, ParseError, PullTriggerSpec, RefreshTriggerSpec, Resource, ResourceName } from "./resources_pb.js";
 import { MetricsView, Model, ObjectType, Source, Table } from "./catalog_pb.js";
 
 /**
@@ -100,6 +100,58 @@ proto3.util.setEnumType(ResourceEvent, "rill.runtime.v1.ResourceEvent", [
   { no: 2, name: "RESOURCE_EVENT_DELETE" },
 ]);
 
+/**
+ * @generated from enum rill.runtime.v1.PlannedChangeType
+ */
+export enum PlannedChangeType {
+  /**
+   * @generated from enum value: PLANNED_CHANGE_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: PLANNED_CHANGE_TYPE_CREATE = 1;
+   */
+  CREATE = 1,
+
+  /**
+   * @generated from enum value: PLANNED_CHANGE_TYPE_DELETE = 2;
+   */
+  DELETE = 2,
+
+  /**
+   * @generated from enum value: PLANNED_CHANGE_TYPE_RENAME = 3;
+   */
+  RENAME = 3,
+
+  /**
+   * The spec changes, but the resource's data does not need to be re-created
+   *
+   * @generated from enum value: PLANNED_CHANGE_TYPE_UPDATE = 4;
+   */
+  UPDATE = 4,
+
+  /**
+   * @generated from enum value: PLANNED_CHANGE_TYPE_REINGEST = 5;
+   */
+  REINGEST = 5,
+
+  /**
+   * @generated from enum value: PLANNED_CHANGE_TYPE_REBUILD = 6;
+   */
+  REBUILD = 6,
+}
+// Retrieve enum metadata with: proto3.getEnumType(PlannedChangeType)
+proto3.util.setEnumType(PlannedChangeType, "rill.runtime.v1.PlannedChangeType", [
+  { no: 0, name: "PLANNED_CHANGE_TYPE_UNSPECIFIED" },
+  { no: 1, name: "PLANNED_CHANGE_TYPE_CREATE" },
+  { no: 2, name: "PLANNED_CHANGE_TYPE_DELETE" },
+  { no: 3, name: "PLANNED_CHANGE_TYPE_RENAME" },
+  { no: 4, name: "PLANNED_CHANGE_TYPE_UPDATE" },
+  { no: 5, name: "PLANNED_CHANGE_TYPE_REINGEST" },
+  { no: 6, name: "PLANNED_CHANGE_TYPE_REBUILD" },
+]);
+
 /**
  * Request message for RuntimeService.Ping
  *
@@ -251,6 +303,13 @@ export class Instance extends Message<Instance> {
    */
   connectors: Connector[] = [];
 
+  /**
+   * limits applied to queries before they run, unset means no limits
+   *
+   * @generated from field: rill.runtime.v1.QueryLimits query_limits = 11;
+   */
+  queryLimits?: QueryLimits;
+
   constructor(data?: PartialMessage<Instance>) {
     super();
     proto3.util.initPartial(data, this);
@@ -267,6 +326,7 @@ export class Instance extends Message<Instance> {
     { no: 8, name: "project_variables", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
     { no: 9, name: "ingestion_limit_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 10, name: "connectors", kind: "message", T: Connector, repeated: true },
+    { no: 11, name: "query_limits", kind: "message", T: QueryLimits },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Instance {
@@ -286,6 +346,89 @@ export class Instance extends Message<Instance> {
   }
 }
 
+/**
+ * QueryLimits are guardrails for the cost of queries against an instance.
+ * The runtime estimates the cost of a query before running it and rejects it with code RESOURCE_EXHAUSTED if it exceeds a limit.
+ * A zero value means no limit.
+ *
+ * @generated from message rill.runtime.v1.QueryLimits
+ */
+export class QueryLimits extends Message<QueryLimits> {
+  /**
+   * Max number of rows a query may scan
+   *
+   * @generated from field: int64 max_scanned_rows = 1;
+   */
+  maxScannedRows = protoInt64.zero;
+
+  /**
+   * Max number of columns a query may scan
+   *
+   * @generated from field: int64 max_scanned_columns = 2;
+   */
+  maxScannedColumns = protoInt64.zero;
+
+  /**
+   * Max number of rows a query may return
+   *
+   * @generated from field: int64 max_result_rows = 3;
+   */
+  maxResultRows = protoInt64.zero;
+
+  /**
+   * Max estimated size of a query's result in bytes
+   *
+   * @generated from field: int64 max_result_bytes = 4;
+   */
+  maxResultBytes = protoInt64.zero;
+
+  /**
+   * Max number of queries a user (identified by the JWT subject) may run concurrently. Further queries wait in a queue.
+   *
+   * @generated from field: int64 max_concurrent_queries_per_user = 5;
+   */
+  maxConcurrentQueriesPerUser = protoInt64.zero;
+
+  /**
+   * If true, queries that exceed a cost limit are run with the lowest priority instead of being rejected
+   *
+   * @generated from field: bool downgrade = 6;
+   */
+  downgrade = false;
+
+  constructor(data?: PartialMessage<QueryLimits>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.QueryLimits";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "max_scanned_rows", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "max_scanned_columns", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "max_result_rows", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "max_result_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "max_concurrent_queries_per_user", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "downgrade", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryLimits {
+    return new QueryLimits().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryLimits {
+    return new QueryLimits().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryLimits {
+    return new QueryLimits().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: QueryLimits | PlainMessage<QueryLimits> | undefined, b: QueryLimits | PlainMessage<QueryLimits> | undefined): boolean {
+    return proto3.util.equals(QueryLimits, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.Connector
  */
@@ -556,6 +699,11 @@ export class CreateInstanceRequest extends Message<CreateInstanceRequest> {
    */
   connectors: Connector[] = [];
 
+  /**
+   * @generated from field: rill.runtime.v1.QueryLimits query_limits = 11;
+   */
+  queryLimits?: QueryLimits;
+
   constructor(data?: PartialMessage<CreateInstanceRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -572,6 +720,7 @@ export class CreateInstanceRequest extends Message<CreateInstanceRequest> {
     { no: 8, name: "ingestion_limit_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 9, name: "annotations", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
     { no: 10, name: "connectors", kind: "message", T: Connector, repeated: true },
+    { no: 11, name: "query_limits", kind: "message", T: QueryLimits },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateInstanceRequest {
@@ -750,6 +899,11 @@ export class EditInstanceRequest extends Message<EditInstanceRequest> {
    */
   annotations: { [key: string]: string } = {};
 
+  /**
+   * @generated from field: rill.runtime.v1.QueryLimits query_limits = 11;
+   */
+  queryLimits?: QueryLimits;
+
   constructor(data?: PartialMessage<EditInstanceRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -765,6 +919,7 @@ export class EditInstanceRequest extends Message<EditInstanceRequest> {
     { no: 8, name: "ingestion_limit_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
     { no: 9, name: "connectors", kind: "message", T: Connector, repeated: true },
     { no: 10, name: "annotations", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 11, name: "query_limits", kind: "message", T: QueryLimits },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EditInstanceRequest {
@@ -2290,6 +2445,251 @@ export class GetResourceResponse extends Message<GetResourceResponse> {
   }
 }
 
+/**
+ * @generated from message rill.runtime.v1.GetColumnLineageRequest
+ */
+export class GetColumnLineageRequest extends Message<GetColumnLineageRequest> {
+  /**
+   * @generated from field: string instance_id = 1;
+   */
+  instanceId = "";
+
+  /**
+   * @generated from field: rill.runtime.v1.ResourceName name = 2;
+   */
+  name?: ResourceName;
+
+  /**
+   * @generated from field: string column = 3;
+   */
+  column = "";
+
+  constructor(data?: PartialMessage<GetColumnLineageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.GetColumnLineageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "message", T: ResourceName },
+    { no: 3, name: "column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetColumnLineageRequest {
+    return new GetColumnLineageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetColumnLineageRequest {
+    return new GetColumnLineageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetColumnLineageRequest {
+    return new GetColumnLineageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetColumnLineageRequest | PlainMessage<GetColumnLineageRequest> | undefined, b: GetColumnLineageRequest | PlainMessage<GetColumnLineageRequest> | undefined): boolean {
+    return proto3.util.equals(GetColumnLineageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.GetColumnLineageResponse
+ */
+export class GetColumnLineageResponse extends Message<GetColumnLineageResponse> {
+  /**
+   * Columns the column is derived from, including transitive dependencies
+   *
+   * @generated from field: repeated rill.runtime.v1.LineageColumn upstream = 1;
+   */
+  upstream: LineageColumn[] = [];
+
+  /**
+   * Columns derived from the column, including transitive dependents
+   *
+   * @generated from field: repeated rill.runtime.v1.LineageColumn downstream = 2;
+   */
+  downstream: LineageColumn[] = [];
+
+  constructor(data?: PartialMessage<GetColumnLineageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.GetColumnLineageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "upstream", kind: "message", T: LineageColumn, repeated: true },
+    { no: 2, name: "downstream", kind: "message", T: LineageColumn, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetColumnLineageResponse {
+    return new GetColumnLineageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetColumnLineageResponse {
+    return new GetColumnLineageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetColumnLineageResponse {
+    return new GetColumnLineageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetColumnLineageResponse | PlainMessage<GetColumnLineageResponse> | undefined, b: GetColumnLineageResponse | PlainMessage<GetColumnLineageResponse> | undefined): boolean {
+    return proto3.util.equals(GetColumnLineageResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.PlanReconcileRequest
+ */
+export class PlanReconcileRequest extends Message<PlanReconcileRequest> {
+  /**
+   * @generated from field: string instance_id = 1;
+   */
+  instanceId = "";
+
+  /**
+   * Project files to plan against, keyed by path. If empty, the instance's repo is used.
+   *
+   * @generated from field: map<string, string> files = 2;
+   */
+  files: { [key: string]: string } = {};
+
+  constructor(data?: PartialMessage<PlanReconcileRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.PlanReconcileRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "files", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlanReconcileRequest {
+    return new PlanReconcileRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlanReconcileRequest {
+    return new PlanReconcileRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlanReconcileRequest {
+    return new PlanReconcileRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PlanReconcileRequest | PlainMessage<PlanReconcileRequest> | undefined, b: PlanReconcileRequest | PlainMessage<PlanReconcileRequest> | undefined): boolean {
+    return proto3.util.equals(PlanReconcileRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.PlanReconcileResponse
+ */
+export class PlanReconcileResponse extends Message<PlanReconcileResponse> {
+  /**
+   * @generated from field: repeated rill.runtime.v1.PlannedChange changes = 1;
+   */
+  changes: PlannedChange[] = [];
+
+  /**
+   * @generated from field: repeated rill.runtime.v1.ParseError parse_errors = 2;
+   */
+  parseErrors: ParseError[] = [];
+
+  constructor(data?: PartialMessage<PlanReconcileResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.PlanReconcileResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "changes", kind: "message", T: PlannedChange, repeated: true },
+    { no: 2, name: "parse_errors", kind: "message", T: ParseError, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlanReconcileResponse {
+    return new PlanReconcileResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlanReconcileResponse {
+    return new PlanReconcileResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlanReconcileResponse {
+    return new PlanReconcileResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PlanReconcileResponse | PlainMessage<PlanReconcileResponse> | undefined, b: PlanReconcileResponse | PlainMessage<PlanReconcileResponse> | undefined): boolean {
+    return proto3.util.equals(PlanReconcileResponse, a, b);
+  }
+}
+
+/**
+ * PlannedChange describes a change that a reconcile would make to a resource
+ *
+ * @generated from message rill.runtime.v1.PlannedChange
+ */
+export class PlannedChange extends Message<PlannedChange> {
+  /**
+   * @generated from field: rill.runtime.v1.ResourceName name = 1;
+   */
+  name?: ResourceName;
+
+  /**
+   * @generated from field: rill.runtime.v1.PlannedChangeType type = 2;
+   */
+  type = PlannedChangeType.UNSPECIFIED;
+
+  /**
+   * Current name of the resource if type is PLANNED_CHANGE_TYPE_RENAME
+   *
+   * @generated from field: rill.runtime.v1.ResourceName renamed_from = 3;
+   */
+  renamedFrom?: ResourceName;
+
+  /**
+   * Human-readable explanation of why the change is planned
+   *
+   * @generated from field: string reason = 4;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<PlannedChange>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.PlannedChange";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "message", T: ResourceName },
+    { no: 2, name: "type", kind: "enum", T: proto3.getEnumType(PlannedChangeType) },
+    { no: 3, name: "renamed_from", kind: "message", T: ResourceName },
+    { no: 4, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlannedChange {
+    return new PlannedChange().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlannedChange {
+    return new PlannedChange().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlannedChange {
+    return new PlannedChange().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PlannedChange | PlainMessage<PlannedChange> | undefined, b: PlannedChange | PlainMessage<PlannedChange> | undefined): boolean {
+    return proto3.util.equals(PlannedChange, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.CreateTriggerRequest
  */
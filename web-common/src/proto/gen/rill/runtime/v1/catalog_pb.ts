@@ -502,6 +502,16 @@ export class MetricsView_Measure extends Message<MetricsView_Measure> {
    */
   validPercentOfTotal = false;
 
+  /**
+   * @generated from field: repeated string referenced_measures = 7;
+   */
+  referencedMeasures: string[] = [];
+
+  /**
+   * @generated from field: rill.runtime.v1.MetricsView.MeasureWindow window = 8;
+   */
+  window?: MetricsView_MeasureWindow;
+
   constructor(data?: PartialMessage<MetricsView_Measure>) {
     super();
     proto3.util.initPartial(data, this);
@@ -516,6 +526,8 @@ export class MetricsView_Measure extends Message<MetricsView_Measure> {
     { no: 4, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 5, name: "format", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 6, name: "valid_percent_of_total", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "referenced_measures", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 8, name: "window", kind: "message", T: MetricsView_MeasureWindow },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsView_Measure {
@@ -535,6 +547,49 @@ export class MetricsView_Measure extends Message<MetricsView_Measure> {
   }
 }
 
+/**
+ * @generated from message rill.runtime.v1.MetricsView.MeasureWindow
+ */
+export class MetricsView_MeasureWindow extends Message<MetricsView_MeasureWindow> {
+  /**
+   * @generated from field: bool partition = 1;
+   */
+  partition = false;
+
+  /**
+   * @generated from field: string frame = 2;
+   */
+  frame = "";
+
+  constructor(data?: PartialMessage<MetricsView_MeasureWindow>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.MetricsView.MeasureWindow";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "partition", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "frame", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsView_MeasureWindow {
+    return new MetricsView_MeasureWindow().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetricsView_MeasureWindow {
+    return new MetricsView_MeasureWindow().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetricsView_MeasureWindow {
+    return new MetricsView_MeasureWindow().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetricsView_MeasureWindow | PlainMessage<MetricsView_MeasureWindow> | undefined, b: MetricsView_MeasureWindow | PlainMessage<MetricsView_MeasureWindow> | undefined): boolean {
+    return proto3.util.equals(MetricsView_MeasureWindow, a, b);
+  }
+}
+
 /**
  * Security for the metrics view
  *
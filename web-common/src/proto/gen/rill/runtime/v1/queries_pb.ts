@@ -6,6 +6,7 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64, Struct, Timestamp, Value } from "@bufbuild/protobuf";
 import { StructType } from "./schema_pb.js";
+import { Expression } from "./expression_pb.js";
 import { TimeGrain } from "./time_grain_pb.js";
 
 /**
@@ -430,10 +431,22 @@ export class MetricsViewAggregationRequest extends Message<MetricsViewAggregatio
   timeEnd?: Timestamp;
 
   /**
+   * Deprecated. Use where instead.
+   *
    * @generated from field: rill.runtime.v1.MetricsViewFilter filter = 8;
    */
   filter?: MetricsViewFilter;
 
+  /**
+   * @generated from field: rill.runtime.v1.Expression where = 12;
+   */
+  where?: Expression;
+
+  /**
+   * @generated from field: rill.runtime.v1.Expression having = 13;
+   */
+  having?: Expression;
+
   /**
    * @generated from field: int64 limit = 9;
    */
@@ -465,6 +478,8 @@ export class MetricsViewAggregationRequest extends Message<MetricsViewAggregatio
     { no: 6, name: "time_start", kind: "message", T: Timestamp },
     { no: 7, name: "time_end", kind: "message", T: Timestamp },
     { no: 8, name: "filter", kind: "message", T: MetricsViewFilter },
+    { no: 12, name: "where", kind: "message", T: Expression },
+    { no: 13, name: "having", kind: "message", T: Expression },
     { no: 9, name: "limit", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 10, name: "offset", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 11, name: "priority", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
@@ -728,10 +743,22 @@ export class MetricsViewToplistRequest extends Message<MetricsViewToplistRequest
   sort: MetricsViewSort[] = [];
 
   /**
+   * Deprecated. Use where instead.
+   *
    * @generated from field: rill.runtime.v1.MetricsViewFilter filter = 10;
    */
   filter?: MetricsViewFilter;
 
+  /**
+   * @generated from field: rill.runtime.v1.Expression where = 13;
+   */
+  where?: Expression;
+
+  /**
+   * @generated from field: rill.runtime.v1.Expression having = 14;
+   */
+  having?: Expression;
+
   /**
    * @generated from field: int32 priority = 11;
    */
@@ -756,6 +783,8 @@ export class MetricsViewToplistRequest extends Message<MetricsViewToplistRequest
     { no: 8, name: "offset", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 9, name: "sort", kind: "message", T: MetricsViewSort, repeated: true },
     { no: 10, name: "filter", kind: "message", T: MetricsViewFilter },
+    { no: 13, name: "where", kind: "message", T: Expression },
+    { no: 14, name: "having", kind: "message", T: Expression },
     { no: 11, name: "priority", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
@@ -868,10 +897,22 @@ export class MetricsViewComparisonToplistRequest extends Message<MetricsViewComp
   sort: MetricsViewComparisonSort[] = [];
 
   /**
+   * Deprecated. Use where instead.
+   *
    * @generated from field: rill.runtime.v1.MetricsViewFilter filter = 9;
    */
   filter?: MetricsViewFilter;
 
+  /**
+   * @generated from field: rill.runtime.v1.Expression where = 13;
+   */
+  where?: Expression;
+
+  /**
+   * @generated from field: rill.runtime.v1.Expression having = 14;
+   */
+  having?: Expression;
+
   /**
    * @generated from field: int64 limit = 10;
    */
@@ -904,6 +945,8 @@ export class MetricsViewComparisonToplistRequest extends Message<MetricsViewComp
     { no: 7, name: "comparison_time_range", kind: "message", T: TimeRange },
     { no: 8, name: "sort", kind: "message", T: MetricsViewComparisonSort, repeated: true },
     { no: 9, name: "filter", kind: "message", T: MetricsViewFilter },
+    { no: 13, name: "where", kind: "message", T: Expression },
+    { no: 14, name: "having", kind: "message", T: Expression },
     { no: 10, name: "limit", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 11, name: "offset", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 12, name: "priority", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
@@ -1203,10 +1246,17 @@ export class MetricsViewTimeSeriesRequest extends Message<MetricsViewTimeSeriesR
   timeGranularity = TimeGrain.UNSPECIFIED;
 
   /**
+   * Deprecated. Use where instead.
+   *
    * @generated from field: rill.runtime.v1.MetricsViewFilter filter = 7;
    */
   filter?: MetricsViewFilter;
 
+  /**
+   * @generated from field: rill.runtime.v1.Expression where = 11;
+   */
+  where?: Expression;
+
   /**
    * @generated from field: string time_zone = 10;
    */
@@ -1233,6 +1283,7 @@ export class MetricsViewTimeSeriesRequest extends Message<MetricsViewTimeSeriesR
     { no: 5, name: "time_end", kind: "message", T: Timestamp },
     { no: 6, name: "time_granularity", kind: "enum", T: proto3.getEnumType(TimeGrain) },
     { no: 7, name: "filter", kind: "message", T: MetricsViewFilter },
+    { no: 11, name: "where", kind: "message", T: Expression },
     { no: 10, name: "time_zone", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 8, name: "priority", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
@@ -1336,10 +1387,17 @@ export class MetricsViewTotalsRequest extends Message<MetricsViewTotalsRequest>
   timeEnd?: Timestamp;
 
   /**
+   * Deprecated. Use where instead.
+   *
    * @generated from field: rill.runtime.v1.MetricsViewFilter filter = 7;
    */
   filter?: MetricsViewFilter;
 
+  /**
+   * @generated from field: rill.runtime.v1.Expression where = 10;
+   */
+  where?: Expression;
+
   /**
    * @generated from field: int32 priority = 8;
    */
@@ -1360,6 +1418,7 @@ export class MetricsViewTotalsRequest extends Message<MetricsViewTotalsRequest>
     { no: 4, name: "time_start", kind: "message", T: Timestamp },
     { no: 5, name: "time_end", kind: "message", T: Timestamp },
     { no: 7, name: "filter", kind: "message", T: MetricsViewFilter },
+    { no: 10, name: "where", kind: "message", T: Expression },
     { no: 8, name: "priority", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
@@ -1457,10 +1516,17 @@ export class MetricsViewRowsRequest extends Message<MetricsViewRowsRequest> {
   timeGranularity = TimeGrain.UNSPECIFIED;
 
   /**
+   * Deprecated. Use where instead.
+   *
    * @generated from field: rill.runtime.v1.MetricsViewFilter filter = 5;
    */
   filter?: MetricsViewFilter;
 
+  /**
+   * @generated from field: rill.runtime.v1.Expression where = 12;
+   */
+  where?: Expression;
+
   /**
    * @generated from field: repeated rill.runtime.v1.MetricsViewSort sort = 6;
    */
@@ -1500,6 +1566,7 @@ export class MetricsViewRowsRequest extends Message<MetricsViewRowsRequest> {
     { no: 4, name: "time_end", kind: "message", T: Timestamp },
     { no: 10, name: "time_granularity", kind: "enum", T: proto3.getEnumType(TimeGrain) },
     { no: 5, name: "filter", kind: "message", T: MetricsViewFilter },
+    { no: 12, name: "where", kind: "message", T: Expression },
     { no: 6, name: "sort", kind: "message", T: MetricsViewSort, repeated: true },
     { no: 7, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 8, name: "offset", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
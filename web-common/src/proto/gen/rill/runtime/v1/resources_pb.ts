@@ -6,6 +6,8 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64, Struct, Timestamp } from "@bufbuild/protobuf";
 import { TimeGrain } from "./time_grain_pb.js";
+import { ExportFormat } from "./queries_pb.js";
+import { Expression } from "./expression_pb.js";
 
 /**
  * @generated from enum rill.runtime.v1.ReconcileStatus
@@ -39,6 +41,32 @@ proto3.util.setEnumType(ReconcileStatus, "rill.runtime.v1.ReconcileStatus", [
   { no: 3, name: "RECONCILE_STATUS_RUNNING" },
 ]);
 
+/**
+ * @generated from enum rill.runtime.v1.AlertStatus
+ */
+export enum AlertStatus {
+  /**
+   * @generated from enum value: ALERT_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ALERT_STATUS_OK = 1;
+   */
+  OK = 1,
+
+  /**
+   * @generated from enum value: ALERT_STATUS_FIRING = 2;
+   */
+  FIRING = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(AlertStatus)
+proto3.util.setEnumType(AlertStatus, "rill.runtime.v1.AlertStatus", [
+  { no: 0, name: "ALERT_STATUS_UNSPECIFIED" },
+  { no: 1, name: "ALERT_STATUS_OK" },
+  { no: 2, name: "ALERT_STATUS_FIRING" },
+]);
+
 /**
  * @generated from message rill.runtime.v1.Resource
  */
@@ -83,6 +111,30 @@ export class Resource extends Message<Resource> {
      */
     value: Migration;
     case: "migration";
+  } | {
+    /**
+     * @generated from field: rill.runtime.v1.Report report = 10;
+     */
+    value: Report;
+    case: "report";
+  } | {
+    /**
+     * @generated from field: rill.runtime.v1.Alert alert = 11;
+     */
+    value: Alert;
+    case: "alert";
+  } | {
+    /**
+     * @generated from field: rill.runtime.v1.Test test = 12;
+     */
+    value: Test;
+    case: "test";
+  } | {
+    /**
+     * @generated from field: rill.runtime.v1.Macro macro = 13;
+     */
+    value: Macro;
+    case: "macro";
   } | {
     /**
      * @generated from field: rill.runtime.v1.PullTrigger pull_trigger = 6;
@@ -117,6 +169,10 @@ export class Resource extends Message<Resource> {
     { no: 4, name: "model", kind: "message", T: ModelV2, oneof: "resource" },
     { no: 5, name: "metrics_view", kind: "message", T: MetricsViewV2, oneof: "resource" },
     { no: 9, name: "migration", kind: "message", T: Migration, oneof: "resource" },
+    { no: 10, name: "report", kind: "message", T: Report, oneof: "resource" },
+    { no: 11, name: "alert", kind: "message", T: Alert, oneof: "resource" },
+    { no: 12, name: "test", kind: "message", T: Test, oneof: "resource" },
+    { no: 13, name: "macro", kind: "message", T: Macro, oneof: "resource" },
     { no: 6, name: "pull_trigger", kind: "message", T: PullTrigger, oneof: "resource" },
     { no: 7, name: "refresh_trigger", kind: "message", T: RefreshTrigger, oneof: "resource" },
     { no: 8, name: "bucket_planner", kind: "message", T: BucketPlanner, oneof: "resource" },
@@ -546,6 +602,13 @@ export class SourceSpec extends Message<SourceSpec> {
    */
   timeoutSeconds = 0;
 
+  /**
+   * incremental makes refreshes only ingest new or changed objects (currently only supported for object stores)
+   *
+   * @generated from field: bool incremental = 10;
+   */
+  incremental = false;
+
   /**
    * Fields not derived from code files
    *
@@ -563,6 +626,13 @@ export class SourceSpec extends Message<SourceSpec> {
    */
   trigger = false;
 
+  /**
+   * trigger_full_refresh forces an incremental source to be re-ingested from scratch on the next trigger
+   *
+   * @generated from field: bool trigger_full_refresh = 11;
+   */
+  triggerFullRefresh = false;
+
   constructor(data?: PartialMessage<SourceSpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -576,9 +646,11 @@ export class SourceSpec extends Message<SourceSpec> {
     { no: 3, name: "properties", kind: "message", T: Struct },
     { no: 5, name: "refresh_schedule", kind: "message", T: Schedule },
     { no: 6, name: "timeout_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 10, name: "incremental", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 7, name: "stage_changes", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 8, name: "stream_ingestion", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 9, name: "trigger", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 11, name: "trigger_full_refresh", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SourceSpec {
@@ -622,6 +694,13 @@ export class SourceState extends Message<SourceState> {
    */
   refreshedOn?: Timestamp;
 
+  /**
+   * ingested_objects tracks the objects ingested into the table of an incremental source
+   *
+   * @generated from field: repeated rill.runtime.v1.IngestedObject ingested_objects = 5;
+   */
+  ingestedObjects: IngestedObject[] = [];
+
   constructor(data?: PartialMessage<SourceState>) {
     super();
     proto3.util.initPartial(data, this);
@@ -634,6 +713,7 @@ export class SourceState extends Message<SourceState> {
     { no: 2, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "spec_hash", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "refreshed_on", kind: "message", T: Timestamp },
+    { no: 5, name: "ingested_objects", kind: "message", T: IngestedObject, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SourceState {
@@ -653,6 +733,61 @@ export class SourceState extends Message<SourceState> {
   }
 }
 
+/**
+ * @generated from message rill.runtime.v1.IngestedObject
+ */
+export class IngestedObject extends Message<IngestedObject> {
+  /**
+   * @generated from field: string key = 1;
+   */
+  key = "";
+
+  /**
+   * @generated from field: string etag = 2;
+   */
+  etag = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_modified = 3;
+   */
+  lastModified?: Timestamp;
+
+  /**
+   * @generated from field: int64 size = 4;
+   */
+  size = protoInt64.zero;
+
+  constructor(data?: PartialMessage<IngestedObject>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.IngestedObject";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "etag", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "last_modified", kind: "message", T: Timestamp },
+    { no: 4, name: "size", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IngestedObject {
+    return new IngestedObject().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IngestedObject {
+    return new IngestedObject().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IngestedObject {
+    return new IngestedObject().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: IngestedObject | PlainMessage<IngestedObject> | undefined, b: IngestedObject | PlainMessage<IngestedObject> | undefined): boolean {
+    return proto3.util.equals(IngestedObject, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.ModelV2
  */
@@ -730,6 +865,42 @@ export class ModelSpec extends Message<ModelSpec> {
    */
   usesTemplating = false;
 
+  /**
+   * incremental makes refreshes insert new results into the existing table instead of rebuilding it
+   *
+   * @generated from field: bool incremental = 10;
+   */
+  incremental = false;
+
+  /**
+   * unique_key makes incremental refreshes replace existing rows with matching key values (delete+insert) instead of appending.
+   * For snapshot models, it identifies the rows to track history for.
+   *
+   * @generated from field: repeated string unique_key = 11;
+   */
+  uniqueKey: string[] = [];
+
+  /**
+   * snapshot makes refreshes maintain a slowly changing dimension (type 2) table with valid_from and valid_to columns instead of overwriting it
+   *
+   * @generated from field: bool snapshot = 13;
+   */
+  snapshot = false;
+
+  /**
+   * snapshot_updated_at is a column that is compared to detect changed rows in a snapshot model
+   *
+   * @generated from field: string snapshot_updated_at = 14;
+   */
+  snapshotUpdatedAt = "";
+
+  /**
+   * snapshot_check_columns are compared to detect changed rows in a snapshot model if snapshot_updated_at is not set
+   *
+   * @generated from field: repeated string snapshot_check_columns = 15;
+   */
+  snapshotCheckColumns: string[] = [];
+
   /**
    * Fields not derived from code files
    *
@@ -747,6 +918,13 @@ export class ModelSpec extends Message<ModelSpec> {
    */
   trigger = false;
 
+  /**
+   * trigger_full_refresh forces an incremental model to be rebuilt from scratch on the next trigger
+   *
+   * @generated from field: bool trigger_full_refresh = 12;
+   */
+  triggerFullRefresh = false;
+
   constructor(data?: PartialMessage<ModelSpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -761,9 +939,15 @@ export class ModelSpec extends Message<ModelSpec> {
     { no: 4, name: "refresh_schedule", kind: "message", T: Schedule },
     { no: 5, name: "timeout_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
     { no: 6, name: "uses_templating", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 10, name: "incremental", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 11, name: "unique_key", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 13, name: "snapshot", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 14, name: "snapshot_updated_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 15, name: "snapshot_check_columns", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 7, name: "stage_changes", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 8, name: "materialize_delay_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
     { no: 9, name: "trigger", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 12, name: "trigger_full_refresh", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ModelSpec {
@@ -807,6 +991,30 @@ export class ModelState extends Message<ModelState> {
    */
   refreshedOn?: Timestamp;
 
+  /**
+   * incremental_spec_hash is a hash of the execution-related spec fields excluding refs.
+   * An incremental model is fully refreshed if it changes.
+   *
+   * @generated from field: string incremental_spec_hash = 5;
+   */
+  incrementalSpecHash = "";
+
+  /**
+   * incremental_watermark is the time the last successful run of an incremental model started.
+   * It's exposed to templates to let incremental runs only select new data.
+   *
+   * @generated from field: google.protobuf.Timestamp incremental_watermark = 6;
+   */
+  incrementalWatermark?: Timestamp;
+
+  /**
+   * column_lineage describes the upstream columns of each column in the model's output.
+   * It is only computed for models in DuckDB.
+   *
+   * @generated from field: repeated rill.runtime.v1.ColumnLineage column_lineage = 7;
+   */
+  columnLineage: ColumnLineage[] = [];
+
   constructor(data?: PartialMessage<ModelState>) {
     super();
     proto3.util.initPartial(data, this);
@@ -819,6 +1027,9 @@ export class ModelState extends Message<ModelState> {
     { no: 2, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "spec_hash", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "refreshed_on", kind: "message", T: Timestamp },
+    { no: 5, name: "incremental_spec_hash", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "incremental_watermark", kind: "message", T: Timestamp },
+    { no: 7, name: "column_lineage", kind: "message", T: ColumnLineage, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ModelState {
@@ -1105,6 +1316,20 @@ export class MetricsViewSpec_MeasureV2 extends Message<MetricsViewSpec_MeasureV2
    */
   validPercentOfTotal = false;
 
+  /**
+   * Names of other measures referenced in the expression
+   *
+   * @generated from field: repeated string referenced_measures = 7;
+   */
+  referencedMeasures: string[] = [];
+
+  /**
+   * If set, the expression is evaluated as a window function over the time dimension
+   *
+   * @generated from field: rill.runtime.v1.MetricsViewSpec.MeasureWindow window = 8;
+   */
+  window?: MetricsViewSpec_MeasureWindow;
+
   constructor(data?: PartialMessage<MetricsViewSpec_MeasureV2>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1119,6 +1344,8 @@ export class MetricsViewSpec_MeasureV2 extends Message<MetricsViewSpec_MeasureV2
     { no: 4, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 5, name: "format", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 6, name: "valid_percent_of_total", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "referenced_measures", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 8, name: "window", kind: "message", T: MetricsViewSpec_MeasureWindow },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsViewSpec_MeasureV2 {
@@ -1138,6 +1365,55 @@ export class MetricsViewSpec_MeasureV2 extends Message<MetricsViewSpec_MeasureV2
   }
 }
 
+/**
+ * Window for a measure computed over the time dimension
+ *
+ * @generated from message rill.runtime.v1.MetricsViewSpec.MeasureWindow
+ */
+export class MetricsViewSpec_MeasureWindow extends Message<MetricsViewSpec_MeasureWindow> {
+  /**
+   * Partition the window by the other dimensions in the query
+   *
+   * @generated from field: bool partition = 1;
+   */
+  partition = false;
+
+  /**
+   * Frame clause of the window, e.g. "ROWS BETWEEN 6 PRECEDING AND CURRENT ROW"
+   *
+   * @generated from field: string frame = 2;
+   */
+  frame = "";
+
+  constructor(data?: PartialMessage<MetricsViewSpec_MeasureWindow>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.MetricsViewSpec.MeasureWindow";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "partition", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "frame", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsViewSpec_MeasureWindow {
+    return new MetricsViewSpec_MeasureWindow().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetricsViewSpec_MeasureWindow {
+    return new MetricsViewSpec_MeasureWindow().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetricsViewSpec_MeasureWindow {
+    return new MetricsViewSpec_MeasureWindow().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetricsViewSpec_MeasureWindow | PlainMessage<MetricsViewSpec_MeasureWindow> | undefined, b: MetricsViewSpec_MeasureWindow | PlainMessage<MetricsViewSpec_MeasureWindow> | undefined): boolean {
+    return proto3.util.equals(MetricsViewSpec_MeasureWindow, a, b);
+  }
+}
+
 /**
  * Security for the dashboard
  *
@@ -1255,6 +1531,13 @@ export class MetricsViewState extends Message<MetricsViewState> {
    */
   validSpec?: MetricsViewSpec;
 
+  /**
+   * column_lineage describes the upstream columns of each dimension and measure in the valid spec
+   *
+   * @generated from field: repeated rill.runtime.v1.ColumnLineage column_lineage = 2;
+   */
+  columnLineage: ColumnLineage[] = [];
+
   constructor(data?: PartialMessage<MetricsViewState>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1264,6 +1547,7 @@ export class MetricsViewState extends Message<MetricsViewState> {
   static readonly typeName = "rill.runtime.v1.MetricsViewState";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "valid_spec", kind: "message", T: MetricsViewSpec },
+    { no: 2, name: "column_lineage", kind: "message", T: ColumnLineage, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsViewState {
@@ -1283,6 +1567,99 @@ export class MetricsViewState extends Message<MetricsViewState> {
   }
 }
 
+/**
+ * ColumnLineage describes the upstream columns that a column of a resource is derived from
+ *
+ * @generated from message rill.runtime.v1.ColumnLineage
+ */
+export class ColumnLineage extends Message<ColumnLineage> {
+  /**
+   * Name of the column, or of the dimension or measure for metrics views
+   *
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: repeated rill.runtime.v1.LineageColumn upstream = 2;
+   */
+  upstream: LineageColumn[] = [];
+
+  constructor(data?: PartialMessage<ColumnLineage>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ColumnLineage";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "upstream", kind: "message", T: LineageColumn, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ColumnLineage {
+    return new ColumnLineage().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ColumnLineage {
+    return new ColumnLineage().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ColumnLineage {
+    return new ColumnLineage().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ColumnLineage | PlainMessage<ColumnLineage> | undefined, b: ColumnLineage | PlainMessage<ColumnLineage> | undefined): boolean {
+    return proto3.util.equals(ColumnLineage, a, b);
+  }
+}
+
+/**
+ * LineageColumn identifies a column of a resource.
+ * The column is "*" if all columns of the resource are used.
+ *
+ * @generated from message rill.runtime.v1.LineageColumn
+ */
+export class LineageColumn extends Message<LineageColumn> {
+  /**
+   * @generated from field: rill.runtime.v1.ResourceName resource = 1;
+   */
+  resource?: ResourceName;
+
+  /**
+   * @generated from field: string column = 2;
+   */
+  column = "";
+
+  constructor(data?: PartialMessage<LineageColumn>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.LineageColumn";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "resource", kind: "message", T: ResourceName },
+    { no: 2, name: "column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LineageColumn {
+    return new LineageColumn().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LineageColumn {
+    return new LineageColumn().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LineageColumn {
+    return new LineageColumn().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LineageColumn | PlainMessage<LineageColumn> | undefined, b: LineageColumn | PlainMessage<LineageColumn> | undefined): boolean {
+    return proto3.util.equals(LineageColumn, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.Migration
  */
@@ -1413,75 +1790,828 @@ export class MigrationState extends Message<MigrationState> {
 }
 
 /**
- * @generated from message rill.runtime.v1.PullTrigger
+ * @generated from message rill.runtime.v1.Report
  */
-export class PullTrigger extends Message<PullTrigger> {
+export class Report extends Message<Report> {
   /**
-   * @generated from field: rill.runtime.v1.PullTriggerSpec spec = 1;
+   * @generated from field: rill.runtime.v1.ReportSpec spec = 1;
    */
-  spec?: PullTriggerSpec;
+  spec?: ReportSpec;
 
   /**
-   * @generated from field: rill.runtime.v1.PullTriggerState state = 2;
+   * @generated from field: rill.runtime.v1.ReportState state = 2;
    */
-  state?: PullTriggerState;
+  state?: ReportState;
 
-  constructor(data?: PartialMessage<PullTrigger>) {
+  constructor(data?: PartialMessage<Report>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.runtime.v1.PullTrigger";
+  static readonly typeName = "rill.runtime.v1.Report";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "spec", kind: "message", T: PullTriggerSpec },
-    { no: 2, name: "state", kind: "message", T: PullTriggerState },
+    { no: 1, name: "spec", kind: "message", T: ReportSpec },
+    { no: 2, name: "state", kind: "message", T: ReportState },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PullTrigger {
-    return new PullTrigger().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Report {
+    return new Report().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PullTrigger {
-    return new PullTrigger().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Report {
+    return new Report().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PullTrigger {
-    return new PullTrigger().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Report {
+    return new Report().fromJsonString(jsonString, options);
   }
 
-  static equals(a: PullTrigger | PlainMessage<PullTrigger> | undefined, b: PullTrigger | PlainMessage<PullTrigger> | undefined): boolean {
-    return proto3.util.equals(PullTrigger, a, b);
+  static equals(a: Report | PlainMessage<Report> | undefined, b: Report | PlainMessage<Report> | undefined): boolean {
+    return proto3.util.equals(Report, a, b);
   }
 }
 
 /**
- * @generated from message rill.runtime.v1.PullTriggerSpec
+ * @generated from message rill.runtime.v1.ReportSpec
  */
-export class PullTriggerSpec extends Message<PullTriggerSpec> {
-  constructor(data?: PartialMessage<PullTriggerSpec>) {
-    super();
-    proto3.util.initPartial(data, this);
-  }
-
-  static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.runtime.v1.PullTriggerSpec";
-  static readonly fields: FieldList = proto3.util.newFieldList(() => [
-  ]);
+export class ReportSpec extends Message<ReportSpec> {
+  /**
+   * @generated from field: bool trigger = 1;
+   */
+  trigger = false;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PullTriggerSpec {
-    return new PullTriggerSpec().fromBinary(bytes, options);
-  }
+  /**
+   * User friendly title of the report, used in emails
+   *
+   * @generated from field: string title = 2;
+   */
+  title = "";
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PullTriggerSpec {
-    return new PullTriggerSpec().fromJson(jsonValue, options);
-  }
+  /**
+   * @generated from field: rill.runtime.v1.Schedule refresh_schedule = 3;
+   */
+  refreshSchedule?: Schedule;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PullTriggerSpec {
-    return new PullTriggerSpec().fromJsonString(jsonString, options);
-  }
+  /**
+   * @generated from field: uint32 timeout_seconds = 4;
+   */
+  timeoutSeconds = 0;
 
-  static equals(a: PullTriggerSpec | PlainMessage<PullTriggerSpec> | undefined, b: PullTriggerSpec | PlainMessage<PullTriggerSpec> | undefined): boolean {
+  /**
+   * Name of the query to run, e.g. "MetricsViewToplist" or "MetricsViewRows"
+   *
+   * @generated from field: string query_name = 5;
+   */
+  queryName = "";
+
+  /**
+   * JSON-serialized request for the query (without the instance ID)
+   *
+   * @generated from field: string query_args_json = 6;
+   */
+  queryArgsJson = "";
+
+  /**
+   * Maximum number of rows to export (0 means no limit)
+   *
+   * @generated from field: uint64 export_limit = 7;
+   */
+  exportLimit = protoInt64.zero;
+
+  /**
+   * @generated from field: rill.runtime.v1.ExportFormat export_format = 8;
+   */
+  exportFormat = ExportFormat.UNSPECIFIED;
+
+  /**
+   * Email addresses to send the report to
+   *
+   * @generated from field: repeated string email_recipients = 9;
+   */
+  emailRecipients: string[] = [];
+
+  /**
+   * URLs to POST the exported file to
+   *
+   * @generated from field: repeated string webhook_urls = 10;
+   */
+  webhookUrls: string[] = [];
+
+  /**
+   * Attributes of the report owner, used to resolve the metrics view's security policy
+   *
+   * @generated from field: google.protobuf.Struct owner_attributes = 11;
+   */
+  ownerAttributes?: Struct;
+
+  constructor(data?: PartialMessage<ReportSpec>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ReportSpec";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "trigger", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "refresh_schedule", kind: "message", T: Schedule },
+    { no: 4, name: "timeout_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "query_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "query_args_json", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "export_limit", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 8, name: "export_format", kind: "enum", T: proto3.getEnumType(ExportFormat) },
+    { no: 9, name: "email_recipients", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 10, name: "webhook_urls", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 11, name: "owner_attributes", kind: "message", T: Struct },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportSpec {
+    return new ReportSpec().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportSpec {
+    return new ReportSpec().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportSpec {
+    return new ReportSpec().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReportSpec | PlainMessage<ReportSpec> | undefined, b: ReportSpec | PlainMessage<ReportSpec> | undefined): boolean {
+    return proto3.util.equals(ReportSpec, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.ReportState
+ */
+export class ReportState extends Message<ReportState> {
+  /**
+   * @generated from field: google.protobuf.Timestamp next_run_on = 1;
+   */
+  nextRunOn?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_run_on = 2;
+   */
+  lastRunOn?: Timestamp;
+
+  /**
+   * @generated from field: string last_error = 3;
+   */
+  lastError = "";
+
+  /**
+   * @generated from field: uint32 execution_count = 4;
+   */
+  executionCount = 0;
+
+  constructor(data?: PartialMessage<ReportState>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ReportState";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "next_run_on", kind: "message", T: Timestamp },
+    { no: 2, name: "last_run_on", kind: "message", T: Timestamp },
+    { no: 3, name: "last_error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "execution_count", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportState {
+    return new ReportState().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportState {
+    return new ReportState().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportState {
+    return new ReportState().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReportState | PlainMessage<ReportState> | undefined, b: ReportState | PlainMessage<ReportState> | undefined): boolean {
+    return proto3.util.equals(ReportState, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.Alert
+ */
+export class Alert extends Message<Alert> {
+  /**
+   * @generated from field: rill.runtime.v1.AlertSpec spec = 1;
+   */
+  spec?: AlertSpec;
+
+  /**
+   * @generated from field: rill.runtime.v1.AlertState state = 2;
+   */
+  state?: AlertState;
+
+  constructor(data?: PartialMessage<Alert>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.Alert";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "spec", kind: "message", T: AlertSpec },
+    { no: 2, name: "state", kind: "message", T: AlertState },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Alert {
+    return new Alert().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Alert {
+    return new Alert().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Alert {
+    return new Alert().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Alert | PlainMessage<Alert> | undefined, b: Alert | PlainMessage<Alert> | undefined): boolean {
+    return proto3.util.equals(Alert, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.AlertSpec
+ */
+export class AlertSpec extends Message<AlertSpec> {
+  /**
+   * @generated from field: bool trigger = 1;
+   */
+  trigger = false;
+
+  /**
+   * User friendly title of the alert, used in notifications
+   *
+   * @generated from field: string title = 2;
+   */
+  title = "";
+
+  /**
+   * @generated from field: rill.runtime.v1.Schedule refresh_schedule = 3;
+   */
+  refreshSchedule?: Schedule;
+
+  /**
+   * @generated from field: uint32 timeout_seconds = 4;
+   */
+  timeoutSeconds = 0;
+
+  /**
+   * Name of the query to evaluate. Currently only "MetricsViewAggregation" is supported.
+   *
+   * @generated from field: string query_name = 5;
+   */
+  queryName = "";
+
+  /**
+   * JSON-serialized request for the query (without the instance ID)
+   *
+   * @generated from field: string query_args_json = 6;
+   */
+  queryArgsJson = "";
+
+  /**
+   * ISO 8601 duration of the time range to evaluate, ending at the time of evaluation
+   *
+   * @generated from field: string query_time_range = 7;
+   */
+  queryTimeRange = "";
+
+  /**
+   * Condition on the query's measures. The alert fires if any row returned by the query satisfies it.
+   *
+   * @generated from field: rill.runtime.v1.Expression condition = 8;
+   */
+  condition?: Expression;
+
+  /**
+   * Attributes of the alert owner, used to resolve the metrics view's security policy
+   *
+   * @generated from field: google.protobuf.Struct owner_attributes = 9;
+   */
+  ownerAttributes?: Struct;
+
+  /**
+   * Email addresses to notify
+   *
+   * @generated from field: repeated string email_recipients = 10;
+   */
+  emailRecipients: string[] = [];
+
+  /**
+   * URLs to POST notifications to
+   *
+   * @generated from field: repeated string webhook_urls = 11;
+   */
+  webhookUrls: string[] = [];
+
+  constructor(data?: PartialMessage<AlertSpec>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.AlertSpec";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "trigger", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "refresh_schedule", kind: "message", T: Schedule },
+    { no: 4, name: "timeout_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "query_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "query_args_json", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "query_time_range", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "condition", kind: "message", T: Expression },
+    { no: 9, name: "owner_attributes", kind: "message", T: Struct },
+    { no: 10, name: "email_recipients", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 11, name: "webhook_urls", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AlertSpec {
+    return new AlertSpec().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AlertSpec {
+    return new AlertSpec().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AlertSpec {
+    return new AlertSpec().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AlertSpec | PlainMessage<AlertSpec> | undefined, b: AlertSpec | PlainMessage<AlertSpec> | undefined): boolean {
+    return proto3.util.equals(AlertSpec, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.AlertState
+ */
+export class AlertState extends Message<AlertState> {
+  /**
+   * @generated from field: google.protobuf.Timestamp next_run_on = 1;
+   */
+  nextRunOn?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_run_on = 2;
+   */
+  lastRunOn?: Timestamp;
+
+  /**
+   * @generated from field: string last_error = 3;
+   */
+  lastError = "";
+
+  /**
+   * @generated from field: uint32 execution_count = 4;
+   */
+  executionCount = 0;
+
+  /**
+   * @generated from field: rill.runtime.v1.AlertStatus status = 5;
+   */
+  status = AlertStatus.UNSPECIFIED;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp status_changed_on = 6;
+   */
+  statusChangedOn?: Timestamp;
+
+  /**
+   * Number of rows that satisfied the condition in the last evaluation
+   *
+   * @generated from field: uint32 firing_count = 7;
+   */
+  firingCount = 0;
+
+  constructor(data?: PartialMessage<AlertState>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.AlertState";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "next_run_on", kind: "message", T: Timestamp },
+    { no: 2, name: "last_run_on", kind: "message", T: Timestamp },
+    { no: 3, name: "last_error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "execution_count", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "status", kind: "enum", T: proto3.getEnumType(AlertStatus) },
+    { no: 6, name: "status_changed_on", kind: "message", T: Timestamp },
+    { no: 7, name: "firing_count", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AlertState {
+    return new AlertState().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AlertState {
+    return new AlertState().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AlertState {
+    return new AlertState().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AlertState | PlainMessage<AlertState> | undefined, b: AlertState | PlainMessage<AlertState> | undefined): boolean {
+    return proto3.util.equals(AlertState, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.Test
+ */
+export class Test extends Message<Test> {
+  /**
+   * @generated from field: rill.runtime.v1.TestSpec spec = 1;
+   */
+  spec?: TestSpec;
+
+  /**
+   * @generated from field: rill.runtime.v1.TestState state = 2;
+   */
+  state?: TestState;
+
+  constructor(data?: PartialMessage<Test>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.Test";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "spec", kind: "message", T: TestSpec },
+    { no: 2, name: "state", kind: "message", T: TestState },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Test {
+    return new Test().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Test {
+    return new Test().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Test {
+    return new Test().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Test | PlainMessage<Test> | undefined, b: Test | PlainMessage<Test> | undefined): boolean {
+    return proto3.util.equals(Test, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.TestSpec
+ */
+export class TestSpec extends Message<TestSpec> {
+  /**
+   * @generated from field: string connector = 1;
+   */
+  connector = "";
+
+  /**
+   * Name of the model or source the assertions check. It's empty for tests that only contain custom SQL.
+   *
+   * @generated from field: string model = 2;
+   */
+  model = "";
+
+  /**
+   * @generated from field: repeated rill.runtime.v1.TestAssertion assertions = 3;
+   */
+  assertions: TestAssertion[] = [];
+
+  constructor(data?: PartialMessage<TestSpec>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.TestSpec";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "connector", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "model", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "assertions", kind: "message", T: TestAssertion, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TestSpec {
+    return new TestSpec().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TestSpec {
+    return new TestSpec().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TestSpec {
+    return new TestSpec().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TestSpec | PlainMessage<TestSpec> | undefined, b: TestSpec | PlainMessage<TestSpec> | undefined): boolean {
+    return proto3.util.equals(TestSpec, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.TestAssertion
+ */
+export class TestAssertion extends Message<TestAssertion> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * SQL query that returns the rows violating the assertion. The assertion passes if it returns no rows.
+   *
+   * @generated from field: string sql = 2;
+   */
+  sql = "";
+
+  constructor(data?: PartialMessage<TestAssertion>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.TestAssertion";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "sql", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TestAssertion {
+    return new TestAssertion().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TestAssertion {
+    return new TestAssertion().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TestAssertion {
+    return new TestAssertion().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TestAssertion | PlainMessage<TestAssertion> | undefined, b: TestAssertion | PlainMessage<TestAssertion> | undefined): boolean {
+    return proto3.util.equals(TestAssertion, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.TestState
+ */
+export class TestState extends Message<TestState> {
+  /**
+   * @generated from field: google.protobuf.Timestamp last_run_on = 1;
+   */
+  lastRunOn?: Timestamp;
+
+  /**
+   * Names of the assertions that failed in the last run
+   *
+   * @generated from field: repeated string failed_assertions = 2;
+   */
+  failedAssertions: string[] = [];
+
+  constructor(data?: PartialMessage<TestState>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.TestState";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "last_run_on", kind: "message", T: Timestamp },
+    { no: 2, name: "failed_assertions", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TestState {
+    return new TestState().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TestState {
+    return new TestState().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TestState {
+    return new TestState().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TestState | PlainMessage<TestState> | undefined, b: TestState | PlainMessage<TestState> | undefined): boolean {
+    return proto3.util.equals(TestState, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.Macro
+ */
+export class Macro extends Message<Macro> {
+  /**
+   * @generated from field: rill.runtime.v1.MacroSpec spec = 1;
+   */
+  spec?: MacroSpec;
+
+  /**
+   * @generated from field: rill.runtime.v1.MacroState state = 2;
+   */
+  state?: MacroState;
+
+  constructor(data?: PartialMessage<Macro>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.Macro";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "spec", kind: "message", T: MacroSpec },
+    { no: 2, name: "state", kind: "message", T: MacroState },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Macro {
+    return new Macro().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Macro {
+    return new Macro().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Macro {
+    return new Macro().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Macro | PlainMessage<Macro> | undefined, b: Macro | PlainMessage<Macro> | undefined): boolean {
+    return proto3.util.equals(Macro, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.MacroSpec
+ */
+export class MacroSpec extends Message<MacroSpec> {
+  /**
+   * Names of the macro's arguments. They are passed positionally and available as .args.<name> in the SQL.
+   *
+   * @generated from field: repeated string args = 1;
+   */
+  args: string[] = [];
+
+  /**
+   * SQL template the macro expands to
+   *
+   * @generated from field: string sql = 2;
+   */
+  sql = "";
+
+  constructor(data?: PartialMessage<MacroSpec>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.MacroSpec";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "args", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "sql", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MacroSpec {
+    return new MacroSpec().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MacroSpec {
+    return new MacroSpec().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MacroSpec {
+    return new MacroSpec().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MacroSpec | PlainMessage<MacroSpec> | undefined, b: MacroSpec | PlainMessage<MacroSpec> | undefined): boolean {
+    return proto3.util.equals(MacroSpec, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.MacroState
+ */
+export class MacroState extends Message<MacroState> {
+  /**
+   * Hash of the spec and the state versions of the macro's refs. It changes whenever the macro's expansion may have changed.
+   *
+   * @generated from field: string spec_hash = 1;
+   */
+  specHash = "";
+
+  constructor(data?: PartialMessage<MacroState>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.MacroState";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "spec_hash", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MacroState {
+    return new MacroState().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MacroState {
+    return new MacroState().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MacroState {
+    return new MacroState().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MacroState | PlainMessage<MacroState> | undefined, b: MacroState | PlainMessage<MacroState> | undefined): boolean {
+    return proto3.util.equals(MacroState, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.PullTrigger
+ */
+export class PullTrigger extends Message<PullTrigger> {
+  /**
+   * @generated from field: rill.runtime.v1.PullTriggerSpec spec = 1;
+   */
+  spec?: PullTriggerSpec;
+
+  /**
+   * @generated from field: rill.runtime.v1.PullTriggerState state = 2;
+   */
+  state?: PullTriggerState;
+
+  constructor(data?: PartialMessage<PullTrigger>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.PullTrigger";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "spec", kind: "message", T: PullTriggerSpec },
+    { no: 2, name: "state", kind: "message", T: PullTriggerState },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PullTrigger {
+    return new PullTrigger().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PullTrigger {
+    return new PullTrigger().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PullTrigger {
+    return new PullTrigger().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PullTrigger | PlainMessage<PullTrigger> | undefined, b: PullTrigger | PlainMessage<PullTrigger> | undefined): boolean {
+    return proto3.util.equals(PullTrigger, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.PullTriggerSpec
+ */
+export class PullTriggerSpec extends Message<PullTriggerSpec> {
+  constructor(data?: PartialMessage<PullTriggerSpec>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.PullTriggerSpec";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PullTriggerSpec {
+    return new PullTriggerSpec().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PullTriggerSpec {
+    return new PullTriggerSpec().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PullTriggerSpec {
+    return new PullTriggerSpec().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PullTriggerSpec | PlainMessage<PullTriggerSpec> | undefined, b: PullTriggerSpec | PlainMessage<PullTriggerSpec> | undefined): boolean {
     return proto3.util.equals(PullTriggerSpec, a, b);
   }
 }
@@ -1569,6 +2699,13 @@ export class RefreshTriggerSpec extends Message<RefreshTriggerSpec> {
    */
   onlyNames: ResourceName[] = [];
 
+  /**
+   * full_refresh makes incremental models and sources rebuild from scratch instead of refreshing incrementally
+   *
+   * @generated from field: bool full_refresh = 2;
+   */
+  fullRefresh = false;
+
   constructor(data?: PartialMessage<RefreshTriggerSpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1578,6 +2715,7 @@ export class RefreshTriggerSpec extends Message<RefreshTriggerSpec> {
   static readonly typeName = "rill.runtime.v1.RefreshTriggerSpec";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "only_names", kind: "message", T: ResourceName, repeated: true },
+    { no: 2, name: "full_refresh", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RefreshTriggerSpec {
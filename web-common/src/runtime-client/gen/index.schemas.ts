@@ -348,6 +348,7 @@ export type RuntimeServiceEditInstanceBody = {
   ingestionLimitBytes?: string;
   connectors?: V1Connector[];
   annotations?: RuntimeServiceEditInstanceBodyAnnotations;
+  queryLimits?: V1QueryLimits;
 };
 
 export type RuntimeServiceEditInstanceVariablesBodyVariables = {
@@ -843,6 +844,21 @@ export interface V1QueryResponse {
   data?: V1QueryResponseDataItem[];
 }
 
+/**
+ * QueryLimits are guardrails for the cost of queries against an instance.
+The runtime estimates the cost of a query before running it and rejects it with code RESOURCE_EXHAUSTED if it exceeds a limit.
+A zero value means no limit.
+ */
+export interface V1QueryLimits {
+  maxScannedRows?: string;
+  maxScannedColumns?: string;
+  maxResultRows?: string;
+  maxResultBytes?: string;
+  /** Max number of queries a user (identified by the JWT subject) may run concurrently. Further queries wait in a queue. */
+  maxConcurrentQueriesPerUser?: string;
+  downgrade?: boolean;
+}
+
 export interface V1QueryBatchResponse {
   key?: number;
   error?: string;
@@ -1379,6 +1395,7 @@ of in the runtime's metadata store. Currently only supported for the duckdb driv
   ingestionLimitBytes?: string;
   /** bare minimum connectors required by the instance. */
   connectors?: V1Connector[];
+  queryLimits?: V1QueryLimits;
 }
 
 export interface V1InlineMeasure {
@@ -1538,6 +1555,7 @@ export interface V1CreateInstanceRequest {
   ingestionLimitBytes?: string;
   annotations?: V1CreateInstanceRequestAnnotations;
   connectors?: V1Connector[];
+  queryLimits?: V1QueryLimits;
 }
 
 /**